@@ -1738,6 +1738,8 @@ var (
 	fd_EventFunTokenCreated_erc20_contract_address protoreflect.FieldDescriptor
 	fd_EventFunTokenCreated_creator                protoreflect.FieldDescriptor
 	fd_EventFunTokenCreated_is_made_from_coin      protoreflect.FieldDescriptor
+	fd_EventFunTokenCreated_cw20_addr              protoreflect.FieldDescriptor
+	fd_EventFunTokenCreated_decimals_scale         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1747,6 +1749,8 @@ func init() {
 	fd_EventFunTokenCreated_erc20_contract_address = md_EventFunTokenCreated.Fields().ByName("erc20_contract_address")
 	fd_EventFunTokenCreated_creator = md_EventFunTokenCreated.Fields().ByName("creator")
 	fd_EventFunTokenCreated_is_made_from_coin = md_EventFunTokenCreated.Fields().ByName("is_made_from_coin")
+	fd_EventFunTokenCreated_cw20_addr = md_EventFunTokenCreated.Fields().ByName("cw20_addr")
+	fd_EventFunTokenCreated_decimals_scale = md_EventFunTokenCreated.Fields().ByName("decimals_scale")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventFunTokenCreated)(nil)
@@ -1838,6 +1842,18 @@ func (x *fastReflection_EventFunTokenCreated) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
+	if x.Cw20Addr != "" {
+		value := protoreflect.ValueOfString(x.Cw20Addr)
+		if !f(fd_EventFunTokenCreated_cw20_addr, value) {
+			return
+		}
+	}
+	if x.DecimalsScale != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DecimalsScale)
+		if !f(fd_EventFunTokenCreated_decimals_scale, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1861,6 +1877,10 @@ func (x *fastReflection_EventFunTokenCreated) Has(fd protoreflect.FieldDescripto
 		return x.Creator != ""
 	case "eth.evm.v1.EventFunTokenCreated.is_made_from_coin":
 		return x.IsMadeFromCoin != false
+	case "eth.evm.v1.EventFunTokenCreated.cw20_addr":
+		return x.Cw20Addr != ""
+	case "eth.evm.v1.EventFunTokenCreated.decimals_scale":
+		return x.DecimalsScale != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenCreated"))
@@ -1885,6 +1905,10 @@ func (x *fastReflection_EventFunTokenCreated) Clear(fd protoreflect.FieldDescrip
 		x.Creator = ""
 	case "eth.evm.v1.EventFunTokenCreated.is_made_from_coin":
 		x.IsMadeFromCoin = false
+	case "eth.evm.v1.EventFunTokenCreated.cw20_addr":
+		x.Cw20Addr = ""
+	case "eth.evm.v1.EventFunTokenCreated.decimals_scale":
+		x.DecimalsScale = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenCreated"))
@@ -1913,6 +1937,12 @@ func (x *fastReflection_EventFunTokenCreated) Get(descriptor protoreflect.FieldD
 	case "eth.evm.v1.EventFunTokenCreated.is_made_from_coin":
 		value := x.IsMadeFromCoin
 		return protoreflect.ValueOfBool(value)
+	case "eth.evm.v1.EventFunTokenCreated.cw20_addr":
+		value := x.Cw20Addr
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunTokenCreated.decimals_scale":
+		value := x.DecimalsScale
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenCreated"))
@@ -1941,6 +1971,10 @@ func (x *fastReflection_EventFunTokenCreated) Set(fd protoreflect.FieldDescripto
 		x.Creator = value.Interface().(string)
 	case "eth.evm.v1.EventFunTokenCreated.is_made_from_coin":
 		x.IsMadeFromCoin = value.Bool()
+	case "eth.evm.v1.EventFunTokenCreated.cw20_addr":
+		x.Cw20Addr = value.Interface().(string)
+	case "eth.evm.v1.EventFunTokenCreated.decimals_scale":
+		x.DecimalsScale = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenCreated"))
@@ -1969,6 +2003,10 @@ func (x *fastReflection_EventFunTokenCreated) Mutable(fd protoreflect.FieldDescr
 		panic(fmt.Errorf("field creator of message eth.evm.v1.EventFunTokenCreated is not mutable"))
 	case "eth.evm.v1.EventFunTokenCreated.is_made_from_coin":
 		panic(fmt.Errorf("field is_made_from_coin of message eth.evm.v1.EventFunTokenCreated is not mutable"))
+	case "eth.evm.v1.EventFunTokenCreated.cw20_addr":
+		panic(fmt.Errorf("field cw20_addr of message eth.evm.v1.EventFunTokenCreated is not mutable"))
+	case "eth.evm.v1.EventFunTokenCreated.decimals_scale":
+		panic(fmt.Errorf("field decimals_scale of message eth.evm.v1.EventFunTokenCreated is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenCreated"))
@@ -1990,6 +2028,10 @@ func (x *fastReflection_EventFunTokenCreated) NewField(fd protoreflect.FieldDesc
 		return protoreflect.ValueOfString("")
 	case "eth.evm.v1.EventFunTokenCreated.is_made_from_coin":
 		return protoreflect.ValueOfBool(false)
+	case "eth.evm.v1.EventFunTokenCreated.cw20_addr":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunTokenCreated.decimals_scale":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenCreated"))
@@ -2074,6 +2116,13 @@ func (x *fastReflection_EventFunTokenCreated) ProtoMethods() *protoiface.Methods
 		if x.IsMadeFromCoin {
 			n += 2
 		}
+		l = len(x.Cw20Addr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DecimalsScale != 0 {
+			n += 1 + runtime.Sov(uint64(x.DecimalsScale))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2103,6 +2152,18 @@ func (x *fastReflection_EventFunTokenCreated) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DecimalsScale != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DecimalsScale))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Cw20Addr) > 0 {
+			i -= len(x.Cw20Addr)
+			copy(dAtA[i:], x.Cw20Addr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Cw20Addr)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if x.IsMadeFromCoin {
 			i--
 			if x.IsMadeFromCoin {
@@ -2299,6 +2360,57 @@ func (x *fastReflection_EventFunTokenCreated) ProtoMethods() *protoiface.Methods
 					}
 				}
 				x.IsMadeFromCoin = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cw20Addr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Cw20Addr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecimalsScale", wireType)
+				}
+				x.DecimalsScale = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DecimalsScale |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2340,6 +2452,7 @@ var (
 	fd_EventConvertCoinToEvm_erc20_contract_address protoreflect.FieldDescriptor
 	fd_EventConvertCoinToEvm_to_eth_addr            protoreflect.FieldDescriptor
 	fd_EventConvertCoinToEvm_bank_coin              protoreflect.FieldDescriptor
+	fd_EventConvertCoinToEvm_erc20_amount           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2349,6 +2462,7 @@ func init() {
 	fd_EventConvertCoinToEvm_erc20_contract_address = md_EventConvertCoinToEvm.Fields().ByName("erc20_contract_address")
 	fd_EventConvertCoinToEvm_to_eth_addr = md_EventConvertCoinToEvm.Fields().ByName("to_eth_addr")
 	fd_EventConvertCoinToEvm_bank_coin = md_EventConvertCoinToEvm.Fields().ByName("bank_coin")
+	fd_EventConvertCoinToEvm_erc20_amount = md_EventConvertCoinToEvm.Fields().ByName("erc20_amount")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventConvertCoinToEvm)(nil)
@@ -2440,6 +2554,12 @@ func (x *fastReflection_EventConvertCoinToEvm) Range(f func(protoreflect.FieldDe
 			return
 		}
 	}
+	if x.Erc20Amount != "" {
+		value := protoreflect.ValueOfString(x.Erc20Amount)
+		if !f(fd_EventConvertCoinToEvm_erc20_amount, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2463,6 +2583,8 @@ func (x *fastReflection_EventConvertCoinToEvm) Has(fd protoreflect.FieldDescript
 		return x.ToEthAddr != ""
 	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
 		return x.BankCoin != nil
+	case "eth.evm.v1.EventConvertCoinToEvm.erc20_amount":
+		return x.Erc20Amount != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
@@ -2487,6 +2609,8 @@ func (x *fastReflection_EventConvertCoinToEvm) Clear(fd protoreflect.FieldDescri
 		x.ToEthAddr = ""
 	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
 		x.BankCoin = nil
+	case "eth.evm.v1.EventConvertCoinToEvm.erc20_amount":
+		x.Erc20Amount = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
@@ -2515,6 +2639,9 @@ func (x *fastReflection_EventConvertCoinToEvm) Get(descriptor protoreflect.Field
 	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
 		value := x.BankCoin
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "eth.evm.v1.EventConvertCoinToEvm.erc20_amount":
+		value := x.Erc20Amount
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
@@ -2543,6 +2670,8 @@ func (x *fastReflection_EventConvertCoinToEvm) Set(fd protoreflect.FieldDescript
 		x.ToEthAddr = value.Interface().(string)
 	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
 		x.BankCoin = value.Message().Interface().(*v1beta1.Coin)
+	case "eth.evm.v1.EventConvertCoinToEvm.erc20_amount":
+		x.Erc20Amount = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
@@ -2574,6 +2703,8 @@ func (x *fastReflection_EventConvertCoinToEvm) Mutable(fd protoreflect.FieldDesc
 		panic(fmt.Errorf("field erc20_contract_address of message eth.evm.v1.EventConvertCoinToEvm is not mutable"))
 	case "eth.evm.v1.EventConvertCoinToEvm.to_eth_addr":
 		panic(fmt.Errorf("field to_eth_addr of message eth.evm.v1.EventConvertCoinToEvm is not mutable"))
+	case "eth.evm.v1.EventConvertCoinToEvm.erc20_amount":
+		panic(fmt.Errorf("field erc20_amount of message eth.evm.v1.EventConvertCoinToEvm is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
@@ -2596,6 +2727,8 @@ func (x *fastReflection_EventConvertCoinToEvm) NewField(fd protoreflect.FieldDes
 	case "eth.evm.v1.EventConvertCoinToEvm.bank_coin":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "eth.evm.v1.EventConvertCoinToEvm.erc20_amount":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertCoinToEvm"))
@@ -2681,6 +2814,10 @@ func (x *fastReflection_EventConvertCoinToEvm) ProtoMethods() *protoiface.Method
 			l = options.Size(x.BankCoin)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Erc20Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2710,6 +2847,13 @@ func (x *fastReflection_EventConvertCoinToEvm) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Erc20Amount) > 0 {
+			i -= len(x.Erc20Amount)
+			copy(dAtA[i:], x.Erc20Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc20Amount)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if x.BankCoin != nil {
 			encoded, err := options.Marshal(x.BankCoin)
 			if err != nil {
@@ -2926,6 +3070,38 @@ func (x *fastReflection_EventConvertCoinToEvm) ProtoMethods() *protoiface.Method
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4484,6 +4660,7 @@ var (
 	fd_EventConvertEvmToCoin_to_address             protoreflect.FieldDescriptor
 	fd_EventConvertEvmToCoin_bank_coin              protoreflect.FieldDescriptor
 	fd_EventConvertEvmToCoin_sender_eth_addr        protoreflect.FieldDescriptor
+	fd_EventConvertEvmToCoin_erc20_amount           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -4494,6 +4671,7 @@ func init() {
 	fd_EventConvertEvmToCoin_to_address = md_EventConvertEvmToCoin.Fields().ByName("to_address")
 	fd_EventConvertEvmToCoin_bank_coin = md_EventConvertEvmToCoin.Fields().ByName("bank_coin")
 	fd_EventConvertEvmToCoin_sender_eth_addr = md_EventConvertEvmToCoin.Fields().ByName("sender_eth_addr")
+	fd_EventConvertEvmToCoin_erc20_amount = md_EventConvertEvmToCoin.Fields().ByName("erc20_amount")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventConvertEvmToCoin)(nil)
@@ -4591,6 +4769,12 @@ func (x *fastReflection_EventConvertEvmToCoin) Range(f func(protoreflect.FieldDe
 			return
 		}
 	}
+	if x.Erc20Amount != "" {
+		value := protoreflect.ValueOfString(x.Erc20Amount)
+		if !f(fd_EventConvertEvmToCoin_erc20_amount, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4616,6 +4800,8 @@ func (x *fastReflection_EventConvertEvmToCoin) Has(fd protoreflect.FieldDescript
 		return x.BankCoin != nil
 	case "eth.evm.v1.EventConvertEvmToCoin.sender_eth_addr":
 		return x.SenderEthAddr != ""
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_amount":
+		return x.Erc20Amount != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
@@ -4642,6 +4828,8 @@ func (x *fastReflection_EventConvertEvmToCoin) Clear(fd protoreflect.FieldDescri
 		x.BankCoin = nil
 	case "eth.evm.v1.EventConvertEvmToCoin.sender_eth_addr":
 		x.SenderEthAddr = ""
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_amount":
+		x.Erc20Amount = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
@@ -4673,6 +4861,9 @@ func (x *fastReflection_EventConvertEvmToCoin) Get(descriptor protoreflect.Field
 	case "eth.evm.v1.EventConvertEvmToCoin.sender_eth_addr":
 		value := x.SenderEthAddr
 		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_amount":
+		value := x.Erc20Amount
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
@@ -4703,6 +4894,8 @@ func (x *fastReflection_EventConvertEvmToCoin) Set(fd protoreflect.FieldDescript
 		x.BankCoin = value.Message().Interface().(*v1beta1.Coin)
 	case "eth.evm.v1.EventConvertEvmToCoin.sender_eth_addr":
 		x.SenderEthAddr = value.Interface().(string)
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_amount":
+		x.Erc20Amount = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
@@ -4736,6 +4929,8 @@ func (x *fastReflection_EventConvertEvmToCoin) Mutable(fd protoreflect.FieldDesc
 		panic(fmt.Errorf("field to_address of message eth.evm.v1.EventConvertEvmToCoin is not mutable"))
 	case "eth.evm.v1.EventConvertEvmToCoin.sender_eth_addr":
 		panic(fmt.Errorf("field sender_eth_addr of message eth.evm.v1.EventConvertEvmToCoin is not mutable"))
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_amount":
+		panic(fmt.Errorf("field erc20_amount of message eth.evm.v1.EventConvertEvmToCoin is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
@@ -4760,6 +4955,8 @@ func (x *fastReflection_EventConvertEvmToCoin) NewField(fd protoreflect.FieldDes
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "eth.evm.v1.EventConvertEvmToCoin.sender_eth_addr":
 		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_amount":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
@@ -4849,6 +5046,10 @@ func (x *fastReflection_EventConvertEvmToCoin) ProtoMethods() *protoiface.Method
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Erc20Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4878,6 +5079,13 @@ func (x *fastReflection_EventConvertEvmToCoin) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Erc20Amount) > 0 {
+			i -= len(x.Erc20Amount)
+			copy(dAtA[i:], x.Erc20Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc20Amount)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if len(x.SenderEthAddr) > 0 {
 			i -= len(x.SenderEthAddr)
 			copy(dAtA[i:], x.SenderEthAddr)
@@ -5133,6 +5341,38 @@ func (x *fastReflection_EventConvertEvmToCoin) ProtoMethods() *protoiface.Method
 				}
 				x.SenderEthAddr = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5168,108 +5408,1791 @@ func (x *fastReflection_EventConvertEvmToCoin) ProtoMethods() *protoiface.Method
 	}
 }
 
-// Copyright (c) 2023-2024 Nibi, Inc.
+var (
+	md_EventFunTokenRateLimitUpdated            protoreflect.MessageDescriptor
+	fd_EventFunTokenRateLimitUpdated_sender     protoreflect.FieldDescriptor
+	fd_EventFunTokenRateLimitUpdated_rate_limit protoreflect.FieldDescriptor
+	fd_EventFunTokenRateLimitUpdated_removed    protoreflect.FieldDescriptor
+)
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: eth/evm/v1/events.proto
+func init() {
+	file_eth_evm_v1_events_proto_init()
+	md_EventFunTokenRateLimitUpdated = File_eth_evm_v1_events_proto.Messages().ByName("EventFunTokenRateLimitUpdated")
+	fd_EventFunTokenRateLimitUpdated_sender = md_EventFunTokenRateLimitUpdated.Fields().ByName("sender")
+	fd_EventFunTokenRateLimitUpdated_rate_limit = md_EventFunTokenRateLimitUpdated.Fields().ByName("rate_limit")
+	fd_EventFunTokenRateLimitUpdated_removed = md_EventFunTokenRateLimitUpdated.Fields().ByName("removed")
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+var _ protoreflect.Message = (*fastReflection_EventFunTokenRateLimitUpdated)(nil)
 
-// EventEthereumTx defines the event for an Ethereum transaction
-type EventEthereumTx struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_EventFunTokenRateLimitUpdated EventFunTokenRateLimitUpdated
 
-	// amount
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	// eth_hash is the Ethereum hash of the transaction
-	EthHash string `protobuf:"bytes,2,opt,name=eth_hash,json=ethHash,proto3" json:"eth_hash,omitempty"`
-	// index of the transaction in the block
-	Index string `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
-	// gas_used is the amount of gas used by the transaction
-	GasUsed string `protobuf:"bytes,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
-	// hash is the Tendermint hash of the transaction
-	Hash string `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
-	// recipient of the transaction
-	Recipient string `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	// vm_error contains a VM error should it occur
-	VmError string `protobuf:"bytes,7,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+func (x *EventFunTokenRateLimitUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventFunTokenRateLimitUpdated)(x)
 }
 
-func (x *EventEthereumTx) Reset() {
-	*x = EventEthereumTx{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[0]
+func (x *EventFunTokenRateLimitUpdated) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_events_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *EventEthereumTx) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_EventFunTokenRateLimitUpdated_messageType fastReflection_EventFunTokenRateLimitUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_EventFunTokenRateLimitUpdated_messageType{}
 
-func (*EventEthereumTx) ProtoMessage() {}
+type fastReflection_EventFunTokenRateLimitUpdated_messageType struct{}
 
-// Deprecated: Use EventEthereumTx.ProtoReflect.Descriptor instead.
-func (*EventEthereumTx) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_EventFunTokenRateLimitUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventFunTokenRateLimitUpdated)(nil)
 }
-
-func (x *EventEthereumTx) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+func (x fastReflection_EventFunTokenRateLimitUpdated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventFunTokenRateLimitUpdated)
+}
+func (x fastReflection_EventFunTokenRateLimitUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFunTokenRateLimitUpdated
 }
 
-func (x *EventEthereumTx) GetEthHash() string {
-	if x != nil {
-		return x.EthHash
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFunTokenRateLimitUpdated
 }
 
-func (x *EventEthereumTx) GetIndex() string {
-	if x != nil {
-		return x.Index
-	}
-	return ""
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) Type() protoreflect.MessageType {
+	return _fastReflection_EventFunTokenRateLimitUpdated_messageType
 }
 
-func (x *EventEthereumTx) GetGasUsed() string {
-	if x != nil {
-		return x.GasUsed
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) New() protoreflect.Message {
+	return new(fastReflection_EventFunTokenRateLimitUpdated)
 }
 
-func (x *EventEthereumTx) GetHash() string {
-	if x != nil {
-		return x.Hash
-	}
-	return ""
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) Interface() protoreflect.ProtoMessage {
+	return (*EventFunTokenRateLimitUpdated)(x)
 }
 
-func (x *EventEthereumTx) GetRecipient() string {
-	if x != nil {
-		return x.Recipient
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_EventFunTokenRateLimitUpdated_sender, value) {
+			return
+		}
+	}
+	if x.RateLimit != nil {
+		value := protoreflect.ValueOfMessage(x.RateLimit.ProtoReflect())
+		if !f(fd_EventFunTokenRateLimitUpdated_rate_limit, value) {
+			return
+		}
+	}
+	if x.Removed != false {
+		value := protoreflect.ValueOfBool(x.Removed)
+		if !f(fd_EventFunTokenRateLimitUpdated_removed, value) {
+			return
+		}
 	}
-	return ""
 }
 
-func (x *EventEthereumTx) GetVmError() string {
-	if x != nil {
-		return x.VmError
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.rate_limit":
+		return x.RateLimit != nil
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.removed":
+		return x.Removed != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenRateLimitUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenRateLimitUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.sender":
+		x.Sender = ""
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.rate_limit":
+		x.RateLimit = nil
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.removed":
+		x.Removed = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenRateLimitUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenRateLimitUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.rate_limit":
+		value := x.RateLimit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.removed":
+		value := x.Removed
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenRateLimitUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenRateLimitUpdated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.rate_limit":
+		x.RateLimit = value.Message().Interface().(*FunTokenRateLimit)
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.removed":
+		x.Removed = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenRateLimitUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenRateLimitUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.rate_limit":
+		if x.RateLimit == nil {
+			x.RateLimit = new(FunTokenRateLimit)
+		}
+		return protoreflect.ValueOfMessage(x.RateLimit.ProtoReflect())
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventFunTokenRateLimitUpdated is not mutable"))
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.removed":
+		panic(fmt.Errorf("field removed of message eth.evm.v1.EventFunTokenRateLimitUpdated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenRateLimitUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenRateLimitUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.rate_limit":
+		m := new(FunTokenRateLimit)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "eth.evm.v1.EventFunTokenRateLimitUpdated.removed":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenRateLimitUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenRateLimitUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventFunTokenRateLimitUpdated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventFunTokenRateLimitUpdated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventFunTokenRateLimitUpdated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RateLimit != nil {
+			l = options.Size(x.RateLimit)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Removed {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventFunTokenRateLimitUpdated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Removed {
+			i--
+			if x.Removed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.RateLimit != nil {
+			encoded, err := options.Marshal(x.RateLimit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventFunTokenRateLimitUpdated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFunTokenRateLimitUpdated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFunTokenRateLimitUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RateLimit == nil {
+					x.RateLimit = &FunTokenRateLimit{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RateLimit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Removed = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventFunTokenPauseUpdated            protoreflect.MessageDescriptor
+	fd_EventFunTokenPauseUpdated_sender     protoreflect.FieldDescriptor
+	fd_EventFunTokenPauseUpdated_bank_denom protoreflect.FieldDescriptor
+	fd_EventFunTokenPauseUpdated_paused     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_events_proto_init()
+	md_EventFunTokenPauseUpdated = File_eth_evm_v1_events_proto.Messages().ByName("EventFunTokenPauseUpdated")
+	fd_EventFunTokenPauseUpdated_sender = md_EventFunTokenPauseUpdated.Fields().ByName("sender")
+	fd_EventFunTokenPauseUpdated_bank_denom = md_EventFunTokenPauseUpdated.Fields().ByName("bank_denom")
+	fd_EventFunTokenPauseUpdated_paused = md_EventFunTokenPauseUpdated.Fields().ByName("paused")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventFunTokenPauseUpdated)(nil)
+
+type fastReflection_EventFunTokenPauseUpdated EventFunTokenPauseUpdated
+
+func (x *EventFunTokenPauseUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventFunTokenPauseUpdated)(x)
+}
+
+func (x *EventFunTokenPauseUpdated) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_events_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventFunTokenPauseUpdated_messageType fastReflection_EventFunTokenPauseUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_EventFunTokenPauseUpdated_messageType{}
+
+type fastReflection_EventFunTokenPauseUpdated_messageType struct{}
+
+func (x fastReflection_EventFunTokenPauseUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventFunTokenPauseUpdated)(nil)
+}
+func (x fastReflection_EventFunTokenPauseUpdated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventFunTokenPauseUpdated)
+}
+func (x fastReflection_EventFunTokenPauseUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFunTokenPauseUpdated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventFunTokenPauseUpdated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFunTokenPauseUpdated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventFunTokenPauseUpdated) Type() protoreflect.MessageType {
+	return _fastReflection_EventFunTokenPauseUpdated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventFunTokenPauseUpdated) New() protoreflect.Message {
+	return new(fastReflection_EventFunTokenPauseUpdated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventFunTokenPauseUpdated) Interface() protoreflect.ProtoMessage {
+	return (*EventFunTokenPauseUpdated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventFunTokenPauseUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_EventFunTokenPauseUpdated_sender, value) {
+			return
+		}
+	}
+	if x.BankDenom != "" {
+		value := protoreflect.ValueOfString(x.BankDenom)
+		if !f(fd_EventFunTokenPauseUpdated_bank_denom, value) {
+			return
+		}
+	}
+	if x.Paused != false {
+		value := protoreflect.ValueOfBool(x.Paused)
+		if !f(fd_EventFunTokenPauseUpdated_paused, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventFunTokenPauseUpdated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenPauseUpdated.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.EventFunTokenPauseUpdated.bank_denom":
+		return x.BankDenom != ""
+	case "eth.evm.v1.EventFunTokenPauseUpdated.paused":
+		return x.Paused != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenPauseUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenPauseUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenPauseUpdated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenPauseUpdated.sender":
+		x.Sender = ""
+	case "eth.evm.v1.EventFunTokenPauseUpdated.bank_denom":
+		x.BankDenom = ""
+	case "eth.evm.v1.EventFunTokenPauseUpdated.paused":
+		x.Paused = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenPauseUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenPauseUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventFunTokenPauseUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.EventFunTokenPauseUpdated.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunTokenPauseUpdated.bank_denom":
+		value := x.BankDenom
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunTokenPauseUpdated.paused":
+		value := x.Paused
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenPauseUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenPauseUpdated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenPauseUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenPauseUpdated.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.EventFunTokenPauseUpdated.bank_denom":
+		x.BankDenom = value.Interface().(string)
+	case "eth.evm.v1.EventFunTokenPauseUpdated.paused":
+		x.Paused = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenPauseUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenPauseUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenPauseUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenPauseUpdated.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventFunTokenPauseUpdated is not mutable"))
+	case "eth.evm.v1.EventFunTokenPauseUpdated.bank_denom":
+		panic(fmt.Errorf("field bank_denom of message eth.evm.v1.EventFunTokenPauseUpdated is not mutable"))
+	case "eth.evm.v1.EventFunTokenPauseUpdated.paused":
+		panic(fmt.Errorf("field paused of message eth.evm.v1.EventFunTokenPauseUpdated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenPauseUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenPauseUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventFunTokenPauseUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventFunTokenPauseUpdated.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunTokenPauseUpdated.bank_denom":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunTokenPauseUpdated.paused":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenPauseUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenPauseUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventFunTokenPauseUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventFunTokenPauseUpdated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventFunTokenPauseUpdated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFunTokenPauseUpdated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventFunTokenPauseUpdated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventFunTokenPauseUpdated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventFunTokenPauseUpdated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BankDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Paused {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventFunTokenPauseUpdated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Paused {
+			i--
+			if x.Paused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.BankDenom) > 0 {
+			i -= len(x.BankDenom)
+			copy(dAtA[i:], x.BankDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventFunTokenPauseUpdated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFunTokenPauseUpdated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFunTokenPauseUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Paused = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventDeployPermissionUpdated_3_list)(nil)
+
+type _EventDeployPermissionUpdated_3_list struct {
+	list *[]string
+}
+
+func (x *_EventDeployPermissionUpdated_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventDeployPermissionUpdated_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventDeployPermissionUpdated_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventDeployPermissionUpdated_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventDeployPermissionUpdated_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventDeployPermissionUpdated at list field Allowlist as it is not of Message kind"))
+}
+
+func (x *_EventDeployPermissionUpdated_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventDeployPermissionUpdated_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventDeployPermissionUpdated_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventDeployPermissionUpdated            protoreflect.MessageDescriptor
+	fd_EventDeployPermissionUpdated_sender     protoreflect.FieldDescriptor
+	fd_EventDeployPermissionUpdated_permission protoreflect.FieldDescriptor
+	fd_EventDeployPermissionUpdated_allowlist  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_events_proto_init()
+	md_EventDeployPermissionUpdated = File_eth_evm_v1_events_proto.Messages().ByName("EventDeployPermissionUpdated")
+	fd_EventDeployPermissionUpdated_sender = md_EventDeployPermissionUpdated.Fields().ByName("sender")
+	fd_EventDeployPermissionUpdated_permission = md_EventDeployPermissionUpdated.Fields().ByName("permission")
+	fd_EventDeployPermissionUpdated_allowlist = md_EventDeployPermissionUpdated.Fields().ByName("allowlist")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventDeployPermissionUpdated)(nil)
+
+type fastReflection_EventDeployPermissionUpdated EventDeployPermissionUpdated
+
+func (x *EventDeployPermissionUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventDeployPermissionUpdated)(x)
+}
+
+func (x *EventDeployPermissionUpdated) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_events_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventDeployPermissionUpdated_messageType fastReflection_EventDeployPermissionUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_EventDeployPermissionUpdated_messageType{}
+
+type fastReflection_EventDeployPermissionUpdated_messageType struct{}
+
+func (x fastReflection_EventDeployPermissionUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventDeployPermissionUpdated)(nil)
+}
+func (x fastReflection_EventDeployPermissionUpdated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventDeployPermissionUpdated)
+}
+func (x fastReflection_EventDeployPermissionUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDeployPermissionUpdated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventDeployPermissionUpdated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDeployPermissionUpdated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventDeployPermissionUpdated) Type() protoreflect.MessageType {
+	return _fastReflection_EventDeployPermissionUpdated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventDeployPermissionUpdated) New() protoreflect.Message {
+	return new(fastReflection_EventDeployPermissionUpdated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventDeployPermissionUpdated) Interface() protoreflect.ProtoMessage {
+	return (*EventDeployPermissionUpdated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventDeployPermissionUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_EventDeployPermissionUpdated_sender, value) {
+			return
+		}
+	}
+	if x.Permission != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Permission))
+		if !f(fd_EventDeployPermissionUpdated_permission, value) {
+			return
+		}
+	}
+	if len(x.Allowlist) != 0 {
+		value := protoreflect.ValueOfList(&_EventDeployPermissionUpdated_3_list{list: &x.Allowlist})
+		if !f(fd_EventDeployPermissionUpdated_allowlist, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventDeployPermissionUpdated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventDeployPermissionUpdated.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.EventDeployPermissionUpdated.permission":
+		return x.Permission != 0
+	case "eth.evm.v1.EventDeployPermissionUpdated.allowlist":
+		return len(x.Allowlist) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventDeployPermissionUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventDeployPermissionUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDeployPermissionUpdated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventDeployPermissionUpdated.sender":
+		x.Sender = ""
+	case "eth.evm.v1.EventDeployPermissionUpdated.permission":
+		x.Permission = 0
+	case "eth.evm.v1.EventDeployPermissionUpdated.allowlist":
+		x.Allowlist = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventDeployPermissionUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventDeployPermissionUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventDeployPermissionUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.EventDeployPermissionUpdated.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventDeployPermissionUpdated.permission":
+		value := x.Permission
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "eth.evm.v1.EventDeployPermissionUpdated.allowlist":
+		if len(x.Allowlist) == 0 {
+			return protoreflect.ValueOfList(&_EventDeployPermissionUpdated_3_list{})
+		}
+		listValue := &_EventDeployPermissionUpdated_3_list{list: &x.Allowlist}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventDeployPermissionUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventDeployPermissionUpdated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDeployPermissionUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventDeployPermissionUpdated.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.EventDeployPermissionUpdated.permission":
+		x.Permission = (DeployPermission)(value.Enum())
+	case "eth.evm.v1.EventDeployPermissionUpdated.allowlist":
+		lv := value.List()
+		clv := lv.(*_EventDeployPermissionUpdated_3_list)
+		x.Allowlist = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventDeployPermissionUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventDeployPermissionUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDeployPermissionUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventDeployPermissionUpdated.allowlist":
+		if x.Allowlist == nil {
+			x.Allowlist = []string{}
+		}
+		value := &_EventDeployPermissionUpdated_3_list{list: &x.Allowlist}
+		return protoreflect.ValueOfList(value)
+	case "eth.evm.v1.EventDeployPermissionUpdated.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventDeployPermissionUpdated is not mutable"))
+	case "eth.evm.v1.EventDeployPermissionUpdated.permission":
+		panic(fmt.Errorf("field permission of message eth.evm.v1.EventDeployPermissionUpdated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventDeployPermissionUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventDeployPermissionUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventDeployPermissionUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventDeployPermissionUpdated.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventDeployPermissionUpdated.permission":
+		return protoreflect.ValueOfEnum(0)
+	case "eth.evm.v1.EventDeployPermissionUpdated.allowlist":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventDeployPermissionUpdated_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventDeployPermissionUpdated"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventDeployPermissionUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventDeployPermissionUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventDeployPermissionUpdated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventDeployPermissionUpdated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDeployPermissionUpdated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventDeployPermissionUpdated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventDeployPermissionUpdated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventDeployPermissionUpdated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Permission != 0 {
+			n += 1 + runtime.Sov(uint64(x.Permission))
+		}
+		if len(x.Allowlist) > 0 {
+			for _, s := range x.Allowlist {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventDeployPermissionUpdated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Allowlist) > 0 {
+			for iNdEx := len(x.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Allowlist[iNdEx])
+				copy(dAtA[i:], x.Allowlist[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Allowlist[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Permission != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Permission))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventDeployPermissionUpdated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDeployPermissionUpdated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDeployPermissionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+				}
+				x.Permission = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Permission |= DeployPermission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Allowlist = append(x.Allowlist, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Copyright (c) 2023-2024 Nibi, Inc.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: eth/evm/v1/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventEthereumTx defines the event for an Ethereum transaction
+type EventEthereumTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// amount
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// eth_hash is the Ethereum hash of the transaction
+	EthHash string `protobuf:"bytes,2,opt,name=eth_hash,json=ethHash,proto3" json:"eth_hash,omitempty"`
+	// index of the transaction in the block
+	Index string `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+	// gas_used is the amount of gas used by the transaction
+	GasUsed string `protobuf:"bytes,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// hash is the Tendermint hash of the transaction
+	Hash string `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	// recipient of the transaction
+	Recipient string `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// vm_error contains a VM error should it occur
+	VmError string `protobuf:"bytes,7,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+}
+
+func (x *EventEthereumTx) Reset() {
+	*x = EventEthereumTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventEthereumTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventEthereumTx) ProtoMessage() {}
+
+// Deprecated: Use EventEthereumTx.ProtoReflect.Descriptor instead.
+func (*EventEthereumTx) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventEthereumTx) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EventEthereumTx) GetEthHash() string {
+	if x != nil {
+		return x.EthHash
+	}
+	return ""
+}
+
+func (x *EventEthereumTx) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *EventEthereumTx) GetGasUsed() string {
+	if x != nil {
+		return x.GasUsed
+	}
+	return ""
+}
+
+func (x *EventEthereumTx) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *EventEthereumTx) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *EventEthereumTx) GetVmError() string {
+	if x != nil {
+		return x.VmError
 	}
 	return ""
 }
@@ -5359,6 +7282,11 @@ type EventFunTokenCreated struct {
 	Erc20ContractAddress string `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
 	Creator              string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
 	IsMadeFromCoin       bool   `protobuf:"varint,4,opt,name=is_made_from_coin,json=isMadeFromCoin,proto3" json:"is_made_from_coin,omitempty"`
+	// Bech32 address of the CW20 contract if the mapping originates from a CW20.
+	Cw20Addr string `protobuf:"bytes,5,opt,name=cw20_addr,json=cw20Addr,proto3" json:"cw20_addr,omitempty"`
+	// Exponent that scales amounts between the bank coin and the ERC-20. See
+	// "FunToken.decimals_scale".
+	DecimalsScale uint32 `protobuf:"varint,6,opt,name=decimals_scale,json=decimalsScale,proto3" json:"decimals_scale,omitempty"`
 }
 
 func (x *EventFunTokenCreated) Reset() {
@@ -5409,6 +7337,20 @@ func (x *EventFunTokenCreated) GetIsMadeFromCoin() bool {
 	return false
 }
 
+func (x *EventFunTokenCreated) GetCw20Addr() string {
+	if x != nil {
+		return x.Cw20Addr
+	}
+	return ""
+}
+
+func (x *EventFunTokenCreated) GetDecimalsScale() uint32 {
+	if x != nil {
+		return x.DecimalsScale
+	}
+	return 0
+}
+
 // EventConvertCoinToEvm is an event emitted when converting Bank Coins into
 // ERC20 tokens with the "eth.evm.v1.MsgConvertCoinToEvm" transaction message.
 type EventConvertCoinToEvm struct {
@@ -5420,6 +7362,9 @@ type EventConvertCoinToEvm struct {
 	Erc20ContractAddress string        `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
 	ToEthAddr            string        `protobuf:"bytes,3,opt,name=to_eth_addr,json=toEthAddr,proto3" json:"to_eth_addr,omitempty"`
 	BankCoin             *v1beta1.Coin `protobuf:"bytes,4,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin,omitempty"`
+	// Amount of ERC-20 tokens minted or transferred to "to_eth_addr". Differs
+	// from the amount of "bank_coin" when the mapping has a "decimals_scale".
+	Erc20Amount string `protobuf:"bytes,5,opt,name=erc20_amount,json=erc20Amount,proto3" json:"erc20_amount,omitempty"`
 }
 
 func (x *EventConvertCoinToEvm) Reset() {
@@ -5470,6 +7415,13 @@ func (x *EventConvertCoinToEvm) GetBankCoin() *v1beta1.Coin {
 	return nil
 }
 
+func (x *EventConvertCoinToEvm) GetErc20Amount() string {
+	if x != nil {
+		return x.Erc20Amount
+	}
+	return ""
+}
+
 // EventTransfer defines event for EVM transfer
 type EventTransfer struct {
 	state         protoimpl.MessageState
@@ -5622,6 +7574,9 @@ type EventConvertEvmToCoin struct {
 	ToAddress            string        `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
 	BankCoin             *v1beta1.Coin `protobuf:"bytes,4,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin,omitempty"`
 	SenderEthAddr        string        `protobuf:"bytes,6,opt,name=sender_eth_addr,json=senderEthAddr,proto3" json:"sender_eth_addr,omitempty"`
+	// Amount of ERC-20 tokens taken from the sender. Differs from the amount of
+	// "bank_coin" when the mapping has a "decimals_scale".
+	Erc20Amount string `protobuf:"bytes,7,opt,name=erc20_amount,json=erc20Amount,proto3" json:"erc20_amount,omitempty"`
 }
 
 func (x *EventConvertEvmToCoin) Reset() {
@@ -5679,6 +7634,174 @@ func (x *EventConvertEvmToCoin) GetSenderEthAddr() string {
 	return ""
 }
 
+func (x *EventConvertEvmToCoin) GetErc20Amount() string {
+	if x != nil {
+		return x.Erc20Amount
+	}
+	return ""
+}
+
+// EventFunTokenRateLimitUpdated is emitted when the rate limit of a `FunToken`
+// mapping is set or removed with "MsgSetFunTokenRateLimit".
+type EventFunTokenRateLimitUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender    string             `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	RateLimit *FunTokenRateLimit `protobuf:"bytes,2,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// True if the rate limit was removed.
+	Removed bool `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *EventFunTokenRateLimitUpdated) Reset() {
+	*x = EventFunTokenRateLimitUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventFunTokenRateLimitUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFunTokenRateLimitUpdated) ProtoMessage() {}
+
+// Deprecated: Use EventFunTokenRateLimitUpdated.ProtoReflect.Descriptor instead.
+func (*EventFunTokenRateLimitUpdated) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EventFunTokenRateLimitUpdated) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EventFunTokenRateLimitUpdated) GetRateLimit() *FunTokenRateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *EventFunTokenRateLimitUpdated) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+// EventFunTokenPauseUpdated is emitted when conversions of a `FunToken`
+// mapping are paused or unpaused with "MsgSetFunTokenPaused".
+type EventFunTokenPauseUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	BankDenom string `protobuf:"bytes,2,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	Paused    bool   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (x *EventFunTokenPauseUpdated) Reset() {
+	*x = EventFunTokenPauseUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_events_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventFunTokenPauseUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFunTokenPauseUpdated) ProtoMessage() {}
+
+// Deprecated: Use EventFunTokenPauseUpdated.ProtoReflect.Descriptor instead.
+func (*EventFunTokenPauseUpdated) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EventFunTokenPauseUpdated) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EventFunTokenPauseUpdated) GetBankDenom() string {
+	if x != nil {
+		return x.BankDenom
+	}
+	return ""
+}
+
+func (x *EventFunTokenPauseUpdated) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+// EventDeployPermissionUpdated is emitted when the deploy permission of the EVM
+// is set with "MsgSetDeployPermission".
+type EventDeployPermissionUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender     string           `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Permission DeployPermission `protobuf:"varint,2,opt,name=permission,proto3,enum=eth.evm.v1.DeployPermission" json:"permission,omitempty"`
+	// Hexadecimal addresses of the deploy allowlist after the update.
+	Allowlist []string `protobuf:"bytes,3,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+}
+
+func (x *EventDeployPermissionUpdated) Reset() {
+	*x = EventDeployPermissionUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_events_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventDeployPermissionUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDeployPermissionUpdated) ProtoMessage() {}
+
+// Deprecated: Use EventDeployPermissionUpdated.ProtoReflect.Descriptor instead.
+func (*EventDeployPermissionUpdated) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EventDeployPermissionUpdated) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EventDeployPermissionUpdated) GetPermission() DeployPermission {
+	if x != nil {
+		return x.Permission
+	}
+	return DeployPermission_DEPLOY_PERMISSION_EVERYBODY
+}
+
+func (x *EventDeployPermissionUpdated) GetAllowlist() []string {
+	if x != nil {
+		return x.Allowlist
+	}
+	return nil
+}
+
 var File_eth_evm_v1_events_proto protoreflect.FileDescriptor
 
 var file_eth_evm_v1_events_proto_rawDesc = []byte{
@@ -5707,7 +7830,7 @@ var file_eth_evm_v1_events_proto_rawDesc = []byte{
 	0x4c, 0x6f, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22,
 	0x27, 0x0a, 0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6c, 0x6f,
 	0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x22, 0xb0, 0x01, 0x0a, 0x14, 0x45, 0x76, 0x65,
+	0x09, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x22, 0xf4, 0x01, 0x0a, 0x14, 0x45, 0x76, 0x65,
 	0x6e, 0x74, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
@@ -5718,63 +7841,97 @@ var file_eth_evm_v1_events_proto_rawDesc = []byte{
 	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
 	0x12, 0x29, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x6d, 0x61, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
 	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x4d,
-	0x61, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0xd7, 0x01, 0x0a, 0x15,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x69, 0x6e,
-	0x54, 0x6f, 0x45, 0x76, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a,
-	0x16, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65,
-	0x72, 0x63, 0x32, 0x30, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x45, 0x74, 0x68, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x50, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x42, 0x18, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x52, 0x08, 0x62, 0x61, 0x6e,
-	0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0x5d, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c,
-	0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x54, 0x0a, 0x15, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x22, 0xfe, 0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x74, 0x45, 0x76, 0x6d, 0x54, 0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x14, 0x65, 0x72, 0x63, 0x32, 0x30, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x50, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x5f,
+	0x61, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x77, 0x32, 0x30, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x77, 0x32, 0x30, 0x41, 0x64, 0x64, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x73, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x22,
+	0xfa, 0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x43, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x14, 0x65, 0x72, 0x63, 0x32, 0x30, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x65, 0x74,
+	0x68, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f,
+	0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x12, 0x50, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x5f,
 	0x63, 0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
 	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x18, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79,
 	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x52,
-	0x08, 0x62, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x5f, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x45, 0x74, 0x68, 0x41, 0x64, 0x64,
-	0x72, 0x42, 0x8a, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76,
-	0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31,
-	0x3b, 0x65, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45, 0x58, 0xaa, 0x02, 0x0a, 0x45,
-	0x74, 0x68, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x5c,
-	0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x0c, 0x45, 0x74, 0x68, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x08, 0x62, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x72, 0x63,
+	0x32, 0x30, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x65, 0x72, 0x63, 0x32, 0x30, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x0d,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x15, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x22, 0x54, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0xa1, 0x02, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x6f, 0x43, 0x6f, 0x69,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x72, 0x63,
+	0x32, 0x30, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x72, 0x63, 0x32, 0x30,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x50,
+	0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x18, 0xc8, 0xde,
+	0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x6e, 0x6b,
+	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x69, 0x6e,
+	0x12, 0x26, 0x0a, 0x0f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x65, 0x74, 0x68, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x72, 0x63, 0x32,
+	0x30, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x65, 0x72, 0x63, 0x32, 0x30, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x1d,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x74, 0x68, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09,
+	0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x22, 0x6a, 0x0a, 0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6b,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61,
+	0x6e, 0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22,
+	0x92, 0x01, 0x0a, 0x1c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x6c, 0x69, 0x73, 0x74, 0x42, 0x8a, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68,
+	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65, 0x76, 0x6d,
+	0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45, 0x58, 0xaa,
+	0x02, 0x0a, 0x45, 0x74, 0x68, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x45,
+	0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x74, 0x68, 0x5c,
+	0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0c, 0x45, 0x74, 0x68, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5789,29 +7946,36 @@ func file_eth_evm_v1_events_proto_rawDescGZIP() []byte {
 	return file_eth_evm_v1_events_proto_rawDescData
 }
 
-var file_eth_evm_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_eth_evm_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_eth_evm_v1_events_proto_goTypes = []interface{}{
-	(*EventEthereumTx)(nil),       // 0: eth.evm.v1.EventEthereumTx
-	(*EventTxLog)(nil),            // 1: eth.evm.v1.EventTxLog
-	(*EventBlockBloom)(nil),       // 2: eth.evm.v1.EventBlockBloom
-	(*EventFunTokenCreated)(nil),  // 3: eth.evm.v1.EventFunTokenCreated
-	(*EventConvertCoinToEvm)(nil), // 4: eth.evm.v1.EventConvertCoinToEvm
-	(*EventTransfer)(nil),         // 5: eth.evm.v1.EventTransfer
-	(*EventContractDeployed)(nil), // 6: eth.evm.v1.EventContractDeployed
-	(*EventContractExecuted)(nil), // 7: eth.evm.v1.EventContractExecuted
-	(*EventConvertEvmToCoin)(nil), // 8: eth.evm.v1.EventConvertEvmToCoin
-	(*Log)(nil),                   // 9: eth.evm.v1.Log
-	(*v1beta1.Coin)(nil),          // 10: cosmos.base.v1beta1.Coin
+	(*EventEthereumTx)(nil),               // 0: eth.evm.v1.EventEthereumTx
+	(*EventTxLog)(nil),                    // 1: eth.evm.v1.EventTxLog
+	(*EventBlockBloom)(nil),               // 2: eth.evm.v1.EventBlockBloom
+	(*EventFunTokenCreated)(nil),          // 3: eth.evm.v1.EventFunTokenCreated
+	(*EventConvertCoinToEvm)(nil),         // 4: eth.evm.v1.EventConvertCoinToEvm
+	(*EventTransfer)(nil),                 // 5: eth.evm.v1.EventTransfer
+	(*EventContractDeployed)(nil),         // 6: eth.evm.v1.EventContractDeployed
+	(*EventContractExecuted)(nil),         // 7: eth.evm.v1.EventContractExecuted
+	(*EventConvertEvmToCoin)(nil),         // 8: eth.evm.v1.EventConvertEvmToCoin
+	(*EventFunTokenRateLimitUpdated)(nil), // 9: eth.evm.v1.EventFunTokenRateLimitUpdated
+	(*EventFunTokenPauseUpdated)(nil),     // 10: eth.evm.v1.EventFunTokenPauseUpdated
+	(*EventDeployPermissionUpdated)(nil),  // 11: eth.evm.v1.EventDeployPermissionUpdated
+	(*Log)(nil),                           // 12: eth.evm.v1.Log
+	(*v1beta1.Coin)(nil),                  // 13: cosmos.base.v1beta1.Coin
+	(*FunTokenRateLimit)(nil),             // 14: eth.evm.v1.FunTokenRateLimit
+	(DeployPermission)(0),                 // 15: eth.evm.v1.DeployPermission
 }
 var file_eth_evm_v1_events_proto_depIdxs = []int32{
-	9,  // 0: eth.evm.v1.EventTxLog.logs:type_name -> eth.evm.v1.Log
-	10, // 1: eth.evm.v1.EventConvertCoinToEvm.bank_coin:type_name -> cosmos.base.v1beta1.Coin
-	10, // 2: eth.evm.v1.EventConvertEvmToCoin.bank_coin:type_name -> cosmos.base.v1beta1.Coin
-	3,  // [3:3] is the sub-list for method output_type
-	3,  // [3:3] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	12, // 0: eth.evm.v1.EventTxLog.logs:type_name -> eth.evm.v1.Log
+	13, // 1: eth.evm.v1.EventConvertCoinToEvm.bank_coin:type_name -> cosmos.base.v1beta1.Coin
+	13, // 2: eth.evm.v1.EventConvertEvmToCoin.bank_coin:type_name -> cosmos.base.v1beta1.Coin
+	14, // 3: eth.evm.v1.EventFunTokenRateLimitUpdated.rate_limit:type_name -> eth.evm.v1.FunTokenRateLimit
+	15, // 4: eth.evm.v1.EventDeployPermissionUpdated.permission:type_name -> eth.evm.v1.DeployPermission
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_eth_evm_v1_events_proto_init() }
@@ -5929,6 +8093,42 @@ func file_eth_evm_v1_events_proto_init() {
 				return nil
 			}
 		}
+		file_eth_evm_v1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventFunTokenRateLimitUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventFunTokenPauseUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventDeployPermissionUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5936,7 +8136,7 @@ func file_eth_evm_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_eth_evm_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
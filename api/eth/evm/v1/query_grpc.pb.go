@@ -51,6 +51,14 @@ type QueryClient interface {
 	// Similar to feemarket module's method
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(ctx context.Context, in *QueryFunTokenMappingRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingResponse, error)
+	// FunTokenMappings lists all FunToken mappings, ordered by their ID.
+	FunTokenMappings(ctx context.Context, in *QueryFunTokenMappingsRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingsResponse, error)
+	// FunTokenRateLimit queries the rate limit, the net flow in the current rate
+	// limit window, and the pause status of a FunToken mapping.
+	FunTokenRateLimit(ctx context.Context, in *QueryFunTokenRateLimitRequest, opts ...grpc.CallOption) (*QueryFunTokenRateLimitResponse, error)
+	// DeployPermission queries which accounts can deploy contracts to the EVM
+	// and, optionally, whether a given account can.
+	DeployPermission(ctx context.Context, in *QueryDeployPermissionRequest, opts ...grpc.CallOption) (*QueryDeployPermissionResponse, error)
 }
 
 type queryClient struct {
@@ -178,6 +186,33 @@ func (c *queryClient) FunTokenMapping(ctx context.Context, in *QueryFunTokenMapp
 	return out, nil
 }
 
+func (c *queryClient) FunTokenMappings(ctx context.Context, in *QueryFunTokenMappingsRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingsResponse, error) {
+	out := new(QueryFunTokenMappingsResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunTokenMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FunTokenRateLimit(ctx context.Context, in *QueryFunTokenRateLimitRequest, opts ...grpc.CallOption) (*QueryFunTokenRateLimitResponse, error) {
+	out := new(QueryFunTokenRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunTokenRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeployPermission(ctx context.Context, in *QueryDeployPermissionRequest, opts ...grpc.CallOption) (*QueryDeployPermissionResponse, error) {
+	out := new(QueryDeployPermissionResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/DeployPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -211,6 +246,14 @@ type QueryServer interface {
 	// Similar to feemarket module's method
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(context.Context, *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error)
+	// FunTokenMappings lists all FunToken mappings, ordered by their ID.
+	FunTokenMappings(context.Context, *QueryFunTokenMappingsRequest) (*QueryFunTokenMappingsResponse, error)
+	// FunTokenRateLimit queries the rate limit, the net flow in the current rate
+	// limit window, and the pause status of a FunToken mapping.
+	FunTokenRateLimit(context.Context, *QueryFunTokenRateLimitRequest) (*QueryFunTokenRateLimitResponse, error)
+	// DeployPermission queries which accounts can deploy contracts to the EVM
+	// and, optionally, whether a given account can.
+	DeployPermission(context.Context, *QueryDeployPermissionRequest) (*QueryDeployPermissionResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -257,6 +300,15 @@ func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (
 func (UnimplementedQueryServer) FunTokenMapping(context.Context, *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FunTokenMapping not implemented")
 }
+func (UnimplementedQueryServer) FunTokenMappings(context.Context, *QueryFunTokenMappingsRequest) (*QueryFunTokenMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunTokenMappings not implemented")
+}
+func (UnimplementedQueryServer) FunTokenRateLimit(context.Context, *QueryFunTokenRateLimitRequest) (*QueryFunTokenRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunTokenRateLimit not implemented")
+}
+func (UnimplementedQueryServer) DeployPermission(context.Context, *QueryDeployPermissionRequest) (*QueryDeployPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployPermission not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -504,6 +556,60 @@ func _Query_FunTokenMapping_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FunTokenMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunTokenMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunTokenMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunTokenMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunTokenMappings(ctx, req.(*QueryFunTokenMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FunTokenRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunTokenRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunTokenRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunTokenRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunTokenRateLimit(ctx, req.(*QueryFunTokenRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeployPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeployPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeployPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/DeployPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeployPermission(ctx, req.(*QueryDeployPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -563,6 +669,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FunTokenMapping",
 			Handler:    _Query_FunTokenMapping_Handler,
 		},
+		{
+			MethodName: "FunTokenMappings",
+			Handler:    _Query_FunTokenMappings_Handler,
+		},
+		{
+			MethodName: "FunTokenRateLimit",
+			Handler:    _Query_FunTokenRateLimit_Handler,
+		},
+		{
+			MethodName: "DeployPermission",
+			Handler:    _Query_DeployPermission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/query.proto",
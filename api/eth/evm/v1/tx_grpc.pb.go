@@ -39,6 +39,17 @@ type MsgClient interface {
 	// ConvertEvmToCoin: Sends an ERC20 token with a valid "FunToken" mapping to the
 	// given recipient address as a bank coin.
 	ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoin, opts ...grpc.CallOption) (*MsgConvertEvmToCoinResponse, error)
+	// SetFunTokenRateLimit: Sets or removes the rate limit on conversions of a
+	// "FunToken" mapping. Only callable by governance or the sudoers of the
+	// x/sudo module.
+	SetFunTokenRateLimit(ctx context.Context, in *MsgSetFunTokenRateLimit, opts ...grpc.CallOption) (*MsgSetFunTokenRateLimitResponse, error)
+	// SetFunTokenPaused: Pauses or unpauses all conversions of a "FunToken"
+	// mapping. Only callable by governance or the sudoers of the x/sudo module.
+	SetFunTokenPaused(ctx context.Context, in *MsgSetFunTokenPaused, opts ...grpc.CallOption) (*MsgSetFunTokenPausedResponse, error)
+	// SetDeployPermission: Sets which accounts can deploy contracts to the EVM
+	// and edits the deploy allowlist. Only callable by governance or the sudoers
+	// of the x/sudo module.
+	SetDeployPermission(ctx context.Context, in *MsgSetDeployPermission, opts ...grpc.CallOption) (*MsgSetDeployPermissionResponse, error)
 }
 
 type msgClient struct {
@@ -94,6 +105,33 @@ func (c *msgClient) ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoi
 	return out, nil
 }
 
+func (c *msgClient) SetFunTokenRateLimit(ctx context.Context, in *MsgSetFunTokenRateLimit, opts ...grpc.CallOption) (*MsgSetFunTokenRateLimitResponse, error) {
+	out := new(MsgSetFunTokenRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/SetFunTokenRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFunTokenPaused(ctx context.Context, in *MsgSetFunTokenPaused, opts ...grpc.CallOption) (*MsgSetFunTokenPausedResponse, error) {
+	out := new(MsgSetFunTokenPausedResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/SetFunTokenPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDeployPermission(ctx context.Context, in *MsgSetDeployPermission, opts ...grpc.CallOption) (*MsgSetDeployPermissionResponse, error) {
+	out := new(MsgSetDeployPermissionResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/SetDeployPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -115,6 +153,17 @@ type MsgServer interface {
 	// ConvertEvmToCoin: Sends an ERC20 token with a valid "FunToken" mapping to the
 	// given recipient address as a bank coin.
 	ConvertEvmToCoin(context.Context, *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error)
+	// SetFunTokenRateLimit: Sets or removes the rate limit on conversions of a
+	// "FunToken" mapping. Only callable by governance or the sudoers of the
+	// x/sudo module.
+	SetFunTokenRateLimit(context.Context, *MsgSetFunTokenRateLimit) (*MsgSetFunTokenRateLimitResponse, error)
+	// SetFunTokenPaused: Pauses or unpauses all conversions of a "FunToken"
+	// mapping. Only callable by governance or the sudoers of the x/sudo module.
+	SetFunTokenPaused(context.Context, *MsgSetFunTokenPaused) (*MsgSetFunTokenPausedResponse, error)
+	// SetDeployPermission: Sets which accounts can deploy contracts to the EVM
+	// and edits the deploy allowlist. Only callable by governance or the sudoers
+	// of the x/sudo module.
+	SetDeployPermission(context.Context, *MsgSetDeployPermission) (*MsgSetDeployPermissionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -137,6 +186,15 @@ func (UnimplementedMsgServer) ConvertCoinToEvm(context.Context, *MsgConvertCoinT
 func (UnimplementedMsgServer) ConvertEvmToCoin(context.Context, *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertEvmToCoin not implemented")
 }
+func (UnimplementedMsgServer) SetFunTokenRateLimit(context.Context, *MsgSetFunTokenRateLimit) (*MsgSetFunTokenRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFunTokenRateLimit not implemented")
+}
+func (UnimplementedMsgServer) SetFunTokenPaused(context.Context, *MsgSetFunTokenPaused) (*MsgSetFunTokenPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFunTokenPaused not implemented")
+}
+func (UnimplementedMsgServer) SetDeployPermission(context.Context, *MsgSetDeployPermission) (*MsgSetDeployPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDeployPermission not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -240,6 +298,60 @@ func _Msg_ConvertEvmToCoin_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetFunTokenRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFunTokenRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFunTokenRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/SetFunTokenRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFunTokenRateLimit(ctx, req.(*MsgSetFunTokenRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFunTokenPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFunTokenPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFunTokenPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/SetFunTokenPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFunTokenPaused(ctx, req.(*MsgSetFunTokenPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDeployPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDeployPermission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDeployPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/SetDeployPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDeployPermission(ctx, req.(*MsgSetDeployPermission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +379,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertEvmToCoin",
 			Handler:    _Msg_ConvertEvmToCoin_Handler,
 		},
+		{
+			MethodName: "SetFunTokenRateLimit",
+			Handler:    _Msg_SetFunTokenRateLimit_Handler,
+		},
+		{
+			MethodName: "SetFunTokenPaused",
+			Handler:    _Msg_SetFunTokenPaused_Handler,
+		},
+		{
+			MethodName: "SetDeployPermission",
+			Handler:    _Msg_SetDeployPermission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/tx.proto",
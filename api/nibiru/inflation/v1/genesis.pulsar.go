@@ -591,15 +591,69 @@ func (x *_Params_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_9_list)(nil)
+
+type _Params_9_list struct {
+	list *[]*InflationRecipient
+}
+
+func (x *_Params_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationRecipient)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationRecipient)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_9_list) AppendMutable() protoreflect.Value {
+	v := new(InflationRecipient)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_9_list) NewElement() protoreflect.Value {
+	v := new(InflationRecipient)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                        protoreflect.MessageDescriptor
-	fd_Params_inflation_enabled      protoreflect.FieldDescriptor
-	fd_Params_polynomial_factors     protoreflect.FieldDescriptor
-	fd_Params_inflation_distribution protoreflect.FieldDescriptor
-	fd_Params_epochs_per_period      protoreflect.FieldDescriptor
-	fd_Params_periods_per_year       protoreflect.FieldDescriptor
-	fd_Params_max_period             protoreflect.FieldDescriptor
-	fd_Params_has_inflation_started  protoreflect.FieldDescriptor
+	md_Params                               protoreflect.MessageDescriptor
+	fd_Params_inflation_enabled             protoreflect.FieldDescriptor
+	fd_Params_polynomial_factors            protoreflect.FieldDescriptor
+	fd_Params_inflation_distribution        protoreflect.FieldDescriptor
+	fd_Params_epochs_per_period             protoreflect.FieldDescriptor
+	fd_Params_periods_per_year              protoreflect.FieldDescriptor
+	fd_Params_max_period                    protoreflect.FieldDescriptor
+	fd_Params_has_inflation_started         protoreflect.FieldDescriptor
+	fd_Params_circulating_supply_exclusions protoreflect.FieldDescriptor
+	fd_Params_inflation_recipients          protoreflect.FieldDescriptor
+	fd_Params_bonded_ratio_inflation        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -612,6 +666,9 @@ func init() {
 	fd_Params_periods_per_year = md_Params.Fields().ByName("periods_per_year")
 	fd_Params_max_period = md_Params.Fields().ByName("max_period")
 	fd_Params_has_inflation_started = md_Params.Fields().ByName("has_inflation_started")
+	fd_Params_circulating_supply_exclusions = md_Params.Fields().ByName("circulating_supply_exclusions")
+	fd_Params_inflation_recipients = md_Params.Fields().ByName("inflation_recipients")
+	fd_Params_bonded_ratio_inflation = md_Params.Fields().ByName("bonded_ratio_inflation")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -721,6 +778,24 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.CirculatingSupplyExclusions != nil {
+		value := protoreflect.ValueOfMessage(x.CirculatingSupplyExclusions.ProtoReflect())
+		if !f(fd_Params_circulating_supply_exclusions, value) {
+			return
+		}
+	}
+	if len(x.InflationRecipients) != 0 {
+		value := protoreflect.ValueOfList(&_Params_9_list{list: &x.InflationRecipients})
+		if !f(fd_Params_inflation_recipients, value) {
+			return
+		}
+	}
+	if x.BondedRatioInflation != nil {
+		value := protoreflect.ValueOfMessage(x.BondedRatioInflation.ProtoReflect())
+		if !f(fd_Params_bonded_ratio_inflation, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -750,6 +825,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MaxPeriod != uint64(0)
 	case "nibiru.inflation.v1.Params.has_inflation_started":
 		return x.HasInflationStarted != false
+	case "nibiru.inflation.v1.Params.circulating_supply_exclusions":
+		return x.CirculatingSupplyExclusions != nil
+	case "nibiru.inflation.v1.Params.inflation_recipients":
+		return len(x.InflationRecipients) != 0
+	case "nibiru.inflation.v1.Params.bonded_ratio_inflation":
+		return x.BondedRatioInflation != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.Params"))
@@ -780,6 +861,12 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxPeriod = uint64(0)
 	case "nibiru.inflation.v1.Params.has_inflation_started":
 		x.HasInflationStarted = false
+	case "nibiru.inflation.v1.Params.circulating_supply_exclusions":
+		x.CirculatingSupplyExclusions = nil
+	case "nibiru.inflation.v1.Params.inflation_recipients":
+		x.InflationRecipients = nil
+	case "nibiru.inflation.v1.Params.bonded_ratio_inflation":
+		x.BondedRatioInflation = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.Params"))
@@ -820,6 +907,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "nibiru.inflation.v1.Params.has_inflation_started":
 		value := x.HasInflationStarted
 		return protoreflect.ValueOfBool(value)
+	case "nibiru.inflation.v1.Params.circulating_supply_exclusions":
+		value := x.CirculatingSupplyExclusions
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nibiru.inflation.v1.Params.inflation_recipients":
+		if len(x.InflationRecipients) == 0 {
+			return protoreflect.ValueOfList(&_Params_9_list{})
+		}
+		listValue := &_Params_9_list{list: &x.InflationRecipients}
+		return protoreflect.ValueOfList(listValue)
+	case "nibiru.inflation.v1.Params.bonded_ratio_inflation":
+		value := x.BondedRatioInflation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.Params"))
@@ -856,6 +955,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MaxPeriod = value.Uint()
 	case "nibiru.inflation.v1.Params.has_inflation_started":
 		x.HasInflationStarted = value.Bool()
+	case "nibiru.inflation.v1.Params.circulating_supply_exclusions":
+		x.CirculatingSupplyExclusions = value.Message().Interface().(*CirculatingSupplyExclusions)
+	case "nibiru.inflation.v1.Params.inflation_recipients":
+		lv := value.List()
+		clv := lv.(*_Params_9_list)
+		x.InflationRecipients = *clv.list
+	case "nibiru.inflation.v1.Params.bonded_ratio_inflation":
+		x.BondedRatioInflation = value.Message().Interface().(*BondedRatioInflation)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.Params"))
@@ -887,6 +994,22 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.InflationDistribution = new(InflationDistribution)
 		}
 		return protoreflect.ValueOfMessage(x.InflationDistribution.ProtoReflect())
+	case "nibiru.inflation.v1.Params.circulating_supply_exclusions":
+		if x.CirculatingSupplyExclusions == nil {
+			x.CirculatingSupplyExclusions = new(CirculatingSupplyExclusions)
+		}
+		return protoreflect.ValueOfMessage(x.CirculatingSupplyExclusions.ProtoReflect())
+	case "nibiru.inflation.v1.Params.inflation_recipients":
+		if x.InflationRecipients == nil {
+			x.InflationRecipients = []*InflationRecipient{}
+		}
+		value := &_Params_9_list{list: &x.InflationRecipients}
+		return protoreflect.ValueOfList(value)
+	case "nibiru.inflation.v1.Params.bonded_ratio_inflation":
+		if x.BondedRatioInflation == nil {
+			x.BondedRatioInflation = new(BondedRatioInflation)
+		}
+		return protoreflect.ValueOfMessage(x.BondedRatioInflation.ProtoReflect())
 	case "nibiru.inflation.v1.Params.inflation_enabled":
 		panic(fmt.Errorf("field inflation_enabled of message nibiru.inflation.v1.Params is not mutable"))
 	case "nibiru.inflation.v1.Params.epochs_per_period":
@@ -926,6 +1049,15 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "nibiru.inflation.v1.Params.has_inflation_started":
 		return protoreflect.ValueOfBool(false)
+	case "nibiru.inflation.v1.Params.circulating_supply_exclusions":
+		m := new(CirculatingSupplyExclusions)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nibiru.inflation.v1.Params.inflation_recipients":
+		list := []*InflationRecipient{}
+		return protoreflect.ValueOfList(&_Params_9_list{list: &list})
+	case "nibiru.inflation.v1.Params.bonded_ratio_inflation":
+		m := new(BondedRatioInflation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.Params"))
@@ -1020,6 +1152,20 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.HasInflationStarted {
 			n += 2
 		}
+		if x.CirculatingSupplyExclusions != nil {
+			l = options.Size(x.CirculatingSupplyExclusions)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.InflationRecipients) > 0 {
+			for _, e := range x.InflationRecipients {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BondedRatioInflation != nil {
+			l = options.Size(x.BondedRatioInflation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1049,6 +1195,50 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.BondedRatioInflation != nil {
+			encoded, err := options.Marshal(x.BondedRatioInflation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.InflationRecipients) > 0 {
+			for iNdEx := len(x.InflationRecipients) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InflationRecipients[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.CirculatingSupplyExclusions != nil {
+			encoded, err := options.Marshal(x.CirculatingSupplyExclusions)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
 		if x.HasInflationStarted {
 			i--
 			if x.HasInflationStarted {
@@ -1321,6 +1511,112 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.HasInflationStarted = bool(v != 0)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupplyExclusions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CirculatingSupplyExclusions == nil {
+					x.CirculatingSupplyExclusions = &CirculatingSupplyExclusions{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CirculatingSupplyExclusions); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationRecipients", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationRecipients = append(x.InflationRecipients, &InflationRecipient{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InflationRecipients[len(x.InflationRecipients)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondedRatioInflation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BondedRatioInflation == nil {
+					x.BondedRatioInflation = &BondedRatioInflation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BondedRatioInflation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1437,7 +1733,10 @@ type Params struct {
 	// polynomial_factors takes in the variables to calculate polynomial
 	// inflation
 	PolynomialFactors []string `protobuf:"bytes,2,rep,name=polynomial_factors,json=polynomialFactors,proto3" json:"polynomial_factors,omitempty"`
-	// inflation_distribution of the minted denom
+	// Deprecated: inflation_distribution is replaced by inflation_recipients.
+	// It is only read to migrate the params of existing networks.
+	//
+	// Deprecated: Do not use.
 	InflationDistribution *InflationDistribution `protobuf:"bytes,3,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution,omitempty"`
 	// epochs_per_period is the number of epochs that must pass before a new
 	// period is created
@@ -1451,6 +1750,15 @@ type Params struct {
 	// started. It's set to false at the starts, and stays at true when we toggle
 	// inflation on. It's used to track num skipped epochs
 	HasInflationStarted bool `protobuf:"varint,7,opt,name=has_inflation_started,json=hasInflationStarted,proto3" json:"has_inflation_started,omitempty"`
+	// circulating_supply_exclusions lists the accounts whose balances are not
+	// counted as circulating supply.
+	CirculatingSupplyExclusions *CirculatingSupplyExclusions `protobuf:"bytes,8,opt,name=circulating_supply_exclusions,json=circulatingSupplyExclusions,proto3" json:"circulating_supply_exclusions,omitempty"`
+	// inflation_recipients lists the accounts that receive the minted denom
+	// and the share of each. The weights sum to 1.
+	InflationRecipients []*InflationRecipient `protobuf:"bytes,9,rep,name=inflation_recipients,json=inflationRecipients,proto3" json:"inflation_recipients,omitempty"`
+	// bonded_ratio_inflation optionally scales the epoch mint provision of the
+	// polynomial by how far the staking bonded ratio is from a target.
+	BondedRatioInflation *BondedRatioInflation `protobuf:"bytes,10,opt,name=bonded_ratio_inflation,json=bondedRatioInflation,proto3" json:"bonded_ratio_inflation,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1487,6 +1795,7 @@ func (x *Params) GetPolynomialFactors() []string {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (x *Params) GetInflationDistribution() *InflationDistribution {
 	if x != nil {
 		return x.InflationDistribution
@@ -1522,6 +1831,27 @@ func (x *Params) GetHasInflationStarted() bool {
 	return false
 }
 
+func (x *Params) GetCirculatingSupplyExclusions() *CirculatingSupplyExclusions {
+	if x != nil {
+		return x.CirculatingSupplyExclusions
+	}
+	return nil
+}
+
+func (x *Params) GetInflationRecipients() []*InflationRecipient {
+	if x != nil {
+		return x.InflationRecipients
+	}
+	return nil
+}
+
+func (x *Params) GetBondedRatioInflation() *BondedRatioInflation {
+	if x != nil {
+		return x.BondedRatioInflation
+	}
+	return nil
+}
+
 var File_nibiru_inflation_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_nibiru_inflation_v1_genesis_proto_rawDesc = []byte{
@@ -1542,7 +1872,7 @@ var file_nibiru_inflation_v1_genesis_proto_rawDesc = []byte{
 	0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69,
 	0x6f, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x70,
 	0x6f, 0x63, 0x68, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70,
-	0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0xa9, 0x03, 0x0a, 0x06, 0x50, 0x61,
+	0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0xec, 0x05, 0x0a, 0x06, 0x50, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
 	0x10, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
@@ -1552,37 +1882,57 @@ var file_nibiru_inflation_v1_genesis_proto_rawDesc = []byte{
 	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
 	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
 	0x52, 0x11, 0x70, 0x6f, 0x6c, 0x79, 0x6e, 0x6f, 0x6d, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x12, 0x67, 0x0a, 0x16, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x6f, 0x72, 0x73, 0x12, 0x65, 0x0a, 0x16, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66,
 	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x50,
-	0x65, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x50, 0x65, 0x72, 0x59, 0x65,
-	0x61, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x13, 0x68, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x65, 0x64, 0x42, 0xc7, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x69,
-	0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x4e, 0x69, 0x62, 0x69,
-	0x72, 0x75, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x13, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x49,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75,
-	0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x02, 0x18, 0x01, 0x52, 0x15, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x50, 0x65, 0x72,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x50, 0x65, 0x72, 0x59, 0x65, 0x61, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x32, 0x0a, 0x15, 0x68, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
+	0x68, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x7a, 0x0a, 0x1d, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6e, 0x69, 0x62,
+	0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x1b, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x60, 0x0a, 0x14, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x65, 0x0a, 0x16, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x61,
+	0x74, 0x69, 0x6f, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x14, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x49,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xc7, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x4e,
+	0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x13, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4e, 0x69, 0x62,
+	0x69, 0x72, 0x75, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1599,18 +1949,24 @@ func file_nibiru_inflation_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_nibiru_inflation_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_nibiru_inflation_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),          // 0: nibiru.inflation.v1.GenesisState
-	(*Params)(nil),                // 1: nibiru.inflation.v1.Params
-	(*InflationDistribution)(nil), // 2: nibiru.inflation.v1.InflationDistribution
+	(*GenesisState)(nil),                // 0: nibiru.inflation.v1.GenesisState
+	(*Params)(nil),                      // 1: nibiru.inflation.v1.Params
+	(*InflationDistribution)(nil),       // 2: nibiru.inflation.v1.InflationDistribution
+	(*CirculatingSupplyExclusions)(nil), // 3: nibiru.inflation.v1.CirculatingSupplyExclusions
+	(*InflationRecipient)(nil),          // 4: nibiru.inflation.v1.InflationRecipient
+	(*BondedRatioInflation)(nil),        // 5: nibiru.inflation.v1.BondedRatioInflation
 }
 var file_nibiru_inflation_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: nibiru.inflation.v1.GenesisState.params:type_name -> nibiru.inflation.v1.Params
 	2, // 1: nibiru.inflation.v1.Params.inflation_distribution:type_name -> nibiru.inflation.v1.InflationDistribution
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: nibiru.inflation.v1.Params.circulating_supply_exclusions:type_name -> nibiru.inflation.v1.CirculatingSupplyExclusions
+	4, // 3: nibiru.inflation.v1.Params.inflation_recipients:type_name -> nibiru.inflation.v1.InflationRecipient
+	5, // 4: nibiru.inflation.v1.Params.bonded_ratio_inflation:type_name -> nibiru.inflation.v1.BondedRatioInflation
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_nibiru_inflation_v1_genesis_proto_init() }
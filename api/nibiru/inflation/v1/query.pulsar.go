@@ -3119,23 +3119,23 @@ func (x *fastReflection_QueryCirculatingSupplyResponse) ProtoMethods() *protoifa
 }
 
 var (
-	md_QueryInflationRateRequest protoreflect.MessageDescriptor
+	md_QueryCirculatingSupplyBreakdownRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_nibiru_inflation_v1_query_proto_init()
-	md_QueryInflationRateRequest = File_nibiru_inflation_v1_query_proto.Messages().ByName("QueryInflationRateRequest")
+	md_QueryCirculatingSupplyBreakdownRequest = File_nibiru_inflation_v1_query_proto.Messages().ByName("QueryCirculatingSupplyBreakdownRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryInflationRateRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCirculatingSupplyBreakdownRequest)(nil)
 
-type fastReflection_QueryInflationRateRequest QueryInflationRateRequest
+type fastReflection_QueryCirculatingSupplyBreakdownRequest QueryCirculatingSupplyBreakdownRequest
 
-func (x *QueryInflationRateRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryInflationRateRequest)(x)
+func (x *QueryCirculatingSupplyBreakdownRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCirculatingSupplyBreakdownRequest)(x)
 }
 
-func (x *QueryInflationRateRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryCirculatingSupplyBreakdownRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_inflation_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3147,43 +3147,43 @@ func (x *QueryInflationRateRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryInflationRateRequest_messageType fastReflection_QueryInflationRateRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryInflationRateRequest_messageType{}
+var _fastReflection_QueryCirculatingSupplyBreakdownRequest_messageType fastReflection_QueryCirculatingSupplyBreakdownRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCirculatingSupplyBreakdownRequest_messageType{}
 
-type fastReflection_QueryInflationRateRequest_messageType struct{}
+type fastReflection_QueryCirculatingSupplyBreakdownRequest_messageType struct{}
 
-func (x fastReflection_QueryInflationRateRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryInflationRateRequest)(nil)
+func (x fastReflection_QueryCirculatingSupplyBreakdownRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCirculatingSupplyBreakdownRequest)(nil)
 }
-func (x fastReflection_QueryInflationRateRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryInflationRateRequest)
+func (x fastReflection_QueryCirculatingSupplyBreakdownRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCirculatingSupplyBreakdownRequest)
 }
-func (x fastReflection_QueryInflationRateRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryInflationRateRequest
+func (x fastReflection_QueryCirculatingSupplyBreakdownRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCirculatingSupplyBreakdownRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryInflationRateRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryInflationRateRequest
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCirculatingSupplyBreakdownRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryInflationRateRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryInflationRateRequest_messageType
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCirculatingSupplyBreakdownRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryInflationRateRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryInflationRateRequest)
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCirculatingSupplyBreakdownRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryInflationRateRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryInflationRateRequest)(x)
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCirculatingSupplyBreakdownRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3191,7 +3191,7 @@ func (x *fastReflection_QueryInflationRateRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryInflationRateRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3205,13 +3205,13 @@ func (x *fastReflection_QueryInflationRateRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryInflationRateRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3221,13 +3221,13 @@ func (x *fastReflection_QueryInflationRateRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInflationRateRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3237,13 +3237,13 @@ func (x *fastReflection_QueryInflationRateRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryInflationRateRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3257,13 +3257,13 @@ func (x *fastReflection_QueryInflationRateRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInflationRateRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3277,36 +3277,36 @@ func (x *fastReflection_QueryInflationRateRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInflationRateRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryInflationRateRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest"))
 		}
-		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryInflationRateRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.QueryInflationRateRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3314,7 +3314,7 @@ func (x *fastReflection_QueryInflationRateRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryInflationRateRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3325,7 +3325,7 @@ func (x *fastReflection_QueryInflationRateRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInflationRateRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3337,7 +3337,7 @@ func (x *fastReflection_QueryInflationRateRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryInflationRateRequest) IsValid() bool {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3347,9 +3347,9 @@ func (x *fastReflection_QueryInflationRateRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryInflationRateRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryInflationRateRequest)
+		x := input.Message.Interface().(*QueryCirculatingSupplyBreakdownRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3371,7 +3371,7 @@ func (x *fastReflection_QueryInflationRateRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryInflationRateRequest)
+		x := input.Message.Interface().(*QueryCirculatingSupplyBreakdownRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3401,7 +3401,7 @@ func (x *fastReflection_QueryInflationRateRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryInflationRateRequest)
+		x := input.Message.Interface().(*QueryCirculatingSupplyBreakdownRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3433,10 +3433,10 @@ func (x *fastReflection_QueryInflationRateRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationRateRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCirculatingSupplyBreakdownRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCirculatingSupplyBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -3474,26 +3474,83 @@ func (x *fastReflection_QueryInflationRateRequest) ProtoMethods() *protoiface.Me
 	}
 }
 
+var _ protoreflect.List = (*_QueryCirculatingSupplyBreakdownResponse_4_list)(nil)
+
+type _QueryCirculatingSupplyBreakdownResponse_4_list struct {
+	list *[]*ExcludedBalance
+}
+
+func (x *_QueryCirculatingSupplyBreakdownResponse_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryCirculatingSupplyBreakdownResponse_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryCirculatingSupplyBreakdownResponse_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExcludedBalance)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryCirculatingSupplyBreakdownResponse_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExcludedBalance)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryCirculatingSupplyBreakdownResponse_4_list) AppendMutable() protoreflect.Value {
+	v := new(ExcludedBalance)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryCirculatingSupplyBreakdownResponse_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryCirculatingSupplyBreakdownResponse_4_list) NewElement() protoreflect.Value {
+	v := new(ExcludedBalance)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryCirculatingSupplyBreakdownResponse_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryInflationRateResponse                protoreflect.MessageDescriptor
-	fd_QueryInflationRateResponse_inflation_rate protoreflect.FieldDescriptor
+	md_QueryCirculatingSupplyBreakdownResponse                    protoreflect.MessageDescriptor
+	fd_QueryCirculatingSupplyBreakdownResponse_total_supply       protoreflect.FieldDescriptor
+	fd_QueryCirculatingSupplyBreakdownResponse_circulating_supply protoreflect.FieldDescriptor
+	fd_QueryCirculatingSupplyBreakdownResponse_unvested           protoreflect.FieldDescriptor
+	fd_QueryCirculatingSupplyBreakdownResponse_excluded           protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_inflation_v1_query_proto_init()
-	md_QueryInflationRateResponse = File_nibiru_inflation_v1_query_proto.Messages().ByName("QueryInflationRateResponse")
-	fd_QueryInflationRateResponse_inflation_rate = md_QueryInflationRateResponse.Fields().ByName("inflation_rate")
+	md_QueryCirculatingSupplyBreakdownResponse = File_nibiru_inflation_v1_query_proto.Messages().ByName("QueryCirculatingSupplyBreakdownResponse")
+	fd_QueryCirculatingSupplyBreakdownResponse_total_supply = md_QueryCirculatingSupplyBreakdownResponse.Fields().ByName("total_supply")
+	fd_QueryCirculatingSupplyBreakdownResponse_circulating_supply = md_QueryCirculatingSupplyBreakdownResponse.Fields().ByName("circulating_supply")
+	fd_QueryCirculatingSupplyBreakdownResponse_unvested = md_QueryCirculatingSupplyBreakdownResponse.Fields().ByName("unvested")
+	fd_QueryCirculatingSupplyBreakdownResponse_excluded = md_QueryCirculatingSupplyBreakdownResponse.Fields().ByName("excluded")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryInflationRateResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCirculatingSupplyBreakdownResponse)(nil)
 
-type fastReflection_QueryInflationRateResponse QueryInflationRateResponse
+type fastReflection_QueryCirculatingSupplyBreakdownResponse QueryCirculatingSupplyBreakdownResponse
 
-func (x *QueryInflationRateResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryInflationRateResponse)(x)
+func (x *QueryCirculatingSupplyBreakdownResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCirculatingSupplyBreakdownResponse)(x)
 }
 
-func (x *QueryInflationRateResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryCirculatingSupplyBreakdownResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_inflation_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3505,43 +3562,43 @@ func (x *QueryInflationRateResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryInflationRateResponse_messageType fastReflection_QueryInflationRateResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryInflationRateResponse_messageType{}
+var _fastReflection_QueryCirculatingSupplyBreakdownResponse_messageType fastReflection_QueryCirculatingSupplyBreakdownResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCirculatingSupplyBreakdownResponse_messageType{}
 
-type fastReflection_QueryInflationRateResponse_messageType struct{}
+type fastReflection_QueryCirculatingSupplyBreakdownResponse_messageType struct{}
 
-func (x fastReflection_QueryInflationRateResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryInflationRateResponse)(nil)
+func (x fastReflection_QueryCirculatingSupplyBreakdownResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCirculatingSupplyBreakdownResponse)(nil)
 }
-func (x fastReflection_QueryInflationRateResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryInflationRateResponse)
+func (x fastReflection_QueryCirculatingSupplyBreakdownResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCirculatingSupplyBreakdownResponse)
 }
-func (x fastReflection_QueryInflationRateResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryInflationRateResponse
+func (x fastReflection_QueryCirculatingSupplyBreakdownResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCirculatingSupplyBreakdownResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryInflationRateResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryInflationRateResponse
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCirculatingSupplyBreakdownResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryInflationRateResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryInflationRateResponse_messageType
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCirculatingSupplyBreakdownResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryInflationRateResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryInflationRateResponse)
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCirculatingSupplyBreakdownResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryInflationRateResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryInflationRateResponse)(x)
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCirculatingSupplyBreakdownResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3549,10 +3606,28 @@ func (x *fastReflection_QueryInflationRateResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryInflationRateResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.InflationRate != "" {
-		value := protoreflect.ValueOfString(x.InflationRate)
-		if !f(fd_QueryInflationRateResponse_inflation_rate, value) {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TotalSupply != nil {
+		value := protoreflect.ValueOfMessage(x.TotalSupply.ProtoReflect())
+		if !f(fd_QueryCirculatingSupplyBreakdownResponse_total_supply, value) {
+			return
+		}
+	}
+	if x.CirculatingSupply != nil {
+		value := protoreflect.ValueOfMessage(x.CirculatingSupply.ProtoReflect())
+		if !f(fd_QueryCirculatingSupplyBreakdownResponse_circulating_supply, value) {
+			return
+		}
+	}
+	if x.Unvested != nil {
+		value := protoreflect.ValueOfMessage(x.Unvested.ProtoReflect())
+		if !f(fd_QueryCirculatingSupplyBreakdownResponse_unvested, value) {
+			return
+		}
+	}
+	if len(x.Excluded) != 0 {
+		value := protoreflect.ValueOfList(&_QueryCirculatingSupplyBreakdownResponse_4_list{list: &x.Excluded})
+		if !f(fd_QueryCirculatingSupplyBreakdownResponse_excluded, value) {
 			return
 		}
 	}
@@ -3569,15 +3644,21 @@ func (x *fastReflection_QueryInflationRateResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryInflationRateResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.inflation.v1.QueryInflationRateResponse.inflation_rate":
-		return x.InflationRate != ""
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.total_supply":
+		return x.TotalSupply != nil
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.circulating_supply":
+		return x.CirculatingSupply != nil
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.unvested":
+		return x.Unvested != nil
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.excluded":
+		return len(x.Excluded) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3587,15 +3668,21 @@ func (x *fastReflection_QueryInflationRateResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInflationRateResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.inflation.v1.QueryInflationRateResponse.inflation_rate":
-		x.InflationRate = ""
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.total_supply":
+		x.TotalSupply = nil
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.circulating_supply":
+		x.CirculatingSupply = nil
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.unvested":
+		x.Unvested = nil
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.excluded":
+		x.Excluded = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3605,16 +3692,28 @@ func (x *fastReflection_QueryInflationRateResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryInflationRateResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.inflation.v1.QueryInflationRateResponse.inflation_rate":
-		value := x.InflationRate
-		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.total_supply":
+		value := x.TotalSupply
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.circulating_supply":
+		value := x.CirculatingSupply
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.unvested":
+		value := x.Unvested
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.excluded":
+		if len(x.Excluded) == 0 {
+			return protoreflect.ValueOfList(&_QueryCirculatingSupplyBreakdownResponse_4_list{})
+		}
+		listValue := &_QueryCirculatingSupplyBreakdownResponse_4_list{list: &x.Excluded}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3628,15 +3727,23 @@ func (x *fastReflection_QueryInflationRateResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInflationRateResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.inflation.v1.QueryInflationRateResponse.inflation_rate":
-		x.InflationRate = value.Interface().(string)
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.total_supply":
+		x.TotalSupply = value.Message().Interface().(*v1beta1.Coin)
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.circulating_supply":
+		x.CirculatingSupply = value.Message().Interface().(*v1beta1.Coin)
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.unvested":
+		x.Unvested = value.Message().Interface().(*v1beta1.Coin)
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.excluded":
+		lv := value.List()
+		clv := lv.(*_QueryCirculatingSupplyBreakdownResponse_4_list)
+		x.Excluded = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3650,40 +3757,69 @@ func (x *fastReflection_QueryInflationRateResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInflationRateResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.inflation.v1.QueryInflationRateResponse.inflation_rate":
-		panic(fmt.Errorf("field inflation_rate of message nibiru.inflation.v1.QueryInflationRateResponse is not mutable"))
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.total_supply":
+		if x.TotalSupply == nil {
+			x.TotalSupply = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.TotalSupply.ProtoReflect())
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.circulating_supply":
+		if x.CirculatingSupply == nil {
+			x.CirculatingSupply = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.CirculatingSupply.ProtoReflect())
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.unvested":
+		if x.Unvested == nil {
+			x.Unvested = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Unvested.ProtoReflect())
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.excluded":
+		if x.Excluded == nil {
+			x.Excluded = []*ExcludedBalance{}
+		}
+		value := &_QueryCirculatingSupplyBreakdownResponse_4_list{list: &x.Excluded}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryInflationRateResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.inflation.v1.QueryInflationRateResponse.inflation_rate":
-		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.total_supply":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.circulating_supply":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.unvested":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.excluded":
+		list := []*ExcludedBalance{}
+		return protoreflect.ValueOfList(&_QueryCirculatingSupplyBreakdownResponse_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse"))
 		}
-		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryInflationRateResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.QueryInflationRateResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3691,7 +3827,7 @@ func (x *fastReflection_QueryInflationRateResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryInflationRateResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3702,7 +3838,7 @@ func (x *fastReflection_QueryInflationRateResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryInflationRateResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3714,7 +3850,7 @@ func (x *fastReflection_QueryInflationRateResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryInflationRateResponse) IsValid() bool {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3724,9 +3860,9 @@ func (x *fastReflection_QueryInflationRateResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryInflationRateResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCirculatingSupplyBreakdownResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryInflationRateResponse)
+		x := input.Message.Interface().(*QueryCirculatingSupplyBreakdownResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3738,10 +3874,24 @@ func (x *fastReflection_QueryInflationRateResponse) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.InflationRate)
-		if l > 0 {
+		if x.TotalSupply != nil {
+			l = options.Size(x.TotalSupply)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CirculatingSupply != nil {
+			l = options.Size(x.CirculatingSupply)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Unvested != nil {
+			l = options.Size(x.Unvested)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Excluded) > 0 {
+			for _, e := range x.Excluded {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3752,7 +3902,7 @@ func (x *fastReflection_QueryInflationRateResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryInflationRateResponse)
+		x := input.Message.Interface().(*QueryCirculatingSupplyBreakdownResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3771,10 +3921,61 @@ func (x *fastReflection_QueryInflationRateResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.InflationRate) > 0 {
-			i -= len(x.InflationRate)
-			copy(dAtA[i:], x.InflationRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationRate)))
+		if len(x.Excluded) > 0 {
+			for iNdEx := len(x.Excluded) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Excluded[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Unvested != nil {
+			encoded, err := options.Marshal(x.Unvested)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.CirculatingSupply != nil {
+			encoded, err := options.Marshal(x.CirculatingSupply)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TotalSupply != nil {
+			encoded, err := options.Marshal(x.TotalSupply)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3789,7 +3990,7 @@ func (x *fastReflection_QueryInflationRateResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryInflationRateResponse)
+		x := input.Message.Interface().(*QueryCirculatingSupplyBreakdownResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3821,17 +4022,17 @@ func (x *fastReflection_QueryInflationRateResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationRateResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCirculatingSupplyBreakdownResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCirculatingSupplyBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3841,41 +4042,3239 @@ func (x *fastReflection_QueryInflationRateResponse) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InflationRate = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.TotalSupply == nil {
+					x.TotalSupply = &v1beta1.Coin{}
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalSupply); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
 				}
-				iNdEx += skippy
-			}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CirculatingSupply == nil {
+					x.CirculatingSupply = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CirculatingSupply); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Unvested == nil {
+					x.Unvested = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Unvested); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Excluded", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Excluded = append(x.Excluded, &ExcludedBalance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Excluded[len(x.Excluded)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ExcludedBalance         protoreflect.MessageDescriptor
+	fd_ExcludedBalance_address protoreflect.FieldDescriptor
+	fd_ExcludedBalance_label   protoreflect.FieldDescriptor
+	fd_ExcludedBalance_balance protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_inflation_v1_query_proto_init()
+	md_ExcludedBalance = File_nibiru_inflation_v1_query_proto.Messages().ByName("ExcludedBalance")
+	fd_ExcludedBalance_address = md_ExcludedBalance.Fields().ByName("address")
+	fd_ExcludedBalance_label = md_ExcludedBalance.Fields().ByName("label")
+	fd_ExcludedBalance_balance = md_ExcludedBalance.Fields().ByName("balance")
+}
+
+var _ protoreflect.Message = (*fastReflection_ExcludedBalance)(nil)
+
+type fastReflection_ExcludedBalance ExcludedBalance
+
+func (x *ExcludedBalance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExcludedBalance)(x)
+}
+
+func (x *ExcludedBalance) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_inflation_v1_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ExcludedBalance_messageType fastReflection_ExcludedBalance_messageType
+var _ protoreflect.MessageType = fastReflection_ExcludedBalance_messageType{}
+
+type fastReflection_ExcludedBalance_messageType struct{}
+
+func (x fastReflection_ExcludedBalance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExcludedBalance)(nil)
+}
+func (x fastReflection_ExcludedBalance_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExcludedBalance)
+}
+func (x fastReflection_ExcludedBalance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExcludedBalance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ExcludedBalance) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExcludedBalance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ExcludedBalance) Type() protoreflect.MessageType {
+	return _fastReflection_ExcludedBalance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ExcludedBalance) New() protoreflect.Message {
+	return new(fastReflection_ExcludedBalance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ExcludedBalance) Interface() protoreflect.ProtoMessage {
+	return (*ExcludedBalance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ExcludedBalance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ExcludedBalance_address, value) {
+			return
+		}
+	}
+	if x.Label != "" {
+		value := protoreflect.ValueOfString(x.Label)
+		if !f(fd_ExcludedBalance_label, value) {
+			return
+		}
+	}
+	if x.Balance != nil {
+		value := protoreflect.ValueOfMessage(x.Balance.ProtoReflect())
+		if !f(fd_ExcludedBalance_balance, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExcludedBalance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.ExcludedBalance.address":
+		return x.Address != ""
+	case "nibiru.inflation.v1.ExcludedBalance.label":
+		return x.Label != ""
+	case "nibiru.inflation.v1.ExcludedBalance.balance":
+		return x.Balance != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.ExcludedBalance"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.ExcludedBalance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExcludedBalance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.ExcludedBalance.address":
+		x.Address = ""
+	case "nibiru.inflation.v1.ExcludedBalance.label":
+		x.Label = ""
+	case "nibiru.inflation.v1.ExcludedBalance.balance":
+		x.Balance = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.ExcludedBalance"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.ExcludedBalance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExcludedBalance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.inflation.v1.ExcludedBalance.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.ExcludedBalance.label":
+		value := x.Label
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.ExcludedBalance.balance":
+		value := x.Balance
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.ExcludedBalance"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.ExcludedBalance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExcludedBalance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.ExcludedBalance.address":
+		x.Address = value.Interface().(string)
+	case "nibiru.inflation.v1.ExcludedBalance.label":
+		x.Label = value.Interface().(string)
+	case "nibiru.inflation.v1.ExcludedBalance.balance":
+		x.Balance = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.ExcludedBalance"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.ExcludedBalance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExcludedBalance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.ExcludedBalance.balance":
+		if x.Balance == nil {
+			x.Balance = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Balance.ProtoReflect())
+	case "nibiru.inflation.v1.ExcludedBalance.address":
+		panic(fmt.Errorf("field address of message nibiru.inflation.v1.ExcludedBalance is not mutable"))
+	case "nibiru.inflation.v1.ExcludedBalance.label":
+		panic(fmt.Errorf("field label of message nibiru.inflation.v1.ExcludedBalance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.ExcludedBalance"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.ExcludedBalance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExcludedBalance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.ExcludedBalance.address":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.ExcludedBalance.label":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.ExcludedBalance.balance":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.ExcludedBalance"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.ExcludedBalance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExcludedBalance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.ExcludedBalance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExcludedBalance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExcludedBalance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExcludedBalance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExcludedBalance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExcludedBalance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Label)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Balance != nil {
+			l = options.Size(x.Balance)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExcludedBalance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Balance != nil {
+			encoded, err := options.Marshal(x.Balance)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Label) > 0 {
+			i -= len(x.Label)
+			copy(dAtA[i:], x.Label)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Label)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExcludedBalance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExcludedBalance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExcludedBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Label = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Balance == nil {
+					x.Balance = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balance); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryInflationRateRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_nibiru_inflation_v1_query_proto_init()
+	md_QueryInflationRateRequest = File_nibiru_inflation_v1_query_proto.Messages().ByName("QueryInflationRateRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInflationRateRequest)(nil)
+
+type fastReflection_QueryInflationRateRequest QueryInflationRateRequest
+
+func (x *QueryInflationRateRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInflationRateRequest)(x)
+}
+
+func (x *QueryInflationRateRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_inflation_v1_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInflationRateRequest_messageType fastReflection_QueryInflationRateRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInflationRateRequest_messageType{}
+
+type fastReflection_QueryInflationRateRequest_messageType struct{}
+
+func (x fastReflection_QueryInflationRateRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInflationRateRequest)(nil)
+}
+func (x fastReflection_QueryInflationRateRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInflationRateRequest)
+}
+func (x fastReflection_QueryInflationRateRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInflationRateRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInflationRateRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInflationRateRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInflationRateRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInflationRateRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInflationRateRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryInflationRateRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInflationRateRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryInflationRateRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInflationRateRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInflationRateRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationRateRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInflationRateRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationRateRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationRateRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInflationRateRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInflationRateRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.QueryInflationRateRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInflationRateRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationRateRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInflationRateRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInflationRateRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInflationRateRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInflationRateRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInflationRateRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationRateRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryInflationRateResponse                protoreflect.MessageDescriptor
+	fd_QueryInflationRateResponse_inflation_rate protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_inflation_v1_query_proto_init()
+	md_QueryInflationRateResponse = File_nibiru_inflation_v1_query_proto.Messages().ByName("QueryInflationRateResponse")
+	fd_QueryInflationRateResponse_inflation_rate = md_QueryInflationRateResponse.Fields().ByName("inflation_rate")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInflationRateResponse)(nil)
+
+type fastReflection_QueryInflationRateResponse QueryInflationRateResponse
+
+func (x *QueryInflationRateResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInflationRateResponse)(x)
+}
+
+func (x *QueryInflationRateResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_inflation_v1_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInflationRateResponse_messageType fastReflection_QueryInflationRateResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInflationRateResponse_messageType{}
+
+type fastReflection_QueryInflationRateResponse_messageType struct{}
+
+func (x fastReflection_QueryInflationRateResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInflationRateResponse)(nil)
+}
+func (x fastReflection_QueryInflationRateResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInflationRateResponse)
+}
+func (x fastReflection_QueryInflationRateResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInflationRateResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInflationRateResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInflationRateResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInflationRateResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInflationRateResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInflationRateResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryInflationRateResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInflationRateResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryInflationRateResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInflationRateResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InflationRate != "" {
+		value := protoreflect.ValueOfString(x.InflationRate)
+		if !f(fd_QueryInflationRateResponse_inflation_rate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInflationRateResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationRateResponse.inflation_rate":
+		return x.InflationRate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationRateResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationRateResponse.inflation_rate":
+		x.InflationRate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInflationRateResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.inflation.v1.QueryInflationRateResponse.inflation_rate":
+		value := x.InflationRate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationRateResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationRateResponse.inflation_rate":
+		x.InflationRate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationRateResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationRateResponse.inflation_rate":
+		panic(fmt.Errorf("field inflation_rate of message nibiru.inflation.v1.QueryInflationRateResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInflationRateResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationRateResponse.inflation_rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationRateResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationRateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInflationRateResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.QueryInflationRateResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInflationRateResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationRateResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInflationRateResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInflationRateResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInflationRateResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.InflationRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInflationRateResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.InflationRate) > 0 {
+			i -= len(x.InflationRate)
+			copy(dAtA[i:], x.InflationRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationRate)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInflationRateResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationRateResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryInflationScheduleRequest             protoreflect.MessageDescriptor
+	fd_QueryInflationScheduleRequest_num_periods protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_inflation_v1_query_proto_init()
+	md_QueryInflationScheduleRequest = File_nibiru_inflation_v1_query_proto.Messages().ByName("QueryInflationScheduleRequest")
+	fd_QueryInflationScheduleRequest_num_periods = md_QueryInflationScheduleRequest.Fields().ByName("num_periods")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInflationScheduleRequest)(nil)
+
+type fastReflection_QueryInflationScheduleRequest QueryInflationScheduleRequest
+
+func (x *QueryInflationScheduleRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInflationScheduleRequest)(x)
+}
+
+func (x *QueryInflationScheduleRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_inflation_v1_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInflationScheduleRequest_messageType fastReflection_QueryInflationScheduleRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInflationScheduleRequest_messageType{}
+
+type fastReflection_QueryInflationScheduleRequest_messageType struct{}
+
+func (x fastReflection_QueryInflationScheduleRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInflationScheduleRequest)(nil)
+}
+func (x fastReflection_QueryInflationScheduleRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInflationScheduleRequest)
+}
+func (x fastReflection_QueryInflationScheduleRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInflationScheduleRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInflationScheduleRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInflationScheduleRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInflationScheduleRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInflationScheduleRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInflationScheduleRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryInflationScheduleRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInflationScheduleRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryInflationScheduleRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInflationScheduleRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.NumPeriods != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumPeriods)
+		if !f(fd_QueryInflationScheduleRequest_num_periods, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInflationScheduleRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleRequest.num_periods":
+		return x.NumPeriods != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleRequest.num_periods":
+		x.NumPeriods = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInflationScheduleRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleRequest.num_periods":
+		value := x.NumPeriods
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleRequest.num_periods":
+		x.NumPeriods = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleRequest.num_periods":
+		panic(fmt.Errorf("field num_periods of message nibiru.inflation.v1.QueryInflationScheduleRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInflationScheduleRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleRequest.num_periods":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInflationScheduleRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.QueryInflationScheduleRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInflationScheduleRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInflationScheduleRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInflationScheduleRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInflationScheduleRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.NumPeriods != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumPeriods))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInflationScheduleRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NumPeriods != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumPeriods))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInflationScheduleRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationScheduleRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumPeriods", wireType)
+				}
+				x.NumPeriods = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumPeriods |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryInflationScheduleResponse_2_list)(nil)
+
+type _QueryInflationScheduleResponse_2_list struct {
+	list *[]*InflationSchedulePeriod
+}
+
+func (x *_QueryInflationScheduleResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryInflationScheduleResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryInflationScheduleResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationSchedulePeriod)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryInflationScheduleResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationSchedulePeriod)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryInflationScheduleResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(InflationSchedulePeriod)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryInflationScheduleResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryInflationScheduleResponse_2_list) NewElement() protoreflect.Value {
+	v := new(InflationSchedulePeriod)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryInflationScheduleResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryInflationScheduleResponse                    protoreflect.MessageDescriptor
+	fd_QueryInflationScheduleResponse_total_supply       protoreflect.FieldDescriptor
+	fd_QueryInflationScheduleResponse_periods            protoreflect.FieldDescriptor
+	fd_QueryInflationScheduleResponse_circulating_supply protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_inflation_v1_query_proto_init()
+	md_QueryInflationScheduleResponse = File_nibiru_inflation_v1_query_proto.Messages().ByName("QueryInflationScheduleResponse")
+	fd_QueryInflationScheduleResponse_total_supply = md_QueryInflationScheduleResponse.Fields().ByName("total_supply")
+	fd_QueryInflationScheduleResponse_periods = md_QueryInflationScheduleResponse.Fields().ByName("periods")
+	fd_QueryInflationScheduleResponse_circulating_supply = md_QueryInflationScheduleResponse.Fields().ByName("circulating_supply")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInflationScheduleResponse)(nil)
+
+type fastReflection_QueryInflationScheduleResponse QueryInflationScheduleResponse
+
+func (x *QueryInflationScheduleResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInflationScheduleResponse)(x)
+}
+
+func (x *QueryInflationScheduleResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_inflation_v1_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInflationScheduleResponse_messageType fastReflection_QueryInflationScheduleResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInflationScheduleResponse_messageType{}
+
+type fastReflection_QueryInflationScheduleResponse_messageType struct{}
+
+func (x fastReflection_QueryInflationScheduleResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInflationScheduleResponse)(nil)
+}
+func (x fastReflection_QueryInflationScheduleResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInflationScheduleResponse)
+}
+func (x fastReflection_QueryInflationScheduleResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInflationScheduleResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInflationScheduleResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInflationScheduleResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInflationScheduleResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInflationScheduleResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInflationScheduleResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryInflationScheduleResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInflationScheduleResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryInflationScheduleResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInflationScheduleResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TotalSupply != nil {
+		value := protoreflect.ValueOfMessage(x.TotalSupply.ProtoReflect())
+		if !f(fd_QueryInflationScheduleResponse_total_supply, value) {
+			return
+		}
+	}
+	if len(x.Periods) != 0 {
+		value := protoreflect.ValueOfList(&_QueryInflationScheduleResponse_2_list{list: &x.Periods})
+		if !f(fd_QueryInflationScheduleResponse_periods, value) {
+			return
+		}
+	}
+	if x.CirculatingSupply != "" {
+		value := protoreflect.ValueOfString(x.CirculatingSupply)
+		if !f(fd_QueryInflationScheduleResponse_circulating_supply, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInflationScheduleResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.total_supply":
+		return x.TotalSupply != nil
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.periods":
+		return len(x.Periods) != 0
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.circulating_supply":
+		return x.CirculatingSupply != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.total_supply":
+		x.TotalSupply = nil
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.periods":
+		x.Periods = nil
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.circulating_supply":
+		x.CirculatingSupply = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInflationScheduleResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.total_supply":
+		value := x.TotalSupply
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.periods":
+		if len(x.Periods) == 0 {
+			return protoreflect.ValueOfList(&_QueryInflationScheduleResponse_2_list{})
+		}
+		listValue := &_QueryInflationScheduleResponse_2_list{list: &x.Periods}
+		return protoreflect.ValueOfList(listValue)
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.circulating_supply":
+		value := x.CirculatingSupply
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.total_supply":
+		x.TotalSupply = value.Message().Interface().(*v1beta1.Coin)
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.periods":
+		lv := value.List()
+		clv := lv.(*_QueryInflationScheduleResponse_2_list)
+		x.Periods = *clv.list
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.circulating_supply":
+		x.CirculatingSupply = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.total_supply":
+		if x.TotalSupply == nil {
+			x.TotalSupply = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.TotalSupply.ProtoReflect())
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.periods":
+		if x.Periods == nil {
+			x.Periods = []*InflationSchedulePeriod{}
+		}
+		value := &_QueryInflationScheduleResponse_2_list{list: &x.Periods}
+		return protoreflect.ValueOfList(value)
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.circulating_supply":
+		panic(fmt.Errorf("field circulating_supply of message nibiru.inflation.v1.QueryInflationScheduleResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInflationScheduleResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.total_supply":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.periods":
+		list := []*InflationSchedulePeriod{}
+		return protoreflect.ValueOfList(&_QueryInflationScheduleResponse_2_list{list: &list})
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.circulating_supply":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInflationScheduleResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.QueryInflationScheduleResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInflationScheduleResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInflationScheduleResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInflationScheduleResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInflationScheduleResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TotalSupply != nil {
+			l = options.Size(x.TotalSupply)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Periods) > 0 {
+			for _, e := range x.Periods {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.CirculatingSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInflationScheduleResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CirculatingSupply) > 0 {
+			i -= len(x.CirculatingSupply)
+			copy(dAtA[i:], x.CirculatingSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CirculatingSupply)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Periods) > 0 {
+			for iNdEx := len(x.Periods) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Periods[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.TotalSupply != nil {
+			encoded, err := options.Marshal(x.TotalSupply)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInflationScheduleResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationScheduleResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalSupply == nil {
+					x.TotalSupply = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalSupply); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Periods = append(x.Periods, &InflationSchedulePeriod{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Periods[len(x.Periods)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CirculatingSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_InflationSchedulePeriod                      protoreflect.MessageDescriptor
+	fd_InflationSchedulePeriod_period               protoreflect.FieldDescriptor
+	fd_InflationSchedulePeriod_num_epochs           protoreflect.FieldDescriptor
+	fd_InflationSchedulePeriod_epoch_mint_provision protoreflect.FieldDescriptor
+	fd_InflationSchedulePeriod_period_mint          protoreflect.FieldDescriptor
+	fd_InflationSchedulePeriod_cumulative_supply    protoreflect.FieldDescriptor
+	fd_InflationSchedulePeriod_annualized_rate      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_inflation_v1_query_proto_init()
+	md_InflationSchedulePeriod = File_nibiru_inflation_v1_query_proto.Messages().ByName("InflationSchedulePeriod")
+	fd_InflationSchedulePeriod_period = md_InflationSchedulePeriod.Fields().ByName("period")
+	fd_InflationSchedulePeriod_num_epochs = md_InflationSchedulePeriod.Fields().ByName("num_epochs")
+	fd_InflationSchedulePeriod_epoch_mint_provision = md_InflationSchedulePeriod.Fields().ByName("epoch_mint_provision")
+	fd_InflationSchedulePeriod_period_mint = md_InflationSchedulePeriod.Fields().ByName("period_mint")
+	fd_InflationSchedulePeriod_cumulative_supply = md_InflationSchedulePeriod.Fields().ByName("cumulative_supply")
+	fd_InflationSchedulePeriod_annualized_rate = md_InflationSchedulePeriod.Fields().ByName("annualized_rate")
+}
+
+var _ protoreflect.Message = (*fastReflection_InflationSchedulePeriod)(nil)
+
+type fastReflection_InflationSchedulePeriod InflationSchedulePeriod
+
+func (x *InflationSchedulePeriod) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InflationSchedulePeriod)(x)
+}
+
+func (x *InflationSchedulePeriod) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_inflation_v1_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InflationSchedulePeriod_messageType fastReflection_InflationSchedulePeriod_messageType
+var _ protoreflect.MessageType = fastReflection_InflationSchedulePeriod_messageType{}
+
+type fastReflection_InflationSchedulePeriod_messageType struct{}
+
+func (x fastReflection_InflationSchedulePeriod_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InflationSchedulePeriod)(nil)
+}
+func (x fastReflection_InflationSchedulePeriod_messageType) New() protoreflect.Message {
+	return new(fastReflection_InflationSchedulePeriod)
+}
+func (x fastReflection_InflationSchedulePeriod_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationSchedulePeriod
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InflationSchedulePeriod) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationSchedulePeriod
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InflationSchedulePeriod) Type() protoreflect.MessageType {
+	return _fastReflection_InflationSchedulePeriod_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InflationSchedulePeriod) New() protoreflect.Message {
+	return new(fastReflection_InflationSchedulePeriod)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InflationSchedulePeriod) Interface() protoreflect.ProtoMessage {
+	return (*InflationSchedulePeriod)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InflationSchedulePeriod) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Period != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Period)
+		if !f(fd_InflationSchedulePeriod_period, value) {
+			return
+		}
+	}
+	if x.NumEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumEpochs)
+		if !f(fd_InflationSchedulePeriod_num_epochs, value) {
+			return
+		}
+	}
+	if x.EpochMintProvision != "" {
+		value := protoreflect.ValueOfString(x.EpochMintProvision)
+		if !f(fd_InflationSchedulePeriod_epoch_mint_provision, value) {
+			return
+		}
+	}
+	if x.PeriodMint != "" {
+		value := protoreflect.ValueOfString(x.PeriodMint)
+		if !f(fd_InflationSchedulePeriod_period_mint, value) {
+			return
+		}
+	}
+	if x.CumulativeSupply != "" {
+		value := protoreflect.ValueOfString(x.CumulativeSupply)
+		if !f(fd_InflationSchedulePeriod_cumulative_supply, value) {
+			return
+		}
+	}
+	if x.AnnualizedRate != "" {
+		value := protoreflect.ValueOfString(x.AnnualizedRate)
+		if !f(fd_InflationSchedulePeriod_annualized_rate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InflationSchedulePeriod) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationSchedulePeriod.period":
+		return x.Period != uint64(0)
+	case "nibiru.inflation.v1.InflationSchedulePeriod.num_epochs":
+		return x.NumEpochs != uint64(0)
+	case "nibiru.inflation.v1.InflationSchedulePeriod.epoch_mint_provision":
+		return x.EpochMintProvision != ""
+	case "nibiru.inflation.v1.InflationSchedulePeriod.period_mint":
+		return x.PeriodMint != ""
+	case "nibiru.inflation.v1.InflationSchedulePeriod.cumulative_supply":
+		return x.CumulativeSupply != ""
+	case "nibiru.inflation.v1.InflationSchedulePeriod.annualized_rate":
+		return x.AnnualizedRate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationSchedulePeriod"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationSchedulePeriod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationSchedulePeriod) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationSchedulePeriod.period":
+		x.Period = uint64(0)
+	case "nibiru.inflation.v1.InflationSchedulePeriod.num_epochs":
+		x.NumEpochs = uint64(0)
+	case "nibiru.inflation.v1.InflationSchedulePeriod.epoch_mint_provision":
+		x.EpochMintProvision = ""
+	case "nibiru.inflation.v1.InflationSchedulePeriod.period_mint":
+		x.PeriodMint = ""
+	case "nibiru.inflation.v1.InflationSchedulePeriod.cumulative_supply":
+		x.CumulativeSupply = ""
+	case "nibiru.inflation.v1.InflationSchedulePeriod.annualized_rate":
+		x.AnnualizedRate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationSchedulePeriod"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationSchedulePeriod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InflationSchedulePeriod) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.inflation.v1.InflationSchedulePeriod.period":
+		value := x.Period
+		return protoreflect.ValueOfUint64(value)
+	case "nibiru.inflation.v1.InflationSchedulePeriod.num_epochs":
+		value := x.NumEpochs
+		return protoreflect.ValueOfUint64(value)
+	case "nibiru.inflation.v1.InflationSchedulePeriod.epoch_mint_provision":
+		value := x.EpochMintProvision
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.InflationSchedulePeriod.period_mint":
+		value := x.PeriodMint
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.InflationSchedulePeriod.cumulative_supply":
+		value := x.CumulativeSupply
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.InflationSchedulePeriod.annualized_rate":
+		value := x.AnnualizedRate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationSchedulePeriod"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationSchedulePeriod does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationSchedulePeriod) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationSchedulePeriod.period":
+		x.Period = value.Uint()
+	case "nibiru.inflation.v1.InflationSchedulePeriod.num_epochs":
+		x.NumEpochs = value.Uint()
+	case "nibiru.inflation.v1.InflationSchedulePeriod.epoch_mint_provision":
+		x.EpochMintProvision = value.Interface().(string)
+	case "nibiru.inflation.v1.InflationSchedulePeriod.period_mint":
+		x.PeriodMint = value.Interface().(string)
+	case "nibiru.inflation.v1.InflationSchedulePeriod.cumulative_supply":
+		x.CumulativeSupply = value.Interface().(string)
+	case "nibiru.inflation.v1.InflationSchedulePeriod.annualized_rate":
+		x.AnnualizedRate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationSchedulePeriod"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationSchedulePeriod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationSchedulePeriod) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationSchedulePeriod.period":
+		panic(fmt.Errorf("field period of message nibiru.inflation.v1.InflationSchedulePeriod is not mutable"))
+	case "nibiru.inflation.v1.InflationSchedulePeriod.num_epochs":
+		panic(fmt.Errorf("field num_epochs of message nibiru.inflation.v1.InflationSchedulePeriod is not mutable"))
+	case "nibiru.inflation.v1.InflationSchedulePeriod.epoch_mint_provision":
+		panic(fmt.Errorf("field epoch_mint_provision of message nibiru.inflation.v1.InflationSchedulePeriod is not mutable"))
+	case "nibiru.inflation.v1.InflationSchedulePeriod.period_mint":
+		panic(fmt.Errorf("field period_mint of message nibiru.inflation.v1.InflationSchedulePeriod is not mutable"))
+	case "nibiru.inflation.v1.InflationSchedulePeriod.cumulative_supply":
+		panic(fmt.Errorf("field cumulative_supply of message nibiru.inflation.v1.InflationSchedulePeriod is not mutable"))
+	case "nibiru.inflation.v1.InflationSchedulePeriod.annualized_rate":
+		panic(fmt.Errorf("field annualized_rate of message nibiru.inflation.v1.InflationSchedulePeriod is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationSchedulePeriod"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationSchedulePeriod does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InflationSchedulePeriod) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationSchedulePeriod.period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.inflation.v1.InflationSchedulePeriod.num_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.inflation.v1.InflationSchedulePeriod.epoch_mint_provision":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.InflationSchedulePeriod.period_mint":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.InflationSchedulePeriod.cumulative_supply":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.InflationSchedulePeriod.annualized_rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationSchedulePeriod"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationSchedulePeriod does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InflationSchedulePeriod) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.InflationSchedulePeriod", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InflationSchedulePeriod) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationSchedulePeriod) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InflationSchedulePeriod) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InflationSchedulePeriod) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InflationSchedulePeriod)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Period != 0 {
+			n += 1 + runtime.Sov(uint64(x.Period))
+		}
+		if x.NumEpochs != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumEpochs))
+		}
+		l = len(x.EpochMintProvision)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PeriodMint)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CumulativeSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AnnualizedRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InflationSchedulePeriod)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AnnualizedRate) > 0 {
+			i -= len(x.AnnualizedRate)
+			copy(dAtA[i:], x.AnnualizedRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AnnualizedRate)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.CumulativeSupply) > 0 {
+			i -= len(x.CumulativeSupply)
+			copy(dAtA[i:], x.CumulativeSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CumulativeSupply)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.PeriodMint) > 0 {
+			i -= len(x.PeriodMint)
+			copy(dAtA[i:], x.PeriodMint)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PeriodMint)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.EpochMintProvision) > 0 {
+			i -= len(x.EpochMintProvision)
+			copy(dAtA[i:], x.EpochMintProvision)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EpochMintProvision)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.NumEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumEpochs))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Period != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Period))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InflationSchedulePeriod)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationSchedulePeriod: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationSchedulePeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				}
+				x.Period = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Period |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+				}
+				x.NumEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EpochMintProvision = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PeriodMint", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PeriodMint = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CumulativeSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CumulativeSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnnualizedRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AnnualizedRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
 		}
 
 		if iNdEx > l {
@@ -3912,7 +7311,7 @@ func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_nibiru_inflation_v1_query_proto_msgTypes[10]
+	mi := &file_nibiru_inflation_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4270,7 +7669,7 @@ func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_nibiru_inflation_v1_query_proto_msgTypes[11]
+	mi := &file_nibiru_inflation_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4790,239 +8189,575 @@ func (*QueryEpochMintProvisionRequest) Descriptor() ([]byte, []int) {
 	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{2}
 }
 
-// QueryEpochMintProvisionResponse is the response type for the
-// Query/EpochMintProvision RPC method.
-type QueryEpochMintProvisionResponse struct {
+// QueryEpochMintProvisionResponse is the response type for the
+// Query/EpochMintProvision RPC method.
+type QueryEpochMintProvisionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// epoch_mint_provision is the current minting per epoch provision value.
+	EpochMintProvision *v1beta1.DecCoin `protobuf:"bytes,1,opt,name=epoch_mint_provision,json=epochMintProvision,proto3" json:"epoch_mint_provision,omitempty"`
+}
+
+func (x *QueryEpochMintProvisionResponse) Reset() {
+	*x = QueryEpochMintProvisionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEpochMintProvisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEpochMintProvisionResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryEpochMintProvisionResponse.ProtoReflect.Descriptor instead.
+func (*QueryEpochMintProvisionResponse) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryEpochMintProvisionResponse) GetEpochMintProvision() *v1beta1.DecCoin {
+	if x != nil {
+		return x.EpochMintProvision
+	}
+	return nil
+}
+
+// QuerySkippedEpochsRequest is the request type for the Query/SkippedEpochs RPC
+// method.
+type QuerySkippedEpochsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QuerySkippedEpochsRequest) Reset() {
+	*x = QuerySkippedEpochsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySkippedEpochsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySkippedEpochsRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySkippedEpochsRequest.ProtoReflect.Descriptor instead.
+func (*QuerySkippedEpochsRequest) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+// QuerySkippedEpochsResponse is the response type for the Query/SkippedEpochs
+// RPC method.
+type QuerySkippedEpochsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// skipped_epochs is the number of epochs that the inflation module has been
+	// disabled.
+	SkippedEpochs uint64 `protobuf:"varint,1,opt,name=skipped_epochs,json=skippedEpochs,proto3" json:"skipped_epochs,omitempty"`
+}
+
+func (x *QuerySkippedEpochsResponse) Reset() {
+	*x = QuerySkippedEpochsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySkippedEpochsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySkippedEpochsResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySkippedEpochsResponse.ProtoReflect.Descriptor instead.
+func (*QuerySkippedEpochsResponse) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QuerySkippedEpochsResponse) GetSkippedEpochs() uint64 {
+	if x != nil {
+		return x.SkippedEpochs
+	}
+	return 0
+}
+
+// QueryCirculatingSupplyRequest is the request type for the
+// Query/CirculatingSupply RPC method.
+type QueryCirculatingSupplyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryCirculatingSupplyRequest) Reset() {
+	*x = QueryCirculatingSupplyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCirculatingSupplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCirculatingSupplyRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryCirculatingSupplyRequest.ProtoReflect.Descriptor instead.
+func (*QueryCirculatingSupplyRequest) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+// QueryCirculatingSupplyResponse is the response type for the
+// Query/CirculatingSupply RPC method.
+type QueryCirculatingSupplyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// circulating_supply is the total amount of coins in circulation
+	CirculatingSupply *v1beta1.DecCoin `protobuf:"bytes,1,opt,name=circulating_supply,json=circulatingSupply,proto3" json:"circulating_supply,omitempty"`
+}
+
+func (x *QueryCirculatingSupplyResponse) Reset() {
+	*x = QueryCirculatingSupplyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCirculatingSupplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCirculatingSupplyResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryCirculatingSupplyResponse.ProtoReflect.Descriptor instead.
+func (*QueryCirculatingSupplyResponse) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryCirculatingSupplyResponse) GetCirculatingSupply() *v1beta1.DecCoin {
+	if x != nil {
+		return x.CirculatingSupply
+	}
+	return nil
+}
+
+// QueryCirculatingSupplyBreakdownRequest is the request type for the
+// Query/CirculatingSupplyBreakdown RPC method.
+type QueryCirculatingSupplyBreakdownRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryCirculatingSupplyBreakdownRequest) Reset() {
+	*x = QueryCirculatingSupplyBreakdownRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCirculatingSupplyBreakdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCirculatingSupplyBreakdownRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryCirculatingSupplyBreakdownRequest.ProtoReflect.Descriptor instead.
+func (*QueryCirculatingSupplyBreakdownRequest) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+// QueryCirculatingSupplyBreakdownResponse is the response type for the
+// Query/CirculatingSupplyBreakdown RPC method. The circulating supply equals
+// the total supply minus the excluded balances and the unvested amount.
+type QueryCirculatingSupplyBreakdownResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// total_supply is the bank supply of the mint denom.
+	TotalSupply *v1beta1.Coin `protobuf:"bytes,1,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	// circulating_supply is the amount of the mint denom in circulation.
+	CirculatingSupply *v1beta1.Coin `protobuf:"bytes,2,opt,name=circulating_supply,json=circulatingSupply,proto3" json:"circulating_supply,omitempty"`
+	// unvested is the amount still locked in vesting accounts that are not
+	// otherwise excluded.
+	Unvested *v1beta1.Coin `protobuf:"bytes,3,opt,name=unvested,proto3" json:"unvested,omitempty"`
+	// excluded lists the balances of the excluded accounts.
+	Excluded []*ExcludedBalance `protobuf:"bytes,4,rep,name=excluded,proto3" json:"excluded,omitempty"`
+}
+
+func (x *QueryCirculatingSupplyBreakdownResponse) Reset() {
+	*x = QueryCirculatingSupplyBreakdownResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCirculatingSupplyBreakdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCirculatingSupplyBreakdownResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryCirculatingSupplyBreakdownResponse.ProtoReflect.Descriptor instead.
+func (*QueryCirculatingSupplyBreakdownResponse) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryCirculatingSupplyBreakdownResponse) GetTotalSupply() *v1beta1.Coin {
+	if x != nil {
+		return x.TotalSupply
+	}
+	return nil
+}
+
+func (x *QueryCirculatingSupplyBreakdownResponse) GetCirculatingSupply() *v1beta1.Coin {
+	if x != nil {
+		return x.CirculatingSupply
+	}
+	return nil
+}
+
+func (x *QueryCirculatingSupplyBreakdownResponse) GetUnvested() *v1beta1.Coin {
+	if x != nil {
+		return x.Unvested
+	}
+	return nil
+}
+
+func (x *QueryCirculatingSupplyBreakdownResponse) GetExcluded() []*ExcludedBalance {
+	if x != nil {
+		return x.Excluded
+	}
+	return nil
+}
+
+// ExcludedBalance is the balance of an account excluded from the circulating
+// supply.
+type ExcludedBalance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// epoch_mint_provision is the current minting per epoch provision value.
-	EpochMintProvision *v1beta1.DecCoin `protobuf:"bytes,1,opt,name=epoch_mint_provision,json=epochMintProvision,proto3" json:"epoch_mint_provision,omitempty"`
+	// address is the bech32 address of the excluded account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// label names the reason for the exclusion: a module account name,
+	// "strategic_reserve", or "address" for an explicitly listed address.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// balance is the balance of the mint denom held by the account.
+	Balance *v1beta1.Coin `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
-func (x *QueryEpochMintProvisionResponse) Reset() {
-	*x = QueryEpochMintProvisionResponse{}
+func (x *ExcludedBalance) Reset() {
+	*x = ExcludedBalance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[3]
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryEpochMintProvisionResponse) String() string {
+func (x *ExcludedBalance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryEpochMintProvisionResponse) ProtoMessage() {}
+func (*ExcludedBalance) ProtoMessage() {}
 
-// Deprecated: Use QueryEpochMintProvisionResponse.ProtoReflect.Descriptor instead.
-func (*QueryEpochMintProvisionResponse) Descriptor() ([]byte, []int) {
-	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ExcludedBalance.ProtoReflect.Descriptor instead.
+func (*ExcludedBalance) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *QueryEpochMintProvisionResponse) GetEpochMintProvision() *v1beta1.DecCoin {
+func (x *ExcludedBalance) GetAddress() string {
 	if x != nil {
-		return x.EpochMintProvision
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ExcludedBalance) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ExcludedBalance) GetBalance() *v1beta1.Coin {
+	if x != nil {
+		return x.Balance
 	}
 	return nil
 }
 
-// QuerySkippedEpochsRequest is the request type for the Query/SkippedEpochs RPC
+// QueryInflationRateRequest is the request type for the Query/InflationRate RPC
 // method.
-type QuerySkippedEpochsRequest struct {
+type QueryInflationRateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *QuerySkippedEpochsRequest) Reset() {
-	*x = QuerySkippedEpochsRequest{}
+func (x *QueryInflationRateRequest) Reset() {
+	*x = QueryInflationRateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[4]
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySkippedEpochsRequest) String() string {
+func (x *QueryInflationRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySkippedEpochsRequest) ProtoMessage() {}
+func (*QueryInflationRateRequest) ProtoMessage() {}
 
-// Deprecated: Use QuerySkippedEpochsRequest.ProtoReflect.Descriptor instead.
-func (*QuerySkippedEpochsRequest) Descriptor() ([]byte, []int) {
-	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryInflationRateRequest.ProtoReflect.Descriptor instead.
+func (*QueryInflationRateRequest) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{11}
 }
 
-// QuerySkippedEpochsResponse is the response type for the Query/SkippedEpochs
+// QueryInflationRateResponse is the response type for the Query/InflationRate
 // RPC method.
-type QuerySkippedEpochsResponse struct {
+type QueryInflationRateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// skipped_epochs is the number of epochs that the inflation module has been
-	// disabled.
-	SkippedEpochs uint64 `protobuf:"varint,1,opt,name=skipped_epochs,json=skippedEpochs,proto3" json:"skipped_epochs,omitempty"`
+	// inflation_rate by which the total supply increases within one period
+	InflationRate string `protobuf:"bytes,1,opt,name=inflation_rate,json=inflationRate,proto3" json:"inflation_rate,omitempty"`
 }
 
-func (x *QuerySkippedEpochsResponse) Reset() {
-	*x = QuerySkippedEpochsResponse{}
+func (x *QueryInflationRateResponse) Reset() {
+	*x = QueryInflationRateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[5]
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySkippedEpochsResponse) String() string {
+func (x *QueryInflationRateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySkippedEpochsResponse) ProtoMessage() {}
+func (*QueryInflationRateResponse) ProtoMessage() {}
 
-// Deprecated: Use QuerySkippedEpochsResponse.ProtoReflect.Descriptor instead.
-func (*QuerySkippedEpochsResponse) Descriptor() ([]byte, []int) {
-	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryInflationRateResponse.ProtoReflect.Descriptor instead.
+func (*QueryInflationRateResponse) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *QuerySkippedEpochsResponse) GetSkippedEpochs() uint64 {
+func (x *QueryInflationRateResponse) GetInflationRate() string {
 	if x != nil {
-		return x.SkippedEpochs
+		return x.InflationRate
 	}
-	return 0
+	return ""
 }
 
-// QueryCirculatingSupplyRequest is the request type for the
-// Query/CirculatingSupply RPC method.
-type QueryCirculatingSupplyRequest struct {
+// QueryInflationScheduleRequest is the request type for the
+// Query/InflationSchedule RPC method.
+type QueryInflationScheduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// num_periods is the number of periods to project, starting with the
+	// current one. Zero projects every period until max_period.
+	NumPeriods uint64 `protobuf:"varint,1,opt,name=num_periods,json=numPeriods,proto3" json:"num_periods,omitempty"`
 }
 
-func (x *QueryCirculatingSupplyRequest) Reset() {
-	*x = QueryCirculatingSupplyRequest{}
+func (x *QueryInflationScheduleRequest) Reset() {
+	*x = QueryInflationScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[6]
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCirculatingSupplyRequest) String() string {
+func (x *QueryInflationScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCirculatingSupplyRequest) ProtoMessage() {}
+func (*QueryInflationScheduleRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryCirculatingSupplyRequest.ProtoReflect.Descriptor instead.
-func (*QueryCirculatingSupplyRequest) Descriptor() ([]byte, []int) {
-	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryInflationScheduleRequest.ProtoReflect.Descriptor instead.
+func (*QueryInflationScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
-// QueryCirculatingSupplyResponse is the response type for the
-// Query/CirculatingSupply RPC method.
-type QueryCirculatingSupplyResponse struct {
+func (x *QueryInflationScheduleRequest) GetNumPeriods() uint64 {
+	if x != nil {
+		return x.NumPeriods
+	}
+	return 0
+}
+
+// QueryInflationScheduleResponse is the response type for the
+// Query/InflationSchedule RPC method.
+type QueryInflationScheduleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// circulating_supply is the total amount of coins in circulation
-	CirculatingSupply *v1beta1.DecCoin `protobuf:"bytes,1,opt,name=circulating_supply,json=circulatingSupply,proto3" json:"circulating_supply,omitempty"`
+	// total_supply is the current bank supply of the mint denom, which the
+	// projection starts from.
+	TotalSupply *v1beta1.Coin `protobuf:"bytes,1,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	// periods is the projected schedule, one entry per period.
+	Periods []*InflationSchedulePeriod `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`
+	// circulating_supply is the current circulating supply of the mint denom,
+	// which the annualized rates of the projection start from.
+	CirculatingSupply string `protobuf:"bytes,3,opt,name=circulating_supply,json=circulatingSupply,proto3" json:"circulating_supply,omitempty"`
 }
 
-func (x *QueryCirculatingSupplyResponse) Reset() {
-	*x = QueryCirculatingSupplyResponse{}
+func (x *QueryInflationScheduleResponse) Reset() {
+	*x = QueryInflationScheduleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[7]
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCirculatingSupplyResponse) String() string {
+func (x *QueryInflationScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCirculatingSupplyResponse) ProtoMessage() {}
+func (*QueryInflationScheduleResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryCirculatingSupplyResponse.ProtoReflect.Descriptor instead.
-func (*QueryCirculatingSupplyResponse) Descriptor() ([]byte, []int) {
-	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryInflationScheduleResponse.ProtoReflect.Descriptor instead.
+func (*QueryInflationScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryCirculatingSupplyResponse) GetCirculatingSupply() *v1beta1.DecCoin {
+func (x *QueryInflationScheduleResponse) GetTotalSupply() *v1beta1.Coin {
 	if x != nil {
-		return x.CirculatingSupply
+		return x.TotalSupply
 	}
 	return nil
 }
 
-// QueryInflationRateRequest is the request type for the Query/InflationRate RPC
-// method.
-type QueryInflationRateRequest struct {
+func (x *QueryInflationScheduleResponse) GetPeriods() []*InflationSchedulePeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *QueryInflationScheduleResponse) GetCirculatingSupply() string {
+	if x != nil {
+		return x.CirculatingSupply
+	}
+	return ""
+}
+
+// InflationSchedulePeriod is the projected inflation of a single period.
+type InflationSchedulePeriod struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *QueryInflationRateRequest) Reset() {
-	*x = QueryInflationRateRequest{}
+	// period is the inflation period number.
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// num_epochs is the number of epochs of the period that have yet to mint.
+	// It is lower than epochs_per_period for the current period when some of
+	// its epochs already minted.
+	NumEpochs uint64 `protobuf:"varint,2,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
+	// epoch_mint_provision is the amount minted per epoch.
+	EpochMintProvision string `protobuf:"bytes,3,opt,name=epoch_mint_provision,json=epochMintProvision,proto3" json:"epoch_mint_provision,omitempty"`
+	// period_mint is the amount minted by the num_epochs epochs.
+	PeriodMint string `protobuf:"bytes,4,opt,name=period_mint,json=periodMint,proto3" json:"period_mint,omitempty"`
+	// cumulative_supply is the projected total supply at the end of the period.
+	CumulativeSupply string `protobuf:"bytes,5,opt,name=cumulative_supply,json=cumulativeSupply,proto3" json:"cumulative_supply,omitempty"`
+	// annualized_rate is the inflation rate in percent implied by the epoch
+	// mint provision, relative to the circulating supply at the start of the
+	// period, like the InflationRate query. Minted coins are assumed to
+	// circulate.
+	AnnualizedRate string `protobuf:"bytes,6,opt,name=annualized_rate,json=annualizedRate,proto3" json:"annualized_rate,omitempty"`
+}
+
+func (x *InflationSchedulePeriod) Reset() {
+	*x = InflationSchedulePeriod{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[8]
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryInflationRateRequest) String() string {
+func (x *InflationSchedulePeriod) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryInflationRateRequest) ProtoMessage() {}
+func (*InflationSchedulePeriod) ProtoMessage() {}
 
-// Deprecated: Use QueryInflationRateRequest.ProtoReflect.Descriptor instead.
-func (*QueryInflationRateRequest) Descriptor() ([]byte, []int) {
-	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use InflationSchedulePeriod.ProtoReflect.Descriptor instead.
+func (*InflationSchedulePeriod) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
-// QueryInflationRateResponse is the response type for the Query/InflationRate
-// RPC method.
-type QueryInflationRateResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// inflation_rate by which the total supply increases within one period
-	InflationRate string `protobuf:"bytes,1,opt,name=inflation_rate,json=inflationRate,proto3" json:"inflation_rate,omitempty"`
+func (x *InflationSchedulePeriod) GetPeriod() uint64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
 }
 
-func (x *QueryInflationRateResponse) Reset() {
-	*x = QueryInflationRateResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *InflationSchedulePeriod) GetNumEpochs() uint64 {
+	if x != nil {
+		return x.NumEpochs
 	}
+	return 0
 }
 
-func (x *QueryInflationRateResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InflationSchedulePeriod) GetEpochMintProvision() string {
+	if x != nil {
+		return x.EpochMintProvision
+	}
+	return ""
 }
 
-func (*QueryInflationRateResponse) ProtoMessage() {}
+func (x *InflationSchedulePeriod) GetPeriodMint() string {
+	if x != nil {
+		return x.PeriodMint
+	}
+	return ""
+}
 
-// Deprecated: Use QueryInflationRateResponse.ProtoReflect.Descriptor instead.
-func (*QueryInflationRateResponse) Descriptor() ([]byte, []int) {
-	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{9}
+func (x *InflationSchedulePeriod) GetCumulativeSupply() string {
+	if x != nil {
+		return x.CumulativeSupply
+	}
+	return ""
 }
 
-func (x *QueryInflationRateResponse) GetInflationRate() string {
+func (x *InflationSchedulePeriod) GetAnnualizedRate() string {
 	if x != nil {
-		return x.InflationRate
+		return x.AnnualizedRate
 	}
 	return ""
 }
@@ -5037,7 +8772,7 @@ type QueryParamsRequest struct {
 func (x *QueryParamsRequest) Reset() {
 	*x = QueryParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[10]
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5051,7 +8786,7 @@ func (*QueryParamsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{10}
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
 // QueryParamsResponse is the response type for the Query/Params RPC method.
@@ -5067,7 +8802,7 @@ type QueryParamsResponse struct {
 func (x *QueryParamsResponse) Reset() {
 	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[11]
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5081,7 +8816,7 @@ func (*QueryParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{11}
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueryParamsResponse) GetParams() *Params {
@@ -5142,95 +8877,198 @@ var file_nibiru_inflation_v1_query_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
 	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52,
 	0x11, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70,
-	0x6c, 0x79, 0x22, 0x1b, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x76, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a,
-	0x0e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a,
-	0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x32,
-	0xb2, 0x07, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x80, 0x01, 0x0a, 0x06, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x12, 0x27, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e,
+	0x6c, 0x79, 0x22, 0x28, 0x0a, 0x26, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x69, 0x72, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc2, 0x02, 0x0a,
+	0x27, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x4e, 0x0a, 0x12,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x08,
+	0x75, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x08, 0x75, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x08, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x69,
+	0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x22, 0x7c, 0x0a, 0x0f, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x1b, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x76, 0x0a, 0x1a,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0e, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x61, 0x74, 0x65, 0x22, 0x40, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0x8e, 0x02, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x4c, 0x0a,
+	0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x5a, 0x0a, 0x12, 0x63,
+	0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0x52, 0x11, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x22, 0xb9, 0x03, 0x0a, 0x17, 0x49, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
+	0x75, 0x6d, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x6e, 0x75, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x63, 0x0a, 0x14, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x12, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x4c, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x58, 0x0a,
+	0x11, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x10, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x5a, 0x0a, 0x0f, 0x61, 0x6e, 0x6e, 0x75, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x0e, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x52,
+	0x61, 0x74, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x13, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x32, 0xad, 0x0a, 0x0a, 0x05,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x80, 0x01, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x27, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x69, 0x62, 0x69,
+	0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6e, 0x69,
+	0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0xb2, 0x01, 0x0a, 0x12, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x33, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e,
 	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
-	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
-	0x1b, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0xb2, 0x01, 0x0a,
-	0x12, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
-	0x70, 0x6f, 0x63, 0x68, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72,
-	0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f,
-	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x9d, 0x01, 0x0a, 0x0d, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x45, 0x70, 0x6f,
-	0x63, 0x68, 0x73, 0x12, 0x2e, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x6e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x73, 0x12, 0xad, 0x01, 0x0a, 0x11, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2b, 0x12, 0x29, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6d,
+	0x69, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x9d, 0x01,
+	0x0a, 0x0d, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12,
+	0x2e, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0xad, 0x01,
+	0x0a, 0x11, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x12, 0x32, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
 	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
 	0x65, 0x72, 0x79, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75,
-	0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6e, 0x69,
-	0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72,
-	0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63,
-	0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c,
-	0x79, 0x12, 0x9d, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x61, 0x74, 0x65, 0x12, 0x2e, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x6e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x76, 0x31, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x12, 0x80, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x2e, 0x6e,
+	0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0xd2, 0x01,
+	0x0a, 0x1a, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x3b, 0x2e, 0x6e,
+	0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x6e, 0x69, 0x62, 0x69,
+	0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12,
+	0x31, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x2f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x12, 0x9d, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f,
+	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x12, 0xa3, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x32, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6e,
+	0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x6e, 0x69, 0x62, 0x69,
+	0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x27, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e,
 	0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
 	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
-	0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x42, 0xc5, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x69, 0x62,
-	0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b,
 	0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x4e, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e,
-	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x3a, 0x3a, 0x49,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc5, 0x01, 0x0a, 0x17,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x49, 0x58, 0xaa, 0x02, 0x13,
+	0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4e, 0x69, 0x62, 0x69,
+	0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4e, 0x69,
+	0x62, 0x69, 0x72, 0x75, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5245,44 +9083,62 @@ func file_nibiru_inflation_v1_query_proto_rawDescGZIP() []byte {
 	return file_nibiru_inflation_v1_query_proto_rawDescData
 }
 
-var file_nibiru_inflation_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_nibiru_inflation_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_nibiru_inflation_v1_query_proto_goTypes = []interface{}{
-	(*QueryPeriodRequest)(nil),              // 0: nibiru.inflation.v1.QueryPeriodRequest
-	(*QueryPeriodResponse)(nil),             // 1: nibiru.inflation.v1.QueryPeriodResponse
-	(*QueryEpochMintProvisionRequest)(nil),  // 2: nibiru.inflation.v1.QueryEpochMintProvisionRequest
-	(*QueryEpochMintProvisionResponse)(nil), // 3: nibiru.inflation.v1.QueryEpochMintProvisionResponse
-	(*QuerySkippedEpochsRequest)(nil),       // 4: nibiru.inflation.v1.QuerySkippedEpochsRequest
-	(*QuerySkippedEpochsResponse)(nil),      // 5: nibiru.inflation.v1.QuerySkippedEpochsResponse
-	(*QueryCirculatingSupplyRequest)(nil),   // 6: nibiru.inflation.v1.QueryCirculatingSupplyRequest
-	(*QueryCirculatingSupplyResponse)(nil),  // 7: nibiru.inflation.v1.QueryCirculatingSupplyResponse
-	(*QueryInflationRateRequest)(nil),       // 8: nibiru.inflation.v1.QueryInflationRateRequest
-	(*QueryInflationRateResponse)(nil),      // 9: nibiru.inflation.v1.QueryInflationRateResponse
-	(*QueryParamsRequest)(nil),              // 10: nibiru.inflation.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),             // 11: nibiru.inflation.v1.QueryParamsResponse
-	(*v1beta1.DecCoin)(nil),                 // 12: cosmos.base.v1beta1.DecCoin
-	(*Params)(nil),                          // 13: nibiru.inflation.v1.Params
+	(*QueryPeriodRequest)(nil),                      // 0: nibiru.inflation.v1.QueryPeriodRequest
+	(*QueryPeriodResponse)(nil),                     // 1: nibiru.inflation.v1.QueryPeriodResponse
+	(*QueryEpochMintProvisionRequest)(nil),          // 2: nibiru.inflation.v1.QueryEpochMintProvisionRequest
+	(*QueryEpochMintProvisionResponse)(nil),         // 3: nibiru.inflation.v1.QueryEpochMintProvisionResponse
+	(*QuerySkippedEpochsRequest)(nil),               // 4: nibiru.inflation.v1.QuerySkippedEpochsRequest
+	(*QuerySkippedEpochsResponse)(nil),              // 5: nibiru.inflation.v1.QuerySkippedEpochsResponse
+	(*QueryCirculatingSupplyRequest)(nil),           // 6: nibiru.inflation.v1.QueryCirculatingSupplyRequest
+	(*QueryCirculatingSupplyResponse)(nil),          // 7: nibiru.inflation.v1.QueryCirculatingSupplyResponse
+	(*QueryCirculatingSupplyBreakdownRequest)(nil),  // 8: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest
+	(*QueryCirculatingSupplyBreakdownResponse)(nil), // 9: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse
+	(*ExcludedBalance)(nil),                         // 10: nibiru.inflation.v1.ExcludedBalance
+	(*QueryInflationRateRequest)(nil),               // 11: nibiru.inflation.v1.QueryInflationRateRequest
+	(*QueryInflationRateResponse)(nil),              // 12: nibiru.inflation.v1.QueryInflationRateResponse
+	(*QueryInflationScheduleRequest)(nil),           // 13: nibiru.inflation.v1.QueryInflationScheduleRequest
+	(*QueryInflationScheduleResponse)(nil),          // 14: nibiru.inflation.v1.QueryInflationScheduleResponse
+	(*InflationSchedulePeriod)(nil),                 // 15: nibiru.inflation.v1.InflationSchedulePeriod
+	(*QueryParamsRequest)(nil),                      // 16: nibiru.inflation.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),                     // 17: nibiru.inflation.v1.QueryParamsResponse
+	(*v1beta1.DecCoin)(nil),                         // 18: cosmos.base.v1beta1.DecCoin
+	(*v1beta1.Coin)(nil),                            // 19: cosmos.base.v1beta1.Coin
+	(*Params)(nil),                                  // 20: nibiru.inflation.v1.Params
 }
 var file_nibiru_inflation_v1_query_proto_depIdxs = []int32{
-	12, // 0: nibiru.inflation.v1.QueryEpochMintProvisionResponse.epoch_mint_provision:type_name -> cosmos.base.v1beta1.DecCoin
-	12, // 1: nibiru.inflation.v1.QueryCirculatingSupplyResponse.circulating_supply:type_name -> cosmos.base.v1beta1.DecCoin
-	13, // 2: nibiru.inflation.v1.QueryParamsResponse.params:type_name -> nibiru.inflation.v1.Params
-	0,  // 3: nibiru.inflation.v1.Query.Period:input_type -> nibiru.inflation.v1.QueryPeriodRequest
-	2,  // 4: nibiru.inflation.v1.Query.EpochMintProvision:input_type -> nibiru.inflation.v1.QueryEpochMintProvisionRequest
-	4,  // 5: nibiru.inflation.v1.Query.SkippedEpochs:input_type -> nibiru.inflation.v1.QuerySkippedEpochsRequest
-	6,  // 6: nibiru.inflation.v1.Query.CirculatingSupply:input_type -> nibiru.inflation.v1.QueryCirculatingSupplyRequest
-	8,  // 7: nibiru.inflation.v1.Query.InflationRate:input_type -> nibiru.inflation.v1.QueryInflationRateRequest
-	10, // 8: nibiru.inflation.v1.Query.Params:input_type -> nibiru.inflation.v1.QueryParamsRequest
-	1,  // 9: nibiru.inflation.v1.Query.Period:output_type -> nibiru.inflation.v1.QueryPeriodResponse
-	3,  // 10: nibiru.inflation.v1.Query.EpochMintProvision:output_type -> nibiru.inflation.v1.QueryEpochMintProvisionResponse
-	5,  // 11: nibiru.inflation.v1.Query.SkippedEpochs:output_type -> nibiru.inflation.v1.QuerySkippedEpochsResponse
-	7,  // 12: nibiru.inflation.v1.Query.CirculatingSupply:output_type -> nibiru.inflation.v1.QueryCirculatingSupplyResponse
-	9,  // 13: nibiru.inflation.v1.Query.InflationRate:output_type -> nibiru.inflation.v1.QueryInflationRateResponse
-	11, // 14: nibiru.inflation.v1.Query.Params:output_type -> nibiru.inflation.v1.QueryParamsResponse
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	18, // 0: nibiru.inflation.v1.QueryEpochMintProvisionResponse.epoch_mint_provision:type_name -> cosmos.base.v1beta1.DecCoin
+	18, // 1: nibiru.inflation.v1.QueryCirculatingSupplyResponse.circulating_supply:type_name -> cosmos.base.v1beta1.DecCoin
+	19, // 2: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.total_supply:type_name -> cosmos.base.v1beta1.Coin
+	19, // 3: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.circulating_supply:type_name -> cosmos.base.v1beta1.Coin
+	19, // 4: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.unvested:type_name -> cosmos.base.v1beta1.Coin
+	10, // 5: nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse.excluded:type_name -> nibiru.inflation.v1.ExcludedBalance
+	19, // 6: nibiru.inflation.v1.ExcludedBalance.balance:type_name -> cosmos.base.v1beta1.Coin
+	19, // 7: nibiru.inflation.v1.QueryInflationScheduleResponse.total_supply:type_name -> cosmos.base.v1beta1.Coin
+	15, // 8: nibiru.inflation.v1.QueryInflationScheduleResponse.periods:type_name -> nibiru.inflation.v1.InflationSchedulePeriod
+	20, // 9: nibiru.inflation.v1.QueryParamsResponse.params:type_name -> nibiru.inflation.v1.Params
+	0,  // 10: nibiru.inflation.v1.Query.Period:input_type -> nibiru.inflation.v1.QueryPeriodRequest
+	2,  // 11: nibiru.inflation.v1.Query.EpochMintProvision:input_type -> nibiru.inflation.v1.QueryEpochMintProvisionRequest
+	4,  // 12: nibiru.inflation.v1.Query.SkippedEpochs:input_type -> nibiru.inflation.v1.QuerySkippedEpochsRequest
+	6,  // 13: nibiru.inflation.v1.Query.CirculatingSupply:input_type -> nibiru.inflation.v1.QueryCirculatingSupplyRequest
+	8,  // 14: nibiru.inflation.v1.Query.CirculatingSupplyBreakdown:input_type -> nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest
+	11, // 15: nibiru.inflation.v1.Query.InflationRate:input_type -> nibiru.inflation.v1.QueryInflationRateRequest
+	13, // 16: nibiru.inflation.v1.Query.InflationSchedule:input_type -> nibiru.inflation.v1.QueryInflationScheduleRequest
+	16, // 17: nibiru.inflation.v1.Query.Params:input_type -> nibiru.inflation.v1.QueryParamsRequest
+	1,  // 18: nibiru.inflation.v1.Query.Period:output_type -> nibiru.inflation.v1.QueryPeriodResponse
+	3,  // 19: nibiru.inflation.v1.Query.EpochMintProvision:output_type -> nibiru.inflation.v1.QueryEpochMintProvisionResponse
+	5,  // 20: nibiru.inflation.v1.Query.SkippedEpochs:output_type -> nibiru.inflation.v1.QuerySkippedEpochsResponse
+	7,  // 21: nibiru.inflation.v1.Query.CirculatingSupply:output_type -> nibiru.inflation.v1.QueryCirculatingSupplyResponse
+	9,  // 22: nibiru.inflation.v1.Query.CirculatingSupplyBreakdown:output_type -> nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse
+	12, // 23: nibiru.inflation.v1.Query.InflationRate:output_type -> nibiru.inflation.v1.QueryInflationRateResponse
+	14, // 24: nibiru.inflation.v1.Query.InflationSchedule:output_type -> nibiru.inflation.v1.QueryInflationScheduleResponse
+	17, // 25: nibiru.inflation.v1.Query.Params:output_type -> nibiru.inflation.v1.QueryParamsResponse
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_nibiru_inflation_v1_query_proto_init() }
@@ -5389,7 +9245,7 @@ func file_nibiru_inflation_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_inflation_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInflationRateRequest); i {
+			switch v := v.(*QueryCirculatingSupplyBreakdownRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5401,7 +9257,7 @@ func file_nibiru_inflation_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_inflation_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInflationRateResponse); i {
+			switch v := v.(*QueryCirculatingSupplyBreakdownResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5413,7 +9269,7 @@ func file_nibiru_inflation_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_inflation_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsRequest); i {
+			switch v := v.(*ExcludedBalance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5425,6 +9281,78 @@ func file_nibiru_inflation_v1_query_proto_init() {
 			}
 		}
 		file_nibiru_inflation_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInflationRateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_inflation_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInflationRateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_inflation_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInflationScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_inflation_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInflationScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_inflation_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InflationSchedulePeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_inflation_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_inflation_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryParamsResponse); i {
 			case 0:
 				return &v.state
@@ -5443,7 +9371,7 @@ func file_nibiru_inflation_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nibiru_inflation_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -31,8 +31,14 @@ type QueryClient interface {
 	// CirculatingSupply retrieves the total number of tokens that are in
 	// circulation (i.e. excluding unvested tokens).
 	CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error)
+	// CirculatingSupplyBreakdown retrieves the total supply together with the
+	// amounts subtracted from it to compute the circulating supply.
+	CirculatingSupplyBreakdown(ctx context.Context, in *QueryCirculatingSupplyBreakdownRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyBreakdownResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
+	// InflationSchedule projects the inflation schedule for the next periods
+	// from the current state.
+	InflationSchedule(ctx context.Context, in *QueryInflationScheduleRequest, opts ...grpc.CallOption) (*QueryInflationScheduleResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -81,6 +87,15 @@ func (c *queryClient) CirculatingSupply(ctx context.Context, in *QueryCirculatin
 	return out, nil
 }
 
+func (c *queryClient) CirculatingSupplyBreakdown(ctx context.Context, in *QueryCirculatingSupplyBreakdownRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyBreakdownResponse, error) {
+	out := new(QueryCirculatingSupplyBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.inflation.v1.Query/CirculatingSupplyBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error) {
 	out := new(QueryInflationRateResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.inflation.v1.Query/InflationRate", in, out, opts...)
@@ -90,6 +105,15 @@ func (c *queryClient) InflationRate(ctx context.Context, in *QueryInflationRateR
 	return out, nil
 }
 
+func (c *queryClient) InflationSchedule(ctx context.Context, in *QueryInflationScheduleRequest, opts ...grpc.CallOption) (*QueryInflationScheduleResponse, error) {
+	out := new(QueryInflationScheduleResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.inflation.v1.Query/InflationSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.inflation.v1.Query/Params", in, out, opts...)
@@ -112,8 +136,14 @@ type QueryServer interface {
 	// CirculatingSupply retrieves the total number of tokens that are in
 	// circulation (i.e. excluding unvested tokens).
 	CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error)
+	// CirculatingSupplyBreakdown retrieves the total supply together with the
+	// amounts subtracted from it to compute the circulating supply.
+	CirculatingSupplyBreakdown(context.Context, *QueryCirculatingSupplyBreakdownRequest) (*QueryCirculatingSupplyBreakdownResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
+	// InflationSchedule projects the inflation schedule for the next periods
+	// from the current state.
+	InflationSchedule(context.Context, *QueryInflationScheduleRequest) (*QueryInflationScheduleResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -135,9 +165,15 @@ func (UnimplementedQueryServer) SkippedEpochs(context.Context, *QuerySkippedEpoc
 func (UnimplementedQueryServer) CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CirculatingSupply not implemented")
 }
+func (UnimplementedQueryServer) CirculatingSupplyBreakdown(context.Context, *QueryCirculatingSupplyBreakdownRequest) (*QueryCirculatingSupplyBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CirculatingSupplyBreakdown not implemented")
+}
 func (UnimplementedQueryServer) InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InflationRate not implemented")
 }
+func (UnimplementedQueryServer) InflationSchedule(context.Context, *QueryInflationScheduleRequest) (*QueryInflationScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationSchedule not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -226,6 +262,24 @@ func _Query_CirculatingSupply_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CirculatingSupplyBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCirculatingSupplyBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CirculatingSupplyBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.inflation.v1.Query/CirculatingSupplyBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CirculatingSupplyBreakdown(ctx, req.(*QueryCirculatingSupplyBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_InflationRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryInflationRateRequest)
 	if err := dec(in); err != nil {
@@ -244,6 +298,24 @@ func _Query_InflationRate_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InflationSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.inflation.v1.Query/InflationSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationSchedule(ctx, req.(*QueryInflationScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -285,10 +357,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CirculatingSupply",
 			Handler:    _Query_CirculatingSupply_Handler,
 		},
+		{
+			MethodName: "CirculatingSupplyBreakdown",
+			Handler:    _Query_CirculatingSupplyBreakdown_Handler,
+		},
 		{
 			MethodName: "InflationRate",
 			Handler:    _Query_InflationRate_Handler,
 		},
+		{
+			MethodName: "InflationSchedule",
+			Handler:    _Query_InflationSchedule_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -536,15 +536,69 @@ func (x *_MsgEditInflationParams_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_MsgEditInflationParams_9_list)(nil)
+
+type _MsgEditInflationParams_9_list struct {
+	list *[]*InflationRecipient
+}
+
+func (x *_MsgEditInflationParams_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgEditInflationParams_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgEditInflationParams_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationRecipient)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgEditInflationParams_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationRecipient)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgEditInflationParams_9_list) AppendMutable() protoreflect.Value {
+	v := new(InflationRecipient)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgEditInflationParams_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgEditInflationParams_9_list) NewElement() protoreflect.Value {
+	v := new(InflationRecipient)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgEditInflationParams_9_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgEditInflationParams                        protoreflect.MessageDescriptor
-	fd_MsgEditInflationParams_sender                 protoreflect.FieldDescriptor
-	fd_MsgEditInflationParams_inflation_enabled      protoreflect.FieldDescriptor
-	fd_MsgEditInflationParams_polynomial_factors     protoreflect.FieldDescriptor
-	fd_MsgEditInflationParams_inflation_distribution protoreflect.FieldDescriptor
-	fd_MsgEditInflationParams_epochs_per_period      protoreflect.FieldDescriptor
-	fd_MsgEditInflationParams_periods_per_year       protoreflect.FieldDescriptor
-	fd_MsgEditInflationParams_max_period             protoreflect.FieldDescriptor
+	md_MsgEditInflationParams                               protoreflect.MessageDescriptor
+	fd_MsgEditInflationParams_sender                        protoreflect.FieldDescriptor
+	fd_MsgEditInflationParams_inflation_enabled             protoreflect.FieldDescriptor
+	fd_MsgEditInflationParams_polynomial_factors            protoreflect.FieldDescriptor
+	fd_MsgEditInflationParams_inflation_distribution        protoreflect.FieldDescriptor
+	fd_MsgEditInflationParams_epochs_per_period             protoreflect.FieldDescriptor
+	fd_MsgEditInflationParams_periods_per_year              protoreflect.FieldDescriptor
+	fd_MsgEditInflationParams_max_period                    protoreflect.FieldDescriptor
+	fd_MsgEditInflationParams_circulating_supply_exclusions protoreflect.FieldDescriptor
+	fd_MsgEditInflationParams_inflation_recipients          protoreflect.FieldDescriptor
+	fd_MsgEditInflationParams_bonded_ratio_inflation        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -557,6 +611,9 @@ func init() {
 	fd_MsgEditInflationParams_epochs_per_period = md_MsgEditInflationParams.Fields().ByName("epochs_per_period")
 	fd_MsgEditInflationParams_periods_per_year = md_MsgEditInflationParams.Fields().ByName("periods_per_year")
 	fd_MsgEditInflationParams_max_period = md_MsgEditInflationParams.Fields().ByName("max_period")
+	fd_MsgEditInflationParams_circulating_supply_exclusions = md_MsgEditInflationParams.Fields().ByName("circulating_supply_exclusions")
+	fd_MsgEditInflationParams_inflation_recipients = md_MsgEditInflationParams.Fields().ByName("inflation_recipients")
+	fd_MsgEditInflationParams_bonded_ratio_inflation = md_MsgEditInflationParams.Fields().ByName("bonded_ratio_inflation")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgEditInflationParams)(nil)
@@ -666,6 +723,24 @@ func (x *fastReflection_MsgEditInflationParams) Range(f func(protoreflect.FieldD
 			return
 		}
 	}
+	if x.CirculatingSupplyExclusions != nil {
+		value := protoreflect.ValueOfMessage(x.CirculatingSupplyExclusions.ProtoReflect())
+		if !f(fd_MsgEditInflationParams_circulating_supply_exclusions, value) {
+			return
+		}
+	}
+	if len(x.InflationRecipients) != 0 {
+		value := protoreflect.ValueOfList(&_MsgEditInflationParams_9_list{list: &x.InflationRecipients})
+		if !f(fd_MsgEditInflationParams_inflation_recipients, value) {
+			return
+		}
+	}
+	if x.BondedRatioInflation != nil {
+		value := protoreflect.ValueOfMessage(x.BondedRatioInflation.ProtoReflect())
+		if !f(fd_MsgEditInflationParams_bonded_ratio_inflation, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -695,6 +770,12 @@ func (x *fastReflection_MsgEditInflationParams) Has(fd protoreflect.FieldDescrip
 		return x.PeriodsPerYear != ""
 	case "nibiru.inflation.v1.MsgEditInflationParams.max_period":
 		return x.MaxPeriod != ""
+	case "nibiru.inflation.v1.MsgEditInflationParams.circulating_supply_exclusions":
+		return x.CirculatingSupplyExclusions != nil
+	case "nibiru.inflation.v1.MsgEditInflationParams.inflation_recipients":
+		return len(x.InflationRecipients) != 0
+	case "nibiru.inflation.v1.MsgEditInflationParams.bonded_ratio_inflation":
+		return x.BondedRatioInflation != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.MsgEditInflationParams"))
@@ -725,6 +806,12 @@ func (x *fastReflection_MsgEditInflationParams) Clear(fd protoreflect.FieldDescr
 		x.PeriodsPerYear = ""
 	case "nibiru.inflation.v1.MsgEditInflationParams.max_period":
 		x.MaxPeriod = ""
+	case "nibiru.inflation.v1.MsgEditInflationParams.circulating_supply_exclusions":
+		x.CirculatingSupplyExclusions = nil
+	case "nibiru.inflation.v1.MsgEditInflationParams.inflation_recipients":
+		x.InflationRecipients = nil
+	case "nibiru.inflation.v1.MsgEditInflationParams.bonded_ratio_inflation":
+		x.BondedRatioInflation = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.MsgEditInflationParams"))
@@ -765,6 +852,18 @@ func (x *fastReflection_MsgEditInflationParams) Get(descriptor protoreflect.Fiel
 	case "nibiru.inflation.v1.MsgEditInflationParams.max_period":
 		value := x.MaxPeriod
 		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.MsgEditInflationParams.circulating_supply_exclusions":
+		value := x.CirculatingSupplyExclusions
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nibiru.inflation.v1.MsgEditInflationParams.inflation_recipients":
+		if len(x.InflationRecipients) == 0 {
+			return protoreflect.ValueOfList(&_MsgEditInflationParams_9_list{})
+		}
+		listValue := &_MsgEditInflationParams_9_list{list: &x.InflationRecipients}
+		return protoreflect.ValueOfList(listValue)
+	case "nibiru.inflation.v1.MsgEditInflationParams.bonded_ratio_inflation":
+		value := x.BondedRatioInflation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.MsgEditInflationParams"))
@@ -801,6 +900,14 @@ func (x *fastReflection_MsgEditInflationParams) Set(fd protoreflect.FieldDescrip
 		x.PeriodsPerYear = value.Interface().(string)
 	case "nibiru.inflation.v1.MsgEditInflationParams.max_period":
 		x.MaxPeriod = value.Interface().(string)
+	case "nibiru.inflation.v1.MsgEditInflationParams.circulating_supply_exclusions":
+		x.CirculatingSupplyExclusions = value.Message().Interface().(*CirculatingSupplyExclusions)
+	case "nibiru.inflation.v1.MsgEditInflationParams.inflation_recipients":
+		lv := value.List()
+		clv := lv.(*_MsgEditInflationParams_9_list)
+		x.InflationRecipients = *clv.list
+	case "nibiru.inflation.v1.MsgEditInflationParams.bonded_ratio_inflation":
+		x.BondedRatioInflation = value.Message().Interface().(*BondedRatioInflation)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.MsgEditInflationParams"))
@@ -832,6 +939,22 @@ func (x *fastReflection_MsgEditInflationParams) Mutable(fd protoreflect.FieldDes
 			x.InflationDistribution = new(InflationDistribution)
 		}
 		return protoreflect.ValueOfMessage(x.InflationDistribution.ProtoReflect())
+	case "nibiru.inflation.v1.MsgEditInflationParams.circulating_supply_exclusions":
+		if x.CirculatingSupplyExclusions == nil {
+			x.CirculatingSupplyExclusions = new(CirculatingSupplyExclusions)
+		}
+		return protoreflect.ValueOfMessage(x.CirculatingSupplyExclusions.ProtoReflect())
+	case "nibiru.inflation.v1.MsgEditInflationParams.inflation_recipients":
+		if x.InflationRecipients == nil {
+			x.InflationRecipients = []*InflationRecipient{}
+		}
+		value := &_MsgEditInflationParams_9_list{list: &x.InflationRecipients}
+		return protoreflect.ValueOfList(value)
+	case "nibiru.inflation.v1.MsgEditInflationParams.bonded_ratio_inflation":
+		if x.BondedRatioInflation == nil {
+			x.BondedRatioInflation = new(BondedRatioInflation)
+		}
+		return protoreflect.ValueOfMessage(x.BondedRatioInflation.ProtoReflect())
 	case "nibiru.inflation.v1.MsgEditInflationParams.sender":
 		panic(fmt.Errorf("field sender of message nibiru.inflation.v1.MsgEditInflationParams is not mutable"))
 	case "nibiru.inflation.v1.MsgEditInflationParams.inflation_enabled":
@@ -871,6 +994,15 @@ func (x *fastReflection_MsgEditInflationParams) NewField(fd protoreflect.FieldDe
 		return protoreflect.ValueOfString("")
 	case "nibiru.inflation.v1.MsgEditInflationParams.max_period":
 		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.MsgEditInflationParams.circulating_supply_exclusions":
+		m := new(CirculatingSupplyExclusions)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nibiru.inflation.v1.MsgEditInflationParams.inflation_recipients":
+		list := []*InflationRecipient{}
+		return protoreflect.ValueOfList(&_MsgEditInflationParams_9_list{list: &list})
+	case "nibiru.inflation.v1.MsgEditInflationParams.bonded_ratio_inflation":
+		m := new(BondedRatioInflation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.MsgEditInflationParams"))
@@ -969,6 +1101,20 @@ func (x *fastReflection_MsgEditInflationParams) ProtoMethods() *protoiface.Metho
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.CirculatingSupplyExclusions != nil {
+			l = options.Size(x.CirculatingSupplyExclusions)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.InflationRecipients) > 0 {
+			for _, e := range x.InflationRecipients {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BondedRatioInflation != nil {
+			l = options.Size(x.BondedRatioInflation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -998,6 +1144,50 @@ func (x *fastReflection_MsgEditInflationParams) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.BondedRatioInflation != nil {
+			encoded, err := options.Marshal(x.BondedRatioInflation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.InflationRecipients) > 0 {
+			for iNdEx := len(x.InflationRecipients) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InflationRecipients[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.CirculatingSupplyExclusions != nil {
+			encoded, err := options.Marshal(x.CirculatingSupplyExclusions)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
 		if len(x.MaxPeriod) > 0 {
 			i -= len(x.MaxPeriod)
 			copy(dAtA[i:], x.MaxPeriod)
@@ -1324,6 +1514,112 @@ func (x *fastReflection_MsgEditInflationParams) ProtoMethods() *protoiface.Metho
 				}
 				x.MaxPeriod = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupplyExclusions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CirculatingSupplyExclusions == nil {
+					x.CirculatingSupplyExclusions = &CirculatingSupplyExclusions{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CirculatingSupplyExclusions); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationRecipients", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationRecipients = append(x.InflationRecipients, &InflationRecipient{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InflationRecipients[len(x.InflationRecipients)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondedRatioInflation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BondedRatioInflation == nil {
+					x.BondedRatioInflation = &BondedRatioInflation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BondedRatioInflation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2988,13 +3284,20 @@ type MsgEditInflationParams struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender                string                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	InflationEnabled      bool                   `protobuf:"varint,2,opt,name=inflation_enabled,json=inflationEnabled,proto3" json:"inflation_enabled,omitempty"`
-	PolynomialFactors     []string               `protobuf:"bytes,3,rep,name=polynomial_factors,json=polynomialFactors,proto3" json:"polynomial_factors,omitempty"`
-	InflationDistribution *InflationDistribution `protobuf:"bytes,4,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution,omitempty"`
-	EpochsPerPeriod       string                 `protobuf:"bytes,5,opt,name=epochs_per_period,json=epochsPerPeriod,proto3" json:"epochs_per_period,omitempty"`
-	PeriodsPerYear        string                 `protobuf:"bytes,6,opt,name=periods_per_year,json=periodsPerYear,proto3" json:"periods_per_year,omitempty"`
-	MaxPeriod             string                 `protobuf:"bytes,7,opt,name=max_period,json=maxPeriod,proto3" json:"max_period,omitempty"`
+	Sender            string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	InflationEnabled  bool     `protobuf:"varint,2,opt,name=inflation_enabled,json=inflationEnabled,proto3" json:"inflation_enabled,omitempty"`
+	PolynomialFactors []string `protobuf:"bytes,3,rep,name=polynomial_factors,json=polynomialFactors,proto3" json:"polynomial_factors,omitempty"`
+	// Deprecated: use inflation_recipients. When set, the distribution is
+	// converted to the equivalent recipients.
+	//
+	// Deprecated: Do not use.
+	InflationDistribution       *InflationDistribution       `protobuf:"bytes,4,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution,omitempty"`
+	EpochsPerPeriod             string                       `protobuf:"bytes,5,opt,name=epochs_per_period,json=epochsPerPeriod,proto3" json:"epochs_per_period,omitempty"`
+	PeriodsPerYear              string                       `protobuf:"bytes,6,opt,name=periods_per_year,json=periodsPerYear,proto3" json:"periods_per_year,omitempty"`
+	MaxPeriod                   string                       `protobuf:"bytes,7,opt,name=max_period,json=maxPeriod,proto3" json:"max_period,omitempty"`
+	CirculatingSupplyExclusions *CirculatingSupplyExclusions `protobuf:"bytes,8,opt,name=circulating_supply_exclusions,json=circulatingSupplyExclusions,proto3" json:"circulating_supply_exclusions,omitempty"`
+	InflationRecipients         []*InflationRecipient        `protobuf:"bytes,9,rep,name=inflation_recipients,json=inflationRecipients,proto3" json:"inflation_recipients,omitempty"`
+	BondedRatioInflation        *BondedRatioInflation        `protobuf:"bytes,10,opt,name=bonded_ratio_inflation,json=bondedRatioInflation,proto3" json:"bonded_ratio_inflation,omitempty"`
 }
 
 func (x *MsgEditInflationParams) Reset() {
@@ -3038,6 +3341,7 @@ func (x *MsgEditInflationParams) GetPolynomialFactors() []string {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (x *MsgEditInflationParams) GetInflationDistribution() *InflationDistribution {
 	if x != nil {
 		return x.InflationDistribution
@@ -3066,6 +3370,27 @@ func (x *MsgEditInflationParams) GetMaxPeriod() string {
 	return ""
 }
 
+func (x *MsgEditInflationParams) GetCirculatingSupplyExclusions() *CirculatingSupplyExclusions {
+	if x != nil {
+		return x.CirculatingSupplyExclusions
+	}
+	return nil
+}
+
+func (x *MsgEditInflationParams) GetInflationRecipients() []*InflationRecipient {
+	if x != nil {
+		return x.InflationRecipients
+	}
+	return nil
+}
+
+func (x *MsgEditInflationParams) GetBondedRatioInflation() *BondedRatioInflation {
+	if x != nil {
+		return x.BondedRatioInflation
+	}
+	return nil
+}
+
 type MsgToggleInflationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3209,7 +3534,7 @@ var file_nibiru_inflation_v1_tx_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
 	0x22, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xae, 0x04, 0x0a, 0x16, 0x4d,
+	0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xf5, 0x06, 0x0a, 0x16, 0x4d,
 	0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
 	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2b, 0x0a,
@@ -3221,76 +3546,97 @@ var file_nibiru_inflation_v1_tx_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
 	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x11, 0x70, 0x6f, 0x6c, 0x79, 0x6e,
-	0x6f, 0x6d, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x67, 0x0a, 0x16,
+	0x6f, 0x6d, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x69, 0x0a, 0x16,
 	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
 	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e,
 	0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
 	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x15,
-	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x11, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x5f,
-	0x70, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x01, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2,
-	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0f, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x73, 0x50, 0x65, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x55,
-	0x0a, 0x10, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x79, 0x65,
-	0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x01, 0xda, 0xde,
-	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x50, 0x65,
-	0x72, 0x59, 0x65, 0x61, 0x72, 0x12, 0x4a, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x01, 0xda,
-	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x1c, 0x0a, 0x1a, 0x4d,
-	0x73, 0x67, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x73, 0x67,
-	0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x07, 0x4d,
-	0x73, 0x67, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x12, 0x42, 0x0a, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x13, 0xc8, 0xde, 0x1f, 0x00,
-	0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x52,
-	0x04, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x11, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x42, 0x75, 0x72, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc3, 0x02, 0x0a, 0x03, 0x4d, 0x73, 0x67,
-	0x12, 0x90, 0x01, 0x0a, 0x0f, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x2f, 0x2e,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0xc8, 0xde, 0x1f, 0x01, 0x18, 0x01,
+	0x52, 0x15, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x11, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x01, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52,
+	0x0f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x50, 0x65, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x55, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x79, 0x65, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x01,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0x50, 0x65, 0x72, 0x59, 0x65, 0x61, 0x72, 0x12, 0x4a, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f,
+	0x01, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x7a, 0x0a, 0x1d, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6e, 0x69, 0x62,
+	0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x01, 0x52, 0x1b, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x60, 0x0a, 0x14, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
 	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x1b, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f,
-	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x12, 0xa8, 0x01, 0x0a, 0x13, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2b, 0x2e, 0x6e, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x65, 0x0a, 0x16, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x61,
+	0x74, 0x69, 0x6f, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x01, 0x52, 0x14, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x49,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0,
+	0x1f, 0x00, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x49,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x78, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x29, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xf2,
+	0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x04, 0x63, 0x6f, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x13, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x52, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x11, 0x0a, 0x0f,
+	0x4d, 0x73, 0x67, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xc3, 0x02, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x90, 0x01, 0x0a, 0x0f, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x6e, 0x69,
 	0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x33, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72,
-	0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x27, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x2d, 0x69, 0x6e,
-	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc2,
-	0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x49, 0x58, 0xaa, 0x02, 0x13,
-	0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4e, 0x69, 0x62, 0x69,
-	0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4e, 0x69,
-	0x62, 0x69, 0x72, 0x75, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x2f, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x1b, 0x2f,
+	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0xa8, 0x01, 0x0a, 0x13, 0x45,
+	0x64, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x2b, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74,
+	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a,
+	0x33, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x27, 0x2f, 0x6e,
+	0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x65, 0x64, 0x69, 0x74, 0x2d, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc2, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x69,
+	0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e,
+	0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x4e, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x49, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4e, 0x69, 0x62,
+	0x69, 0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x1f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x15, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x3a, 0x3a, 0x49, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -3314,20 +3660,26 @@ var file_nibiru_inflation_v1_tx_proto_goTypes = []interface{}{
 	(*MsgBurn)(nil),                        // 4: nibiru.inflation.v1.MsgBurn
 	(*MsgBurnResponse)(nil),                // 5: nibiru.inflation.v1.MsgBurnResponse
 	(*InflationDistribution)(nil),          // 6: nibiru.inflation.v1.InflationDistribution
-	(*v1beta1.Coin)(nil),                   // 7: cosmos.base.v1beta1.Coin
+	(*CirculatingSupplyExclusions)(nil),    // 7: nibiru.inflation.v1.CirculatingSupplyExclusions
+	(*InflationRecipient)(nil),             // 8: nibiru.inflation.v1.InflationRecipient
+	(*BondedRatioInflation)(nil),           // 9: nibiru.inflation.v1.BondedRatioInflation
+	(*v1beta1.Coin)(nil),                   // 10: cosmos.base.v1beta1.Coin
 }
 var file_nibiru_inflation_v1_tx_proto_depIdxs = []int32{
-	6, // 0: nibiru.inflation.v1.MsgEditInflationParams.inflation_distribution:type_name -> nibiru.inflation.v1.InflationDistribution
-	7, // 1: nibiru.inflation.v1.MsgBurn.coin:type_name -> cosmos.base.v1beta1.Coin
-	0, // 2: nibiru.inflation.v1.Msg.ToggleInflation:input_type -> nibiru.inflation.v1.MsgToggleInflation
-	1, // 3: nibiru.inflation.v1.Msg.EditInflationParams:input_type -> nibiru.inflation.v1.MsgEditInflationParams
-	2, // 4: nibiru.inflation.v1.Msg.ToggleInflation:output_type -> nibiru.inflation.v1.MsgToggleInflationResponse
-	3, // 5: nibiru.inflation.v1.Msg.EditInflationParams:output_type -> nibiru.inflation.v1.MsgEditInflationParamsResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	6,  // 0: nibiru.inflation.v1.MsgEditInflationParams.inflation_distribution:type_name -> nibiru.inflation.v1.InflationDistribution
+	7,  // 1: nibiru.inflation.v1.MsgEditInflationParams.circulating_supply_exclusions:type_name -> nibiru.inflation.v1.CirculatingSupplyExclusions
+	8,  // 2: nibiru.inflation.v1.MsgEditInflationParams.inflation_recipients:type_name -> nibiru.inflation.v1.InflationRecipient
+	9,  // 3: nibiru.inflation.v1.MsgEditInflationParams.bonded_ratio_inflation:type_name -> nibiru.inflation.v1.BondedRatioInflation
+	10, // 4: nibiru.inflation.v1.MsgBurn.coin:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 5: nibiru.inflation.v1.Msg.ToggleInflation:input_type -> nibiru.inflation.v1.MsgToggleInflation
+	1,  // 6: nibiru.inflation.v1.Msg.EditInflationParams:input_type -> nibiru.inflation.v1.MsgEditInflationParams
+	2,  // 7: nibiru.inflation.v1.Msg.ToggleInflation:output_type -> nibiru.inflation.v1.MsgToggleInflationResponse
+	3,  // 8: nibiru.inflation.v1.Msg.EditInflationParams:output_type -> nibiru.inflation.v1.MsgEditInflationParamsResponse
+	7,  // [7:9] is the sub-list for method output_type
+	5,  // [5:7] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_nibiru_inflation_v1_tx_proto_init() }
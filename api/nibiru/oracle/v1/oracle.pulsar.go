@@ -62,19 +62,73 @@ func (x *_Params_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_13_list)(nil)
+
+type _Params_13_list struct {
+	list *[]*PairAggregation
+}
+
+func (x *_Params_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PairAggregation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PairAggregation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_13_list) AppendMutable() protoreflect.Value {
+	v := new(PairAggregation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_13_list) NewElement() protoreflect.Value {
+	v := new(PairAggregation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                      protoreflect.MessageDescriptor
-	fd_Params_vote_period          protoreflect.FieldDescriptor
-	fd_Params_vote_threshold       protoreflect.FieldDescriptor
-	fd_Params_reward_band          protoreflect.FieldDescriptor
-	fd_Params_whitelist            protoreflect.FieldDescriptor
-	fd_Params_slash_fraction       protoreflect.FieldDescriptor
-	fd_Params_slash_window         protoreflect.FieldDescriptor
-	fd_Params_min_valid_per_window protoreflect.FieldDescriptor
-	fd_Params_twap_lookback_window protoreflect.FieldDescriptor
-	fd_Params_min_voters           protoreflect.FieldDescriptor
-	fd_Params_validator_fee_ratio  protoreflect.FieldDescriptor
-	fd_Params_expiration_blocks    protoreflect.FieldDescriptor
+	md_Params                                 protoreflect.MessageDescriptor
+	fd_Params_vote_period                     protoreflect.FieldDescriptor
+	fd_Params_vote_threshold                  protoreflect.FieldDescriptor
+	fd_Params_reward_band                     protoreflect.FieldDescriptor
+	fd_Params_whitelist                       protoreflect.FieldDescriptor
+	fd_Params_slash_fraction                  protoreflect.FieldDescriptor
+	fd_Params_slash_window                    protoreflect.FieldDescriptor
+	fd_Params_min_valid_per_window            protoreflect.FieldDescriptor
+	fd_Params_twap_lookback_window            protoreflect.FieldDescriptor
+	fd_Params_min_voters                      protoreflect.FieldDescriptor
+	fd_Params_validator_fee_ratio             protoreflect.FieldDescriptor
+	fd_Params_expiration_blocks               protoreflect.FieldDescriptor
+	fd_Params_snapshot_retention_windows      protoreflect.FieldDescriptor
+	fd_Params_pair_aggregations               protoreflect.FieldDescriptor
+	fd_Params_vote_accuracy_retention_windows protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -91,6 +145,9 @@ func init() {
 	fd_Params_min_voters = md_Params.Fields().ByName("min_voters")
 	fd_Params_validator_fee_ratio = md_Params.Fields().ByName("validator_fee_ratio")
 	fd_Params_expiration_blocks = md_Params.Fields().ByName("expiration_blocks")
+	fd_Params_snapshot_retention_windows = md_Params.Fields().ByName("snapshot_retention_windows")
+	fd_Params_pair_aggregations = md_Params.Fields().ByName("pair_aggregations")
+	fd_Params_vote_accuracy_retention_windows = md_Params.Fields().ByName("vote_accuracy_retention_windows")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -224,6 +281,24 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.SnapshotRetentionWindows != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SnapshotRetentionWindows)
+		if !f(fd_Params_snapshot_retention_windows, value) {
+			return
+		}
+	}
+	if len(x.PairAggregations) != 0 {
+		value := protoreflect.ValueOfList(&_Params_13_list{list: &x.PairAggregations})
+		if !f(fd_Params_pair_aggregations, value) {
+			return
+		}
+	}
+	if x.VoteAccuracyRetentionWindows != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VoteAccuracyRetentionWindows)
+		if !f(fd_Params_vote_accuracy_retention_windows, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -261,6 +336,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ValidatorFeeRatio != ""
 	case "nibiru.oracle.v1.Params.expiration_blocks":
 		return x.ExpirationBlocks != uint64(0)
+	case "nibiru.oracle.v1.Params.snapshot_retention_windows":
+		return x.SnapshotRetentionWindows != uint64(0)
+	case "nibiru.oracle.v1.Params.pair_aggregations":
+		return len(x.PairAggregations) != 0
+	case "nibiru.oracle.v1.Params.vote_accuracy_retention_windows":
+		return x.VoteAccuracyRetentionWindows != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.Params"))
@@ -299,6 +380,12 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.ValidatorFeeRatio = ""
 	case "nibiru.oracle.v1.Params.expiration_blocks":
 		x.ExpirationBlocks = uint64(0)
+	case "nibiru.oracle.v1.Params.snapshot_retention_windows":
+		x.SnapshotRetentionWindows = uint64(0)
+	case "nibiru.oracle.v1.Params.pair_aggregations":
+		x.PairAggregations = nil
+	case "nibiru.oracle.v1.Params.vote_accuracy_retention_windows":
+		x.VoteAccuracyRetentionWindows = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.Params"))
@@ -351,6 +438,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "nibiru.oracle.v1.Params.expiration_blocks":
 		value := x.ExpirationBlocks
 		return protoreflect.ValueOfUint64(value)
+	case "nibiru.oracle.v1.Params.snapshot_retention_windows":
+		value := x.SnapshotRetentionWindows
+		return protoreflect.ValueOfUint64(value)
+	case "nibiru.oracle.v1.Params.pair_aggregations":
+		if len(x.PairAggregations) == 0 {
+			return protoreflect.ValueOfList(&_Params_13_list{})
+		}
+		listValue := &_Params_13_list{list: &x.PairAggregations}
+		return protoreflect.ValueOfList(listValue)
+	case "nibiru.oracle.v1.Params.vote_accuracy_retention_windows":
+		value := x.VoteAccuracyRetentionWindows
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.Params"))
@@ -395,6 +494,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.ValidatorFeeRatio = value.Interface().(string)
 	case "nibiru.oracle.v1.Params.expiration_blocks":
 		x.ExpirationBlocks = value.Uint()
+	case "nibiru.oracle.v1.Params.snapshot_retention_windows":
+		x.SnapshotRetentionWindows = value.Uint()
+	case "nibiru.oracle.v1.Params.pair_aggregations":
+		lv := value.List()
+		clv := lv.(*_Params_13_list)
+		x.PairAggregations = *clv.list
+	case "nibiru.oracle.v1.Params.vote_accuracy_retention_windows":
+		x.VoteAccuracyRetentionWindows = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.Params"))
@@ -426,6 +533,12 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.TwapLookbackWindow = new(durationpb.Duration)
 		}
 		return protoreflect.ValueOfMessage(x.TwapLookbackWindow.ProtoReflect())
+	case "nibiru.oracle.v1.Params.pair_aggregations":
+		if x.PairAggregations == nil {
+			x.PairAggregations = []*PairAggregation{}
+		}
+		value := &_Params_13_list{list: &x.PairAggregations}
+		return protoreflect.ValueOfList(value)
 	case "nibiru.oracle.v1.Params.vote_period":
 		panic(fmt.Errorf("field vote_period of message nibiru.oracle.v1.Params is not mutable"))
 	case "nibiru.oracle.v1.Params.vote_threshold":
@@ -444,6 +557,10 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field validator_fee_ratio of message nibiru.oracle.v1.Params is not mutable"))
 	case "nibiru.oracle.v1.Params.expiration_blocks":
 		panic(fmt.Errorf("field expiration_blocks of message nibiru.oracle.v1.Params is not mutable"))
+	case "nibiru.oracle.v1.Params.snapshot_retention_windows":
+		panic(fmt.Errorf("field snapshot_retention_windows of message nibiru.oracle.v1.Params is not mutable"))
+	case "nibiru.oracle.v1.Params.vote_accuracy_retention_windows":
+		panic(fmt.Errorf("field vote_accuracy_retention_windows of message nibiru.oracle.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.Params"))
@@ -481,6 +598,13 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "nibiru.oracle.v1.Params.expiration_blocks":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.oracle.v1.Params.snapshot_retention_windows":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.oracle.v1.Params.pair_aggregations":
+		list := []*PairAggregation{}
+		return protoreflect.ValueOfList(&_Params_13_list{list: &list})
+	case "nibiru.oracle.v1.Params.vote_accuracy_retention_windows":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.Params"))
@@ -592,6 +716,18 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.ExpirationBlocks != 0 {
 			n += 1 + runtime.Sov(uint64(x.ExpirationBlocks))
 		}
+		if x.SnapshotRetentionWindows != 0 {
+			n += 1 + runtime.Sov(uint64(x.SnapshotRetentionWindows))
+		}
+		if len(x.PairAggregations) > 0 {
+			for _, e := range x.PairAggregations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.VoteAccuracyRetentionWindows != 0 {
+			n += 1 + runtime.Sov(uint64(x.VoteAccuracyRetentionWindows))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -621,6 +757,32 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.VoteAccuracyRetentionWindows != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VoteAccuracyRetentionWindows))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.PairAggregations) > 0 {
+			for iNdEx := len(x.PairAggregations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PairAggregations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if x.SnapshotRetentionWindows != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SnapshotRetentionWindows))
+			i--
+			dAtA[i] = 0x60
+		}
 		if x.ExpirationBlocks != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpirationBlocks))
 			i--
@@ -1052,6 +1214,78 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetentionWindows", wireType)
+				}
+				x.SnapshotRetentionWindows = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SnapshotRetentionWindows |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PairAggregations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PairAggregations = append(x.PairAggregations, &PairAggregation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PairAggregations[len(x.PairAggregations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteAccuracyRetentionWindows", wireType)
+				}
+				x.VoteAccuracyRetentionWindows = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VoteAccuracyRetentionWindows |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1088,29 +1322,31 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_AggregateExchangeRatePrevote              protoreflect.MessageDescriptor
-	fd_AggregateExchangeRatePrevote_hash         protoreflect.FieldDescriptor
-	fd_AggregateExchangeRatePrevote_voter        protoreflect.FieldDescriptor
-	fd_AggregateExchangeRatePrevote_submit_block protoreflect.FieldDescriptor
+	md_PairAggregation                   protoreflect.MessageDescriptor
+	fd_PairAggregation_pair              protoreflect.FieldDescriptor
+	fd_PairAggregation_method            protoreflect.FieldDescriptor
+	fd_PairAggregation_trim_fraction     protoreflect.FieldDescriptor
+	fd_PairAggregation_max_std_dev_ratio protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_oracle_proto_init()
-	md_AggregateExchangeRatePrevote = File_nibiru_oracle_v1_oracle_proto.Messages().ByName("AggregateExchangeRatePrevote")
-	fd_AggregateExchangeRatePrevote_hash = md_AggregateExchangeRatePrevote.Fields().ByName("hash")
-	fd_AggregateExchangeRatePrevote_voter = md_AggregateExchangeRatePrevote.Fields().ByName("voter")
-	fd_AggregateExchangeRatePrevote_submit_block = md_AggregateExchangeRatePrevote.Fields().ByName("submit_block")
+	md_PairAggregation = File_nibiru_oracle_v1_oracle_proto.Messages().ByName("PairAggregation")
+	fd_PairAggregation_pair = md_PairAggregation.Fields().ByName("pair")
+	fd_PairAggregation_method = md_PairAggregation.Fields().ByName("method")
+	fd_PairAggregation_trim_fraction = md_PairAggregation.Fields().ByName("trim_fraction")
+	fd_PairAggregation_max_std_dev_ratio = md_PairAggregation.Fields().ByName("max_std_dev_ratio")
 }
 
-var _ protoreflect.Message = (*fastReflection_AggregateExchangeRatePrevote)(nil)
+var _ protoreflect.Message = (*fastReflection_PairAggregation)(nil)
 
-type fastReflection_AggregateExchangeRatePrevote AggregateExchangeRatePrevote
+type fastReflection_PairAggregation PairAggregation
 
-func (x *AggregateExchangeRatePrevote) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AggregateExchangeRatePrevote)(x)
+func (x *PairAggregation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PairAggregation)(x)
 }
 
-func (x *AggregateExchangeRatePrevote) slowProtoReflect() protoreflect.Message {
+func (x *PairAggregation) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1122,43 +1358,43 @@ func (x *AggregateExchangeRatePrevote) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AggregateExchangeRatePrevote_messageType fastReflection_AggregateExchangeRatePrevote_messageType
-var _ protoreflect.MessageType = fastReflection_AggregateExchangeRatePrevote_messageType{}
+var _fastReflection_PairAggregation_messageType fastReflection_PairAggregation_messageType
+var _ protoreflect.MessageType = fastReflection_PairAggregation_messageType{}
 
-type fastReflection_AggregateExchangeRatePrevote_messageType struct{}
+type fastReflection_PairAggregation_messageType struct{}
 
-func (x fastReflection_AggregateExchangeRatePrevote_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AggregateExchangeRatePrevote)(nil)
+func (x fastReflection_PairAggregation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PairAggregation)(nil)
 }
-func (x fastReflection_AggregateExchangeRatePrevote_messageType) New() protoreflect.Message {
-	return new(fastReflection_AggregateExchangeRatePrevote)
+func (x fastReflection_PairAggregation_messageType) New() protoreflect.Message {
+	return new(fastReflection_PairAggregation)
 }
-func (x fastReflection_AggregateExchangeRatePrevote_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AggregateExchangeRatePrevote
+func (x fastReflection_PairAggregation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairAggregation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AggregateExchangeRatePrevote) Descriptor() protoreflect.MessageDescriptor {
-	return md_AggregateExchangeRatePrevote
+func (x *fastReflection_PairAggregation) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairAggregation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AggregateExchangeRatePrevote) Type() protoreflect.MessageType {
-	return _fastReflection_AggregateExchangeRatePrevote_messageType
+func (x *fastReflection_PairAggregation) Type() protoreflect.MessageType {
+	return _fastReflection_PairAggregation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AggregateExchangeRatePrevote) New() protoreflect.Message {
-	return new(fastReflection_AggregateExchangeRatePrevote)
+func (x *fastReflection_PairAggregation) New() protoreflect.Message {
+	return new(fastReflection_PairAggregation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AggregateExchangeRatePrevote) Interface() protoreflect.ProtoMessage {
-	return (*AggregateExchangeRatePrevote)(x)
+func (x *fastReflection_PairAggregation) Interface() protoreflect.ProtoMessage {
+	return (*PairAggregation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1166,22 +1402,28 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AggregateExchangeRatePrevote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Hash != "" {
-		value := protoreflect.ValueOfString(x.Hash)
-		if !f(fd_AggregateExchangeRatePrevote_hash, value) {
+func (x *fastReflection_PairAggregation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pair != "" {
+		value := protoreflect.ValueOfString(x.Pair)
+		if !f(fd_PairAggregation_pair, value) {
 			return
 		}
 	}
-	if x.Voter != "" {
-		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_AggregateExchangeRatePrevote_voter, value) {
+	if x.Method != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Method))
+		if !f(fd_PairAggregation_method, value) {
 			return
 		}
 	}
-	if x.SubmitBlock != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SubmitBlock)
-		if !f(fd_AggregateExchangeRatePrevote_submit_block, value) {
+	if x.TrimFraction != "" {
+		value := protoreflect.ValueOfString(x.TrimFraction)
+		if !f(fd_PairAggregation_trim_fraction, value) {
+			return
+		}
+	}
+	if x.MaxStdDevRatio != "" {
+		value := protoreflect.ValueOfString(x.MaxStdDevRatio)
+		if !f(fd_PairAggregation_max_std_dev_ratio, value) {
 			return
 		}
 	}
@@ -1198,19 +1440,21 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AggregateExchangeRatePrevote) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PairAggregation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.hash":
-		return x.Hash != ""
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.voter":
-		return x.Voter != ""
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.submit_block":
-		return x.SubmitBlock != uint64(0)
+	case "nibiru.oracle.v1.PairAggregation.pair":
+		return x.Pair != ""
+	case "nibiru.oracle.v1.PairAggregation.method":
+		return x.Method != 0
+	case "nibiru.oracle.v1.PairAggregation.trim_fraction":
+		return x.TrimFraction != ""
+	case "nibiru.oracle.v1.PairAggregation.max_std_dev_ratio":
+		return x.MaxStdDevRatio != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairAggregation"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairAggregation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1220,19 +1464,21 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRatePrevote) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PairAggregation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.hash":
-		x.Hash = ""
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.voter":
-		x.Voter = ""
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.submit_block":
-		x.SubmitBlock = uint64(0)
+	case "nibiru.oracle.v1.PairAggregation.pair":
+		x.Pair = ""
+	case "nibiru.oracle.v1.PairAggregation.method":
+		x.Method = 0
+	case "nibiru.oracle.v1.PairAggregation.trim_fraction":
+		x.TrimFraction = ""
+	case "nibiru.oracle.v1.PairAggregation.max_std_dev_ratio":
+		x.MaxStdDevRatio = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairAggregation"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairAggregation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1242,22 +1488,25 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AggregateExchangeRatePrevote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PairAggregation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.hash":
-		value := x.Hash
+	case "nibiru.oracle.v1.PairAggregation.pair":
+		value := x.Pair
 		return protoreflect.ValueOfString(value)
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.voter":
-		value := x.Voter
+	case "nibiru.oracle.v1.PairAggregation.method":
+		value := x.Method
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nibiru.oracle.v1.PairAggregation.trim_fraction":
+		value := x.TrimFraction
+		return protoreflect.ValueOfString(value)
+	case "nibiru.oracle.v1.PairAggregation.max_std_dev_ratio":
+		value := x.MaxStdDevRatio
 		return protoreflect.ValueOfString(value)
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.submit_block":
-		value := x.SubmitBlock
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairAggregation"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRatePrevote does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairAggregation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1271,19 +1520,21 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRatePrevote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PairAggregation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.hash":
-		x.Hash = value.Interface().(string)
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.voter":
-		x.Voter = value.Interface().(string)
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.submit_block":
-		x.SubmitBlock = value.Uint()
+	case "nibiru.oracle.v1.PairAggregation.pair":
+		x.Pair = value.Interface().(string)
+	case "nibiru.oracle.v1.PairAggregation.method":
+		x.Method = (AggregationMethod)(value.Enum())
+	case "nibiru.oracle.v1.PairAggregation.trim_fraction":
+		x.TrimFraction = value.Interface().(string)
+	case "nibiru.oracle.v1.PairAggregation.max_std_dev_ratio":
+		x.MaxStdDevRatio = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairAggregation"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairAggregation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1297,48 +1548,52 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRatePrevote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PairAggregation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.hash":
-		panic(fmt.Errorf("field hash of message nibiru.oracle.v1.AggregateExchangeRatePrevote is not mutable"))
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.voter":
-		panic(fmt.Errorf("field voter of message nibiru.oracle.v1.AggregateExchangeRatePrevote is not mutable"))
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.submit_block":
-		panic(fmt.Errorf("field submit_block of message nibiru.oracle.v1.AggregateExchangeRatePrevote is not mutable"))
+	case "nibiru.oracle.v1.PairAggregation.pair":
+		panic(fmt.Errorf("field pair of message nibiru.oracle.v1.PairAggregation is not mutable"))
+	case "nibiru.oracle.v1.PairAggregation.method":
+		panic(fmt.Errorf("field method of message nibiru.oracle.v1.PairAggregation is not mutable"))
+	case "nibiru.oracle.v1.PairAggregation.trim_fraction":
+		panic(fmt.Errorf("field trim_fraction of message nibiru.oracle.v1.PairAggregation is not mutable"))
+	case "nibiru.oracle.v1.PairAggregation.max_std_dev_ratio":
+		panic(fmt.Errorf("field max_std_dev_ratio of message nibiru.oracle.v1.PairAggregation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairAggregation"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairAggregation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AggregateExchangeRatePrevote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PairAggregation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.hash":
+	case "nibiru.oracle.v1.PairAggregation.pair":
 		return protoreflect.ValueOfString("")
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.voter":
+	case "nibiru.oracle.v1.PairAggregation.method":
+		return protoreflect.ValueOfEnum(0)
+	case "nibiru.oracle.v1.PairAggregation.trim_fraction":
+		return protoreflect.ValueOfString("")
+	case "nibiru.oracle.v1.PairAggregation.max_std_dev_ratio":
 		return protoreflect.ValueOfString("")
-	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.submit_block":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.PairAggregation"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.PairAggregation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AggregateExchangeRatePrevote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PairAggregation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.AggregateExchangeRatePrevote", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.PairAggregation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1346,7 +1601,7 @@ func (x *fastReflection_AggregateExchangeRatePrevote) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AggregateExchangeRatePrevote) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PairAggregation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1357,7 +1612,7 @@ func (x *fastReflection_AggregateExchangeRatePrevote) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRatePrevote) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PairAggregation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1369,7 +1624,7 @@ func (x *fastReflection_AggregateExchangeRatePrevote) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AggregateExchangeRatePrevote) IsValid() bool {
+func (x *fastReflection_PairAggregation) IsValid() bool {
 	return x != nil
 }
 
@@ -1379,9 +1634,9 @@ func (x *fastReflection_AggregateExchangeRatePrevote) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PairAggregation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
+		x := input.Message.Interface().(*PairAggregation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1393,16 +1648,20 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		l = len(x.Hash)
+		l = len(x.Pair)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Voter)
+		if x.Method != 0 {
+			n += 1 + runtime.Sov(uint64(x.Method))
+		}
+		l = len(x.TrimFraction)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.SubmitBlock != 0 {
-			n += 1 + runtime.Sov(uint64(x.SubmitBlock))
+		l = len(x.MaxStdDevRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1414,7 +1673,7 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
+		x := input.Message.Interface().(*PairAggregation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1433,22 +1692,29 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.SubmitBlock != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubmitBlock))
+		if len(x.MaxStdDevRatio) > 0 {
+			i -= len(x.MaxStdDevRatio)
+			copy(dAtA[i:], x.MaxStdDevRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxStdDevRatio)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x22
 		}
-		if len(x.Voter) > 0 {
-			i -= len(x.Voter)
-			copy(dAtA[i:], x.Voter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+		if len(x.TrimFraction) > 0 {
+			i -= len(x.TrimFraction)
+			copy(dAtA[i:], x.TrimFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TrimFraction)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
-		if len(x.Hash) > 0 {
-			i -= len(x.Hash)
-			copy(dAtA[i:], x.Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+		if x.Method != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Method))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Pair) > 0 {
+			i -= len(x.Pair)
+			copy(dAtA[i:], x.Pair)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pair)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1463,7 +1729,7 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
+		x := input.Message.Interface().(*PairAggregation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1495,15 +1761,15 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairAggregation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1531,11 +1797,30 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
+				x.Pair = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				}
+				x.Method = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Method |= AggregationMethod(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrimFraction", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1563,13 +1848,13 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Voter = string(dAtA[iNdEx:postIndex])
+				x.TrimFraction = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxStdDevRatio", wireType)
 				}
-				x.SubmitBlock = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1579,11 +1864,24 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SubmitBlock |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxStdDevRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1619,79 +1917,30 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 	}
 }
 
-var _ protoreflect.List = (*_AggregateExchangeRateVote_1_list)(nil)
-
-type _AggregateExchangeRateVote_1_list struct {
-	list *[]*ExchangeRateTuple
-}
-
-func (x *_AggregateExchangeRateVote_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_AggregateExchangeRateVote_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_AggregateExchangeRateVote_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ExchangeRateTuple)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_AggregateExchangeRateVote_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ExchangeRateTuple)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_AggregateExchangeRateVote_1_list) AppendMutable() protoreflect.Value {
-	v := new(ExchangeRateTuple)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_AggregateExchangeRateVote_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_AggregateExchangeRateVote_1_list) NewElement() protoreflect.Value {
-	v := new(ExchangeRateTuple)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_AggregateExchangeRateVote_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_AggregateExchangeRateVote                      protoreflect.MessageDescriptor
-	fd_AggregateExchangeRateVote_exchange_rate_tuples protoreflect.FieldDescriptor
-	fd_AggregateExchangeRateVote_voter                protoreflect.FieldDescriptor
+	md_AggregateExchangeRatePrevote              protoreflect.MessageDescriptor
+	fd_AggregateExchangeRatePrevote_hash         protoreflect.FieldDescriptor
+	fd_AggregateExchangeRatePrevote_voter        protoreflect.FieldDescriptor
+	fd_AggregateExchangeRatePrevote_submit_block protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_oracle_proto_init()
-	md_AggregateExchangeRateVote = File_nibiru_oracle_v1_oracle_proto.Messages().ByName("AggregateExchangeRateVote")
-	fd_AggregateExchangeRateVote_exchange_rate_tuples = md_AggregateExchangeRateVote.Fields().ByName("exchange_rate_tuples")
-	fd_AggregateExchangeRateVote_voter = md_AggregateExchangeRateVote.Fields().ByName("voter")
+	md_AggregateExchangeRatePrevote = File_nibiru_oracle_v1_oracle_proto.Messages().ByName("AggregateExchangeRatePrevote")
+	fd_AggregateExchangeRatePrevote_hash = md_AggregateExchangeRatePrevote.Fields().ByName("hash")
+	fd_AggregateExchangeRatePrevote_voter = md_AggregateExchangeRatePrevote.Fields().ByName("voter")
+	fd_AggregateExchangeRatePrevote_submit_block = md_AggregateExchangeRatePrevote.Fields().ByName("submit_block")
 }
 
-var _ protoreflect.Message = (*fastReflection_AggregateExchangeRateVote)(nil)
+var _ protoreflect.Message = (*fastReflection_AggregateExchangeRatePrevote)(nil)
 
-type fastReflection_AggregateExchangeRateVote AggregateExchangeRateVote
+type fastReflection_AggregateExchangeRatePrevote AggregateExchangeRatePrevote
 
-func (x *AggregateExchangeRateVote) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AggregateExchangeRateVote)(x)
+func (x *AggregateExchangeRatePrevote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AggregateExchangeRatePrevote)(x)
 }
 
-func (x *AggregateExchangeRateVote) slowProtoReflect() protoreflect.Message {
+func (x *AggregateExchangeRatePrevote) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1703,43 +1952,43 @@ func (x *AggregateExchangeRateVote) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AggregateExchangeRateVote_messageType fastReflection_AggregateExchangeRateVote_messageType
-var _ protoreflect.MessageType = fastReflection_AggregateExchangeRateVote_messageType{}
+var _fastReflection_AggregateExchangeRatePrevote_messageType fastReflection_AggregateExchangeRatePrevote_messageType
+var _ protoreflect.MessageType = fastReflection_AggregateExchangeRatePrevote_messageType{}
 
-type fastReflection_AggregateExchangeRateVote_messageType struct{}
+type fastReflection_AggregateExchangeRatePrevote_messageType struct{}
 
-func (x fastReflection_AggregateExchangeRateVote_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AggregateExchangeRateVote)(nil)
+func (x fastReflection_AggregateExchangeRatePrevote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AggregateExchangeRatePrevote)(nil)
 }
-func (x fastReflection_AggregateExchangeRateVote_messageType) New() protoreflect.Message {
-	return new(fastReflection_AggregateExchangeRateVote)
+func (x fastReflection_AggregateExchangeRatePrevote_messageType) New() protoreflect.Message {
+	return new(fastReflection_AggregateExchangeRatePrevote)
 }
-func (x fastReflection_AggregateExchangeRateVote_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AggregateExchangeRateVote
+func (x fastReflection_AggregateExchangeRatePrevote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AggregateExchangeRatePrevote
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AggregateExchangeRateVote) Descriptor() protoreflect.MessageDescriptor {
-	return md_AggregateExchangeRateVote
+func (x *fastReflection_AggregateExchangeRatePrevote) Descriptor() protoreflect.MessageDescriptor {
+	return md_AggregateExchangeRatePrevote
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AggregateExchangeRateVote) Type() protoreflect.MessageType {
-	return _fastReflection_AggregateExchangeRateVote_messageType
+func (x *fastReflection_AggregateExchangeRatePrevote) Type() protoreflect.MessageType {
+	return _fastReflection_AggregateExchangeRatePrevote_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AggregateExchangeRateVote) New() protoreflect.Message {
-	return new(fastReflection_AggregateExchangeRateVote)
+func (x *fastReflection_AggregateExchangeRatePrevote) New() protoreflect.Message {
+	return new(fastReflection_AggregateExchangeRatePrevote)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AggregateExchangeRateVote) Interface() protoreflect.ProtoMessage {
-	return (*AggregateExchangeRateVote)(x)
+func (x *fastReflection_AggregateExchangeRatePrevote) Interface() protoreflect.ProtoMessage {
+	return (*AggregateExchangeRatePrevote)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1747,16 +1996,22 @@ func (x *fastReflection_AggregateExchangeRateVote) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AggregateExchangeRateVote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ExchangeRateTuples) != 0 {
-		value := protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{list: &x.ExchangeRateTuples})
-		if !f(fd_AggregateExchangeRateVote_exchange_rate_tuples, value) {
+func (x *fastReflection_AggregateExchangeRatePrevote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_AggregateExchangeRatePrevote_hash, value) {
 			return
 		}
 	}
 	if x.Voter != "" {
 		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_AggregateExchangeRateVote_voter, value) {
+		if !f(fd_AggregateExchangeRatePrevote_voter, value) {
+			return
+		}
+	}
+	if x.SubmitBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubmitBlock)
+		if !f(fd_AggregateExchangeRatePrevote_submit_block, value) {
 			return
 		}
 	}
@@ -1773,17 +2028,19 @@ func (x *fastReflection_AggregateExchangeRateVote) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AggregateExchangeRateVote) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AggregateExchangeRatePrevote) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples":
-		return len(x.ExchangeRateTuples) != 0
-	case "nibiru.oracle.v1.AggregateExchangeRateVote.voter":
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.hash":
+		return x.Hash != ""
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.voter":
 		return x.Voter != ""
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.submit_block":
+		return x.SubmitBlock != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1793,17 +2050,19 @@ func (x *fastReflection_AggregateExchangeRateVote) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRateVote) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AggregateExchangeRatePrevote) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples":
-		x.ExchangeRateTuples = nil
-	case "nibiru.oracle.v1.AggregateExchangeRateVote.voter":
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.hash":
+		x.Hash = ""
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.voter":
 		x.Voter = ""
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.submit_block":
+		x.SubmitBlock = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1813,22 +2072,22 @@ func (x *fastReflection_AggregateExchangeRateVote) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AggregateExchangeRateVote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRatePrevote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples":
-		if len(x.ExchangeRateTuples) == 0 {
-			return protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{})
-		}
-		listValue := &_AggregateExchangeRateVote_1_list{list: &x.ExchangeRateTuples}
-		return protoreflect.ValueOfList(listValue)
-	case "nibiru.oracle.v1.AggregateExchangeRateVote.voter":
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.voter":
 		value := x.Voter
 		return protoreflect.ValueOfString(value)
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.submit_block":
+		value := x.SubmitBlock
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRateVote does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRatePrevote does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1842,19 +2101,19 @@ func (x *fastReflection_AggregateExchangeRateVote) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRateVote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AggregateExchangeRatePrevote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples":
-		lv := value.List()
-		clv := lv.(*_AggregateExchangeRateVote_1_list)
-		x.ExchangeRateTuples = *clv.list
-	case "nibiru.oracle.v1.AggregateExchangeRateVote.voter":
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.hash":
+		x.Hash = value.Interface().(string)
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.voter":
 		x.Voter = value.Interface().(string)
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.submit_block":
+		x.SubmitBlock = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1868,49 +2127,48 @@ func (x *fastReflection_AggregateExchangeRateVote) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRateVote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRatePrevote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples":
-		if x.ExchangeRateTuples == nil {
-			x.ExchangeRateTuples = []*ExchangeRateTuple{}
-		}
-		value := &_AggregateExchangeRateVote_1_list{list: &x.ExchangeRateTuples}
-		return protoreflect.ValueOfList(value)
-	case "nibiru.oracle.v1.AggregateExchangeRateVote.voter":
-		panic(fmt.Errorf("field voter of message nibiru.oracle.v1.AggregateExchangeRateVote is not mutable"))
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.hash":
+		panic(fmt.Errorf("field hash of message nibiru.oracle.v1.AggregateExchangeRatePrevote is not mutable"))
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.voter":
+		panic(fmt.Errorf("field voter of message nibiru.oracle.v1.AggregateExchangeRatePrevote is not mutable"))
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.submit_block":
+		panic(fmt.Errorf("field submit_block of message nibiru.oracle.v1.AggregateExchangeRatePrevote is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AggregateExchangeRateVote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRatePrevote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples":
-		list := []*ExchangeRateTuple{}
-		return protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{list: &list})
-	case "nibiru.oracle.v1.AggregateExchangeRateVote.voter":
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.hash":
+		return protoreflect.ValueOfString("")
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.voter":
 		return protoreflect.ValueOfString("")
+	case "nibiru.oracle.v1.AggregateExchangeRatePrevote.submit_block":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AggregateExchangeRateVote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AggregateExchangeRatePrevote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.AggregateExchangeRateVote", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.AggregateExchangeRatePrevote", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1918,7 +2176,7 @@ func (x *fastReflection_AggregateExchangeRateVote) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AggregateExchangeRateVote) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AggregateExchangeRatePrevote) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1929,7 +2187,7 @@ func (x *fastReflection_AggregateExchangeRateVote) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRateVote) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AggregateExchangeRatePrevote) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1941,7 +2199,7 @@ func (x *fastReflection_AggregateExchangeRateVote) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AggregateExchangeRateVote) IsValid() bool {
+func (x *fastReflection_AggregateExchangeRatePrevote) IsValid() bool {
 	return x != nil
 }
 
@@ -1951,9 +2209,9 @@ func (x *fastReflection_AggregateExchangeRateVote) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AggregateExchangeRateVote)
+		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1965,16 +2223,17 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if len(x.ExchangeRateTuples) > 0 {
-			for _, e := range x.ExchangeRateTuples {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		l = len(x.Voter)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.SubmitBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubmitBlock))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1985,7 +2244,7 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AggregateExchangeRateVote)
+		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2004,6 +2263,11 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.SubmitBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubmitBlock))
+			i--
+			dAtA[i] = 0x18
+		}
 		if len(x.Voter) > 0 {
 			i -= len(x.Voter)
 			copy(dAtA[i:], x.Voter)
@@ -2011,21 +2275,12 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.ExchangeRateTuples) > 0 {
-			for iNdEx := len(x.ExchangeRateTuples) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ExchangeRateTuples[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2038,7 +2293,7 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AggregateExchangeRateVote)
+		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2070,17 +2325,17 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2090,25 +2345,23 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExchangeRateTuples = append(x.ExchangeRateTuples, &ExchangeRateTuple{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExchangeRateTuples[len(x.ExchangeRateTuples)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
@@ -2142,6 +2395,25 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 				}
 				x.Voter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+				}
+				x.SubmitBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubmitBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2177,28 +2449,79 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 	}
 }
 
+var _ protoreflect.List = (*_AggregateExchangeRateVote_1_list)(nil)
+
+type _AggregateExchangeRateVote_1_list struct {
+	list *[]*ExchangeRateTuple
+}
+
+func (x *_AggregateExchangeRateVote_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AggregateExchangeRateVote_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_AggregateExchangeRateVote_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExchangeRateTuple)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AggregateExchangeRateVote_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExchangeRateTuple)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AggregateExchangeRateVote_1_list) AppendMutable() protoreflect.Value {
+	v := new(ExchangeRateTuple)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AggregateExchangeRateVote_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AggregateExchangeRateVote_1_list) NewElement() protoreflect.Value {
+	v := new(ExchangeRateTuple)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AggregateExchangeRateVote_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_ExchangeRateTuple               protoreflect.MessageDescriptor
-	fd_ExchangeRateTuple_pair          protoreflect.FieldDescriptor
-	fd_ExchangeRateTuple_exchange_rate protoreflect.FieldDescriptor
+	md_AggregateExchangeRateVote                      protoreflect.MessageDescriptor
+	fd_AggregateExchangeRateVote_exchange_rate_tuples protoreflect.FieldDescriptor
+	fd_AggregateExchangeRateVote_voter                protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_oracle_proto_init()
-	md_ExchangeRateTuple = File_nibiru_oracle_v1_oracle_proto.Messages().ByName("ExchangeRateTuple")
-	fd_ExchangeRateTuple_pair = md_ExchangeRateTuple.Fields().ByName("pair")
-	fd_ExchangeRateTuple_exchange_rate = md_ExchangeRateTuple.Fields().ByName("exchange_rate")
+	md_AggregateExchangeRateVote = File_nibiru_oracle_v1_oracle_proto.Messages().ByName("AggregateExchangeRateVote")
+	fd_AggregateExchangeRateVote_exchange_rate_tuples = md_AggregateExchangeRateVote.Fields().ByName("exchange_rate_tuples")
+	fd_AggregateExchangeRateVote_voter = md_AggregateExchangeRateVote.Fields().ByName("voter")
 }
 
-var _ protoreflect.Message = (*fastReflection_ExchangeRateTuple)(nil)
+var _ protoreflect.Message = (*fastReflection_AggregateExchangeRateVote)(nil)
 
-type fastReflection_ExchangeRateTuple ExchangeRateTuple
+type fastReflection_AggregateExchangeRateVote AggregateExchangeRateVote
 
-func (x *ExchangeRateTuple) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ExchangeRateTuple)(x)
+func (x *AggregateExchangeRateVote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AggregateExchangeRateVote)(x)
 }
 
-func (x *ExchangeRateTuple) slowProtoReflect() protoreflect.Message {
+func (x *AggregateExchangeRateVote) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2210,43 +2533,43 @@ func (x *ExchangeRateTuple) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ExchangeRateTuple_messageType fastReflection_ExchangeRateTuple_messageType
-var _ protoreflect.MessageType = fastReflection_ExchangeRateTuple_messageType{}
+var _fastReflection_AggregateExchangeRateVote_messageType fastReflection_AggregateExchangeRateVote_messageType
+var _ protoreflect.MessageType = fastReflection_AggregateExchangeRateVote_messageType{}
 
-type fastReflection_ExchangeRateTuple_messageType struct{}
+type fastReflection_AggregateExchangeRateVote_messageType struct{}
 
-func (x fastReflection_ExchangeRateTuple_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ExchangeRateTuple)(nil)
+func (x fastReflection_AggregateExchangeRateVote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AggregateExchangeRateVote)(nil)
 }
-func (x fastReflection_ExchangeRateTuple_messageType) New() protoreflect.Message {
-	return new(fastReflection_ExchangeRateTuple)
+func (x fastReflection_AggregateExchangeRateVote_messageType) New() protoreflect.Message {
+	return new(fastReflection_AggregateExchangeRateVote)
 }
-func (x fastReflection_ExchangeRateTuple_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ExchangeRateTuple
+func (x fastReflection_AggregateExchangeRateVote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AggregateExchangeRateVote
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ExchangeRateTuple) Descriptor() protoreflect.MessageDescriptor {
-	return md_ExchangeRateTuple
+func (x *fastReflection_AggregateExchangeRateVote) Descriptor() protoreflect.MessageDescriptor {
+	return md_AggregateExchangeRateVote
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ExchangeRateTuple) Type() protoreflect.MessageType {
-	return _fastReflection_ExchangeRateTuple_messageType
+func (x *fastReflection_AggregateExchangeRateVote) Type() protoreflect.MessageType {
+	return _fastReflection_AggregateExchangeRateVote_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ExchangeRateTuple) New() protoreflect.Message {
-	return new(fastReflection_ExchangeRateTuple)
+func (x *fastReflection_AggregateExchangeRateVote) New() protoreflect.Message {
+	return new(fastReflection_AggregateExchangeRateVote)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ExchangeRateTuple) Interface() protoreflect.ProtoMessage {
-	return (*ExchangeRateTuple)(x)
+func (x *fastReflection_AggregateExchangeRateVote) Interface() protoreflect.ProtoMessage {
+	return (*AggregateExchangeRateVote)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2254,16 +2577,16 @@ func (x *fastReflection_ExchangeRateTuple) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ExchangeRateTuple) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Pair != "" {
-		value := protoreflect.ValueOfString(x.Pair)
-		if !f(fd_ExchangeRateTuple_pair, value) {
+func (x *fastReflection_AggregateExchangeRateVote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ExchangeRateTuples) != 0 {
+		value := protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{list: &x.ExchangeRateTuples})
+		if !f(fd_AggregateExchangeRateVote_exchange_rate_tuples, value) {
 			return
 		}
 	}
-	if x.ExchangeRate != "" {
-		value := protoreflect.ValueOfString(x.ExchangeRate)
-		if !f(fd_ExchangeRateTuple_exchange_rate, value) {
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_AggregateExchangeRateVote_voter, value) {
 			return
 		}
 	}
@@ -2280,17 +2603,17 @@ func (x *fastReflection_ExchangeRateTuple) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ExchangeRateTuple) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AggregateExchangeRateVote) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.ExchangeRateTuple.pair":
-		return x.Pair != ""
-	case "nibiru.oracle.v1.ExchangeRateTuple.exchange_rate":
-		return x.ExchangeRate != ""
-	default:
+	case "nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples":
+		return len(x.ExchangeRateTuples) != 0
+	case "nibiru.oracle.v1.AggregateExchangeRateVote.voter":
+		return x.Voter != ""
+	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateTuple does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2300,17 +2623,17 @@ func (x *fastReflection_ExchangeRateTuple) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExchangeRateTuple) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AggregateExchangeRateVote) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.ExchangeRateTuple.pair":
-		x.Pair = ""
-	case "nibiru.oracle.v1.ExchangeRateTuple.exchange_rate":
-		x.ExchangeRate = ""
+	case "nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples":
+		x.ExchangeRateTuples = nil
+	case "nibiru.oracle.v1.AggregateExchangeRateVote.voter":
+		x.Voter = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateTuple does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2320,19 +2643,22 @@ func (x *fastReflection_ExchangeRateTuple) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ExchangeRateTuple) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRateVote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.ExchangeRateTuple.pair":
-		value := x.Pair
-		return protoreflect.ValueOfString(value)
-	case "nibiru.oracle.v1.ExchangeRateTuple.exchange_rate":
-		value := x.ExchangeRate
+	case "nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples":
+		if len(x.ExchangeRateTuples) == 0 {
+			return protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{})
+		}
+		listValue := &_AggregateExchangeRateVote_1_list{list: &x.ExchangeRateTuples}
+		return protoreflect.ValueOfList(listValue)
+	case "nibiru.oracle.v1.AggregateExchangeRateVote.voter":
+		value := x.Voter
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateTuple does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRateVote does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2346,17 +2672,19 @@ func (x *fastReflection_ExchangeRateTuple) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExchangeRateTuple) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AggregateExchangeRateVote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.ExchangeRateTuple.pair":
-		x.Pair = value.Interface().(string)
-	case "nibiru.oracle.v1.ExchangeRateTuple.exchange_rate":
-		x.ExchangeRate = value.Interface().(string)
+	case "nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples":
+		lv := value.List()
+		clv := lv.(*_AggregateExchangeRateVote_1_list)
+		x.ExchangeRateTuples = *clv.list
+	case "nibiru.oracle.v1.AggregateExchangeRateVote.voter":
+		x.Voter = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateTuple does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2370,44 +2698,49 @@ func (x *fastReflection_ExchangeRateTuple) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExchangeRateTuple) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRateVote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.ExchangeRateTuple.pair":
-		panic(fmt.Errorf("field pair of message nibiru.oracle.v1.ExchangeRateTuple is not mutable"))
-	case "nibiru.oracle.v1.ExchangeRateTuple.exchange_rate":
-		panic(fmt.Errorf("field exchange_rate of message nibiru.oracle.v1.ExchangeRateTuple is not mutable"))
+	case "nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples":
+		if x.ExchangeRateTuples == nil {
+			x.ExchangeRateTuples = []*ExchangeRateTuple{}
+		}
+		value := &_AggregateExchangeRateVote_1_list{list: &x.ExchangeRateTuples}
+		return protoreflect.ValueOfList(value)
+	case "nibiru.oracle.v1.AggregateExchangeRateVote.voter":
+		panic(fmt.Errorf("field voter of message nibiru.oracle.v1.AggregateExchangeRateVote is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateTuple does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ExchangeRateTuple) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRateVote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.ExchangeRateTuple.pair":
-		return protoreflect.ValueOfString("")
-	case "nibiru.oracle.v1.ExchangeRateTuple.exchange_rate":
+	case "nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples":
+		list := []*ExchangeRateTuple{}
+		return protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{list: &list})
+	case "nibiru.oracle.v1.AggregateExchangeRateVote.voter":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateTuple does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ExchangeRateTuple) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AggregateExchangeRateVote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.ExchangeRateTuple", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.AggregateExchangeRateVote", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2415,7 +2748,7 @@ func (x *fastReflection_ExchangeRateTuple) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ExchangeRateTuple) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AggregateExchangeRateVote) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2426,7 +2759,7 @@ func (x *fastReflection_ExchangeRateTuple) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExchangeRateTuple) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AggregateExchangeRateVote) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2438,7 +2771,7 @@ func (x *fastReflection_ExchangeRateTuple) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ExchangeRateTuple) IsValid() bool {
+func (x *fastReflection_AggregateExchangeRateVote) IsValid() bool {
 	return x != nil
 }
 
@@ -2448,9 +2781,9 @@ func (x *fastReflection_ExchangeRateTuple) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ExchangeRateTuple) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ExchangeRateTuple)
+		x := input.Message.Interface().(*AggregateExchangeRateVote)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2462,11 +2795,13 @@ func (x *fastReflection_ExchangeRateTuple) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Pair)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.ExchangeRateTuples) > 0 {
+			for _, e := range x.ExchangeRateTuples {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.ExchangeRate)
+		l = len(x.Voter)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -2480,7 +2815,7 @@ func (x *fastReflection_ExchangeRateTuple) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ExchangeRateTuple)
+		x := input.Message.Interface().(*AggregateExchangeRateVote)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2499,19 +2834,28 @@ func (x *fastReflection_ExchangeRateTuple) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ExchangeRate) > 0 {
-			i -= len(x.ExchangeRate)
-			copy(dAtA[i:], x.ExchangeRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExchangeRate)))
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Pair) > 0 {
-			i -= len(x.Pair)
-			copy(dAtA[i:], x.Pair)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pair)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.ExchangeRateTuples) > 0 {
+			for iNdEx := len(x.ExchangeRateTuples) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExchangeRateTuples[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2524,7 +2868,7 @@ func (x *fastReflection_ExchangeRateTuple) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ExchangeRateTuple)
+		x := input.Message.Interface().(*AggregateExchangeRateVote)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2556,17 +2900,17 @@ func (x *fastReflection_ExchangeRateTuple) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2576,27 +2920,29 @@ func (x *fastReflection_ExchangeRateTuple) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Pair = string(dAtA[iNdEx:postIndex])
+				x.ExchangeRateTuples = append(x.ExchangeRateTuples, &ExchangeRateTuple{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExchangeRateTuples[len(x.ExchangeRateTuples)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2624,7 +2970,7 @@ func (x *fastReflection_ExchangeRateTuple) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExchangeRate = string(dAtA[iNdEx:postIndex])
+				x.Voter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2662,29 +3008,27 @@ func (x *fastReflection_ExchangeRateTuple) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ExchangeRateAtBlock                    protoreflect.MessageDescriptor
-	fd_ExchangeRateAtBlock_exchange_rate      protoreflect.FieldDescriptor
-	fd_ExchangeRateAtBlock_created_block      protoreflect.FieldDescriptor
-	fd_ExchangeRateAtBlock_block_timestamp_ms protoreflect.FieldDescriptor
+	md_ExchangeRateTuple               protoreflect.MessageDescriptor
+	fd_ExchangeRateTuple_pair          protoreflect.FieldDescriptor
+	fd_ExchangeRateTuple_exchange_rate protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_oracle_v1_oracle_proto_init()
-	md_ExchangeRateAtBlock = File_nibiru_oracle_v1_oracle_proto.Messages().ByName("ExchangeRateAtBlock")
-	fd_ExchangeRateAtBlock_exchange_rate = md_ExchangeRateAtBlock.Fields().ByName("exchange_rate")
-	fd_ExchangeRateAtBlock_created_block = md_ExchangeRateAtBlock.Fields().ByName("created_block")
-	fd_ExchangeRateAtBlock_block_timestamp_ms = md_ExchangeRateAtBlock.Fields().ByName("block_timestamp_ms")
+	md_ExchangeRateTuple = File_nibiru_oracle_v1_oracle_proto.Messages().ByName("ExchangeRateTuple")
+	fd_ExchangeRateTuple_pair = md_ExchangeRateTuple.Fields().ByName("pair")
+	fd_ExchangeRateTuple_exchange_rate = md_ExchangeRateTuple.Fields().ByName("exchange_rate")
 }
 
-var _ protoreflect.Message = (*fastReflection_ExchangeRateAtBlock)(nil)
+var _ protoreflect.Message = (*fastReflection_ExchangeRateTuple)(nil)
 
-type fastReflection_ExchangeRateAtBlock ExchangeRateAtBlock
+type fastReflection_ExchangeRateTuple ExchangeRateTuple
 
-func (x *ExchangeRateAtBlock) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ExchangeRateAtBlock)(x)
+func (x *ExchangeRateTuple) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExchangeRateTuple)(x)
 }
 
-func (x *ExchangeRateAtBlock) slowProtoReflect() protoreflect.Message {
+func (x *ExchangeRateTuple) slowProtoReflect() protoreflect.Message {
 	mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2696,43 +3040,43 @@ func (x *ExchangeRateAtBlock) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ExchangeRateAtBlock_messageType fastReflection_ExchangeRateAtBlock_messageType
-var _ protoreflect.MessageType = fastReflection_ExchangeRateAtBlock_messageType{}
+var _fastReflection_ExchangeRateTuple_messageType fastReflection_ExchangeRateTuple_messageType
+var _ protoreflect.MessageType = fastReflection_ExchangeRateTuple_messageType{}
 
-type fastReflection_ExchangeRateAtBlock_messageType struct{}
+type fastReflection_ExchangeRateTuple_messageType struct{}
 
-func (x fastReflection_ExchangeRateAtBlock_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ExchangeRateAtBlock)(nil)
+func (x fastReflection_ExchangeRateTuple_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExchangeRateTuple)(nil)
 }
-func (x fastReflection_ExchangeRateAtBlock_messageType) New() protoreflect.Message {
-	return new(fastReflection_ExchangeRateAtBlock)
+func (x fastReflection_ExchangeRateTuple_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExchangeRateTuple)
 }
-func (x fastReflection_ExchangeRateAtBlock_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ExchangeRateAtBlock
+func (x fastReflection_ExchangeRateTuple_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExchangeRateTuple
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ExchangeRateAtBlock) Descriptor() protoreflect.MessageDescriptor {
-	return md_ExchangeRateAtBlock
+func (x *fastReflection_ExchangeRateTuple) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExchangeRateTuple
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ExchangeRateAtBlock) Type() protoreflect.MessageType {
-	return _fastReflection_ExchangeRateAtBlock_messageType
+func (x *fastReflection_ExchangeRateTuple) Type() protoreflect.MessageType {
+	return _fastReflection_ExchangeRateTuple_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ExchangeRateAtBlock) New() protoreflect.Message {
-	return new(fastReflection_ExchangeRateAtBlock)
+func (x *fastReflection_ExchangeRateTuple) New() protoreflect.Message {
+	return new(fastReflection_ExchangeRateTuple)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ExchangeRateAtBlock) Interface() protoreflect.ProtoMessage {
-	return (*ExchangeRateAtBlock)(x)
+func (x *fastReflection_ExchangeRateTuple) Interface() protoreflect.ProtoMessage {
+	return (*ExchangeRateTuple)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2740,22 +3084,16 @@ func (x *fastReflection_ExchangeRateAtBlock) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ExchangeRateAtBlock) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ExchangeRate != "" {
-		value := protoreflect.ValueOfString(x.ExchangeRate)
-		if !f(fd_ExchangeRateAtBlock_exchange_rate, value) {
-			return
-		}
-	}
-	if x.CreatedBlock != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.CreatedBlock)
-		if !f(fd_ExchangeRateAtBlock_created_block, value) {
+func (x *fastReflection_ExchangeRateTuple) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pair != "" {
+		value := protoreflect.ValueOfString(x.Pair)
+		if !f(fd_ExchangeRateTuple_pair, value) {
 			return
 		}
 	}
-	if x.BlockTimestampMs != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockTimestampMs)
-		if !f(fd_ExchangeRateAtBlock_block_timestamp_ms, value) {
+	if x.ExchangeRate != "" {
+		value := protoreflect.ValueOfString(x.ExchangeRate)
+		if !f(fd_ExchangeRateTuple_exchange_rate, value) {
 			return
 		}
 	}
@@ -2772,19 +3110,1749 @@ func (x *fastReflection_ExchangeRateAtBlock) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ExchangeRateAtBlock) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ExchangeRateTuple) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.exchange_rate":
+	case "nibiru.oracle.v1.ExchangeRateTuple.pair":
+		return x.Pair != ""
+	case "nibiru.oracle.v1.ExchangeRateTuple.exchange_rate":
 		return x.ExchangeRate != ""
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.created_block":
-		return x.CreatedBlock != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateTuple"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateTuple does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExchangeRateTuple) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.ExchangeRateTuple.pair":
+		x.Pair = ""
+	case "nibiru.oracle.v1.ExchangeRateTuple.exchange_rate":
+		x.ExchangeRate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateTuple"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateTuple does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExchangeRateTuple) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.oracle.v1.ExchangeRateTuple.pair":
+		value := x.Pair
+		return protoreflect.ValueOfString(value)
+	case "nibiru.oracle.v1.ExchangeRateTuple.exchange_rate":
+		value := x.ExchangeRate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateTuple"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateTuple does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExchangeRateTuple) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.ExchangeRateTuple.pair":
+		x.Pair = value.Interface().(string)
+	case "nibiru.oracle.v1.ExchangeRateTuple.exchange_rate":
+		x.ExchangeRate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateTuple"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateTuple does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExchangeRateTuple) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.ExchangeRateTuple.pair":
+		panic(fmt.Errorf("field pair of message nibiru.oracle.v1.ExchangeRateTuple is not mutable"))
+	case "nibiru.oracle.v1.ExchangeRateTuple.exchange_rate":
+		panic(fmt.Errorf("field exchange_rate of message nibiru.oracle.v1.ExchangeRateTuple is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateTuple"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateTuple does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExchangeRateTuple) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.ExchangeRateTuple.pair":
+		return protoreflect.ValueOfString("")
+	case "nibiru.oracle.v1.ExchangeRateTuple.exchange_rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateTuple"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateTuple does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExchangeRateTuple) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.ExchangeRateTuple", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExchangeRateTuple) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExchangeRateTuple) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExchangeRateTuple) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExchangeRateTuple) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExchangeRateTuple)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Pair)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExchangeRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExchangeRateTuple)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ExchangeRate) > 0 {
+			i -= len(x.ExchangeRate)
+			copy(dAtA[i:], x.ExchangeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExchangeRate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Pair) > 0 {
+			i -= len(x.Pair)
+			copy(dAtA[i:], x.Pair)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pair)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExchangeRateTuple)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pair = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExchangeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ExchangeRateAtBlock_4_list)(nil)
+
+type _ExchangeRateAtBlock_4_list struct {
+	list *[]string
+}
+
+func (x *_ExchangeRateAtBlock_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ExchangeRateAtBlock_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ExchangeRateAtBlock_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ExchangeRateAtBlock_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ExchangeRateAtBlock_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ExchangeRateAtBlock at list field DerivedFrom as it is not of Message kind"))
+}
+
+func (x *_ExchangeRateAtBlock_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ExchangeRateAtBlock_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ExchangeRateAtBlock_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ExchangeRateAtBlock                    protoreflect.MessageDescriptor
+	fd_ExchangeRateAtBlock_exchange_rate      protoreflect.FieldDescriptor
+	fd_ExchangeRateAtBlock_created_block      protoreflect.FieldDescriptor
+	fd_ExchangeRateAtBlock_block_timestamp_ms protoreflect.FieldDescriptor
+	fd_ExchangeRateAtBlock_derived_from       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_oracle_v1_oracle_proto_init()
+	md_ExchangeRateAtBlock = File_nibiru_oracle_v1_oracle_proto.Messages().ByName("ExchangeRateAtBlock")
+	fd_ExchangeRateAtBlock_exchange_rate = md_ExchangeRateAtBlock.Fields().ByName("exchange_rate")
+	fd_ExchangeRateAtBlock_created_block = md_ExchangeRateAtBlock.Fields().ByName("created_block")
+	fd_ExchangeRateAtBlock_block_timestamp_ms = md_ExchangeRateAtBlock.Fields().ByName("block_timestamp_ms")
+	fd_ExchangeRateAtBlock_derived_from = md_ExchangeRateAtBlock.Fields().ByName("derived_from")
+}
+
+var _ protoreflect.Message = (*fastReflection_ExchangeRateAtBlock)(nil)
+
+type fastReflection_ExchangeRateAtBlock ExchangeRateAtBlock
+
+func (x *ExchangeRateAtBlock) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExchangeRateAtBlock)(x)
+}
+
+func (x *ExchangeRateAtBlock) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ExchangeRateAtBlock_messageType fastReflection_ExchangeRateAtBlock_messageType
+var _ protoreflect.MessageType = fastReflection_ExchangeRateAtBlock_messageType{}
+
+type fastReflection_ExchangeRateAtBlock_messageType struct{}
+
+func (x fastReflection_ExchangeRateAtBlock_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExchangeRateAtBlock)(nil)
+}
+func (x fastReflection_ExchangeRateAtBlock_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExchangeRateAtBlock)
+}
+func (x fastReflection_ExchangeRateAtBlock_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExchangeRateAtBlock
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ExchangeRateAtBlock) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExchangeRateAtBlock
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ExchangeRateAtBlock) Type() protoreflect.MessageType {
+	return _fastReflection_ExchangeRateAtBlock_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ExchangeRateAtBlock) New() protoreflect.Message {
+	return new(fastReflection_ExchangeRateAtBlock)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ExchangeRateAtBlock) Interface() protoreflect.ProtoMessage {
+	return (*ExchangeRateAtBlock)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ExchangeRateAtBlock) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExchangeRate != "" {
+		value := protoreflect.ValueOfString(x.ExchangeRate)
+		if !f(fd_ExchangeRateAtBlock_exchange_rate, value) {
+			return
+		}
+	}
+	if x.CreatedBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CreatedBlock)
+		if !f(fd_ExchangeRateAtBlock_created_block, value) {
+			return
+		}
+	}
+	if x.BlockTimestampMs != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockTimestampMs)
+		if !f(fd_ExchangeRateAtBlock_block_timestamp_ms, value) {
+			return
+		}
+	}
+	if len(x.DerivedFrom) != 0 {
+		value := protoreflect.ValueOfList(&_ExchangeRateAtBlock_4_list{list: &x.DerivedFrom})
+		if !f(fd_ExchangeRateAtBlock_derived_from, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExchangeRateAtBlock) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.exchange_rate":
+		return x.ExchangeRate != ""
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.created_block":
+		return x.CreatedBlock != uint64(0)
 	case "nibiru.oracle.v1.ExchangeRateAtBlock.block_timestamp_ms":
 		return x.BlockTimestampMs != int64(0)
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.derived_from":
+		return len(x.DerivedFrom) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateAtBlock"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateAtBlock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExchangeRateAtBlock) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.exchange_rate":
+		x.ExchangeRate = ""
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.created_block":
+		x.CreatedBlock = uint64(0)
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.block_timestamp_ms":
+		x.BlockTimestampMs = int64(0)
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.derived_from":
+		x.DerivedFrom = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateAtBlock"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateAtBlock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExchangeRateAtBlock) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.exchange_rate":
+		value := x.ExchangeRate
+		return protoreflect.ValueOfString(value)
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.created_block":
+		value := x.CreatedBlock
+		return protoreflect.ValueOfUint64(value)
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.block_timestamp_ms":
+		value := x.BlockTimestampMs
+		return protoreflect.ValueOfInt64(value)
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.derived_from":
+		if len(x.DerivedFrom) == 0 {
+			return protoreflect.ValueOfList(&_ExchangeRateAtBlock_4_list{})
+		}
+		listValue := &_ExchangeRateAtBlock_4_list{list: &x.DerivedFrom}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateAtBlock"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateAtBlock does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExchangeRateAtBlock) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.exchange_rate":
+		x.ExchangeRate = value.Interface().(string)
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.created_block":
+		x.CreatedBlock = value.Uint()
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.block_timestamp_ms":
+		x.BlockTimestampMs = value.Int()
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.derived_from":
+		lv := value.List()
+		clv := lv.(*_ExchangeRateAtBlock_4_list)
+		x.DerivedFrom = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateAtBlock"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateAtBlock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExchangeRateAtBlock) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.derived_from":
+		if x.DerivedFrom == nil {
+			x.DerivedFrom = []string{}
+		}
+		value := &_ExchangeRateAtBlock_4_list{list: &x.DerivedFrom}
+		return protoreflect.ValueOfList(value)
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.exchange_rate":
+		panic(fmt.Errorf("field exchange_rate of message nibiru.oracle.v1.ExchangeRateAtBlock is not mutable"))
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.created_block":
+		panic(fmt.Errorf("field created_block of message nibiru.oracle.v1.ExchangeRateAtBlock is not mutable"))
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.block_timestamp_ms":
+		panic(fmt.Errorf("field block_timestamp_ms of message nibiru.oracle.v1.ExchangeRateAtBlock is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateAtBlock"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateAtBlock does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExchangeRateAtBlock) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.exchange_rate":
+		return protoreflect.ValueOfString("")
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.created_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.block_timestamp_ms":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nibiru.oracle.v1.ExchangeRateAtBlock.derived_from":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ExchangeRateAtBlock_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateAtBlock"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateAtBlock does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExchangeRateAtBlock) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.ExchangeRateAtBlock", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExchangeRateAtBlock) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExchangeRateAtBlock) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExchangeRateAtBlock) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExchangeRateAtBlock) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExchangeRateAtBlock)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ExchangeRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreatedBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedBlock))
+		}
+		if x.BlockTimestampMs != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockTimestampMs))
+		}
+		if len(x.DerivedFrom) > 0 {
+			for _, s := range x.DerivedFrom {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExchangeRateAtBlock)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DerivedFrom) > 0 {
+			for iNdEx := len(x.DerivedFrom) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DerivedFrom[iNdEx])
+				copy(dAtA[i:], x.DerivedFrom[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DerivedFrom[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.BlockTimestampMs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockTimestampMs))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.CreatedBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedBlock))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ExchangeRate) > 0 {
+			i -= len(x.ExchangeRate)
+			copy(dAtA[i:], x.ExchangeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExchangeRate)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExchangeRateAtBlock)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExchangeRateAtBlock: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExchangeRateAtBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExchangeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedBlock", wireType)
+				}
+				x.CreatedBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTimestampMs", wireType)
+				}
+				x.BlockTimestampMs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockTimestampMs |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DerivedFrom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DerivedFrom = append(x.DerivedFrom, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DerivedPair            protoreflect.MessageDescriptor
+	fd_DerivedPair_pair       protoreflect.FieldDescriptor
+	fd_DerivedPair_base_pair  protoreflect.FieldDescriptor
+	fd_DerivedPair_quote_pair protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_oracle_v1_oracle_proto_init()
+	md_DerivedPair = File_nibiru_oracle_v1_oracle_proto.Messages().ByName("DerivedPair")
+	fd_DerivedPair_pair = md_DerivedPair.Fields().ByName("pair")
+	fd_DerivedPair_base_pair = md_DerivedPair.Fields().ByName("base_pair")
+	fd_DerivedPair_quote_pair = md_DerivedPair.Fields().ByName("quote_pair")
+}
+
+var _ protoreflect.Message = (*fastReflection_DerivedPair)(nil)
+
+type fastReflection_DerivedPair DerivedPair
+
+func (x *DerivedPair) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DerivedPair)(x)
+}
+
+func (x *DerivedPair) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DerivedPair_messageType fastReflection_DerivedPair_messageType
+var _ protoreflect.MessageType = fastReflection_DerivedPair_messageType{}
+
+type fastReflection_DerivedPair_messageType struct{}
+
+func (x fastReflection_DerivedPair_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DerivedPair)(nil)
+}
+func (x fastReflection_DerivedPair_messageType) New() protoreflect.Message {
+	return new(fastReflection_DerivedPair)
+}
+func (x fastReflection_DerivedPair_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DerivedPair
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DerivedPair) Descriptor() protoreflect.MessageDescriptor {
+	return md_DerivedPair
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DerivedPair) Type() protoreflect.MessageType {
+	return _fastReflection_DerivedPair_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DerivedPair) New() protoreflect.Message {
+	return new(fastReflection_DerivedPair)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DerivedPair) Interface() protoreflect.ProtoMessage {
+	return (*DerivedPair)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DerivedPair) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pair != "" {
+		value := protoreflect.ValueOfString(x.Pair)
+		if !f(fd_DerivedPair_pair, value) {
+			return
+		}
+	}
+	if x.BasePair != "" {
+		value := protoreflect.ValueOfString(x.BasePair)
+		if !f(fd_DerivedPair_base_pair, value) {
+			return
+		}
+	}
+	if x.QuotePair != "" {
+		value := protoreflect.ValueOfString(x.QuotePair)
+		if !f(fd_DerivedPair_quote_pair, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DerivedPair) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.DerivedPair.pair":
+		return x.Pair != ""
+	case "nibiru.oracle.v1.DerivedPair.base_pair":
+		return x.BasePair != ""
+	case "nibiru.oracle.v1.DerivedPair.quote_pair":
+		return x.QuotePair != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.DerivedPair"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.DerivedPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DerivedPair) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.DerivedPair.pair":
+		x.Pair = ""
+	case "nibiru.oracle.v1.DerivedPair.base_pair":
+		x.BasePair = ""
+	case "nibiru.oracle.v1.DerivedPair.quote_pair":
+		x.QuotePair = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.DerivedPair"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.DerivedPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DerivedPair) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.oracle.v1.DerivedPair.pair":
+		value := x.Pair
+		return protoreflect.ValueOfString(value)
+	case "nibiru.oracle.v1.DerivedPair.base_pair":
+		value := x.BasePair
+		return protoreflect.ValueOfString(value)
+	case "nibiru.oracle.v1.DerivedPair.quote_pair":
+		value := x.QuotePair
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.DerivedPair"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.DerivedPair does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DerivedPair) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.DerivedPair.pair":
+		x.Pair = value.Interface().(string)
+	case "nibiru.oracle.v1.DerivedPair.base_pair":
+		x.BasePair = value.Interface().(string)
+	case "nibiru.oracle.v1.DerivedPair.quote_pair":
+		x.QuotePair = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.DerivedPair"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.DerivedPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DerivedPair) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.DerivedPair.pair":
+		panic(fmt.Errorf("field pair of message nibiru.oracle.v1.DerivedPair is not mutable"))
+	case "nibiru.oracle.v1.DerivedPair.base_pair":
+		panic(fmt.Errorf("field base_pair of message nibiru.oracle.v1.DerivedPair is not mutable"))
+	case "nibiru.oracle.v1.DerivedPair.quote_pair":
+		panic(fmt.Errorf("field quote_pair of message nibiru.oracle.v1.DerivedPair is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.DerivedPair"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.DerivedPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DerivedPair) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.DerivedPair.pair":
+		return protoreflect.ValueOfString("")
+	case "nibiru.oracle.v1.DerivedPair.base_pair":
+		return protoreflect.ValueOfString("")
+	case "nibiru.oracle.v1.DerivedPair.quote_pair":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.DerivedPair"))
+		}
+		panic(fmt.Errorf("message nibiru.oracle.v1.DerivedPair does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DerivedPair) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.DerivedPair", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DerivedPair) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DerivedPair) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DerivedPair) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DerivedPair) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DerivedPair)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Pair)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BasePair)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.QuotePair)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DerivedPair)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.QuotePair) > 0 {
+			i -= len(x.QuotePair)
+			copy(dAtA[i:], x.QuotePair)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.QuotePair)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BasePair) > 0 {
+			i -= len(x.BasePair)
+			copy(dAtA[i:], x.BasePair)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasePair)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Pair) > 0 {
+			i -= len(x.Pair)
+			copy(dAtA[i:], x.Pair)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pair)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DerivedPair)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DerivedPair: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DerivedPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pair = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasePair", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasePair = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuotePair", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QuotePair = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VoteAccuracy               protoreflect.MessageDescriptor
+	fd_VoteAccuracy_validator     protoreflect.FieldDescriptor
+	fd_VoteAccuracy_pair          protoreflect.FieldDescriptor
+	fd_VoteAccuracy_slash_window  protoreflect.FieldDescriptor
+	fd_VoteAccuracy_vote_count    protoreflect.FieldDescriptor
+	fd_VoteAccuracy_win_count     protoreflect.FieldDescriptor
+	fd_VoteAccuracy_abstain_count protoreflect.FieldDescriptor
+	fd_VoteAccuracy_sum_deviation protoreflect.FieldDescriptor
+	fd_VoteAccuracy_max_deviation protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_oracle_v1_oracle_proto_init()
+	md_VoteAccuracy = File_nibiru_oracle_v1_oracle_proto.Messages().ByName("VoteAccuracy")
+	fd_VoteAccuracy_validator = md_VoteAccuracy.Fields().ByName("validator")
+	fd_VoteAccuracy_pair = md_VoteAccuracy.Fields().ByName("pair")
+	fd_VoteAccuracy_slash_window = md_VoteAccuracy.Fields().ByName("slash_window")
+	fd_VoteAccuracy_vote_count = md_VoteAccuracy.Fields().ByName("vote_count")
+	fd_VoteAccuracy_win_count = md_VoteAccuracy.Fields().ByName("win_count")
+	fd_VoteAccuracy_abstain_count = md_VoteAccuracy.Fields().ByName("abstain_count")
+	fd_VoteAccuracy_sum_deviation = md_VoteAccuracy.Fields().ByName("sum_deviation")
+	fd_VoteAccuracy_max_deviation = md_VoteAccuracy.Fields().ByName("max_deviation")
+}
+
+var _ protoreflect.Message = (*fastReflection_VoteAccuracy)(nil)
+
+type fastReflection_VoteAccuracy VoteAccuracy
+
+func (x *VoteAccuracy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VoteAccuracy)(x)
+}
+
+func (x *VoteAccuracy) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VoteAccuracy_messageType fastReflection_VoteAccuracy_messageType
+var _ protoreflect.MessageType = fastReflection_VoteAccuracy_messageType{}
+
+type fastReflection_VoteAccuracy_messageType struct{}
+
+func (x fastReflection_VoteAccuracy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VoteAccuracy)(nil)
+}
+func (x fastReflection_VoteAccuracy_messageType) New() protoreflect.Message {
+	return new(fastReflection_VoteAccuracy)
+}
+func (x fastReflection_VoteAccuracy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VoteAccuracy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VoteAccuracy) Descriptor() protoreflect.MessageDescriptor {
+	return md_VoteAccuracy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VoteAccuracy) Type() protoreflect.MessageType {
+	return _fastReflection_VoteAccuracy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VoteAccuracy) New() protoreflect.Message {
+	return new(fastReflection_VoteAccuracy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VoteAccuracy) Interface() protoreflect.ProtoMessage {
+	return (*VoteAccuracy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VoteAccuracy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_VoteAccuracy_validator, value) {
+			return
+		}
+	}
+	if x.Pair != "" {
+		value := protoreflect.ValueOfString(x.Pair)
+		if !f(fd_VoteAccuracy_pair, value) {
+			return
+		}
+	}
+	if x.SlashWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashWindow)
+		if !f(fd_VoteAccuracy_slash_window, value) {
+			return
+		}
+	}
+	if x.VoteCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VoteCount)
+		if !f(fd_VoteAccuracy_vote_count, value) {
+			return
+		}
+	}
+	if x.WinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WinCount)
+		if !f(fd_VoteAccuracy_win_count, value) {
+			return
+		}
+	}
+	if x.AbstainCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AbstainCount)
+		if !f(fd_VoteAccuracy_abstain_count, value) {
+			return
+		}
+	}
+	if x.SumDeviation != "" {
+		value := protoreflect.ValueOfString(x.SumDeviation)
+		if !f(fd_VoteAccuracy_sum_deviation, value) {
+			return
+		}
+	}
+	if x.MaxDeviation != "" {
+		value := protoreflect.ValueOfString(x.MaxDeviation)
+		if !f(fd_VoteAccuracy_max_deviation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VoteAccuracy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.oracle.v1.VoteAccuracy.validator":
+		return x.Validator != ""
+	case "nibiru.oracle.v1.VoteAccuracy.pair":
+		return x.Pair != ""
+	case "nibiru.oracle.v1.VoteAccuracy.slash_window":
+		return x.SlashWindow != uint64(0)
+	case "nibiru.oracle.v1.VoteAccuracy.vote_count":
+		return x.VoteCount != uint64(0)
+	case "nibiru.oracle.v1.VoteAccuracy.win_count":
+		return x.WinCount != uint64(0)
+	case "nibiru.oracle.v1.VoteAccuracy.abstain_count":
+		return x.AbstainCount != uint64(0)
+	case "nibiru.oracle.v1.VoteAccuracy.sum_deviation":
+		return x.SumDeviation != ""
+	case "nibiru.oracle.v1.VoteAccuracy.max_deviation":
+		return x.MaxDeviation != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateAtBlock"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.VoteAccuracy"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateAtBlock does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.VoteAccuracy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2794,19 +4862,29 @@ func (x *fastReflection_ExchangeRateAtBlock) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExchangeRateAtBlock) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_VoteAccuracy) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.exchange_rate":
-		x.ExchangeRate = ""
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.created_block":
-		x.CreatedBlock = uint64(0)
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.block_timestamp_ms":
-		x.BlockTimestampMs = int64(0)
+	case "nibiru.oracle.v1.VoteAccuracy.validator":
+		x.Validator = ""
+	case "nibiru.oracle.v1.VoteAccuracy.pair":
+		x.Pair = ""
+	case "nibiru.oracle.v1.VoteAccuracy.slash_window":
+		x.SlashWindow = uint64(0)
+	case "nibiru.oracle.v1.VoteAccuracy.vote_count":
+		x.VoteCount = uint64(0)
+	case "nibiru.oracle.v1.VoteAccuracy.win_count":
+		x.WinCount = uint64(0)
+	case "nibiru.oracle.v1.VoteAccuracy.abstain_count":
+		x.AbstainCount = uint64(0)
+	case "nibiru.oracle.v1.VoteAccuracy.sum_deviation":
+		x.SumDeviation = ""
+	case "nibiru.oracle.v1.VoteAccuracy.max_deviation":
+		x.MaxDeviation = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateAtBlock"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.VoteAccuracy"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateAtBlock does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.VoteAccuracy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2816,22 +4894,37 @@ func (x *fastReflection_ExchangeRateAtBlock) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ExchangeRateAtBlock) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VoteAccuracy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.exchange_rate":
-		value := x.ExchangeRate
+	case "nibiru.oracle.v1.VoteAccuracy.validator":
+		value := x.Validator
 		return protoreflect.ValueOfString(value)
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.created_block":
-		value := x.CreatedBlock
+	case "nibiru.oracle.v1.VoteAccuracy.pair":
+		value := x.Pair
+		return protoreflect.ValueOfString(value)
+	case "nibiru.oracle.v1.VoteAccuracy.slash_window":
+		value := x.SlashWindow
 		return protoreflect.ValueOfUint64(value)
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.block_timestamp_ms":
-		value := x.BlockTimestampMs
-		return protoreflect.ValueOfInt64(value)
+	case "nibiru.oracle.v1.VoteAccuracy.vote_count":
+		value := x.VoteCount
+		return protoreflect.ValueOfUint64(value)
+	case "nibiru.oracle.v1.VoteAccuracy.win_count":
+		value := x.WinCount
+		return protoreflect.ValueOfUint64(value)
+	case "nibiru.oracle.v1.VoteAccuracy.abstain_count":
+		value := x.AbstainCount
+		return protoreflect.ValueOfUint64(value)
+	case "nibiru.oracle.v1.VoteAccuracy.sum_deviation":
+		value := x.SumDeviation
+		return protoreflect.ValueOfString(value)
+	case "nibiru.oracle.v1.VoteAccuracy.max_deviation":
+		value := x.MaxDeviation
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateAtBlock"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.VoteAccuracy"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateAtBlock does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.VoteAccuracy does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2845,19 +4938,29 @@ func (x *fastReflection_ExchangeRateAtBlock) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExchangeRateAtBlock) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_VoteAccuracy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.exchange_rate":
-		x.ExchangeRate = value.Interface().(string)
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.created_block":
-		x.CreatedBlock = value.Uint()
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.block_timestamp_ms":
-		x.BlockTimestampMs = value.Int()
+	case "nibiru.oracle.v1.VoteAccuracy.validator":
+		x.Validator = value.Interface().(string)
+	case "nibiru.oracle.v1.VoteAccuracy.pair":
+		x.Pair = value.Interface().(string)
+	case "nibiru.oracle.v1.VoteAccuracy.slash_window":
+		x.SlashWindow = value.Uint()
+	case "nibiru.oracle.v1.VoteAccuracy.vote_count":
+		x.VoteCount = value.Uint()
+	case "nibiru.oracle.v1.VoteAccuracy.win_count":
+		x.WinCount = value.Uint()
+	case "nibiru.oracle.v1.VoteAccuracy.abstain_count":
+		x.AbstainCount = value.Uint()
+	case "nibiru.oracle.v1.VoteAccuracy.sum_deviation":
+		x.SumDeviation = value.Interface().(string)
+	case "nibiru.oracle.v1.VoteAccuracy.max_deviation":
+		x.MaxDeviation = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateAtBlock"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.VoteAccuracy"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateAtBlock does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.VoteAccuracy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2871,48 +4974,68 @@ func (x *fastReflection_ExchangeRateAtBlock) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExchangeRateAtBlock) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VoteAccuracy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.exchange_rate":
-		panic(fmt.Errorf("field exchange_rate of message nibiru.oracle.v1.ExchangeRateAtBlock is not mutable"))
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.created_block":
-		panic(fmt.Errorf("field created_block of message nibiru.oracle.v1.ExchangeRateAtBlock is not mutable"))
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.block_timestamp_ms":
-		panic(fmt.Errorf("field block_timestamp_ms of message nibiru.oracle.v1.ExchangeRateAtBlock is not mutable"))
+	case "nibiru.oracle.v1.VoteAccuracy.validator":
+		panic(fmt.Errorf("field validator of message nibiru.oracle.v1.VoteAccuracy is not mutable"))
+	case "nibiru.oracle.v1.VoteAccuracy.pair":
+		panic(fmt.Errorf("field pair of message nibiru.oracle.v1.VoteAccuracy is not mutable"))
+	case "nibiru.oracle.v1.VoteAccuracy.slash_window":
+		panic(fmt.Errorf("field slash_window of message nibiru.oracle.v1.VoteAccuracy is not mutable"))
+	case "nibiru.oracle.v1.VoteAccuracy.vote_count":
+		panic(fmt.Errorf("field vote_count of message nibiru.oracle.v1.VoteAccuracy is not mutable"))
+	case "nibiru.oracle.v1.VoteAccuracy.win_count":
+		panic(fmt.Errorf("field win_count of message nibiru.oracle.v1.VoteAccuracy is not mutable"))
+	case "nibiru.oracle.v1.VoteAccuracy.abstain_count":
+		panic(fmt.Errorf("field abstain_count of message nibiru.oracle.v1.VoteAccuracy is not mutable"))
+	case "nibiru.oracle.v1.VoteAccuracy.sum_deviation":
+		panic(fmt.Errorf("field sum_deviation of message nibiru.oracle.v1.VoteAccuracy is not mutable"))
+	case "nibiru.oracle.v1.VoteAccuracy.max_deviation":
+		panic(fmt.Errorf("field max_deviation of message nibiru.oracle.v1.VoteAccuracy is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateAtBlock"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.VoteAccuracy"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateAtBlock does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.VoteAccuracy does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ExchangeRateAtBlock) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VoteAccuracy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.exchange_rate":
+	case "nibiru.oracle.v1.VoteAccuracy.validator":
 		return protoreflect.ValueOfString("")
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.created_block":
+	case "nibiru.oracle.v1.VoteAccuracy.pair":
+		return protoreflect.ValueOfString("")
+	case "nibiru.oracle.v1.VoteAccuracy.slash_window":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "nibiru.oracle.v1.ExchangeRateAtBlock.block_timestamp_ms":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "nibiru.oracle.v1.VoteAccuracy.vote_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.oracle.v1.VoteAccuracy.win_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.oracle.v1.VoteAccuracy.abstain_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.oracle.v1.VoteAccuracy.sum_deviation":
+		return protoreflect.ValueOfString("")
+	case "nibiru.oracle.v1.VoteAccuracy.max_deviation":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.ExchangeRateAtBlock"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.oracle.v1.VoteAccuracy"))
 		}
-		panic(fmt.Errorf("message nibiru.oracle.v1.ExchangeRateAtBlock does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message nibiru.oracle.v1.VoteAccuracy does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ExchangeRateAtBlock) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_VoteAccuracy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.ExchangeRateAtBlock", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.oracle.v1.VoteAccuracy", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2920,7 +5043,7 @@ func (x *fastReflection_ExchangeRateAtBlock) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ExchangeRateAtBlock) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_VoteAccuracy) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2931,7 +5054,7 @@ func (x *fastReflection_ExchangeRateAtBlock) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExchangeRateAtBlock) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_VoteAccuracy) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2943,7 +5066,7 @@ func (x *fastReflection_ExchangeRateAtBlock) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ExchangeRateAtBlock) IsValid() bool {
+func (x *fastReflection_VoteAccuracy) IsValid() bool {
 	return x != nil
 }
 
@@ -2953,9 +5076,9 @@ func (x *fastReflection_ExchangeRateAtBlock) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ExchangeRateAtBlock) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_VoteAccuracy) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ExchangeRateAtBlock)
+		x := input.Message.Interface().(*VoteAccuracy)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2967,15 +5090,33 @@ func (x *fastReflection_ExchangeRateAtBlock) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.ExchangeRate)
+		l = len(x.Validator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.CreatedBlock != 0 {
-			n += 1 + runtime.Sov(uint64(x.CreatedBlock))
+		l = len(x.Pair)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockTimestampMs != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockTimestampMs))
+		if x.SlashWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashWindow))
+		}
+		if x.VoteCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.VoteCount))
+		}
+		if x.WinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.WinCount))
+		}
+		if x.AbstainCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AbstainCount))
+		}
+		l = len(x.SumDeviation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxDeviation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2987,7 +5128,7 @@ func (x *fastReflection_ExchangeRateAtBlock) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ExchangeRateAtBlock)
+		x := input.Message.Interface().(*VoteAccuracy)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3006,20 +5147,51 @@ func (x *fastReflection_ExchangeRateAtBlock) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockTimestampMs != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockTimestampMs))
+		if len(x.MaxDeviation) > 0 {
+			i -= len(x.MaxDeviation)
+			copy(dAtA[i:], x.MaxDeviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxDeviation)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.SumDeviation) > 0 {
+			i -= len(x.SumDeviation)
+			copy(dAtA[i:], x.SumDeviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SumDeviation)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.AbstainCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AbstainCount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.WinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WinCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.VoteCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VoteCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SlashWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashWindow))
 			i--
 			dAtA[i] = 0x18
 		}
-		if x.CreatedBlock != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedBlock))
+		if len(x.Pair) > 0 {
+			i -= len(x.Pair)
+			copy(dAtA[i:], x.Pair)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pair)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.ExchangeRate) > 0 {
-			i -= len(x.ExchangeRate)
-			copy(dAtA[i:], x.ExchangeRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExchangeRate)))
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3034,7 +5206,7 @@ func (x *fastReflection_ExchangeRateAtBlock) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ExchangeRateAtBlock)
+		x := input.Message.Interface().(*VoteAccuracy)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3066,15 +5238,15 @@ func (x *fastReflection_ExchangeRateAtBlock) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExchangeRateAtBlock: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VoteAccuracy: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExchangeRateAtBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VoteAccuracy: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3102,13 +5274,13 @@ func (x *fastReflection_ExchangeRateAtBlock) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExchangeRate = string(dAtA[iNdEx:postIndex])
+				x.Validator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedBlock", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 				}
-				x.CreatedBlock = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3118,16 +5290,137 @@ func (x *fastReflection_ExchangeRateAtBlock) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.CreatedBlock |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pair = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTimestampMs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+				}
+				x.SlashWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteCount", wireType)
+				}
+				x.VoteCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VoteCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WinCount", wireType)
+				}
+				x.WinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				}
+				x.AbstainCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AbstainCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SumDeviation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BlockTimestampMs = 0
+				x.SumDeviation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+				}
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3137,11 +5430,24 @@ func (x *fastReflection_ExchangeRateAtBlock) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockTimestampMs |= int64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxDeviation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3252,7 +5558,7 @@ func (x *Rewards) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Rewards) slowProtoReflect() protoreflect.Message {
-	mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[5]
+	mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3780,6 +6086,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// AggregationMethod defines how the votes of a ballot are aggregated into an
+// exchange rate. There is no volume-weighted average price (VWAP) method:
+// votes carry an exchange rate but no traded volume to weight it by.
+type AggregationMethod int32
+
+const (
+	// Median of the votes weighted by voting power.
+	AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEDIAN AggregationMethod = 0
+	// Mean of the votes weighted by voting power, after discarding
+	// "trim_fraction" of the voting power at each end of the sorted votes.
+	AggregationMethod_AGGREGATION_METHOD_TRIMMED_MEAN AggregationMethod = 1
+	// Mean of the votes weighted by voting power.
+	AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEAN AggregationMethod = 2
+)
+
+// Enum value maps for AggregationMethod.
+var (
+	AggregationMethod_name = map[int32]string{
+		0: "AGGREGATION_METHOD_WEIGHTED_MEDIAN",
+		1: "AGGREGATION_METHOD_TRIMMED_MEAN",
+		2: "AGGREGATION_METHOD_WEIGHTED_MEAN",
+	}
+	AggregationMethod_value = map[string]int32{
+		"AGGREGATION_METHOD_WEIGHTED_MEDIAN": 0,
+		"AGGREGATION_METHOD_TRIMMED_MEAN":    1,
+		"AGGREGATION_METHOD_WEIGHTED_MEAN":   2,
+	}
+)
+
+func (x AggregationMethod) Enum() *AggregationMethod {
+	p := new(AggregationMethod)
+	*p = x
+	return p
+}
+
+func (x AggregationMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregationMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_nibiru_oracle_v1_oracle_proto_enumTypes[0].Descriptor()
+}
+
+func (AggregationMethod) Type() protoreflect.EnumType {
+	return &file_nibiru_oracle_v1_oracle_proto_enumTypes[0]
+}
+
+func (x AggregationMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregationMethod.Descriptor instead.
+func (AggregationMethod) EnumDescriptor() ([]byte, []int) {
+	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
+}
+
 // Params defines the module parameters for the x/oracle module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -3794,10 +6156,8 @@ type Params struct {
 	// RewardBand defines a maximum divergence that a price vote can have from the
 	// weighted median in the ballot. If a vote lies within the valid range
 	// defined by:
-	//
 	//	μ := weightedMedian,
 	//	validRange := μ ± (μ * rewardBand / 2),
-	//
 	// then rewards are added to the validator performance.
 	// Note that if the reward band is smaller than 1 standard
 	// deviation, the band is taken to be 1 standard deviation.a price
@@ -3824,6 +6184,17 @@ type Params struct {
 	// The validator fee ratio that is given to validators every epoch.
 	ValidatorFeeRatio string `protobuf:"bytes,10,opt,name=validator_fee_ratio,json=validatorFeeRatio,proto3" json:"validator_fee_ratio,omitempty"`
 	ExpirationBlocks  uint64 `protobuf:"varint,11,opt,name=expiration_blocks,json=expirationBlocks,proto3" json:"expiration_blocks,omitempty"`
+	// Number of TWAP lookback windows of price snapshots to retain. Snapshots
+	// older than "snapshot_retention_windows * twap_lookback_window" are pruned
+	// in the EndBlocker. Zero disables pruning and keeps every snapshot.
+	SnapshotRetentionWindows uint64 `protobuf:"varint,12,opt,name=snapshot_retention_windows,json=snapshotRetentionWindows,proto3" json:"snapshot_retention_windows,omitempty"`
+	// Aggregation strategies of the pairs that don't use the default weighted
+	// median of the votes.
+	PairAggregations []*PairAggregation `protobuf:"bytes,13,rep,name=pair_aggregations,json=pairAggregations,proto3" json:"pair_aggregations,omitempty"`
+	// Number of completed slash windows whose vote accuracy records are kept
+	// in addition to the current slash window. Older records are pruned at the
+	// end of each slash window. Zero keeps only the current slash window.
+	VoteAccuracyRetentionWindows uint64 `protobuf:"varint,14,opt,name=vote_accuracy_retention_windows,json=voteAccuracyRetentionWindows,proto3" json:"vote_accuracy_retention_windows,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -3923,6 +6294,91 @@ func (x *Params) GetExpirationBlocks() uint64 {
 	return 0
 }
 
+func (x *Params) GetSnapshotRetentionWindows() uint64 {
+	if x != nil {
+		return x.SnapshotRetentionWindows
+	}
+	return 0
+}
+
+func (x *Params) GetPairAggregations() []*PairAggregation {
+	if x != nil {
+		return x.PairAggregations
+	}
+	return nil
+}
+
+func (x *Params) GetVoteAccuracyRetentionWindows() uint64 {
+	if x != nil {
+		return x.VoteAccuracyRetentionWindows
+	}
+	return 0
+}
+
+// PairAggregation defines the aggregation strategy of the votes of a pair.
+type PairAggregation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair   string            `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Method AggregationMethod `protobuf:"varint,2,opt,name=method,proto3,enum=nibiru.oracle.v1.AggregationMethod" json:"method,omitempty"`
+	// Fraction of the voting power discarded at each end of the sorted votes
+	// by AGGREGATION_METHOD_TRIMMED_MEAN. Must be in [0, 0.5).
+	TrimFraction string `protobuf:"bytes,3,opt,name=trim_fraction,json=trimFraction,proto3" json:"trim_fraction,omitempty"`
+	// If positive, the ballot is rejected when the standard deviation of the
+	// votes is larger than "max_std_dev_ratio * exchange_rate".
+	MaxStdDevRatio string `protobuf:"bytes,4,opt,name=max_std_dev_ratio,json=maxStdDevRatio,proto3" json:"max_std_dev_ratio,omitempty"`
+}
+
+func (x *PairAggregation) Reset() {
+	*x = PairAggregation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairAggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairAggregation) ProtoMessage() {}
+
+// Deprecated: Use PairAggregation.ProtoReflect.Descriptor instead.
+func (*PairAggregation) Descriptor() ([]byte, []int) {
+	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PairAggregation) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+func (x *PairAggregation) GetMethod() AggregationMethod {
+	if x != nil {
+		return x.Method
+	}
+	return AggregationMethod_AGGREGATION_METHOD_WEIGHTED_MEDIAN
+}
+
+func (x *PairAggregation) GetTrimFraction() string {
+	if x != nil {
+		return x.TrimFraction
+	}
+	return ""
+}
+
+func (x *PairAggregation) GetMaxStdDevRatio() string {
+	if x != nil {
+		return x.MaxStdDevRatio
+	}
+	return ""
+}
+
 // Struct for aggregate prevoting on the ExchangeRateVote.
 // The purpose of aggregate prevote is to hide vote exchange rates with hash
 // which is formatted as hex string in
@@ -3940,7 +6396,7 @@ type AggregateExchangeRatePrevote struct {
 func (x *AggregateExchangeRatePrevote) Reset() {
 	*x = AggregateExchangeRatePrevote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[1]
+		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3954,7 +6410,7 @@ func (*AggregateExchangeRatePrevote) ProtoMessage() {}
 
 // Deprecated: Use AggregateExchangeRatePrevote.ProtoReflect.Descriptor instead.
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *AggregateExchangeRatePrevote) GetHash() string {
@@ -3992,7 +6448,7 @@ type AggregateExchangeRateVote struct {
 func (x *AggregateExchangeRateVote) Reset() {
 	*x = AggregateExchangeRateVote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[2]
+		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4006,7 +6462,7 @@ func (*AggregateExchangeRateVote) ProtoMessage() {}
 
 // Deprecated: Use AggregateExchangeRateVote.ProtoReflect.Descriptor instead.
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AggregateExchangeRateVote) GetExchangeRateTuples() []*ExchangeRateTuple {
@@ -4036,7 +6492,7 @@ type ExchangeRateTuple struct {
 func (x *ExchangeRateTuple) Reset() {
 	*x = ExchangeRateTuple{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[3]
+		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4050,7 +6506,7 @@ func (*ExchangeRateTuple) ProtoMessage() {}
 
 // Deprecated: Use ExchangeRateTuple.ProtoReflect.Descriptor instead.
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
+	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ExchangeRateTuple) GetPair() string {
@@ -4078,12 +6534,15 @@ type ExchangeRateAtBlock struct {
 	// price. This timestamp is a conventional Unix millisecond time, i.e. the
 	// number of milliseconds elapsed since January 1, 1970 UTC.
 	BlockTimestampMs int64 `protobuf:"varint,3,opt,name=block_timestamp_ms,json=blockTimestampMs,proto3" json:"block_timestamp_ms,omitempty"`
+	// Pairs the exchange rate was derived from if the pair is a DerivedPair.
+	// Empty if validators voted on the pair directly.
+	DerivedFrom []string `protobuf:"bytes,4,rep,name=derived_from,json=derivedFrom,proto3" json:"derived_from,omitempty"`
 }
 
 func (x *ExchangeRateAtBlock) Reset() {
 	*x = ExchangeRateAtBlock{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[4]
+		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4097,7 +6556,7 @@ func (*ExchangeRateAtBlock) ProtoMessage() {}
 
 // Deprecated: Use ExchangeRateAtBlock.ProtoReflect.Descriptor instead.
 func (*ExchangeRateAtBlock) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
+	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ExchangeRateAtBlock) GetExchangeRate() string {
@@ -4121,6 +6580,177 @@ func (x *ExchangeRateAtBlock) GetBlockTimestampMs() int64 {
 	return 0
 }
 
+func (x *ExchangeRateAtBlock) GetDerivedFrom() []string {
+	if x != nil {
+		return x.DerivedFrom
+	}
+	return nil
+}
+
+// DerivedPair defines a pair that validators don't vote on. Its exchange rate
+// is computed from the exchange rates of two voted pairs that share the same
+// quote asset, each time both of them are tallied:
+//
+//	rate(pair) = rate(base_pair) / rate(quote_pair)
+//
+// Ex. "ueth:unibi" is derived from "ueth:uusd" and "unibi:uusd".
+type DerivedPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The derived pair. Ex. "ueth:unibi"
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// The voted pair of the base asset of the derived pair. Ex. "ueth:uusd"
+	BasePair string `protobuf:"bytes,2,opt,name=base_pair,json=basePair,proto3" json:"base_pair,omitempty"`
+	// The voted pair of the quote asset of the derived pair. Ex. "unibi:uusd"
+	QuotePair string `protobuf:"bytes,3,opt,name=quote_pair,json=quotePair,proto3" json:"quote_pair,omitempty"`
+}
+
+func (x *DerivedPair) Reset() {
+	*x = DerivedPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DerivedPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DerivedPair) ProtoMessage() {}
+
+// Deprecated: Use DerivedPair.ProtoReflect.Descriptor instead.
+func (*DerivedPair) Descriptor() ([]byte, []int) {
+	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DerivedPair) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+func (x *DerivedPair) GetBasePair() string {
+	if x != nil {
+		return x.BasePair
+	}
+	return ""
+}
+
+func (x *DerivedPair) GetQuotePair() string {
+	if x != nil {
+		return x.QuotePair
+	}
+	return ""
+}
+
+// VoteAccuracy records how far the exchange rate votes of a validator for a
+// pair deviated from the tallied exchange rate, i.e. the weighted median by
+// default, during a slash window. Deviations are relative to the tallied
+// exchange rate: |vote - rate| / rate.
+type VoteAccuracy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Operator address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// The voted pair.
+	Pair string `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	// Index of the slash window, i.e. block height / slash_window.
+	SlashWindow uint64 `protobuf:"varint,3,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty"`
+	// Number of tallied votes with a positive exchange rate.
+	VoteCount uint64 `protobuf:"varint,4,opt,name=vote_count,json=voteCount,proto3" json:"vote_count,omitempty"`
+	// Number of tallied votes inside the reward spread of the exchange rate.
+	WinCount uint64 `protobuf:"varint,5,opt,name=win_count,json=winCount,proto3" json:"win_count,omitempty"`
+	// Number of tallied abstain votes.
+	AbstainCount uint64 `protobuf:"varint,6,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
+	// Sum of the relative deviations of the votes from the exchange rate.
+	SumDeviation string `protobuf:"bytes,7,opt,name=sum_deviation,json=sumDeviation,proto3" json:"sum_deviation,omitempty"`
+	// Largest relative deviation of a vote from the exchange rate.
+	MaxDeviation string `protobuf:"bytes,8,opt,name=max_deviation,json=maxDeviation,proto3" json:"max_deviation,omitempty"`
+}
+
+func (x *VoteAccuracy) Reset() {
+	*x = VoteAccuracy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteAccuracy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteAccuracy) ProtoMessage() {}
+
+// Deprecated: Use VoteAccuracy.ProtoReflect.Descriptor instead.
+func (*VoteAccuracy) Descriptor() ([]byte, []int) {
+	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VoteAccuracy) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *VoteAccuracy) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+func (x *VoteAccuracy) GetSlashWindow() uint64 {
+	if x != nil {
+		return x.SlashWindow
+	}
+	return 0
+}
+
+func (x *VoteAccuracy) GetVoteCount() uint64 {
+	if x != nil {
+		return x.VoteCount
+	}
+	return 0
+}
+
+func (x *VoteAccuracy) GetWinCount() uint64 {
+	if x != nil {
+		return x.WinCount
+	}
+	return 0
+}
+
+func (x *VoteAccuracy) GetAbstainCount() uint64 {
+	if x != nil {
+		return x.AbstainCount
+	}
+	return 0
+}
+
+func (x *VoteAccuracy) GetSumDeviation() string {
+	if x != nil {
+		return x.SumDeviation
+	}
+	return ""
+}
+
+func (x *VoteAccuracy) GetMaxDeviation() string {
+	if x != nil {
+		return x.MaxDeviation
+	}
+	return ""
+}
+
 // Rewards defines a credit object towards validators
 // which provide prices faithfully for different pairs.
 type Rewards struct {
@@ -4140,7 +6770,7 @@ type Rewards struct {
 func (x *Rewards) Reset() {
 	*x = Rewards{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[5]
+		mi := &file_nibiru_oracle_v1_oracle_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4154,7 +6784,7 @@ func (*Rewards) ProtoMessage() {}
 
 // Deprecated: Use Rewards.ProtoReflect.Descriptor instead.
 func (*Rewards) Descriptor() ([]byte, []int) {
-	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
+	return file_nibiru_oracle_v1_oracle_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Rewards) GetId() uint64 {
@@ -4191,7 +6821,7 @@ var file_nibiru_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69,
 	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xde, 0x08, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x37, 0x0a,
+	0x74, 0x6f, 0x22, 0xa8, 0x0b, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x37, 0x0a,
 	0x0b, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x04, 0x42, 0x16, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f,
 	0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65,
@@ -4260,84 +6890,209 @@ var file_nibiru_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xf2,
 	0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x52, 0x10, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x3a, 0x04, 0xe8,
-	0xa0, 0x1f, 0x01, 0x22, 0xb1, 0x01, 0x0a, 0x1c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65,
-	0x76, 0x6f, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0f, 0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x61,
-	0x73, 0x68, 0x22, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x6f, 0x74,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x22, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65,
-	0x72, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
-	0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x08, 0x88,
-	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xe0, 0x01, 0x0a, 0x19, 0x41, 0x67, 0x67, 0x72,
-	0x65, 0x67, 0x61, 0x74, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
-	0x65, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x90, 0x01, 0x0a, 0x14, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x42, 0x39, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
-	0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xaa, 0xdf,
-	0x1f, 0x12, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x54, 0x75,
-	0x70, 0x6c, 0x65, 0x73, 0x52, 0x12, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
-	0x74, 0x65, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x63, 0x0a,
+	0x1a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0x52, 0x18, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x12, 0x70, 0x0a, 0x11, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x69, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x20, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x70, 0x61, 0x69, 0x72, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x52, 0x10, 0x70, 0x61, 0x69, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x71, 0x0a, 0x1f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63,
+	0x75, 0x72, 0x61, 0x63, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xf2,
+	0xde, 0x1f, 0x26, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x63,
+	0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0x52, 0x1c, 0x76, 0x6f, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb2, 0x03,
+	0x0a, 0x0f, 0x50, 0x61, 0x69, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x5f, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x4b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x78, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0xf2, 0xde, 0x1f,
+	0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x61, 0x69, 0x72, 0x22, 0x52, 0x04, 0x70, 0x61,
+	0x69, 0x72, 0x12, 0x4e, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x6e, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde,
+	0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x66, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x6d, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x78, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x64, 0x5f, 0x64, 0x65,
+	0x76, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4d, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x74, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x22, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x6d, 0x61,
+	0x78, 0x53, 0x74, 0x64, 0x44, 0x65, 0x76, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xb1, 0x01, 0x0a, 0x1c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76,
+	0x6f, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0f, 0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x61, 0x73,
+	0x68, 0x22, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65,
 	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d,
 	0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x22, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72,
-	0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xee, 0x01, 0x0a, 0x11, 0x45,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x54, 0x75, 0x70, 0x6c, 0x65,
-	0x12, 0x5f, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4b,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x78, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0xf2, 0xde, 0x1f, 0x0b,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x61, 0x69, 0x72, 0x22, 0x52, 0x04, 0x70, 0x61, 0x69,
-	0x72, 0x12, 0x6e, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61,
-	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
-	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f,
-	0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x44, 0x65, 0x63, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
-	0x65, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x91, 0x02, 0x0a, 0x13,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x41, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x12, 0x6e, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2,
-	0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x18, 0xf2, 0xde, 0x1f, 0x14,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x4b, 0x0a, 0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d,
-	0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x22, 0x52, 0x10, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x22,
-	0x73, 0x0a, 0x07, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x6f,
-	0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x35, 0x0a,
-	0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x63,
-	0x6f, 0x69, 0x6e, 0x73, 0x42, 0xb1, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x69, 0x62,
-	0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x4f, 0x58, 0xaa, 0x02,
-	0x10, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x10, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x4f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x3a, 0x3a, 0x4f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52,
+	0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x08, 0x88, 0xa0,
+	0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xe0, 0x01, 0x0a, 0x19, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x56, 0x6f, 0x74, 0x65, 0x12, 0x90, 0x01, 0x0a, 0x14, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x42, 0x39, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde,
+	0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xaa, 0xdf, 0x1f,
+	0x12, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x54, 0x75, 0x70,
+	0x6c, 0x65, 0x73, 0x52, 0x12, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x22, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x3a,
+	0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xee, 0x01, 0x0a, 0x11, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12,
+	0x5f, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4b, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69,
+	0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x78, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0xf2, 0xde, 0x1f, 0x0b, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x61, 0x69, 0x72, 0x22, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72,
+	0x12, 0x6e, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x14,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x89, 0x03, 0x0a, 0x13, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x41, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x6e, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde,
+	0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x18, 0xf2, 0xde, 0x1f, 0x14, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x4b, 0x0a, 0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d, 0xf2,
+	0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x22, 0x52, 0x10, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x12, 0x76,
+	0x0a, 0x0c, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x53, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x34, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x78,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x50, 0x61,
+	0x69, 0x72, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x72, 0x69,
+	0x76, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x52, 0x0b, 0x64, 0x65, 0x72, 0x69, 0x76,
+	0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xcf, 0x02, 0x0a, 0x0b, 0x44, 0x65, 0x72, 0x69, 0x76,
+	0x65, 0x64, 0x50, 0x61, 0x69, 0x72, 0x12, 0x5f, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x4b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x34, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x78,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x50, 0x61,
+	0x69, 0x72, 0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x61, 0x69, 0x72,
+	0x22, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x6d, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x50, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e,
+	0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x2f, 0x76, 0x32, 0x2f, 0x78, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x22, 0x52, 0x08, 0x62, 0x61,
+	0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x70, 0x0a, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f,
+	0x70, 0x61, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x51, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e,
+	0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x2f, 0x76, 0x32, 0x2f, 0x78, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x22, 0x52, 0x09, 0x71,
+	0x75, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x69, 0x72, 0x22, 0xe7, 0x04, 0x0a, 0x0c, 0x56, 0x6f, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x32, 0x0a, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xf2, 0xde,
+	0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x5f, 0x0a,
+	0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4b, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69,
+	0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x78, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x70, 0x61, 0x69, 0x72, 0x22, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x3a,
+	0x0a, 0x0c, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52, 0x0b, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x34, 0x0a, 0x0a, 0x76, 0x6f,
+	0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x15,
+	0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x31, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77,
+	0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x18, 0xf2, 0xde, 0x1f, 0x14,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x52, 0x0c, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x6e, 0x0a, 0x0d, 0x73, 0x75, 0x6d, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde,
+	0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x75, 0x6d, 0x5f, 0x64, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x73, 0x75, 0x6d, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x6e, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde,
+	0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x07, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0x12, 0x35, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x2a, 0x8c, 0x01, 0x0a, 0x11, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x26, 0x0a,
+	0x22, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54,
+	0x48, 0x4f, 0x44, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x44,
+	0x49, 0x41, 0x4e, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x54, 0x52, 0x49, 0x4d,
+	0x4d, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x47,
+	0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44,
+	0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x02,
+	0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xb1, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6e,
+	0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42,
+	0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76,
+	0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x4f, 0x58,
+	0xaa, 0x02, 0x10, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x3a, 0x3a,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -4352,26 +7107,33 @@ func file_nibiru_oracle_v1_oracle_proto_rawDescGZIP() []byte {
 	return file_nibiru_oracle_v1_oracle_proto_rawDescData
 }
 
-var file_nibiru_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_nibiru_oracle_v1_oracle_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_nibiru_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_nibiru_oracle_v1_oracle_proto_goTypes = []interface{}{
-	(*Params)(nil),                       // 0: nibiru.oracle.v1.Params
-	(*AggregateExchangeRatePrevote)(nil), // 1: nibiru.oracle.v1.AggregateExchangeRatePrevote
-	(*AggregateExchangeRateVote)(nil),    // 2: nibiru.oracle.v1.AggregateExchangeRateVote
-	(*ExchangeRateTuple)(nil),            // 3: nibiru.oracle.v1.ExchangeRateTuple
-	(*ExchangeRateAtBlock)(nil),          // 4: nibiru.oracle.v1.ExchangeRateAtBlock
-	(*Rewards)(nil),                      // 5: nibiru.oracle.v1.Rewards
-	(*durationpb.Duration)(nil),          // 6: google.protobuf.Duration
-	(*v1beta1.Coin)(nil),                 // 7: cosmos.base.v1beta1.Coin
+	(AggregationMethod)(0),               // 0: nibiru.oracle.v1.AggregationMethod
+	(*Params)(nil),                       // 1: nibiru.oracle.v1.Params
+	(*PairAggregation)(nil),              // 2: nibiru.oracle.v1.PairAggregation
+	(*AggregateExchangeRatePrevote)(nil), // 3: nibiru.oracle.v1.AggregateExchangeRatePrevote
+	(*AggregateExchangeRateVote)(nil),    // 4: nibiru.oracle.v1.AggregateExchangeRateVote
+	(*ExchangeRateTuple)(nil),            // 5: nibiru.oracle.v1.ExchangeRateTuple
+	(*ExchangeRateAtBlock)(nil),          // 6: nibiru.oracle.v1.ExchangeRateAtBlock
+	(*DerivedPair)(nil),                  // 7: nibiru.oracle.v1.DerivedPair
+	(*VoteAccuracy)(nil),                 // 8: nibiru.oracle.v1.VoteAccuracy
+	(*Rewards)(nil),                      // 9: nibiru.oracle.v1.Rewards
+	(*durationpb.Duration)(nil),          // 10: google.protobuf.Duration
+	(*v1beta1.Coin)(nil),                 // 11: cosmos.base.v1beta1.Coin
 }
 var file_nibiru_oracle_v1_oracle_proto_depIdxs = []int32{
-	6, // 0: nibiru.oracle.v1.Params.twap_lookback_window:type_name -> google.protobuf.Duration
-	3, // 1: nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples:type_name -> nibiru.oracle.v1.ExchangeRateTuple
-	7, // 2: nibiru.oracle.v1.Rewards.coins:type_name -> cosmos.base.v1beta1.Coin
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	10, // 0: nibiru.oracle.v1.Params.twap_lookback_window:type_name -> google.protobuf.Duration
+	2,  // 1: nibiru.oracle.v1.Params.pair_aggregations:type_name -> nibiru.oracle.v1.PairAggregation
+	0,  // 2: nibiru.oracle.v1.PairAggregation.method:type_name -> nibiru.oracle.v1.AggregationMethod
+	5,  // 3: nibiru.oracle.v1.AggregateExchangeRateVote.exchange_rate_tuples:type_name -> nibiru.oracle.v1.ExchangeRateTuple
+	11, // 4: nibiru.oracle.v1.Rewards.coins:type_name -> cosmos.base.v1beta1.Coin
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_nibiru_oracle_v1_oracle_proto_init() }
@@ -4393,7 +7155,7 @@ func file_nibiru_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_oracle_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AggregateExchangeRatePrevote); i {
+			switch v := v.(*PairAggregation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4405,7 +7167,7 @@ func file_nibiru_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_oracle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AggregateExchangeRateVote); i {
+			switch v := v.(*AggregateExchangeRatePrevote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4417,7 +7179,7 @@ func file_nibiru_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_oracle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExchangeRateTuple); i {
+			switch v := v.(*AggregateExchangeRateVote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4429,7 +7191,7 @@ func file_nibiru_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_oracle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExchangeRateAtBlock); i {
+			switch v := v.(*ExchangeRateTuple); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4441,6 +7203,42 @@ func file_nibiru_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_nibiru_oracle_v1_oracle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeRateAtBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_oracle_v1_oracle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DerivedPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_oracle_v1_oracle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteAccuracy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_oracle_v1_oracle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Rewards); i {
 			case 0:
 				return &v.state
@@ -4458,13 +7256,14 @@ func file_nibiru_oracle_v1_oracle_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nibiru_oracle_v1_oracle_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_nibiru_oracle_v1_oracle_proto_goTypes,
 		DependencyIndexes: file_nibiru_oracle_v1_oracle_proto_depIdxs,
+		EnumInfos:         file_nibiru_oracle_v1_oracle_proto_enumTypes,
 		MessageInfos:      file_nibiru_oracle_v1_oracle_proto_msgTypes,
 	}.Build()
 	File_nibiru_oracle_v1_oracle_proto = out.File
@@ -47,6 +47,17 @@ type QueryClient interface {
 	AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PriceHistory returns the retained price snapshots of a pair in
+	// chronological order
+	PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error)
+	// DerivedPairs returns all derived pairs
+	DerivedPairs(ctx context.Context, in *QueryDerivedPairsRequest, opts ...grpc.CallOption) (*QueryDerivedPairsResponse, error)
+	// ValidatorAccuracy returns the vote accuracy records of a validator for
+	// the retained slash windows
+	ValidatorAccuracy(ctx context.Context, in *QueryValidatorAccuracyRequest, opts ...grpc.CallOption) (*QueryValidatorAccuracyResponse, error)
+	// VoteAccuracies returns the vote accuracy records of all validators for a
+	// slash window
+	VoteAccuracies(ctx context.Context, in *QueryVoteAccuraciesRequest, opts ...grpc.CallOption) (*QueryVoteAccuraciesResponse, error)
 }
 
 type queryClient struct {
@@ -165,6 +176,42 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error) {
+	out := new(QueryPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/PriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DerivedPairs(ctx context.Context, in *QueryDerivedPairsRequest, opts ...grpc.CallOption) (*QueryDerivedPairsResponse, error) {
+	out := new(QueryDerivedPairsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/DerivedPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorAccuracy(ctx context.Context, in *QueryValidatorAccuracyRequest, opts ...grpc.CallOption) (*QueryValidatorAccuracyResponse, error) {
+	out := new(QueryValidatorAccuracyResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/ValidatorAccuracy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteAccuracies(ctx context.Context, in *QueryVoteAccuraciesRequest, opts ...grpc.CallOption) (*QueryVoteAccuraciesResponse, error) {
+	out := new(QueryVoteAccuraciesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/VoteAccuracies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -194,6 +241,17 @@ type QueryServer interface {
 	AggregateVotes(context.Context, *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error)
 	// Params queries all parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PriceHistory returns the retained price snapshots of a pair in
+	// chronological order
+	PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error)
+	// DerivedPairs returns all derived pairs
+	DerivedPairs(context.Context, *QueryDerivedPairsRequest) (*QueryDerivedPairsResponse, error)
+	// ValidatorAccuracy returns the vote accuracy records of a validator for
+	// the retained slash windows
+	ValidatorAccuracy(context.Context, *QueryValidatorAccuracyRequest) (*QueryValidatorAccuracyResponse, error)
+	// VoteAccuracies returns the vote accuracy records of all validators for a
+	// slash window
+	VoteAccuracies(context.Context, *QueryVoteAccuraciesRequest) (*QueryVoteAccuraciesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -237,6 +295,18 @@ func (UnimplementedQueryServer) AggregateVotes(context.Context, *QueryAggregateV
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHistory not implemented")
+}
+func (UnimplementedQueryServer) DerivedPairs(context.Context, *QueryDerivedPairsRequest) (*QueryDerivedPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DerivedPairs not implemented")
+}
+func (UnimplementedQueryServer) ValidatorAccuracy(context.Context, *QueryValidatorAccuracyRequest) (*QueryValidatorAccuracyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAccuracy not implemented")
+}
+func (UnimplementedQueryServer) VoteAccuracies(context.Context, *QueryVoteAccuraciesRequest) (*QueryVoteAccuraciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteAccuracies not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -466,6 +536,78 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/PriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHistory(ctx, req.(*QueryPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DerivedPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDerivedPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DerivedPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/DerivedPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DerivedPairs(ctx, req.(*QueryDerivedPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorAccuracy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAccuracyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAccuracy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/ValidatorAccuracy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAccuracy(ctx, req.(*QueryValidatorAccuracyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteAccuracies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteAccuraciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteAccuracies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/VoteAccuracies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteAccuracies(ctx, req.(*QueryVoteAccuraciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -521,6 +663,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PriceHistory",
+			Handler:    _Query_PriceHistory_Handler,
+		},
+		{
+			MethodName: "DerivedPairs",
+			Handler:    _Query_DerivedPairs_Handler,
+		},
+		{
+			MethodName: "ValidatorAccuracy",
+			Handler:    _Query_ValidatorAccuracy_Handler,
+		},
+		{
+			MethodName: "VoteAccuracies",
+			Handler:    _Query_VoteAccuracies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/oracle/v1/query.proto",
@@ -33,6 +33,10 @@ type MsgClient interface {
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
 	EditOracleParams(ctx context.Context, in *MsgEditOracleParams, opts ...grpc.CallOption) (*MsgEditOracleParamsResponse, error)
+	// EditDerivedPairs adds, updates, and removes derived pairs, whose exchange
+	// rates are computed from the exchange rates of voted pairs.
+	// [SUDO] Only callable by sudoers or governance.
+	EditDerivedPairs(ctx context.Context, in *MsgEditDerivedPairs, opts ...grpc.CallOption) (*MsgEditDerivedPairsResponse, error)
 }
 
 type msgClient struct {
@@ -79,6 +83,15 @@ func (c *msgClient) EditOracleParams(ctx context.Context, in *MsgEditOracleParam
 	return out, nil
 }
 
+func (c *msgClient) EditDerivedPairs(ctx context.Context, in *MsgEditDerivedPairs, opts ...grpc.CallOption) (*MsgEditDerivedPairsResponse, error) {
+	out := new(MsgEditDerivedPairsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Msg/EditDerivedPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -94,6 +107,10 @@ type MsgServer interface {
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
 	EditOracleParams(context.Context, *MsgEditOracleParams) (*MsgEditOracleParamsResponse, error)
+	// EditDerivedPairs adds, updates, and removes derived pairs, whose exchange
+	// rates are computed from the exchange rates of voted pairs.
+	// [SUDO] Only callable by sudoers or governance.
+	EditDerivedPairs(context.Context, *MsgEditDerivedPairs) (*MsgEditDerivedPairsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -113,6 +130,9 @@ func (UnimplementedMsgServer) DelegateFeedConsent(context.Context, *MsgDelegateF
 func (UnimplementedMsgServer) EditOracleParams(context.Context, *MsgEditOracleParams) (*MsgEditOracleParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditOracleParams not implemented")
 }
+func (UnimplementedMsgServer) EditDerivedPairs(context.Context, *MsgEditDerivedPairs) (*MsgEditDerivedPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditDerivedPairs not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -198,6 +218,24 @@ func _Msg_EditOracleParams_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_EditDerivedPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditDerivedPairs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditDerivedPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Msg/EditDerivedPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditDerivedPairs(ctx, req.(*MsgEditDerivedPairs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -221,6 +259,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EditOracleParams",
 			Handler:    _Msg_EditOracleParams_Handler,
 		},
+		{
+			MethodName: "EditDerivedPairs",
+			Handler:    _Msg_EditDerivedPairs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/oracle/v1/tx.proto",
@@ -37,6 +37,9 @@ func NewAnteHandler(
 				case "/eth.evm.v1.ExtensionOptionsEthereumTx":
 					// handle as *evmtypes.MsgEthereumTx
 					anteHandler = evmante.NewAnteHandlerEVM(options)
+				case "/eth.types.v1.ExtensionOptionsWeb3Tx":
+					// handle as a Cosmos tx signed with EIP-712 typed data
+					anteHandler = NewAnteHandlerEIP712(options)
 				default:
 					return ctx, fmt.Errorf(
 						"rejecting tx with unsupported extension option: %s", typeURL)
@@ -93,3 +96,39 @@ func NewAnteHandlerNonEVM(
 		ante.AnteDecoratorGasWanted{},
 	)
 }
+
+// NewAnteHandlerEIP712: Ante handler for Cosmos transactions with the
+// "ExtensionOptionsWeb3Tx" option, signed by an eth_secp256k1 key over EIP-712
+// typed data. It is the same as [NewAnteHandlerNonEVM] except that the EIP-712
+// signature replaces the signature of the sign mode handler, and that gas for
+// eth_secp256k1 signatures is consumed with [ante.SigVerificationGasConsumer].
+func NewAnteHandlerEIP712(
+	opts ante.AnteHandlerOptions,
+) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		ante.AnteDecoratorPreventEtheruemTxMsgs{}, // reject MsgEthereumTxs
+		ante.AnteDecoratorAuthzGuard{},            // disable certain messages in authz grant "generic"
+		authante.NewSetUpContextDecorator(),
+		wasmkeeper.NewLimitSimulationGasDecorator(opts.WasmConfig.SimulationGasLimit),
+		wasmkeeper.NewCountTXDecorator(opts.TxCounterStoreKey),
+		authante.NewValidateBasicDecorator(),
+		authante.NewTxTimeoutHeightDecorator(),
+		authante.NewValidateMemoDecorator(opts.AccountKeeper),
+		ante.AnteDecoratorEnsureSinglePostPriceMessage{},
+		ante.AnteDecoratorStakingCommission{},
+		// ----------- Ante Handlers: Gas
+		authante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper),
+		authante.NewDeductFeeDecorator(opts.AccountKeeper, opts.BankKeeper, opts.FeegrantKeeper, opts.TxFeeChecker),
+		// ----------- Ante Handlers:  devgas
+		devgasante.NewDevGasPayoutDecorator(opts.DevGasBankKeeper, opts.DevGasKeeper),
+		// ----------- Ante Handlers:  Keys and signatures
+		// NOTE: SetPubKeyDecorator must be called before all signature verification decorators
+		authante.NewSetPubKeyDecorator(opts.AccountKeeper),
+		authante.NewValidateSigCountDecorator(opts.AccountKeeper),
+		authante.NewSigGasConsumeDecorator(opts.AccountKeeper, ante.SigVerificationGasConsumer),
+		ante.AnteDecoratorEIP712SigVerification{AccountKeeper: opts.AccountKeeper},
+		authante.NewIncrementSequenceDecorator(opts.AccountKeeper),
+		ibcante.NewRedundantRelayDecorator(opts.IBCKeeper),
+		ante.AnteDecoratorGasWanted{},
+	)
+}
@@ -0,0 +1,253 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package ante
+
+import (
+	"bytes"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	sdkante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/crypto/ethsecp256k1"
+	"github.com/NibiruChain/nibiru/v2/eth/eip712"
+)
+
+// AnteDecoratorEIP712SigVerification verifies the signature of a Cosmos tx with
+// the [eth.ExtensionOptionsWeb3Tx] option. Such a tx is signed by an
+// eth_secp256k1 key, e.g. in MetaMask, over the EIP-712 typed data of its
+// Amino JSON sign doc. It replaces the SigVerificationDecorator of the SDK in
+// the ante handler of Web3 txs.
+//
+// Web3 txs have a single signer of their msgs. The fees are paid by that signer
+// or by the fee payer of the tx, which is then the second signer of the tx. The
+// fee payer signs the same typed data as the signer, and its signature is the
+// "FeePayerSig" of the [eth.ExtensionOptionsWeb3Tx] option.
+type AnteDecoratorEIP712SigVerification struct {
+	AccountKeeper authkeeper.AccountKeeper
+}
+
+// AnteHandle verifies the EIP-712 signature of the tx along with its
+// [eth.ExtensionOptionsWeb3Tx] option.
+func (d AnteDecoratorEIP712SigVerification) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, sdkioerrors.Wrapf(sdkerrors.ErrTxDecode, "invalid transaction type %T", tx)
+	}
+	web3Ext, err := web3TxExtension(tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	signerAddrs := sigTx.GetSigners()
+	feePayer := sigTx.FeePayer()
+	// The SDK appends a fee payer that doesn't sign the msgs to the signers.
+	hasFeePayerSigner := len(signerAddrs) == 2 && signerAddrs[1].Equals(feePayer)
+	if len(sigs) != len(signerAddrs) || (len(signerAddrs) != 1 && !hasFeePayerSigner) {
+		return ctx, sdkioerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"EIP-712 txs must have one signer of the msgs, and the fee payer if it is another account, with one signature each: got %d signers and %d signatures",
+			len(signerAddrs), len(sigs),
+		)
+	}
+	sig := sigs[0]
+
+	acc, err := sdkante.GetSignerAcc(ctx, d.AccountKeeper, signerAddrs[0])
+	if err != nil {
+		return ctx, err
+	}
+	if sig.Sequence != acc.GetSequence() {
+		return ctx, sdkioerrors.Wrapf(
+			sdkerrors.ErrWrongSequence,
+			"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+		)
+	}
+	if hasFeePayerSigner {
+		feePayerAcc, err := sdkante.GetSignerAcc(ctx, d.AccountKeeper, feePayer)
+		if err != nil {
+			return ctx, err
+		}
+		if sigs[1].Sequence != feePayerAcc.GetSequence() {
+			return ctx, sdkioerrors.Wrapf(
+				sdkerrors.ErrWrongSequence,
+				"fee payer account sequence mismatch, expected %d, got %d", feePayerAcc.GetSequence(), sigs[1].Sequence,
+			)
+		}
+	}
+
+	// Signatures are not checked in simulations and on recheck
+	if simulate || ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	pubKey, ok := acc.GetPubKey().(*ethsecp256k1.PubKey)
+	if !ok {
+		return ctx, sdkioerrors.Wrapf(
+			sdkerrors.ErrInvalidPubKey,
+			"EIP-712 txs must be signed by an eth_secp256k1 key, got %T", acc.GetPubKey(),
+		)
+	}
+	sigData, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok || sigData.SignMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		return ctx, sdkioerrors.Wrap(
+			sdkerrors.ErrNotSupported,
+			"EIP-712 txs must have a single signature in SIGN_MODE_LEGACY_AMINO_JSON",
+		)
+	}
+
+	ethChainID := appconst.GetEthChainID(ctx.ChainID()).Uint64()
+	if web3Ext.TypedDataChainID != ethChainID {
+		return ctx, sdkioerrors.Wrapf(
+			sdkerrors.ErrInvalidChainID,
+			"typed data chain ID %d does not match the EVM chain ID %d", web3Ext.TypedDataChainID, ethChainID,
+		)
+	}
+	if web3Ext.FeePayer != feePayer.String() {
+		return ctx, sdkioerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"fee payer %s of the Web3 extension option does not match the fee payer %s of the tx",
+			web3Ext.FeePayer, feePayer,
+		)
+	}
+
+	if hasFeePayerSigner {
+		// The signature of the fee payer in the tx is the "FeePayerSig" so
+		// that the tx carries no signature that isn't verified.
+		feePayerSigData, ok := sigs[1].Data.(*signing.SingleSignatureData)
+		if len(web3Ext.FeePayerSig) == 0 || !ok ||
+			feePayerSigData.SignMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON ||
+			!bytes.Equal(feePayerSigData.Signature, web3Ext.FeePayerSig) {
+			return ctx, sdkioerrors.Wrapf(
+				sdkerrors.ErrNoSignatures,
+				"the signature of the fee payer %s must be the fee payer signature of the Web3 extension option in SIGN_MODE_LEGACY_AMINO_JSON",
+				feePayer,
+			)
+		}
+	}
+
+	// The typed data types don't include the timeout height and the tip, so a
+	// signature over the typed data does not commit to them.
+	if sigTx.GetTimeoutHeight() != 0 {
+		return ctx, sdkioerrors.Wrap(sdkerrors.ErrNotSupported, "EIP-712 txs do not support a timeout height")
+	}
+	if sigTx.GetTip() != nil {
+		return ctx, sdkioerrors.Wrap(sdkerrors.ErrNotSupported, "EIP-712 txs do not support tips")
+	}
+
+	// The typed data is built from the Amino JSON sign doc of the tx.
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(legacytx.LegacyMsg); !ok {
+			return ctx, sdkioerrors.Wrapf(
+				sdkerrors.ErrNotSupported, "EIP-712 txs do not support %s, which has no Amino JSON encoding", sdk.MsgTypeURL(msg),
+			)
+		}
+	}
+
+	var accNum uint64
+	if ctx.BlockHeight() != 0 {
+		accNum = acc.GetAccountNumber()
+	}
+	signDoc := legacytx.StdSignBytes(
+		ctx.ChainID(),
+		accNum,
+		acc.GetSequence(),
+		sigTx.GetTimeoutHeight(),
+		legacytx.StdFee{
+			Amount:  sigTx.GetFee(),
+			Gas:     sigTx.GetGas(),
+			Granter: sigTx.FeeGranter().String(),
+		},
+		tx.GetMsgs(),
+		sigTx.GetMemo(),
+		nil,
+	)
+	typedData, err := eip712.WrapTxToTypedDataWithFeePayer(ethChainID, signDoc, feePayer)
+	if err != nil {
+		return ctx, sdkioerrors.Wrap(err, "failed to create EIP-712 typed data from tx")
+	}
+	typedDataHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return ctx, sdkioerrors.Wrap(err, "failed to hash EIP-712 typed data")
+	}
+
+	if err := verifyEIP712Signature(typedDataHash, sigData.Signature, pubKey.Address().Bytes()); err != nil {
+		return ctx, sdkioerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"EIP-712 signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s): %s",
+			accNum, acc.GetSequence(), ctx.ChainID(), err,
+		)
+	}
+	if len(web3Ext.FeePayerSig) > 0 {
+		if err := verifyEIP712Signature(typedDataHash, web3Ext.FeePayerSig, feePayer); err != nil {
+			return ctx, sdkioerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid fee payer signature: %s", err)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// web3TxExtension returns the [eth.ExtensionOptionsWeb3Tx] of the tx, which
+// must be its only extension option.
+func web3TxExtension(tx sdk.Tx) (*eth.ExtensionOptionsWeb3Tx, error) {
+	extTx, ok := tx.(sdkante.HasExtensionOptionsTx)
+	if !ok {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrUnknownExtensionOptions, "tx has no extension options")
+	}
+	opts := extTx.GetExtensionOptions()
+	if len(opts) != 1 {
+		return nil, sdkioerrors.Wrapf(
+			sdkerrors.ErrUnknownExtensionOptions,
+			"EIP-712 txs must have exactly one extension option, got %d", len(opts),
+		)
+	}
+	web3Ext, ok := opts[0].GetCachedValue().(*eth.ExtensionOptionsWeb3Tx)
+	if !ok {
+		return nil, sdkioerrors.Wrapf(
+			sdkerrors.ErrUnknownExtensionOptions,
+			"unknown extension option %s", opts[0].GetTypeUrl(),
+		)
+	}
+	return web3Ext, nil
+}
+
+// verifyEIP712Signature checks that the 65 byte [R || S || V] signature over
+// the typed data hash was made by the account at "signer".
+func verifyEIP712Signature(typedDataHash, sig []byte, signer sdk.AccAddress) error {
+	if len(sig) != crypto.SignatureLength {
+		return sdkioerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"signature length must be %d, got %d", crypto.SignatureLength, len(sig),
+		)
+	}
+	// Wallets such as MetaMask set V to 27 or 28 as in legacy eth txs.
+	sig = common.CopyBytes(sig)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pubKey, err := crypto.SigToPub(typedDataHash, sig)
+	if err != nil {
+		return err
+	}
+	if recovered := crypto.PubkeyToAddress(*pubKey); recovered != common.BytesToAddress(signer) {
+		return sdkioerrors.Wrapf(
+			sdkerrors.ErrInvalidPubKey,
+			"signer %s does not match the recovered address %s", common.BytesToAddress(signer), recovered,
+		)
+	}
+	return nil
+}
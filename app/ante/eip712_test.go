@@ -0,0 +1,422 @@
+package ante_test
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/NibiruChain/nibiru/v2/app"
+	"github.com/NibiruChain/nibiru/v2/app/ante"
+	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/crypto/ethsecp256k1"
+	"github.com/NibiruChain/nibiru/v2/eth/eip712"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+)
+
+// web3TxArgs are the fields of a Web3 tx that the test cases modify.
+type web3TxArgs struct {
+	ext eth.ExtensionOptionsWeb3Tx
+	// msgs are the msgs of the tx, which default to a single MsgSend.
+	msgs    []sdk.Msg
+	granter sdk.AccAddress
+	// signer is the account that signs the typed data of the tx.
+	signer evmtest.EthPrivKeyAcc
+	// typedDataChainID is the chain ID of the signed typed data.
+	typedDataChainID uint64
+	// feePayer is the account that pays the fees if it isn't the signer.
+	feePayer *evmtest.EthPrivKeyAcc
+	// feePayerSigner is the account that signs for the fee payer.
+	feePayerSigner *evmtest.EthPrivKeyAcc
+}
+
+func (s *AnteTestSuite) TestAnteDecoratorEIP712SigVerification() {
+	testCases := []struct {
+		name    string
+		modify  func(deps *evmtest.TestDeps, args *web3TxArgs)
+		wantErr string
+	}{
+		{
+			name:   "happy: signed typed data",
+			modify: func(deps *evmtest.TestDeps, args *web3TxArgs) {},
+		},
+		{
+			name: "happy: fees paid with a fee grant",
+			modify: func(deps *evmtest.TestDeps, args *web3TxArgs) {
+				args.granter = evmtest.NewEthPrivAcc().NibiruAddr
+			},
+		},
+		{
+			name: "happy: signature of the fee payer",
+			modify: func(deps *evmtest.TestDeps, args *web3TxArgs) {
+				args.ext.FeePayerSig = []byte{}
+			},
+		},
+		{
+			name: "happy: fee payer is another account",
+			modify: func(deps *evmtest.TestDeps, args *web3TxArgs) {
+				feePayer := evmtest.NewEthPrivAcc()
+				args.feePayer = &feePayer
+				args.ext.FeePayer = feePayer.NibiruAddr.String()
+				args.ext.FeePayerSig = []byte{}
+			},
+		},
+		{
+			name: "sad: fee payer is another account without a fee payer signature",
+			modify: func(deps *evmtest.TestDeps, args *web3TxArgs) {
+				feePayer := evmtest.NewEthPrivAcc()
+				args.feePayer = &feePayer
+				args.ext.FeePayer = feePayer.NibiruAddr.String()
+			},
+			wantErr: "must be the fee payer signature",
+		},
+		{
+			name: "sad: fee payer signature of another key",
+			modify: func(deps *evmtest.TestDeps, args *web3TxArgs) {
+				feePayer, feePayerSigner := evmtest.NewEthPrivAcc(), evmtest.NewEthPrivAcc()
+				args.feePayer = &feePayer
+				args.feePayerSigner = &feePayerSigner
+				args.ext.FeePayer = feePayer.NibiruAddr.String()
+				args.ext.FeePayerSig = []byte{}
+			},
+			wantErr: "invalid fee payer signature",
+		},
+		{
+			name: "sad: typed data chain ID is not the EVM chain ID",
+			modify: func(deps *evmtest.TestDeps, args *web3TxArgs) {
+				args.ext.TypedDataChainID++
+				args.typedDataChainID++
+			},
+			wantErr: "does not match the EVM chain ID",
+		},
+		{
+			name: "sad: fee payer of the extension is not the fee payer of the tx",
+			modify: func(deps *evmtest.TestDeps, args *web3TxArgs) {
+				args.ext.FeePayer = evmtest.NewEthPrivAcc().NibiruAddr.String()
+			},
+			wantErr: "does not match the fee payer",
+		},
+		{
+			name: "sad: typed data signed by another key",
+			modify: func(deps *evmtest.TestDeps, args *web3TxArgs) {
+				args.signer = evmtest.NewEthPrivAcc()
+			},
+			wantErr: "EIP-712 signature verification failed",
+		},
+		{
+			name: "sad: typed data signed for another chain",
+			modify: func(deps *evmtest.TestDeps, args *web3TxArgs) {
+				args.typedDataChainID++
+			},
+			wantErr: "EIP-712 signature verification failed",
+		},
+		{
+			name: "sad: invalid fee payer signature",
+			modify: func(deps *evmtest.TestDeps, args *web3TxArgs) {
+				args.ext.FeePayerSig = make([]byte, crypto.SignatureLength)
+			},
+			wantErr: "invalid fee payer signature",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			deps := evmtest.NewTestDeps()
+			ethChainID := appconst.GetEthChainID(deps.Ctx.ChainID()).Uint64()
+			args := web3TxArgs{
+				ext: eth.ExtensionOptionsWeb3Tx{
+					TypedDataChainID: ethChainID,
+					FeePayer:         deps.Sender.NibiruAddr.String(),
+				},
+				signer:           deps.Sender,
+				typedDataChainID: ethChainID,
+			}
+			tc.modify(&deps, &args)
+			deps.App.AccountKeeper.SetAccount(
+				deps.Ctx,
+				deps.App.AccountKeeper.NewAccountWithAddress(deps.Ctx, deps.Sender.NibiruAddr),
+			)
+			if args.feePayer != nil {
+				deps.App.AccountKeeper.SetAccount(
+					deps.Ctx,
+					deps.App.AccountKeeper.NewAccountWithAddress(deps.Ctx, args.feePayer.NibiruAddr),
+				)
+			}
+
+			tx := s.buildWeb3Tx(&deps, args)
+			anteHandler := sdk.ChainAnteDecorators(
+				authante.NewSetPubKeyDecorator(deps.App.AccountKeeper),
+				ante.AnteDecoratorEIP712SigVerification{AccountKeeper: deps.App.AccountKeeper},
+			)
+			_, err := anteHandler(deps.Ctx, tx, false)
+			if tc.wantErr != "" {
+				s.Require().ErrorContains(err, tc.wantErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+// buildWeb3Tx builds a MsgSend tx with the [eth.ExtensionOptionsWeb3Tx] option
+// and signs its EIP-712 typed data as MetaMask does. An empty fee payer
+// signature in "args" is replaced by the signature of the fee payer.
+func (s *AnteTestSuite) buildWeb3Tx(deps *evmtest.TestDeps, args web3TxArgs) sdk.Tx {
+	txBuilder, ok := deps.App.GetTxConfig().NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+	s.Require().True(ok)
+	msgs := args.msgs
+	if len(msgs) == 0 {
+		msgs = []sdk.Msg{&banktypes.MsgSend{
+			FromAddress: deps.Sender.NibiruAddr.String(),
+			ToAddress:   evmtest.NewEthPrivAcc().NibiruAddr.String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(eth.EthBaseDenom, 1)),
+		}}
+	}
+	s.Require().NoError(txBuilder.SetMsgs(msgs...))
+	txBuilder.SetGasLimit(200_000)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(eth.EthBaseDenom, 1_000)))
+	txBuilder.SetFeeGranter(args.granter)
+	feePayer := deps.Sender.NibiruAddr
+	if args.feePayer != nil {
+		feePayer = args.feePayer.NibiruAddr
+		txBuilder.SetFeePayer(feePayer)
+	}
+	txBuilder.SetMemo("signed in MetaMask")
+
+	acc := deps.App.AccountKeeper.GetAccount(deps.Ctx, deps.Sender.NibiruAddr)
+	signDoc := legacytx.StdSignBytes(
+		deps.Ctx.ChainID(),
+		acc.GetAccountNumber(),
+		acc.GetSequence(),
+		0,
+		legacytx.StdFee{
+			Amount:  txBuilder.GetTx().GetFee(),
+			Gas:     txBuilder.GetTx().GetGas(),
+			Granter: args.granter.String(),
+		},
+		txBuilder.GetTx().GetMsgs(),
+		txBuilder.GetTx().GetMemo(),
+		nil,
+	)
+	typedData, err := eip712.WrapTxToTypedDataWithFeePayer(
+		args.typedDataChainID, signDoc, feePayer,
+	)
+	s.Require().NoError(err)
+	typedDataHash, _, err := apitypes.TypedDataAndHash(typedData)
+	s.Require().NoError(err)
+
+	privKey, err := args.signer.PrivKey.ToECDSA()
+	s.Require().NoError(err)
+	sig, err := crypto.Sign(typedDataHash, privKey)
+	s.Require().NoError(err)
+	sig[crypto.RecoveryIDOffset] += 27 // MetaMask style V
+
+	if args.ext.FeePayerSig != nil && len(args.ext.FeePayerSig) == 0 {
+		args.ext.FeePayerSig = sig
+		if args.feePayer != nil {
+			feePayerSigner := args.feePayer
+			if args.feePayerSigner != nil {
+				feePayerSigner = args.feePayerSigner
+			}
+			feePayerPrivKey, err := feePayerSigner.PrivKey.ToECDSA()
+			s.Require().NoError(err)
+			args.ext.FeePayerSig, err = crypto.Sign(typedDataHash, feePayerPrivKey)
+			s.Require().NoError(err)
+		}
+	}
+	option, err := codectypes.NewAnyWithValue(&args.ext)
+	s.Require().NoError(err)
+	txBuilder.SetExtensionOptions(option)
+
+	sigs := []signing.SignatureV2{{
+		PubKey: deps.Sender.PrivKey.PubKey(),
+		Data: &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			Signature: sig,
+		},
+		Sequence: acc.GetSequence(),
+	}}
+	if args.feePayer != nil {
+		feePayerAcc := deps.App.AccountKeeper.GetAccount(deps.Ctx, feePayer)
+		sigs = append(sigs, signing.SignatureV2{
+			PubKey: args.feePayer.PrivKey.PubKey(),
+			Data: &signing.SingleSignatureData{
+				SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+				Signature: args.ext.FeePayerSig,
+			},
+			Sequence: feePayerAcc.GetSequence(),
+		})
+	}
+	s.Require().NoError(txBuilder.SetSignatures(sigs...))
+	return txBuilder.GetTx()
+}
+
+// TestAnteHandlerWeb3Tx: Web3 txs go through the EIP-712 branch of the ante
+// handler of the app for the msgs that MetaMask users need. The ante handler
+// sets the eth_secp256k1 pubkey of a new account, consumes gas for its
+// signature, deducts the fees and increments the sequence.
+func (s *AnteTestSuite) TestAnteHandlerWeb3Tx() {
+	fees := sdk.NewCoins(sdk.NewInt64Coin(eth.EthBaseDenom, 1_000))
+	testCases := []struct {
+		name string
+		msgs func(sender sdk.AccAddress) []sdk.Msg
+		// feesFrom sets up the account that pays the fees, which is the
+		// sender unless it returns another account.
+		feesFrom func(deps *evmtest.TestDeps, args *web3TxArgs) sdk.AccAddress
+	}{
+		{
+			name: "staking: MsgDelegate",
+			msgs: func(sender sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{stakingtypes.NewMsgDelegate(
+					sender, sdk.ValAddress(evmtest.NewEthPrivAcc().NibiruAddr),
+					sdk.NewInt64Coin(eth.EthBaseDenom, 100),
+				)}
+			},
+		},
+		{
+			name: "gov: MsgVote",
+			msgs: func(sender sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{govv1.NewMsgVote(sender, 1, govv1.OptionYes, "")}
+			},
+		},
+		{
+			name: "ibc: MsgTransfer",
+			msgs: func(sender sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{ibctransfertypes.NewMsgTransfer(
+					ibctransfertypes.PortID, "channel-0",
+					sdk.NewInt64Coin(eth.EthBaseDenom, 100), sender.String(),
+					"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+					clienttypes.NewHeight(1, 1_000), 0, "",
+				)}
+			},
+		},
+		{
+			name: "wasm: MsgExecuteContract",
+			msgs: func(sender sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{&wasmtypes.MsgExecuteContract{
+					Sender:   sender.String(),
+					Contract: evmtest.NewEthPrivAcc().NibiruAddr.String(),
+					Msg:      []byte(`{"increment":{}}`),
+					Funds:    sdk.NewCoins(sdk.NewInt64Coin(eth.EthBaseDenom, 1)),
+				}}
+			},
+		},
+		{
+			name: "fee grant: MsgDelegate with fees paid by the granter",
+			msgs: func(sender sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{stakingtypes.NewMsgDelegate(
+					sender, sdk.ValAddress(evmtest.NewEthPrivAcc().NibiruAddr),
+					sdk.NewInt64Coin(eth.EthBaseDenom, 100),
+				)}
+			},
+			feesFrom: func(deps *evmtest.TestDeps, args *web3TxArgs) sdk.AccAddress {
+				granter := evmtest.NewEthPrivAcc().NibiruAddr
+				s.Require().NoError(testapp.FundAccount(deps.App.BankKeeper, deps.Ctx, granter, fees))
+				s.Require().NoError(deps.App.FeeGrantKeeper.GrantAllowance(
+					deps.Ctx, granter, deps.Sender.NibiruAddr, &feegrant.BasicAllowance{},
+				))
+				args.granter = granter
+				return granter
+			},
+		},
+		{
+			name: "fee payer: MsgVote with fees paid by another account",
+			msgs: func(sender sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{govv1.NewMsgVote(sender, 1, govv1.OptionYes, "")}
+			},
+			feesFrom: func(deps *evmtest.TestDeps, args *web3TxArgs) sdk.AccAddress {
+				feePayer := evmtest.NewEthPrivAcc()
+				s.Require().NoError(testapp.FundAccount(deps.App.BankKeeper, deps.Ctx, feePayer.NibiruAddr, fees))
+				deps.App.AccountKeeper.SetAccount(
+					deps.Ctx,
+					deps.App.AccountKeeper.NewAccountWithAddress(deps.Ctx, feePayer.NibiruAddr),
+				)
+				args.feePayer = &feePayer
+				args.ext.FeePayer = feePayer.NibiruAddr.String()
+				args.ext.FeePayerSig = []byte{}
+				return feePayer.NibiruAddr
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			deps := evmtest.NewTestDeps()
+			deps.Ctx = deps.Ctx.WithConsensusParams(&tmproto.ConsensusParams{
+				Block: &tmproto.BlockParams{MaxGas: 10_000_000},
+			})
+			ethChainID := appconst.GetEthChainID(deps.Ctx.ChainID()).Uint64()
+			// The sender is a new account without a pubkey.
+			deps.App.AccountKeeper.SetAccount(
+				deps.Ctx,
+				deps.App.AccountKeeper.NewAccountWithAddress(deps.Ctx, deps.Sender.NibiruAddr),
+			)
+			args := web3TxArgs{
+				ext: eth.ExtensionOptionsWeb3Tx{
+					TypedDataChainID: ethChainID,
+					FeePayer:         deps.Sender.NibiruAddr.String(),
+				},
+				msgs:             tc.msgs(deps.Sender.NibiruAddr),
+				signer:           deps.Sender,
+				typedDataChainID: ethChainID,
+			}
+			feesFrom := deps.Sender.NibiruAddr
+			if tc.feesFrom != nil {
+				feesFrom = tc.feesFrom(&deps, &args)
+			} else {
+				s.Require().NoError(testapp.FundAccount(deps.App.BankKeeper, deps.Ctx, feesFrom, fees))
+			}
+			tx := s.buildWeb3Tx(&deps, args)
+
+			wasmConfig := wasmtypes.DefaultWasmConfig()
+			anteHandler := app.NewAnteHandler(deps.App.AppKeepers, ante.AnteHandlerOptions{
+				HandlerOptions: authante.HandlerOptions{
+					AccountKeeper:          deps.App.AccountKeeper,
+					BankKeeper:             deps.App.BankKeeper,
+					FeegrantKeeper:         deps.App.FeeGrantKeeper,
+					SignModeHandler:        deps.App.GetTxConfig().SignModeHandler(),
+					SigGasConsumer:         authante.DefaultSigVerificationGasConsumer,
+					ExtensionOptionChecker: func(*codectypes.Any) bool { return true },
+				},
+				IBCKeeper:         deps.App.GetIBCKeeper(),
+				TxCounterStoreKey: deps.App.GetKey(wasmtypes.StoreKey),
+				WasmConfig:        &wasmConfig,
+				DevGasKeeper:      &deps.App.DevGasKeeper,
+				DevGasBankKeeper:  deps.App.BankKeeper,
+				MaxTxGasWanted:    app.DefaultMaxTxGasWanted,
+				EvmKeeper:         deps.App.EvmKeeper,
+				AccountKeeper:     deps.App.AccountKeeper,
+			})
+			newCtx, err := anteHandler(deps.Ctx, tx, false)
+			s.Require().NoError(err)
+
+			acc := deps.App.AccountKeeper.GetAccount(newCtx, deps.Sender.NibiruAddr)
+			s.Require().IsType(&ethsecp256k1.PubKey{}, acc.GetPubKey())
+			s.Require().EqualValues(1, acc.GetSequence())
+			authParams := deps.App.AccountKeeper.GetParams(newCtx)
+			s.Require().GreaterOrEqual(newCtx.GasMeter().GasConsumed(), authParams.SigVerifyCostSecp256k1)
+			s.Require().True(
+				deps.App.BankKeeper.GetBalance(newCtx, feesFrom, eth.EthBaseDenom).IsZero(),
+				"the fees are deducted from %s", feesFrom,
+			)
+			if args.feePayer != nil {
+				feePayerAcc := deps.App.AccountKeeper.GetAccount(newCtx, feesFrom)
+				s.Require().EqualValues(1, feePayerAcc.GetSequence())
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package ante
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	sdkante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth/crypto/ethsecp256k1"
+)
+
+var _ sdkante.SignatureVerificationGasConsumer = SigVerificationGasConsumer
+
+// SigVerificationGasConsumer consumes gas for the verification of a signature.
+// An eth_secp256k1 signature, such as the one of an EIP-712 tx, costs the same
+// as a secp256k1 signature. Other keys are handled by
+// [sdkante.DefaultSigVerificationGasConsumer].
+func SigVerificationGasConsumer(
+	meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params,
+) error {
+	if _, ok := sig.PubKey.(*ethsecp256k1.PubKey); ok {
+		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: eth_secp256k1")
+		return nil
+	}
+	return sdkante.DefaultSigVerificationGasConsumer(meter, sig, params)
+}
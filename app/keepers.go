@@ -247,6 +247,13 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 		append(GetWasmOpts(*app, appOpts, wmha), wasmkeeper.WithWasmEngine(wasmVM))...,
 	)
 
+	// The EVM keeper moves CW20 balances for FunToken mappings made from CW20
+	// tokens, so it needs a reference to the Wasm keeper.
+	app.EvmKeeper.SetWasmKeeper(precompile.Wasm{
+		PermissionedKeeper: wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper),
+		Keeper:             app.WasmKeeper,
+	})
+
 	app.WasmClientKeeper = ibcwasmkeeper.NewKeeperWithVM(
 		app.appCodec,
 		app.keys[ibcwasmtypes.StoreKey],
@@ -70,7 +70,11 @@ func StartEthereumJSONRPC(
 	// to add different behavior based on the type of request to display a
 	// webpage if someone visits the RPC URL.
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	var rpcHandler http.Handler = rpcServer
+	if config.Telemetry.Enabled {
+		rpcHandler = jsonRPCMetricsHandler(rpcServer, apis)
+	}
+	r.Handle("/", rpcHandler).Methods("POST")
 	r.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		startTime := time.Now()
 		w.Header().Set("Content-Type", "text/html")
@@ -0,0 +1,199 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"time"
+	"unicode"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// Telemetry metric keys of the Ethereum JSON-RPC server.
+var (
+	// MetricKeyJSONRPCLatency: Sample of the latency of each JSON-RPC request
+	// by method. Batch requests are sampled under the method "batch".
+	MetricKeyJSONRPCLatency = []string{"json_rpc", "latency"}
+	// MetricKeyJSONRPCRequests: Counter of JSON-RPC calls by method, including
+	// the calls of batch requests.
+	MetricKeyJSONRPCRequests = []string{"json_rpc", "requests"}
+	// MetricKeyJSONRPCErrors: Counter of JSON-RPC calls with an error response
+	// by method.
+	MetricKeyJSONRPCErrors = []string{"json_rpc", "errors"}
+)
+
+const (
+	// jsonRPCMetricsMaxBody is the number of request bytes kept to find the
+	// called methods. The calls of larger requests are counted as a single
+	// call of the method "unknown".
+	jsonRPCMetricsMaxBody = 64 * 1024
+	// jsonRPCMetricsMaxResponse is the number of response bytes kept to find
+	// the calls that failed. Error responses are small, so larger responses
+	// are counted as successes.
+	jsonRPCMetricsMaxResponse = 64 * 1024
+)
+
+// jsonRPCMetricsHandler wraps the JSON-RPC handler "next" to record the
+// latency and errors of each call. Methods that aren't in "apis" are labeled
+// "unknown" to bound the number of metric series.
+func jsonRPCMetricsHandler(next http.Handler, apis []gethrpc.API) http.Handler {
+	knownMethods := make(map[string]bool)
+	for _, api := range apis {
+		serviceType := reflect.TypeOf(api.Service)
+		for i := 0; i < serviceType.NumMethod(); i++ {
+			name := []rune(serviceType.Method(i).Name)
+			name[0] = unicode.ToLower(name[0])
+			knownMethods[api.Namespace+"_"+string(name)] = true
+		}
+	}
+	methodLabel := func(method string) metrics.Label {
+		if !knownMethods[method] {
+			method = "unknown"
+		}
+		return telemetry.NewLabel("method", method)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		// The server reads the body as usual, and the first bytes are kept to
+		// find the called methods.
+		reqRecorder := &jsonRPCRecorder{limit: jsonRPCMetricsMaxBody}
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.TeeReader(r.Body, reqRecorder), r.Body}
+
+		respRecorder := &jsonRPCRecorder{limit: jsonRPCMetricsMaxResponse}
+		next.ServeHTTP(jsonRPCResponseWriter{ResponseWriter: w, recorder: respRecorder}, r)
+
+		calls, isBatch := []jsonRPCCall{{Method: "unknown"}}, false
+		if !reqRecorder.truncated {
+			calls, isBatch = parseJSONRPCCalls(reqRecorder.body.Bytes())
+		}
+		if len(calls) == 0 {
+			return
+		}
+		latencyLabel := telemetry.NewLabel("method", "batch")
+		if !isBatch {
+			latencyLabel = methodLabel(calls[0].Method)
+		}
+		metrics.MeasureSinceWithLabels(MetricKeyJSONRPCLatency, start, []metrics.Label{latencyLabel})
+
+		var failed []bool
+		if !respRecorder.truncated {
+			failed = parseJSONRPCErrors(respRecorder.body.Bytes(), calls, isBatch)
+		}
+		for i, call := range calls {
+			labels := []metrics.Label{methodLabel(call.Method)}
+			telemetry.IncrCounterWithLabels(MetricKeyJSONRPCRequests, 1, labels)
+			if i < len(failed) && failed[i] {
+				telemetry.IncrCounterWithLabels(MetricKeyJSONRPCErrors, 1, labels)
+			}
+		}
+	})
+}
+
+// jsonRPCRecorder keeps the first "limit" bytes written to it.
+type jsonRPCRecorder struct {
+	limit     int
+	body      bytes.Buffer
+	truncated bool
+}
+
+func (rec *jsonRPCRecorder) Write(bz []byte) (int, error) {
+	if room := rec.limit - rec.body.Len(); room < len(bz) {
+		rec.body.Write(bz[:max(room, 0)])
+		rec.truncated = true
+	} else {
+		rec.body.Write(bz)
+	}
+	return len(bz), nil
+}
+
+// jsonRPCResponseWriter passes a response through while recording it.
+type jsonRPCResponseWriter struct {
+	http.ResponseWriter
+	recorder *jsonRPCRecorder
+}
+
+func (w jsonRPCResponseWriter) Write(bz []byte) (int, error) {
+	_, _ = w.recorder.Write(bz)
+	return w.ResponseWriter.Write(bz)
+}
+
+// jsonRPCCall holds the fields of a JSON-RPC call that the metrics use. A call
+// without an "id" is a notification, which gets no response.
+type jsonRPCCall struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+// jsonRPCResponse holds the fields of a JSON-RPC response that the metrics
+// use. The "result" isn't decoded.
+type jsonRPCResponse struct {
+	ID    json.RawMessage `json:"id"`
+	Error json.RawMessage `json:"error"`
+}
+
+func (resp jsonRPCResponse) failed() bool {
+	return len(resp.Error) > 0 && string(resp.Error) != "null"
+}
+
+// parseJSONRPCCalls returns the calls of a JSON-RPC request body, which is
+// either a single call or a batch of calls.
+func parseJSONRPCCalls(body []byte) (calls []jsonRPCCall, isBatch bool) {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	if len(body) > 0 && body[0] == '[' {
+		if err := json.Unmarshal(body, &calls); err != nil {
+			return nil, true
+		}
+		return calls, true
+	}
+	var call jsonRPCCall
+	if err := json.Unmarshal(body, &call); err != nil {
+		return nil, false
+	}
+	return []jsonRPCCall{call}, false
+}
+
+// parseJSONRPCErrors reports which of the calls of a request have an error
+// response. The responses of a batch are matched to its calls by "id" because
+// notifications get no response. Calls with the same "id" are matched to the
+// responses with that "id" in order.
+func parseJSONRPCErrors(body []byte, calls []jsonRPCCall, isBatch bool) (failed []bool) {
+	failed = make([]bool, len(calls))
+	body = bytes.TrimLeft(body, " \t\r\n")
+	if !isBatch {
+		var resp jsonRPCResponse
+		if err := json.Unmarshal(body, &resp); err == nil && len(calls[0].ID) > 0 {
+			failed[0] = resp.failed()
+		}
+		return failed
+	}
+
+	var responses []jsonRPCResponse
+	if len(body) == 0 || body[0] != '[' || json.Unmarshal(body, &responses) != nil {
+		return failed
+	}
+	failedByID := make(map[string][]bool)
+	for _, resp := range responses {
+		id := string(bytes.TrimSpace(resp.ID))
+		failedByID[id] = append(failedByID[id], resp.failed())
+	}
+	for i, call := range calls {
+		if len(call.ID) == 0 {
+			continue
+		}
+		id := string(bytes.TrimSpace(call.ID))
+		if results := failedByID[id]; len(results) > 0 {
+			failed[i] = results[0]
+			failedByID[id] = results[1:]
+		}
+	}
+	return failed
+}
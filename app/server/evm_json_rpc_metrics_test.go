@@ -0,0 +1,71 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type testEthAPI struct{}
+
+func (testEthAPI) ChainId() string { return "0x1" }
+
+func (testEthAPI) GetBalance() string { return "0x0" }
+
+func TestJSONRPCMetricsHandler(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	_, err := metrics.NewGlobal(&metrics.Config{FilterDefault: true}, sink)
+	require.NoError(t, err)
+
+	handler := jsonRPCMetricsHandler(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			if strings.HasPrefix(string(body), "[") {
+				// The notification gets no response, and the responses may be
+				// in any order.
+				_, _ = w.Write([]byte(`[{"id":3,"error":{"code":-32601}},{"id":2,"result":"0x0"}]`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+		}),
+		[]gethrpc.API{{Namespace: "eth", Service: testEthAPI{}}},
+	)
+	for _, body := range []string{
+		`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`,
+		`[{"method":"eth_chainId"},{"id":2,"method":"eth_getBalance"},{"id":3,"method":"eth_unknownMethod"}]`,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	counters := make(map[string]int)
+	samples := make(map[string]int)
+	for _, interval := range sink.Data() {
+		for name, counter := range interval.Counters {
+			counters[name] += counter.Count
+		}
+		for name, sample := range interval.Samples {
+			samples[name] += sample.Count
+		}
+	}
+	require.Equal(t, map[string]int{
+		"json_rpc.requests;method=eth_chainId":    2,
+		"json_rpc.requests;method=eth_getBalance": 1,
+		"json_rpc.requests;method=unknown":        1,
+		"json_rpc.errors;method=unknown":          1,
+	}, counters)
+	require.Equal(t, map[string]int{
+		"json_rpc.latency;method=eth_chainId": 1,
+		"json_rpc.latency;method=batch":       1,
+	}, samples)
+}
@@ -3,20 +3,35 @@ package server
 
 import (
 	"fmt"
+	"io"
 	"log/slog"
+	"runtime"
 	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/NibiruChain/nibiru/v2/app/server/config"
 	"github.com/NibiruChain/nibiru/v2/eth/indexer"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmnode "github.com/cometbft/cometbft/node"
 	sm "github.com/cometbft/cometbft/state"
 	tmstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
+const (
+	flagKeepRecent = "keep-recent"
+	flagFrom       = "from"
+	flagTo         = "to"
+	flagWorkers    = "workers"
+)
+
 func NewEVMTxIndexCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "evm-tx-index [minBlockNumber|last-indexed] [maxBlockNumber|latest]",
@@ -31,108 +46,462 @@ Processes blocks from minBlockNumber to maxBlockNumber, indexes evm txs.
 Default run before the full node/archive node start should be:
 
 nibid evm-tx-index last-indexed latest
+
+The subcommands verify, prune and rebuild repair an EVMIndexerDB without
+wiping the node. Stop the node before running any of them.
+
+If the [evm-indexer-sink] section of app.toml sets a driver, indexed blocks
+are also written to that SQL database. Pruning doesn't delete rows from it.
 		`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			serverCtx := server.GetServerContextFromCmd(cmd)
-			clientCtx, err := client.GetClientQueryContext(cmd)
+			stores, err := openEVMTxIndexerStores(cmd)
 			if err != nil {
 				return err
 			}
-			cfg := serverCtx.Config
-			logger := serverCtx.Logger
-			evmIndexerDB, err := OpenIndexerDB(cfg.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			defer stores.close()
+
+			fromBlock, toBlock, err := stores.parseBlockRange(args[0], args[1])
 			if err != nil {
-				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
 
-			evmTxIndexer := indexer.NewEVMTxIndexer(evmIndexerDB, logger.With("module", "evmindex"), clientCtx)
+			fmt.Printf("Indexing blocks from %d to %d\n", fromBlock, toBlock)
+			for height := fromBlock; height <= toBlock; height++ {
+				slog.Info("Started indexing block", "height", height)
+				block, txResults, err := stores.loadBlock(height)
+				if err != nil {
+					return err
+				}
+				slog.Info("Indexing block evm txs", "height", height)
+				if err := stores.indexer.IndexBlock(block, txResults); err != nil {
+					return err
+				}
+				slog.Info("Finished indexing block", "height", height)
+			}
+			fmt.Println("Indexing complete")
+			return nil
+		},
+	}
+	cmd.AddCommand(
+		newEVMTxIndexVerifyCmd(),
+		newEVMTxIndexPruneCmd(),
+		newEVMTxIndexRebuildCmd(),
+	)
+	return cmd
+}
+
+func newEVMTxIndexVerifyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify [minBlockNumber|last-indexed] [maxBlockNumber|latest]",
+		Short: "Compare indexed evm txs against CometBFT block results",
+		Long: `Compares the evm txs indexed in EVMIndexerDB for each block from
+minBlockNumber to maxBlockNumber against the txs of the CometBFT block results
+and reports every missing, wrong or extra entry.
 
-			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+The command fails if it finds any mismatch. Repair the reported blocks with
+"nibid evm-tx-index rebuild --from <block> --to <block>".
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stores, err := openEVMTxIndexerStores(cmd)
 			if err != nil {
 				return err
 			}
-			blockStore := tmstore.NewBlockStore(tmdb)
-			minAvailableHeight := blockStore.Base()
-			maxAvailableHeight := blockStore.Height() - 1 // exclude last block as block info could be uncommitted
-			fmt.Printf("Block range available on the node: %d - %d\n", minAvailableHeight, maxAvailableHeight)
+			defer stores.close()
 
-			var fromBlock int64
-			var toBlock int64
+			fromBlock, toBlock, err := stores.parseBlockRange(args[0], args[1])
+			if err != nil {
+				return err
+			}
 
-			// FROM block could be one of two:
-			// - int64 number - replaced with minAvailableHeight if too low
-			// - last-indexed - latest available block in EVMIndexerDB, 0 if nothing is indexed
-			if args[0] == "last-indexed" {
-				fromBlock, err = evmTxIndexer.LastIndexedBlock()
-				if err != nil || fromBlock < 0 {
-					fromBlock = 0
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Verifying blocks from %d to %d\n", fromBlock, toBlock)
+			progress := newIndexProgress(out, "Verified", toBlock-fromBlock+1)
+			defer progress.stop()
+			var numMismatches int
+			for height := fromBlock; height <= toBlock; height++ {
+				block, txResults, err := stores.loadBlock(height)
+				if err != nil {
+					return err
 				}
-			} else {
-				fromBlock, err = strconv.ParseInt(args[0], 10, 64)
+				mismatches, err := stores.indexer.VerifyBlock(block, txResults)
 				if err != nil {
-					return fmt.Errorf("cannot parse min block number: %s", args[1])
+					return err
 				}
-				if fromBlock > maxAvailableHeight {
-					return fmt.Errorf("maximum available block is: %d", maxAvailableHeight)
+				for _, mismatch := range mismatches {
+					fmt.Fprintln(out, mismatch.String())
 				}
+				numMismatches += len(mismatches)
+				progress.add(1)
 			}
-			if fromBlock < minAvailableHeight {
-				fromBlock = minAvailableHeight
+			progress.stop()
+			if numMismatches > 0 {
+				return fmt.Errorf("found %d mismatches between blocks %d and %d", numMismatches, fromBlock, toBlock)
 			}
+			fmt.Fprintln(out, "Verification complete: no mismatches")
+			return nil
+		},
+	}
+}
 
-			// TO block could be one of two:
-			// - int64 number - replaced with maxAvailableHeight if too high
-			// - latest - latest available block in the node
-			if args[1] == "latest" {
-				toBlock = maxAvailableHeight
-			} else {
-				toBlock, err = strconv.ParseInt(args[1], 10, 64)
-				if err != nil {
-					return fmt.Errorf("cannot parse max block number: %s", args[1])
-				}
-				if toBlock > maxAvailableHeight {
-					toBlock = maxAvailableHeight
-				}
+func newEVMTxIndexPruneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune --keep-recent <blocks>",
+		Short: "Delete indexed evm txs of old blocks",
+		Long: `Deletes the evm txs indexed in EVMIndexerDB for all blocks except the
+most recent ones. With "--keep-recent 1000" and a last indexed block 5000, the
+txs of blocks 4001 to 5000 are kept.
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			keepRecent, err := cmd.Flags().GetInt64(flagKeepRecent)
+			if err != nil {
+				return err
 			}
-			if fromBlock > toBlock {
-				return fmt.Errorf("minBlockNumber must be less or equal to maxBlockNumber")
+			if keepRecent <= 0 {
+				return fmt.Errorf("--%s must be a positive number of blocks", flagKeepRecent)
 			}
-			stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
-			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
-			})
+			evmIndexerDB, err := OpenIndexerDB(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			evmTxIndexer := indexer.NewEVMTxIndexer(evmIndexerDB, serverCtx.Logger.With("module", "evmindex"), clientCtx)
+			defer func() { _ = evmTxIndexer.CloseDBAndExit() }()
 
-			fmt.Printf("Indexing blocks from %d to %d\n", fromBlock, toBlock)
-			for height := fromBlock; height <= toBlock; height++ {
-				slog.Info("Started indexing block", "height", height)
-				slog.Info("Loading block meta", "height", height)
-				block := blockStore.LoadBlock(height)
-				if block == nil {
-					return fmt.Errorf("block not found %d", height)
-				}
-				slog.Info("Loading block abci responses", "height", height)
-				blockResults, err := stateStore.LoadABCIResponses(height)
-				if err != nil {
-					return err
-				}
-				slog.Info("Indexing block evm txs", "height", height)
-				if err := evmTxIndexer.IndexBlock(block, blockResults.DeliverTxs); err != nil {
-					return err
-				}
-				slog.Info("Finished indexing block", "height", height)
+			lastIndexed, err := evmTxIndexer.LastIndexedBlock()
+			if err != nil {
+				return err
+			}
+			pruneBelow := lastIndexed - keepRecent + 1
+			if lastIndexed < 0 || pruneBelow <= 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "Nothing to prune")
+				return nil
 			}
-			err = evmTxIndexer.CloseDBAndExit()
+			numPruned, err := evmTxIndexer.PruneBlocks(pruneBelow)
 			if err != nil {
 				return err
 			}
-			fmt.Println("Indexing complete")
+			fmt.Fprintf(cmd.OutOrStdout(),
+				"Pruned %d evm txs of the blocks below %d\n", numPruned, pruneBelow)
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagKeepRecent, 0, "Number of most recent indexed blocks to keep")
+	_ = cmd.MarkFlagRequired(flagKeepRecent)
+	return cmd
+}
+
+func newEVMTxIndexRebuildCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebuild --from <block> [--to <block>]",
+		Short: "Delete and reindex the evm txs of a block range",
+		Long: `Deletes the evm txs indexed in EVMIndexerDB for the blocks from
+--from to --to (the latest block by default) and indexes them again from the
+CometBFT block results, using --workers blocks in parallel.
+
+Blocks are indexed out of order, so rerun the same command if it is
+interrupted.
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			fromArg, err := cmd.Flags().GetString(flagFrom)
+			if err != nil {
+				return err
+			}
+			toArg, err := cmd.Flags().GetString(flagTo)
+			if err != nil {
+				return err
+			}
+			workers, err := cmd.Flags().GetInt(flagWorkers)
+			if err != nil {
+				return err
+			}
+			if workers <= 0 {
+				return fmt.Errorf("--%s must be positive", flagWorkers)
+			}
+
+			stores, err := openEVMTxIndexerStores(cmd)
+			if err != nil {
+				return err
+			}
+			defer stores.close()
+
+			fromBlock, toBlock, err := stores.parseBlockRange(fromArg, toArg)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			numDeleted, err := stores.indexer.DeleteBlocks(fromBlock, toBlock)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "Deleted %d indexed evm txs of blocks %d to %d\n", numDeleted, fromBlock, toBlock)
+
+			fmt.Fprintf(out, "Indexing blocks from %d to %d with %d workers\n", fromBlock, toBlock, workers)
+			if err := stores.indexBlocksParallel(out, fromBlock, toBlock, workers); err != nil {
+				return err
+			}
+			fmt.Fprintln(out, "Rebuild complete")
 			return nil
 		},
 	}
+	cmd.Flags().String(flagFrom, "", `First block to rebuild, a number or "last-indexed"`)
+	cmd.Flags().String(flagTo, "latest", `Last block to rebuild, a number or "latest"`)
+	cmd.Flags().Int(flagWorkers, runtime.NumCPU(), "Number of blocks indexed in parallel")
+	_ = cmd.MarkFlagRequired(flagFrom)
 	return cmd
 }
+
+// evmTxIndexerStores holds the EVM tx indexer along with the CometBFT stores
+// that the "evm-tx-index" commands read blocks and block results from.
+type evmTxIndexerStores struct {
+	indexer    *indexer.EVMTxIndexer
+	blockStore *tmstore.BlockStore
+	stateStore sm.Store
+
+	// minHeight and maxHeight bound the blocks available on the node.
+	minHeight int64
+	maxHeight int64
+}
+
+func openEVMTxIndexerStores(cmd *cobra.Command) (*evmTxIndexerStores, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg := serverCtx.Config
+	logger := serverCtx.Logger
+	evmIndexerDB, err := OpenIndexerDB(cfg.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+	if err != nil {
+		logger.Error("failed to open evm indexer DB", "error", err.Error())
+		return nil, err
+	}
+	stores := &evmTxIndexerStores{
+		indexer: indexer.NewEVMTxIndexer(evmIndexerDB, logger.With("module", "evmindex"), clientCtx),
+	}
+	conf, err := config.GetConfig(serverCtx.Viper)
+	if err != nil {
+		stores.close()
+		return nil, err
+	}
+	sink, err := OpenEVMIndexerSink(conf.EVMIndexerSink)
+	if err != nil {
+		stores.close()
+		return nil, err
+	}
+	if sink != nil {
+		fmt.Printf("Writing indexed blocks to %s sink\n", conf.EVMIndexerSink.Driver)
+		stores.indexer.WithSink(sink)
+	}
+
+	tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		stores.close()
+		return nil, err
+	}
+	stores.blockStore = tmstore.NewBlockStore(tmdb)
+	stores.minHeight = stores.blockStore.Base()
+	stores.maxHeight = stores.blockStore.Height() - 1 // exclude last block as block info could be uncommitted
+	fmt.Printf("Block range available on the node: %d - %d\n", stores.minHeight, stores.maxHeight)
+
+	stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		stores.close()
+		return nil, err
+	}
+	stores.stateStore = sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+	return stores, nil
+}
+
+func (stores *evmTxIndexerStores) close() {
+	if stores.stateStore != nil {
+		_ = stores.stateStore.Close()
+	}
+	if stores.blockStore != nil {
+		_ = stores.blockStore.Close()
+	}
+	if err := stores.indexer.CloseDBAndExit(); err != nil {
+		slog.Error("failed to close evm indexer DB", "error", err)
+	}
+}
+
+// parseBlockRange parses the block range of an "evm-tx-index" command and
+// clamps it to the blocks available on the node.
+//
+// FROM block could be one of two:
+//   - int64 number - replaced with minHeight if too low
+//   - last-indexed - latest available block in EVMIndexerDB, 0 if nothing is indexed
+//
+// TO block could be one of two:
+//   - int64 number - replaced with maxHeight if too high
+//   - latest - latest available block in the node
+func (stores *evmTxIndexerStores) parseBlockRange(
+	fromArg, toArg string,
+) (fromBlock, toBlock int64, err error) {
+	if fromArg == "last-indexed" {
+		fromBlock, err = stores.indexer.LastIndexedBlock()
+		if err != nil || fromBlock < 0 {
+			fromBlock = 0
+		}
+	} else {
+		fromBlock, err = strconv.ParseInt(fromArg, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("cannot parse min block number: %s", fromArg)
+		}
+		if fromBlock > stores.maxHeight {
+			return 0, 0, fmt.Errorf("maximum available block is: %d", stores.maxHeight)
+		}
+	}
+	if fromBlock < stores.minHeight {
+		fromBlock = stores.minHeight
+	}
+
+	if toArg == "latest" {
+		toBlock = stores.maxHeight
+	} else {
+		toBlock, err = strconv.ParseInt(toArg, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("cannot parse max block number: %s", toArg)
+		}
+		if toBlock > stores.maxHeight {
+			toBlock = stores.maxHeight
+		}
+	}
+	if fromBlock > toBlock {
+		return 0, 0, fmt.Errorf("minBlockNumber must be less or equal to maxBlockNumber")
+	}
+	return fromBlock, toBlock, nil
+}
+
+// loadBlock loads a block and the results of its txs.
+func (stores *evmTxIndexerStores) loadBlock(
+	height int64,
+) (*cmttypes.Block, []*abci.ResponseDeliverTx, error) {
+	block := stores.blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, nil, fmt.Errorf("block not found %d", height)
+	}
+	blockResults, err := stores.stateStore.LoadABCIResponses(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return block, blockResults.DeliverTxs, nil
+}
+
+// indexBlocksParallel indexes the blocks from "fromBlock" to "toBlock" with
+// "workers" goroutines and stops at the first error.
+func (stores *evmTxIndexerStores) indexBlocksParallel(
+	out io.Writer, fromBlock, toBlock int64, workers int,
+) error {
+	heights := make(chan int64)
+	done := make(chan struct{})
+	var (
+		firstErr error
+		errOnce  sync.Once
+		wg       sync.WaitGroup
+	)
+	progress := newIndexProgress(out, "Indexed", toBlock-fromBlock+1)
+	defer progress.stop()
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for height := range heights {
+				block, txResults, err := stores.loadBlock(height)
+				if err == nil {
+					err = stores.indexer.IndexBlock(block, txResults)
+				}
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = fmt.Errorf("failed to index block %d: %w", height, err)
+						close(done)
+					})
+					return
+				}
+				progress.add(1)
+			}
+		}()
+	}
+
+feed:
+	for height := fromBlock; height <= toBlock; height++ {
+		select {
+		case heights <- height:
+		case <-done:
+			break feed
+		}
+	}
+	close(heights)
+	wg.Wait()
+	return firstErr
+}
+
+// indexProgressInterval is how often an "evm-tx-index" command reports its
+// progress.
+const indexProgressInterval = 5 * time.Second
+
+// indexProgress periodically prints the number of blocks processed by an
+// "evm-tx-index" command.
+type indexProgress struct {
+	out      io.Writer
+	verb     string
+	total    int64
+	done     atomic.Int64
+	start    time.Time
+	ticker   *time.Ticker
+	stopOnce sync.Once
+	stopped  chan struct{}
+}
+
+func newIndexProgress(out io.Writer, verb string, total int64) *indexProgress {
+	p := &indexProgress{
+		out:     out,
+		verb:    verb,
+		total:   total,
+		start:   time.Now(),
+		ticker:  time.NewTicker(indexProgressInterval),
+		stopped: make(chan struct{}),
+	}
+	go func() {
+		for {
+			select {
+			case <-p.ticker.C:
+				p.print()
+			case <-p.stopped:
+				return
+			}
+		}
+	}()
+	return p
+}
+
+func (p *indexProgress) add(numBlocks int64) { p.done.Add(numBlocks) }
+
+func (p *indexProgress) print() {
+	done := p.done.Load()
+	elapsed := time.Since(p.start)
+	rate := float64(done) / elapsed.Seconds()
+	fmt.Fprintf(p.out, "%s %d/%d blocks (%.1f%%, %.1f blocks/s)\n",
+		p.verb, done, p.total, 100*float64(done)/float64(p.total), rate)
+}
+
+// stop prints the final progress once and stops the reports.
+func (p *indexProgress) stop() {
+	p.stopOnce.Do(func() {
+		p.ticker.Stop()
+		close(p.stopped)
+		p.print()
+	})
+}
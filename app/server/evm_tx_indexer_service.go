@@ -9,6 +9,7 @@ import (
 	"github.com/cometbft/cometbft/libs/service"
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 
 	"github.com/NibiruChain/nibiru/v2/eth/indexer"
 )
@@ -19,6 +20,10 @@ const (
 	NewBlockWaitTimeout = 60 * time.Second
 )
 
+// MetricKeyEVMTxIndexerLag: Gauge of the number of blocks the EVM tx indexer
+// is behind the chain.
+var MetricKeyEVMTxIndexerLag = []string{"evm_tx_indexer", "lag"}
+
 // EVMTxIndexerService indexes transactions for json-rpc service.
 type EVMTxIndexerService struct {
 	service.BaseService
@@ -99,6 +104,7 @@ func (service *EVMTxIndexerService) OnStart() error {
 	// Indexer loop
 	for {
 		chainHeight := atomic.LoadInt64(&chainHeightStorage)
+		telemetry.SetGauge(float32(max(chainHeight-lastIndexedHeight, 0)), MetricKeyEVMTxIndexerLag...)
 		if chainHeight <= lastIndexedHeight {
 			// nothing to index. wait for signal of new block
 			select {
@@ -127,7 +133,15 @@ func (service *EVMTxIndexerService) OnStart() error {
 				break
 			}
 			if err := service.evmTxIndexer.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+				// Retry from the same height on the next block, e.g. after a
+				// restart of the database of the sink, rather than skipping
+				// the block.
 				service.Logger.Error("failed to index block", "height", i, "err", err)
+				select {
+				case <-newBlockSignal:
+				case <-time.After(NewBlockWaitTimeout):
+				}
+				break
 			}
 			lastIndexedHeight = blockResult.Height
 		}
@@ -16,6 +16,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/app/server/config"
 	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/eth/indexer"
+	"github.com/NibiruChain/nibiru/v2/eth/indexer/sqlsink"
 
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -604,12 +605,38 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenEVMIndexerSink opens the external SQL sink of the EVM tx indexer,
+// returns nil if the sink is disabled.
+func OpenEVMIndexerSink(conf config.EVMIndexerSinkConfig) (indexer.EVMIndexerSink, error) {
+	if conf.Driver == "" {
+		return nil, nil
+	}
+	sink, err := sqlsink.Open(conf.Driver, conf.DSN)
+	if err != nil {
+		return nil, err
+	}
+	return sink, nil
+}
+
 func OpenEVMIndexer(
 	ctx *sdkserver.Context, indexerDb dbm.DB, clientCtx client.Context,
 ) (eth.EVMTxIndexer, *EVMTxIndexerService, error) {
 	idxLogger := ctx.Logger.With("indexer", "evm")
 	evmIndexer := indexer.NewEVMTxIndexer(indexerDb, idxLogger, clientCtx)
 
+	conf, err := config.GetConfig(ctx.Viper)
+	if err != nil {
+		return nil, nil, err
+	}
+	sink, err := OpenEVMIndexerSink(conf.EVMIndexerSink)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sink != nil {
+		idxLogger.Info("Writing indexed EVM blocks to sql sink", "driver", conf.EVMIndexerSink.Driver)
+		evmIndexer.WithSink(sink)
+	}
+
 	evmIndexerService := NewEVMIndexerService(evmIndexer, clientCtx.Client.(cmtrpcclient.Client))
 	evmIndexerService.SetLogger(idxLogger)
 
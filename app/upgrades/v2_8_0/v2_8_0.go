@@ -0,0 +1,259 @@
+package v2_8_0
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/NibiruChain/collections"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	clientkeeper "github.com/cosmos/ibc-go/v7/modules/core/02-client/keeper"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/app/upgrades"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
+	oracletypes "github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+const UpgradeName = "v2.8.0"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName: UpgradeName,
+	CreateUpgradeHandler: func(
+		mm *module.Manager,
+		cfg module.Configurator,
+		nibiru *keepers.PublicKeepers,
+		clientKeeper clientkeeper.Keeper,
+	) upgradetypes.UpgradeHandler {
+		return func(
+			ctx sdk.Context,
+			plan upgradetypes.Plan,
+			fromVM module.VersionMap,
+		) (module.VersionMap, error) {
+			err := UpgradeFunTokensWithPermit(nibiru, ctx)
+			if err != nil {
+				return fromVM, fmt.Errorf("v2.8.0 upgrade failure: %w", err)
+			}
+
+			// Replace the fixed staking, community pool and strategic reserve
+			// split with the equivalent list of inflation recipients.
+			err = nibiru.InflationKeeper.MigrateInflationDistribution(ctx)
+			if err != nil {
+				return fromVM, fmt.Errorf("v2.8.0 upgrade failure: %w", err)
+			}
+
+			// The circulating supply reads the unvested balances from an
+			// index of vesting accounts instead of iterating over all accounts.
+			nibiru.InflationKeeper.IndexVestingAccounts(ctx)
+
+			// Price snapshots and vote accuracy records are pruned from this
+			// upgrade onward.
+			err = SetOracleRetentionParams(nibiru, ctx)
+			if err != nil {
+				return fromVM, fmt.Errorf("v2.8.0 upgrade failure: %w", err)
+			}
+
+			return mm.RunMigrations(ctx, cfg, fromVM)
+		}
+	},
+	StoreUpgrades: storetypes.StoreUpgrades{},
+}
+
+// SetOracleRetentionParams sets the snapshot and vote accuracy retentions of
+// the oracle params to [oracletypes.DefaultSnapshotRetentionWindows] and
+// [oracletypes.DefaultVoteAccuracyRetentionWindows]. Params stored before
+// v2.8.0 have no retention fields, which decode as zero and would keep every
+// price snapshot forever and only the current slash window of vote accuracies.
+func SetOracleRetentionParams(keepers *keepers.PublicKeepers, ctx sdk.Context) error {
+	params, err := keepers.OracleKeeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get oracle params: %w", err)
+	}
+	params.SnapshotRetentionWindows = oracletypes.DefaultSnapshotRetentionWindows
+	params.VoteAccuracyRetentionWindows = oracletypes.DefaultVoteAccuracyRetentionWindows
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid oracle params: %w", err)
+	}
+	keepers.OracleKeeper.Params.Set(ctx, params)
+	return nil
+}
+
+// UpgradeFunTokensWithPermit replaces the bytecode of every ERC20 deployed and
+// owned by the EVM module for a FunToken mapping with
+// "ERC20MinterWithPermit.sol", which adds EIP-2612 "permit" and EIP-5267
+// "eip712Domain" to the token.
+//
+// The contract address, balances, allowances, and owner are preserved because
+// the new contract only appends storage for permit nonces. The runtime bytecode
+// of "ERC20MinterWithPermit.sol" doesn't depend on constructor arguments, so the
+// upgrade is an in-place code swap followed by a check that the token metadata
+// is unchanged. If the token came from an older contract that stored its
+// metadata elsewhere, the metadata is set again through the owner functions.
+//
+// FunToken mappings that originated from ERC20s are skipped because the EVM
+// module doesn't own those contracts.
+func UpgradeFunTokensWithPermit(
+	keepers *keepers.PublicKeepers,
+	ctx sdk.Context,
+) (err error) {
+	// IMPORTANT: make sure to clear the StateDB before running the upgrade
+	keepers.EvmKeeper.Bank.StateDB = nil
+	defer func() {
+		keepers.EvmKeeper.Bank.StateDB = nil
+	}()
+
+	newCodeHash := crypto.Keccak256Hash(embeds.SmartContract_ERC20MinterWithPermit.DeployedBytecode)
+
+	funtokens := keepers.EvmKeeper.FunTokens.Iterate(ctx, collections.Range[[]byte]{}).Values()
+	for _, funtoken := range funtokens {
+		if !funtoken.IsMadeFromCoin && !funtoken.IsMadeFromCw20() {
+			continue
+		}
+		erc20Addr := funtoken.Erc20Addr.Address
+		acc := keepers.EvmKeeper.GetAccount(ctx, erc20Addr)
+		if acc == nil || !acc.IsContract() || bytes.Equal(acc.CodeHash, newCodeHash.Bytes()) {
+			continue
+		}
+
+		if err = UpgradeErc20WithPermit(keepers, ctx, erc20Addr); err != nil {
+			return fmt.Errorf(
+				"failed to upgrade ERC20 %s of FunToken mapping for \"%s\": %w",
+				erc20Addr.Hex(), funtoken.BankDenom, err,
+			)
+		}
+	}
+	return nil
+}
+
+// UpgradeErc20WithPermit swaps the bytecode of the module-owned ERC20 at
+// "erc20Addr" for "ERC20MinterWithPermit.sol" and makes sure the name, symbol,
+// and decimals are the same before and after the swap.
+func UpgradeErc20WithPermit(
+	keepers *keepers.PublicKeepers,
+	ctx sdk.Context,
+	erc20Addr gethcommon.Address,
+) error {
+	evmKeeper := keepers.EvmKeeper
+	newContract := embeds.SmartContract_ERC20MinterWithPermit
+
+	// STEP 1: Load the token metadata with the original bytecode
+	stateDB := evmKeeper.NewStateDB(ctx, evmKeeper.TxConfig(ctx, gethcommon.Hash{}))
+	evmObj := newModuleEVM(keepers, ctx, erc20Addr, stateDB)
+	wantInfo, err := evmKeeper.FindERC20Metadata(ctx, evmObj, erc20Addr, nil)
+	if err != nil {
+		return fmt.Errorf("failed to load ERC20 metadata before upgrade: %w", err)
+	}
+
+	// STEP 2: Overwrite the bytecode. Storage is left as is.
+	stateDB.SetCode(erc20Addr, newContract.DeployedBytecode)
+	if err := stateDB.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", evm.ErrStateDBCommit, err)
+	}
+	_ = ctx.EventManager().EmitTypedEvent(
+		// This event is to show we've overwritten the bytecode. Think of this
+		// like a redeployment.
+		&evm.EventContractDeployed{
+			Sender:       evm.EVM_MODULE_ADDRESS.Hex(),
+			ContractAddr: erc20Addr.Hex(),
+		},
+	)
+
+	// STEP 3: Restore any metadata that the new storage layout doesn't read
+	// from the same slots as the original contract.
+	stateDB = evmKeeper.NewStateDB(ctx, evmKeeper.TxConfig(ctx, gethcommon.Hash{}))
+	evmObj = newModuleEVM(keepers, ctx, erc20Addr, stateDB)
+	gotInfo, err := evmKeeper.FindERC20Metadata(ctx, evmObj, erc20Addr, newContract.ABI)
+	if err != nil {
+		return fmt.Errorf("failed to load ERC20 metadata after upgrade: %w", err)
+	}
+	var evmLogs []evm.Log
+	for _, fix := range []struct {
+		needed bool
+		method string
+		arg    any
+	}{
+		{gotInfo.Name != wantInfo.Name, "setName", wantInfo.Name},
+		{gotInfo.Symbol != wantInfo.Symbol, "setSymbol", wantInfo.Symbol},
+		{gotInfo.Decimals != wantInfo.Decimals, "setDecimals", wantInfo.Decimals},
+	} {
+		if !fix.needed {
+			continue
+		}
+		contractInput, err := newContract.ABI.Pack(fix.method, fix.arg)
+		if err != nil {
+			return fmt.Errorf("failed to pack ABI args for %s: %w", fix.method, err)
+		}
+		evmResp, err := evmKeeper.CallContract(
+			ctx, evmObj, evm.EVM_MODULE_ADDRESS, &erc20Addr, contractInput,
+			evm.Erc20GasLimitExecute,
+			evm.COMMIT_ETH_TX, /*commit*/
+			nil,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to call %s: %w", fix.method, err)
+		} else if evmResp.Failed() {
+			return fmt.Errorf("VM Error in %s: %s", fix.method, evmResp.VmError)
+		}
+		evmLogs = append(evmLogs, evmResp.Logs...)
+	}
+	if err := stateDB.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", evm.ErrStateDBCommit, err)
+	}
+	if len(evmLogs) > 0 {
+		_ = ctx.EventManager().EmitTypedEvent(&evm.EventTxLog{Logs: evmLogs})
+	}
+
+	// STEP 4: Sanity check the upgraded contract
+	evmObj = newModuleEVM(
+		keepers, ctx, erc20Addr,
+		evmKeeper.NewStateDB(ctx, evmKeeper.TxConfig(ctx, gethcommon.Hash{})),
+	)
+	gotInfo, err = evmKeeper.FindERC20Metadata(ctx, evmObj, erc20Addr, newContract.ABI)
+	if err != nil {
+		return fmt.Errorf("failed to load ERC20 metadata after upgrade: %w", err)
+	}
+	if *gotInfo != *wantInfo {
+		return fmt.Errorf(
+			"mismatch in upgraded contract metadata: wanted %+v, got %+v", *wantInfo, *gotInfo,
+		)
+	}
+	return nil
+}
+
+func newModuleEVM(
+	keepers *keepers.PublicKeepers,
+	ctx sdk.Context,
+	erc20Addr gethcommon.Address,
+	stateDB *statedb.StateDB,
+) *vm.EVM {
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               &erc20Addr,
+		From:             evm.EVM_MODULE_ADDRESS,
+		Nonce:            keepers.EvmKeeper.GetAccNonce(ctx, evm.EVM_MODULE_ADDRESS),
+		Value:            unusedBigInt,
+		GasLimit:         evm.Erc20GasLimitExecute,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		Data:             []byte{},
+		AccessList:       gethcore.AccessList{},
+		BlobGasFeeCap:    &big.Int{},
+		BlobHashes:       []gethcommon.Hash{},
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+	return keepers.EvmKeeper.NewEVM(
+		ctx, evmMsg, keepers.EvmKeeper.GetEVMConfig(ctx), nil /*tracer*/, stateDB,
+	)
+}
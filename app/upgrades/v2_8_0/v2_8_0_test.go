@@ -0,0 +1,156 @@
+package v2_8_0_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/NibiruChain/nibiru/v2/app/upgrades/v2_8_0"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	oracletypes "github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+// The v2.8.0 upgrade replaces the bytecode of the ERC20s that the EVM module
+// deployed for FunToken mappings with "ERC20MinterWithPermit.sol".
+//
+// Test Procedure
+//  1. Deploy an ERC20 contract used before the upgrade, which doesn't support
+//     "permit", from the EVM module and map it to a bank coin.
+//  2. Give an account ERC20 tokens.
+//  3. Run the upgrade and check that the address, balances, and metadata of the
+//     ERC20 are unchanged and that "permit" works.
+func (s *Suite) TestUpgradeFunTokensWithPermit() {
+	// "ERC20Minter.sol" isn't loaded by default since nothing deploys it anymore.
+	erc20Minter := embeds.SmartContract_ERC20Minter
+	erc20Minter.MustLoad()
+
+	for _, oldContract := range []struct {
+		name     string
+		contract embeds.CompiledEvmContract
+	}{
+		{"ERC20Minter.sol", erc20Minter},
+		{"ERC20MinterWithMetadataUpdates.sol", embeds.SmartContract_ERC20MinterWithMetadataUpdates},
+	} {
+		s.Run(oldContract.name, func() {
+			deps := evmtest.NewTestDeps()
+			bankDenom := "unibi_permit"
+			holder := evmtest.NewEthPrivAcc()
+
+			s.T().Logf("Deploy %s from the EVM module for a FunToken mapping", oldContract.name)
+			erc20 := crypto.CreateAddress(evm.EVM_MODULE_ADDRESS, deps.EvmKeeper.GetAccNonce(deps.Ctx, evm.EVM_MODULE_ADDRESS))
+			evmObj, stateDB := deps.NewEVM()
+			{
+				packedArgs, err := oldContract.contract.ABI.Pack("", "Name for "+bankDenom, "TOKEN", uint8(6))
+				s.Require().NoError(err)
+				_, err = deps.EvmKeeper.CallContract(
+					deps.Ctx, evmObj, evm.EVM_MODULE_ADDRESS, nil,
+					append(oldContract.contract.Bytecode, packedArgs...),
+					evmkeeper.Erc20GasLimitDeploy, evm.COMMIT_ETH_TX, nil,
+				)
+				s.Require().NoError(err)
+				s.Require().NoError(stateDB.Commit())
+				s.Require().NoError(deps.EvmKeeper.FunTokens.SafeInsert(deps.Ctx, erc20, bankDenom, true))
+			}
+
+			s.T().Log("Give the holder ERC20 tokens")
+			_, err := deps.EvmKeeper.ERC20().Mint(
+				erc20, evm.EVM_MODULE_ADDRESS, holder.EthAddr, big.NewInt(420), deps.Ctx, evmObj,
+			)
+			s.Require().NoError(err)
+			s.Require().NoError(stateDB.Commit())
+
+			evmObj, _ = deps.NewEVM()
+			metadataBefore, err := deps.EvmKeeper.FindERC20Metadata(
+				deps.Ctx, evmObj, erc20, embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI,
+			)
+			s.Require().NoError(err)
+			_, err = deps.EvmKeeper.ERC20().LoadERC20BigInt(
+				deps.Ctx, evmObj, embeds.SmartContract_ERC20MinterWithPermit.ABI, erc20, "nonces", holder.EthAddr,
+			)
+			s.Require().Error(err, "expect no permit support before the upgrade")
+
+			s.T().Log("Run the upgrade")
+			s.Require().NoError(deps.RunUpgrade(v2_8_0.Upgrade))
+
+			newCodeHash := crypto.Keccak256Hash(embeds.SmartContract_ERC20MinterWithPermit.DeployedBytecode)
+			acc := deps.EvmKeeper.GetAccount(deps.Ctx, erc20)
+			s.Require().NotNil(acc)
+			s.Equal(newCodeHash.Hex(), gethcommon.BytesToHash(acc.CodeHash).Hex())
+
+			s.T().Log("Expect ERC20 metadata and balances to be unchanged")
+			evmObj, _ = deps.NewEVM()
+			metadataAfter, err := deps.EvmKeeper.FindERC20Metadata(
+				deps.Ctx, evmObj, erc20, embeds.SmartContract_ERC20MinterWithPermit.ABI,
+			)
+			s.Require().NoError(err)
+			s.Equal(*metadataBefore, *metadataAfter)
+			s.Equal("Name for "+bankDenom, metadataAfter.Name)
+			evmtest.AssertERC20BalanceEqualWithDescription(
+				s.T(), deps, evmObj, erc20, holder.EthAddr, big.NewInt(420), "holder",
+			)
+
+			s.T().Log("Expect permit to work after the upgrade")
+			spender := evmtest.NewEthPrivAcc()
+			permit := evmtest.ERC20Permit{
+				Owner:    holder,
+				Spender:  spender.EthAddr,
+				Value:    big.NewInt(69),
+				Nonce:    big.NewInt(0),
+				Deadline: new(big.Int).SetUint64(math.MaxUint64),
+			}
+			v, r, sig := permit.Sign(s.T(), deps, erc20, metadataAfter.Name)
+			input, err := embeds.SmartContract_ERC20MinterWithPermit.ABI.Pack(
+				"permit", holder.EthAddr, spender.EthAddr, permit.Value, permit.Deadline, v, r, sig,
+			)
+			s.Require().NoError(err)
+			_, err = deps.EvmKeeper.CallContract(
+				deps.Ctx, evmObj, spender.EthAddr, &erc20, input,
+				evm.Erc20GasLimitExecute, evm.COMMIT_ETH_TX, nil,
+			)
+			s.Require().NoError(err)
+			allowance, err := deps.EvmKeeper.ERC20().LoadERC20BigInt(
+				deps.Ctx, evmObj, embeds.SmartContract_ERC20MinterWithPermit.ABI, erc20,
+				"allowance", holder.EthAddr, spender.EthAddr,
+			)
+			s.Require().NoError(err)
+			s.Equal("69", allowance.String())
+
+			s.T().Log("Running the upgrade again is a no-op")
+			s.Require().NoError(v2_8_0.UpgradeFunTokensWithPermit(&deps.App.PublicKeepers, deps.Ctx))
+		})
+	}
+}
+
+// Oracle params stored before v2.8.0 decode with zero retentions, which keep
+// every price snapshot. The upgrade sets the default retentions.
+func (s *Suite) TestSetOracleRetentionParams() {
+	deps := evmtest.NewTestDeps()
+	oracleKeeper := deps.App.OracleKeeper
+	params, err := oracleKeeper.Params.Get(deps.Ctx)
+	s.Require().NoError(err)
+	params.SnapshotRetentionWindows = 0
+	params.VoteAccuracyRetentionWindows = 0
+	oracleKeeper.Params.Set(deps.Ctx, params)
+
+	s.Require().NoError(v2_8_0.SetOracleRetentionParams(&deps.App.PublicKeepers, deps.Ctx))
+
+	params, err = oracleKeeper.Params.Get(deps.Ctx)
+	s.Require().NoError(err)
+	s.Equal(uint64(oracletypes.DefaultSnapshotRetentionWindows), params.SnapshotRetentionWindows)
+	s.Equal(uint64(oracletypes.DefaultVoteAccuracyRetentionWindows), params.VoteAccuracyRetentionWindows)
+}
+
+type Suite struct {
+	suite.Suite
+}
+
+func TestV2_8_0(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
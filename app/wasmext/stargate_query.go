@@ -111,12 +111,14 @@ func WasmAcceptedStargateQueries() wasmkeeper.AcceptedStargateQueries {
 		"/nibiru.epochs.v1.Query/CurrentEpoch": new(epochs.QueryCurrentEpochResponse),
 
 		// nibiru inflation
-		"/nibiru.inflation.v1.Query/Period":             new(inflation.QueryPeriodResponse),
-		"/nibiru.inflation.v1.Query/EpochMintProvision": new(inflation.QueryEpochMintProvisionResponse),
-		"/nibiru.inflation.v1.Query/SkippedEpochs":      new(inflation.QuerySkippedEpochsResponse),
-		"/nibiru.inflation.v1.Query/CirculatingSupply":  new(inflation.QueryCirculatingSupplyResponse),
-		"/nibiru.inflation.v1.Query/InflationRate":      new(inflation.QueryInflationRateResponse),
-		"/nibiru.inflation.v1.Query/Params":             new(inflation.QueryParamsResponse),
+		"/nibiru.inflation.v1.Query/Period":                     new(inflation.QueryPeriodResponse),
+		"/nibiru.inflation.v1.Query/EpochMintProvision":         new(inflation.QueryEpochMintProvisionResponse),
+		"/nibiru.inflation.v1.Query/SkippedEpochs":              new(inflation.QuerySkippedEpochsResponse),
+		"/nibiru.inflation.v1.Query/CirculatingSupply":          new(inflation.QueryCirculatingSupplyResponse),
+		"/nibiru.inflation.v1.Query/CirculatingSupplyBreakdown": new(inflation.QueryCirculatingSupplyBreakdownResponse),
+		"/nibiru.inflation.v1.Query/InflationRate":              new(inflation.QueryInflationRateResponse),
+		"/nibiru.inflation.v1.Query/InflationSchedule":          new(inflation.QueryInflationScheduleResponse),
+		"/nibiru.inflation.v1.Query/Params":                     new(inflation.QueryParamsResponse),
 
 		// nibiru oracle
 		"/nibiru.oracle.v1.Query/ExchangeRate":      new(oracle.QueryExchangeRateResponse),
@@ -131,6 +133,10 @@ func WasmAcceptedStargateQueries() wasmkeeper.AcceptedStargateQueries {
 		"/nibiru.oracle.v1.Query/AggregateVote":     new(oracle.QueryAggregateVoteResponse),
 		"/nibiru.oracle.v1.Query/AggregateVotes":    new(oracle.QueryAggregateVotesResponse),
 		"/nibiru.oracle.v1.Query/Params":            new(oracle.QueryParamsResponse),
+		"/nibiru.oracle.v1.Query/PriceHistory":      new(oracle.QueryPriceHistoryResponse),
+		"/nibiru.oracle.v1.Query/DerivedPairs":      new(oracle.QueryDerivedPairsResponse),
+		"/nibiru.oracle.v1.Query/ValidatorAccuracy": new(oracle.QueryValidatorAccuracyResponse),
+		"/nibiru.oracle.v1.Query/VoteAccuracies":    new(oracle.QueryVoteAccuraciesResponse),
 
 		// nibiru sudo
 		"/nibiru.sudo.v1.Query/QuerySudoers": new(sudotypes.QuerySudoersResponse),
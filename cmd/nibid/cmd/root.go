@@ -29,6 +29,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
 	"github.com/NibiruChain/nibiru/v2/app/server"
 	srvconfig "github.com/NibiruChain/nibiru/v2/app/server/config"
+	ethhd "github.com/NibiruChain/nibiru/v2/eth/crypto/hd"
 	oraclecli "github.com/NibiruChain/nibiru/v2/x/oracle/cli"
 	"github.com/NibiruChain/nibiru/v2/x/sudo/cli"
 )
@@ -58,6 +59,8 @@ func NewRootCmd() (*cobra.Command, app.EncodingConfig) {
 		WithInput(os.Stdin).
 		WithAccountRetriever(types.AccountRetriever{}).
 		WithHomeDir(app.DefaultNodeHome).
+		// eth_secp256k1 keys sign EVM txs, as with "nibid tx evm deploy".
+		WithKeyringOptions(ethhd.EthSecp256k1Option()).
 		WithViper("") // In simapp, we don't use any prefix for env variables.
 
 	rootCmd := &cobra.Command{
@@ -9,11 +9,13 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	tmos "github.com/cometbft/cometbft/libs/os"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	"github.com/cometbft/cometbft/p2p"
 	cmttypes "github.com/cometbft/cometbft/types"
 	tmtime "github.com/cometbft/cometbft/types/time"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -34,6 +36,8 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/spf13/cobra"
 
+	serverconfig "github.com/NibiruChain/nibiru/v2/app/server/config"
+	ethhd "github.com/NibiruChain/nibiru/v2/eth/crypto/hd"
 	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
 )
 
@@ -43,6 +47,7 @@ var (
 	flagOutputDir         = "output-dir"
 	flagNodeDaemonHome    = "node-daemon-home"
 	flagStartingIPAddress = "starting-ip-address"
+	flagSpec              = "spec"
 )
 
 // get cmd to initialize all files for tendermint testnet and application
@@ -57,8 +62,37 @@ necessary files (private validator, genesis, config, etc.).
 
 Note, strict routability for addresses is turned off in the config file.
 
+With "--spec", a JSON file declares the rest of the localnet: genesis accounts
+with ETH-style keys, predeployed EVM contracts and Wasm codes, FunToken
+mappings, sudoers, oracle pairs and prices, and the JSON-RPC ports of each
+node. The keys and addresses of the localnet are written to "testnet.json" in
+the output directory.
+
 Example:
 	nibid testnet --v 4 -o ./output --starting-ip-address 192.168.10.2
+	nibid testnet -o ./output --spec localnet.json --keyring-backend test
+
+Example spec:
+	{
+	  "chain_id": "nibiru-localnet-0",
+	  "validators": 2,
+	  "seed": "localnet",
+	  "genesis_time": "2024-01-01T00:00:00Z",
+	  "accounts": [{"name": "alice", "coins": "1000000000000unibi"}],
+	  "wnibi": true,
+	  "contracts": [{"name": "Counter", "artifact": "./out/Counter.json", "deployer": "alice"}],
+	  "fun_tokens": [{
+	    "bank_denom": "uusdc",
+	    "metadata": {
+	      "base": "uusdc", "display": "usdc", "name": "USD Coin", "symbol": "USDC",
+	      "denom_units": [{"denom": "uusdc", "exponent": 0}, {"denom": "usdc", "exponent": 6}]
+	    }
+	  }],
+	  "wasm_codes": [{"file": "./cw20_base.wasm", "creator": "alice"}],
+	  "sudo": {"root": "alice", "contracts": ["alice"]},
+	  "oracle": {"prices": {"ubtc:uusd": "50000", "ueth:uusd": "2000"}},
+	  "json_rpc": {"port": 8545, "ws_port": 8546, "port_step": 10, "enable_indexer": true}
+	}
 	`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -79,9 +113,17 @@ Example:
 			numValidators, _ := cmd.Flags().GetInt(flagNumValidators)
 			algo, _ := cmd.Flags().GetString(flags.FlagKeyType)
 
+			var spec *TestnetSpec
+			if specPath, _ := cmd.Flags().GetString(flagSpec); specPath != "" {
+				if spec, err = LoadTestnetSpec(specPath); err != nil {
+					return err
+				}
+			}
+
 			return InitTestnet(
 				clientCtx, cmd, config, mbm, genBalIterator, outputDir, chainID, minGasPrices,
 				nodeDirPrefix, nodeDaemonHome, startingIPAddress, keyringBackend, algo, numValidators,
+				spec,
 			)
 		},
 	}
@@ -95,13 +137,15 @@ Example:
 	cmd.Flags().String(server.FlagMinGasPrices, fmt.Sprintf("0.000006%s", denoms.NIBI), "Minimum gas prices to accept for transactions; All fees in a tx must meet this minimum (e.g. 0.01photino,0.001stake)")
 	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
 	cmd.Flags().String(flags.FlagKeyType, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
+	cmd.Flags().String(flagSpec, "", "JSON file declaring the accounts, contracts, FunTokens, sudoers, oracle prices and JSON-RPC ports of the testnet")
 
 	return cmd
 }
 
 const nodeDirPerm = 0o755
 
-// Initialize the testnet
+// Initialize the testnet. A non-nil "spec" overrides "chainID" and
+// "numValidators" when it sets them, and adds its own genesis state.
 func InitTestnet(
 	clientCtx client.Context,
 	cmd *cobra.Command,
@@ -117,13 +161,33 @@ func InitTestnet(
 	keyringBackend,
 	algoStr string,
 	numValidators int,
+	spec *TestnetSpec,
 ) error {
+	genTime := tmtime.Now()
+	var specKeys []testnetKey
+	if spec != nil {
+		if spec.ChainID != "" {
+			chainID = spec.ChainID
+		}
+		if spec.Validators > 0 {
+			numValidators = spec.Validators
+		}
+		if spec.GenesisTime != nil {
+			genTime = *spec.GenesisTime
+		}
+		var err error
+		if specKeys, err = spec.accountKeys(); err != nil {
+			return err
+		}
+	}
 	if chainID == "" {
 		chainID = "chain-" + cmtrand.NewRand().Str(6)
 	}
 
 	nodeIDs := make([]string, numValidators)
 	valPubKeys := make([]cryptotypes.PubKey, numValidators)
+	nodeNames := make([]string, numValidators)
+	valAddrs := make([]sdk.AccAddress, numValidators)
 
 	simappConfig := srvconfig.DefaultConfig()
 	simappConfig.MinGasPrices = minGasPrices
@@ -133,6 +197,20 @@ func InitTestnet(
 	simappConfig.Telemetry.EnableHostnameLabel = false
 	simappConfig.Telemetry.GlobalLabels = [][]string{{"chain_id", chainID}}
 
+	// With a spec, the app.toml of the nodes includes the EVM and JSON-RPC
+	// sections, and the validators take a commission that leaves most of the
+	// staking rewards to their delegators.
+	var specAppConfig *serverconfig.Config
+	commission := stakingtypes.NewCommissionRates(sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec())
+	if spec != nil {
+		srvconfig.SetConfigTemplate(srvconfig.DefaultConfigTemplate + serverconfig.DefaultConfigTemplate)
+		specAppConfig = serverconfig.DefaultConfig()
+		specAppConfig.Config = *simappConfig
+		commission = stakingtypes.NewCommissionRates(
+			sdkmath.LegacyNewDecWithPrec(1, 1), sdkmath.LegacyNewDecWithPrec(2, 1), sdkmath.LegacyNewDecWithPrec(1, 2),
+		)
+	}
+
 	var (
 		genAccounts []authtypes.GenesisAccount
 		genBalances []banktypes.Balance
@@ -162,7 +240,20 @@ func InitTestnet(
 			return err
 		}
 
-		nodeIDs[i], valPubKeys[i], err = genutil.InitializeNodeValidatorFiles(nodeConfig)
+		// With a seed, the node key and the validator key are derived from it
+		// instead of being random.
+		var valMnemonic string
+		if spec != nil && spec.Seed != "" {
+			if valMnemonic, err = spec.mnemonic("validator/" + nodeDirName); err != nil {
+				return err
+			}
+			nodeKey := p2p.NodeKey{PrivKey: spec.nodeKey(nodeDirName)}
+			if err := nodeKey.SaveAs(nodeConfig.NodeKeyFile()); err != nil {
+				_ = os.RemoveAll(outputDir)
+				return err
+			}
+		}
+		nodeIDs[i], valPubKeys[i], err = genutil.InitializeNodeValidatorFilesFromMnemonic(nodeConfig, valMnemonic)
 		if err != nil {
 			_ = os.RemoveAll(outputDir)
 			return err
@@ -171,7 +262,10 @@ func InitTestnet(
 		memo := fmt.Sprintf("%s@%s:26656", nodeIDs[i], ip)
 		genFiles = append(genFiles, nodeConfig.GenesisFile())
 
-		kb, err := keyring.New(sdk.KeyringServiceName(), keyringBackend, nodeDir, inBuf, clientCtx.Codec)
+		kb, err := keyring.New(
+			sdk.KeyringServiceName(), keyringBackend, nodeDir, inBuf, clientCtx.Codec,
+			ethhd.EthSecp256k1Option(),
+		)
 		if err != nil {
 			return err
 		}
@@ -182,10 +276,38 @@ func InitTestnet(
 			return err
 		}
 
-		addr, secret, err := testutil.GenerateSaveCoinKey(kb, nodeDirName, "", true, algo)
-		if err != nil {
-			_ = os.RemoveAll(outputDir)
-			return err
+		var (
+			addr   sdk.AccAddress
+			secret string
+		)
+		if valMnemonic != "" {
+			record, err := kb.NewAccount(
+				nodeDirName, valMnemonic, keyring.DefaultBIP39Passphrase,
+				sdk.GetConfig().GetFullBIP44Path(), algo,
+			)
+			if err != nil {
+				_ = os.RemoveAll(outputDir)
+				return err
+			}
+			if addr, err = record.GetAddress(); err != nil {
+				return err
+			}
+			secret = valMnemonic
+		} else {
+			addr, secret, err = testutil.GenerateSaveCoinKey(kb, nodeDirName, "", true, algo)
+			if err != nil {
+				_ = os.RemoveAll(outputDir)
+				return err
+			}
+		}
+		nodeNames[i], valAddrs[i] = nodeDirName, addr
+
+		for _, key := range specKeys {
+			_, err := kb.NewAccount(key.Name, key.Mnemonic, keyring.DefaultBIP39Passphrase, key.HDPath, key.algo)
+			if err != nil {
+				_ = os.RemoveAll(outputDir)
+				return fmt.Errorf("failed to add the key of account %q: %w", key.Name, err)
+			}
 		}
 
 		info := map[string]string{"secret": secret}
@@ -216,7 +338,7 @@ func InitTestnet(
 			valPubKeys[i],
 			sdk.NewCoin(denoms.NIBI, valTokens),
 			stakingtypes.NewDescription(nodeDirName, "", "", "", ""),
-			stakingtypes.NewCommissionRates(sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec()),
+			commission,
 			sdkmath.OneInt(),
 		)
 		if err != nil {
@@ -250,21 +372,68 @@ func InitTestnet(
 			return err
 		}
 
-		srvconfig.WriteConfigFile(filepath.Join(nodeDir, "config/app.toml"), simappConfig)
+		appTomlPath := filepath.Join(nodeDir, "config/app.toml")
+		if specAppConfig == nil {
+			srvconfig.WriteConfigFile(appTomlPath, simappConfig)
+			continue
+		}
+		nodeAppConfig := *specAppConfig
+		if spec.JSONRPC != nil {
+			nodeAppConfig.JSONRPC.Enable = true
+			nodeAppConfig.JSONRPC.Address, nodeAppConfig.JSONRPC.WsAddress = spec.JSONRPC.jsonRPCAddrs(i)
+			nodeAppConfig.JSONRPC.EnableIndexer = spec.JSONRPC.EnableIndexer
+			if len(spec.JSONRPC.API) > 0 {
+				nodeAppConfig.JSONRPC.API = spec.JSONRPC.API
+			}
+		}
+		srvconfig.WriteConfigFile(appTomlPath, nodeAppConfig)
+	}
+
+	var specGen *testnetSpecGenesis
+	if spec != nil {
+		var err error
+		specGen, err = spec.buildGenesis(
+			chainID, genTime, specKeys, nodeNames, valAddrs, uint64(numValidators),
+		)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+		genAccounts = append(genAccounts, specGen.accounts...)
+		genBalances = append(genBalances, specGen.balances...)
 	}
 
-	if err := initGenFiles(clientCtx, mbm, chainID, genAccounts, genBalances, genFiles, numValidators); err != nil {
+	if err := initGenFiles(clientCtx, mbm, chainID, genAccounts, genBalances, genFiles, numValidators, specGen); err != nil {
 		return err
 	}
 
 	err := collectGenFiles(
 		clientCtx, nodeConfig, chainID, nodeIDs, valPubKeys, numValidators,
-		outputDir, nodeDirPrefix, nodeDaemonHome, genBalIterator,
+		outputDir, nodeDirPrefix, nodeDaemonHome, genBalIterator, genTime,
 	)
 	if err != nil {
 		return err
 	}
 
+	if specGen != nil {
+		for i := range nodeNames {
+			node := testnetNodeInfo{
+				Moniker: nodeNames[i], NodeID: nodeIDs[i], Address: valAddrs[i].String(),
+			}
+			if spec.JSONRPC != nil {
+				node.JSONRPC, node.JSONRPCWs = spec.JSONRPC.jsonRPCAddrs(i)
+			}
+			specGen.info.Nodes = append(specGen.info.Nodes, node)
+		}
+		infoBz, err := json.MarshalIndent(specGen.info, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := writeFile("testnet.json", outputDir, infoBz); err != nil {
+			return err
+		}
+	}
+
 	cmd.PrintErrf("Successfully initialized %d node directories\n", numValidators)
 	return nil
 }
@@ -272,7 +441,7 @@ func InitTestnet(
 func initGenFiles(
 	clientCtx client.Context, mbm module.BasicManager, chainID string,
 	genAccounts []authtypes.GenesisAccount, genBalances []banktypes.Balance,
-	genFiles []string, numValidators int,
+	genFiles []string, numValidators int, specGen *testnetSpecGenesis,
 ) error {
 	appGenState := mbm.DefaultGenesis(clientCtx.Codec)
 
@@ -298,6 +467,10 @@ func initGenFiles(
 	}
 	appGenState[banktypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&bankGenState)
 
+	if specGen != nil {
+		specGen.apply(clientCtx.Codec, appGenState)
+	}
+
 	appGenStateJSON, err := json.MarshalIndent(appGenState, "", "  ")
 	if err != nil {
 		return err
@@ -322,9 +495,9 @@ func collectGenFiles(
 	clientCtx client.Context, nodeConfig *cmtcfg.Config, chainID string,
 	nodeIDs []string, valPubKeys []cryptotypes.PubKey, numValidators int,
 	outputDir, nodeDirPrefix, nodeDaemonHome string, genBalIterator banktypes.GenesisBalancesIterator,
+	genTime time.Time,
 ) error {
 	var appState json.RawMessage
-	genTime := tmtime.Now()
 
 	for i := 0; i < numValidators; i++ {
 		nodeDirName := fmt.Sprintf("%s%d", nodeDirPrefix, i)
@@ -0,0 +1,892 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/go-bip39"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/triedb"
+	"github.com/holiman/uint256"
+
+	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	"github.com/NibiruChain/nibiru/v2/app/upgrades/v2_7_0"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	ethhd "github.com/NibiruChain/nibiru/v2/eth/crypto/hd"
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	evmcli "github.com/NibiruChain/nibiru/v2/x/evm/cli"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	oracletypes "github.com/NibiruChain/nibiru/v2/x/oracle/types"
+	sudotypes "github.com/NibiruChain/nibiru/v2/x/sudo/types"
+)
+
+// TestnetSpec declaratively describes a localnet for "nibid testnet --spec".
+// On top of the validators, it sets genesis accounts, predeployed EVM
+// contracts and Wasm codes, FunToken mappings, sudoers, oracle prices and the
+// JSON-RPC server of each node.
+//
+// Everything is computed offline. Given a "seed" and a "genesis_time", the
+// same spec always produces the same keys and genesis.
+type TestnetSpec struct {
+	// ChainID overrides the "--chain-id" flag.
+	ChainID string `json:"chain_id"`
+	// Validators overrides the "--v" flag.
+	Validators int `json:"validators"`
+	// Seed derives the node keys, the validator mnemonics and the mnemonics of
+	// the accounts that don't set one. Without a seed, they are random.
+	Seed string `json:"seed"`
+	// GenesisTime defaults to the current time.
+	GenesisTime *time.Time `json:"genesis_time"`
+
+	Accounts []TestnetAccount `json:"accounts"`
+	// WNIBI predeploys the WNIBI contract at its mainnet address, which is
+	// the default canonical WNIBI of the EVM params.
+	WNIBI     bool              `json:"wnibi"`
+	Contracts []TestnetContract `json:"contracts"`
+	FunTokens []TestnetFunToken `json:"fun_tokens"`
+	WasmCodes []TestnetWasmCode `json:"wasm_codes"`
+	Sudo      *TestnetSudo      `json:"sudo"`
+	Oracle    *TestnetOracle    `json:"oracle"`
+	// JSONRPC enables the JSON-RPC server of every node when set.
+	JSONRPC *TestnetJSONRPC `json:"json_rpc"`
+
+	// dir is the directory of the spec file, which relative paths in the
+	// spec are resolved against.
+	dir string
+}
+
+// TestnetAccount is a genesis account of a [TestnetSpec]. Its key is added to
+// the keyring of every node.
+type TestnetAccount struct {
+	Name string `json:"name"`
+	// Mnemonic defaults to one derived from the seed of the spec.
+	Mnemonic string `json:"mnemonic"`
+	// Algo is "eth_secp256k1" (default) or "secp256k1".
+	Algo string `json:"algo"`
+	// HDPath defaults to the Ethereum path "m/44'/60'/0'/0/0" for
+	// "eth_secp256k1" keys, so that the mnemonic gives the same address in
+	// wallets like MetaMask, and to the Cosmos path otherwise.
+	HDPath string `json:"hd_path"`
+	// Coins are the genesis balances, like "1000000000unibi,1000uusdc".
+	Coins string `json:"coins"`
+}
+
+// TestnetContract is an EVM contract predeployed by running its constructor
+// offline, as if "Deployer" had sent the deploy tx before the first block.
+// The contract gets the address the deploy tx would have given it, and its
+// code and storage go into the EVM genesis state.
+type TestnetContract struct {
+	Name string `json:"name"`
+	// Artifact is a Hardhat or Foundry artifact, as for "nibid tx evm deploy".
+	Artifact string `json:"artifact"`
+	// Bytecode is the hex creation bytecode of a contract without artifact.
+	Bytecode string `json:"bytecode"`
+	// Args are the constructor args, in the format of "nibid tx evm deploy".
+	// They require an artifact.
+	Args []string `json:"args"`
+	// Deployer is the name of the account that deploys the contract. It
+	// defaults to the first account.
+	Deployer string `json:"deployer"`
+}
+
+// TestnetFunToken is a FunToken mapping of a [TestnetSpec], created from
+// either a bank coin or an ERC20 like "MsgCreateFunToken" does.
+type TestnetFunToken struct {
+	// BankDenom creates the mapping from a bank coin. Its ERC20 is deployed
+	// by the EVM module with the name, symbol and decimals of "Metadata".
+	BankDenom string `json:"bank_denom"`
+	// Metadata is the bank metadata of "BankDenom".
+	Metadata *banktypes.Metadata `json:"metadata"`
+	// DecimalsScale gives the ERC20 of a bank coin that many more decimals.
+	DecimalsScale uint32 `json:"decimals_scale"`
+	// ERC20 creates the mapping from an ERC20, given by the name of a contract
+	// of the spec or by address.
+	ERC20             string `json:"erc20"`
+	AllowZeroDecimals bool   `json:"allow_zero_decimals"`
+}
+
+// TestnetWasmCode is a Wasm code stored in genesis. Codes get the IDs 1, 2,
+// ... in the order of the spec, and anyone may instantiate them.
+type TestnetWasmCode struct {
+	File string `json:"file"`
+	// Creator is an account or node name, or a Bech32 address. It defaults to
+	// the first validator.
+	Creator string `json:"creator"`
+}
+
+// TestnetSudo sets the sudoers. Addresses may be given as account or node
+// names.
+type TestnetSudo struct {
+	Root      string   `json:"root"`
+	Contracts []string `json:"contracts"`
+}
+
+// TestnetOracle whitelists oracle pairs and sets their initial prices.
+type TestnetOracle struct {
+	// Pairs are whitelisted in addition to the default whitelist and to the
+	// pairs with a price.
+	Pairs []string `json:"pairs"`
+	// Prices maps pairs like "ubtc:uusd" to their exchange rate.
+	Prices map[string]string `json:"prices"`
+}
+
+// TestnetJSONRPC configures the JSON-RPC server of every node.
+type TestnetJSONRPC struct {
+	// API defaults to the default namespaces of the JSON-RPC server.
+	API []string `json:"api"`
+	// Host defaults to "0.0.0.0".
+	Host string `json:"host"`
+	// Port and WsPort default to 8545 and 8546.
+	Port   int `json:"port"`
+	WsPort int `json:"ws_port"`
+	// PortStep is added to both ports for each node after the first, so that
+	// nodes running on the same host don't collide.
+	PortStep      int  `json:"port_step"`
+	EnableIndexer bool `json:"enable_indexer"`
+}
+
+// LoadTestnetSpec reads a [TestnetSpec] from a JSON file.
+func LoadTestnetSpec(path string) (*TestnetSpec, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	spec := new(TestnetSpec)
+	if err := decoder.Decode(spec); err != nil {
+		return nil, fmt.Errorf("failed to parse testnet spec %s: %w", path, err)
+	}
+	spec.dir = filepath.Dir(path)
+	return spec, nil
+}
+
+func (s *TestnetSpec) path(p string) string {
+	if filepath.IsAbs(p) {
+		return p
+	}
+	return filepath.Join(s.dir, p)
+}
+
+// mnemonic returns the mnemonic of the key "name" derived from the seed, or a
+// random one without a seed.
+func (s *TestnetSpec) mnemonic(name string) (string, error) {
+	if s.Seed == "" {
+		entropy, err := bip39.NewEntropy(256)
+		if err != nil {
+			return "", err
+		}
+		return bip39.NewMnemonic(entropy)
+	}
+	entropy := sha256.Sum256([]byte(s.Seed + "/" + name))
+	return bip39.NewMnemonic(entropy[:])
+}
+
+// nodeKey returns the node key of the node "name" derived from the seed, or
+// nil without a seed.
+func (s *TestnetSpec) nodeKey(name string) ed25519.PrivKey {
+	if s.Seed == "" {
+		return nil
+	}
+	return ed25519.GenPrivKeyFromSecret([]byte(s.Seed + "/node/" + name))
+}
+
+// jsonRPCAddrs returns the JSON-RPC HTTP and WebSocket addresses of the node
+// with index "i".
+func (c TestnetJSONRPC) jsonRPCAddrs(i int) (addr, wsAddr string) {
+	host, port, wsPort := c.Host, c.Port, c.WsPort
+	if host == "" {
+		host = "0.0.0.0"
+	}
+	if port == 0 {
+		port = 8545
+	}
+	if wsPort == 0 {
+		wsPort = 8546
+	}
+	return fmt.Sprintf("%s:%d", host, port+i*c.PortStep),
+		fmt.Sprintf("%s:%d", host, wsPort+i*c.PortStep)
+}
+
+// testnetKey is the key of a [TestnetAccount].
+type testnetKey struct {
+	TestnetAccount
+	algo keyring.SignatureAlgo
+	addr sdk.AccAddress
+}
+
+// accountKeys derives the keys of the accounts of the spec.
+func (s *TestnetSpec) accountKeys() (keys []testnetKey, err error) {
+	algos := keyring.SigningAlgoList{hd.Secp256k1, ethhd.EthSecp256k1}
+	names := make(map[string]bool, len(s.Accounts))
+	for _, acc := range s.Accounts {
+		if acc.Name == "" {
+			return nil, fmt.Errorf("testnet spec account has no name")
+		}
+		if names[acc.Name] {
+			return nil, fmt.Errorf("duplicate testnet spec account %q", acc.Name)
+		}
+		names[acc.Name] = true
+
+		if acc.Algo == "" {
+			acc.Algo = string(ethhd.EthSecp256k1Type)
+		}
+		algo, err := keyring.NewSigningAlgoFromString(acc.Algo, algos)
+		if err != nil {
+			return nil, fmt.Errorf("account %q: %w", acc.Name, err)
+		}
+		if acc.HDPath == "" {
+			acc.HDPath = sdk.GetConfig().GetFullBIP44Path()
+			if algo.Name() == ethhd.EthSecp256k1Type {
+				acc.HDPath = eth.BIP44HDPath
+			}
+		}
+		if acc.Mnemonic == "" {
+			if acc.Mnemonic, err = s.mnemonic("account/" + acc.Name); err != nil {
+				return nil, err
+			}
+		}
+		derivedPriv, err := algo.Derive()(acc.Mnemonic, keyring.DefaultBIP39Passphrase, acc.HDPath)
+		if err != nil {
+			return nil, fmt.Errorf("account %q: %w", acc.Name, err)
+		}
+		keys = append(keys, testnetKey{
+			TestnetAccount: acc,
+			algo:           algo,
+			addr:           sdk.AccAddress(algo.Generate()(derivedPriv).PubKey().Address()),
+		})
+	}
+	return keys, nil
+}
+
+// testnetSpecGenesis is the genesis state that a [TestnetSpec] adds to the
+// one of the validators.
+type testnetSpecGenesis struct {
+	accounts      []authtypes.GenesisAccount
+	balances      []banktypes.Balance
+	denomMetadata []banktypes.Metadata
+	evmAccounts   []evm.GenesisAccount
+	funTokens     []evm.FunToken
+	wasmCodes     []wasmtypes.Code
+	sudoers       *sudotypes.Sudoers
+	oraclePairs   []asset.Pair
+	prices        oracletypes.ExchangeRateTuples
+
+	info testnetInfo
+}
+
+// testnetInfo is written to "testnet.json" in the output directory so that
+// devs and scripts can find the keys and addresses of the localnet.
+type testnetInfo struct {
+	ChainID    string                `json:"chain_id"`
+	EthChainID string                `json:"eth_chain_id"`
+	Nodes      []testnetNodeInfo     `json:"nodes"`
+	Accounts   []testnetAccountInfo  `json:"accounts"`
+	Contracts  []testnetAddrInfo     `json:"contracts"`
+	FunTokens  []testnetFunTokenInfo `json:"fun_tokens"`
+	WasmCodes  []testnetWasmCodeInfo `json:"wasm_codes"`
+}
+
+type testnetNodeInfo struct {
+	Moniker   string `json:"moniker"`
+	NodeID    string `json:"node_id"`
+	Address   string `json:"address"`
+	JSONRPC   string `json:"json_rpc,omitempty"`
+	JSONRPCWs string `json:"json_rpc_ws,omitempty"`
+}
+
+type testnetAccountInfo struct {
+	Name       string `json:"name"`
+	Algo       string `json:"algo"`
+	Mnemonic   string `json:"mnemonic"`
+	Address    string `json:"address"`
+	EthAddress string `json:"eth_address"`
+}
+
+type testnetAddrInfo struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+type testnetFunTokenInfo struct {
+	BankDenom string `json:"bank_denom"`
+	ERC20     string `json:"erc20"`
+}
+
+type testnetWasmCodeInfo struct {
+	CodeID uint64 `json:"code_id"`
+	File   string `json:"file"`
+}
+
+// buildGenesis computes the genesis state of the spec. "nodeNames" and
+// "valAddrs" are the names of the nodes and the accounts of their validators,
+// and "nextAccNum" is the account number of the first account the spec adds.
+func (s *TestnetSpec) buildGenesis(
+	chainID string, genTime time.Time, keys []testnetKey,
+	nodeNames []string, valAddrs []sdk.AccAddress, nextAccNum uint64,
+) (*testnetSpecGenesis, error) {
+	ethChainID := appconst.GetEthChainID(chainID)
+	gen := &testnetSpecGenesis{
+		info: testnetInfo{ChainID: chainID, EthChainID: ethChainID.String()},
+	}
+
+	addrsByName := make(map[string]sdk.AccAddress, len(keys)+len(valAddrs))
+	for i, name := range nodeNames {
+		addrsByName[name] = valAddrs[i]
+	}
+	for _, key := range keys {
+		addrsByName[key.Name] = key.addr
+	}
+	resolveAddr := func(nameOrAddr string) (sdk.AccAddress, error) {
+		if addr, ok := addrsByName[nameOrAddr]; ok {
+			return addr, nil
+		}
+		return sdk.AccAddressFromBech32(nameOrAddr)
+	}
+
+	offline := newOfflineEVM(ethChainID, genTime)
+
+	// EVM contracts
+	contractAddrs := make(map[string]gethcommon.Address, len(s.Contracts))
+	for _, contract := range s.Contracts {
+		addr, err := s.deployContract(offline, contract, keys)
+		if err != nil {
+			return nil, fmt.Errorf("contract %q: %w", contract.Name, err)
+		}
+		if contract.Name != "" {
+			contractAddrs[contract.Name] = addr
+		}
+		gen.info.Contracts = append(gen.info.Contracts, testnetAddrInfo{
+			Name: contract.Name, Address: addr.Hex(),
+		})
+	}
+
+	// FunToken mappings
+	bankDenoms := make(map[string]bool, len(s.FunTokens))
+	for i, spec := range s.FunTokens {
+		funToken, bankMetadata, err := createTestnetFunToken(offline, spec, contractAddrs)
+		if err != nil {
+			return nil, fmt.Errorf("fun token %d: %w", i, err)
+		}
+		if bankDenoms[funToken.BankDenom] {
+			return nil, fmt.Errorf("duplicate FunToken mapping for bank denom %q", funToken.BankDenom)
+		}
+		bankDenoms[funToken.BankDenom] = true
+		gen.funTokens = append(gen.funTokens, funToken)
+		gen.denomMetadata = append(gen.denomMetadata, bankMetadata)
+		gen.info.FunTokens = append(gen.info.FunTokens, testnetFunTokenInfo{
+			BankDenom: funToken.BankDenom, ERC20: funToken.Erc20Addr.Hex(),
+		})
+	}
+
+	// Auth accounts and balances. The sequence of each account is its nonce
+	// after the offline deploys.
+	newBaseAccount := func(addr sdk.AccAddress) *authtypes.BaseAccount {
+		acc := authtypes.NewBaseAccount(
+			addr, nil, nextAccNum, offline.state.GetNonce(eth.NibiruAddrToEthAddr(addr)),
+		)
+		nextAccNum++
+		return acc
+	}
+	for _, key := range keys {
+		coins, err := sdk.ParseCoinsNormalized(key.Coins)
+		if err != nil {
+			return nil, fmt.Errorf("account %q: invalid coins: %w", key.Name, err)
+		}
+		gen.accounts = append(gen.accounts, newBaseAccount(key.addr))
+		if !coins.IsZero() {
+			gen.balances = append(gen.balances, banktypes.Balance{
+				Address: key.addr.String(), Coins: coins,
+			})
+		}
+		gen.info.Accounts = append(gen.info.Accounts, testnetAccountInfo{
+			Name:       key.Name,
+			Algo:       string(key.algo.Name()),
+			Mnemonic:   key.Mnemonic,
+			Address:    key.addr.String(),
+			EthAddress: eth.NibiruAddrToEthAddr(key.addr).Hex(),
+		})
+	}
+	if offline.state.GetNonce(evm.EVM_MODULE_ADDRESS) > 0 {
+		evmModuleAcc := authtypes.NewModuleAccount(
+			newBaseAccount(evm.EVM_MODULE_ADDRESS_NIBI),
+			evm.ModuleName, authtypes.Minter, authtypes.Burner,
+		)
+		gen.accounts = append(gen.accounts, evmModuleAcc)
+	}
+	for _, genAcc := range offline.genesisAccounts() {
+		addr := gethcommon.HexToAddress(genAcc.Address)
+		gen.accounts = append(gen.accounts, &eth.EthAccount{
+			BaseAccount: newBaseAccount(eth.EthAddrToNibiruAddr(addr)),
+			CodeHash:    offline.state.GetCodeHash(addr).Hex(),
+		})
+		gen.evmAccounts = append(gen.evmAccounts, genAcc)
+	}
+	if s.WNIBI {
+		wnibiAcc := v2_7_0.WNIBI_GENESIS_AUTH_ACC()
+		wnibiAcc.AccountNumber = nextAccNum
+		nextAccNum++
+		gen.accounts = append(gen.accounts, &wnibiAcc)
+		gen.evmAccounts = append(gen.evmAccounts, v2_7_0.WNIBI_GENESIS_EVM_ACC())
+		gen.info.Contracts = append(gen.info.Contracts, testnetAddrInfo{
+			Name: "WNIBI", Address: appconst.MAINNET_WNIBI_ADDR.Hex(),
+		})
+	}
+
+	// Wasm codes
+	for i, code := range s.WasmCodes {
+		wasmCode, err := os.ReadFile(s.path(code.File))
+		if err != nil {
+			return nil, fmt.Errorf("wasm code %d: %w", i, err)
+		}
+		creator := valAddrs[0]
+		if code.Creator != "" {
+			if creator, err = resolveAddr(code.Creator); err != nil {
+				return nil, fmt.Errorf("wasm code %d: invalid creator: %w", i, err)
+			}
+		}
+		checksum := sha256.Sum256(wasmCode)
+		codeID := uint64(i + 1)
+		gen.wasmCodes = append(gen.wasmCodes, wasmtypes.Code{
+			CodeID:    codeID,
+			CodeInfo:  wasmtypes.NewCodeInfo(checksum[:], creator, wasmtypes.AllowEverybody),
+			CodeBytes: wasmCode,
+		})
+		gen.info.WasmCodes = append(gen.info.WasmCodes, testnetWasmCodeInfo{
+			CodeID: codeID, File: code.File,
+		})
+	}
+
+	// Sudoers
+	if s.Sudo != nil {
+		root, err := resolveAddr(s.Sudo.Root)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sudo root: %w", err)
+		}
+		gen.sudoers = &sudotypes.Sudoers{Root: root.String()}
+		for _, contract := range s.Sudo.Contracts {
+			addr, err := resolveAddr(contract)
+			if err != nil {
+				return nil, fmt.Errorf("invalid sudo contract: %w", err)
+			}
+			gen.sudoers.Contracts = append(gen.sudoers.Contracts, addr.String())
+		}
+	}
+
+	// Oracle pairs and prices, sorted so that the genesis is reproducible
+	if s.Oracle != nil {
+		for _, pairStr := range s.Oracle.Pairs {
+			pair, err := asset.TryNewPair(pairStr)
+			if err != nil {
+				return nil, err
+			}
+			gen.oraclePairs = append(gen.oraclePairs, pair)
+		}
+		pricePairs := make([]string, 0, len(s.Oracle.Prices))
+		for pairStr := range s.Oracle.Prices {
+			pricePairs = append(pricePairs, pairStr)
+		}
+		sort.Strings(pricePairs)
+		for _, pairStr := range pricePairs {
+			pair, err := asset.TryNewPair(pairStr)
+			if err != nil {
+				return nil, err
+			}
+			price, err := sdkmath.LegacyNewDecFromStr(s.Oracle.Prices[pairStr])
+			if err != nil {
+				return nil, fmt.Errorf("invalid price of %s: %w", pairStr, err)
+			}
+			gen.oraclePairs = append(gen.oraclePairs, pair)
+			gen.prices = append(gen.prices, oracletypes.ExchangeRateTuple{
+				Pair: pair, ExchangeRate: price,
+			})
+		}
+	}
+
+	return gen, nil
+}
+
+// deployContract runs the constructor of "contract" offline and returns the
+// address of the contract.
+func (s *TestnetSpec) deployContract(
+	offline *offlineEVM, contract TestnetContract, keys []testnetKey,
+) (gethcommon.Address, error) {
+	if len(keys) == 0 {
+		return gethcommon.Address{}, fmt.Errorf("contracts need an account to deploy them")
+	}
+	deployer := keys[0]
+	if contract.Deployer != "" {
+		found := false
+		for _, key := range keys {
+			if key.Name == contract.Deployer {
+				deployer, found = key, true
+				break
+			}
+		}
+		if !found {
+			return gethcommon.Address{}, fmt.Errorf("deployer %q is not an account of the spec", contract.Deployer)
+		}
+	}
+
+	var input []byte
+	switch {
+	case contract.Artifact != "":
+		artifact, err := evmcli.LoadContractArtifact(s.path(contract.Artifact))
+		if err != nil {
+			return gethcommon.Address{}, err
+		}
+		if len(artifact.Bytecode) == 0 {
+			return gethcommon.Address{}, fmt.Errorf("artifact %s has no bytecode", contract.Artifact)
+		}
+		args, err := evmcli.ParseABIArgs(artifact.ABI.Constructor.Inputs, contract.Args)
+		if err != nil {
+			return gethcommon.Address{}, fmt.Errorf("invalid constructor args: %w", err)
+		}
+		packedArgs, err := artifact.ABI.Pack("", args...)
+		if err != nil {
+			return gethcommon.Address{}, fmt.Errorf("failed to pack constructor args: %w", err)
+		}
+		input = append(artifact.Bytecode, packedArgs...)
+	case contract.Bytecode != "":
+		if len(contract.Args) > 0 {
+			return gethcommon.Address{}, fmt.Errorf("constructor args require an artifact")
+		}
+		input = gethcommon.FromHex(contract.Bytecode)
+	default:
+		return gethcommon.Address{}, fmt.Errorf("contract needs an artifact or a bytecode")
+	}
+	return offline.deploy(eth.NibiruAddrToEthAddr(deployer.addr), input)
+}
+
+// createTestnetFunToken creates the FunToken mapping "spec" offline like the
+// EVM keeper does and returns it along with the bank metadata of its coin.
+func createTestnetFunToken(
+	offline *offlineEVM, spec TestnetFunToken, contractAddrs map[string]gethcommon.Address,
+) (funToken evm.FunToken, bankMetadata banktypes.Metadata, err error) {
+	switch {
+	case spec.BankDenom != "" && spec.ERC20 != "":
+		return funToken, bankMetadata, fmt.Errorf(`set either "bank_denom" or "erc20", not both`)
+
+	case spec.BankDenom != "":
+		if spec.Metadata == nil || spec.Metadata.Base != spec.BankDenom {
+			return funToken, bankMetadata, fmt.Errorf(
+				"bank coin %q needs its bank metadata with the same base denom", spec.BankDenom)
+		}
+		bankMetadata = *spec.Metadata
+		erc20Info, err := evm.ValidateFunTokenBankMetadata(bankMetadata, spec.AllowZeroDecimals)
+		if err != nil {
+			return funToken, bankMetadata, err
+		}
+		if erc20Decimals := uint32(erc20Info.Decimals) + spec.DecimalsScale; erc20Decimals > evm.MaxErc20Decimals {
+			return funToken, bankMetadata, fmt.Errorf(
+				"decimals scale %d gives the ERC20 %d decimals, which exceeds the maximum of %d",
+				spec.DecimalsScale, erc20Decimals, evm.MaxErc20Decimals,
+			)
+		}
+		erc20Info.Decimals += uint8(spec.DecimalsScale)
+
+		packedArgs, err := embeds.SmartContract_ERC20MinterWithPermit.ABI.Pack(
+			"", erc20Info.Name, erc20Info.Symbol, erc20Info.Decimals,
+		)
+		if err != nil {
+			return funToken, bankMetadata, err
+		}
+		erc20Addr, err := offline.deploy(
+			evm.EVM_MODULE_ADDRESS,
+			append(embeds.SmartContract_ERC20MinterWithPermit.Bytecode, packedArgs...),
+		)
+		if err != nil {
+			return funToken, bankMetadata, err
+		}
+		return evm.FunToken{
+			Erc20Addr:      eth.EIP55Addr{Address: erc20Addr},
+			BankDenom:      spec.BankDenom,
+			IsMadeFromCoin: true,
+			DecimalsScale:  spec.DecimalsScale,
+		}, bankMetadata, nil
+
+	case spec.ERC20 != "":
+		erc20Addr, ok := contractAddrs[spec.ERC20]
+		if !ok {
+			if !gethcommon.IsHexAddress(spec.ERC20) {
+				return funToken, bankMetadata, fmt.Errorf(
+					"ERC20 %q is neither a contract of the spec nor a hex address", spec.ERC20)
+			}
+			erc20Addr = gethcommon.HexToAddress(spec.ERC20)
+		}
+		var erc20Info evm.ERC20Metadata
+		erc20ABI := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI
+		for method, out := range map[string]any{
+			"name":     &erc20Info.Name,
+			"symbol":   &erc20Info.Symbol,
+			"decimals": &erc20Info.Decimals,
+		} {
+			input, err := erc20ABI.Pack(method)
+			if err != nil {
+				return funToken, bankMetadata, err
+			}
+			ret, err := offline.call(evm.EVM_MODULE_ADDRESS, erc20Addr, input)
+			if err != nil {
+				return funToken, bankMetadata, fmt.Errorf("failed to call ERC20.%s: %w", method, err)
+			}
+			if err := erc20ABI.UnpackIntoInterface(out, method, ret); err != nil {
+				return funToken, bankMetadata, fmt.Errorf("failed to unpack ERC20.%s: %w", method, err)
+			}
+		}
+		bankMetadata = erc20BankMetadata(erc20Addr, erc20Info)
+		if _, err := evm.ValidateFunTokenBankMetadata(bankMetadata, spec.AllowZeroDecimals); err != nil {
+			return funToken, bankMetadata, err
+		}
+		return evm.FunToken{
+			Erc20Addr: eth.EIP55Addr{Address: erc20Addr},
+			BankDenom: bankMetadata.Base,
+		}, bankMetadata, nil
+
+	default:
+		return funToken, bankMetadata, fmt.Errorf(`set either "bank_denom" or "erc20"`)
+	}
+}
+
+// erc20BankMetadata returns the metadata of the Bank Coin "erc20/{addr}" that
+// represents the ERC20 in a FunToken mapping created from it. It matches the
+// metadata set by "MsgCreateFunToken" for an ERC20.
+func erc20BankMetadata(erc20 gethcommon.Address, info evm.ERC20Metadata) banktypes.Metadata {
+	bankDenom := fmt.Sprintf("erc20/%s", erc20.String())
+	displayDenom := bankDenom
+	denomUnits := []*banktypes.DenomUnit{
+		{
+			Denom:    bankDenom,
+			Exponent: 0,
+		},
+	}
+	if info.Decimals > 0 {
+		// Bank denom "erc20/{addr}" is ~48 chars. Adding 19 more keeps the
+		// length far under the limit of 127 from "sdk.ValidateDenom()".
+		displayDenom = fmt.Sprintf("decimals_denom_for-%s", bankDenom)
+		denomUnits = append(denomUnits, &banktypes.DenomUnit{
+			Denom:    displayDenom,
+			Exponent: uint32(info.Decimals),
+		})
+	}
+	return banktypes.Metadata{
+		Description: fmt.Sprintf(
+			`ERC20 token "%s" represented as a Bank Coin with a corresponding FunToken mapping`, erc20.Hex(),
+		),
+		DenomUnits: denomUnits,
+		Base:       bankDenom,
+		Display:    displayDenom,
+		Name:       info.Name,
+		Symbol:     info.Symbol,
+	}
+}
+
+// apply adds the genesis state of the spec to "appGenState". The accounts and
+// balances are set along with the ones of the validators.
+func (g *testnetSpecGenesis) apply(cdc codec.Codec, appGenState map[string]json.RawMessage) {
+	var bankGenState banktypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[banktypes.ModuleName], &bankGenState)
+	bankGenState.DenomMetadata = append(bankGenState.DenomMetadata, g.denomMetadata...)
+	appGenState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenState)
+
+	var evmGenState evm.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[evm.ModuleName], &evmGenState)
+	evmGenState.Accounts = append(evmGenState.Accounts, g.evmAccounts...)
+	evmGenState.FuntokenMappings = append(evmGenState.FuntokenMappings, g.funTokens...)
+	appGenState[evm.ModuleName] = cdc.MustMarshalJSON(&evmGenState)
+
+	if len(g.wasmCodes) > 0 {
+		var wasmGenState wasmtypes.GenesisState
+		cdc.MustUnmarshalJSON(appGenState[wasmtypes.ModuleName], &wasmGenState)
+		wasmGenState.Codes = append(wasmGenState.Codes, g.wasmCodes...)
+		wasmGenState.Sequences = append(wasmGenState.Sequences, wasmtypes.Sequence{
+			IDKey: wasmtypes.KeySequenceCodeID, Value: uint64(len(g.wasmCodes) + 1),
+		})
+		appGenState[wasmtypes.ModuleName] = cdc.MustMarshalJSON(&wasmGenState)
+	}
+
+	if g.sudoers != nil {
+		var sudoGenState sudotypes.GenesisState
+		cdc.MustUnmarshalJSON(appGenState[sudotypes.ModuleName], &sudoGenState)
+		sudoGenState.Sudoers = *g.sudoers
+		appGenState[sudotypes.ModuleName] = cdc.MustMarshalJSON(&sudoGenState)
+	}
+
+	if len(g.oraclePairs) > 0 {
+		var oracleGenState oracletypes.GenesisState
+		cdc.MustUnmarshalJSON(appGenState[oracletypes.ModuleName], &oracleGenState)
+		whitelisted := make(map[asset.Pair]bool, len(oracleGenState.Params.Whitelist))
+		for _, pair := range oracleGenState.Params.Whitelist {
+			whitelisted[pair] = true
+		}
+		for _, pair := range g.oraclePairs {
+			if !whitelisted[pair] {
+				whitelisted[pair] = true
+				oracleGenState.Params.Whitelist = append(oracleGenState.Params.Whitelist, pair)
+			}
+		}
+		oracleGenState.ExchangeRates = append(oracleGenState.ExchangeRates, g.prices...)
+		appGenState[oracletypes.ModuleName] = cdc.MustMarshalJSON(&oracleGenState)
+	}
+}
+
+// offlineEVMGasLimit is the gas limit of each offline deploy or call.
+const offlineEVMGasLimit = 100_000_000
+
+// offlineEVM runs EVM txs on an in-memory state before the chain starts, to
+// compute the genesis code and storage of predeployed contracts.
+type offlineEVM struct {
+	evm   *vm.EVM
+	state *state.StateDB
+	rules params.Rules
+	// created holds the addresses whose code was set, in order.
+	created []gethcommon.Address
+	// written holds the storage slots written for each address.
+	written map[gethcommon.Address]map[gethcommon.Hash]bool
+}
+
+func newOfflineEVM(ethChainID *big.Int, genTime time.Time) *offlineEVM {
+	// Preimages aren't needed since the written slots are tracked.
+	stateDB, err := state.New(
+		gethcore.EmptyRootHash,
+		state.NewDatabase(triedb.NewDatabase(rawdb.NewMemoryDatabase(), nil), nil),
+	)
+	if err != nil {
+		// The empty root always exists in a new database.
+		panic(err)
+	}
+
+	o := &offlineEVM{
+		state:   stateDB,
+		written: make(map[gethcommon.Address]map[gethcommon.Hash]bool),
+	}
+	hooks := &tracing.Hooks{
+		// Constructors write storage before the code is set, so code changes
+		// are tracked apart from the written slots.
+		OnCodeChange: func(addr gethcommon.Address, _ gethcommon.Hash, _ []byte, _ gethcommon.Hash, _ []byte) {
+			if !slices.Contains(o.created, addr) {
+				o.created = append(o.created, addr)
+			}
+		},
+		OnStorageChange: func(addr gethcommon.Address, slot, _, _ gethcommon.Hash) {
+			if o.written[addr] == nil {
+				o.written[addr] = make(map[gethcommon.Hash]bool)
+			}
+			o.written[addr][slot] = true
+		},
+	}
+
+	chainConfig := evm.EthereumConfig(ethChainID)
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash:     func(uint64) gethcommon.Hash { return gethcommon.Hash{} },
+		BlockNumber: big.NewInt(1),
+		Time:        uint64(genTime.Unix()),
+		Difficulty:  big.NewInt(0),
+		GasLimit:    offlineEVMGasLimit,
+		BaseFee:     big.NewInt(0),
+		Random:      &gethcommon.Hash{},
+	}
+	o.evm = vm.NewEVM(
+		blockCtx, vm.TxContext{GasPrice: big.NewInt(0)},
+		state.NewHookedState(stateDB, hooks), chainConfig, vm.Config{},
+	)
+	o.rules = chainConfig.Rules(blockCtx.BlockNumber, true, blockCtx.Time)
+	return o
+}
+
+// deploy runs the creation bytecode "input" from "from" and returns the
+// address of the new contract.
+func (o *offlineEVM) deploy(from gethcommon.Address, input []byte) (gethcommon.Address, error) {
+	o.prepare(from, nil)
+	ret, addr, _, err := o.evm.Create(vm.AccountRef(from), input, offlineEVMGasLimit, uint256.NewInt(0))
+	if err != nil {
+		return addr, fmt.Errorf("deploy failed: %w%s", err, revertReason(err, ret))
+	}
+	o.state.Finalise(true)
+	return addr, nil
+}
+
+// call calls the contract at "to" without changing the state.
+func (o *offlineEVM) call(from, to gethcommon.Address, input []byte) ([]byte, error) {
+	o.prepare(from, &to)
+	snapshot := o.state.Snapshot()
+	defer o.state.RevertToSnapshot(snapshot)
+	ret, _, err := o.evm.Call(vm.AccountRef(from), to, input, offlineEVMGasLimit, uint256.NewInt(0))
+	if err != nil {
+		return nil, fmt.Errorf("%w%s", err, revertReason(err, ret))
+	}
+	return ret, nil
+}
+
+func (o *offlineEVM) prepare(from gethcommon.Address, to *gethcommon.Address) {
+	o.evm.TxContext.Origin = from
+	o.state.Prepare(o.rules, from, gethcommon.Address{}, to, vm.ActivePrecompiles(o.rules), nil)
+}
+
+// genesisAccounts returns the EVM genesis accounts of the contracts created
+// offline, in the order they were created.
+func (o *offlineEVM) genesisAccounts() (genAccs []evm.GenesisAccount) {
+	for _, addr := range o.created {
+		code := o.state.GetCode(addr)
+		if len(code) == 0 {
+			continue
+		}
+		slots := make([]gethcommon.Hash, 0, len(o.written[addr]))
+		for slot := range o.written[addr] {
+			slots = append(slots, slot)
+		}
+		sort.Slice(slots, func(i, j int) bool {
+			return bytes.Compare(slots[i].Bytes(), slots[j].Bytes()) < 0
+		})
+		var storage evm.Storage
+		for _, slot := range slots {
+			if value := o.state.GetState(addr, slot); value != (gethcommon.Hash{}) {
+				storage = append(storage, evm.NewStateFromEthHashes(slot, value))
+			}
+		}
+		genAccs = append(genAccs, evm.GenesisAccount{
+			Address: addr.Hex(),
+			Code:    eth.BytesToHex(code),
+			Storage: storage,
+		})
+	}
+	return genAccs
+}
+
+// revertReason formats the revert reason in "ret", if any.
+func revertReason(err error, ret []byte) string {
+	if err != vm.ErrExecutionReverted {
+		return ""
+	}
+	if reason, unpackErr := gethabi.UnpackRevert(ret); unpackErr == nil {
+		return ": " + reason
+	}
+	return ""
+}
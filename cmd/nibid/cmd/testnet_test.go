@@ -2,23 +2,43 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/NibiruChain/nibiru/v2/app"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
 
+	tmdb "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 )
 
-func Test_TestnetCmd(t *testing.T) {
+// execTestnetCmd runs "nibid testnet" with "args" and returns the path of
+// the genesis file, which is the same for every node.
+func execTestnetCmd(t *testing.T, outputDir string, args ...string) (genFile string) {
 	home := t.TempDir()
 	encodingConfig := app.MakeEncodingConfig()
 	logger := log.NewNopLogger()
@@ -38,17 +58,184 @@ func Test_TestnetCmd(t *testing.T) {
 	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
 	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
 	cmd := testnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{})
-	cmd.SetArgs([]string{
+	cmd.SetArgs(append([]string{
 		fmt.Sprintf("--%s=test", flags.FlagKeyringBackend),
-		fmt.Sprintf("--output-dir=%s", home),
-	})
+		fmt.Sprintf("--output-dir=%s", outputDir),
+	}, args...))
 	err = cmd.ExecuteContext(ctx)
 	require.NoError(t, err)
 
-	genFile := cfg.GenesisFile()
+	// The command leaves the node config rooted at the last node.
+	return cfg.GenesisFile()
+}
+
+func Test_TestnetCmd(t *testing.T) {
+	encodingConfig := app.MakeEncodingConfig()
+	outputDir := t.TempDir()
+	genFile := execTestnetCmd(t, outputDir)
+
 	appState, _, err := genutiltypes.GenesisStateFromGenFile(genFile)
 	require.NoError(t, err)
 
 	bankGenState := banktypes.GetGenesisStateFromAppState(encodingConfig.Codec, appState)
 	require.NotEmpty(t, bankGenState.Supply.String())
+
+	// Only a spec adds the EVM and JSON-RPC sections to app.toml.
+	appToml, err := os.ReadFile(filepath.Join(outputDir, "node0", "nibid", "config", "app.toml"))
+	require.NoError(t, err)
+	require.NotContains(t, string(appToml), "[json-rpc]")
+}
+
+func Test_TestnetCmdSpec(t *testing.T) {
+	artifact, err := filepath.Abs("../../../x/evm/embeds/artifacts/contracts/TestERC20.sol/TestERC20.json")
+	require.NoError(t, err)
+	wasmCode, err := filepath.Abs("../../../x/evm/precompile/test/counter.wasm")
+	require.NoError(t, err)
+
+	specPath := filepath.Join(t.TempDir(), "spec.json")
+	require.NoError(t, os.WriteFile(specPath, []byte(`{
+  "chain_id": "nibiru-localnet-0",
+  "validators": 2,
+  "seed": "test",
+  "genesis_time": "2024-01-01T00:00:00Z",
+  "accounts": [
+    {"name": "alice", "coins": "1000000000unibi"},
+    {"name": "bob", "algo": "secp256k1", "coins": "5unibi"}
+  ],
+  "wnibi": true,
+  "contracts": [{"name": "TestERC20", "artifact": "`+artifact+`", "deployer": "alice"}],
+  "fun_tokens": [
+    {
+      "bank_denom": "uusdc",
+      "decimals_scale": 12,
+      "metadata": {
+        "base": "uusdc", "display": "usdc", "name": "USD Coin", "symbol": "USDC",
+        "denom_units": [{"denom": "uusdc", "exponent": 0}, {"denom": "usdc", "exponent": 6}]
+      }
+    },
+    {"erc20": "TestERC20"}
+  ],
+  "wasm_codes": [{"file": "`+wasmCode+`", "creator": "bob"}],
+  "sudo": {"root": "alice", "contracts": ["node0"]},
+  "oracle": {"prices": {"ubtc:uusd": "50000"}},
+  "json_rpc": {"port_step": 10, "enable_indexer": true}
+}`), 0o600))
+
+	outputDir := t.TempDir()
+	genFile := execTestnetCmd(t, outputDir, "--spec="+specPath)
+	genDoc, err := cmttypes.GenesisDocFromFile(genFile)
+	require.NoError(t, err)
+	require.Equal(t, "nibiru-localnet-0", genDoc.ChainID)
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), genDoc.GenesisTime)
+
+	var info testnetInfo
+	infoBz, err := os.ReadFile(filepath.Join(outputDir, "testnet.json"))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(infoBz, &info))
+	require.Len(t, info.Nodes, 2)
+	require.Equal(t, "0.0.0.0:8555", info.Nodes[1].JSONRPC)
+	require.Len(t, info.Accounts, 2)
+	require.Len(t, info.FunTokens, 2)
+	appToml, err := os.ReadFile(filepath.Join(outputDir, "node1", "nibid", "config", "app.toml"))
+	require.NoError(t, err)
+	require.Contains(t, string(appToml), `address = "0.0.0.0:8555"`)
+
+	// The same spec gives the same genesis and keys.
+	outputDir2 := t.TempDir()
+	genFile2 := execTestnetCmd(t, outputDir2, "--spec="+specPath)
+	genDoc2, err := cmttypes.GenesisDocFromFile(genFile2)
+	require.NoError(t, err)
+	require.Equal(t, genDoc.AppState, genDoc2.AppState)
+	infoBz2, err := os.ReadFile(filepath.Join(outputDir2, "testnet.json"))
+	require.NoError(t, err)
+	require.Equal(t, infoBz, infoBz2)
+
+	// The genesis is valid for the app.
+	nibiru := app.NewNibiruApp(
+		log.NewNopLogger(), tmdb.NewMemDB(), nil, true,
+		sims.AppOptionsMap{flags.FlagHome: t.TempDir()},
+		baseapp.SetChainID(genDoc.ChainID),
+	)
+	nibiru.InitChain(abci.RequestInitChain{
+		ChainId:         genDoc.ChainID,
+		Time:            genDoc.GenesisTime,
+		ConsensusParams: sims.DefaultConsensusParams,
+		AppStateBytes:   genDoc.AppState,
+	})
+	ctx := nibiru.NewContext(false, tmproto.Header{
+		ChainID: genDoc.ChainID, Height: 1, Time: genDoc.GenesisTime,
+	})
+
+	evmKeeper := nibiru.EvmKeeper
+	// The EVM module deployed the ERC20 of uusdc at nonce 0.
+	require.EqualValues(t, 1, evmKeeper.GetAccNonce(ctx, evm.EVM_MODULE_ADDRESS))
+	for _, funToken := range info.FunTokens {
+		erc20 := gethcommon.HexToAddress(funToken.ERC20)
+		mappings := evmKeeper.FunTokens.Collect(ctx, evmKeeper.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20))
+		require.Len(t, mappings, 1)
+		require.Equal(t, funToken.BankDenom, mappings[0].BankDenom)
+		require.NotNil(t, evmKeeper.GetAccount(ctx, erc20))
+	}
+	_, found := nibiru.BankKeeper.GetDenomMetaData(ctx, "uusdc")
+	require.True(t, found)
+
+	// alice deployed TestERC20, which minted its supply to her.
+	alice := gethcommon.HexToAddress(info.Accounts[0].EthAddress)
+	require.EqualValues(t, 1, evmKeeper.GetAccNonce(ctx, alice))
+	numSlots := 0
+	evmKeeper.ForEachStorage(ctx, gethcommon.HexToAddress(info.Contracts[0].Address),
+		func(_, _ gethcommon.Hash) bool {
+			numSlots++
+			return true
+		})
+	require.Positive(t, numSlots)
+
+	wnibi := evmKeeper.GetAccount(ctx, gethcommon.HexToAddress(info.Contracts[1].Address))
+	require.NotNil(t, wnibi)
+	require.True(t, wnibi.IsContract())
+
+	require.NotNil(t, nibiru.WasmKeeper.GetCodeInfo(ctx, 1))
+	sudoers, err := nibiru.SudoKeeper.Sudoers.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, info.Accounts[0].Address, sudoers.Root)
+	require.Equal(t, []string{info.Nodes[0].Address}, sudoers.Contracts)
+
+	pair := asset.NewPair("ubtc", "uusd")
+	price, err := nibiru.OracleKeeper.ExchangeRates.Get(ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, "50000.000000000000000000", price.ExchangeRate.String())
+	require.True(t, nibiru.OracleKeeper.WhitelistedPairs.Has(ctx, pair))
+}
+
+// Test_erc20BankMetadata: The bank metadata that a testnet spec gives to a
+// FunToken mapping of an ERC20 matches the one from "MsgCreateFunToken".
+func Test_erc20BankMetadata(t *testing.T) {
+	for _, decimals := range []uint8{18, 0} {
+		deps := evmtest.NewTestDeps()
+		info := evm.ERC20Metadata{Name: "Spec Token", Symbol: "SPEC", Decimals: decimals}
+		deployResp, err := evmtest.DeployContract(
+			&deps, embeds.SmartContract_ERC20MinterWithMetadataUpdates,
+			info.Name, info.Symbol, info.Decimals,
+		)
+		require.NoError(t, err)
+		erc20 := deployResp.ContractAddr
+
+		require.NoError(t, testapp.FundAccount(
+			deps.App.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+			deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx),
+		))
+		createResp, err := deps.EvmKeeper.CreateFunToken(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgCreateFunToken{
+				FromErc20:         &eth.EIP55Addr{Address: erc20},
+				Sender:            deps.Sender.NibiruAddr.String(),
+				AllowZeroDecimals: decimals == 0,
+			},
+		)
+		require.NoError(t, err)
+
+		want, found := deps.App.BankKeeper.GetDenomMetaData(deps.Ctx, createResp.FuntokenMapping.BankDenom)
+		require.True(t, found)
+		require.Equal(t, want, erc20BankMetadata(erc20, info), "decimals %d", decimals)
+	}
 }
@@ -2,7 +2,10 @@
 package eip712
 
 import (
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/tidwall/sjson"
 )
 
 // WrapTxToTypedData wraps an Amino-encoded Cosmos Tx JSON SignDoc
@@ -33,3 +36,31 @@ func WrapTxToTypedData(
 
 	return typedData, nil
 }
+
+// WrapTxToTypedDataWithFeePayer wraps an Amino-encoded Cosmos Tx JSON SignDoc
+// bytestream into an EIP712-compatible TypedData request that also commits to
+// the fee payer of the tx. This is the typed data signed for txs with the
+// [eth.ExtensionOptionsWeb3Tx] option. As in other Web3 txs of Ethermint based
+// chains, the fee payer is the "feePayer" field of the fee and comes first in
+// the "Fee" type.
+func WrapTxToTypedDataWithFeePayer(
+	chainID uint64,
+	data []byte,
+	feePayer sdk.AccAddress,
+) (apitypes.TypedData, error) {
+	data, err := sjson.SetBytes(data, "fee.feePayer", feePayer.String())
+	if err != nil {
+		return apitypes.TypedData{}, sdkioerrors.Wrap(err, "failed to set fee payer")
+	}
+
+	typedData, err := WrapTxToTypedData(chainID, data)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	typedData.Types["Fee"] = append(
+		[]apitypes.Type{{Name: "feePayer", Type: ethString}},
+		typedData.Types["Fee"]...,
+	)
+	return typedData, nil
+}
@@ -75,6 +75,13 @@ func createEIP712Types(messagePayload eip712MessagePayload) (apitypes.Types, err
 		},
 	}
 
+	// The fee payer and fee granter are only in the sign doc when they are set.
+	for _, feeField := range []string{"payer", "granter"} {
+		if messagePayload.payload.Get("fee." + feeField).Exists() {
+			eip712Types["Fee"] = append(eip712Types["Fee"], apitypes.Type{Name: feeField, Type: ethString})
+		}
+	}
+
 	for i := 0; i < messagePayload.numPayloadMsgs; i++ {
 		field := msgFieldForIndex(i)
 		msg := messagePayload.payload.Get(field)
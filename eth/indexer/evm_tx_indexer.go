@@ -3,6 +3,7 @@ package indexer
 
 import (
 	"fmt"
+	"sort"
 
 	sdkioerrors "cosmossdk.io/errors"
 	dbm "github.com/cometbft/cometbft-db"
@@ -13,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
@@ -35,11 +37,21 @@ type EVMTxIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+
+	// sink optionally receives every indexed block, see [EVMIndexerSink].
+	sink EVMIndexerSink
 }
 
 // NewEVMTxIndexer creates the EVMTxIndexer
 func NewEVMTxIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *EVMTxIndexer {
-	return &EVMTxIndexer{db, logger, clientCtx}
+	return &EVMTxIndexer{db: db, logger: logger, clientCtx: clientCtx}
+}
+
+// WithSink sets a sink that receives every block indexed after the call, in
+// addition to the KV db. Passing nil removes the sink.
+func (indexer *EVMTxIndexer) WithSink(sink EVMIndexerSink) *EVMTxIndexer {
+	indexer.sink = sink
+	return indexer
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -48,11 +60,44 @@ func NewEVMTxIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *EV
 // - Iterates over all the messages of the Tx
 // - Builds and stores indexer.TxResult based on parsed events for every message
 func (indexer *EVMTxIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ResponseDeliverTx) error {
-	height := block.Height
-
 	batch := indexer.db.NewBatch()
 	defer batch.Close()
 
+	indexedTxs := indexer.blockTxResults(block, txResults)
+	for _, indexedTx := range indexedTxs {
+		if err := saveTxResult(indexer.clientCtx.Codec, batch, indexedTx.hash, &indexedTx.result); err != nil {
+			return sdkioerrors.Wrapf(err, "IndexBlock %d", block.Height)
+		}
+	}
+	// The sink is written first so that a block the sink fails to store is
+	// not marked as indexed in the KV db, and indexing it again retries the
+	// sink. Sinks overwrite blocks they already hold, so a failed KV write
+	// after a sink write is retried the same way.
+	if indexer.sink != nil {
+		if err := indexer.sink.WriteBlock(indexer.sinkBlock(block, txResults, indexedTxs)); err != nil {
+			return sdkioerrors.Wrapf(err, "IndexBlock %d, write sink", block.Height)
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return sdkioerrors.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	}
+	return nil
+}
+
+// indexedTx is an eth tx result along with its eth tx hash and msg.
+type indexedTx struct {
+	hash   common.Hash
+	result eth.TxResult
+	msg    *evm.MsgEthereumTx
+}
+
+// blockTxResults builds the results of all the eth txs in a block, in the
+// order of their eth tx index.
+func (indexer *EVMTxIndexer) blockTxResults(
+	block *cmttypes.Block, txResults []*abci.ResponseDeliverTx,
+) (indexedTxs []indexedTx) {
+	height := block.Height
+
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
 	for txIndex, tx := range block.Txs {
@@ -121,15 +166,159 @@ func (indexer *EVMTxIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci
 			txResult.CumulativeGasUsed = cumulativeGasUsed
 			ethTxIndex++
 
-			if err := saveTxResult(indexer.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return sdkioerrors.Wrapf(err, "IndexBlock %d", height)
-			}
+			indexedTxs = append(indexedTxs, indexedTx{hash: txHash, result: txResult, msg: ethMsg})
 		}
 	}
-	if err := batch.Write(); err != nil {
-		return sdkioerrors.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	return indexedTxs
+}
+
+// IndexMismatch is a difference between the indexed eth txs of a block and
+// the eth txs of its CometBFT block results.
+type IndexMismatch struct {
+	Height     int64
+	EthTxIndex int32
+	TxHash     common.Hash
+	Reason     string
+}
+
+func (m IndexMismatch) String() string {
+	return fmt.Sprintf("block %d, eth tx %d (%s): %s", m.Height, m.EthTxIndex, m.TxHash.Hex(), m.Reason)
+}
+
+// VerifyBlock compares the indexed eth txs of a block against the eth txs of
+// its CometBFT block results and returns every difference. An empty result
+// means that the block is indexed correctly.
+func (indexer *EVMTxIndexer) VerifyBlock(
+	block *cmttypes.Block, txResults []*abci.ResponseDeliverTx,
+) (mismatches []IndexMismatch, err error) {
+	height := block.Height
+	expected := indexer.blockTxResults(block, txResults)
+
+	// (block number, eth tx index) -> tx hash entries of the block
+	indexedHashes := make(map[int32]common.Hash)
+	it, err := indexer.db.Iterator(TxIndexKey(height, 0), TxIndexKey(height+1, 0))
+	if err != nil {
+		return nil, sdkioerrors.Wrapf(err, "VerifyBlock %d", height)
+	}
+	for ; it.Valid(); it.Next() {
+		ethTxIndex := int32(sdk.BigEndianToUint64(it.Key()[9:]))
+		indexedHashes[ethTxIndex] = common.BytesToHash(it.Value())
+	}
+	if err := it.Close(); err != nil {
+		return nil, sdkioerrors.Wrapf(err, "VerifyBlock %d", height)
+	}
+
+	for _, want := range expected {
+		mismatch := IndexMismatch{Height: height, EthTxIndex: want.result.EthTxIndex, TxHash: want.hash}
+		indexedHash, found := indexedHashes[want.result.EthTxIndex]
+		delete(indexedHashes, want.result.EthTxIndex)
+		switch {
+		case !found:
+			mismatch.Reason = "missing tx-index entry"
+		case indexedHash != want.hash:
+			mismatch.Reason = fmt.Sprintf("tx-index entry points to %s", indexedHash.Hex())
+		}
+		if mismatch.Reason != "" {
+			mismatches = append(mismatches, mismatch)
+		}
+
+		got, err := indexer.getTxResult(want.hash)
+		if err != nil {
+			return nil, sdkioerrors.Wrapf(err, "VerifyBlock %d", height)
+		}
+		switch {
+		case got == nil:
+			mismatch.Reason = "missing tx-hash entry"
+		case !proto.Equal(got, &want.result):
+			mismatch.Reason = fmt.Sprintf(
+				"indexed result %s, expected %s", got.String(), want.result.String())
+		default:
+			continue
+		}
+		mismatches = append(mismatches, mismatch)
+	}
+
+	for ethTxIndex, indexedHash := range indexedHashes {
+		mismatches = append(mismatches, IndexMismatch{
+			Height:     height,
+			EthTxIndex: ethTxIndex,
+			TxHash:     indexedHash,
+			Reason:     "indexed tx not in block results",
+		})
+	}
+	sort.SliceStable(mismatches, func(i, j int) bool {
+		return mismatches[i].EthTxIndex < mismatches[j].EthTxIndex
+	})
+	return mismatches, nil
+}
+
+// PruneBlocks deletes the indexed eth txs of all blocks below "height" and
+// returns the number of deleted txs.
+func (indexer *EVMTxIndexer) PruneBlocks(height int64) (numPruned int, err error) {
+	return indexer.deleteBlocks(TxIndexKey(0, 0), TxIndexKey(height, 0))
+}
+
+// DeleteBlocks deletes the indexed eth txs of the blocks from "fromHeight" to
+// "toHeight" (inclusive) and returns the number of deleted txs.
+func (indexer *EVMTxIndexer) DeleteBlocks(fromHeight, toHeight int64) (numDeleted int, err error) {
+	return indexer.deleteBlocks(TxIndexKey(fromHeight, 0), TxIndexKey(toHeight+1, 0))
+}
+
+// deleteBlocksBatchSize is the number of txs deleted per DB batch.
+const deleteBlocksBatchSize = 10_000
+
+// deleteBlocks deletes the eth txs whose tx-index keys are in [start, end),
+// along with their tx-hash entries. A tx-hash entry is kept if it points to
+// another block, which happens when a tx hash was indexed again.
+func (indexer *EVMTxIndexer) deleteBlocks(start, end []byte) (numDeleted int, err error) {
+	for {
+		var keys [][]byte
+		var hashes []common.Hash
+		it, err := indexer.db.Iterator(start, end)
+		if err != nil {
+			return numDeleted, sdkioerrors.Wrap(err, "deleteBlocks")
+		}
+		for ; it.Valid() && len(keys) < deleteBlocksBatchSize; it.Next() {
+			keys = append(keys, it.Key())
+			hashes = append(hashes, common.BytesToHash(it.Value()))
+		}
+		if err := it.Close(); err != nil {
+			return numDeleted, sdkioerrors.Wrap(err, "deleteBlocks")
+		}
+		if len(keys) == 0 {
+			return numDeleted, nil
+		}
+
+		batch := indexer.db.NewBatch()
+		for i, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return numDeleted, sdkioerrors.Wrap(err, "delete tx-index key")
+			}
+			txResult, err := indexer.getTxResult(hashes[i])
+			if err != nil {
+				batch.Close()
+				return numDeleted, sdkioerrors.Wrap(err, "deleteBlocks")
+			}
+			height, err := parseBlockNumberFromKey(key)
+			if err != nil {
+				batch.Close()
+				return numDeleted, err
+			}
+			if txResult != nil && txResult.Height == height {
+				if err := batch.Delete(TxHashKey(hashes[i])); err != nil {
+					batch.Close()
+					return numDeleted, sdkioerrors.Wrap(err, "delete tx-hash key")
+				}
+			}
+		}
+		if err := batch.Write(); err != nil {
+			batch.Close()
+			return numDeleted, sdkioerrors.Wrap(err, "deleteBlocks, write batch")
+		}
+		batch.Close()
+		numDeleted += len(keys)
 	}
-	return nil
 }
 
 // LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
@@ -144,18 +333,28 @@ func (indexer *EVMTxIndexer) FirstIndexedBlock() (int64, error) {
 
 // GetByTxHash finds eth tx by eth tx hash
 func (indexer *EVMTxIndexer) GetByTxHash(hash common.Hash) (*eth.TxResult, error) {
-	bz, err := indexer.db.Get(TxHashKey(hash))
+	txResult, err := indexer.getTxResult(hash)
 	if err != nil {
 		return nil, sdkioerrors.Wrapf(err, "GetByTxHash %s", hash.Hex())
 	}
-	if len(bz) == 0 {
+	if txResult == nil {
 		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
 	}
-	var txKey eth.TxResult
-	if err := indexer.clientCtx.Codec.Unmarshal(bz, &txKey); err != nil {
-		return nil, sdkioerrors.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	return txResult, nil
+}
+
+// getTxResult loads the tx-hash entry of an eth tx, returns nil if there is
+// none.
+func (indexer *EVMTxIndexer) getTxResult(hash common.Hash) (*eth.TxResult, error) {
+	bz, err := indexer.db.Get(TxHashKey(hash))
+	if err != nil || len(bz) == 0 {
+		return nil, err
+	}
+	var txResult eth.TxResult
+	if err := indexer.clientCtx.Codec.Unmarshal(bz, &txResult); err != nil {
+		return nil, err
 	}
-	return &txKey, nil
+	return &txResult, nil
 }
 
 // GetByBlockAndIndex finds eth tx by block number and eth tx index
@@ -215,6 +414,11 @@ func (indexer *EVMTxIndexer) CloseDBAndExit() error {
 	if err != nil {
 		return sdkioerrors.Wrap(err, "CloseDBAndExit")
 	}
+	if indexer.sink != nil {
+		if err := indexer.sink.Close(); err != nil {
+			return sdkioerrors.Wrap(err, "CloseDBAndExit, close sink")
+		}
+	}
 	return nil
 }
 
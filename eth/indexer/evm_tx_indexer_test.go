@@ -200,3 +200,176 @@ func TestEVMTxIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestEVMTxIndexerVerifyAndPrune(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := evmtest.NewSigner(priv)
+	ethSigner := gethcore.LatestSignerForChainID(nil)
+
+	encCfg := app.MakeEncodingConfig()
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec)
+
+	// newBlock returns a block at "height" with one eth tx, along with the
+	// results of its txs.
+	newBlock := func(height int64) (*cmttypes.Block, []*abci.ResponseDeliverTx, common.Hash) {
+		to := common.BigToAddress(big.NewInt(1))
+		tx := evm.NewTx(&evm.EvmTxArgs{
+			Nonce:    uint64(height),
+			To:       &to,
+			Amount:   big.NewInt(1000),
+			GasLimit: 21000,
+		})
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+		sdkTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), eth.EthBaseDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(sdkTx)
+		require.NoError(t, err)
+
+		block := &cmttypes.Block{
+			Header: cmttypes.Header{Height: height},
+			Data:   cmttypes.Data{Txs: []cmttypes.Tx{txBz}},
+		}
+		txResults := []*abci.ResponseDeliverTx{{
+			Events: []abci.Event{{
+				Type: evm.PendingEthereumTxEvent,
+				Attributes: []abci.EventAttribute{
+					{Key: evm.PendingEthereumTxEventAttrEthHash, Value: txHash.Hex()},
+					{Key: evm.PendingEthereumTxEventAttrIndex, Value: "0"},
+				},
+			}},
+		}}
+		return block, txResults, txHash
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewEVMTxIndexer(db, tmlog.NewNopLogger(), clientCtx)
+	var txHashes []common.Hash
+	for height := int64(1); height <= 3; height++ {
+		block, txResults, txHash := newBlock(height)
+		require.NoError(t, idxer.IndexBlock(block, txResults))
+		txHashes = append(txHashes, txHash)
+
+		mismatches, err := idxer.VerifyBlock(block, txResults)
+		require.NoError(t, err)
+		require.Empty(t, mismatches)
+	}
+
+	t.Log("Verify reports missing and extra entries")
+	require.NoError(t, db.Delete(indexer.TxHashKey(txHashes[1])))
+	block, txResults, _ := newBlock(2)
+	mismatches, err := idxer.VerifyBlock(block, txResults)
+	require.NoError(t, err)
+	require.Len(t, mismatches, 1)
+	require.Equal(t, "missing tx-hash entry", mismatches[0].Reason)
+
+	require.NoError(t, db.Set(indexer.TxIndexKey(3, 1), txHashes[0].Bytes()))
+	block, txResults, _ = newBlock(3)
+	mismatches, err = idxer.VerifyBlock(block, txResults)
+	require.NoError(t, err)
+	require.Len(t, mismatches, 1)
+	require.Equal(t, int32(1), mismatches[0].EthTxIndex)
+	require.Equal(t, "indexed tx not in block results", mismatches[0].Reason)
+
+	t.Log("Prune keeps the entries of recent blocks")
+	numPruned, err := idxer.PruneBlocks(3)
+	require.NoError(t, err)
+	require.Equal(t, 2, numPruned)
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), first)
+	_, err = idxer.GetByTxHash(txHashes[0])
+	require.ErrorContains(t, err, "tx not found")
+	_, err = idxer.GetByTxHash(txHashes[2])
+	require.NoError(t, err)
+
+	t.Log("Delete and reindex a block")
+	numDeleted, err := idxer.DeleteBlocks(3, 3)
+	require.NoError(t, err)
+	require.Equal(t, 2, numDeleted)
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+
+	require.NoError(t, idxer.IndexBlock(block, txResults))
+	mismatches, err = idxer.VerifyBlock(block, txResults)
+	require.NoError(t, err)
+	require.Empty(t, mismatches)
+}
+
+// flakySink is an [indexer.EVMIndexerSink] that fails its first writes, like
+// a database that is restarting.
+type flakySink struct {
+	failures int
+	blocks   []indexer.SinkBlock
+}
+
+func (s *flakySink) WriteBlock(block indexer.SinkBlock) error {
+	if s.failures > 0 {
+		s.failures--
+		return fmt.Errorf("connection refused")
+	}
+	s.blocks = append(s.blocks, block)
+	return nil
+}
+
+func (s *flakySink) Close() error { return nil }
+
+func TestEVMTxIndexerSinkError(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	to := common.BigToAddress(big.NewInt(1))
+	tx := evm.NewTx(&evm.EvmTxArgs{To: &to, Amount: big.NewInt(1000), GasLimit: 21000})
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(gethcore.LatestSignerForChainID(nil), evmtest.NewSigner(priv)))
+	txHash := tx.AsTransaction().Hash()
+
+	encCfg := app.MakeEncodingConfig()
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec)
+	sdkTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), eth.EthBaseDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(sdkTx)
+	require.NoError(t, err)
+	block := &cmttypes.Block{
+		Header: cmttypes.Header{Height: 1},
+		Data:   cmttypes.Data{Txs: []cmttypes.Tx{txBz}},
+	}
+	txResults := []*abci.ResponseDeliverTx{{
+		Events: []abci.Event{{
+			Type: evm.PendingEthereumTxEvent,
+			Attributes: []abci.EventAttribute{
+				{Key: evm.PendingEthereumTxEventAttrEthHash, Value: txHash.Hex()},
+				{Key: evm.PendingEthereumTxEventAttrIndex, Value: "0"},
+			},
+		}},
+	}}
+
+	sink := &flakySink{failures: 1}
+	idxer := indexer.NewEVMTxIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx).WithSink(sink)
+
+	t.Log("A block that the sink fails to store is not marked as indexed")
+	require.ErrorContains(t, idxer.IndexBlock(block, txResults), "connection refused")
+	lastBlock, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.EqualValues(t, -1, lastBlock)
+	_, err = idxer.GetByTxHash(txHash)
+	require.Error(t, err)
+
+	t.Log("Indexing the block again stores it in both the sink and the KV db")
+	require.NoError(t, idxer.IndexBlock(block, txResults))
+	require.Len(t, sink.blocks, 1)
+	require.Equal(t, txHash, sink.blocks[0].Txs[0].Hash)
+	lastBlock, err = idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.EqualValues(t, 1, lastBlock)
+	_, err = idxer.GetByTxHash(txHash)
+	require.NoError(t, err)
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package indexer
+
+import (
+	"math/big"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// EVMIndexerSink is an external store, such as a SQL database, that receives
+// every block indexed by the [EVMTxIndexer]. Blocks may be written more than
+// once, e.g. when the "evm-tx-index" command re-runs over indexed heights, so
+// a sink must overwrite the data of a block that it already holds.
+type EVMIndexerSink interface {
+	// WriteBlock stores the eth txs, logs and FunToken conversions of a block.
+	WriteBlock(block SinkBlock) error
+	// Close releases the resources of the sink.
+	Close() error
+}
+
+// SinkBlock is an indexed block as written to an [EVMIndexerSink].
+type SinkBlock struct {
+	Height int64
+	Hash   common.Hash
+	Time   time.Time
+	// Txs are the eth txs of the block in the order of their eth tx index.
+	Txs []SinkTx
+	// FunTokenConversions are the FunToken conversions of all the successful
+	// txs of the block, including Cosmos txs.
+	FunTokenConversions []SinkFunTokenConversion
+}
+
+// SinkTx is an eth tx along with its receipt fields.
+type SinkTx struct {
+	Hash   common.Hash
+	Result eth.TxResult
+
+	Type     uint8
+	From     common.Address
+	To       *common.Address
+	Nonce    uint64
+	Value    *big.Int
+	GasLimit uint64
+	Input    []byte
+
+	// ContractAddress is the address of the contract created by the tx, nil
+	// if the tx isn't a successful contract creation.
+	ContractAddress *common.Address
+	Logs            []*gethcore.Log
+}
+
+// FunToken conversion directions of a [SinkFunTokenConversion].
+const (
+	FunTokenConversionToEvm  = "coin_to_evm"
+	FunTokenConversionToCoin = "evm_to_coin"
+)
+
+// SinkFunTokenConversion is a conversion between the bank coin and ERC20 of a
+// FunToken mapping, parsed from an [evm.EventConvertCoinToEvm] or
+// [evm.EventConvertEvmToCoin] event.
+type SinkFunTokenConversion struct {
+	Height int64
+	// TxIndex is the index of the tx in the block.
+	TxIndex uint32
+	// EventIndex is the index of the event in the events of the tx.
+	EventIndex uint32
+	// TxHash is the CometBFT hash of the tx.
+	TxHash string
+
+	Direction    string
+	Sender       string
+	Recipient    string
+	Erc20Address string
+	BankCoin     sdk.Coin
+	Erc20Amount  string
+}
+
+// sinkBlock builds the [SinkBlock] of a block from its indexed eth txs.
+func (indexer *EVMTxIndexer) sinkBlock(
+	block *cmttypes.Block, txResults []*abci.ResponseDeliverTx, indexedTxs []indexedTx,
+) SinkBlock {
+	sinkBlock := SinkBlock{
+		Height: block.Height,
+		Hash:   common.BytesToHash(block.Hash()),
+		Time:   block.Time,
+	}
+
+	for _, indexedTx := range indexedTxs {
+		ethTx := indexedTx.msg.AsTransaction()
+		sinkTx := SinkTx{
+			Hash:     indexedTx.hash,
+			Result:   indexedTx.result,
+			Type:     ethTx.Type(),
+			To:       ethTx.To(),
+			Nonce:    ethTx.Nonce(),
+			Value:    ethTx.Value(),
+			GasLimit: ethTx.Gas(),
+			Input:    ethTx.Data(),
+		}
+		// The From field of a msg is cleared before broadcast, so the sender
+		// is recovered from the signature.
+		from, err := gethcore.Sender(gethcore.LatestSignerForChainID(ethTx.ChainId()), ethTx)
+		if err != nil {
+			indexer.logger.Error("Fail to recover tx sender", "err", err, "txHash", indexedTx.hash.Hex())
+		}
+		sinkTx.From = from
+		if sinkTx.To == nil && !indexedTx.result.Failed {
+			contractAddr := crypto.CreateAddress(sinkTx.From, sinkTx.Nonce)
+			sinkTx.ContractAddress = &contractAddr
+		}
+		result := txResults[indexedTx.result.TxIndex]
+		if result.Code == abci.CodeTypeOK {
+			sinkTx.Logs = indexer.txLogs(result.Events, int(indexedTx.result.MsgIndex))
+		}
+		sinkBlock.Txs = append(sinkBlock.Txs, sinkTx)
+	}
+
+	for txIndex, result := range txResults {
+		if result.Code != abci.CodeTypeOK {
+			continue
+		}
+		for eventIndex, event := range result.Events {
+			conversion, ok := indexer.funTokenConversion(event)
+			if !ok {
+				continue
+			}
+			conversion.Height = block.Height
+			conversion.TxIndex = uint32(txIndex)
+			conversion.EventIndex = uint32(eventIndex)
+			conversion.TxHash = eth.TmTxHashToString(block.Txs[txIndex].Hash())
+			sinkBlock.FunTokenConversions = append(sinkBlock.FunTokenConversions, conversion)
+		}
+	}
+	return sinkBlock
+}
+
+// txLogs parses the logs of the eth tx at "msgIndex" from the events of its
+// Cosmos tx. Each eth tx emits one [evm.EventTxLog].
+func (indexer *EVMTxIndexer) txLogs(events []abci.Event, msgIndex int) []*gethcore.Log {
+	for _, event := range events {
+		if event.Type != evm.TypeUrlEventTxLog {
+			continue
+		}
+		if msgIndex > 0 {
+			msgIndex--
+			continue
+		}
+		eventTxLog, err := evm.EventTxLogFromABCIEvent(event)
+		if err != nil {
+			indexer.logger.Error("Fail to parse tx logs", "err", err)
+			return nil
+		}
+		return evm.LogsToEthereum(eventTxLog.Logs)
+	}
+	return nil
+}
+
+// funTokenConversion parses a FunToken conversion event, returns false if the
+// event isn't one.
+func (indexer *EVMTxIndexer) funTokenConversion(event abci.Event) (SinkFunTokenConversion, bool) {
+	if event.Type != proto.MessageName(new(evm.EventConvertCoinToEvm)) &&
+		event.Type != proto.MessageName(new(evm.EventConvertEvmToCoin)) {
+		return SinkFunTokenConversion{}, false
+	}
+	typedEvent, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		indexer.logger.Error("Fail to parse FunToken conversion", "err", err, "event", event.Type)
+		return SinkFunTokenConversion{}, false
+	}
+	switch e := typedEvent.(type) {
+	case *evm.EventConvertCoinToEvm:
+		return SinkFunTokenConversion{
+			Direction:    FunTokenConversionToEvm,
+			Sender:       e.Sender,
+			Recipient:    e.ToEthAddr,
+			Erc20Address: e.Erc20ContractAddress,
+			BankCoin:     e.BankCoin,
+			Erc20Amount:  e.Erc20Amount,
+		}, true
+	case *evm.EventConvertEvmToCoin:
+		return SinkFunTokenConversion{
+			Direction:    FunTokenConversionToCoin,
+			Sender:       e.Sender,
+			Recipient:    e.ToAddress,
+			Erc20Address: e.Erc20ContractAddress,
+			BankCoin:     e.BankCoin,
+			Erc20Amount:  e.Erc20Amount,
+		}, true
+	}
+	return SinkFunTokenConversion{}, false
+}
@@ -0,0 +1,172 @@
+package sqlsink_test
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/app"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/crypto/ethsecp256k1"
+	"github.com/NibiruChain/nibiru/v2/eth/indexer"
+	"github.com/NibiruChain/nibiru/v2/eth/indexer/sqlsink"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	evmtest "github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+)
+
+func TestSQLSink(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := evmtest.NewSigner(priv)
+	ethSigner := gethcore.LatestSignerForChainID(nil)
+
+	encCfg := app.MakeEncodingConfig()
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec)
+
+	// A contract creation that emits one log
+	tx := evm.NewTx(&evm.EvmTxArgs{
+		Nonce:    3,
+		Amount:   big.NewInt(1000),
+		GasLimit: 100_000,
+		Input:    []byte{0x60, 0x80},
+	})
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+	sdkTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), eth.EthBaseDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(sdkTx)
+	require.NoError(t, err)
+
+	contractAddr := crypto.CreateAddress(from, 3)
+	topic := common.BytesToHash([]byte("topic"))
+	logEvent, err := sdk.TypedEventToEvent(&evm.EventTxLog{Logs: []evm.Log{{
+		Address:     contractAddr.Hex(),
+		Topics:      []string{topic.Hex()},
+		Data:        []byte{0xab},
+		BlockNumber: 7,
+		TxHash:      txHash.Hex(),
+		Index:       2,
+	}}})
+	require.NoError(t, err)
+	erc20 := common.BigToAddress(big.NewInt(42))
+	conversionEvent, err := sdk.TypedEventToEvent(&evm.EventConvertCoinToEvm{
+		Sender:               "nibi1sender",
+		Erc20ContractAddress: erc20.Hex(),
+		ToEthAddr:            from.Hex(),
+		BankCoin:             sdk.NewCoin("unibi", sdkmath.NewInt(5)),
+		Erc20Amount:          "5000",
+	})
+	require.NoError(t, err)
+
+	block := &cmttypes.Block{
+		Header: cmttypes.Header{Height: 7, Time: time.Unix(1_700_000_000, 0)},
+		Data:   cmttypes.Data{Txs: []cmttypes.Tx{txBz}},
+	}
+	txResults := []*abci.ResponseDeliverTx{{
+		Events: []abci.Event{
+			{
+				Type: evm.PendingEthereumTxEvent,
+				Attributes: []abci.EventAttribute{
+					{Key: evm.PendingEthereumTxEventAttrEthHash, Value: txHash.Hex()},
+					{Key: evm.PendingEthereumTxEventAttrIndex, Value: "0"},
+				},
+			},
+			abci.Event(logEvent),
+			abci.Event(conversionEvent),
+		},
+	}}
+
+	sink, err := sqlsink.Open(sqlsink.DriverSQLite, filepath.Join(t.TempDir(), "sink.sqlite"))
+	require.NoError(t, err)
+	idxer := indexer.NewEVMTxIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx).WithSink(sink)
+	defer func() { require.NoError(t, idxer.CloseDBAndExit()) }()
+	db := sink.DB()
+
+	requireRowCounts := func() {
+		for table, want := range map[string]int{
+			"blocks":               1,
+			"txs":                  1,
+			"logs":                 1,
+			"funtoken_conversions": 1,
+		} {
+			var got int
+			require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM "+table).Scan(&got))
+			require.Equal(t, want, got, table)
+		}
+	}
+
+	t.Log("Index a block")
+	require.NoError(t, idxer.IndexBlock(block, txResults))
+	requireRowCounts()
+
+	var (
+		blockTime, height, nonce  int64
+		gotFrom, gotContract      string
+		toAddr                    *string
+		value                     string
+		failed                    bool
+		logAddr, logTopic, logTx  string
+		direction, recipient, amt string
+	)
+	require.NoError(t, db.QueryRow(
+		"SELECT block_time FROM blocks WHERE height = $1", 7,
+	).Scan(&blockTime))
+	require.Equal(t, int64(1_700_000_000), blockTime)
+
+	require.NoError(t, db.QueryRow(
+		"SELECT height, from_address, to_address, contract_address, nonce, value, failed FROM txs WHERE hash = $1",
+		txHash.Hex(),
+	).Scan(&height, &gotFrom, &toAddr, &gotContract, &nonce, &value, &failed))
+	require.Equal(t, int64(7), height)
+	require.Equal(t, hexLower(from), gotFrom)
+	require.Nil(t, toAddr)
+	require.Equal(t, hexLower(contractAddr), gotContract)
+	require.Equal(t, int64(3), nonce)
+	require.Equal(t, "1000", value)
+	require.False(t, failed)
+
+	require.NoError(t, db.QueryRow(
+		"SELECT address, topic0, tx_hash FROM logs WHERE height = $1 AND log_index = $2", 7, 2,
+	).Scan(&logAddr, &logTopic, &logTx))
+	require.Equal(t, hexLower(contractAddr), logAddr)
+	require.Equal(t, topic.Hex(), logTopic)
+	require.Equal(t, txHash.Hex(), logTx)
+
+	require.NoError(t, db.QueryRow(
+		"SELECT direction, recipient, erc20_amount FROM funtoken_conversions WHERE erc20_address = $1",
+		hexLower(erc20),
+	).Scan(&direction, &recipient, &amt))
+	require.Equal(t, indexer.FunTokenConversionToEvm, direction)
+	require.Equal(t, from.Hex(), recipient)
+	require.Equal(t, "5000", amt)
+
+	t.Log("Indexing the block again is idempotent")
+	require.NoError(t, idxer.IndexBlock(block, txResults))
+	requireRowCounts()
+}
+
+func hexLower(addr common.Address) string {
+	return "0x" + common.Bytes2Hex(addr.Bytes())
+}
+
+func TestOpenUnsupportedDriver(t *testing.T) {
+	_, err := sqlsink.Open("mysql", "")
+	require.ErrorContains(t, err, "unsupported sql sink driver")
+}
@@ -2,6 +2,7 @@ package gosdk
 
 import (
 	"context"
+	"errors"
 
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
@@ -11,9 +12,6 @@ import (
 	sdktypestx "github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"google.golang.org/grpc"
-
-	"github.com/NibiruChain/nibiru/v2/x/common"
-	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
 )
 
 func BroadcastMsgsWithSeq(
@@ -35,23 +33,18 @@ func BroadcastMsgsWithSeq(
 		return nil, err
 	}
 
-	bondDenom := denoms.NIBI
-	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(1000))))
-	txBuilder.SetGasLimit(uint64(2 * common.TO_MICRO))
-
 	nums, err := args.gosdk.GetAccountNumbers(from.String())
 	if err != nil {
 		return nil, err
 	}
 
-	var accRetriever sdkclient.AccountRetriever = authtypes.AccountRetriever{}
-	txFactory := sdkclienttx.Factory{}.
-		WithChainID(args.chainID).
-		WithKeybase(args.kring).
-		WithTxConfig(args.txCfg).
-		WithAccountRetriever(accRetriever).
-		WithAccountNumber(nums.Number).
-		WithSequence(seq)
+	txFactory := newTxFactory(args, info.Name, nums.Number, seq)
+	gasEstimate, err := EstimateGas(args, txFactory, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	txBuilder.SetFeeAmount(gasEstimate.Fee)
+	txBuilder.SetGasLimit(gasEstimate.GasLimit)
 
 	overwriteSig := true
 	err = sdkclienttx.Sign(txFactory, info.Name, txBuilder, overwriteSig)
@@ -67,6 +60,22 @@ func BroadcastMsgsWithSeq(
 	return broadcaster.BroadcastTxSync(txBytes)
 }
 
+// newTxFactory returns a factory that signs with the key "fromName" of the
+// keyring in "args".
+func newTxFactory(
+	args BroadcastArgs, fromName string, accNum, seq uint64,
+) sdkclienttx.Factory {
+	var accRetriever sdkclient.AccountRetriever = authtypes.AccountRetriever{}
+	return sdkclienttx.Factory{}.
+		WithChainID(args.chainID).
+		WithKeybase(args.kring).
+		WithFromName(fromName).
+		WithTxConfig(args.txCfg).
+		WithAccountRetriever(accRetriever).
+		WithAccountNumber(accNum).
+		WithSequence(seq)
+}
+
 func BroadcastMsgs(
 	args BroadcastArgs,
 	from sdk.AccAddress,
@@ -141,8 +150,11 @@ type BroadcastArgs struct {
 	gosdk NibiruSDK
 	// clientCtx   sdkclient.Context // TODO: implement
 	Broadcaster Broadcaster
-	rpc         cmtrpcclient.Client
-	chainID     string
+	// GasConfig sets how the gas limit and fee of each transaction are chosen.
+	GasConfig GasConfig
+	rpc       cmtrpcclient.Client
+	grpcConn  *grpc.ClientConn
+	chainID   string
 }
 
 func initBroadcastArgs(
@@ -154,11 +166,32 @@ func initBroadcastArgs(
 		txCfg:       txConfig,
 		gosdk:       *nc,
 		Broadcaster: broadcaster,
+		GasConfig:   nc.GasConfig,
 		rpc:         nc.CometRPC,
+		grpcConn:    nc.Querier.ClientConn,
 		chainID:     nc.ChainId,
 	}
 }
 
+// EstimateGas returns the gas limit and fee that broadcasting "msgs" from
+// "from" would use under the [GasConfig] of the SDK.
+func (nc *NibiruSDK) EstimateGas(
+	from sdk.AccAddress,
+	msgs ...sdk.Msg,
+) (GasEstimate, error) {
+	args := initBroadcastArgs(nc, nil)
+	info, err := args.kring.KeyByAddress(from)
+	if err != nil {
+		return GasEstimate{}, err
+	}
+	nums, err := nc.GetAccountNumbers(from.String())
+	if err != nil {
+		return GasEstimate{}, err
+	}
+	txFactory := newTxFactory(args, info.Name, nums.Number, nums.Sequence)
+	return EstimateGas(args, txFactory, msgs...)
+}
+
 func (nc *NibiruSDK) BroadcastMsgs(
 	from sdk.AccAddress,
 	msgs ...sdk.Msg,
@@ -178,6 +211,24 @@ func (nc *NibiruSDK) BroadcastMsgsWithSeq(
 	return BroadcastMsgsWithSeq(args, from, seq, msgs...)
 }
 
+// BroadcastMsgsManagedSeq broadcasts "msgs" with the next sequence of "from"
+// handed out by [NibiruSDK.Sequences]. Unlike [NibiruSDK.BroadcastMsgsWithSeq],
+// it is safe to call concurrently for the same signer without waiting for
+// earlier txs to be included in a block.
+func (nc *NibiruSDK) BroadcastMsgsManagedSeq(
+	from sdk.AccAddress,
+	msgs ...sdk.Msg,
+) (*sdk.TxResponse, error) {
+	if nc.Sequences == nil {
+		return nil, errors.New("gosdk: NibiruSDK has no sequence manager")
+	}
+	broadcaster := BroadcasterTmRpc{RPC: nc.CometRPC}
+	args := initBroadcastArgs(nc, broadcaster)
+	return nc.Sequences.Broadcast(from, func(nums AccountNumbers) (*sdk.TxResponse, error) {
+		return BroadcastMsgsWithSeq(args, from, nums.Sequence, msgs...)
+	})
+}
+
 func (nc *NibiruSDK) BroadcastMsgsGrpc(
 	from sdk.AccAddress,
 	msgs ...sdk.Msg,
@@ -0,0 +1,249 @@
+package gosdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcoretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// SubscribePollInterval is how often a typed event subscription checks for
+// new blocks when it can't receive new block headers from CometBFT, for
+// example while reconnecting.
+var SubscribePollInterval = time.Second
+
+// TypedEvent is a typed event of type T along with where it was emitted.
+type TypedEvent[T proto.Message] struct {
+	Event  T
+	Height int64
+	// TxHash is the hex-encoded hash of the tx that emitted the event. It is
+	// empty for events emitted at the start or end of a block, like the price
+	// updates of the oracle module.
+	TxHash string
+}
+
+// EventDecodeError is reported by [SubscribeTypedEvents] for an event of the
+// subscribed type that can't be decoded. The event is skipped, since every
+// retry would fail the same way.
+type EventDecodeError struct {
+	EventType string
+	Height    int64
+	Err       error
+}
+
+func (e *EventDecodeError) Error() string {
+	return fmt.Sprintf("failed to decode event %s at height %d: %s", e.EventType, e.Height, e.Err)
+}
+
+func (e *EventDecodeError) Unwrap() error { return e.Err }
+
+// SubscribeOptions configures [SubscribeTypedEvents].
+type SubscribeOptions struct {
+	// FromHeight is the first block to read events from. Passing the height
+	// of the last event seen resumes a subscription after a restart. Zero
+	// means the block after the latest one.
+	FromHeight int64
+}
+
+// SubscribeTypedEvents returns a channel of the typed events of type T, such
+// as "*oracletypes.EventPriceUpdate", emitted through "EmitTypedEvent" by txs
+// and by the begin and end blockers. Events arrive in block order, and within
+// a block in the order they were emitted. Both channels are closed when "ctx"
+// is done.
+//
+// Blocks are read with [NibiruSDK.CometRPC]. When it is running, new blocks
+// are noticed through a new block header subscription, which is resubscribed
+// if it drops. Otherwise, or while the node is unreachable, the latest height
+// is polled every [SubscribePollInterval]. Either way, the subscription resumes
+// from the block after the last one it read, so no events are skipped.
+//
+// Errors are sent on the error channel, which must be received from along with
+// the events: the subscription waits for each error to be received. A failed
+// read, such as the node being unreachable, is retried on the next poll from
+// the same height. An event that can't be decoded is reported as an
+// [*EventDecodeError] and skipped.
+func SubscribeTypedEvents[T proto.Message](
+	ctx context.Context, nc *NibiruSDK, opts SubscribeOptions,
+) (<-chan TypedEvent[T], <-chan error, error) {
+	var zero T
+	eventType := proto.MessageName(zero)
+	if eventType == "" {
+		return nil, nil, fmt.Errorf("%T is not a registered proto message", zero)
+	}
+
+	nextHeight := opts.FromHeight
+	if nextHeight <= 0 {
+		status, err := nc.CometRPC.Status(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to query latest height: %w", err)
+		}
+		nextHeight = status.SyncInfo.LatestBlockHeight + 1
+	}
+
+	out := make(chan TypedEvent[T])
+	errs := make(chan error)
+	sub := &typedEventSub[T]{
+		nc:         nc,
+		eventType:  eventType,
+		nextHeight: nextHeight,
+		out:        out,
+		errs:       errs,
+	}
+	go sub.run(ctx)
+	return out, errs, nil
+}
+
+// typedEventSub is the state of a subscription started by
+// [SubscribeTypedEvents].
+type typedEventSub[T proto.Message] struct {
+	nc         *NibiruSDK
+	eventType  string
+	nextHeight int64
+	out        chan<- TypedEvent[T]
+	errs       chan<- error
+}
+
+func (s *typedEventSub[T]) run(ctx context.Context) {
+	defer close(s.errs)
+	defer close(s.out)
+
+	subscriber := fmt.Sprintf("gosdk-typed-events-%s-%p", s.eventType, s)
+	query := cmttypes.QueryForEvent(cmttypes.EventNewBlockHeader).String()
+	var headerCh <-chan cmtcoretypes.ResultEvent
+	defer func() {
+		if headerCh != nil {
+			_ = s.nc.CometRPC.Unsubscribe(context.Background(), subscriber, query)
+		}
+	}()
+
+	ticker := time.NewTicker(SubscribePollInterval)
+	defer ticker.Stop()
+	for {
+		if headerCh == nil && s.nc.CometRPC.IsRunning() {
+			// A failed subscription is retried on the next tick.
+			headerCh, _ = s.nc.CometRPC.Subscribe(ctx, subscriber, query)
+		}
+
+		// Errors, such as the node being unreachable, are retried on the next
+		// tick from the same height.
+		if err := s.catchUp(ctx); err != nil && !s.sendErr(ctx, err) {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case _, ok := <-headerCh:
+			if !ok {
+				_ = s.nc.CometRPC.Unsubscribe(context.Background(), subscriber, query)
+				headerCh = nil
+			}
+		}
+	}
+}
+
+// sendErr sends "err" on the error channel. It returns false if "ctx" is done
+// first.
+func (s *typedEventSub[T]) sendErr(ctx context.Context, err error) bool {
+	select {
+	case s.errs <- err:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// catchUp sends the events of the blocks from "nextHeight" up to the latest
+// block, along with the errors of the events it skipped.
+func (s *typedEventSub[T]) catchUp(ctx context.Context) error {
+	status, err := s.nc.CometRPC.Status(ctx)
+	if err != nil {
+		return err
+	}
+	for ; s.nextHeight <= status.SyncInfo.LatestBlockHeight; s.nextHeight++ {
+		events, decodeErrs, err := s.blockEvents(ctx, s.nextHeight)
+		if err != nil {
+			return err
+		}
+		for _, decodeErr := range decodeErrs {
+			if !s.sendErr(ctx, decodeErr) {
+				return ctx.Err()
+			}
+		}
+		for _, event := range events {
+			select {
+			case s.out <- event:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+// blockEvents decodes the events of type T emitted in the block at "height".
+// Events that can't be decoded are left out and returned as "decodeErrs".
+func (s *typedEventSub[T]) blockEvents(
+	ctx context.Context, height int64,
+) (events []TypedEvent[T], decodeErrs []error, err error) {
+	results, err := s.nc.CometRPC.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The block is only fetched to hash its txs if one of them emitted an
+	// event of type T.
+	var block *cmtcoretypes.ResultBlock
+	decode := func(abciEvents []abci.Event, txIdx int) error {
+		for _, abciEvent := range abciEvents {
+			if abciEvent.Type != s.eventType {
+				continue
+			}
+			typedEvent, err := sdk.ParseTypedEvent(abciEvent)
+			if err != nil {
+				decodeErrs = append(decodeErrs, &EventDecodeError{
+					EventType: s.eventType, Height: height, Err: err,
+				})
+				continue
+			}
+			typed, ok := typedEvent.(T)
+			if !ok {
+				decodeErrs = append(decodeErrs, &EventDecodeError{
+					EventType: s.eventType, Height: height,
+					Err: fmt.Errorf("decoded as %T, not %T", typedEvent, typed),
+				})
+				continue
+			}
+			event := TypedEvent[T]{Event: typed, Height: height}
+			if txIdx >= 0 {
+				if block == nil {
+					if block, err = s.nc.CometRPC.Block(ctx, &height); err != nil {
+						return err
+					}
+				}
+				event.TxHash = fmt.Sprintf("%X", block.Block.Txs[txIdx].Hash())
+			}
+			events = append(events, event)
+		}
+		return nil
+	}
+
+	if err := decode(results.BeginBlockEvents, -1); err != nil {
+		return nil, nil, err
+	}
+	for txIdx, txResult := range results.TxsResults {
+		if err := decode(txResult.Events, txIdx); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := decode(results.EndBlockEvents, -1); err != nil {
+		return nil, nil, err
+	}
+	return events, decodeErrs, nil
+}
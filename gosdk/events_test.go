@@ -0,0 +1,108 @@
+package gosdk_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmtcoretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/gosdk"
+	tftypes "github.com/NibiruChain/nibiru/v2/x/tokenfactory/types"
+)
+
+// fakeCometRPC serves the block results of "blocks", keyed by height. Reading
+// a height in "failures" fails once.
+type fakeCometRPC struct {
+	cmtrpcclient.Client
+
+	mu       sync.Mutex
+	blocks   map[int64][]abci.Event
+	failures map[int64]bool
+}
+
+func (c *fakeCometRPC) IsRunning() bool { return false }
+
+func (c *fakeCometRPC) Status(context.Context) (*cmtcoretypes.ResultStatus, error) {
+	status := new(cmtcoretypes.ResultStatus)
+	status.SyncInfo.LatestBlockHeight = int64(len(c.blocks))
+	return status, nil
+}
+
+func (c *fakeCometRPC) BlockResults(
+	_ context.Context, height *int64,
+) (*cmtcoretypes.ResultBlockResults, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failures[*height] {
+		delete(c.failures, *height)
+		return nil, errors.New("node unreachable")
+	}
+	return &cmtcoretypes.ResultBlockResults{
+		Height:         *height,
+		EndBlockEvents: c.blocks[*height],
+	}, nil
+}
+
+func TestSubscribeTypedEventsErrors(t *testing.T) {
+	pollInterval := gosdk.SubscribePollInterval
+	gosdk.SubscribePollInterval = 10 * time.Millisecond
+	defer func() { gosdk.SubscribePollInterval = pollInterval }()
+
+	newEvent := func(denom string) abci.Event {
+		event, err := sdk.TypedEventToEvent(&tftypes.EventCreateDenom{Denom: denom, Creator: "creator"})
+		require.NoError(t, err)
+		return abci.Event(event)
+	}
+	poisonEvent := newEvent("poison")
+	poisonEvent.Attributes[0].Value = "not json"
+
+	cometRPC := &fakeCometRPC{
+		blocks: map[int64][]abci.Event{
+			1: {poisonEvent, newEvent("denom1")},
+			2: {newEvent("denom2")},
+		},
+		failures: map[int64]bool{2: true},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	eventCh, errCh, err := gosdk.SubscribeTypedEvents[*tftypes.EventCreateDenom](
+		ctx, &gosdk.NibiruSDK{CometRPC: cometRPC}, gosdk.SubscribeOptions{FromHeight: 1},
+	)
+	require.NoError(t, err)
+
+	var (
+		denoms []string
+		errs   []error
+	)
+	for len(denoms) < 2 || len(errs) < 2 {
+		select {
+		case event := <-eventCh:
+			denoms = append(denoms, event.Event.Denom)
+		case err := <-errCh:
+			errs = append(errs, err)
+		case <-ctx.Done():
+			t.Fatalf("timed out with events %v and errors %v", denoms, errs)
+		}
+	}
+
+	t.Log("the event that can't be decoded is reported and skipped")
+	var decodeErr *gosdk.EventDecodeError
+	require.ErrorAs(t, errs[0], &decodeErr)
+	require.EqualValues(t, 1, decodeErr.Height)
+	t.Log("the failed read is reported and retried from the same height")
+	require.ErrorContains(t, errs[1], "node unreachable")
+	require.Equal(t, []string{"denom1", "denom2"}, denoms)
+
+	cancel()
+	for range eventCh {
+	}
+	for range errCh {
+	}
+}
@@ -0,0 +1,359 @@
+package gosdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	srvconfig "github.com/NibiruChain/nibiru/v2/app/server/config"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/crypto/ethsecp256k1"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// EthChainID returns the EIP-155 chain ID that EVM txs of the chain are
+// signed with.
+func (nc *NibiruSDK) EthChainID() *big.Int {
+	return eth.ParseEthChainID(nc.ChainId)
+}
+
+// EthNonce returns the nonce of the next EVM tx from "addr". Txs of "addr"
+// that are in the mempool but not yet in a block are not counted.
+func (nc *NibiruSDK) EthNonce(addr gethcommon.Address) (uint64, error) {
+	resp, err := nc.Querier.EVM.EthAccount(
+		context.Background(), &evm.QueryEthAccountRequest{Address: addr.Hex()},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query nonce of %s: %w", addr.Hex(), err)
+	}
+	return resp.Nonce, nil
+}
+
+// EthBaseFeeWei returns the EIP-1559 base fee of the chain in units of wei.
+func (nc *NibiruSDK) EthBaseFeeWei() (*big.Int, error) {
+	resp, err := nc.Querier.EVM.BaseFee(context.Background(), &evm.QueryBaseFeeRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query base fee: %w", err)
+	}
+	return resp.BaseFee.BigInt(), nil
+}
+
+// newEthCallRequest encodes "args" for the EthCall and EstimateGas queries.
+func (nc *NibiruSDK) newEthCallRequest(args evm.JsonTxArgs) (*evm.EthCallRequest, error) {
+	argsBz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	return &evm.EthCallRequest{
+		Args:    argsBz,
+		GasCap:  srvconfig.DefaultEthCallGasLimit,
+		ChainId: nc.EthChainID().Int64(),
+	}, nil
+}
+
+// EthEstimateGas returns the gas limit an EVM tx with "args" needs, as given
+// by "eth_estimateGas".
+func (nc *NibiruSDK) EthEstimateGas(args evm.JsonTxArgs) (uint64, error) {
+	req, err := nc.newEthCallRequest(args)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := nc.Querier.EVM.EstimateGas(context.Background(), req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to estimate gas: %w", err)
+	}
+	return resp.Gas, nil
+}
+
+// EthCall executes an EVM call with "args" against the latest state without
+// sending a tx, as in "eth_call".
+func (nc *NibiruSDK) EthCall(args evm.JsonTxArgs) (*evm.MsgEthereumTxResponse, error) {
+	req, err := nc.newEthCallRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := nc.Querier.EVM.EthCall(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Failed() {
+		return resp, fmt.Errorf("eth call failed: %s", resp.VmError)
+	}
+	return resp, nil
+}
+
+// BuildEthTx returns an unsigned [evm.MsgEthereumTx] from "args". "args.From"
+// is required. The chain ID, nonce, gas limit and gas price are filled in
+// when unset, with the gas price set to the base fee.
+func (nc *NibiruSDK) BuildEthTx(args evm.JsonTxArgs) (*evm.MsgEthereumTx, error) {
+	if args.From == nil {
+		return nil, fmt.Errorf("eth tx args: missing sender address")
+	}
+	if args.ChainID == nil {
+		args.ChainID = (*hexutil.Big)(nc.EthChainID())
+	}
+	if args.Nonce == nil {
+		nonce, err := nc.EthNonce(*args.From)
+		if err != nil {
+			return nil, err
+		}
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	}
+	if args.GasPrice == nil && args.MaxFeePerGas == nil {
+		baseFeeWei, err := nc.EthBaseFeeWei()
+		if err != nil {
+			return nil, err
+		}
+		args.GasPrice = (*hexutil.Big)(baseFeeWei)
+	}
+	if args.Gas == nil {
+		gas, err := nc.EthEstimateGas(args)
+		if err != nil {
+			return nil, err
+		}
+		args.Gas = (*hexutil.Uint64)(&gas)
+	}
+
+	msg := args.ToMsgEthTx()
+	if msg == nil {
+		return nil, fmt.Errorf("eth tx args: invalid tx data")
+	}
+	return msg, nil
+}
+
+// SignEthTx signs "msg" with the key of its sender in [NibiruSDK.Keyring].
+// The key must be an eth_secp256k1 key, such as one added with
+// [AddSignerToKeyringEthSecp256k1].
+func (nc *NibiruSDK) SignEthTx(msg *evm.MsgEthereumTx) error {
+	from := msg.GetFrom()
+	record, err := nc.Keyring.KeyByAddress(from)
+	if err != nil {
+		return fmt.Errorf("no key for eth tx sender %s: %w", msg.From, err)
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if _, ok := pubKey.(*ethsecp256k1.PubKey); !ok {
+		return fmt.Errorf(
+			"key %q of eth tx sender %s has type %s, expected %s",
+			record.Name, msg.From, pubKey.Type(), ethsecp256k1.KeyType,
+		)
+	}
+	ethSigner := gethcore.LatestSignerForChainID(nc.EthChainID())
+	return msg.Sign(ethSigner, nc.Keyring)
+}
+
+// BroadcastEthTx wraps the signed "msg" in a Cosmos tx with the
+// [evm.ExtensionOptionsEthereumTx] option and broadcasts it. The Cosmos tx
+// hash is in the response and the Ethereum tx hash is "msg.Hash".
+func (nc *NibiruSDK) BroadcastEthTx(msg *evm.MsgEthereumTx) (*sdk.TxResponse, error) {
+	txConfig := nc.EncCfg.TxConfig
+	tx, err := msg.BuildTx(txConfig.NewTxBuilder(), evm.EVMBankDenom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build eth tx: %w", err)
+	}
+	txBytes, err := txConfig.TxEncoder()(tx)
+	if err != nil {
+		return nil, err
+	}
+	broadcaster := BroadcasterTmRpc{RPC: nc.CometRPC}
+	return broadcaster.BroadcastTxSync(txBytes)
+}
+
+// SendEthTx builds, signs and broadcasts an EVM tx with "args". See
+// [NibiruSDK.BuildEthTx] for the fields filled in by default.
+func (nc *NibiruSDK) SendEthTx(
+	args evm.JsonTxArgs,
+) (msg *evm.MsgEthereumTx, txResp *sdk.TxResponse, err error) {
+	msg, err = nc.BuildEthTx(args)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = nc.SignEthTx(msg); err != nil {
+		return nil, nil, err
+	}
+	txResp, err = nc.BroadcastEthTx(msg)
+	return msg, txResp, err
+}
+
+// DeployContract deploys the contract with creation code "bytecode" from
+// "from", passing the constructor arguments "args" packed with
+// "contractABI". The contract address is known before the tx is included.
+func (nc *NibiruSDK) DeployContract(
+	from gethcommon.Address,
+	contractABI *gethabi.ABI,
+	bytecode []byte,
+	args ...any,
+) (contractAddr gethcommon.Address, txResp *sdk.TxResponse, err error) {
+	packedArgs, err := contractABI.Pack("", args...)
+	if err != nil {
+		return contractAddr, nil, fmt.Errorf("failed to pack constructor args: %w", err)
+	}
+	input := append(append([]byte{}, bytecode...), packedArgs...)
+
+	nonce, err := nc.EthNonce(from)
+	if err != nil {
+		return contractAddr, nil, err
+	}
+	_, txResp, err = nc.SendEthTx(evm.JsonTxArgs{
+		From:  &from,
+		Nonce: (*hexutil.Uint64)(&nonce),
+		Input: (*hexutil.Bytes)(&input),
+	})
+	if err != nil {
+		return contractAddr, nil, err
+	}
+	return crypto.CreateAddress(from, nonce), txResp, nil
+}
+
+// CallContract sends an EVM tx from "from" that calls "method" of the
+// contract at "contract" with "args" packed with "contractABI".
+func (nc *NibiruSDK) CallContract(
+	from, contract gethcommon.Address,
+	contractABI *gethabi.ABI,
+	method string,
+	args ...any,
+) (msg *evm.MsgEthereumTx, txResp *sdk.TxResponse, err error) {
+	input, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to pack args of %s: %w", method, err)
+	}
+	return nc.SendEthTx(evm.JsonTxArgs{
+		From:  &from,
+		To:    &contract,
+		Input: (*hexutil.Bytes)(&input),
+	})
+}
+
+// QueryContract calls the read-only "method" of the contract at "contract"
+// with "args" and returns its unpacked outputs.
+func (nc *NibiruSDK) QueryContract(
+	from, contract gethcommon.Address,
+	contractABI *gethabi.ABI,
+	method string,
+	args ...any,
+) ([]any, error) {
+	input, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack args of %s: %w", method, err)
+	}
+	resp, err := nc.EthCall(evm.JsonTxArgs{
+		From:  &from,
+		To:    &contract,
+		Input: (*hexutil.Bytes)(&input),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return contractABI.Unpack(method, resp.Ret)
+}
+
+// EthReceipt is the outcome of an EVM tx, decoded from the events of the
+// Cosmos tx that carried it.
+type EthReceipt struct {
+	// EthHash is the Ethereum tx hash.
+	EthHash gethcommon.Hash
+	// TxHash is the hex-encoded hash of the Cosmos tx.
+	TxHash      string
+	BlockHeight int64
+	GasUsed     uint64
+	// ContractAddress is set when the tx deployed a contract.
+	ContractAddress *gethcommon.Address
+	// VmError is the EVM execution error. It is empty when the tx succeeded.
+	VmError string
+	Logs    []*gethcore.Log
+}
+
+// Failed reports whether the EVM execution of the tx reverted or errored.
+func (r EthReceipt) Failed() bool {
+	return r.VmError != ""
+}
+
+// NewEthReceipt decodes the receipt of the EVM tx in "txResult". It errors if
+// the tx has no EVM execution, for example because it failed in the ante
+// handler.
+func NewEthReceipt(txResult *TxResult) (*EthReceipt, error) {
+	receipt := &EthReceipt{
+		TxHash:      TxHashBytesToHex(txResult.Hash),
+		BlockHeight: txResult.Height,
+	}
+	var found bool
+	for _, event := range txResult.TypedEvents {
+		switch e := event.(type) {
+		case *evm.EventEthereumTx:
+			found = true
+			receipt.EthHash = gethcommon.HexToHash(e.EthHash)
+			receipt.VmError = e.VmError
+			gasUsed, err := strconv.ParseUint(e.GasUsed, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid gas used %q: %w", e.GasUsed, err)
+			}
+			receipt.GasUsed = gasUsed
+		case *evm.EventTxLog:
+			receipt.Logs = append(receipt.Logs, evm.LogsToEthereum(e.Logs)...)
+		case *evm.EventContractDeployed:
+			contractAddr := gethcommon.HexToAddress(e.ContractAddr)
+			receipt.ContractAddress = &contractAddr
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf(
+			"tx %s has no %s event: code %d, log: %s",
+			receipt.TxHash, evm.TypeUrlEventEthereumTx, txResult.TxResult.Code, txResult.TxResult.Log,
+		)
+	}
+	return receipt, nil
+}
+
+// WaitForEthReceipt waits for the Cosmos tx with hash "txHashHex" like
+// [NibiruSDK.WaitForTx] and returns the receipt of the EVM tx it carries.
+func (nc *NibiruSDK) WaitForEthReceipt(
+	ctx context.Context, txHashHex string,
+) (*EthReceipt, error) {
+	txResult, err := nc.WaitForTx(ctx, txHashHex)
+	if err != nil {
+		return nil, err
+	}
+	return NewEthReceipt(txResult)
+}
+
+// DecodeEthLog decodes "log" as an event of "contractABI". It returns the
+// event name and its arguments, indexed and non-indexed, by name.
+func DecodeEthLog(
+	contractABI *gethabi.ABI, log *gethcore.Log,
+) (eventName string, args map[string]any, err error) {
+	if len(log.Topics) == 0 {
+		return "", nil, fmt.Errorf("log of %s has no topics", log.Address.Hex())
+	}
+	event, err := contractABI.EventByID(log.Topics[0])
+	if err != nil {
+		return "", nil, err
+	}
+
+	args = make(map[string]any)
+	if len(log.Data) > 0 {
+		if err := contractABI.UnpackIntoMap(args, event.Name, log.Data); err != nil {
+			return "", nil, fmt.Errorf("failed to unpack data of event %s: %w", event.Name, err)
+		}
+	}
+	var indexed gethabi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := gethabi.ParseTopicsIntoMap(args, indexed, log.Topics[1:]); err != nil {
+		return "", nil, fmt.Errorf("failed to parse topics of event %s: %w", event.Name, err)
+	}
+	return event.Name, args, nil
+}
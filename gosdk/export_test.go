@@ -3,11 +3,13 @@ package gosdk
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"google.golang.org/grpc"
 
 	"github.com/NibiruChain/nibiru/v2/app"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/genesis"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testnetwork"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
 
 	cmtcfg "github.com/cometbft/cometbft/config"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
@@ -22,7 +24,15 @@ type Blockchain struct {
 
 func CreateBlockchain(t *testing.T) (nibiru Blockchain, err error) {
 	EnsureNibiruPrefix()
-	genState := genesis.NewTestGenesisState(app.MakeEncodingConfig().Codec)
+	encCfg := app.MakeEncodingConfig()
+	genState := genesis.NewTestGenesisState(encCfg.Codec)
+
+	// Lower the FunToken creation fee to what the test accounts can pay.
+	var evmGenState evm.GenesisState
+	encCfg.Codec.MustUnmarshalJSON(genState[evm.ModuleName], &evmGenState)
+	evmGenState.Params.CreateFuntokenFee = sdkmath.NewInt(1_000_000)
+	genState[evm.ModuleName] = encCfg.Codec.MustMarshalJSON(&evmGenState)
+
 	cliCfg := testnetwork.BuildNetworkConfig(genState)
 	cfg := &cliCfg
 	cfg.NumValidators = 1
@@ -0,0 +1,121 @@
+package gosdk
+
+import (
+	"context"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdkclienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+)
+
+// DefaultGasAdjustment is the factor applied to the gas used in simulation to
+// get the gas limit of a transaction. The margin covers state changes between
+// the simulation and the block that includes the transaction. For example, a
+// bank send that creates the recipient account, or that is the first tx of
+// its block, uses about a third more gas than the same send simulated after
+// the account exists.
+const DefaultGasAdjustment = 1.5
+
+// GasConfig sets how the gas limit and fee of a transaction are chosen. The
+// zero value simulates the transaction, applies [DefaultGasAdjustment], and
+// pays the minimum gas prices of the node.
+type GasConfig struct {
+	// GasLimit, when positive, is used as the gas limit instead of simulating
+	// the transaction.
+	GasLimit uint64
+	// GasAdjustment multiplies the gas used in simulation to give the gas
+	// limit. Zero means [DefaultGasAdjustment].
+	GasAdjustment float64
+	// GasPrices is the price per unit of gas used to compute the fee. When
+	// empty, the minimum gas prices of the node are used.
+	GasPrices sdk.DecCoins
+	// Fees, when set, is used as the fee instead of computing it from the gas
+	// prices.
+	Fees sdk.Coins
+}
+
+// gasAdjustment returns the configured gas adjustment or the default.
+func (cfg GasConfig) gasAdjustment() float64 {
+	if cfg.GasAdjustment <= 0 {
+		return DefaultGasAdjustment
+	}
+	return cfg.GasAdjustment
+}
+
+// GasEstimate is the gas limit and fee chosen for a transaction.
+type GasEstimate struct {
+	// GasUsed is the gas used in simulation. It is zero when the gas limit
+	// was set in the [GasConfig].
+	GasUsed  uint64
+	GasLimit uint64
+	Fee      sdk.Coins
+}
+
+// EstimateGas picks the gas limit and fee for "msgs" according to the
+// [GasConfig] of "args". Unless a gas limit is configured, the transaction is
+// simulated through the Simulate method of the tx gRPC service and the gas
+// used is multiplied by the gas adjustment.
+func EstimateGas(
+	args BroadcastArgs,
+	txFactory sdkclienttx.Factory,
+	msgs ...sdk.Msg,
+) (estimate GasEstimate, err error) {
+	cfg := args.GasConfig
+
+	estimate.GasLimit = cfg.GasLimit
+	if estimate.GasLimit == 0 {
+		txFactory = txFactory.
+			WithSimulateAndExecute(true).
+			WithGasAdjustment(cfg.gasAdjustment())
+		simRes, adjustedGas, err := sdkclienttx.CalculateGas(args.grpcConn, txFactory, msgs...)
+		if err != nil {
+			return estimate, fmt.Errorf("failed to simulate tx: %w", err)
+		}
+		estimate.GasUsed = simRes.GasInfo.GasUsed
+		estimate.GasLimit = adjustedGas
+	}
+
+	estimate.Fee = cfg.Fees
+	if estimate.Fee.Empty() {
+		gasPrices := cfg.GasPrices
+		if gasPrices.Empty() {
+			gasPrices, err = QueryMinGasPrices(args.grpcConn)
+			if err != nil {
+				return estimate, err
+			}
+		}
+		estimate.Fee = FeeForGas(gasPrices, estimate.GasLimit)
+	}
+	return estimate, nil
+}
+
+// FeeForGas returns the fee that pays "gasLimit" units of gas at "gasPrices",
+// rounding each amount up.
+func FeeForGas(gasPrices sdk.DecCoins, gasLimit uint64) sdk.Coins {
+	gas := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gasLimit))
+	fee := make(sdk.Coins, 0, len(gasPrices))
+	for _, gasPrice := range gasPrices {
+		amount := gasPrice.Amount.Mul(gas).Ceil().RoundInt()
+		fee = append(fee, sdk.NewCoin(gasPrice.Denom, amount))
+	}
+	return sdk.NewCoins(fee...)
+}
+
+// QueryMinGasPrices returns the minimum gas prices configured on the node
+// behind "grpcConn".
+func QueryMinGasPrices(grpcConn *grpc.ClientConn) (sdk.DecCoins, error) {
+	resp, err := nodeservice.NewServiceClient(grpcConn).Config(
+		context.Background(), &nodeservice.ConfigRequest{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query node min gas prices: %w", err)
+	}
+	gasPrices, err := sdk.ParseDecCoins(resp.MinimumGasPrice)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node min gas prices %q: %w", resp.MinimumGasPrice, err)
+	}
+	return gasPrices, nil
+}
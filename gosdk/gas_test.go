@@ -0,0 +1,33 @@
+package gosdk_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/gosdk"
+)
+
+func TestFeeForGas(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		gasPrices string
+		gasLimit  uint64
+		want      string
+	}{
+		{"whole amount", "0.025unibi", 200_000, "5000unibi"},
+		{"rounds up", "0.000006unibi", 100_001, "1unibi"},
+		{"multiple denoms", "0.5uatom,0.025unibi", 1_000, "500uatom,25unibi"},
+		{"no gas prices", "", 100_000, ""},
+		{"zero gas price", "0unibi", 100_000, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			gasPrices, err := sdk.ParseDecCoins(tc.gasPrices)
+			require.NoError(t, err)
+			want, err := sdk.ParseCoinsNormalized(tc.want)
+			require.NoError(t, err)
+			require.Equal(t, want.String(), gosdk.FeeForGas(gasPrices, tc.gasLimit).String())
+		})
+	}
+}
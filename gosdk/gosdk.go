@@ -10,6 +10,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/app"
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	ethhd "github.com/NibiruChain/nibiru/v2/eth/crypto/hd"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,6 +25,12 @@ type NibiruSDK struct {
 	CometRPC         cmtrpcclient.Client
 	AccountRetriever authtypes.AccountRetriever
 	GrpcClient       *grpc.ClientConn
+	// GasConfig sets how the gas limit and fee of broadcasted transactions
+	// are chosen. The zero value simulates each transaction.
+	GasConfig GasConfig
+	// Sequences tracks the account sequences of signers for
+	// [NibiruSDK.BroadcastMsgsManagedSeq].
+	Sequences *SequenceManager
 }
 
 func NewNibiruSdk(
@@ -32,8 +39,8 @@ func NewNibiruSdk(
 	rpcEndpt string,
 ) (NibiruSDK, error) {
 	EnsureNibiruPrefix()
-	encCfg := app.MakeEncodingConfig()
-	keyring := keyring.NewInMemory(encCfg.Codec)
+	encCfg := MakeEncodingConfig()
+	keyring := keyring.NewInMemory(encCfg.Codec, ethhd.EthSecp256k1Option())
 	queryClient, err := NewQuerier(grpcConn)
 	if err != nil {
 		return NibiruSDK{}, err
@@ -50,6 +57,9 @@ func NewNibiruSdk(
 		CometRPC:         cometRpc,
 		AccountRetriever: authtypes.AccountRetriever{},
 		GrpcClient:       grpcConn,
+		Sequences: NewSequenceManager(func(address string) (AccountNumbers, error) {
+			return GetAccountNumbers(address, grpcConn, encCfg)
+		}),
 	}, err
 }
 
@@ -1,20 +1,35 @@
 package gosdk_test
 
 import (
+	"context"
 	"fmt"
+	"math/big"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
 
+	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/gosdk"
 	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testnetwork"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	sudotypes "github.com/NibiruChain/nibiru/v2/x/sudo/types"
+	tftypes "github.com/NibiruChain/nibiru/v2/x/tokenfactory/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 )
 
 // --------------------------------------------------
@@ -89,6 +104,42 @@ func (s *TestSuite) TestNewNibiruSdk() {
 		}
 		s.DoTestBroadcastMsgsGrpc()
 	})
+	s.Run("DoTestEstimateGas", func() {
+		for t := 0; t < 4; t++ {
+			s.NoError(s.network.WaitForNextBlock())
+		}
+		s.DoTestEstimateGas()
+	})
+	s.Run("DoTestBroadcastMsgsGasConfig", func() {
+		for t := 0; t < 4; t++ {
+			s.NoError(s.network.WaitForNextBlock())
+		}
+		s.DoTestBroadcastMsgsGasConfig()
+	})
+	s.Run("DoTestBroadcastMsgsManagedSeq", func() {
+		for t := 0; t < 4; t++ {
+			s.NoError(s.network.WaitForNextBlock())
+		}
+		s.DoTestBroadcastMsgsManagedSeq()
+	})
+	s.Run("DoTestEthTx", func() {
+		for t := 0; t < 4; t++ {
+			s.NoError(s.network.WaitForNextBlock())
+		}
+		s.DoTestEthTx()
+	})
+	s.Run("DoTestSubscribeTypedEvents", func() {
+		for t := 0; t < 4; t++ {
+			s.NoError(s.network.WaitForNextBlock())
+		}
+		s.DoTestSubscribeTypedEvents()
+	})
+	s.Run("DoTestQueryLookups", func() {
+		for t := 0; t < 4; t++ {
+			s.NoError(s.network.WaitForNextBlock())
+		}
+		s.DoTestQueryLookups()
+	})
 	s.Run("DoTestNewQueryClient", func() {
 		_, err := gosdk.NewQuerier(s.grpcConn)
 		s.NoError(err)
@@ -141,6 +192,318 @@ func (s *TestSuite) DoTestBroadcastMsgsGrpc() (txHashHex string) {
 	return txHashHex
 }
 
+func (s *TestSuite) DoTestEstimateGas() {
+	from, _, _, msgSend := s.msgSendVars()
+
+	estimate, err := s.nibiruSdk.EstimateGas(from, msgSend)
+	s.Require().NoError(err)
+	s.Positive(estimate.GasUsed)
+	s.EqualValues(uint64(gosdk.DefaultGasAdjustment*float64(estimate.GasUsed)), estimate.GasLimit)
+
+	minGasPrices, err := sdk.ParseDecCoins(s.cfg.MinGasPrices)
+	s.Require().NoError(err)
+	s.Equal(gosdk.FeeForGas(minGasPrices, estimate.GasLimit), estimate.Fee)
+
+	s.T().Log("a configured gas limit and fee skip simulation")
+	s.nibiruSdk.GasConfig = gosdk.GasConfig{
+		GasLimit: 123_456,
+		Fees:     sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 5_000)),
+	}
+	defer func() { s.nibiruSdk.GasConfig = gosdk.GasConfig{} }()
+	estimate, err = s.nibiruSdk.EstimateGas(from, msgSend)
+	s.Require().NoError(err)
+	s.Zero(estimate.GasUsed)
+	s.EqualValues(123_456, estimate.GasLimit)
+	s.Equal(s.nibiruSdk.GasConfig.Fees, estimate.Fee)
+
+	s.T().Log("simulation errors are returned before broadcasting")
+	s.nibiruSdk.GasConfig = gosdk.GasConfig{}
+	unowned := sdk.NewCoins(sdk.NewInt64Coin("unotowned", 1))
+	_, err = s.nibiruSdk.EstimateGas(
+		from, banktypes.NewMsgSend(from, testutil.AccAddress(), unowned),
+	)
+	s.ErrorContains(err, "failed to simulate tx")
+}
+
+func (s *TestSuite) DoTestBroadcastMsgsGasConfig() {
+	from, _, _, msgSend := s.msgSendVars()
+	gasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(denoms.NIBI, sdk.MustNewDecFromStr("0.025")))
+	s.nibiruSdk.GasConfig = gosdk.GasConfig{
+		GasAdjustment: 2,
+		GasPrices:     gasPrices,
+	}
+	defer func() { s.nibiruSdk.GasConfig = gosdk.GasConfig{} }()
+
+	estimate, err := s.nibiruSdk.EstimateGas(from, msgSend)
+	s.Require().NoError(err)
+	s.EqualValues(uint64(2*float64(estimate.GasUsed)), estimate.GasLimit)
+
+	txResp, err := s.nibiruSdk.BroadcastMsgs(from, msgSend)
+	s.Require().NoError(err)
+	txHashHex := s.AssertTxResponseSuccess(txResp)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	resultTx, err := s.nibiruSdk.TxByHash(txHashHex)
+	s.Require().NoError(err)
+	s.EqualValues(0, resultTx.TxResult.Code, resultTx.TxResult.Log)
+	s.EqualValues(estimate.GasLimit, resultTx.TxResult.GasWanted)
+	s.LessOrEqual(resultTx.TxResult.GasUsed, resultTx.TxResult.GasWanted)
+
+	tx, err := s.nibiruSdk.EncCfg.TxConfig.TxDecoder()(resultTx.Tx)
+	s.Require().NoError(err)
+	feeTx, ok := tx.(sdk.FeeTx)
+	s.Require().True(ok)
+	s.Equal(gosdk.FeeForGas(gasPrices, estimate.GasLimit), feeTx.GetFee())
+}
+
+func (s *TestSuite) DoTestBroadcastMsgsManagedSeq() {
+	from, _, _, msgSend := s.msgSendVars()
+
+	s.T().Log("concurrent txs from one signer all pass CheckTx")
+	numTxs := 4
+	txHashes := make([]string, numTxs)
+	var wg sync.WaitGroup
+	for i := range txHashes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			txResp, err := s.nibiruSdk.BroadcastMsgsManagedSeq(from, msgSend)
+			s.NoError(err)
+			txHashes[i] = s.AssertTxResponseSuccess(txResp)
+		}()
+	}
+	wg.Wait()
+
+	s.T().Log("WaitForTx polls until each tx is included")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	for _, txHash := range txHashes {
+		txResult, err := s.nibiruSdk.WaitForTx(ctx, txHash)
+		s.Require().NoError(err)
+		s.EqualValues(0, txResult.TxResult.Code, txResult.TxResult.Log)
+		s.Positive(txResult.Height)
+	}
+
+	s.T().Log("a tx sent with a hand-picked sequence forces a resync")
+	nums, err := s.nibiruSdk.GetAccountNumbers(from.String())
+	s.Require().NoError(err)
+	txResp, err := s.nibiruSdk.BroadcastMsgsWithSeq(from, nums.Sequence, msgSend)
+	s.Require().NoError(err)
+	s.AssertTxResponseSuccess(txResp)
+
+	msgCreateDenom := &tftypes.MsgCreateDenom{Sender: from.String(), Subdenom: "gosdk"}
+	txResp, err = s.nibiruSdk.BroadcastMsgsManagedSeq(from, msgCreateDenom)
+	s.Require().NoError(err)
+	txHash := s.AssertTxResponseSuccess(txResp)
+
+	s.T().Log("WaitForTx decodes typed events through a subscription")
+	s.Require().NoError(s.nibiruSdk.CometRPC.Start())
+	defer func() { s.NoError(s.nibiruSdk.CometRPC.Stop()) }()
+	txResult, err := s.nibiruSdk.WaitForTx(ctx, txHash)
+	s.Require().NoError(err)
+	s.EqualValues(0, txResult.TxResult.Code, txResult.TxResult.Log)
+	var eventCreateDenom *tftypes.EventCreateDenom
+	for _, event := range txResult.TypedEvents {
+		if e, ok := event.(*tftypes.EventCreateDenom); ok {
+			eventCreateDenom = e
+		}
+	}
+	s.Require().NotNil(eventCreateDenom, "typed events: %v", txResult.TypedEvents)
+	s.Equal(tftypes.TFDenom{Creator: from.String(), Subdenom: "gosdk"}.Denom().String(), eventCreateDenom.Denom)
+
+	s.T().Log("WaitForTx stops when the context is done")
+	shortCtx, shortCancel := context.WithTimeout(context.Background(), time.Second)
+	defer shortCancel()
+	_, err = s.nibiruSdk.WaitForTx(shortCtx, strings.Repeat("AB", 32))
+	s.ErrorIs(err, context.DeadlineExceeded)
+}
+
+// newFundedEthSdk returns a copy of the SDK whose keyring holds a single
+// eth_secp256k1 key funded by the validator.
+func (s *TestSuite) newFundedEthSdk(
+	ctx context.Context,
+) (ethSdk gosdk.NibiruSDK, nibiAddr sdk.AccAddress) {
+	s.T().Log("fund an eth_secp256k1 key from the validator")
+	ethSdk = *s.nibiruSdk
+	ethSdk.Keyring = gosdk.NewKeyring()
+	nibiAddr, err := gosdk.AddSignerToKeyringEthSecp256k1(ethSdk.Keyring, "", "evm-sender")
+	s.Require().NoError(err)
+	funds := sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 100_000_000))
+	txResp, err := s.nibiruSdk.BroadcastMsgsManagedSeq(
+		s.val.Address, banktypes.NewMsgSend(s.val.Address, nibiAddr, funds),
+	)
+	s.Require().NoError(err)
+	_, err = s.nibiruSdk.WaitForTx(ctx, s.AssertTxResponseSuccess(txResp))
+	s.Require().NoError(err)
+	return ethSdk, nibiAddr
+}
+
+func (s *TestSuite) DoTestEthTx() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	ethSdk, nibiAddr := s.newFundedEthSdk(ctx)
+	sender := eth.NibiruAddrToEthAddr(nibiAddr)
+
+	s.T().Log("deploy a contract")
+	contract := embeds.SmartContract_TestERC20
+	contractAddr, txResp, err := ethSdk.DeployContract(sender, contract.ABI, contract.Bytecode)
+	s.Require().NoError(err)
+	receipt, err := ethSdk.WaitForEthReceipt(ctx, s.AssertTxResponseSuccess(txResp))
+	s.Require().NoError(err)
+	s.False(receipt.Failed(), receipt.VmError)
+	s.Positive(receipt.GasUsed)
+	s.Require().NotNil(receipt.ContractAddress)
+	s.Equal(contractAddr, *receipt.ContractAddress)
+
+	s.T().Log("call the contract and decode its logs")
+	to := evmtest.NewEthPrivAcc().EthAddr
+	amount := big.NewInt(1_000)
+	ethTx, txResp, err := ethSdk.CallContract(sender, contractAddr, contract.ABI, "transfer", to, amount)
+	s.Require().NoError(err)
+	receipt, err = ethSdk.WaitForEthReceipt(ctx, s.AssertTxResponseSuccess(txResp))
+	s.Require().NoError(err)
+	s.False(receipt.Failed(), receipt.VmError)
+	s.Equal(ethTx.Hash, receipt.EthHash.Hex())
+	s.Nil(receipt.ContractAddress)
+	s.Require().Len(receipt.Logs, 1)
+	eventName, eventArgs, err := gosdk.DecodeEthLog(contract.ABI, receipt.Logs[0])
+	s.Require().NoError(err)
+	s.Equal("Transfer", eventName)
+	s.Equal(sender, eventArgs["from"])
+	s.Equal(to, eventArgs["to"])
+	s.Equal(amount, eventArgs["value"])
+
+	out, err := ethSdk.QueryContract(sender, contractAddr, contract.ABI, "balanceOf", to)
+	s.Require().NoError(err)
+	s.Equal([]any{amount}, out)
+
+	nonce, err := ethSdk.EthNonce(sender)
+	s.Require().NoError(err)
+	s.EqualValues(2, nonce)
+
+	s.T().Log("secp256k1 keys can't sign EVM txs")
+	valEthAddr := eth.NibiruAddrToEthAddr(s.val.Address)
+	_, _, err = s.nibiruSdk.SendEthTx(evm.JsonTxArgs{From: &valEthAddr, To: &to})
+	s.ErrorContains(err, "expected eth_secp256k1")
+}
+
+func (s *TestSuite) DoTestSubscribeTypedEvents() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	from := s.val.Address
+
+	s.T().Log("events of txs sent after subscribing arrive in order")
+	subCtx, subCancel := context.WithCancel(ctx)
+	eventCh, errCh, err := gosdk.SubscribeTypedEvents[*tftypes.EventCreateDenom](
+		subCtx, s.nibiruSdk, gosdk.SubscribeOptions{},
+	)
+	s.Require().NoError(err)
+	var txHashes []string
+	for _, subdenom := range []string{"sub0", "sub1"} {
+		txResp, err := s.nibiruSdk.BroadcastMsgsManagedSeq(
+			from, &tftypes.MsgCreateDenom{Sender: from.String(), Subdenom: subdenom},
+		)
+		s.Require().NoError(err)
+		txHashes = append(txHashes, s.AssertTxResponseSuccess(txResp))
+	}
+	var events []gosdk.TypedEvent[*tftypes.EventCreateDenom]
+	for len(events) < 2 {
+		select {
+		case event := <-eventCh:
+			events = append(events, event)
+		case err := <-errCh:
+			s.FailNow("subscription error", err)
+		case <-ctx.Done():
+			s.FailNow("timed out waiting for events", "got %v", events)
+		}
+	}
+	for i, event := range events {
+		subdenom := fmt.Sprintf("sub%d", i)
+		s.Equal(tftypes.TFDenom{Creator: from.String(), Subdenom: subdenom}.Denom().String(), event.Event.Denom)
+		s.Equal(txHashes[i], event.TxHash)
+		s.Positive(event.Height)
+	}
+
+	s.T().Log("the channels are closed when the context is done")
+	subCancel()
+	for range eventCh {
+	}
+	for range errCh {
+	}
+
+	s.T().Log("a new subscription resumes from a past height through the event subscription")
+	wsSdk := *s.nibiruSdk
+	wsSdk.CometRPC, err = gosdk.NewRPCClient(s.RPCEndpoint(), "/websocket")
+	s.Require().NoError(err)
+	s.Require().NoError(wsSdk.CometRPC.Start())
+	defer func() { s.NoError(wsSdk.CometRPC.Stop()) }()
+	eventCh, errCh, err = gosdk.SubscribeTypedEvents[*tftypes.EventCreateDenom](
+		ctx, &wsSdk, gosdk.SubscribeOptions{FromHeight: events[0].Height},
+	)
+	s.Require().NoError(err)
+	select {
+	case event := <-eventCh:
+		s.Equal(events[0], event)
+	case err := <-errCh:
+		s.FailNow("subscription error", err)
+	case <-ctx.Done():
+		s.FailNow("timed out waiting for a past event")
+	}
+}
+
+func (s *TestSuite) DoTestQueryLookups() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	querier := s.nibiruSdk.Querier
+
+	s.T().Log("clients of the Cosmos SDK, IBC and Nibiru modules")
+	sudoers, err := querier.Sudo.QuerySudoers(ctx, &sudotypes.QuerySudoersRequest{})
+	s.Require().NoError(err)
+	s.NotEmpty(sudoers.Sudoers.Root)
+	_, err = querier.Auth.Params(ctx, &authtypes.QueryParamsRequest{})
+	s.NoError(err)
+	_, err = querier.Gov.Params(ctx, &govv1.QueryParamsRequest{ParamsType: govv1.ParamDeposit})
+	s.NoError(err)
+	_, err = querier.IBCTransfer.Params(ctx, &ibctransfertypes.QueryParamsRequest{})
+	s.NoError(err)
+	_, err = querier.Feegrant.Allowances(ctx, &feegrant.QueryAllowancesRequest{Grantee: s.val.Address.String()})
+	s.NoError(err)
+
+	s.T().Log("AllBalances includes the ERC20 side of FunTokens")
+	ethSdk, nibiAddr := s.newFundedEthSdk(ctx)
+	sender := eth.NibiruAddrToEthAddr(nibiAddr)
+	contract := embeds.SmartContract_TestERC20
+	erc20Addr, txResp, err := ethSdk.DeployContract(sender, contract.ABI, contract.Bytecode)
+	s.Require().NoError(err)
+	_, err = ethSdk.WaitForEthReceipt(ctx, s.AssertTxResponseSuccess(txResp))
+	s.Require().NoError(err)
+	txResp, err = s.nibiruSdk.BroadcastMsgsManagedSeq(s.val.Address, &evm.MsgCreateFunToken{
+		FromErc20: &eth.EIP55Addr{Address: erc20Addr},
+		Sender:    s.val.Address.String(),
+	})
+	s.Require().NoError(err)
+	txResult, err := s.nibiruSdk.WaitForTx(ctx, s.AssertTxResponseSuccess(txResp))
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, txResult.TxResult.Code, txResult.TxResult.Log)
+
+	balances, err := ethSdk.AllBalances(nibiAddr)
+	s.Require().NoError(err)
+	s.True(balances.Bank.AmountOf(denoms.NIBI).IsPositive(), balances.Bank)
+	s.Require().Len(balances.ERC20, 1)
+	s.Equal(erc20Addr, balances.ERC20[0].FunToken.Erc20Addr.Address)
+	wantSupply := new(big.Int).Mul(big.NewInt(1_000_000), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+	s.Equal(wantSupply, balances.ERC20[0].Balance)
+
+	s.T().Log("DelegationsWithRewards matches each delegation to its rewards")
+	delegations, totalRewards, err := s.nibiruSdk.DelegationsWithRewards(s.val.Address)
+	s.Require().NoError(err)
+	s.Require().Len(delegations, 1)
+	s.Equal(s.val.ValAddress.String(), delegations[0].Delegation.ValidatorAddress)
+	s.True(delegations[0].Balance.Amount.IsPositive())
+	s.Equal(totalRewards, delegations[0].Rewards)
+}
+
 func (s *TestSuite) TearDownSuite() {
 	s.T().Log("tearing down integration test suite")
 	s.network.Cleanup()
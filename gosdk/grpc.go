@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
@@ -14,6 +15,9 @@ import (
 // GetGRPCConnection establishes a connection to a gRPC server using either
 // secure (TLS) or insecure credentials. The function blocks until the connection
 // is established or the specified timeout is reached.
+//
+// Messages are encoded with the gogoproto codec of the app so that responses
+// with custom types, such as "cosmossdk.io/math.Int" fields, decode.
 func GetGRPCConnection(
 	grpcUrl string, grpcInsecure bool, timeoutSeconds int64,
 ) (*grpc.ClientConn, error) {
@@ -27,6 +31,9 @@ func GetGRPCConnection(
 	options := []grpc.DialOption{
 		grpc.WithBlock(),
 		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(
+			codec.NewProtoCodec(MakeEncodingConfig().InterfaceRegistry).GRPCCodec(),
+		)),
 	}
 	timeout := time.Duration(timeoutSeconds) * time.Second
 	ctx, cancel := context.WithTimeout(
@@ -9,11 +9,23 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/v2/app"
+	ethcryptocodec "github.com/NibiruChain/nibiru/v2/eth/crypto/codec"
+	ethhd "github.com/NibiruChain/nibiru/v2/eth/crypto/hd"
 )
 
-// NewKeyring: Creates an empty, in-memory keyring
+// NewKeyring: Creates an empty, in-memory keyring that supports both
+// secp256k1 and eth_secp256k1 keys.
 func NewKeyring() keyring.Keyring {
-	return keyring.NewInMemory(app.MakeEncodingConfig().Codec)
+	return keyring.NewInMemory(MakeEncodingConfig().Codec, ethhd.EthSecp256k1Option())
+}
+
+// MakeEncodingConfig returns the encoding config of the app with the
+// eth_secp256k1 key types registered, which the app does outside of
+// [app.MakeEncodingConfig].
+func MakeEncodingConfig() app.EncodingConfig {
+	encCfg := app.MakeEncodingConfig()
+	ethcryptocodec.RegisterInterfaces(encCfg.InterfaceRegistry)
+	return encCfg
 }
 
 // TODO: Is it necessary to add support for interacting with local file system
@@ -41,11 +53,32 @@ func AddSignerToKeyringSecp256k1(
 ) (sdk.AccAddress, error) {
 	algo := hd.Secp256k1
 	overwrite := true
-	addr, secretMnem, err := sdktestutil.GenerateSaveCoinKey(
+	// The mnemonic is secret, so it's left out of the error.
+	addr, _, err := sdktestutil.GenerateSaveCoinKey(
+		kring, keyName, mnemonic, overwrite, algo,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w : Failed Key Generation for key %s", err, keyName)
+	}
+
+	return addr, err
+}
+
+// AddSignerToKeyringEthSecp256k1 adds an eth_secp256k1 key derived from
+// "mnemonic" to "kring". Such keys sign EVM txs, as in
+// [NibiruSDK.SignEthTx], as well as Cosmos txs. The keyring must support
+// eth_secp256k1, like the ones from [NewKeyring].
+func AddSignerToKeyringEthSecp256k1(
+	kring keyring.Keyring, mnemonic string, keyName string,
+) (sdk.AccAddress, error) {
+	algo := ethhd.EthSecp256k1
+	overwrite := true
+	// The mnemonic is secret, so it's left out of the error.
+	addr, _, err := sdktestutil.GenerateSaveCoinKey(
 		kring, keyName, mnemonic, overwrite, algo,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("%w : Failed Key Generation with mnemonic %s", err, secretMnem)
+		return nil, fmt.Errorf("%w : Failed Key Generation for key %s", err, keyName)
 	}
 
 	return addr, err
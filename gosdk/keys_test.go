@@ -40,6 +40,7 @@ func TestCreateSigner(t *testing.T) {
 
 			if tc.expectErr {
 				require.Error(t, err)
+				require.NotContains(t, err.Error(), tc.mnemonic, "the mnemonic is secret")
 				return
 			}
 
@@ -54,3 +55,20 @@ func TestKeyring(t *testing.T) {
 		_ = gosdk.NewKeyring()
 	})
 }
+
+func TestCreateSignerEthSecp256k1(t *testing.T) {
+	kring := gosdk.NewKeyring()
+	ethAddr, err := gosdk.AddSignerToKeyringEthSecp256k1(kring, LOCALNET_VALIDATOR_MNEMONIC, "eth")
+	require.NoError(t, err)
+
+	record, err := kring.KeyByAddress(ethAddr)
+	require.NoError(t, err)
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, "eth_secp256k1", pubKey.Type())
+
+	cosmosAddr, err := gosdk.AddSignerToKeyringSecp256k1(kring, LOCALNET_VALIDATOR_MNEMONIC, "cosmos")
+	require.NoError(t, err)
+	require.NotEqual(t, cosmosAddr, ethAddr,
+		"the same mnemonic gives different addresses for each algorithm")
+}
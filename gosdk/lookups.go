@@ -0,0 +1,152 @@
+package gosdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+)
+
+// Balances holds every balance of an account.
+type Balances struct {
+	// Bank is the balance of every bank coin, including the bank coin side of
+	// FunToken mappings.
+	Bank sdk.Coins
+	// ERC20 holds the non-zero ERC20 side balances of FunToken mappings.
+	ERC20 []ERC20Balance
+}
+
+// ERC20Balance is the balance of the ERC20 token of a FunToken mapping.
+type ERC20Balance struct {
+	FunToken evm.FunToken
+	Balance  *big.Int
+}
+
+// AllBalances returns the bank balances of "addr" along with its ERC20
+// balances for every FunToken mapping. The ERC20 balances are read with one
+// "balanceOf" call per mapping.
+func (nc *NibiruSDK) AllBalances(addr sdk.AccAddress) (balances Balances, err error) {
+	ctx := context.Background()
+	err = forEachPage(func(pageReq *sdkquery.PageRequest) (*sdkquery.PageResponse, error) {
+		resp, err := nc.Querier.Bank.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+			Address:    addr.String(),
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query bank balances: %w", err)
+		}
+		balances.Bank = balances.Bank.Add(resp.Balances...)
+		return resp.Pagination, nil
+	})
+	if err != nil {
+		return balances, err
+	}
+
+	ethAddr := eth.NibiruAddrToEthAddr(addr)
+	erc20ABI := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI
+	err = forEachPage(func(pageReq *sdkquery.PageRequest) (*sdkquery.PageResponse, error) {
+		resp, err := nc.Querier.EVM.FunTokenMappings(ctx, &evm.QueryFunTokenMappingsRequest{
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query FunToken mappings: %w", err)
+		}
+		for _, funToken := range resp.FunTokens {
+			out, err := nc.QueryContract(
+				ethAddr, funToken.Erc20Addr.Address, erc20ABI, "balanceOf", ethAddr,
+			)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"failed to query ERC20 balance of %s: %w", funToken.Erc20Addr.Hex(), err)
+			}
+			balance, ok := out[0].(*big.Int)
+			if !ok {
+				return nil, fmt.Errorf(
+					"invalid balanceOf output of %s: %v", funToken.Erc20Addr.Hex(), out)
+			}
+			if balance.Sign() > 0 {
+				balances.ERC20 = append(balances.ERC20, ERC20Balance{
+					FunToken: funToken,
+					Balance:  balance,
+				})
+			}
+		}
+		return resp.Pagination, nil
+	})
+	return balances, err
+}
+
+// DelegationWithRewards is a delegation along with its pending staking
+// rewards.
+type DelegationWithRewards struct {
+	stakingtypes.DelegationResponse
+	Rewards sdk.DecCoins
+}
+
+// DelegationsWithRewards returns every delegation of "delegator" with its
+// pending rewards, and the total of those rewards.
+func (nc *NibiruSDK) DelegationsWithRewards(
+	delegator sdk.AccAddress,
+) (delegations []DelegationWithRewards, totalRewards sdk.DecCoins, err error) {
+	ctx := context.Background()
+	err = forEachPage(func(pageReq *sdkquery.PageRequest) (*sdkquery.PageResponse, error) {
+		resp, err := nc.Querier.Staking.DelegatorDelegations(
+			ctx, &stakingtypes.QueryDelegatorDelegationsRequest{
+				DelegatorAddr: delegator.String(),
+				Pagination:    pageReq,
+			})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query delegations: %w", err)
+		}
+		for _, delegation := range resp.DelegationResponses {
+			delegations = append(delegations, DelegationWithRewards{DelegationResponse: delegation})
+		}
+		return resp.Pagination, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rewardsResp, err := nc.Querier.Distribution.DelegationTotalRewards(
+		ctx, &distrtypes.QueryDelegationTotalRewardsRequest{
+			DelegatorAddress: delegator.String(),
+		})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query delegation rewards: %w", err)
+	}
+	rewardsByVal := make(map[string]sdk.DecCoins, len(rewardsResp.Rewards))
+	for _, reward := range rewardsResp.Rewards {
+		rewardsByVal[reward.ValidatorAddress] = reward.Reward
+	}
+	for i := range delegations {
+		delegations[i].Rewards = rewardsByVal[delegations[i].Delegation.ValidatorAddress]
+	}
+	return delegations, rewardsResp.Total, nil
+}
+
+// forEachPage calls "query" with successive page requests until the
+// response has no next key.
+func forEachPage(
+	query func(pageReq *sdkquery.PageRequest) (*sdkquery.PageResponse, error),
+) error {
+	pageReq := &sdkquery.PageRequest{}
+	for {
+		pageResp, err := query(pageReq)
+		if err != nil {
+			return err
+		}
+		if pageResp == nil || len(pageResp.NextKey) == 0 {
+			return nil
+		}
+		pageReq = &sdkquery.PageRequest{Key: pageResp.NextKey}
+	}
+}
@@ -4,6 +4,13 @@ import (
 	"errors"
 
 	wasm "github.com/CosmWasm/wasmd/x/wasm/types"
+	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distribution "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfer "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	"google.golang.org/grpc"
 
 	devgas "github.com/NibiruChain/nibiru/v2/x/devgas/v1/types"
@@ -11,6 +18,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 	inflation "github.com/NibiruChain/nibiru/v2/x/inflation/types"
 	xoracle "github.com/NibiruChain/nibiru/v2/x/oracle/types"
+	sudo "github.com/NibiruChain/nibiru/v2/x/sudo/types"
 	tokenfactory "github.com/NibiruChain/nibiru/v2/x/tokenfactory/types"
 )
 
@@ -21,11 +29,21 @@ type Querier struct {
 	EVM  evm.QueryClient
 	Wasm wasm.QueryClient
 
+	// Cosmos SDK and IBC Modules
+	Auth         auth.QueryClient
+	Bank         bank.QueryClient
+	Distribution distribution.QueryClient
+	Feegrant     feegrant.QueryClient
+	Gov          gov.QueryClient
+	IBCTransfer  ibctransfer.QueryClient
+	Staking      staking.QueryClient
+
 	// Other Modules
 	Devgas       devgas.QueryClient
 	Epoch        epochs.QueryClient
 	Inflation    inflation.QueryClient
 	Oracle       xoracle.QueryClient
+	Sudo         sudo.QueryClient
 	TokenFactory tokenfactory.QueryClient
 }
 
@@ -43,10 +61,19 @@ func NewQuerier(
 		EVM:  evm.NewQueryClient(grpcConn),
 		Wasm: wasm.NewQueryClient(grpcConn),
 
+		Auth:         auth.NewQueryClient(grpcConn),
+		Bank:         bank.NewQueryClient(grpcConn),
+		Distribution: distribution.NewQueryClient(grpcConn),
+		Feegrant:     feegrant.NewQueryClient(grpcConn),
+		Gov:          gov.NewQueryClient(grpcConn),
+		IBCTransfer:  ibctransfer.NewQueryClient(grpcConn),
+		Staking:      staking.NewQueryClient(grpcConn),
+
 		Devgas:       devgas.NewQueryClient(grpcConn),
 		Epoch:        epochs.NewQueryClient(grpcConn),
 		Inflation:    inflation.NewQueryClient(grpcConn),
 		Oracle:       xoracle.NewQueryClient(grpcConn),
+		Sudo:         sudo.NewQueryClient(grpcConn),
 		TokenFactory: tokenfactory.NewQueryClient(grpcConn),
 	}, nil
 }
@@ -0,0 +1,141 @@
+package gosdk
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SequenceManager hands out account sequence numbers to signers that send
+// many txs without waiting for each one to be included in a block. It is safe
+// for concurrent use.
+//
+// Txs from the same signer are signed and broadcast one at a time so that
+// they reach the mempool in sequence order. Txs from different signers don't
+// block each other.
+type SequenceManager struct {
+	getAccountNumbers func(address string) (AccountNumbers, error)
+
+	mu      sync.Mutex
+	signers map[string]*signerSequence
+}
+
+// signerSequence is the sequence state of a single signer.
+type signerSequence struct {
+	mu     sync.Mutex
+	synced bool
+	nums   AccountNumbers
+}
+
+// maxSequenceRetries is the number of times a tx is re-signed after an
+// "account sequence mismatch" error.
+const maxSequenceRetries = 2
+
+// NewSequenceManager returns a [SequenceManager] that reads the account number
+// and on-chain sequence of a signer with "getAccountNumbers", such as
+// [NibiruSDK.GetAccountNumbers].
+func NewSequenceManager(
+	getAccountNumbers func(address string) (AccountNumbers, error),
+) *SequenceManager {
+	return &SequenceManager{
+		getAccountNumbers: getAccountNumbers,
+		signers:           make(map[string]*signerSequence),
+	}
+}
+
+func (m *SequenceManager) signer(addr sdk.AccAddress) *signerSequence {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.signers[addr.String()]
+	if !ok {
+		s = new(signerSequence)
+		m.signers[addr.String()] = s
+	}
+	return s
+}
+
+// Broadcast calls "broadcast" with the account number and next sequence of
+// "from". The sequence is used up when the tx passes CheckTx. On an "account
+// sequence mismatch" error, the sequence is resynced from the chain and the tx
+// is broadcast again. Any other failure marks the signer to be resynced before
+// its next tx, since the node may or may not have accepted the sequence.
+func (m *SequenceManager) Broadcast(
+	from sdk.AccAddress,
+	broadcast func(nums AccountNumbers) (*sdk.TxResponse, error),
+) (txResp *sdk.TxResponse, err error) {
+	s := m.signer(from)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var expectedSeq *uint64
+	for attempt := 0; ; attempt++ {
+		if !s.synced {
+			if s.nums, err = m.getAccountNumbers(from.String()); err != nil {
+				return nil, err
+			}
+			// The node reports the sequence it expects, which counts the txs
+			// of the signer that are in the mempool but not yet in a block.
+			if expectedSeq != nil && *expectedSeq > s.nums.Sequence {
+				s.nums.Sequence = *expectedSeq
+			}
+			s.synced = true
+		}
+
+		txResp, err = broadcast(s.nums)
+		if err == nil && txResp != nil && txResp.Code == 0 {
+			s.nums.Sequence++
+			return txResp, nil
+		}
+
+		s.synced = false
+		var isMismatch bool
+		expectedSeq, isMismatch = parseSequenceMismatch(txResp, err)
+		if !isMismatch || attempt >= maxSequenceRetries {
+			return txResp, err
+		}
+	}
+}
+
+// Reset forgets the sequence of "from" so that it is read from the chain
+// before the next tx.
+func (m *SequenceManager) Reset(from sdk.AccAddress) {
+	s := m.signer(from)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.synced = false
+}
+
+var reSequenceMismatch = regexp.MustCompile(`account sequence mismatch, expected (\d+)`)
+
+// parseSequenceMismatch reports whether a broadcast failed because of a wrong
+// account sequence and, if the error says so, the sequence the node expected.
+func parseSequenceMismatch(
+	txResp *sdk.TxResponse, err error,
+) (expectedSeq *uint64, isMismatch bool) {
+	var log string
+	switch {
+	case err != nil:
+		log = err.Error()
+		isMismatch = errors.Is(err, sdkerrors.ErrWrongSequence) ||
+			strings.Contains(log, "account sequence mismatch")
+	case txResp != nil:
+		log = txResp.RawLog
+		isMismatch = txResp.Codespace == sdkerrors.RootCodespace &&
+			txResp.Code == sdkerrors.ErrWrongSequence.ABCICode()
+	}
+	if !isMismatch {
+		return nil, false
+	}
+
+	if match := reSequenceMismatch.FindStringSubmatch(log); match != nil {
+		if seq, err := strconv.ParseUint(match[1], 10, 64); err == nil {
+			return &seq, true
+		}
+	}
+	return nil, true
+}
@@ -0,0 +1,114 @@
+package gosdk_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/gosdk"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+)
+
+// fakeChain stands in for the account state of a node: it accepts a tx only
+// with the next sequence of the signer.
+type fakeChain struct {
+	mu            sync.Mutex
+	seq           uint64
+	numQueries    int
+	broadcastSeqs []uint64
+}
+
+func (c *fakeChain) GetAccountNumbers(string) (gosdk.AccountNumbers, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.numQueries++
+	return gosdk.AccountNumbers{Number: 7, Sequence: c.seq}, nil
+}
+
+func (c *fakeChain) Broadcast(nums gosdk.AccountNumbers) (*sdk.TxResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.broadcastSeqs = append(c.broadcastSeqs, nums.Sequence)
+	if nums.Sequence != c.seq {
+		return &sdk.TxResponse{
+			Codespace: "sdk",
+			Code:      32,
+			RawLog:    "account sequence mismatch, expected 0, got 0: incorrect account sequence",
+		}, nil
+	}
+	c.seq++
+	return &sdk.TxResponse{}, nil
+}
+
+func TestSequenceManager_Concurrent(t *testing.T) {
+	chain := &fakeChain{seq: 5}
+	seqs := gosdk.NewSequenceManager(chain.GetAccountNumbers)
+	from := testutil.AccAddress()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			txResp, err := seqs.Broadcast(from, chain.Broadcast)
+			require.NoError(t, err)
+			require.Zero(t, txResp.Code)
+		}()
+	}
+	wg.Wait()
+
+	require.EqualValues(t, 25, chain.seq)
+	require.Len(t, chain.broadcastSeqs, 20, "no tx should be re-signed")
+	require.Equal(t, 1, chain.numQueries, "the sequence should be read once")
+}
+
+func TestSequenceManager_Resync(t *testing.T) {
+	chain := &fakeChain{seq: 0}
+	seqs := gosdk.NewSequenceManager(chain.GetAccountNumbers)
+	from := testutil.AccAddress()
+
+	_, err := seqs.Broadcast(from, chain.Broadcast)
+	require.NoError(t, err)
+
+	t.Log("a tx sent outside of the manager causes a mismatch and a resync")
+	chain.seq += 3
+	txResp, err := seqs.Broadcast(from, chain.Broadcast)
+	require.NoError(t, err)
+	require.Zero(t, txResp.Code)
+	require.Equal(t, []uint64{0, 1, 4}, chain.broadcastSeqs)
+	require.Equal(t, 2, chain.numQueries)
+
+	t.Log("the sequence the node expects wins over a stale account query")
+	chain.broadcastSeqs = nil
+	_, err = seqs.Broadcast(from, func(nums gosdk.AccountNumbers) (*sdk.TxResponse, error) {
+		chain.broadcastSeqs = append(chain.broadcastSeqs, nums.Sequence)
+		if nums.Sequence != 9 {
+			return nil, errors.New("account sequence mismatch, expected 9, got 5: incorrect account sequence")
+		}
+		return &sdk.TxResponse{}, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 9}, chain.broadcastSeqs)
+
+	t.Log("other failures are returned and resync the next tx")
+	numQueries := chain.numQueries
+	txResp, err = seqs.Broadcast(from, func(gosdk.AccountNumbers) (*sdk.TxResponse, error) {
+		return &sdk.TxResponse{Codespace: "sdk", Code: 5, RawLog: "insufficient funds"}, nil
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 5, txResp.Code)
+	_, err = seqs.Broadcast(from, chain.Broadcast)
+	require.NoError(t, err)
+	require.Equal(t, numQueries+1, chain.numQueries)
+
+	t.Log("a mismatch that persists is returned after the retries")
+	chain.seq = 100
+	txResp, err = seqs.Broadcast(from, func(nums gosdk.AccountNumbers) (*sdk.TxResponse, error) {
+		return &sdk.TxResponse{Codespace: "sdk", Code: 32, RawLog: "account sequence mismatch"}, nil
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 32, txResp.Code)
+}
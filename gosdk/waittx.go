@@ -0,0 +1,101 @@
+package gosdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	cmtcoretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// WaitForTxPollInterval is how often [NibiruSDK.WaitForTx] queries for the tx
+// when it can't subscribe to CometBFT events.
+var WaitForTxPollInterval = 500 * time.Millisecond
+
+// TxResult is a tx included in a block along with its decoded events.
+type TxResult struct {
+	*cmtcoretypes.ResultTx
+	// TypedEvents holds the typed events emitted by the tx, decoded into their
+	// proto messages, in emission order. Events that are not typed events, such
+	// as the untyped events of the Cosmos SDK modules, are skipped. They are
+	// available in "TxResult.Events".
+	TypedEvents []proto.Message
+}
+
+// WaitForTx blocks until the tx with hash "txHashHex" is included in a block
+// or "ctx" is done. If [NibiruSDK.CometRPC] is running, the tx is awaited
+// through an event subscription. Otherwise, the tx is polled every
+// [WaitForTxPollInterval].
+func (nc *NibiruSDK) WaitForTx(
+	ctx context.Context, txHashHex string,
+) (*TxResult, error) {
+	txHashBz, err := TxHashHexToBytes(txHashHex)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventCh <-chan cmtcoretypes.ResultEvent
+	if nc.CometRPC.IsRunning() {
+		subscriber := "gosdk-wait-tx-" + txHashHex
+		query := fmt.Sprintf("%s='%s' AND %s='%X'",
+			cmttypes.EventTypeKey, cmttypes.EventTx, cmttypes.TxHashKey, txHashBz)
+		// A failed subscription, e.g. because the same tx is already awaited,
+		// falls back to polling.
+		if eventCh, err = nc.CometRPC.Subscribe(ctx, subscriber, query); err == nil {
+			defer func() {
+				_ = nc.CometRPC.Unsubscribe(context.Background(), subscriber, query)
+			}()
+		}
+	}
+
+	ticker := time.NewTicker(WaitForTxPollInterval)
+	defer ticker.Stop()
+	for {
+		// The tx is queried even with a subscription in case it was included
+		// before the subscription started.
+		resultTx, err := nc.CometRPC.Tx(ctx, txHashBz, false)
+		switch {
+		case err == nil:
+			return NewTxResult(resultTx), nil
+		case !strings.Contains(err.Error(), "not found"):
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("tx %s not included: %w", txHashHex, ctx.Err())
+		case <-ticker.C:
+		case event, ok := <-eventCh:
+			if !ok {
+				eventCh = nil
+				continue
+			}
+			if data, isTx := event.Data.(cmttypes.EventDataTx); isTx {
+				return NewTxResult(&cmtcoretypes.ResultTx{
+					Hash:     txHashBz,
+					Height:   data.Height,
+					Index:    data.Index,
+					TxResult: data.Result,
+					Tx:       data.Tx,
+				}), nil
+			}
+		}
+	}
+}
+
+// NewTxResult decodes the typed events of "resultTx".
+func NewTxResult(resultTx *cmtcoretypes.ResultTx) *TxResult {
+	txResult := &TxResult{ResultTx: resultTx}
+	for _, event := range resultTx.TxResult.Events {
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		txResult.TypedEvents = append(txResult.TypedEvents, typedEvent)
+	}
+	return txResult
+}
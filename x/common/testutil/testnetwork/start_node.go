@@ -79,6 +79,10 @@ func startNodeAndServers(cfg Config, val *Validator) error {
 		// Add the tendermint queries service in the gRPC router.
 		app.RegisterTendermintService(val.ClientCtx)
 
+		// Add the node service, which serves the min gas prices, in the gRPC
+		// router.
+		app.RegisterNodeService(val.ClientCtx)
+
 		val.EthRpc_NET = rpcapi.NewImplNetAPI(val.ClientCtx)
 	}
 
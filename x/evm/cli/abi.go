@@ -0,0 +1,466 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+)
+
+// ContractArtifact is a compiled contract read from a Hardhat or Foundry
+// artifact JSON file.
+type ContractArtifact struct {
+	ABI *gethabi.ABI
+	// Bytecode is the creation bytecode of the contract. It is empty when the
+	// file only holds an ABI.
+	Bytecode []byte
+}
+
+// LoadContractArtifact reads the ABI and creation bytecode of a contract from
+// the JSON file at "path". Hardhat artifacts store the bytecode as a hex
+// string, while Foundry artifacts store it in "bytecode.object". A file
+// holding only a JSON ABI array is read as an artifact without bytecode.
+func LoadContractArtifact(path string) (*ContractArtifact, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	bz = bytes.TrimSpace(bz)
+
+	artifact := new(ContractArtifact)
+	abiJSON := bz
+	var bytecodeJSON json.RawMessage
+	if !bytes.HasPrefix(bz, []byte("[")) {
+		var raw map[string]json.RawMessage
+		if err := json.Unmarshal(bz, &raw); err != nil {
+			return nil, fmt.Errorf("invalid contract artifact %s: %w", path, err)
+		}
+		if abiJSON = raw["abi"]; abiJSON == nil {
+			return nil, fmt.Errorf("contract artifact %s has no \"abi\" field", path)
+		}
+		bytecodeJSON = raw["bytecode"]
+	}
+
+	artifact.ABI = new(gethabi.ABI)
+	if err := artifact.ABI.UnmarshalJSON(abiJSON); err != nil {
+		return nil, fmt.Errorf("invalid ABI in %s: %w", path, err)
+	}
+
+	if len(bytecodeJSON) > 0 {
+		var hardhatBytecode string
+		var foundryBytecode struct {
+			Object string `json:"object"`
+		}
+		if err := json.Unmarshal(bytecodeJSON, &hardhatBytecode); err != nil {
+			if err := json.Unmarshal(bytecodeJSON, &foundryBytecode); err != nil {
+				return nil, fmt.Errorf("invalid bytecode in %s: %w", path, err)
+			}
+			hardhatBytecode = foundryBytecode.Object
+		}
+		artifact.Bytecode = gethcommon.FromHex(hardhatBytecode)
+	}
+	return artifact, nil
+}
+
+// ResolveMethod returns the method of "contractABI" given by its name or
+// signature, such as "transfer" or "transfer(address,uint256)". When
+// "contractABI" is nil, the method is built from the signature instead, which
+// may list its return types after the inputs, as in
+// "balanceOf(address)(uint256)".
+func ResolveMethod(contractABI *gethabi.ABI, nameOrSig string) (gethabi.Method, error) {
+	nameOrSig = strings.ReplaceAll(nameOrSig, " ", "")
+	if contractABI == nil {
+		return ParseMethodSig(nameOrSig)
+	}
+
+	if !strings.Contains(nameOrSig, "(") {
+		method, ok := contractABI.Methods[nameOrSig]
+		if !ok {
+			return gethabi.Method{}, fmt.Errorf("method %q not found in ABI", nameOrSig)
+		}
+		return method, nil
+	}
+	sig, err := ParseMethodSig(nameOrSig)
+	if err != nil {
+		return gethabi.Method{}, err
+	}
+	for _, method := range contractABI.Methods {
+		if method.Sig == sig.Sig {
+			return method, nil
+		}
+	}
+	return gethabi.Method{}, fmt.Errorf("method %s not found in ABI", sig.Sig)
+}
+
+// ParseMethodSig builds a method from a signature such as
+// "transfer(address,uint256)", optionally followed by its return types, as in
+// "balanceOf(address)(uint256)". Tuples are written as parenthesized type
+// lists, like "(address,uint256)[]".
+func ParseMethodSig(sig string) (gethabi.Method, error) {
+	sig = strings.ReplaceAll(sig, " ", "")
+	open := strings.Index(sig, "(")
+	if open <= 0 {
+		return gethabi.Method{}, fmt.Errorf("invalid method signature %q", sig)
+	}
+	name := sig[:open]
+	inputsEnd := matchingParen(sig, open)
+	if inputsEnd < 0 {
+		return gethabi.Method{}, fmt.Errorf("invalid method signature %q: unbalanced parentheses", sig)
+	}
+
+	inputs, err := parseSigArgs(sig[open+1 : inputsEnd])
+	if err != nil {
+		return gethabi.Method{}, fmt.Errorf("invalid method signature %q: %w", sig, err)
+	}
+	var outputs gethabi.Arguments
+	if rest := sig[inputsEnd+1:]; rest != "" {
+		if !strings.HasPrefix(rest, "(") || matchingParen(rest, 0) != len(rest)-1 {
+			return gethabi.Method{}, fmt.Errorf("invalid return types in method signature %q", sig)
+		}
+		if outputs, err = parseSigArgs(rest[1 : len(rest)-1]); err != nil {
+			return gethabi.Method{}, fmt.Errorf("invalid method signature %q: %w", sig, err)
+		}
+	}
+	return gethabi.NewMethod(name, name, gethabi.Function, "", false, false, inputs, outputs), nil
+}
+
+// parseSigArgs parses the comma-separated types of a method signature.
+func parseSigArgs(typeList string) (args gethabi.Arguments, err error) {
+	marshalings, err := parseSigTypes(typeList)
+	if err != nil {
+		return nil, err
+	}
+	for _, marshaling := range marshalings {
+		typ, err := gethabi.NewType(marshaling.Type, "", marshaling.Components)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, gethabi.Argument{Type: typ})
+	}
+	return args, nil
+}
+
+// parseSigTypes parses the comma-separated types of a method signature into
+// the form of ABI JSON, which is how go-ethereum builds tuple types.
+func parseSigTypes(typeList string) (types []gethabi.ArgumentMarshaling, err error) {
+	elems, err := splitTopLevel(typeList)
+	if err != nil {
+		return nil, err
+	}
+	for i, elem := range elems {
+		// Tuple components need names to become struct fields.
+		marshaling := gethabi.ArgumentMarshaling{Name: fmt.Sprintf("field%d", i), Type: elem}
+		if strings.HasPrefix(elem, "(") {
+			end := matchingParen(elem, 0)
+			if end < 0 {
+				return nil, fmt.Errorf("unbalanced parentheses in %q", elem)
+			}
+			if marshaling.Components, err = parseSigTypes(elem[1:end]); err != nil {
+				return nil, err
+			}
+			marshaling.Type = "tuple" + elem[end+1:]
+		}
+		types = append(types, marshaling)
+	}
+	return types, nil
+}
+
+// ParseABIArgs converts the command line arguments "strArgs" into values that
+// can be packed as "args". Numbers can be decimal or 0x-prefixed hex, bytes
+// are hex, addresses can be hex or Bech32, arrays are written as "[a,b]" and
+// tuples as "(a,b)".
+func ParseABIArgs(args gethabi.Arguments, strArgs []string) ([]any, error) {
+	if len(strArgs) != len(args) {
+		return nil, fmt.Errorf("expected %d ABI arguments, got %d", len(args), len(strArgs))
+	}
+	values := make([]any, len(args))
+	for i, arg := range args {
+		value, err := parseABIValue(arg.Type, strArgs[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument %d (%s): %w", i, arg.Type.String(), err)
+		}
+		values[i] = value.Interface()
+	}
+	return values, nil
+}
+
+func parseABIValue(typ gethabi.Type, s string) (reflect.Value, error) {
+	s = strings.TrimSpace(s)
+	goType := typ.GetType()
+	switch typ.T {
+	case gethabi.AddressTy:
+		addr, err := parseEthAddr(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(addr), nil
+
+	case gethabi.BoolTy:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(b), nil
+
+	case gethabi.StringTy:
+		if unquoted, err := strconv.Unquote(s); err == nil {
+			s = unquoted
+		}
+		return reflect.ValueOf(s), nil
+
+	case gethabi.IntTy, gethabi.UintTy:
+		base := 10
+		if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "-0x") {
+			s, base = strings.Replace(s, "0x", "", 1), 16
+		}
+		n, ok := new(big.Int).SetString(s, base)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("invalid integer %q", s)
+		}
+		if typ.T == gethabi.UintTy && n.Sign() < 0 {
+			return reflect.Value{}, fmt.Errorf("negative unsigned integer %s", s)
+		}
+		if goType == reflect.TypeOf(n) {
+			return reflect.ValueOf(n), nil
+		}
+		// Integers of up to 64 bits are packed from Go integers of that size.
+		value := reflect.New(goType).Elem()
+		if typ.T == gethabi.IntTy {
+			if !n.IsInt64() || value.OverflowInt(n.Int64()) {
+				return reflect.Value{}, fmt.Errorf("%s overflows %s", s, typ.String())
+			}
+			value.SetInt(n.Int64())
+		} else {
+			if !n.IsUint64() || value.OverflowUint(n.Uint64()) {
+				return reflect.Value{}, fmt.Errorf("%s overflows %s", s, typ.String())
+			}
+			value.SetUint(n.Uint64())
+		}
+		return value, nil
+
+	case gethabi.BytesTy:
+		bz, err := hexutil.Decode(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(bz), nil
+
+	case gethabi.FixedBytesTy:
+		bz, err := hexutil.Decode(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if len(bz) != typ.Size {
+			return reflect.Value{}, fmt.Errorf("expected %d bytes, got %d", typ.Size, len(bz))
+		}
+		value := reflect.New(goType).Elem()
+		reflect.Copy(value, reflect.ValueOf(bz))
+		return value, nil
+
+	case gethabi.SliceTy, gethabi.ArrayTy:
+		elems, err := splitEnclosed(s, '[', ']')
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		var value reflect.Value
+		if typ.T == gethabi.ArrayTy {
+			if len(elems) != typ.Size {
+				return reflect.Value{}, fmt.Errorf("expected %d elements, got %d", typ.Size, len(elems))
+			}
+			value = reflect.New(goType).Elem()
+		} else {
+			value = reflect.MakeSlice(goType, len(elems), len(elems))
+		}
+		for i, elem := range elems {
+			elemValue, err := parseABIValue(*typ.Elem, elem)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			value.Index(i).Set(elemValue)
+		}
+		return value, nil
+
+	case gethabi.TupleTy:
+		elems, err := splitEnclosed(s, '(', ')')
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if len(elems) != len(typ.TupleElems) {
+			return reflect.Value{}, fmt.Errorf("expected %d tuple elements, got %d", len(typ.TupleElems), len(elems))
+		}
+		value := reflect.New(goType).Elem()
+		for i, elemType := range typ.TupleElems {
+			elemValue, err := parseABIValue(*elemType, elems[i])
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			value.Field(i).Set(elemValue)
+		}
+		return value, nil
+
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported ABI type %s", typ.String())
+	}
+}
+
+// FormatABIValue formats a value unpacked from ABI-encoded data for display.
+// Bytes are shown as hex, and arrays, slices and tuples element by element.
+func FormatABIValue(value any) string {
+	return formatABIValue(reflect.ValueOf(value))
+}
+
+func formatABIValue(value reflect.Value) string {
+	if !value.IsValid() {
+		return "<nil>"
+	}
+	switch v := value.Interface().(type) {
+	case gethcommon.Address:
+		return v.Hex()
+	case *big.Int:
+		return v.String()
+	case []byte:
+		return hexutil.Encode(v)
+	}
+
+	var elems []string
+	switch value.Kind() {
+	case reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, value.Len())
+			reflect.Copy(reflect.ValueOf(bz), value)
+			return hexutil.Encode(bz)
+		}
+		fallthrough
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			elems = append(elems, formatABIValue(value.Index(i)))
+		}
+		return "[" + strings.Join(elems, ",") + "]"
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			elems = append(elems, formatABIValue(value.Field(i)))
+		}
+		return "(" + strings.Join(elems, ",") + ")"
+	default:
+		return fmt.Sprint(value.Interface())
+	}
+}
+
+// abiFromFlag returns the ABI in the file given by "--abi", or nil if the flag
+// is unset.
+func abiFromFlag(cmd *cobra.Command) (*gethabi.ABI, error) {
+	path, _ := cmd.Flags().GetString("abi")
+	if path == "" {
+		return nil, nil
+	}
+	artifact, err := LoadContractArtifact(path)
+	if err != nil {
+		return nil, err
+	}
+	return artifact.ABI, nil
+}
+
+// packMethodCall returns the calldata of a call to the method given by its
+// name or signature "nameOrSig" with the command line arguments "strArgs",
+// using the ABI given by "--abi" if any.
+func packMethodCall(
+	cmd *cobra.Command, nameOrSig string, strArgs []string,
+) (input []byte, method gethabi.Method, err error) {
+	contractABI, err := abiFromFlag(cmd)
+	if err != nil {
+		return nil, method, err
+	}
+	method, err = ResolveMethod(contractABI, nameOrSig)
+	if err != nil {
+		return nil, method, err
+	}
+	values, err := ParseABIArgs(method.Inputs, strArgs)
+	if err != nil {
+		return nil, method, err
+	}
+	packedArgs, err := method.Inputs.Pack(values...)
+	if err != nil {
+		return nil, method, fmt.Errorf("failed to pack arguments of %s: %w", method.Sig, err)
+	}
+	return append(append([]byte{}, method.ID...), packedArgs...), method, nil
+}
+
+// parseEthAddr parses a hex or Bech32 account address.
+func parseEthAddr(s string) (gethcommon.Address, error) {
+	if gethcommon.IsHexAddress(s) {
+		return gethcommon.HexToAddress(s), nil
+	}
+	nibiAddr, err := sdk.AccAddressFromBech32(s)
+	if err != nil {
+		return gethcommon.Address{}, fmt.Errorf("%q is neither a hex nor a Bech32 address", s)
+	}
+	return eth.NibiruAddrToEthAddr(nibiAddr), nil
+}
+
+// splitEnclosed splits a list such as "[a,b]" into its elements.
+func splitEnclosed(s string, open, closing byte) ([]string, error) {
+	if len(s) < 2 || s[0] != open || s[len(s)-1] != closing {
+		return nil, fmt.Errorf("expected a list enclosed in %c%c, got %q", open, closing, s)
+	}
+	return splitTopLevel(s[1 : len(s)-1])
+}
+
+// splitTopLevel splits "s" at the commas that are not nested in brackets,
+// parentheses or double quotes.
+func splitTopLevel(s string) (elems []string, err error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	depth, start, inQuotes := 0, 0, false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"' && (i == 0 || s[i-1] != '\\'):
+			inQuotes = !inQuotes
+		case inQuotes:
+		case c == '[' || c == '(':
+			depth++
+		case c == ']' || c == ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced brackets in %q", s)
+			}
+		case c == ',' && depth == 0:
+			elems = append(elems, strings.TrimSpace(s[start:i]))
+			start = i + 1
+		}
+	}
+	if depth != 0 || inQuotes {
+		return nil, fmt.Errorf("unbalanced brackets or quotes in %q", s)
+	}
+	return append(elems, strings.TrimSpace(s[start:])), nil
+}
+
+// matchingParen returns the index of the parenthesis closing the one at
+// "open", or -1 if there is none.
+func matchingParen(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,136 @@
+package cli_test
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm/cli"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+)
+
+func TestLoadContractArtifact(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+	abiJSON := `[{"type":"function","name":"get","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"}]`
+
+	for _, tc := range []struct {
+		name         string
+		path         string
+		wantBytecode []byte
+		wantErr      string
+	}{
+		{
+			name: "hardhat artifact",
+			path: writeFile("hardhat.json",
+				`{"contractName":"Get","abi":`+abiJSON+`,"bytecode":"0x6080"}`),
+			wantBytecode: []byte{0x60, 0x80},
+		},
+		{
+			name: "foundry artifact",
+			path: writeFile("foundry.json",
+				`{"abi":`+abiJSON+`,"bytecode":{"object":"0x6080","linkReferences":{}}}`),
+			wantBytecode: []byte{0x60, 0x80},
+		},
+		{
+			name: "ABI only",
+			path: writeFile("abi.json", abiJSON),
+		},
+		{
+			name:    "no ABI",
+			path:    writeFile("no-abi.json", `{"bytecode":"0x6080"}`),
+			wantErr: `has no "abi" field`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			artifact, err := cli.LoadContractArtifact(tc.path)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, artifact.ABI.Methods, "get")
+			require.Equal(t, tc.wantBytecode, artifact.Bytecode)
+		})
+	}
+}
+
+func TestParseMethodSig(t *testing.T) {
+	method, err := cli.ParseMethodSig("swap((address,uint256)[], bytes32)(bool)")
+	require.NoError(t, err)
+	require.Equal(t, "swap((address,uint256)[],bytes32)", method.Sig)
+	require.Len(t, method.Outputs, 1)
+
+	for _, sig := range []string{"swap", "(uint256)", "swap(uint256", "swap(uint256)bool"} {
+		_, err := cli.ParseMethodSig(sig)
+		require.Error(t, err, sig)
+	}
+}
+
+func TestResolveMethod(t *testing.T) {
+	contractABI := embeds.SmartContract_TestERC20.ABI
+	for _, nameOrSig := range []string{"transfer", "transfer(address,uint256)"} {
+		method, err := cli.ResolveMethod(contractABI, nameOrSig)
+		require.NoError(t, err)
+		require.Equal(t, "transfer(address,uint256)", method.Sig)
+	}
+	_, err := cli.ResolveMethod(contractABI, "transfer(address)")
+	require.ErrorContains(t, err, "not found in ABI")
+}
+
+func TestParseABIArgs(t *testing.T) {
+	method, err := cli.ParseMethodSig(
+		"f(uint8,int256,bool,string,bytes,bytes2,address[],(address,uint64)[2])")
+	require.NoError(t, err)
+
+	values, err := cli.ParseABIArgs(method.Inputs, []string{
+		"0xff",
+		"-12",
+		"true",
+		`"a, b"`,
+		"0x1234",
+		"0xabcd",
+		"[" + dummyEthAddr + "," + dummyAccs[0].NibiruAddr.String() + "]",
+		"[(" + dummyEthAddr + ",1),(" + dummyEthAddr + ",2)]",
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint8(255), values[0])
+	require.Equal(t, big.NewInt(-12), values[1])
+	require.Equal(t, "a, b", values[3])
+	require.Equal(t, "0xabcd", cli.FormatABIValue(values[5]))
+	require.Equal(t, []gethcommon.Address{dummyAccs[1].EthAddr, dummyAccs[0].EthAddr}, values[6])
+	require.Equal(t,
+		"[("+dummyEthAddr+",1),("+dummyEthAddr+",2)]",
+		cli.FormatABIValue(values[7]),
+	)
+
+	// Packing checks that the values have the Go types go-ethereum expects.
+	_, err = method.Inputs.Pack(values...)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		sig     string
+		arg     string
+		wantErr string
+	}{
+		{sig: "f(uint8)", arg: "256", wantErr: "overflows uint8"},
+		{sig: "f(uint256)", arg: "-1", wantErr: "negative unsigned integer"},
+		{sig: "f(bytes2)", arg: "0x12", wantErr: "expected 2 bytes"},
+		{sig: "f(address)", arg: "0x12", wantErr: "neither a hex nor a Bech32 address"},
+		{sig: "f(uint8[2])", arg: "[1]", wantErr: "expected 2 elements"},
+		{sig: "f(uint8[])", arg: "[1,[2]", wantErr: "unbalanced"},
+	} {
+		method, err := cli.ParseMethodSig(tc.sig)
+		require.NoError(t, err)
+		_, err = cli.ParseABIArgs(method.Inputs, []string{tc.arg})
+		require.ErrorContains(t, err, tc.wantErr, tc.sig)
+	}
+}
@@ -16,6 +16,9 @@ import (
 
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 
+	"github.com/NibiruChain/nibiru/v2/eth"
+	cryptocodec "github.com/NibiruChain/nibiru/v2/eth/crypto/codec"
+	ethhd "github.com/NibiruChain/nibiru/v2/eth/crypto/hd"
 	"github.com/NibiruChain/nibiru/v2/x/evm/cli"
 	"github.com/NibiruChain/nibiru/v2/x/evm/evmmodule"
 )
@@ -27,13 +30,24 @@ type Suite struct {
 	encCfg  testutilmod.TestEncodingConfig
 
 	testAcc sdktestutil.TestAccount
+	// ethAcc has an eth_secp256k1 key, which signs EVM txs.
+	ethAcc sdktestutil.TestAccount
 }
 
 func (s *Suite) SetupSuite() {
 	s.encCfg = testutilmod.MakeTestEncodingConfig(evmmodule.AppModuleBasic{})
-	s.keyring = keyring.NewInMemory(s.encCfg.Codec)
+	cryptocodec.RegisterInterfaces(s.encCfg.InterfaceRegistry)
+	s.keyring = keyring.NewInMemory(s.encCfg.Codec, ethhd.EthSecp256k1Option())
 	testAccs := sdktestutil.CreateKeyringAccounts(s.T(), s.keyring, 1)
 	s.testAcc = testAccs[0]
+
+	record, _, err := s.keyring.NewMnemonic(
+		"eth", keyring.English, eth.BIP44HDPath, keyring.DefaultBIP39Passphrase, ethhd.EthSecp256k1,
+	)
+	s.Require().NoError(err)
+	addr, err := record.GetAddress()
+	s.Require().NoError(err)
+	s.ethAcc = sdktestutil.TestAccount{Name: record.Name, Address: addr}
 }
 
 func TestSuite(t *testing.T) {
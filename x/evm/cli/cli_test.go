@@ -5,7 +5,10 @@ import (
 	"math/big"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 
+	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
 )
@@ -151,7 +154,7 @@ func (s *Suite) TestCmdCreateFunToken() {
 				fmt.Sprintf("--bank-denom=%s", dummyFuntoken.BankDenom),
 			},
 			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
-			wantErr:   "exactly one of the flags --bank-denom or --erc20 must be specified",
+			wantErr:   "exactly one of the flags --bank-denom, --erc20, or --cw20 must be specified",
 		},
 	}
 
@@ -245,3 +248,180 @@ func (s *Suite) TestCmdQueryFunToken() {
 		tc.RunQueryCmd(s)
 	}
 }
+
+// ethTxArgs sets the gas limit and price of EVM txs, which would otherwise be
+// queried from the chain.
+func ethTxArgs(from string) []string {
+	return []string{
+		fmt.Sprintf("--from=%s", from),
+		"--gas=3000000",
+		"--gas-prices=1unibi",
+	}
+}
+
+func (s *Suite) TestCmdDeployContract() {
+	artifactPath := "../embeds/artifacts/contracts/TestERC20.sol/TestERC20.json"
+	testCases := []TestCase{
+		{
+			name:      "happy: deploy from artifact",
+			args:      []string{"deploy", artifactPath},
+			extraArgs: ethTxArgs(s.ethAcc.Name),
+			wantErr:   "",
+		},
+		{
+			name:      "happy: deploy raw bytecode",
+			args:      []string{"deploy", "0x6080604052"},
+			extraArgs: ethTxArgs(s.ethAcc.Name),
+			wantErr:   "",
+		},
+		{
+			name:      "sad: constructor args without ABI",
+			args:      []string{"deploy", "0x6080604052", "123"},
+			extraArgs: ethTxArgs(s.ethAcc.Name),
+			wantErr:   "constructor arguments need the contract ABI",
+		},
+		{
+			name:      "sad: wrong number of constructor args",
+			args:      []string{"deploy", artifactPath, "123"},
+			extraArgs: ethTxArgs(s.ethAcc.Name),
+			wantErr:   "expected 0 ABI arguments, got 1",
+		},
+		{
+			name:      "sad: not bytecode",
+			args:      []string{"deploy", "not-a-file"},
+			extraArgs: ethTxArgs(s.ethAcc.Name),
+			wantErr:   "neither a contract artifact file nor hex bytecode",
+		},
+		{
+			name:      "sad: secp256k1 key",
+			args:      []string{"deploy", artifactPath},
+			extraArgs: ethTxArgs(s.testAcc.Name),
+			wantErr:   "EVM txs must be signed with an eth_secp256k1 key",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.RunTxCmd(s)
+	}
+}
+
+func (s *Suite) TestCmdCallContract() {
+	testCases := []TestCase{
+		{
+			name: "happy: call by signature",
+			args: []string{
+				"call", dummyEthAddr, "transfer(address,uint256)", dummyAccs[0].NibiruAddr.String(), "1000",
+			},
+			extraArgs: ethTxArgs(s.ethAcc.Name),
+			wantErr:   "",
+		},
+		{
+			name: "happy: call by name with ABI",
+			args: []string{
+				"call", dummyEthAddr, "transfer", dummyEthAddr, "0x3e8",
+				"--abi=../embeds/artifacts/contracts/TestERC20.sol/TestERC20.json",
+				"--value=1000000000000",
+			},
+			extraArgs: ethTxArgs(s.ethAcc.Name),
+			wantErr:   "",
+		},
+		{
+			name:      "sad: invalid arg",
+			args:      []string{"call", dummyEthAddr, "transfer(address,uint256)", dummyEthAddr, "1e18"},
+			extraArgs: ethTxArgs(s.ethAcc.Name),
+			wantErr:   "invalid integer",
+		},
+		{
+			name:      "sad: method name without ABI",
+			args:      []string{"call", dummyEthAddr, "transfer", dummyEthAddr, "1"},
+			extraArgs: ethTxArgs(s.ethAcc.Name),
+			wantErr:   "invalid method signature",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.RunTxCmd(s)
+	}
+}
+
+func (s *Suite) TestCmdSendRawTx() {
+	acc := dummyAccs[0]
+	chainID := eth.ParseEthChainID("test-chain")
+	privKey, err := acc.PrivKey.ToECDSA()
+	s.Require().NoError(err)
+	ethTx, err := gethcore.SignNewTx(
+		privKey,
+		gethcore.LatestSignerForChainID(chainID),
+		&gethcore.LegacyTx{
+			Nonce:    0,
+			GasPrice: evm.NativeToWei(big.NewInt(1)),
+			Gas:      21000,
+			To:       &dummyAccs[1].EthAddr,
+			Value:    big.NewInt(1),
+		},
+	)
+	s.Require().NoError(err)
+	rawTx, err := ethTx.MarshalBinary()
+	s.Require().NoError(err)
+
+	testCases := []TestCase{
+		{
+			name:    "happy: send raw tx",
+			args:    []string{"send-raw", hexutil.Encode(rawTx)},
+			wantErr: "",
+		},
+		{
+			name:    "sad: not a tx",
+			args:    []string{"send-raw", "0x1234"},
+			wantErr: "failed to decode Ethereum tx",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.RunTxCmd(s)
+	}
+}
+
+func (s *Suite) TestCmdQueryEvmState() {
+	testCases := []TestCase{
+		{
+			name:    "happy: call",
+			args:    []string{"call", dummyEthAddr, "transfer(address,uint256)", dummyEthAddr, "1"},
+			wantErr: "",
+		},
+		{
+			name:    "sad: call with invalid contract",
+			args:    []string{"call", "0x123", "totalSupply()"},
+			wantErr: "invalid contract address",
+		},
+		{
+			name:    "happy: storage slot number",
+			args:    []string{"storage", dummyEthAddr, "2"},
+			wantErr: "",
+		},
+		{
+			name:    "happy: storage key",
+			args:    []string{"storage", dummyAccs[0].NibiruAddr.String(), gethcommon.BigToHash(big.NewInt(2)).Hex()},
+			wantErr: "",
+		},
+		{
+			name:    "sad: storage slot",
+			args:    []string{"storage", dummyEthAddr, "two"},
+			wantErr: "invalid storage slot",
+		},
+		{
+			name:    "happy: code",
+			args:    []string{"code", dummyEthAddr},
+			wantErr: "",
+		},
+		{
+			name:    "sad: trace-tx invalid hash",
+			args:    []string{"trace-tx", "0x1234"},
+			wantErr: "invalid Ethereum tx hash",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.RunQueryCmd(s)
+	}
+}
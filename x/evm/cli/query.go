@@ -1,18 +1,26 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"strings"
 
+	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	srvconfig "github.com/NibiruChain/nibiru/v2/app/server/config"
 	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 )
 
@@ -30,7 +38,14 @@ func GetQueryCmd() *cobra.Command {
 	// Add subcommands
 	cmds := []*cobra.Command{
 		CmdQueryFunToken(),
+		CmdQueryFunTokens(),
+		CmdQueryFunTokenRateLimit(),
+		CmdQueryDeployPermission(),
 		CmdQueryAccount(),
+		CmdQueryEthCall(),
+		CmdQueryStorage(),
+		CmdQueryCode(),
+		CmdQueryTraceTx(),
 	}
 	for _, cmd := range cmds {
 		moduleQueryCmd.AddCommand(cmd)
@@ -75,6 +90,128 @@ $ %s query %s get-fun-token 0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6
 	return cmd
 }
 
+// CmdQueryFunTokens lists all fungible token mappings
+func CmdQueryFunTokens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funtokens",
+		Short: "List all evm fungible token mappings",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`List all evm fungible token mappings.
+
+Examples:
+$ %s query %s funtokens
+$ %s query %s funtokens --limit 10 --page 2
+`,
+				version.AppName, evm.ModuleName,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := evm.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FunTokenMappings(cmd.Context(), &evm.QueryFunTokenMappingsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "funtokens")
+	return cmd
+}
+
+// CmdQueryFunTokenRateLimit returns the rate limit, current window flow, and
+// pause status of a fungible token mapping
+func CmdQueryFunTokenRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funtoken-rate-limit [coin-or-erc20addr]",
+		Short: "Query the rate limit and pause status of an evm fungible token mapping",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rate limit, the net flow in the current rate limit window, and
+the pause status of an evm fungible token mapping.
+
+Examples:
+$ %s query %s funtoken-rate-limit ibc/abcdef
+$ %s query %s funtoken-rate-limit 0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6
+`,
+				version.AppName, evm.ModuleName,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := evm.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FunTokenRateLimit(cmd.Context(), &evm.QueryFunTokenRateLimitRequest{
+				Token: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryDeployPermission returns which accounts can deploy contracts to the
+// EVM
+func CmdQueryDeployPermission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy-permission [address]",
+		Short: "Query which accounts can deploy contracts to the EVM",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query which accounts can deploy contracts to the EVM. If an address is
+given, the response also tells whether that account can deploy contracts.
+
+Examples:
+$ %s query %s deploy-permission
+$ %s query %s deploy-permission 0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6
+`,
+				version.AppName, evm.ModuleName,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := evm.NewQueryClient(clientCtx)
+
+			req := &evm.QueryDeployPermissionRequest{}
+			if len(args) > 0 {
+				req.Address = args[0]
+			}
+			res, err := queryClient.DeployPermission(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdQueryAccount() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "account [address]",
@@ -129,3 +266,341 @@ func CmdQueryAccount() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdQueryEthCall calls an EVM contract without sending a tx
+func CmdQueryEthCall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call [contract_address] [method] [args...]",
+		Short: "Call a method of an EVM contract against the latest state, as in eth_call",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Call a method of an EVM contract against the latest state without sending a
+tx, as in "eth_call". The method is given by its signature, optionally
+followed by its return types, or by its name when the contract ABI is given
+by --abi. The decoded return values are printed one per line, or the raw
+return data when the return types are unknown.
+
+Examples:
+$ %s query %s call 0x1234...abcd "balanceOf(address)(uint256)" 0x5678...ef01
+$ %s query %s call 0x1234...abcd balanceOf nibi1...xyz --abi ./Token.json
+`,
+				version.AppName, evm.ModuleName,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := evm.NewQueryClient(clientCtx)
+
+			contractAddr, err := parseEthAddr(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid contract address: %w", err)
+			}
+			input, method, err := packMethodCall(cmd, args[1], args[2:])
+			if err != nil {
+				return err
+			}
+			callArgs := evm.JsonTxArgs{
+				To:    &contractAddr,
+				Input: (*hexutil.Bytes)(&input),
+			}
+			if callerStr, _ := cmd.Flags().GetString("caller"); callerStr != "" {
+				caller, err := parseEthAddr(callerStr)
+				if err != nil {
+					return fmt.Errorf("invalid caller: %w", err)
+				}
+				callArgs.From = &caller
+			}
+
+			req, err := newEthCallRequest(callArgs, eth.ParseEthChainID(clientCtx.ChainID))
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.EthCall(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			if res.Failed() {
+				if reason, err := gethabi.UnpackRevert(res.Ret); err == nil {
+					return fmt.Errorf("eth call failed: %s: %s", res.VmError, reason)
+				}
+				return fmt.Errorf("eth call failed: %s", res.VmError)
+			}
+
+			if len(method.Outputs) == 0 {
+				return clientCtx.PrintString(hexutil.Encode(res.Ret) + "\n")
+			}
+			outputs, err := method.Outputs.Unpack(res.Ret)
+			if err != nil {
+				return fmt.Errorf("failed to unpack return data of %s: %w", method.Sig, err)
+			}
+			var out strings.Builder
+			for _, output := range outputs {
+				out.WriteString(FormatABIValue(output) + "\n")
+			}
+			return clientCtx.PrintString(out.String())
+		},
+	}
+	cmd.Flags().String("abi", "", "Hardhat or Foundry artifact or JSON ABI file of the contract")
+	cmd.Flags().String("caller", "", "Address to make the call from")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryStorage returns the value of a storage slot of an EVM account
+func CmdQueryStorage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage [address] [slot]",
+		Short: "Query the value of a storage slot of an EVM contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the value of a storage slot of an EVM contract. The slot is either a
+32 byte hex key or a decimal slot number.
+
+Examples:
+$ %s query %s storage 0x1234...abcd 0
+$ %s query %s storage 0x1234...abcd 0x0000000000000000000000000000000000000000000000000000000000000002
+`,
+				version.AppName, evm.ModuleName,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := evm.NewQueryClient(clientCtx)
+
+			addr, err := parseEthAddr(args[0])
+			if err != nil {
+				return err
+			}
+			var key gethcommon.Hash
+			if strings.HasPrefix(args[1], "0x") {
+				bz, err := hexutil.Decode(args[1])
+				if err != nil || len(bz) > gethcommon.HashLength {
+					return fmt.Errorf("invalid storage key %s", args[1])
+				}
+				key = gethcommon.BytesToHash(bz)
+			} else {
+				slot, ok := new(big.Int).SetString(args[1], 10)
+				if !ok || slot.Sign() < 0 {
+					return fmt.Errorf("invalid storage slot %s", args[1])
+				}
+				key = gethcommon.BigToHash(slot)
+			}
+
+			res, err := queryClient.Storage(cmd.Context(), &evm.QueryStorageRequest{
+				Address: addr.Hex(),
+				Key:     key.Hex(),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryCode returns the runtime bytecode of an EVM contract
+func CmdQueryCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code [address]",
+		Short: "Query the runtime bytecode of an EVM contract as hex",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := evm.NewQueryClient(clientCtx)
+
+			addr, err := parseEthAddr(args[0])
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Code(cmd.Context(), &evm.QueryCodeRequest{
+				Address: addr.Hex(),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(hexutil.Encode(res.Code) + "\n")
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryTraceTx traces the execution of an EVM tx
+func CmdQueryTraceTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace-tx [eth_tx_hash]",
+		Short: "Trace the execution of an EVM tx, as in debug_traceTransaction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Trace the execution of an EVM tx by replaying it on the state it was
+executed on, as in "debug_traceTransaction". The tx is found through the
+CometBFT tx index of the node. The default tracer logs every opcode, and
+--tracer selects another one, such as "callTracer".
+
+Examples:
+$ %s query %s trace-tx 0x5a7b...c3d4
+$ %s query %s trace-tx 0x5a7b...c3d4 --tracer=callTracer
+`,
+				version.AppName, evm.ModuleName,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hashBz, err := hexutil.Decode(args[0])
+			if err != nil || len(hashBz) != gethcommon.HashLength {
+				return fmt.Errorf("invalid Ethereum tx hash %s", args[0])
+			}
+			ethHash := gethcommon.BytesToHash(hashBz)
+
+			tracer, _ := cmd.Flags().GetString("tracer")
+			disableStack, _ := cmd.Flags().GetBool("disable-stack")
+			disableStorage, _ := cmd.Flags().GetBool("disable-storage")
+			enableMemory, _ := cmd.Flags().GetBool("enable-memory")
+			enableReturnData, _ := cmd.Flags().GetBool("enable-return-data")
+			traceConfig := &evm.TraceConfig{
+				Tracer:           tracer,
+				DisableStack:     disableStack,
+				DisableStorage:   disableStorage,
+				EnableMemory:     enableMemory,
+				EnableReturnData: enableReturnData,
+			}
+
+			req, height, err := newTraceTxRequest(cmd.Context(), clientCtx, ethHash)
+			if err != nil {
+				return err
+			}
+			req.TraceConfig = traceConfig
+
+			// The tx is replayed on the state at the start of its block. Height 0
+			// means the latest block, so the context height is at least 1.
+			queryClient := evm.NewQueryClient(clientCtx.WithHeight(max(height-1, 1)))
+			res, err := queryClient.TraceTx(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(json.RawMessage(res.Data))
+		},
+	}
+	cmd.Flags().String("tracer", "", "Tracer to use, such as \"callTracer\". The opcode logger is used by default")
+	cmd.Flags().Bool("disable-stack", false, "Don't capture the stack in the opcode logger")
+	cmd.Flags().Bool("disable-storage", false, "Don't capture storage in the opcode logger")
+	cmd.Flags().Bool("enable-memory", false, "Capture memory in the opcode logger")
+	cmd.Flags().Bool("enable-return-data", false, "Capture return data in the opcode logger")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// newTraceTxRequest finds the EVM tx with hash "ethHash" through the CometBFT
+// tx index and returns a request to trace it, along with the height of its
+// block. The EVM txs that precede it in the block are replayed first.
+func newTraceTxRequest(
+	ctx context.Context, clientCtx client.Context, ethHash gethcommon.Hash,
+) (req *evm.QueryTraceTxRequest, height int64, err error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, 0, err
+	}
+	query := fmt.Sprintf("%s.%s='%s'",
+		evm.PendingEthereumTxEvent, evm.PendingEthereumTxEventAttrEthHash, ethHash.Hex(),
+	)
+	searchRes, err := node.TxSearch(ctx, query, false, nil, nil, "")
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(searchRes.Txs) == 0 {
+		return nil, 0, fmt.Errorf("ethereum tx %s not found", ethHash.Hex())
+	}
+	txDecoder := clientCtx.TxConfig.TxDecoder()
+	// The tx is only needed to parse the events of a failed tx.
+	var indexedTx sdk.Tx
+	if searchRes.Txs[0].TxResult.Code != 0 {
+		if indexedTx, err = txDecoder(searchRes.Txs[0].Tx); err != nil {
+			return nil, 0, err
+		}
+	}
+	txResult, err := rpc.ParseTxIndexerResult(searchRes.Txs[0], indexedTx, func(txs *rpc.ParsedTxs) *rpc.ParsedTx {
+		return txs.GetTxByHash(ethHash)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	height = txResult.Height
+	block, err := node.Block(ctx, &height)
+	if err != nil {
+		return nil, 0, err
+	}
+	var predecessors []*evm.MsgEthereumTx
+	var ethMsg *evm.MsgEthereumTx
+	for txIdx, txBz := range block.Block.Txs[:txResult.TxIndex+1] {
+		tx, err := txDecoder(txBz)
+		if err != nil {
+			continue
+		}
+		for msgIdx, msg := range tx.GetMsgs() {
+			msgEthTx, ok := msg.(*evm.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			if uint32(txIdx) == txResult.TxIndex && uint32(msgIdx) == txResult.MsgIndex { // #nosec G115
+				ethMsg = msgEthTx
+				break
+			}
+			predecessors = append(predecessors, msgEthTx)
+		}
+	}
+	if ethMsg == nil {
+		return nil, 0, fmt.Errorf("ethereum tx %s not found in block %d", ethHash.Hex(), height)
+	}
+
+	networkClient, ok := node.(cmtrpcclient.NetworkClient)
+	if !ok {
+		return nil, 0, fmt.Errorf("node client can't query consensus params")
+	}
+	consensusParams, err := networkClient.ConsensusParams(ctx, &height)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return &evm.QueryTraceTxRequest{
+		Msg:             ethMsg,
+		Predecessors:    predecessors,
+		BlockNumber:     height,
+		BlockHash:       gethcommon.Bytes2Hex(block.BlockID.Hash),
+		BlockTime:       block.Block.Time,
+		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+		ChainId:         eth.ParseEthChainID(clientCtx.ChainID).Int64(),
+		BlockMaxGas:     consensusParams.ConsensusParams.Block.MaxGas,
+	}, height, nil
+}
+
+// newEthCallRequest encodes "args" for the EthCall and EstimateGas queries.
+func newEthCallRequest(args evm.JsonTxArgs, chainID *big.Int) (*evm.EthCallRequest, error) {
+	argsBz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	return &evm.EthCallRequest{
+		Args:    argsBz,
+		GasCap:  srvconfig.DefaultEthCallGasLimit,
+		ChainId: chainID.Int64(),
+	}, nil
+}
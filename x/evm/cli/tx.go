@@ -1,15 +1,25 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/crypto/ethsecp256k1"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 
 	"github.com/spf13/cobra"
@@ -29,6 +39,12 @@ func GetTxCmd() *cobra.Command {
 		CmdCreateFunToken(),
 		CmdConvertCoinToEvm(),
 		CmdConvertEvmToCoin(),
+		CmdSetFunTokenRateLimit(),
+		CmdSetFunTokenPaused(),
+		CmdSetDeployPermission(),
+		CmdDeployContract(),
+		CmdCallContract(),
+		CmdSendRawTx(),
 	}
 	for _, cmd := range cmds {
 		txCmd.AddCommand(cmd)
@@ -47,9 +63,19 @@ func CmdCreateFunToken() *cobra.Command {
 
 	create-funtoken --bank-denom="ibc/..."
 
+	Example: Creating a fungible token mapping from a bank coin with 6 decimals
+	whose ERC20 has 18 decimals. Each base unit of the coin converts to 10^12
+	base units of the ERC20.
+
+	create-funtoken --bank-denom="ibc/..." --decimals-scale=12
+
 	Example: Creating a fungible token mapping from an ERC20.
 
 	create-funtoken --erc20=[erc20-address]
+
+	Example: Creating a fungible token mapping from a CW20.
+
+	create-funtoken --cw20=[cw20-address]
 		`),
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -60,20 +86,33 @@ func CmdCreateFunToken() *cobra.Command {
 
 			bankDenom, _ := cmd.Flags().GetString("bank-denom")
 			erc20AddrStr, _ := cmd.Flags().GetString("erc20")
+			cw20AddrStr, _ := cmd.Flags().GetString("cw20")
+			decimalsScale, _ := cmd.Flags().GetUint32("decimals-scale")
 
-			if (bankDenom == "" && erc20AddrStr == "") ||
-				(bankDenom != "" && erc20AddrStr != "") {
-				return fmt.Errorf("exactly one of the flags --bank-denom or --erc20 must be specified")
+			numSet := 0
+			for _, flagVal := range []string{bankDenom, erc20AddrStr, cw20AddrStr} {
+				if flagVal != "" {
+					numSet++
+				}
+			}
+			if numSet != 1 {
+				return fmt.Errorf("exactly one of the flags --bank-denom, --erc20, or --cw20 must be specified")
 			}
 
 			msg := &evm.MsgCreateFunToken{
-				Sender: clientCtx.GetFromAddress().String(),
+				Sender:        clientCtx.GetFromAddress().String(),
+				DecimalsScale: decimalsScale,
 			}
 			if bankDenom != "" {
 				if err := sdk.ValidateDenom(bankDenom); err != nil {
 					return err
 				}
 				msg.FromBankDenom = bankDenom
+			} else if cw20AddrStr != "" {
+				if _, err := sdk.AccAddressFromBech32(cw20AddrStr); err != nil {
+					return err
+				}
+				msg.FromCw20 = cw20AddrStr
 			} else {
 				erc20Addr, err := eth.NewEIP55AddrFromStr(erc20AddrStr)
 				if err != nil {
@@ -88,6 +127,8 @@ func CmdCreateFunToken() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String("bank-denom", "", "The bank denom to create a fungible token from")
 	cmd.Flags().String("erc20", "", "The ERC20 address to create a fungible token from")
+	cmd.Flags().String("cw20", "", "The CW20 contract address to create a fungible token from")
+	cmd.Flags().Uint32("decimals-scale", 0, "Number of decimals the ERC20 has in addition to the bank coin. Only valid with --bank-denom")
 
 	return cmd
 }
@@ -170,3 +211,463 @@ func CmdConvertEvmToCoin() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdSetFunTokenRateLimit broadcast MsgSetFunTokenRateLimit
+func CmdSetFunTokenRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-funtoken-rate-limit [bank_denom] [window_blocks] [max_net_to_evm] [max_net_to_bank] [flags]",
+		Short: "Set or remove the rate limit on conversions of a FunToken mapping (sudoers only)",
+		Long: heredoc.Doc(`
+	Set the maximum net amount of a FunToken that can be converted to and from
+	its ERC20 representation within a window of blocks. A maximum of 0 means
+	there's no limit in that direction. Setting [window_blocks] to 0 removes the
+	rate limit.
+
+	Example:
+	set-funtoken-rate-limit ibc/abcdef 600 1000000000 500000000 --from sudoer
+		`),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			windowBlocks, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid window_blocks: %w", err)
+			}
+			maxNetToEvm, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid max_net_to_evm: %s", args[2])
+			}
+			maxNetToBank, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid max_net_to_bank: %s", args[3])
+			}
+
+			msg := &evm.MsgSetFunTokenRateLimit{
+				Sender: clientCtx.GetFromAddress().String(),
+				RateLimit: evm.FunTokenRateLimit{
+					BankDenom:    args[0],
+					WindowBlocks: windowBlocks,
+					MaxNetToEvm:  maxNetToEvm,
+					MaxNetToBank: maxNetToBank,
+				},
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdSetFunTokenPaused broadcast MsgSetFunTokenPaused
+func CmdSetFunTokenPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-funtoken-paused [bank_denom] [true|false] [flags]",
+		Short: "Pause or resume all conversions of a FunToken mapping (sudoers only)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			paused, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid paused value: %w", err)
+			}
+
+			msg := &evm.MsgSetFunTokenPaused{
+				Sender:    clientCtx.GetFromAddress().String(),
+				BankDenom: args[0],
+				Paused:    paused,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdSetDeployPermission broadcast MsgSetDeployPermission
+func CmdSetDeployPermission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-deploy-permission [everybody|nobody|allowlist] [flags]",
+		Short: "Set which accounts can deploy contracts to the EVM (sudoers only)",
+		Long: heredoc.Doc(`
+	Set which accounts can deploy contracts to the EVM and edit the deploy
+	allowlist. Addresses can be hexadecimal or Bech32.
+
+	Example: Only allow two accounts to deploy contracts.
+
+	set-deploy-permission allowlist --add=0x...,nibi1...
+
+	Example: Let anyone deploy contracts again.
+
+	set-deploy-permission everybody
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			permission, err := evm.ParseDeployPermission(args[0])
+			if err != nil {
+				return err
+			}
+			add, _ := cmd.Flags().GetStringSlice("add")
+			remove, _ := cmd.Flags().GetStringSlice("remove")
+
+			msg := &evm.MsgSetDeployPermission{
+				Sender:              clientCtx.GetFromAddress().String(),
+				Permission:          permission,
+				AddToAllowlist:      add,
+				RemoveFromAllowlist: remove,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringSlice("add", nil, "Addresses to add to the deploy allowlist")
+	cmd.Flags().StringSlice("remove", nil, "Addresses to remove from the deploy allowlist")
+	return cmd
+}
+
+// CmdDeployContract signs and broadcasts an EVM tx that deploys a contract
+func CmdDeployContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy [bytecode|artifact.json] [constructor_args...] [flags]",
+		Short: "Deploy an EVM contract from its bytecode or a Hardhat or Foundry artifact",
+		Long: heredoc.Doc(`
+	Deploy an EVM contract. The contract is given either as hex creation
+	bytecode or as the path to a Hardhat or Foundry artifact JSON file.
+	Constructor arguments are ABI-encoded with the ABI of the artifact, or with
+	the one given by --abi when deploying raw bytecode.
+
+	The tx is signed with the eth_secp256k1 key given by --from. The gas limit
+	is estimated unless --gas is set, and the gas price is the base fee unless
+	--gas-prices is set.
+
+	Example: Deploying from a Foundry artifact with constructor arguments.
+
+	deploy ./out/Token.sol/Token.json "My Token" MTK 1000000 --from mykey
+
+	Example: Deploying raw bytecode without constructor arguments.
+
+	deploy 0x6080604052... --from mykey
+		`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractABI, err := abiFromFlag(cmd)
+			if err != nil {
+				return err
+			}
+			var bytecode []byte
+			if _, statErr := os.Stat(args[0]); statErr == nil {
+				artifact, err := LoadContractArtifact(args[0])
+				if err != nil {
+					return err
+				}
+				if len(artifact.Bytecode) == 0 {
+					return fmt.Errorf("contract artifact %s has no bytecode", args[0])
+				}
+				bytecode = artifact.Bytecode
+				if contractABI == nil {
+					contractABI = artifact.ABI
+				}
+			} else {
+				bytecode, err = hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+				if err != nil || len(bytecode) == 0 {
+					return fmt.Errorf("%q is neither a contract artifact file nor hex bytecode", args[0])
+				}
+			}
+
+			input := bytecode
+			ctorArgs := args[1:]
+			if contractABI != nil {
+				values, err := ParseABIArgs(contractABI.Constructor.Inputs, ctorArgs)
+				if err != nil {
+					return fmt.Errorf("invalid constructor arguments: %w", err)
+				}
+				packedArgs, err := contractABI.Pack("", values...)
+				if err != nil {
+					return err
+				}
+				input = append(append([]byte{}, bytecode...), packedArgs...)
+			} else if len(ctorArgs) > 0 {
+				return fmt.Errorf("constructor arguments need the contract ABI: deploy an artifact or set --abi")
+			}
+
+			msg, err := buildSignedEthTx(cmd, clientCtx, evm.JsonTxArgs{
+				Input: (*hexutil.Bytes)(&input),
+			})
+			if err != nil {
+				return err
+			}
+			// The sender is cleared from "msg" when it's wrapped in a Cosmos tx.
+			ethTx := msg.AsTransaction()
+			contractAddr := crypto.CreateAddress(gethcommon.HexToAddress(msg.From), ethTx.Nonce())
+			if err := broadcastEthTx(clientCtx, msg); err != nil {
+				return err
+			}
+			cmd.PrintErrf("ethereum tx hash: %s\ncontract address: %s\n", ethTx.Hash().Hex(), contractAddr.Hex())
+			return nil
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addEthTxFlags(cmd)
+	cmd.Flags().String("abi", "", "Hardhat or Foundry artifact or JSON ABI file used to encode the constructor arguments of raw bytecode")
+	return cmd
+}
+
+// CmdCallContract signs and broadcasts an EVM tx that calls a contract
+func CmdCallContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call [contract_address] [method] [args...] [flags]",
+		Short: "Call a method of an EVM contract in a tx",
+		Long: heredoc.Doc(`
+	Call a method of an EVM contract in a tx. The method is given by its
+	signature, or by its name when the contract ABI is given by --abi, which
+	takes a Hardhat or Foundry artifact or a JSON ABI file. Numbers can be
+	decimal or 0x-prefixed hex, bytes are hex, addresses can be hex or Bech32,
+	arrays are written as "[a,b]" and tuples as "(a,b)".
+
+	The tx is signed with the eth_secp256k1 key given by --from. The gas limit
+	is estimated unless --gas is set, and the gas price is the base fee unless
+	--gas-prices is set.
+
+	Example: Calling a method by its signature.
+
+	call 0x1234...abcd "transfer(address,uint256)" 0x5678...ef01 1000 --from mykey
+
+	Example: Calling a method by its name with the ABI of an artifact.
+
+	call 0x1234...abcd transfer nibi1...xyz 1000 --abi ./Token.json --from mykey
+		`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := parseEthAddr(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid contract address: %w", err)
+			}
+			input, _, err := packMethodCall(cmd, args[1], args[2:])
+			if err != nil {
+				return err
+			}
+
+			msg, err := buildSignedEthTx(cmd, clientCtx, evm.JsonTxArgs{
+				To:    &contractAddr,
+				Input: (*hexutil.Bytes)(&input),
+			})
+			if err != nil {
+				return err
+			}
+			if err := broadcastEthTx(clientCtx, msg); err != nil {
+				return err
+			}
+			cmd.PrintErrf("ethereum tx hash: %s\n", msg.AsTransaction().Hash().Hex())
+			return nil
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addEthTxFlags(cmd)
+	cmd.Flags().String("abi", "", "Hardhat or Foundry artifact or JSON ABI file of the contract")
+	return cmd
+}
+
+// CmdSendRawTx broadcasts a signed Ethereum tx
+func CmdSendRawTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-raw [raw_tx_hex] [flags]",
+		Short: "Broadcast a signed, RLP-encoded Ethereum tx",
+		Long: heredoc.Doc(`
+	Broadcast an Ethereum tx that was already signed, for example by an
+	offline wallet, as "eth_sendRawTransaction" does. The tx is wrapped in a
+	Cosmos tx, so no --from key is needed.
+
+	Example:
+	send-raw 0x02f87082...
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txBz, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+			if err != nil {
+				return fmt.Errorf("invalid raw tx hex: %w", err)
+			}
+			ethTx := new(gethcore.Transaction)
+			if err := ethTx.UnmarshalBinary(txBz); err != nil {
+				return fmt.Errorf("failed to decode Ethereum tx: %w", err)
+			}
+			msg := new(evm.MsgEthereumTx)
+			if err := msg.FromEthereumTx(ethTx); err != nil {
+				return err
+			}
+			if err := broadcastEthTx(clientCtx, msg); err != nil {
+				return err
+			}
+			cmd.PrintErrf("ethereum tx hash: %s\n", ethTx.Hash().Hex())
+			return nil
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// addEthTxFlags adds the flags of commands that sign EVM txs.
+func addEthTxFlags(cmd *cobra.Command) {
+	cmd.Flags().String("value", "0", "Amount of wei to send with the tx")
+}
+
+// buildSignedEthTx fills in the sender, chain ID, nonce, value, gas price and
+// gas limit of "args", then signs the resulting EVM tx with the key given by
+// "--from", which must be an eth_secp256k1 key.
+func buildSignedEthTx(
+	cmd *cobra.Command, clientCtx client.Context, args evm.JsonTxArgs,
+) (*evm.MsgEthereumTx, error) {
+	fromAddr := clientCtx.GetFromAddress()
+	if fromAddr.Empty() {
+		return nil, fmt.Errorf("the --from flag is required to sign EVM txs")
+	}
+	record, err := clientCtx.Keyring.KeyByAddress(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := pubKey.(*ethsecp256k1.PubKey); !ok {
+		return nil, fmt.Errorf(
+			"key %q has type %s, but EVM txs must be signed with an %s key",
+			record.Name, pubKey.Type(), ethsecp256k1.KeyType,
+		)
+	}
+
+	from := gethcommon.BytesToAddress(fromAddr)
+	chainID := eth.ParseEthChainID(clientCtx.ChainID)
+	args.From = &from
+	args.ChainID = (*hexutil.Big)(chainID)
+
+	valueStr, _ := cmd.Flags().GetString("value")
+	value, ok := new(big.Int).SetString(valueStr, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("invalid --value: %s", valueStr)
+	}
+	args.Value = (*hexutil.Big)(value)
+
+	queryClient := evm.NewQueryClient(clientCtx)
+	accResp, err := queryClient.EthAccount(cmd.Context(), &evm.QueryEthAccountRequest{
+		Address: from.Hex(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query nonce: %w", err)
+	}
+	nonce := accResp.Nonce
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	gasPrice, err := ethGasPrice(cmd, queryClient)
+	if err != nil {
+		return nil, err
+	}
+	args.GasPrice = (*hexutil.Big)(gasPrice)
+
+	gasStr, _ := cmd.Flags().GetString(flags.FlagGas)
+	gasSetting, err := flags.ParseGasSetting(gasStr)
+	if err != nil {
+		return nil, err
+	}
+	gas := gasSetting.Gas
+	if !cmd.Flags().Changed(flags.FlagGas) || gasSetting.Simulate {
+		req, err := newEthCallRequest(args, chainID)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := queryClient.EstimateGas(cmd.Context(), req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate gas: %w", err)
+		}
+		gas = resp.Gas
+	}
+	args.Gas = (*hexutil.Uint64)(&gas)
+
+	msg := args.ToMsgEthTx()
+	if err := msg.Sign(gethcore.LatestSignerForChainID(chainID), clientCtx.Keyring); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// ethGasPrice returns the gas price of an EVM tx in wei: the one given by
+// "--gas-prices" in unibi, or else the base fee.
+func ethGasPrice(cmd *cobra.Command, queryClient evm.QueryClient) (*big.Int, error) {
+	gasPricesStr, _ := cmd.Flags().GetString(flags.FlagGasPrices)
+	if gasPricesStr != "" {
+		gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
+		if err != nil {
+			return nil, err
+		}
+		if len(gasPrices) != 1 || gasPrices[0].Denom != evm.EVMBankDenom {
+			return nil, fmt.Errorf("--gas-prices of EVM txs must be in %s", evm.EVMBankDenom)
+		}
+		weiPerUnibi := sdk.NewDecFromBigInt(evm.NativeToWei(big.NewInt(1)))
+		return gasPrices[0].Amount.Mul(weiPerUnibi).TruncateInt().BigInt(), nil
+	}
+
+	resp, err := queryClient.BaseFee(cmd.Context(), &evm.QueryBaseFeeRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query base fee: %w", err)
+	}
+	if resp.BaseFee == nil {
+		return nil, fmt.Errorf("the chain returned no base fee: set --gas-prices")
+	}
+	return resp.BaseFee.BigInt(), nil
+}
+
+// broadcastEthTx wraps the signed "msg" in a Cosmos tx and broadcasts it, or
+// prints the Cosmos tx when "--generate-only" is set.
+func broadcastEthTx(clientCtx client.Context, msg *evm.MsgEthereumTx) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), evm.EVMBankDenom)
+	if err != nil {
+		return err
+	}
+
+	if clientCtx.GenerateOnly {
+		txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+		return clientCtx.PrintString(fmt.Sprintf("%s\n", txJSON))
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		return err
+	}
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintProto(res)
+}
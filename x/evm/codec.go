@@ -37,6 +37,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgCreateFunToken{},
 		&MsgConvertCoinToEvm{},
 		&MsgConvertEvmToCoin{},
+		&MsgSetFunTokenRateLimit{},
+		&MsgSetFunTokenPaused{},
+		&MsgSetDeployPermission{},
 	)
 	registry.RegisterInterface(
 		"eth.evm.v1.TxData",
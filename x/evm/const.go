@@ -84,6 +84,13 @@ const (
 	KeyPrefixFunTokenIdxErc20
 	// KV store prefix for indexing `FunToken` by bank coin denomination
 	KeyPrefixFunTokenIdxBankDenom
+	// KV store prefix for `FunTokenRateLimit` by bank coin denomination
+	KeyPrefixFunTokenRateLimits
+	// KV store prefix for `FunTokenFlow` by bank coin denomination
+	KeyPrefixFunTokenFlows
+	// KV store prefix for the set of bank coin denominations of paused
+	// `FunToken` mappings
+	KeyPrefixFunTokenPaused
 )
 
 // KVStore transient prefix namespaces for the EVM Module. Transient stores only
@@ -0,0 +1,119 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evm
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+)
+
+// CanDeploy returns true if the deploy permission of the [Params] allows the
+// account to deploy contracts to the EVM. For deployments with CREATE or
+// CREATE2 from a contract, the deployer is the contract, not the origin of
+// the transaction.
+func (p Params) CanDeploy(deployer gethcommon.Address) bool {
+	switch p.DeployPermission {
+	case DeployPermissionEverybody:
+		return true
+	case DeployPermissionAllowlist:
+		for _, allowed := range p.DeployAllowlist {
+			if allowed.Address == deployer {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// DeployAllowlistHex returns the hexadecimal addresses of the deploy allowlist.
+func (p Params) DeployAllowlistHex() []string {
+	allowlist := make([]string, len(p.DeployAllowlist))
+	for i, addr := range p.DeployAllowlist {
+		allowlist[i] = addr.Hex()
+	}
+	return allowlist
+}
+
+// EditDeployAllowlist adds and removes accounts from the deploy allowlist of
+// the [Params]. Accounts that are already present aren't added again, and
+// accounts that aren't present are ignored on removal.
+func (p *Params) EditDeployAllowlist(add, remove []gethcommon.Address) {
+	toRemove := make(map[gethcommon.Address]struct{}, len(remove))
+	for _, addr := range remove {
+		toRemove[addr] = struct{}{}
+	}
+
+	candidates := make([]gethcommon.Address, 0, len(p.DeployAllowlist)+len(add))
+	for _, addr := range p.DeployAllowlist {
+		candidates = append(candidates, addr.Address)
+	}
+	candidates = append(candidates, add...)
+
+	allowlist := []eth.EIP55Addr{}
+	present := make(map[gethcommon.Address]struct{})
+	for _, addr := range candidates {
+		if _, ok := toRemove[addr]; ok {
+			continue
+		}
+		if _, ok := present[addr]; ok {
+			continue
+		}
+		present[addr] = struct{}{}
+		allowlist = append(allowlist, eth.EIP55Addr{Address: addr})
+	}
+	p.DeployAllowlist = allowlist
+}
+
+func validateDeployPermission(permission DeployPermission, allowlist []eth.EIP55Addr) error {
+	if _, ok := DeployPermission_name[int32(permission)]; !ok {
+		return fmt.Errorf("invalid deploy permission %d", permission)
+	}
+
+	unique := make(map[gethcommon.Address]struct{})
+	for _, addr := range allowlist {
+		if (addr.Address == gethcommon.Address{}) {
+			return fmt.Errorf("deploy allowlist cannot contain the zero address")
+		}
+		if _, ok := unique[addr.Address]; ok {
+			return fmt.Errorf("found duplicate address in deploy allowlist: %s", addr.Hex())
+		}
+		unique[addr.Address] = struct{}{}
+	}
+	return nil
+}
+
+// ParseHexOrBech32Addr parses an account address given either as an Ethereum
+// hexadecimal address or as a Nibiru Bech32 address.
+func ParseHexOrBech32Addr(addr string) (gethcommon.Address, error) {
+	req := &QueryEthAccountRequest{Address: addr}
+	isBech32, err := req.Validate()
+	if err != nil {
+		return gethcommon.Address{}, err
+	}
+	if isBech32 {
+		return eth.NibiruAddrToEthAddr(sdk.MustAccAddressFromBech32(addr)), nil
+	}
+	return gethcommon.HexToAddress(addr), nil
+}
+
+// ParseDeployPermission parses a [DeployPermission] given either by its full
+// name, like "DEPLOY_PERMISSION_ALLOWLIST", or by its short name, like
+// "allowlist". Case is ignored.
+func ParseDeployPermission(s string) (DeployPermission, error) {
+	name := strings.ToUpper(s)
+	if !strings.HasPrefix(name, "DEPLOY_PERMISSION_") {
+		name = "DEPLOY_PERMISSION_" + name
+	}
+	permission, ok := DeployPermission_value[name]
+	if !ok {
+		return 0, fmt.Errorf(
+			"invalid deploy permission \"%s\", expected one of: everybody, nobody, allowlist", s)
+	}
+	return DeployPermission(permission), nil
+}
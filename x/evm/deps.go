@@ -43,3 +43,14 @@ type SudoKeeper interface {
 	// execute certain permissioned functions.
 	CheckPermissions(contract sdk.AccAddress, ctx sdk.Context) error
 }
+
+// WasmKeeper defines the expected Wasm keeper interface. It's used to move
+// balances of CW20 tokens that have FunToken mappings.
+type WasmKeeper interface {
+	// Execute executes a Wasm contract with the given "caller" as the sender.
+	Execute(
+		ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins,
+	) ([]byte, error)
+	// QuerySmart runs a smart query against a Wasm contract.
+	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.8.19;
+
+import "@openzeppelin/contracts/token/ERC20/extensions/IERC20Permit.sol";
+import "@openzeppelin/contracts/interfaces/IERC5267.sol";
+import "@openzeppelin/contracts/utils/cryptography/ECDSA.sol";
+import "./ERC20MinterWithMetadataUpdates.sol";
+
+/// @dev {ERC20MinterWithMetadataUpdates} with gasless approvals through
+/// EIP-2612 "permit" signatures and EIP-5267 reporting of the EIP-712 domain.
+///
+/// This is the ERC20 deployed by the Nibiru EVM module when it creates a
+/// `FunToken` mapping for a bank coin.
+///
+/// Unlike OpenZeppelin's "ERC20Permit", the EIP-712 domain separator is not
+/// cached in immutables at construction. It's computed from the current
+/// `name()`, the version "1", `block.chainid`, and the address of the token
+/// whenever it's used. This has two consequences:
+///
+///  1. The runtime bytecode is the same for every deployment, so the EVM module
+///     can upgrade an existing "ERC20MinterWithMetadataUpdates" in place. The
+///     storage layout only appends the permit nonces.
+///  2. The domain always follows the Ethereum chain ID of the network and the
+///     latest token name set by the owner.
+contract ERC20MinterWithPermit is
+    ERC20MinterWithMetadataUpdates,
+    IERC20Permit,
+    IERC5267
+{
+    bytes32 private constant _TYPE_HASH =
+        keccak256(
+            "EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"
+        );
+
+    bytes32 private constant _PERMIT_TYPEHASH =
+        keccak256(
+            "Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"
+        );
+
+    /// @dev Version of the EIP-712 signing domain.
+    string private constant _VERSION = "1";
+
+    mapping(address => uint256) private _nonces;
+
+    /// @dev See {ERC20MinterWithMetadataUpdates-constructor}.
+    constructor(
+        string memory name_,
+        string memory symbol_,
+        uint8 decimals_
+    ) ERC20MinterWithMetadataUpdates(name_, symbol_, decimals_) {}
+
+    /// @notice Sets `value` as the allowance of `spender` over `owner`'s tokens,
+    /// given `owner`'s signed approval.
+    /// @dev See {IERC20Permit-permit}.
+    function permit(
+        address owner,
+        address spender,
+        uint256 value,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) public virtual override {
+        require(block.timestamp <= deadline, "ERC20Permit: expired deadline");
+
+        bytes32 structHash = keccak256(
+            abi.encode(
+                _PERMIT_TYPEHASH,
+                owner,
+                spender,
+                value,
+                _useNonce(owner),
+                deadline
+            )
+        );
+        bytes32 hash = ECDSA.toTypedDataHash(DOMAIN_SEPARATOR(), structHash);
+
+        address signer = ECDSA.recover(hash, v, r, s);
+        require(signer == owner, "ERC20Permit: invalid signature");
+
+        _approve(owner, spender, value);
+    }
+
+    /// @notice Returns the current nonce for `owner`. This value must be
+    /// included whenever a signature is generated for {permit}.
+    /// @dev See {IERC20Permit-nonces}.
+    function nonces(
+        address owner
+    ) public view virtual override returns (uint256) {
+        return _nonces[owner];
+    }
+
+    /// @notice Returns the domain separator used in the encoding of the
+    /// signature for {permit}, as defined by EIP-712.
+    /// @dev See {IERC20Permit-DOMAIN_SEPARATOR}.
+    // solhint-disable-next-line func-name-mixedcase
+    function DOMAIN_SEPARATOR() public view virtual override returns (bytes32) {
+        return
+            keccak256(
+                abi.encode(
+                    _TYPE_HASH,
+                    keccak256(bytes(name())),
+                    keccak256(bytes(_VERSION)),
+                    block.chainid,
+                    address(this)
+                )
+            );
+    }
+
+    /// @notice Returns the fields and values that describe the EIP-712 domain
+    /// used for {permit} signatures.
+    /// @dev See {IERC5267-eip712Domain}.
+    function eip712Domain()
+        public
+        view
+        virtual
+        override
+        returns (
+            bytes1,
+            string memory,
+            string memory,
+            uint256,
+            address,
+            bytes32,
+            uint256[] memory
+        )
+    {
+        return (
+            hex"0f", // 01111: name, version, chainId, verifyingContract
+            name(),
+            _VERSION,
+            block.chainid,
+            address(this),
+            bytes32(0),
+            new uint256[](0)
+        );
+    }
+
+    /// @dev Consumes a nonce: returns the current value and increments it.
+    function _useNonce(
+        address owner
+    ) internal virtual returns (uint256 current) {
+        current = _nonces[owner];
+        _nonces[owner] = current + 1;
+    }
+}
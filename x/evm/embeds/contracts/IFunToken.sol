@@ -14,9 +14,11 @@ interface IFunToken is INibiruEvm {
     /// @param erc20 - the address of the ERC20 token contract
     /// @param amount - the amount of tokens to send
     /// @param to - the receiving Nibiru base account address as a string
-    /// @return sentAmount - amount of tokens received by the recipient. This may
-    /// not be equal to `amount` if the corresponding ERC20 contract has a fee or
-    /// deduction on transfer.
+    /// @return sentAmount - amount of bank coins received by the recipient. This
+    /// may not be equal to `amount` if the corresponding ERC20 contract has a fee
+    /// or deduction on transfer, or if the FunToken mapping has a decimals scale.
+    /// With a decimals scale of "k", `amount` is divided by 10^k, and the
+    /// remainder stays in the escrow of the EVM module.
     function sendToBank(
         address erc20,
         uint256 amount,
@@ -40,7 +42,9 @@ interface IFunToken is INibiruEvm {
     }
 
     /// @notice Method "balance" returns the ERC20 balance and Bank Coin balance
-    /// of some fungible token held by the given account.
+    /// of some fungible token held by the given account. Both balances are in
+    /// ERC20 base units: if the FunToken mapping has a decimals scale of "k",
+    /// the Bank Coin balance is multiplied by 10^k.
     function balance(
         address who,
         address funtoken
@@ -55,7 +59,8 @@ interface IFunToken is INibiruEvm {
         );
 
     /// @notice Method "bankBalance" returns the Bank Coin balance of some
-    /// fungible token held by the given account.
+    /// fungible token held by the given account. If `bankDenom` has a FunToken
+    /// mapping with a decimals scale, the balance is scaled to ERC20 base units.
     function bankBalance(
         address who,
         string calldata bankDenom
@@ -85,6 +90,8 @@ interface IFunToken is INibiruEvm {
     /// @param amount The number of coins to send.
     /// @param to The Ethereum hex or bech32 address receiving the ERC-20.
     /// @return sentAmount The number of ERC-20 tokens minted or un-escrowed.
+    /// This is `amount` times 10^k if the FunToken mapping has a decimals scale
+    /// of "k".
     function sendToEvm(
         string calldata bankDenom,
         uint256 amount,
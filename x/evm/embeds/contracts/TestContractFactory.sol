@@ -0,0 +1,24 @@
+// contracts/TestContractFactory.sol
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.24;
+
+contract TestFactoryChild {
+    uint256 public value = 1;
+}
+
+/// @notice Test contract that deploys other contracts with CREATE and CREATE2.
+contract TestContractFactory {
+    event ChildDeployed(address child);
+
+    function deploy() public returns (address) {
+        address child = address(new TestFactoryChild());
+        emit ChildDeployed(child);
+        return child;
+    }
+
+    function deploy2(bytes32 salt) public returns (address) {
+        address child = address(new TestFactoryChild{salt: salt}());
+        emit ChildDeployed(child);
+        return child;
+    }
+}
@@ -19,6 +19,8 @@ var (
 	erc20MinterContractJSON []byte
 	//go:embed artifacts/contracts/ERC20MinterWithMetadataUpdates.sol/ERC20MinterWithMetadataUpdates.json
 	erc20MinterWithMetadataUpdatesContractJSON []byte
+	//go:embed artifacts/contracts/ERC20MinterWithPermit.sol/ERC20MinterWithPermit.json
+	erc20MinterWithPermitContractJSON []byte
 	//go:embed artifacts/contracts/IOracle.sol/IOracle.json
 	oracleContractJSON []byte
 	//go:embed artifacts/contracts/IFunToken.sol/IFunToken.json
@@ -56,6 +58,8 @@ var (
 	testDirtyStateAttack4 []byte
 	//go:embed artifacts/contracts/TestDirtyStateAttack5.sol/TestDirtyStateAttack5.json
 	testDirtyStateAttack5 []byte
+	//go:embed artifacts/contracts/TestContractFactory.sol/TestContractFactory.json
+	testContractFactory []byte
 )
 
 var (
@@ -73,6 +77,15 @@ var (
 		EmbedJSON: erc20MinterWithMetadataUpdatesContractJSON,
 	}
 
+	// SmartContract_ERC20MinterWithPermit: The default ERC20 contract deployed
+	// during the creation of a `FunToken` mapping from a bank coin. It extends
+	// "ERC20MinterWithMetadataUpdates.sol" with EIP-2612 permit approvals and
+	// EIP-5267 domain reporting.
+	SmartContract_ERC20MinterWithPermit = CompiledEvmContract{
+		Name:      "ERC20MinterWithPermit.sol",
+		EmbedJSON: erc20MinterWithPermitContractJSON,
+	}
+
 	// SmartContract_Funtoken: Precompile contract interface for
 	// "IFunToken.sol". This precompile enables transfers of ERC20 tokens
 	// to non-EVM accounts. Only the ABI is used.
@@ -186,10 +199,16 @@ var (
 		Name:      "TestDirtyStateAttack5.sol",
 		EmbedJSON: testDirtyStateAttack5,
 	}
+	// SmartContract_TestContractFactory is a test contract that deploys other contracts with CREATE and CREATE2
+	SmartContract_TestContractFactory = CompiledEvmContract{
+		Name:      "TestContractFactory.sol",
+		EmbedJSON: testContractFactory,
+	}
 )
 
 func init() {
 	SmartContract_ERC20MinterWithMetadataUpdates.MustLoad()
+	SmartContract_ERC20MinterWithPermit.MustLoad()
 	SmartContract_FunToken.MustLoad()
 	SmartContract_Wasm.MustLoad()
 	SmartContract_Oracle.MustLoad()
@@ -209,6 +228,7 @@ func init() {
 	SmartContract_TestPrecompileSendToBankThenERC20Transfer.MustLoad()
 	SmartContract_TestDirtyStateAttack4.MustLoad()
 	SmartContract_TestDirtyStateAttack5.MustLoad()
+	SmartContract_TestContractFactory.MustLoad()
 }
 
 type CompiledEvmContract struct {
@@ -218,6 +238,9 @@ type CompiledEvmContract struct {
 	// filled in post-load
 	ABI      *gethabi.ABI `json:"abi"`
 	Bytecode []byte       `json:"bytecode"`
+	// DeployedBytecode is the runtime bytecode stored at the contract address
+	// after deployment.
+	DeployedBytecode []byte `json:"deployedBytecode"`
 }
 
 func (sc *CompiledEvmContract) MustLoad() {
@@ -243,5 +266,14 @@ func (sc *CompiledEvmContract) MustLoad() {
 		panic(err)
 	}
 	sc.Bytecode = gethcommon.FromHex(bytecodeStr)
+
+	var deployedBytecodeStr string
+	if deployedJson, ok := rawJsonBz["deployedBytecode"]; ok {
+		err = json.Unmarshal(deployedJson, &deployedBytecodeStr)
+		if err != nil {
+			panic(err)
+		}
+	}
+	sc.DeployedBytecode = gethcommon.FromHex(deployedBytecodeStr)
 	sc.ABI = abi
 }
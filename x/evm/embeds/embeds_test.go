@@ -12,6 +12,7 @@ import (
 func TestLoadContracts(t *testing.T) {
 	require.NotPanics(t, func() {
 		embeds.SmartContract_ERC20MinterWithMetadataUpdates.MustLoad()
+		embeds.SmartContract_ERC20MinterWithPermit.MustLoad()
 		embeds.SmartContract_FunToken.MustLoad()
 		embeds.SmartContract_TestERC20.MustLoad()
 		embeds.SmartContract_TestERC20MaliciousName.MustLoad()
@@ -23,5 +24,6 @@ func TestLoadContracts(t *testing.T) {
 		embeds.SmartContract_TestERC20TransferWithFee.MustLoad()
 		embeds.SmartContract_TestRandom.MustLoad()
 		embeds.SmartContract_TestBytes32Metadata.MustLoad()
+		embeds.SmartContract_TestContractFactory.MustLoad()
 	})
 }
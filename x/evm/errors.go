@@ -21,6 +21,9 @@ const (
 	codeErrInvalidBaseFee
 	codeErrInvalidAccount
 	codeErrInactivePrecompile
+	codeErrFunTokenPaused
+	codeErrFunTokenRateLimit
+	codeErrDeployNotPermitted
 )
 
 var (
@@ -51,6 +54,18 @@ var (
 	// ErrInvalidAccount returns an error if the account is not an EVM compatible account
 	ErrInvalidAccount = sdkioerrors.Register(ModuleName, codeErrInvalidAccount, "account type is not a valid ethereum account")
 
+	// ErrFunTokenPaused returns an error if conversions of a FunToken mapping
+	// are paused.
+	ErrFunTokenPaused = sdkioerrors.Register(ModuleName, codeErrFunTokenPaused, "FunToken conversions are paused")
+
+	// ErrFunTokenRateLimit returns an error if a conversion would exceed the
+	// rate limit of a FunToken mapping.
+	ErrFunTokenRateLimit = sdkioerrors.Register(ModuleName, codeErrFunTokenRateLimit, "FunToken rate limit exceeded")
+
+	// ErrDeployNotPermitted returns an error if an account that the deploy
+	// permission of the EVM doesn't allow tries to deploy a contract.
+	ErrDeployNotPermitted = sdkioerrors.Register(ModuleName, codeErrDeployNotPermitted, "contract deployment not permitted")
+
 	ErrCanonicalWnibi = "canonical WNIBI address in state is a not a smart contract"
 )
 
@@ -223,6 +223,11 @@ type EventFunTokenCreated struct {
 	Erc20ContractAddress string `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
 	Creator              string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
 	IsMadeFromCoin       bool   `protobuf:"varint,4,opt,name=is_made_from_coin,json=isMadeFromCoin,proto3" json:"is_made_from_coin,omitempty"`
+	// Bech32 address of the CW20 contract if the mapping originates from a CW20.
+	Cw20Addr string `protobuf:"bytes,5,opt,name=cw20_addr,json=cw20Addr,proto3" json:"cw20_addr,omitempty"`
+	// Exponent that scales amounts between the bank coin and the ERC-20. See
+	// "FunToken.decimals_scale".
+	DecimalsScale uint32 `protobuf:"varint,6,opt,name=decimals_scale,json=decimalsScale,proto3" json:"decimals_scale,omitempty"`
 }
 
 func (m *EventFunTokenCreated) Reset()         { *m = EventFunTokenCreated{} }
@@ -286,6 +291,20 @@ func (m *EventFunTokenCreated) GetIsMadeFromCoin() bool {
 	return false
 }
 
+func (m *EventFunTokenCreated) GetCw20Addr() string {
+	if m != nil {
+		return m.Cw20Addr
+	}
+	return ""
+}
+
+func (m *EventFunTokenCreated) GetDecimalsScale() uint32 {
+	if m != nil {
+		return m.DecimalsScale
+	}
+	return 0
+}
+
 // EventConvertCoinToEvm is an event emitted when converting Bank Coins into
 // ERC20 tokens with the "eth.evm.v1.MsgConvertCoinToEvm" transaction message.
 type EventConvertCoinToEvm struct {
@@ -293,6 +312,9 @@ type EventConvertCoinToEvm struct {
 	Erc20ContractAddress string     `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
 	ToEthAddr            string     `protobuf:"bytes,3,opt,name=to_eth_addr,json=toEthAddr,proto3" json:"to_eth_addr,omitempty"`
 	BankCoin             types.Coin `protobuf:"bytes,4,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin" yaml:"bank_coin"`
+	// Amount of ERC-20 tokens minted or transferred to "to_eth_addr". Differs
+	// from the amount of "bank_coin" when the mapping has a "decimals_scale".
+	Erc20Amount string `protobuf:"bytes,5,opt,name=erc20_amount,json=erc20Amount,proto3" json:"erc20_amount,omitempty"`
 }
 
 func (m *EventConvertCoinToEvm) Reset()         { *m = EventConvertCoinToEvm{} }
@@ -356,6 +378,13 @@ func (m *EventConvertCoinToEvm) GetBankCoin() types.Coin {
 	return types.Coin{}
 }
 
+func (m *EventConvertCoinToEvm) GetErc20Amount() string {
+	if m != nil {
+		return m.Erc20Amount
+	}
+	return ""
+}
+
 // EventTransfer defines event for EVM transfer
 type EventTransfer struct {
 	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -531,6 +560,9 @@ type EventConvertEvmToCoin struct {
 	ToAddress            string     `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
 	BankCoin             types.Coin `protobuf:"bytes,4,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin" yaml:"bank_coin"`
 	SenderEthAddr        string     `protobuf:"bytes,6,opt,name=sender_eth_addr,json=senderEthAddr,proto3" json:"sender_eth_addr,omitempty"`
+	// Amount of ERC-20 tokens taken from the sender. Differs from the amount of
+	// "bank_coin" when the mapping has a "decimals_scale".
+	Erc20Amount string `protobuf:"bytes,7,opt,name=erc20_amount,json=erc20Amount,proto3" json:"erc20_amount,omitempty"`
 }
 
 func (m *EventConvertEvmToCoin) Reset()         { *m = EventConvertEvmToCoin{} }
@@ -601,6 +633,201 @@ func (m *EventConvertEvmToCoin) GetSenderEthAddr() string {
 	return ""
 }
 
+func (m *EventConvertEvmToCoin) GetErc20Amount() string {
+	if m != nil {
+		return m.Erc20Amount
+	}
+	return ""
+}
+
+// EventFunTokenRateLimitUpdated is emitted when the rate limit of a `FunToken`
+// mapping is set or removed with "MsgSetFunTokenRateLimit".
+type EventFunTokenRateLimitUpdated struct {
+	Sender    string            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	RateLimit FunTokenRateLimit `protobuf:"bytes,2,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	// True if the rate limit was removed.
+	Removed bool `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (m *EventFunTokenRateLimitUpdated) Reset()         { *m = EventFunTokenRateLimitUpdated{} }
+func (m *EventFunTokenRateLimitUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventFunTokenRateLimitUpdated) ProtoMessage()    {}
+func (*EventFunTokenRateLimitUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{9}
+}
+func (m *EventFunTokenRateLimitUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFunTokenRateLimitUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFunTokenRateLimitUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFunTokenRateLimitUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFunTokenRateLimitUpdated.Merge(m, src)
+}
+func (m *EventFunTokenRateLimitUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFunTokenRateLimitUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFunTokenRateLimitUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFunTokenRateLimitUpdated proto.InternalMessageInfo
+
+func (m *EventFunTokenRateLimitUpdated) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventFunTokenRateLimitUpdated) GetRateLimit() FunTokenRateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return FunTokenRateLimit{}
+}
+
+func (m *EventFunTokenRateLimitUpdated) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
+// EventFunTokenPauseUpdated is emitted when conversions of a `FunToken`
+// mapping are paused or unpaused with "MsgSetFunTokenPaused".
+type EventFunTokenPauseUpdated struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	BankDenom string `protobuf:"bytes,2,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	Paused    bool   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *EventFunTokenPauseUpdated) Reset()         { *m = EventFunTokenPauseUpdated{} }
+func (m *EventFunTokenPauseUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventFunTokenPauseUpdated) ProtoMessage()    {}
+func (*EventFunTokenPauseUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{10}
+}
+func (m *EventFunTokenPauseUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFunTokenPauseUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFunTokenPauseUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFunTokenPauseUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFunTokenPauseUpdated.Merge(m, src)
+}
+func (m *EventFunTokenPauseUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFunTokenPauseUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFunTokenPauseUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFunTokenPauseUpdated proto.InternalMessageInfo
+
+func (m *EventFunTokenPauseUpdated) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventFunTokenPauseUpdated) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *EventFunTokenPauseUpdated) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+// EventDeployPermissionUpdated is emitted when the deploy permission of the EVM
+// is set with "MsgSetDeployPermission".
+type EventDeployPermissionUpdated struct {
+	Sender     string           `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Permission DeployPermission `protobuf:"varint,2,opt,name=permission,proto3,enum=eth.evm.v1.DeployPermission" json:"permission,omitempty"`
+	// Hexadecimal addresses of the deploy allowlist after the update.
+	Allowlist []string `protobuf:"bytes,3,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+}
+
+func (m *EventDeployPermissionUpdated) Reset()         { *m = EventDeployPermissionUpdated{} }
+func (m *EventDeployPermissionUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventDeployPermissionUpdated) ProtoMessage()    {}
+func (*EventDeployPermissionUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{11}
+}
+func (m *EventDeployPermissionUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeployPermissionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeployPermissionUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDeployPermissionUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeployPermissionUpdated.Merge(m, src)
+}
+func (m *EventDeployPermissionUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeployPermissionUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeployPermissionUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeployPermissionUpdated proto.InternalMessageInfo
+
+func (m *EventDeployPermissionUpdated) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventDeployPermissionUpdated) GetPermission() DeployPermission {
+	if m != nil {
+		return m.Permission
+	}
+	return DeployPermissionEverybody
+}
+
+func (m *EventDeployPermissionUpdated) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventEthereumTx)(nil), "eth.evm.v1.EventEthereumTx")
 	proto.RegisterType((*EventTxLog)(nil), "eth.evm.v1.EventTxLog")
@@ -611,54 +838,70 @@ func init() {
 	proto.RegisterType((*EventContractDeployed)(nil), "eth.evm.v1.EventContractDeployed")
 	proto.RegisterType((*EventContractExecuted)(nil), "eth.evm.v1.EventContractExecuted")
 	proto.RegisterType((*EventConvertEvmToCoin)(nil), "eth.evm.v1.EventConvertEvmToCoin")
+	proto.RegisterType((*EventFunTokenRateLimitUpdated)(nil), "eth.evm.v1.EventFunTokenRateLimitUpdated")
+	proto.RegisterType((*EventFunTokenPauseUpdated)(nil), "eth.evm.v1.EventFunTokenPauseUpdated")
+	proto.RegisterType((*EventDeployPermissionUpdated)(nil), "eth.evm.v1.EventDeployPermissionUpdated")
 }
 
 func init() { proto.RegisterFile("eth/evm/v1/events.proto", fileDescriptor_f8bc26b53c788f17) }
 
 var fileDescriptor_f8bc26b53c788f17 = []byte{
-	// 668 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xdb, 0xf4, 0x27, 0xd3, 0xaf, 0x5f, 0xc1, 0x0a, 0xc5, 0xad, 0xa8, 0x5b, 0x19, 0xa9,
-	0xb4, 0x1b, 0x9b, 0x04, 0x24, 0x24, 0x56, 0x90, 0x34, 0x15, 0x8b, 0x82, 0x50, 0x14, 0x36, 0x48,
-	0xc8, 0x9a, 0xd8, 0xb7, 0xb6, 0xd5, 0xcc, 0xdc, 0x6a, 0x66, 0x62, 0xa5, 0x6f, 0xc1, 0xa3, 0xf0,
-	0x0c, 0xac, 0xba, 0xec, 0x0e, 0x56, 0x15, 0x6a, 0xdf, 0x80, 0x07, 0x40, 0x68, 0xc6, 0x4e, 0xd2,
-	0x16, 0x75, 0x03, 0xdd, 0xdd, 0xff, 0xb9, 0xf7, 0x9c, 0xa3, 0x21, 0x0f, 0x41, 0xa5, 0x01, 0xe4,
-	0x2c, 0xc8, 0x1b, 0x01, 0xe4, 0xc0, 0x95, 0xf4, 0x8f, 0x05, 0x2a, 0xb4, 0x09, 0xa8, 0xd4, 0x87,
-	0x9c, 0xf9, 0x79, 0x63, 0xdd, 0x8d, 0x50, 0x32, 0x94, 0x41, 0x9f, 0x4a, 0x08, 0xf2, 0x46, 0x1f,
-	0x14, 0x6d, 0x04, 0x11, 0x66, 0xbc, 0xa8, 0x5d, 0xaf, 0x5f, 0x1b, 0xc2, 0xc6, 0xd1, 0x04, 0x13,
-	0x34, 0x66, 0xa0, 0xad, 0x22, 0xea, 0x7d, 0xb5, 0xc8, 0x4a, 0x47, 0x3f, 0xd4, 0x51, 0x29, 0x08,
-	0x18, 0xb2, 0xde, 0xc8, 0x5e, 0x25, 0xf3, 0x94, 0xe1, 0x90, 0x2b, 0xc7, 0xda, 0xb2, 0x76, 0x6a,
-	0xdd, 0xd2, 0xb3, 0xd7, 0xc8, 0x22, 0xa8, 0x34, 0x4c, 0xa9, 0x4c, 0x9d, 0x19, 0x93, 0x59, 0x00,
-	0x95, 0xbe, 0xa1, 0x32, 0xb5, 0xeb, 0x64, 0x2e, 0xe3, 0x31, 0x8c, 0x9c, 0x59, 0x13, 0x2f, 0x1c,
-	0xdd, 0x90, 0x50, 0x19, 0x0e, 0x25, 0xc4, 0x4e, 0xb5, 0x68, 0x48, 0xa8, 0xfc, 0x20, 0x21, 0xb6,
-	0x6d, 0x52, 0x35, 0x73, 0xe6, 0x4c, 0xd8, 0xd8, 0xf6, 0x23, 0x52, 0x13, 0x10, 0x65, 0xc7, 0x19,
-	0x70, 0xe5, 0xcc, 0x9b, 0xc4, 0x34, 0xa0, 0x87, 0xe5, 0x2c, 0x04, 0x21, 0x50, 0x38, 0x0b, 0xc5,
-	0xb0, 0x9c, 0x75, 0xb4, 0xeb, 0xbd, 0x20, 0xc4, 0xdc, 0xd0, 0x1b, 0x1d, 0x60, 0x62, 0xef, 0x92,
-	0xea, 0x00, 0x13, 0xe9, 0x58, 0x5b, 0xb3, 0x3b, 0x4b, 0xcd, 0x15, 0x7f, 0x8a, 0x9c, 0x7f, 0x80,
-	0x49, 0xab, 0x7a, 0x7a, 0xbe, 0x59, 0xe9, 0x9a, 0x12, 0xef, 0x49, 0x79, 0x7c, 0x6b, 0x80, 0xd1,
-	0x51, 0x6b, 0x80, 0xc8, 0xf4, 0x25, 0x7d, 0x6d, 0x94, 0xb7, 0x17, 0x8e, 0xf7, 0xc5, 0x22, 0x75,
-	0x53, 0xb9, 0x3f, 0xe4, 0x3d, 0x3c, 0x02, 0xde, 0x16, 0x40, 0x15, 0xc4, 0xf6, 0x06, 0x21, 0x7d,
-	0xca, 0x8f, 0xc2, 0x18, 0xf8, 0xa4, 0xa7, 0xa6, 0x23, 0x7b, 0x3a, 0x60, 0x3f, 0x27, 0xab, 0x20,
-	0xa2, 0xe6, 0xd3, 0x30, 0x42, 0xae, 0x04, 0x8d, 0x54, 0x48, 0xe3, 0x58, 0x80, 0x94, 0x25, 0x80,
-	0x75, 0x93, 0x6d, 0x97, 0xc9, 0xd7, 0x45, 0xce, 0x76, 0xc8, 0x42, 0xa4, 0xe7, 0xa3, 0x28, 0xf1,
-	0x1c, 0xbb, 0xf6, 0x2e, 0xb9, 0x9f, 0xc9, 0x90, 0xd1, 0x18, 0xc2, 0x43, 0x81, 0x2c, 0xd4, 0xac,
-	0x1b, 0x68, 0x17, 0xbb, 0xff, 0x67, 0xf2, 0x2d, 0x8d, 0x61, 0x5f, 0x20, 0x6b, 0x63, 0xc6, 0xbd,
-	0x6f, 0x16, 0x79, 0x60, 0x56, 0x6e, 0x23, 0xcf, 0x41, 0x28, 0x1d, 0xec, 0x61, 0x27, 0x67, 0x9a,
-	0x5f, 0x09, 0x3c, 0x06, 0x31, 0xe6, 0xb7, 0xf0, 0xfe, 0x72, 0x59, 0x97, 0x2c, 0x29, 0x0c, 0xb5,
-	0x30, 0x74, 0x75, 0xb9, 0x70, 0x4d, 0x61, 0x47, 0xa5, 0xba, 0xc4, 0x7e, 0x4f, 0x0c, 0x1e, 0xd3,
-	0x55, 0x97, 0x9a, 0x6b, 0x7e, 0xa1, 0x60, 0x5f, 0x2b, 0xd8, 0x2f, 0x15, 0xec, 0xeb, 0x05, 0x5b,
-	0x8e, 0x66, 0xe7, 0xe7, 0xf9, 0xe6, 0xbd, 0x13, 0xca, 0x06, 0x2f, 0xbd, 0x49, 0xa7, 0xd7, 0x5d,
-	0xd4, 0xb6, 0xb9, 0xec, 0x13, 0x59, 0x2e, 0xe8, 0x16, 0x94, 0xcb, 0x43, 0x10, 0xb7, 0x1e, 0x74,
-	0x4d, 0x50, 0x33, 0x37, 0x05, 0x35, 0x95, 0xf9, 0xec, 0x55, 0x99, 0x7b, 0xbd, 0x29, 0x6e, 0xe6,
-	0xd0, 0x3d, 0x38, 0x1e, 0xe0, 0x09, 0xc4, 0xb7, 0x3e, 0xf3, 0x98, 0x2c, 0x5f, 0x43, 0xac, 0x7c,
-	0xea, 0xbf, 0xe8, 0x0a, 0x52, 0x7f, 0x4c, 0xed, 0x8c, 0x20, 0x1a, 0xaa, 0x7f, 0x9d, 0xfa, 0xeb,
-	0x06, 0xc9, 0x9d, 0x9c, 0xf5, 0x50, 0x83, 0x74, 0xc7, 0x24, 0x6f, 0x10, 0xa2, 0x70, 0x52, 0x39,
-	0xe1, 0x78, 0x9c, 0xbe, 0x73, 0x8e, 0xed, 0x6d, 0xb2, 0x52, 0x2c, 0x3c, 0x55, 0x56, 0xf1, 0x23,
-	0x2c, 0x17, 0xe1, 0x52, 0x5d, 0xad, 0x57, 0xa7, 0x17, 0xae, 0x75, 0x76, 0xe1, 0x5a, 0x3f, 0x2e,
-	0x5c, 0xeb, 0xf3, 0xa5, 0x5b, 0x39, 0xbb, 0x74, 0x2b, 0xdf, 0x2f, 0xdd, 0xca, 0xc7, 0xed, 0x24,
-	0x53, 0xe9, 0xb0, 0xef, 0x47, 0xc8, 0x82, 0x77, 0x59, 0x3f, 0x13, 0xc3, 0x76, 0x4a, 0x33, 0x1e,
-	0x70, 0x63, 0x07, 0x79, 0x33, 0x18, 0xe9, 0xdf, 0xb1, 0x3f, 0x6f, 0x3e, 0xc2, 0x67, 0xbf, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x51, 0xcd, 0x5e, 0xf1, 0x7b, 0x05, 0x00, 0x00,
+	// 866 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x93, 0x34, 0xc9, 0xbe, 0xed, 0x26, 0x60, 0x85, 0xe0, 0x94, 0x64, 0x5b, 0x8c, 0x28,
+	0xed, 0xc5, 0x6e, 0x16, 0x24, 0x24, 0xc4, 0x81, 0xee, 0x76, 0x2b, 0x0e, 0x01, 0x45, 0xcb, 0xf6,
+	0x82, 0x84, 0xac, 0x59, 0xfb, 0x75, 0x3d, 0xc4, 0x33, 0xb3, 0x9a, 0x19, 0xbb, 0xdb, 0x6f, 0x81,
+	0x90, 0xf8, 0x00, 0x7c, 0x15, 0x4e, 0x3d, 0xf6, 0xc8, 0xa9, 0x42, 0xc9, 0x37, 0x40, 0x9c, 0x38,
+	0xa1, 0x99, 0xf1, 0xfe, 0x4b, 0x54, 0x2a, 0x41, 0x6f, 0xf3, 0x7e, 0xf3, 0xe6, 0xfd, 0xf9, 0xf9,
+	0xf7, 0x9e, 0xe1, 0x7d, 0xd4, 0x79, 0x8c, 0x15, 0x8b, 0xab, 0x93, 0x18, 0x2b, 0xe4, 0x5a, 0x45,
+	0x13, 0x29, 0xb4, 0xf0, 0x01, 0x75, 0x1e, 0x61, 0xc5, 0xa2, 0xea, 0xe4, 0x56, 0x3b, 0x15, 0x8a,
+	0x09, 0x15, 0x8f, 0x88, 0xc2, 0xb8, 0x3a, 0x19, 0xa1, 0x26, 0x27, 0x71, 0x2a, 0x28, 0x77, 0xbe,
+	0xb7, 0xf6, 0x57, 0x82, 0xb0, 0x19, 0x3a, 0x16, 0x63, 0x61, 0x8f, 0xb1, 0x39, 0x39, 0x34, 0xfc,
+	0xcd, 0x83, 0xbd, 0xbe, 0x49, 0xd4, 0xd7, 0x39, 0x4a, 0x2c, 0xd9, 0x70, 0xea, 0x1f, 0xc0, 0x16,
+	0x61, 0xa2, 0xe4, 0x3a, 0xf0, 0xee, 0x78, 0xf7, 0x1a, 0x83, 0xda, 0xf2, 0x0f, 0x61, 0x07, 0x75,
+	0x9e, 0xe4, 0x44, 0xe5, 0xc1, 0xba, 0xbd, 0xd9, 0x46, 0x9d, 0x7f, 0x4d, 0x54, 0xee, 0xef, 0xc3,
+	0x0d, 0xca, 0x33, 0x9c, 0x06, 0x1b, 0x16, 0x77, 0x86, 0x79, 0x30, 0x26, 0x2a, 0x29, 0x15, 0x66,
+	0xc1, 0xa6, 0x7b, 0x30, 0x26, 0xea, 0x89, 0xc2, 0xcc, 0xf7, 0x61, 0xd3, 0xc6, 0xb9, 0x61, 0x61,
+	0x7b, 0xf6, 0x8f, 0xa0, 0x21, 0x31, 0xa5, 0x13, 0x8a, 0x5c, 0x07, 0x5b, 0xf6, 0x62, 0x01, 0x98,
+	0x60, 0x15, 0x4b, 0x50, 0x4a, 0x21, 0x83, 0x6d, 0x17, 0xac, 0x62, 0x7d, 0x63, 0x86, 0x9f, 0x03,
+	0xd8, 0x1e, 0x86, 0xd3, 0x53, 0x31, 0xf6, 0xef, 0xc3, 0x66, 0x21, 0xc6, 0x2a, 0xf0, 0xee, 0x6c,
+	0xdc, 0x6b, 0x76, 0xf6, 0xa2, 0x05, 0x73, 0xd1, 0xa9, 0x18, 0x77, 0x37, 0x5f, 0xbc, 0xba, 0xbd,
+	0x36, 0xb0, 0x2e, 0xe1, 0x27, 0x75, 0xf3, 0xdd, 0x42, 0xa4, 0xe7, 0xdd, 0x42, 0x08, 0x66, 0x3a,
+	0x19, 0x99, 0x43, 0xdd, 0xbb, 0x33, 0xc2, 0xbf, 0x3c, 0xd8, 0xb7, 0x9e, 0x8f, 0x4b, 0x3e, 0x14,
+	0xe7, 0xc8, 0x7b, 0x12, 0x89, 0xc6, 0xcc, 0x3f, 0x06, 0x18, 0x11, 0x7e, 0x9e, 0x64, 0xc8, 0xe7,
+	0x6f, 0x1a, 0x06, 0x79, 0x64, 0x00, 0xff, 0x33, 0x38, 0x40, 0x99, 0x76, 0x1e, 0x24, 0xa9, 0xe0,
+	0x5a, 0x92, 0x54, 0x27, 0x24, 0xcb, 0x24, 0x2a, 0x55, 0x13, 0xb8, 0x6f, 0x6f, 0x7b, 0xf5, 0xe5,
+	0x43, 0x77, 0xe7, 0x07, 0xb0, 0x9d, 0x9a, 0xf8, 0x42, 0xd6, 0x7c, 0xce, 0x4c, 0xff, 0x3e, 0xbc,
+	0x4b, 0x55, 0xc2, 0x48, 0x86, 0xc9, 0x53, 0x29, 0x58, 0x62, 0xbe, 0xba, 0xa5, 0x76, 0x67, 0xb0,
+	0x4b, 0xd5, 0x37, 0x24, 0xc3, 0xc7, 0x52, 0xb0, 0x9e, 0xa0, 0xdc, 0xff, 0x00, 0x1a, 0xe9, 0xb3,
+	0xce, 0x03, 0x9b, 0xb0, 0xa6, 0x79, 0xc7, 0x00, 0x26, 0x89, 0xff, 0x31, 0xec, 0x66, 0x98, 0x52,
+	0x46, 0x0a, 0x95, 0xa8, 0x94, 0x14, 0x68, 0xf9, 0x6e, 0x0d, 0x5a, 0x33, 0xf4, 0x3b, 0x03, 0x86,
+	0x7f, 0x7b, 0xf0, 0x9e, 0x6d, 0xbb, 0x27, 0x78, 0x85, 0x52, 0x9b, 0xc0, 0x43, 0xd1, 0xaf, 0x98,
+	0xd1, 0x88, 0x42, 0x9e, 0xa1, 0x9c, 0x69, 0xc4, 0x59, 0xff, 0xb1, 0xe1, 0x36, 0x34, 0xb5, 0x48,
+	0x8c, 0xb8, 0x6c, 0xb5, 0xae, 0xe9, 0x86, 0x16, 0x7d, 0x9d, 0xdb, 0x72, 0xcf, 0xc0, 0x72, 0xba,
+	0x68, 0xb7, 0xd9, 0x39, 0x8c, 0xdc, 0x14, 0x44, 0x66, 0x0a, 0xa2, 0x7a, 0x0a, 0x22, 0x53, 0x60,
+	0x37, 0x30, 0x5f, 0xf8, 0xcf, 0x57, 0xb7, 0xdf, 0x79, 0x4e, 0x58, 0xf1, 0x45, 0x38, 0x7f, 0x19,
+	0x0e, 0x76, 0xcc, 0xd9, 0xb2, 0xf3, 0x21, 0xdc, 0x74, 0x75, 0xd6, 0x4a, 0x77, 0x04, 0x35, 0x2d,
+	0xf6, 0xd0, 0x42, 0xe1, 0x0f, 0xd0, 0x72, 0xaa, 0x92, 0x84, 0xab, 0xa7, 0x28, 0x5f, 0xdb, 0xf3,
+	0x8a, 0x6e, 0xd7, 0xaf, 0xea, 0x76, 0x31, 0x4d, 0x1b, 0xcb, 0xd3, 0x14, 0x0e, 0x17, 0xd4, 0x5a,
+	0x2e, 0x1e, 0xe1, 0xa4, 0x10, 0xcf, 0x31, 0x7b, 0x6d, 0x9a, 0x8f, 0xa0, 0xb5, 0x42, 0x6a, 0x9d,
+	0xea, 0x66, 0xba, 0x44, 0xe6, 0xb5, 0xa8, 0xfd, 0x29, 0xa6, 0xa5, 0xfe, 0xbf, 0x51, 0x7f, 0x5d,
+	0x5f, 0xd5, 0x41, 0xbf, 0x62, 0x43, 0x61, 0x79, 0x7c, 0xbb, 0x3a, 0x38, 0x06, 0xd0, 0x62, 0xee,
+	0x39, 0x97, 0xc1, 0xec, 0xfa, 0xed, 0xcb, 0xe0, 0x2e, 0xec, 0xb9, 0x82, 0x17, 0xe2, 0x73, 0x8b,
+	0xa7, 0xe5, 0xe0, 0x99, 0x00, 0xaf, 0xca, 0x65, 0xfb, 0xba, 0x5c, 0x7e, 0xf1, 0xe0, 0x78, 0x65,
+	0x45, 0x0c, 0x88, 0xc6, 0x53, 0xca, 0xa8, 0x7e, 0x32, 0xc9, 0xc8, 0xbf, 0x7d, 0x82, 0x2e, 0x80,
+	0x24, 0x1a, 0x93, 0xc2, 0x38, 0x5b, 0x7e, 0x9a, 0x9d, 0xe3, 0xe5, 0xb5, 0x75, 0x2d, 0x62, 0xbd,
+	0xc4, 0x1a, 0x72, 0x06, 0x98, 0x95, 0x21, 0x91, 0x89, 0x0a, 0x33, 0x4b, 0xdb, 0xce, 0x60, 0x66,
+	0x86, 0x3f, 0xc2, 0xe1, 0x4a, 0x59, 0x67, 0xa4, 0x54, 0xf8, 0xa6, 0x92, 0x56, 0xd7, 0xda, 0xfa,
+	0xd5, 0xb5, 0x76, 0x00, 0x5b, 0x13, 0x52, 0xaa, 0x79, 0xb2, 0xda, 0x0a, 0x7f, 0xf6, 0xe0, 0xc8,
+	0x26, 0x73, 0x62, 0x3e, 0x43, 0xc9, 0xa8, 0x52, 0x54, 0xf0, 0x37, 0xe5, 0xfb, 0x12, 0x60, 0x32,
+	0x77, 0xb6, 0xf9, 0x76, 0x3b, 0x47, 0xcb, 0x14, 0x5c, 0x0d, 0x38, 0x58, 0xf2, 0x37, 0x03, 0x48,
+	0x8a, 0x42, 0x3c, 0x2b, 0xa8, 0x32, 0x53, 0xb6, 0x61, 0x8a, 0x9d, 0x03, 0xdd, 0xaf, 0x5e, 0x5c,
+	0xb4, 0xbd, 0x97, 0x17, 0x6d, 0xef, 0x8f, 0x8b, 0xb6, 0xf7, 0xd3, 0x65, 0x7b, 0xed, 0xe5, 0x65,
+	0x7b, 0xed, 0xf7, 0xcb, 0xf6, 0xda, 0xf7, 0x77, 0xc7, 0x54, 0xe7, 0xe5, 0x28, 0x4a, 0x05, 0x8b,
+	0xbf, 0xa5, 0x23, 0x2a, 0xcb, 0x5e, 0x4e, 0x28, 0x8f, 0xb9, 0x3d, 0xc7, 0x55, 0x27, 0x9e, 0x9a,
+	0x1f, 0xe8, 0x68, 0xcb, 0xfe, 0x2b, 0x3f, 0xfd, 0x67, 0x00, 0x73, 0x0d, 0x1d, 0xc2, 0x9e, 0x07,
+	0x00, 0x00,
 }
 
 func (m *EventEthereumTx) Marshal() (dAtA []byte, err error) {
@@ -820,6 +1063,18 @@ func (m *EventFunTokenCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DecimalsScale != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DecimalsScale))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Cw20Addr) > 0 {
+		i -= len(m.Cw20Addr)
+		copy(dAtA[i:], m.Cw20Addr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Cw20Addr)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.IsMadeFromCoin {
 		i--
 		if m.IsMadeFromCoin {
@@ -874,6 +1129,13 @@ func (m *EventConvertCoinToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Erc20Amount) > 0 {
+		i -= len(m.Erc20Amount)
+		copy(dAtA[i:], m.Erc20Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Erc20Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size, err := m.BankCoin.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1046,6 +1308,13 @@ func (m *EventConvertEvmToCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Erc20Amount) > 0 {
+		i -= len(m.Erc20Amount)
+		copy(dAtA[i:], m.Erc20Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Erc20Amount)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.SenderEthAddr) > 0 {
 		i -= len(m.SenderEthAddr)
 		copy(dAtA[i:], m.SenderEthAddr)
@@ -1087,6 +1356,147 @@ func (m *EventConvertEvmToCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventFunTokenRateLimitUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFunTokenRateLimitUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFunTokenRateLimitUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Removed {
+		i--
+		if m.Removed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFunTokenPauseUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFunTokenPauseUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFunTokenPauseUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeployPermissionUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeployPermissionUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeployPermissionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Permission != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1184,6 +1594,13 @@ func (m *EventFunTokenCreated) Size() (n int) {
 	if m.IsMadeFromCoin {
 		n += 2
 	}
+	l = len(m.Cw20Addr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.DecimalsScale != 0 {
+		n += 1 + sovEvents(uint64(m.DecimalsScale))
+	}
 	return n
 }
 
@@ -1207,6 +1624,10 @@ func (m *EventConvertCoinToEvm) Size() (n int) {
 	}
 	l = m.BankCoin.Size()
 	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Erc20Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -1289,6 +1710,70 @@ func (m *EventConvertEvmToCoin) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Erc20Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFunTokenRateLimitUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.RateLimit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Removed {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventFunTokenPauseUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventDeployPermissionUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovEvents(uint64(m.Permission))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1883,6 +2368,57 @@ func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsMadeFromCoin = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cw20Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cw20Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalsScale", wireType)
+			}
+			m.DecimalsScale = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecimalsScale |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2062,6 +2598,38 @@ func (m *EventConvertCoinToEvm) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2647,6 +3215,440 @@ func (m *EventConvertEvmToCoin) Unmarshal(dAtA []byte) error {
 			}
 			m.SenderEthAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFunTokenRateLimitUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFunTokenRateLimitUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFunTokenRateLimitUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Removed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFunTokenPauseUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFunTokenPauseUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFunTokenPauseUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeployPermissionUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeployPermissionUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeployPermissionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= DeployPermission(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
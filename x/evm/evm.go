@@ -3,8 +3,10 @@ package evm
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -34,9 +36,77 @@ func (fun FunToken) Validate() error {
 		return funTokenValidationError(err)
 	}
 
+	if fun.IsMadeFromCw20() {
+		if _, err := sdk.AccAddressFromBech32(fun.Cw20Addr); err != nil {
+			return funTokenValidationError(fmt.Errorf("invalid cw20 address: %w", err))
+		}
+		if fun.IsMadeFromCoin {
+			return funTokenValidationError(fmt.Errorf(
+				"mapping from CW20 \"%s\" cannot also be made from a coin", fun.Cw20Addr))
+		}
+		if wantDenom := Cw20BankDenom(fun.Cw20Addr); fun.BankDenom != wantDenom {
+			return funTokenValidationError(fmt.Errorf(
+				"bank denom for CW20 mapping must be \"%s\", got \"%s\"", wantDenom, fun.BankDenom))
+		}
+	}
+
+	if fun.DecimalsScale > 0 {
+		if !fun.IsMadeFromCoin {
+			return funTokenValidationError(fmt.Errorf(
+				"decimals scale is only supported for mappings made from a coin"))
+		}
+		if fun.DecimalsScale > MaxErc20Decimals {
+			return funTokenValidationError(fmt.Errorf(
+				"decimals scale %d exceeds the maximum of %d", fun.DecimalsScale, MaxErc20Decimals))
+		}
+	}
+
 	return nil
 }
 
+// MaxErc20Decimals is the largest number of decimals an ERC20 deployed by the
+// EVM module for a [FunToken] mapping can have when its amounts are scaled
+// with "FunToken.DecimalsScale".
+const MaxErc20Decimals = 18
+
+// DecimalsScaleFactor returns 10^DecimalsScale, the number of ERC20 base
+// units worth one base unit of the bank coin.
+func (fun FunToken) DecimalsScaleFactor() *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(fun.DecimalsScale)), nil)
+}
+
+// ToErc20Amount converts an amount of the bank coin of the [FunToken] mapping
+// to the equivalent amount of its ERC20.
+func (fun FunToken) ToErc20Amount(bankAmount *big.Int) *big.Int {
+	if fun.DecimalsScale == 0 {
+		return new(big.Int).Set(bankAmount)
+	}
+	return new(big.Int).Mul(bankAmount, fun.DecimalsScaleFactor())
+}
+
+// ToBankAmount converts an amount of the ERC20 of the [FunToken] mapping to
+// the amount of whole bank coin base units it's worth. The "dust" is the
+// remainder of ERC20 tokens that's worth less than one bank base unit.
+func (fun FunToken) ToBankAmount(erc20Amount *big.Int) (bankAmount, dust *big.Int) {
+	if fun.DecimalsScale == 0 {
+		return new(big.Int).Set(erc20Amount), big.NewInt(0)
+	}
+	return new(big.Int).QuoRem(erc20Amount, fun.DecimalsScaleFactor(), new(big.Int))
+}
+
+// IsMadeFromCw20 returns true if the [FunToken] mapping was created from a
+// CW20 contract. The EVM module owns the ERC20 contract for such mappings and
+// holds the CW20 tokens in escrow.
+func (fun FunToken) IsMadeFromCw20() bool {
+	return fun.Cw20Addr != ""
+}
+
+// Cw20BankDenom returns the bank denomination that identifies the [FunToken]
+// mapping of a CW20 contract.
+func Cw20BankDenom(cw20Addr string) string {
+	return "cw20/" + cw20Addr
+}
+
 // NewFunToken is a canonical constructor for the [FunToken] type. Using this
 // function helps guarantee a consistent string representation from the
 // hex-encoded Ethereum address.
@@ -52,6 +122,61 @@ func NewFunToken(
 	}
 }
 
+// Validate checks that the [FunTokenRateLimit] is well formed. A rate limit
+// with zero "WindowBlocks" is valid and means the rate limit is removed.
+func (rl FunTokenRateLimit) Validate() error {
+	if err := sdk.ValidateDenom(rl.BankDenom); err != nil {
+		return fmt.Errorf("FunTokenRateLimitError: %w", err)
+	}
+	for _, limit := range []struct {
+		name string
+		max  sdkmath.Int
+	}{
+		{"max_net_to_evm", rl.MaxNetToEvm},
+		{"max_net_to_bank", rl.MaxNetToBank},
+	} {
+		if limit.max.IsNil() || limit.max.IsNegative() {
+			return fmt.Errorf("FunTokenRateLimitError: %s must be non-negative, got %s", limit.name, limit.max)
+		}
+	}
+	return nil
+}
+
+// IsRemoval returns true if the [FunTokenRateLimit] removes the rate limit of
+// a FunToken mapping instead of setting one.
+func (rl FunTokenRateLimit) IsRemoval() bool {
+	return rl.WindowBlocks == 0
+}
+
+// CheckNetFlow returns an [ErrFunTokenRateLimit] error if "netToEvm", the net
+// amount converted into the ERC-20 representation within the window, is beyond
+// the limit in its direction. A limit of zero means there's no limit.
+func (rl FunTokenRateLimit) CheckNetFlow(netToEvm sdkmath.Int) error {
+	switch {
+	case netToEvm.IsPositive() && rl.MaxNetToEvm.IsPositive() && netToEvm.GT(rl.MaxNetToEvm):
+		return fmt.Errorf(
+			"%w: net amount converted to ERC-20 for \"%s\" would be %s, which exceeds the limit of %s per %d blocks",
+			ErrFunTokenRateLimit, rl.BankDenom, netToEvm, rl.MaxNetToEvm, rl.WindowBlocks,
+		)
+	case netToEvm.IsNegative() && rl.MaxNetToBank.IsPositive() && netToEvm.Neg().GT(rl.MaxNetToBank):
+		return fmt.Errorf(
+			"%w: net amount converted from ERC-20 for \"%s\" would be %s, which exceeds the limit of %s per %d blocks",
+			ErrFunTokenRateLimit, rl.BankDenom, netToEvm.Neg(), rl.MaxNetToBank, rl.WindowBlocks,
+		)
+	}
+	return nil
+}
+
+// NewFunTokenFlow returns an empty [FunTokenFlow] for a window that starts at
+// the given block height.
+func NewFunTokenFlow(bankDenom string, windowStartHeight int64) FunTokenFlow {
+	return FunTokenFlow{
+		BankDenom:         bankDenom,
+		WindowStartHeight: windowStartHeight,
+		NetToEvm:          sdkmath.ZeroInt(),
+	}
+}
+
 // ERC20Metadata: Optional metadata fields parsed from an ERC20 contract.
 // The [Wrapped Ether contract] is a good example for reference.
 //
@@ -25,10 +25,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DeployPermission defines which accounts can deploy contracts to the EVM.
+type DeployPermission int32
+
+const (
+	// Any account can deploy contracts.
+	DeployPermissionEverybody DeployPermission = 0
+	// No account can deploy contracts, except for the EVM module itself.
+	DeployPermissionNobody DeployPermission = 1
+	// Only the accounts in "Params.deploy_allowlist" can deploy contracts.
+	DeployPermissionAllowlist DeployPermission = 2
+)
+
+var DeployPermission_name = map[int32]string{
+	0: "DEPLOY_PERMISSION_EVERYBODY",
+	1: "DEPLOY_PERMISSION_NOBODY",
+	2: "DEPLOY_PERMISSION_ALLOWLIST",
+}
+
+var DeployPermission_value = map[string]int32{
+	"DEPLOY_PERMISSION_EVERYBODY": 0,
+	"DEPLOY_PERMISSION_NOBODY":    1,
+	"DEPLOY_PERMISSION_ALLOWLIST": 2,
+}
+
+func (x DeployPermission) String() string {
+	return proto.EnumName(DeployPermission_name, int32(x))
+}
+
+func (DeployPermission) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_98abbdadb327b7d0, []int{0}
+}
+
 // FunToken is a fungible token mapping between a Bank Coin and a corresponding
 // ERC-20 smart contract. Bank Coins here refer to tokens like NIBI, IBC
 // coins (ICS-20), and token factory coins, which are each represented by the
-// "Coin" type in Golang.
+// "Coin" type in Golang. A FunToken may also originate from a CW20 contract,
+// in which case the CW20 balance is the Cosmos-side representation of the
+// token.
 type FunToken struct {
 	// Hexadecimal address of the ERC20 token to which the `FunToken` maps
 	Erc20Addr github_com_NibiruChain_nibiru_v2_eth.EIP55Addr `protobuf:"bytes,1,opt,name=erc20_addr,json=erc20Addr,proto3,customtype=github.com/NibiruChain/nibiru/v2/eth.EIP55Addr" json:"erc20_addr"`
@@ -38,6 +72,22 @@ type FunToken struct {
 	// the ERC-20 contract gets deployed by the module account. False if the
 	// mapping was created from an externally owned ERC-20 contract.
 	IsMadeFromCoin bool `protobuf:"varint,3,opt,name=is_made_from_coin,json=isMadeFromCoin,proto3" json:"is_made_from_coin,omitempty"`
+	// cw20_addr: Bech32 address of the CW20 contract from which the `FunToken`
+	// mapping was created. Empty unless the mapping originates from a CW20. For
+	// CW20-originated mappings, the ERC-20 contract gets deployed by the module
+	// account, the "bank_denom" is "cw20/{cw20_addr}", and CW20 tokens are held
+	// in escrow by the EVM module while they circulate as ERC-20 tokens.
+	Cw20Addr string `protobuf:"bytes,4,opt,name=cw20_addr,json=cw20Addr,proto3" json:"cw20_addr,omitempty"`
+	// decimals_scale: Opt-in exponent "k" that scales amounts between the bank
+	// coin and its ERC-20 representation. One base unit of the bank coin is
+	// worth 10^k base units of the ERC-20, and the ERC-20 has "k" more decimals
+	// than the bank coin. Conversions to bank coins only move whole bank units,
+	// and the ERC-20 "dust" below 10^k stays in the escrow of the EVM module,
+	// like the bank coins backing it. Zero means amounts convert 1:1. Only mappings
+	// made from a bank coin can be scaled. The "balance" and "bankBalance"
+	// methods of the FunToken precompile return bank balances scaled to ERC-20
+	// base units.
+	DecimalsScale uint32 `protobuf:"varint,5,opt,name=decimals_scale,json=decimalsScale,proto3" json:"decimals_scale,omitempty"`
 }
 
 func (m *FunToken) Reset()         { *m = FunToken{} }
@@ -87,6 +137,148 @@ func (m *FunToken) GetIsMadeFromCoin() bool {
 	return false
 }
 
+func (m *FunToken) GetCw20Addr() string {
+	if m != nil {
+		return m.Cw20Addr
+	}
+	return ""
+}
+
+func (m *FunToken) GetDecimalsScale() uint32 {
+	if m != nil {
+		return m.DecimalsScale
+	}
+	return 0
+}
+
+// FunTokenRateLimit: Limits the net amount of a `FunToken` that can be
+// converted between its Bank Coin (or CW20) and ERC-20 representations within
+// a window of blocks. Conversions happen with "MsgConvertCoinToEvm",
+// "MsgConvertEvmToCoin", and the "sendToBank" and "sendToEvm" methods of the
+// FunToken precompile.
+type FunTokenRateLimit struct {
+	// bank_denom: Coin denomination of the `FunToken` mapping in the Bank Module.
+	BankDenom string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// window_blocks: Number of blocks in a rate limit window. The net flow is
+	// reset at the start of each window.
+	WindowBlocks uint64 `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// max_net_to_evm: Maximum net amount that can be converted into the ERC-20
+	// representation within a window. Zero means there's no limit in this
+	// direction.
+	MaxNetToEvm cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_net_to_evm,json=maxNetToEvm,proto3,customtype=cosmossdk.io/math.Int" json:"max_net_to_evm"`
+	// max_net_to_bank: Maximum net amount that can be converted out of the
+	// ERC-20 representation within a window. Zero means there's no limit in this
+	// direction.
+	MaxNetToBank cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_net_to_bank,json=maxNetToBank,proto3,customtype=cosmossdk.io/math.Int" json:"max_net_to_bank"`
+}
+
+func (m *FunTokenRateLimit) Reset()         { *m = FunTokenRateLimit{} }
+func (m *FunTokenRateLimit) String() string { return proto.CompactTextString(m) }
+func (*FunTokenRateLimit) ProtoMessage()    {}
+func (*FunTokenRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98abbdadb327b7d0, []int{1}
+}
+func (m *FunTokenRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunTokenRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunTokenRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FunTokenRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunTokenRateLimit.Merge(m, src)
+}
+func (m *FunTokenRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunTokenRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunTokenRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunTokenRateLimit proto.InternalMessageInfo
+
+func (m *FunTokenRateLimit) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *FunTokenRateLimit) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+// FunTokenFlow: Net amount of a `FunToken` converted within the current rate
+// limit window.
+type FunTokenFlow struct {
+	// bank_denom: Coin denomination of the `FunToken` mapping in the Bank Module.
+	BankDenom string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// window_start_height: Block height at which the current window started.
+	WindowStartHeight int64 `protobuf:"varint,2,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	// net_to_evm: Amount converted into the ERC-20 representation minus the
+	// amount converted out of it during the window. Negative if more tokens left
+	// the EVM than entered it.
+	NetToEvm cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=net_to_evm,json=netToEvm,proto3,customtype=cosmossdk.io/math.Int" json:"net_to_evm"`
+}
+
+func (m *FunTokenFlow) Reset()         { *m = FunTokenFlow{} }
+func (m *FunTokenFlow) String() string { return proto.CompactTextString(m) }
+func (*FunTokenFlow) ProtoMessage()    {}
+func (*FunTokenFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98abbdadb327b7d0, []int{2}
+}
+func (m *FunTokenFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunTokenFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunTokenFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FunTokenFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunTokenFlow.Merge(m, src)
+}
+func (m *FunTokenFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunTokenFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunTokenFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunTokenFlow proto.InternalMessageInfo
+
+func (m *FunTokenFlow) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *FunTokenFlow) GetWindowStartHeight() int64 {
+	if m != nil {
+		return m.WindowStartHeight
+	}
+	return 0
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// extra_eips defines the additional EIPs for the vm.Config
@@ -98,13 +290,24 @@ type Params struct {
 	CreateFuntokenFee cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=create_funtoken_fee,json=createFuntokenFee,proto3,customtype=cosmossdk.io/math.Int" json:"create_funtoken_fee"`
 	// Hexadecimal address of the canonical WNIBI contract on Nibiru mainnet
 	CanonicalWnibi github_com_NibiruChain_nibiru_v2_eth.EIP55Addr `protobuf:"bytes,10,opt,name=canonical_wnibi,json=canonicalWnibi,proto3,customtype=github.com/NibiruChain/nibiru/v2/eth.EIP55Addr" json:"canonical_wnibi"`
+	// Which accounts can deploy contracts to the EVM, both with contract
+	// creation transactions and with CREATE or CREATE2 from a contract. Defaults
+	// to everybody.
+	DeployPermission DeployPermission `protobuf:"varint,11,opt,name=deploy_permission,json=deployPermission,proto3,enum=eth.evm.v1.DeployPermission" json:"deploy_permission,omitempty"`
+	// Hexadecimal addresses of the accounts that can deploy contracts when
+	// "deploy_permission" is DEPLOY_PERMISSION_ALLOWLIST. Deployments with
+	// CREATE or CREATE2 check the contract that deploys, not the tx origin, so a
+	// contract that's on the allowlist, like a factory, can deploy contracts for
+	// any caller. Calls from an allowlisted account don't let other contracts
+	// deploy, except for the contracts it deploys in the same transaction.
+	DeployAllowlist []github_com_NibiruChain_nibiru_v2_eth.EIP55Addr `protobuf:"bytes,12,rep,name=deploy_allowlist,json=deployAllowlist,proto3,customtype=github.com/NibiruChain/nibiru/v2/eth.EIP55Addr" json:"deploy_allowlist"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{1}
+	return fileDescriptor_98abbdadb327b7d0, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,6 +350,13 @@ func (m *Params) GetEVMChannels() []string {
 	return nil
 }
 
+func (m *Params) GetDeployPermission() DeployPermission {
+	if m != nil {
+		return m.DeployPermission
+	}
+	return DeployPermissionEverybody
+}
+
 // State represents a single Storage key value pair item.
 type State struct {
 	// key is the stored key
@@ -159,7 +369,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{2}
+	return fileDescriptor_98abbdadb327b7d0, []int{4}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +445,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{3}
+	return fileDescriptor_98abbdadb327b7d0, []int{5}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,7 +549,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{4}
+	return fileDescriptor_98abbdadb327b7d0, []int{6}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +588,7 @@ func (m *TracerConfig) Reset()         { *m = TracerConfig{} }
 func (m *TracerConfig) String() string { return proto.CompactTextString(m) }
 func (*TracerConfig) ProtoMessage()    {}
 func (*TracerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{5}
+	return fileDescriptor_98abbdadb327b7d0, []int{7}
 }
 func (m *TracerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +653,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{6}
+	return fileDescriptor_98abbdadb327b7d0, []int{8}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +753,10 @@ func (m *TraceConfig) GetTracerConfig() *TracerConfig {
 }
 
 func init() {
+	proto.RegisterEnum("eth.evm.v1.DeployPermission", DeployPermission_name, DeployPermission_value)
 	proto.RegisterType((*FunToken)(nil), "eth.evm.v1.FunToken")
+	proto.RegisterType((*FunTokenRateLimit)(nil), "eth.evm.v1.FunTokenRateLimit")
+	proto.RegisterType((*FunTokenFlow)(nil), "eth.evm.v1.FunTokenFlow")
 	proto.RegisterType((*Params)(nil), "eth.evm.v1.Params")
 	proto.RegisterType((*State)(nil), "eth.evm.v1.State")
 	proto.RegisterType((*Log)(nil), "eth.evm.v1.Log")
@@ -555,69 +768,89 @@ func init() {
 func init() { proto.RegisterFile("eth/evm/v1/evm.proto", fileDescriptor_98abbdadb327b7d0) }
 
 var fileDescriptor_98abbdadb327b7d0 = []byte{
-	// 979 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x13, 0x27, 0x71, 0x26, 0x69, 0xeb, 0x4e, 0x0b, 0xb2, 0x90, 0x36, 0x8e, 0x7c, 0x40,
-	0x41, 0x5a, 0x25, 0x6c, 0x57, 0xe5, 0x50, 0x2e, 0x34, 0xd9, 0x56, 0x34, 0xd0, 0xa5, 0x9a, 0x2d,
-	0x20, 0x71, 0xb1, 0x26, 0xf6, 0x6b, 0x62, 0xc5, 0x9e, 0x89, 0x3c, 0x93, 0x90, 0xfc, 0x03, 0x8e,
-	0xfc, 0x84, 0xbd, 0xf3, 0x47, 0x56, 0x9c, 0xf6, 0x08, 0x1c, 0x2c, 0xd4, 0x5e, 0x50, 0x8e, 0x1c,
-	0x39, 0xa1, 0x19, 0xbb, 0x6d, 0x0a, 0x12, 0x1c, 0xf6, 0x94, 0xf7, 0x7d, 0x6f, 0xde, 0x37, 0xcf,
-	0xdf, 0x7b, 0xb1, 0xd1, 0x01, 0xc8, 0x49, 0x0f, 0x16, 0x49, 0x6f, 0xf1, 0x4c, 0xfd, 0x74, 0x67,
-	0x29, 0x97, 0x1c, 0x23, 0x90, 0x93, 0xae, 0x82, 0x8b, 0x67, 0x1f, 0x1c, 0x8c, 0xf9, 0x98, 0x6b,
-	0xba, 0xa7, 0xa2, 0xfc, 0x84, 0xf7, 0x93, 0x81, 0xac, 0xb3, 0x39, 0xbb, 0xe2, 0x53, 0x60, 0xf8,
-	0x6b, 0x84, 0x20, 0x0d, 0x0e, 0x3f, 0xf6, 0x69, 0x18, 0xa6, 0x8e, 0xd1, 0x36, 0x3a, 0xf5, 0xfe,
-	0x27, 0x6f, 0x32, 0x77, 0xeb, 0xb7, 0xcc, 0xed, 0x8e, 0x23, 0x39, 0x99, 0x8f, 0xba, 0x01, 0x4f,
-	0x7a, 0x2f, 0xa3, 0x51, 0x94, 0xce, 0x07, 0x13, 0x1a, 0xb1, 0x1e, 0xd3, 0x71, 0x6f, 0x71, 0xd8,
-	0x53, 0x77, 0x9d, 0x9e, 0x5f, 0x1e, 0x1d, 0x9d, 0x84, 0x61, 0x4a, 0xea, 0x5a, 0x49, 0x85, 0xf8,
-	0x09, 0x42, 0x23, 0xca, 0xa6, 0x7e, 0x08, 0x8c, 0x27, 0x4e, 0x49, 0xc9, 0x92, 0xba, 0x62, 0x5e,
-	0x28, 0x02, 0x7f, 0x84, 0xf6, 0x22, 0xe1, 0x27, 0x34, 0x04, 0xff, 0x3a, 0xe5, 0x89, 0x1f, 0xf0,
-	0x88, 0x39, 0xe5, 0xb6, 0xd1, 0xb1, 0xc8, 0x4e, 0x24, 0x2e, 0x68, 0x08, 0x67, 0x29, 0x4f, 0x06,
-	0x3c, 0x62, 0xde, 0xaf, 0x25, 0x54, 0xbd, 0xa4, 0x29, 0x4d, 0x04, 0x3e, 0x41, 0x08, 0x96, 0x32,
-	0xa5, 0x3e, 0x44, 0x33, 0xe1, 0x98, 0xed, 0x72, 0xa7, 0xdc, 0xf7, 0x6e, 0x32, 0xb7, 0x7e, 0xaa,
-	0xd8, 0xd3, 0xf3, 0x4b, 0xf1, 0x67, 0xe6, 0xee, 0xad, 0x68, 0x12, 0x1f, 0x7b, 0x0f, 0x07, 0x3d,
-	0x52, 0xd7, 0xe0, 0x34, 0x9a, 0x09, 0x7c, 0x88, 0x9a, 0xb0, 0x48, 0xfc, 0x60, 0x42, 0x19, 0x83,
-	0x58, 0x38, 0x56, 0xbb, 0xdc, 0xa9, 0xf7, 0x77, 0x6f, 0x32, 0xb7, 0x71, 0xfa, 0xcd, 0xc5, 0xa0,
-	0xa0, 0x49, 0x03, 0x16, 0xc9, 0x1d, 0xc0, 0x17, 0x68, 0x3f, 0x48, 0x81, 0x4a, 0xf0, 0xaf, 0xe7,
-	0x4c, 0x2a, 0xd7, 0xfc, 0x6b, 0x00, 0xa7, 0xae, 0xbd, 0x7a, 0x52, 0x78, 0xf5, 0x5e, 0xc0, 0x45,
-	0xc2, 0x85, 0x08, 0xa7, 0xdd, 0x88, 0xf7, 0x12, 0x2a, 0x27, 0xdd, 0x73, 0x26, 0xc9, 0x5e, 0x5e,
-	0x79, 0x56, 0x14, 0x9e, 0x01, 0x60, 0x1f, 0xed, 0x06, 0x94, 0x71, 0x16, 0x05, 0x34, 0xf6, 0xbf,
-	0x57, 0x5e, 0x3a, 0xe8, 0x9d, 0x6c, 0xdf, 0xb9, 0x97, 0xfb, 0x56, 0x1d, 0x39, 0x36, 0xff, 0x78,
-	0xed, 0x1a, 0x43, 0xd3, 0x32, 0xec, 0xd2, 0xd0, 0xb4, 0x4a, 0x76, 0x79, 0x68, 0x5a, 0x65, 0xdb,
-	0x1c, 0x9a, 0x56, 0xc5, 0xae, 0x0e, 0x4d, 0xab, 0x6a, 0xd7, 0x86, 0xa6, 0x55, 0xb3, 0x2d, 0xaf,
-	0x87, 0x2a, 0xaf, 0x24, 0x95, 0x80, 0x6d, 0x54, 0x9e, 0xc2, 0x2a, 0x1f, 0x3f, 0x51, 0x21, 0x3e,
-	0x40, 0x95, 0x05, 0x8d, 0xe7, 0x50, 0xcc, 0x2e, 0x07, 0xde, 0xcf, 0x25, 0x54, 0xfe, 0x92, 0x8f,
-	0xb1, 0x83, 0x6a, 0x6a, 0x5f, 0x40, 0x88, 0xa2, 0xe6, 0x0e, 0xe2, 0xf7, 0x51, 0x55, 0xf2, 0x59,
-	0x14, 0x08, 0xa7, 0xa4, 0xac, 0x25, 0x05, 0xc2, 0x18, 0x99, 0x21, 0x95, 0x54, 0x0f, 0xb9, 0x49,
-	0x74, 0xac, 0x86, 0x31, 0x8a, 0x79, 0x30, 0xf5, 0xd9, 0x3c, 0x19, 0x41, 0xea, 0x98, 0x6d, 0xa3,
-	0x63, 0xf6, 0x77, 0xd7, 0x99, 0xdb, 0xd0, 0xfc, 0x4b, 0x4d, 0x93, 0x4d, 0x80, 0x9f, 0xa2, 0x9a,
-	0x5c, 0xfa, 0x13, 0x2a, 0x26, 0x4e, 0x45, 0xbb, 0xb6, 0xbf, 0xce, 0xdc, 0x5d, 0x99, 0x52, 0x26,
-	0x68, 0x20, 0x23, 0xce, 0x3e, 0xa7, 0x62, 0x42, 0xaa, 0x72, 0xa9, 0x7e, 0x71, 0x0f, 0x59, 0x72,
-	0xe9, 0x47, 0x2c, 0x84, 0xa5, 0x53, 0xd5, 0xea, 0x07, 0xeb, 0xcc, 0xb5, 0x37, 0x8e, 0x9f, 0xab,
-	0x1c, 0xa9, 0xc9, 0xa5, 0x0e, 0xf0, 0x53, 0x84, 0xf2, 0x96, 0xf4, 0x0d, 0x35, 0x7d, 0xc3, 0xf6,
-	0x3a, 0x73, 0xeb, 0x9a, 0xd5, 0xda, 0x0f, 0x21, 0xf6, 0x50, 0x25, 0xd7, 0xb6, 0xb4, 0x76, 0x73,
-	0x9d, 0xb9, 0x56, 0xcc, 0xc7, 0xb9, 0x66, 0x9e, 0x52, 0x56, 0xa5, 0x90, 0xf0, 0x05, 0x84, 0x7a,
-	0x63, 0x2c, 0x72, 0x07, 0x3d, 0x8a, 0x1a, 0x27, 0x41, 0x00, 0x42, 0x5c, 0xcd, 0x67, 0x31, 0xfc,
-	0x87, 0xa7, 0x87, 0xa8, 0x29, 0x24, 0x4f, 0xe9, 0x18, 0xfc, 0x29, 0xac, 0x0a, 0x67, 0x73, 0x9f,
-	0x0a, 0xfe, 0x0b, 0x58, 0x09, 0xb2, 0x09, 0x8e, 0xcd, 0x1f, 0x5e, 0xbb, 0x5b, 0xde, 0x00, 0x35,
-	0xaf, 0x52, 0x1a, 0x40, 0x3a, 0xe0, 0xec, 0x3a, 0x1a, 0xe3, 0xe7, 0x68, 0x9b, 0xb3, 0x78, 0xe5,
-	0x4b, 0x3e, 0xf3, 0x03, 0x1a, 0xc7, 0xfa, 0x26, 0x2b, 0x97, 0x52, 0x89, 0x2b, 0x3e, 0x1b, 0xd0,
-	0x38, 0x26, 0x9b, 0xc0, 0xfb, 0xab, 0x8c, 0x1a, 0x5a, 0xa5, 0x10, 0x51, 0x23, 0xd6, 0xa2, 0x45,
-	0x9f, 0x05, 0x52, 0x0f, 0x20, 0xa3, 0x04, 0xf8, 0x5c, 0x16, 0x4b, 0x73, 0x07, 0x55, 0x45, 0x0a,
-	0xb0, 0x84, 0x40, 0x8f, 0xdf, 0x24, 0x05, 0xc2, 0x47, 0x68, 0x3b, 0x8c, 0x04, 0x1d, 0xc5, 0xe0,
-	0x0b, 0x49, 0x83, 0xa9, 0x1e, 0xa9, 0xd5, 0xb7, 0xd7, 0x99, 0xdb, 0x2c, 0x12, 0xaf, 0x14, 0x4f,
-	0x1e, 0x21, 0xfc, 0x29, 0xda, 0x7d, 0x28, 0xd3, 0x8f, 0xac, 0x87, 0x6b, 0xf5, 0xf1, 0x3a, 0x73,
-	0x77, 0xee, 0x8f, 0xea, 0x0c, 0xf9, 0x07, 0x56, 0x8b, 0x1d, 0xc2, 0x68, 0x3e, 0xd6, 0x33, 0xb3,
-	0x48, 0x0e, 0x14, 0x1b, 0x47, 0x49, 0x24, 0xf5, 0x8c, 0x2a, 0x24, 0x07, 0xaa, 0x3f, 0x60, 0xfa,
-	0x9e, 0x04, 0x12, 0x9e, 0xae, 0x9c, 0xc6, 0x43, 0x7f, 0x79, 0xe2, 0x42, 0xf3, 0xe4, 0x11, 0xc2,
-	0x7d, 0x84, 0x8b, 0xb2, 0x14, 0xe4, 0x3c, 0x65, 0xbe, 0xde, 0xfc, 0xa6, 0xae, 0xd5, 0xfb, 0x97,
-	0x67, 0x89, 0x4e, 0xbe, 0xa0, 0x92, 0x92, 0x7f, 0x31, 0xf8, 0x2b, 0xb4, 0x9d, 0xdb, 0xea, 0x07,
-	0xda, 0x75, 0x67, 0xbb, 0x6d, 0x74, 0x1a, 0x87, 0x4e, 0xf7, 0xe1, 0xf5, 0xde, 0xdd, 0x1c, 0x6d,
-	0xde, 0x94, 0xdc, 0x60, 0xc8, 0x23, 0x34, 0x34, 0x2d, 0xd3, 0xae, 0xe4, 0xff, 0xfb, 0xa1, 0x69,
-	0x21, 0xbb, 0x71, 0xef, 0x4c, 0xf1, 0x70, 0x64, 0xff, 0x0e, 0x6f, 0x74, 0xdd, 0xff, 0xec, 0xcd,
-	0x4d, 0xcb, 0x78, 0x7b, 0xd3, 0x32, 0x7e, 0xbf, 0x69, 0x19, 0x3f, 0xde, 0xb6, 0xb6, 0xde, 0xde,
-	0xb6, 0xb6, 0x7e, 0xb9, 0x6d, 0x6d, 0x7d, 0xf7, 0xe1, 0xff, 0xbe, 0xa6, 0x96, 0xea, 0xb3, 0x34,
-	0xaa, 0xea, 0xaf, 0xce, 0xf3, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x55, 0xc2, 0xca, 0x8f, 0xaf,
-	0x06, 0x00, 0x00,
+	// 1312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6b, 0x1b, 0xc7,
+	0x17, 0xf7, 0x5a, 0x6b, 0x7b, 0x35, 0x92, 0xac, 0xf5, 0xd8, 0xdf, 0xb0, 0x5f, 0xa7, 0xd1, 0x0a,
+	0x95, 0x16, 0xb5, 0x04, 0xa9, 0x71, 0x48, 0x29, 0x09, 0x94, 0x7a, 0x6d, 0x99, 0x48, 0xf5, 0x2f,
+	0x46, 0x6e, 0x42, 0x7a, 0x19, 0x46, 0xbb, 0x63, 0x69, 0xd1, 0xee, 0x8e, 0xd8, 0x1d, 0xfd, 0xfa,
+	0x0b, 0x1a, 0x72, 0xea, 0xb9, 0x10, 0x08, 0xf4, 0x9f, 0x09, 0x3d, 0x85, 0x9e, 0x4a, 0x0f, 0xa2,
+	0x75, 0x2e, 0x45, 0x97, 0x42, 0x8f, 0x3d, 0x95, 0x99, 0x5d, 0xd9, 0x8a, 0x12, 0x9a, 0x94, 0x9c,
+	0x34, 0x9f, 0xcf, 0x9b, 0xf7, 0x99, 0xf7, 0xde, 0xbc, 0x79, 0x5a, 0xb0, 0x45, 0x79, 0xa7, 0x4a,
+	0x07, 0x7e, 0x75, 0x70, 0x4b, 0xfc, 0x54, 0x7a, 0x21, 0xe3, 0x0c, 0x02, 0xca, 0x3b, 0x15, 0x01,
+	0x07, 0xb7, 0xb6, 0xb7, 0xda, 0xac, 0xcd, 0x24, 0x5d, 0x15, 0xab, 0x78, 0x47, 0xe9, 0x4f, 0x05,
+	0x68, 0x07, 0xfd, 0xe0, 0x8c, 0x75, 0x69, 0x00, 0xbf, 0x01, 0x80, 0x86, 0xf6, 0xce, 0x67, 0x98,
+	0x38, 0x4e, 0x68, 0x28, 0x45, 0xa5, 0x9c, 0xb6, 0x3e, 0x7f, 0x3e, 0x31, 0x97, 0x7e, 0x9d, 0x98,
+	0x95, 0xb6, 0xcb, 0x3b, 0xfd, 0x56, 0xc5, 0x66, 0x7e, 0xf5, 0xd8, 0x6d, 0xb9, 0x61, 0x7f, 0xaf,
+	0x43, 0xdc, 0xa0, 0x1a, 0xc8, 0x75, 0x75, 0xb0, 0x53, 0x15, 0x67, 0xd5, 0xea, 0xa7, 0x77, 0xee,
+	0xec, 0x3a, 0x4e, 0x88, 0xd2, 0x52, 0x49, 0x2c, 0xe1, 0x0d, 0x00, 0x5a, 0x24, 0xe8, 0x62, 0x87,
+	0x06, 0xcc, 0x37, 0x96, 0x85, 0x2c, 0x4a, 0x0b, 0x66, 0x5f, 0x10, 0xf0, 0x13, 0xb0, 0xe1, 0x46,
+	0xd8, 0x27, 0x0e, 0xc5, 0xe7, 0x21, 0xf3, 0xb1, 0xcd, 0xdc, 0xc0, 0x48, 0x15, 0x95, 0xb2, 0x86,
+	0xd6, 0xdd, 0xe8, 0x88, 0x38, 0xf4, 0x20, 0x64, 0xfe, 0x1e, 0x73, 0x03, 0x78, 0x1d, 0xa4, 0xed,
+	0xe1, 0x2c, 0x3e, 0x55, 0x0a, 0x69, 0xf6, 0x30, 0x39, 0xe6, 0x23, 0xb0, 0xee, 0x50, 0xdb, 0xf5,
+	0x89, 0x17, 0xe1, 0xc8, 0x26, 0x1e, 0x35, 0x56, 0x8a, 0x4a, 0x39, 0x87, 0x72, 0x33, 0xb6, 0x29,
+	0xc8, 0xd2, 0xef, 0x0a, 0xd8, 0x98, 0x65, 0x8c, 0x08, 0xa7, 0x87, 0xae, 0xef, 0xf2, 0x85, 0x18,
+	0x95, 0xc5, 0x18, 0x3f, 0x04, 0xb9, 0xa1, 0x1b, 0x38, 0x6c, 0x88, 0x5b, 0x1e, 0xb3, 0xbb, 0x91,
+	0xcc, 0x42, 0x45, 0xd9, 0x98, 0xb4, 0x24, 0x07, 0x2d, 0xb0, 0xee, 0x93, 0x11, 0x0e, 0x28, 0xc7,
+	0x9c, 0x61, 0x3a, 0xf0, 0x65, 0x16, 0x69, 0xeb, 0x46, 0x52, 0xc2, 0xff, 0xd9, 0x2c, 0xf2, 0x59,
+	0x14, 0x39, 0xdd, 0x8a, 0xcb, 0xaa, 0x3e, 0xe1, 0x9d, 0x4a, 0x3d, 0xe0, 0x28, 0xe3, 0x93, 0xd1,
+	0x31, 0xe5, 0x67, 0xac, 0x36, 0xf0, 0xe1, 0x3e, 0xc8, 0xcf, 0x69, 0x88, 0x00, 0x0c, 0xf5, 0x5d,
+	0x44, 0xb2, 0x33, 0x11, 0x8b, 0x04, 0xdd, 0xd2, 0x0f, 0x0a, 0xc8, 0xce, 0x72, 0x3c, 0xf0, 0xd8,
+	0xf0, 0x6d, 0xe9, 0x55, 0xc0, 0x66, 0x92, 0x5e, 0xc4, 0x49, 0xc8, 0x71, 0x87, 0xba, 0xed, 0x0e,
+	0x97, 0x49, 0xa6, 0xd0, 0x46, 0x6c, 0x6a, 0x0a, 0xcb, 0x7d, 0x69, 0x80, 0xf7, 0x00, 0xf8, 0xaf,
+	0x59, 0x6a, 0x41, 0x92, 0x62, 0xe9, 0x3b, 0x15, 0xac, 0x9e, 0x92, 0x90, 0xf8, 0x11, 0xdc, 0x05,
+	0x80, 0x8e, 0x78, 0x48, 0x30, 0x75, 0x7b, 0x91, 0xa1, 0x16, 0x53, 0xe5, 0x94, 0x55, 0xba, 0x98,
+	0x98, 0xe9, 0x9a, 0x60, 0x6b, 0xf5, 0xd3, 0xe8, 0xaf, 0x89, 0xb9, 0x31, 0x26, 0xbe, 0x77, 0xb7,
+	0x74, 0xb5, 0xb1, 0x84, 0xd2, 0x12, 0xd4, 0xdc, 0x5e, 0x04, 0x77, 0x40, 0x96, 0x0e, 0x7c, 0x6c,
+	0x77, 0x48, 0x10, 0x50, 0x2f, 0x32, 0xb4, 0x62, 0xaa, 0x9c, 0xb6, 0xf2, 0x17, 0x13, 0x33, 0x53,
+	0x7b, 0x70, 0xb4, 0x97, 0xd0, 0x28, 0x43, 0x07, 0xfe, 0x0c, 0xc0, 0x23, 0xb0, 0x69, 0x87, 0x94,
+	0x70, 0x8a, 0xcf, 0xfb, 0x01, 0x17, 0x45, 0xc2, 0xe7, 0x94, 0x1a, 0xe9, 0x77, 0xc9, 0x63, 0x23,
+	0xf6, 0x3c, 0x48, 0x1c, 0x0f, 0x28, 0x85, 0x18, 0xe4, 0x6d, 0x12, 0xb0, 0xc0, 0xb5, 0x89, 0x87,
+	0x87, 0xe2, 0x41, 0x18, 0xe0, 0xbd, 0xde, 0xce, 0xfa, 0xa5, 0xdc, 0x43, 0xb1, 0x05, 0xd6, 0xc1,
+	0x86, 0x43, 0x7b, 0x1e, 0x1b, 0xe3, 0x1e, 0x0d, 0x7d, 0x37, 0x8a, 0x5c, 0x16, 0x18, 0x99, 0xa2,
+	0x52, 0x5e, 0xdf, 0xf9, 0xa0, 0x72, 0xf5, 0xc4, 0x2b, 0xfb, 0x72, 0xd3, 0xe9, 0xe5, 0x1e, 0xa4,
+	0x3b, 0x0b, 0x0c, 0x24, 0x20, 0xe1, 0x30, 0xf1, 0x3c, 0x36, 0xf4, 0xdc, 0x88, 0x1b, 0xd9, 0x62,
+	0xea, 0x3d, 0x82, 0xcd, 0xc7, 0x7a, 0xbb, 0x33, 0xb9, 0xbb, 0xea, 0x1f, 0xcf, 0x4c, 0xa5, 0xa1,
+	0x6a, 0x8a, 0xbe, 0xdc, 0x50, 0xb5, 0x65, 0x3d, 0xd5, 0x50, 0xb5, 0x94, 0xae, 0x36, 0x54, 0x6d,
+	0x45, 0x5f, 0x6d, 0xa8, 0xda, 0xaa, 0xbe, 0xd6, 0x50, 0xb5, 0x35, 0x5d, 0x2b, 0x55, 0xc1, 0x4a,
+	0x93, 0x13, 0x4e, 0xa1, 0x0e, 0x52, 0x5d, 0x3a, 0x4e, 0xfa, 0x52, 0x2c, 0xe1, 0x16, 0x58, 0x19,
+	0x10, 0xaf, 0x4f, 0x93, 0x71, 0x11, 0x83, 0xd2, 0x4f, 0xcb, 0x20, 0x75, 0xc8, 0xda, 0xd0, 0x00,
+	0x6b, 0x62, 0x04, 0xd0, 0x28, 0x4a, 0x7c, 0x66, 0x10, 0x5e, 0x03, 0xab, 0x9c, 0xf5, 0x5c, 0x5b,
+	0xbc, 0xd0, 0x54, 0x39, 0x8d, 0x12, 0x04, 0x21, 0x50, 0x1d, 0xc2, 0x89, 0xec, 0xd5, 0x2c, 0x92,
+	0x6b, 0xd1, 0x3a, 0xf2, 0x35, 0xe3, 0xa0, 0xef, 0xb7, 0x68, 0x3c, 0x50, 0x54, 0x2b, 0x3f, 0x9d,
+	0x98, 0x19, 0xc9, 0x1f, 0x4b, 0x1a, 0xcd, 0x03, 0x78, 0x13, 0xac, 0xf1, 0x11, 0xee, 0x90, 0xa8,
+	0x23, 0xa7, 0x4b, 0xda, 0xda, 0x9c, 0x4e, 0xcc, 0x3c, 0x0f, 0x49, 0x10, 0x11, 0x9b, 0xbb, 0x2c,
+	0xb8, 0x4f, 0xa2, 0x0e, 0x5a, 0xe5, 0x23, 0xf1, 0x0b, 0xab, 0x40, 0xe3, 0x23, 0xec, 0x06, 0x0e,
+	0x1d, 0x19, 0xab, 0x52, 0x7d, 0x6b, 0x3a, 0x31, 0xf5, 0xb9, 0xed, 0x75, 0x61, 0x43, 0x6b, 0x7c,
+	0x24, 0x17, 0xf0, 0x26, 0x00, 0x71, 0x48, 0xf2, 0x84, 0x35, 0x79, 0x42, 0x6e, 0x3a, 0x31, 0xd3,
+	0x92, 0x95, 0xda, 0x57, 0x4b, 0x58, 0x02, 0x2b, 0xb1, 0xb6, 0x26, 0xb5, 0xb3, 0xd3, 0x89, 0xa9,
+	0x79, 0xac, 0x1d, 0x6b, 0xc6, 0x26, 0x51, 0xaa, 0x90, 0xfa, 0x6c, 0x40, 0x1d, 0xd9, 0xdf, 0x1a,
+	0x9a, 0xc1, 0x12, 0x01, 0x99, 0x5d, 0xdb, 0xa6, 0x51, 0x74, 0xd6, 0xef, 0x79, 0xf4, 0x5f, 0x6a,
+	0xba, 0x03, 0xb2, 0x11, 0x67, 0x21, 0x69, 0x53, 0xdc, 0xa5, 0xe3, 0xa4, 0xb2, 0x71, 0x9d, 0x12,
+	0xfe, 0x6b, 0x3a, 0x8e, 0xd0, 0x3c, 0xb8, 0xab, 0x3e, 0x7e, 0x66, 0x2e, 0x95, 0xf6, 0x40, 0xf6,
+	0x2c, 0x24, 0x36, 0x0d, 0xf7, 0x58, 0x70, 0xee, 0xb6, 0xe1, 0x6d, 0x90, 0x63, 0x81, 0x37, 0xc6,
+	0x9c, 0xf5, 0xb0, 0x4d, 0x3c, 0x4f, 0x9e, 0xa4, 0xc5, 0x52, 0xc2, 0x70, 0xc6, 0x7a, 0x7b, 0xc4,
+	0xf3, 0xd0, 0x3c, 0x28, 0xfd, 0x9d, 0x02, 0x19, 0xa9, 0x92, 0x88, 0x88, 0x2b, 0x96, 0xa2, 0x49,
+	0x9c, 0x09, 0x12, 0x09, 0x70, 0xd7, 0xa7, 0xac, 0xcf, 0x93, 0xa6, 0x99, 0x41, 0xe1, 0x11, 0x52,
+	0x3a, 0xa2, 0xb6, 0xbc, 0x7e, 0x15, 0x25, 0x08, 0xde, 0x01, 0x39, 0xc7, 0x8d, 0x48, 0xcb, 0xa3,
+	0x62, 0xee, 0xd9, 0x5d, 0x79, 0xa5, 0x9a, 0xa5, 0x4f, 0x27, 0x66, 0x36, 0x31, 0x34, 0x05, 0x8f,
+	0x5e, 0x41, 0xf0, 0x1e, 0xc8, 0x5f, 0xb9, 0xc9, 0x94, 0xe5, 0xe5, 0x6a, 0x16, 0x9c, 0x4e, 0xcc,
+	0xf5, 0xcb, 0xad, 0xd2, 0x82, 0x16, 0xb0, 0x68, 0x6c, 0x87, 0xb6, 0xfa, 0x6d, 0x79, 0x67, 0x1a,
+	0x8a, 0x81, 0x60, 0x3d, 0xf1, 0x3f, 0x24, 0xef, 0x68, 0x05, 0xc5, 0x40, 0xc4, 0x47, 0x03, 0x79,
+	0x8e, 0x4f, 0x7d, 0x16, 0x8e, 0x8d, 0xcc, 0x55, 0x7c, 0xb1, 0xe1, 0x48, 0xf2, 0xe8, 0x15, 0x04,
+	0x2d, 0x00, 0x13, 0xb7, 0x90, 0xf2, 0x7e, 0x18, 0x60, 0xd9, 0xf9, 0x59, 0xe9, 0x2b, 0xfb, 0x2f,
+	0xb6, 0x22, 0x69, 0xdc, 0x27, 0x9c, 0xa0, 0xd7, 0x18, 0x78, 0x02, 0x72, 0x71, 0x59, 0xb1, 0x2d,
+	0xab, 0x6e, 0xe4, 0x8a, 0x4a, 0x39, 0xb3, 0x63, 0xcc, 0x8f, 0x9b, 0xf9, 0xab, 0x8d, 0x83, 0xe2,
+	0x73, 0x0c, 0x7a, 0x05, 0x35, 0x54, 0x4d, 0xd5, 0x57, 0xe2, 0x77, 0xdf, 0x50, 0x35, 0xa0, 0x67,
+	0x2e, 0x2b, 0x93, 0x24, 0x87, 0x36, 0x67, 0x78, 0x2e, 0xea, 0x4f, 0x7f, 0x56, 0x80, 0xbe, 0x38,
+	0xd6, 0xe0, 0x97, 0xe0, 0xfa, 0x7e, 0xed, 0xf4, 0xf0, 0xe4, 0x11, 0x3e, 0xad, 0xa1, 0xa3, 0x7a,
+	0xb3, 0x59, 0x3f, 0x39, 0xc6, 0xb5, 0x07, 0x35, 0xf4, 0xc8, 0x3a, 0xd9, 0x7f, 0xa4, 0x2f, 0x6d,
+	0xdf, 0x78, 0xf2, 0xb4, 0xf8, 0xff, 0x45, 0xb7, 0xda, 0x80, 0x86, 0xe3, 0x16, 0x73, 0xc6, 0xf0,
+	0x0b, 0x60, 0xbc, 0xee, 0x7f, 0x7c, 0x22, 0x9d, 0x95, 0xed, 0xed, 0x27, 0x4f, 0x8b, 0xd7, 0x16,
+	0x9d, 0x8f, 0x99, 0xf4, 0x7c, 0xe3, 0xc9, 0xbb, 0x87, 0x87, 0x27, 0x0f, 0x0f, 0xeb, 0xcd, 0x33,
+	0x7d, 0xf9, 0xcd, 0x27, 0x5f, 0xce, 0xc6, 0x6d, 0xf5, 0xf1, 0x8f, 0x85, 0x25, 0xeb, 0xab, 0xe7,
+	0x17, 0x05, 0xe5, 0xc5, 0x45, 0x41, 0xf9, 0xed, 0xa2, 0xa0, 0x7c, 0xff, 0xb2, 0xb0, 0xf4, 0xe2,
+	0x65, 0x61, 0xe9, 0x97, 0x97, 0x85, 0xa5, 0x6f, 0x3f, 0x7e, 0xeb, 0xf0, 0x1d, 0x89, 0xcf, 0xbb,
+	0xd6, 0xaa, 0xfc, 0x7a, 0xbb, 0xfd, 0xcf, 0x00, 0x30, 0x5e, 0x1d, 0x89, 0xf7, 0x09, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -661,6 +894,17 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.CanonicalWnibi.Equal(that1.CanonicalWnibi) {
 		return false
 	}
+	if this.DeployPermission != that1.DeployPermission {
+		return false
+	}
+	if len(this.DeployAllowlist) != len(that1.DeployAllowlist) {
+		return false
+	}
+	for i := range this.DeployAllowlist {
+		if !this.DeployAllowlist[i].Equal(that1.DeployAllowlist[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *FunToken) Marshal() (dAtA []byte, err error) {
@@ -683,6 +927,18 @@ func (m *FunToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DecimalsScale != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.DecimalsScale))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Cw20Addr) > 0 {
+		i -= len(m.Cw20Addr)
+		copy(dAtA[i:], m.Cw20Addr)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Cw20Addr)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.IsMadeFromCoin {
 		i--
 		if m.IsMadeFromCoin {
@@ -713,6 +969,106 @@ func (m *FunToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FunTokenRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunTokenRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunTokenRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxNetToBank.Size()
+		i -= size
+		if _, err := m.MaxNetToBank.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxNetToEvm.Size()
+		i -= size
+		if _, err := m.MaxNetToEvm.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.WindowBlocks != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FunTokenFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunTokenFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunTokenFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetToEvm.Size()
+		i -= size
+		if _, err := m.NetToEvm.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -733,6 +1089,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeployAllowlist) > 0 {
+		for iNdEx := len(m.DeployAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.DeployAllowlist[iNdEx].Size()
+				i -= size
+				if _, err := m.DeployAllowlist[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.DeployPermission != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.DeployPermission))
+		i--
+		dAtA[i] = 0x58
+	}
 	{
 		size := m.CanonicalWnibi.Size()
 		i -= size
@@ -1113,6 +1488,51 @@ func (m *FunToken) Size() (n int) {
 	if m.IsMadeFromCoin {
 		n += 2
 	}
+	l = len(m.Cw20Addr)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.DecimalsScale != 0 {
+		n += 1 + sovEvm(uint64(m.DecimalsScale))
+	}
+	return n
+}
+
+func (m *FunTokenRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovEvm(uint64(m.WindowBlocks))
+	}
+	l = m.MaxNetToEvm.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.MaxNetToBank.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *FunTokenFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovEvm(uint64(m.WindowStartHeight))
+	}
+	l = m.NetToEvm.Size()
+	n += 1 + l + sovEvm(uint64(l))
 	return n
 }
 
@@ -1139,6 +1559,15 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovEvm(uint64(l))
 	l = m.CanonicalWnibi.Size()
 	n += 1 + l + sovEvm(uint64(l))
+	if m.DeployPermission != 0 {
+		n += 1 + sovEvm(uint64(m.DeployPermission))
+	}
+	if len(m.DeployAllowlist) > 0 {
+		for _, e := range m.DeployAllowlist {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1396,25 +1825,380 @@ func (m *FunToken) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsMadeFromCoin = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cw20Addr", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cw20Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalsScale", wireType)
+			}
+			m.DecimalsScale = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecimalsScale |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FunTokenRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FunTokenRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FunTokenRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNetToEvm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxNetToEvm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNetToBank", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxNetToBank.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FunTokenFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FunTokenFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FunTokenFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetToEvm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetToEvm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *Params) Unmarshal(dAtA []byte) error {
@@ -1622,6 +2406,61 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployPermission", wireType)
+			}
+			m.DeployPermission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeployPermission |= DeployPermission(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_NibiruChain_nibiru_v2_eth.EIP55Addr
+			m.DeployAllowlist = append(m.DeployAllowlist, v)
+			if err := m.DeployAllowlist[len(m.DeployAllowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -9,7 +9,9 @@ import (
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
@@ -193,3 +195,61 @@ const (
 	//	  ~65_000 gas (bank send)
 	FunTokenGasLimitSendToEvm uint64 = 400_000
 )
+
+// ERC20Permit holds the arguments of an EIP-2612 "permit" call on an ERC20.
+type ERC20Permit struct {
+	Owner    EthPrivKeyAcc
+	Spender  gethcommon.Address
+	Value    *big.Int
+	Nonce    *big.Int
+	Deadline *big.Int
+}
+
+// PermitDomainSeparator computes the EIP-712 domain separator of an
+// "ERC20MinterWithPermit.sol" token with the given name on the chain of
+// "deps.Ctx".
+func PermitDomainSeparator(
+	deps TestDeps, erc20 gethcommon.Address, tokenName string,
+) gethcommon.Hash {
+	typeHash := crypto.Keccak256Hash([]byte(
+		"EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)",
+	))
+	return crypto.Keccak256Hash(
+		typeHash.Bytes(),
+		crypto.Keccak256([]byte(tokenName)),
+		crypto.Keccak256([]byte("1")),
+		gethcommon.BigToHash(deps.EvmKeeper.EthChainID(deps.Ctx)).Bytes(),
+		gethcommon.BytesToHash(erc20.Bytes()).Bytes(),
+	)
+}
+
+// Sign produces the (v, r, s) signature of the permit by "permit.Owner" for the
+// token at "erc20" with the given name.
+func (permit ERC20Permit) Sign(
+	t *testing.T, deps TestDeps, erc20 gethcommon.Address, tokenName string,
+) (v uint8, r, s [32]byte) {
+	permitTypeHash := crypto.Keccak256Hash([]byte(
+		"Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)",
+	))
+	structHash := crypto.Keccak256Hash(
+		permitTypeHash.Bytes(),
+		gethcommon.BytesToHash(permit.Owner.EthAddr.Bytes()).Bytes(),
+		gethcommon.BytesToHash(permit.Spender.Bytes()).Bytes(),
+		gethcommon.BigToHash(permit.Value).Bytes(),
+		gethcommon.BigToHash(permit.Nonce).Bytes(),
+		gethcommon.BigToHash(permit.Deadline).Bytes(),
+	)
+	digest := crypto.Keccak256(
+		[]byte("\x19\x01"),
+		PermitDomainSeparator(deps, erc20, tokenName).Bytes(),
+		structHash.Bytes(),
+	)
+
+	privKey, err := permit.Owner.PrivKey.ToECDSA()
+	require.NoError(t, err)
+	sig, err := crypto.Sign(digest, privKey)
+	require.NoError(t, err)
+	copy(r[:], sig[:32])
+	copy(s[:], sig[32:64])
+	return sig[64] + 27, r, s
+}
@@ -38,5 +38,15 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	for _, rateLimit := range gs.FuntokenRateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return fmt.Errorf("invalid genesis FunToken rate limit: %w", err)
+		}
+		if rateLimit.IsRemoval() {
+			return fmt.Errorf(
+				"invalid genesis FunToken rate limit for \"%s\": window_blocks must be positive", rateLimit.BankDenom)
+		}
+	}
+
 	return gs.Params.Validate()
 }
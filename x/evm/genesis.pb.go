@@ -31,6 +31,10 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// Fungible token mappings corresponding to ERC-20 smart contract tokens.
 	FuntokenMappings []FunToken `protobuf:"bytes,3,rep,name=funtoken_mappings,json=funtokenMappings,proto3" json:"funtoken_mappings"`
+	// Rate limits on conversions of `FunToken` mappings.
+	FuntokenRateLimits []FunTokenRateLimit `protobuf:"bytes,4,rep,name=funtoken_rate_limits,json=funtokenRateLimits,proto3" json:"funtoken_rate_limits"`
+	// Bank coin denominations of the `FunToken` mappings with paused conversions.
+	PausedFuntokens []string `protobuf:"bytes,5,rep,name=paused_funtokens,json=pausedFuntokens,proto3" json:"paused_funtokens,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +91,20 @@ func (m *GenesisState) GetFuntokenMappings() []FunToken {
 	return nil
 }
 
+func (m *GenesisState) GetFuntokenRateLimits() []FunTokenRateLimit {
+	if m != nil {
+		return m.FuntokenRateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedFuntokens() []string {
+	if m != nil {
+		return m.PausedFuntokens
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -161,28 +179,32 @@ func init() {
 func init() { proto.RegisterFile("eth/evm/v1/genesis.proto", fileDescriptor_d41c81841e3983b5) }
 
 var fileDescriptor_d41c81841e3983b5 = []byte{
-	// 336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x63, 0x5a, 0xb5, 0xd4, 0x45, 0x40, 0xad, 0x0e, 0x51, 0x87, 0xb4, 0xea, 0x80, 0x3a,
-	0xc5, 0xb4, 0xac, 0x1d, 0xa0, 0x48, 0x74, 0x02, 0xa1, 0x94, 0x89, 0x05, 0xb9, 0xa9, 0x49, 0x22,
-	0x14, 0x3b, 0x8a, 0x9d, 0x88, 0x81, 0x43, 0x70, 0x0e, 0x2e, 0x42, 0xc7, 0x8e, 0x4c, 0x80, 0xda,
-	0x8b, 0x20, 0x3b, 0x0e, 0x84, 0xed, 0xf7, 0xf3, 0xff, 0xbd, 0xff, 0xd9, 0x0f, 0xda, 0x54, 0x86,
-	0x98, 0xe6, 0x31, 0xce, 0xc7, 0x38, 0xa0, 0x8c, 0x8a, 0x48, 0xb8, 0x49, 0xca, 0x25, 0x47, 0x90,
-	0xca, 0xd0, 0xa5, 0x79, 0xec, 0xe6, 0xe3, 0x5e, 0xb7, 0xe2, 0x52, 0x25, 0xed, 0xe8, 0x75, 0x03,
-	0x1e, 0x70, 0x2d, 0xb1, 0x52, 0x45, 0x75, 0xf8, 0x0e, 0xe0, 0xc1, 0xbc, 0xe8, 0xb4, 0x90, 0x44,
-	0x52, 0x34, 0x85, 0xfb, 0xc4, 0xf7, 0x79, 0xc6, 0xa4, 0xb0, 0xc1, 0xa0, 0x36, 0x6a, 0x4f, 0x7a,
-	0xee, 0x5f, 0x6f, 0xd7, 0x78, 0x2f, 0x0a, 0xcb, 0xac, 0xbe, 0xfe, 0xec, 0x5b, 0xde, 0x2f, 0x81,
-	0x4e, 0x61, 0x23, 0x21, 0x29, 0x89, 0x85, 0xbd, 0x37, 0x00, 0xa3, 0xf6, 0x04, 0x55, 0xd9, 0x5b,
-	0x7d, 0x63, 0x18, 0xe3, 0x43, 0x73, 0xd8, 0x79, 0xcc, 0x98, 0xe4, 0x4f, 0x94, 0x3d, 0xc4, 0x24,
-	0x49, 0x22, 0x16, 0x08, 0xbb, 0xa6, 0x83, 0xbb, 0x55, 0xf8, 0x2a, 0x63, 0x77, 0xca, 0x64, 0xf0,
-	0xe3, 0x12, 0xba, 0x36, 0xcc, 0xf0, 0x05, 0x1e, 0xfe, 0x1f, 0x0e, 0xd9, 0xb0, 0x49, 0x56, 0xab,
-	0x94, 0x0a, 0xf5, 0x12, 0x30, 0x6a, 0x79, 0xe5, 0x11, 0x21, 0x58, 0xf7, 0xf9, 0x8a, 0xea, 0x21,
-	0x5b, 0x9e, 0xd6, 0x68, 0x0a, 0x9b, 0x42, 0xf2, 0x94, 0x04, 0xd4, 0xc4, 0x77, 0xaa, 0xf1, 0xfa,
-	0x73, 0x66, 0x47, 0x2a, 0xfb, 0xed, 0xab, 0xdf, 0x5c, 0x14, 0x4e, 0xaf, 0x44, 0x66, 0xe7, 0xeb,
-	0xad, 0x03, 0x36, 0x5b, 0x07, 0x7c, 0x6f, 0x1d, 0xf0, 0xba, 0x73, 0xac, 0xcd, 0xce, 0xb1, 0x3e,
-	0x76, 0x8e, 0x75, 0x7f, 0x12, 0x44, 0x32, 0xcc, 0x96, 0xae, 0xcf, 0x63, 0x7c, 0x13, 0x2d, 0xa3,
-	0x34, 0xbb, 0x0c, 0x49, 0xc4, 0x30, 0xd3, 0x1a, 0xe7, 0x13, 0xfc, 0xac, 0xb6, 0xb4, 0x6c, 0xe8,
-	0x85, 0x9c, 0xfd, 0x04, 0x00, 0x00, 0xff, 0xff, 0x72, 0xb9, 0x50, 0x40, 0xe4, 0x01, 0x00, 0x00,
+	// 396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xb1, 0x8e, 0xda, 0x30,
+	0x18, 0xc7, 0x13, 0xa0, 0x50, 0x4c, 0x55, 0xc0, 0xca, 0x10, 0x21, 0x35, 0x20, 0x86, 0x2a, 0x5d,
+	0xe2, 0x42, 0x57, 0x86, 0x96, 0x4a, 0xb0, 0xb4, 0x55, 0x15, 0xda, 0xa5, 0x0b, 0x32, 0x89, 0x9b,
+	0x58, 0x6d, 0xec, 0x28, 0x76, 0xa2, 0x1b, 0xee, 0x21, 0xee, 0x39, 0xee, 0x49, 0xb8, 0x8d, 0xf1,
+	0xa6, 0xbb, 0x13, 0xbc, 0xc8, 0x29, 0x4e, 0xc2, 0xe5, 0xa4, 0xdb, 0xbe, 0x7c, 0xdf, 0xef, 0xf7,
+	0x8f, 0xf5, 0xd9, 0xc0, 0x24, 0x32, 0x44, 0x24, 0x8b, 0x50, 0x36, 0x43, 0x01, 0x61, 0x44, 0x50,
+	0xe1, 0xc4, 0x09, 0x97, 0x1c, 0x02, 0x22, 0x43, 0x87, 0x64, 0x91, 0x93, 0xcd, 0x46, 0x46, 0x8d,
+	0xca, 0x5b, 0x8a, 0x18, 0x19, 0x01, 0x0f, 0xb8, 0x2a, 0x51, 0x5e, 0x15, 0xdd, 0xe9, 0x4d, 0x03,
+	0xbc, 0x59, 0x17, 0x49, 0x1b, 0x89, 0x25, 0x81, 0x0b, 0xf0, 0x1a, 0x7b, 0x1e, 0x4f, 0x99, 0x14,
+	0xa6, 0x3e, 0x69, 0xda, 0xbd, 0xf9, 0xc8, 0x79, 0xca, 0x76, 0x4a, 0xf6, 0x4b, 0x81, 0x2c, 0x5b,
+	0xfb, 0xbb, 0xb1, 0xe6, 0x9e, 0x0d, 0xf8, 0x11, 0xb4, 0x63, 0x9c, 0xe0, 0x48, 0x98, 0x8d, 0x89,
+	0x6e, 0xf7, 0xe6, 0xb0, 0xee, 0xfe, 0x54, 0x93, 0xd2, 0x29, 0x39, 0xb8, 0x06, 0xc3, 0xbf, 0x29,
+	0x93, 0xfc, 0x1f, 0x61, 0xdb, 0x08, 0xc7, 0x31, 0x65, 0x81, 0x30, 0x9b, 0xea, 0xc7, 0x46, 0x5d,
+	0x5e, 0xa5, 0xec, 0x57, 0x0e, 0x95, 0xfa, 0xa0, 0x92, 0xbe, 0x97, 0x0e, 0xfc, 0x0d, 0x8c, 0x73,
+	0x50, 0x82, 0x25, 0xd9, 0xfe, 0xa7, 0x11, 0x95, 0xc2, 0x6c, 0xa9, 0xac, 0x77, 0x2f, 0x65, 0xb9,
+	0x58, 0x92, 0x6f, 0x39, 0x55, 0x86, 0xc2, 0x2a, 0xe0, 0x3c, 0x10, 0xf0, 0x03, 0x18, 0xc4, 0x38,
+	0x15, 0xc4, 0xdf, 0x56, 0x43, 0x61, 0xbe, 0x9a, 0x34, 0xed, 0xae, 0xdb, 0x2f, 0xfa, 0xab, 0xaa,
+	0x3d, 0xbd, 0x04, 0x6f, 0x9f, 0xaf, 0x07, 0x9a, 0xa0, 0x83, 0x7d, 0x3f, 0x21, 0x22, 0xdf, 0xa5,
+	0x6e, 0x77, 0xdd, 0xea, 0x13, 0x42, 0xd0, 0xf2, 0xb8, 0x4f, 0xd4, 0x9a, 0xba, 0xae, 0xaa, 0xe1,
+	0x02, 0x74, 0x84, 0xe4, 0x09, 0x0e, 0x48, 0xb9, 0x80, 0x61, 0xfd, 0xd0, 0xea, 0x7a, 0x96, 0xfd,
+	0xfc, 0xa0, 0xd7, 0xf7, 0xe3, 0xce, 0xa6, 0x20, 0xdd, 0x4a, 0x59, 0x7e, 0xde, 0x1f, 0x2d, 0xfd,
+	0x70, 0xb4, 0xf4, 0x87, 0xa3, 0xa5, 0x5f, 0x9d, 0x2c, 0xed, 0x70, 0xb2, 0xb4, 0xdb, 0x93, 0xa5,
+	0xfd, 0x79, 0x1f, 0x50, 0x19, 0xa6, 0x3b, 0xc7, 0xe3, 0x11, 0xfa, 0x41, 0x77, 0x34, 0x49, 0xbf,
+	0x86, 0x98, 0x32, 0xc4, 0x54, 0x8d, 0xb2, 0x39, 0xba, 0xc8, 0xdf, 0xc9, 0xae, 0xad, 0x9e, 0xc4,
+	0xa7, 0xc7, 0x01, 0x00, 0x89, 0x34, 0x11, 0xcb, 0x66, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -205,6 +227,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PausedFuntokens) > 0 {
+		for iNdEx := len(m.PausedFuntokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedFuntokens[iNdEx])
+			copy(dAtA[i:], m.PausedFuntokens[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedFuntokens[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FuntokenRateLimits) > 0 {
+		for iNdEx := len(m.FuntokenRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FuntokenRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.FuntokenMappings) > 0 {
 		for iNdEx := len(m.FuntokenMappings) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -328,6 +373,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FuntokenRateLimits) > 0 {
+		for _, e := range m.FuntokenRateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedFuntokens) > 0 {
+		for _, s := range m.PausedFuntokens {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -490,6 +547,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuntokenRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FuntokenRateLimits = append(m.FuntokenRateLimits, FunTokenRateLimit{})
+			if err := m.FuntokenRateLimits[len(m.FuntokenRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedFuntokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedFuntokens = append(m.PausedFuntokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,166 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// CW20 returns a mutable reference to the keeper with Go functions
+// corresponding to the execute and query messages of the CW20 standard, like
+// "transfer" and "balance".
+//
+// See the [CW20 spec].
+//
+// [CW20 spec]: https://github.com/CosmWasm/cw-plus/blob/main/packages/cw20/README.md
+func (k Keeper) CW20() cw20Calls {
+	return cw20Calls{Keeper: &k}
+}
+
+type cw20Calls struct {
+	*Keeper
+}
+
+// CW20TokenInfo: Response of the CW20 "token_info" query.
+type CW20TokenInfo struct {
+	Name        string      `json:"name"`
+	Symbol      string      `json:"symbol"`
+	Decimals    uint8       `json:"decimals"`
+	TotalSupply sdkmath.Int `json:"total_supply"`
+}
+
+// EscrowAddr returns the Bech32 address of the EVM module account, which holds
+// CW20 tokens in escrow for CW20-originated FunToken mappings.
+func (c cw20Calls) EscrowAddr() sdk.AccAddress {
+	return eth.EthAddrToNibiruAddr(evm.EVM_MODULE_ADDRESS)
+}
+
+func (c cw20Calls) wasm() (evm.WasmKeeper, error) {
+	if c.wasmKeeper == nil {
+		return nil, fmt.Errorf("CW20 calls require a Wasm keeper, which is not set on the EVM keeper")
+	}
+	return c.wasmKeeper, nil
+}
+
+/*
+Transfer implements "transfer" from the CW20 standard. The "sender" is the
+account whose balance is debited, meaning the caller of this function must have
+already verified that "sender" authorized the transfer.
+
+	```rust
+	Cw20ExecuteMsg::Transfer { recipient: String, amount: Uint128 }
+	```
+
+Returns the balance increase of the recipient, which is the actual amount of
+tokens received.
+*/
+func (c cw20Calls) Transfer(
+	ctx sdk.Context, cw20, sender, recipient sdk.AccAddress, amount *big.Int,
+) (balanceIncrease *big.Int, err error) {
+	wasmKeeper, err := c.wasm()
+	if err != nil {
+		return nil, err
+	}
+	if amount == nil || amount.Sign() != 1 {
+		return nil, fmt.Errorf("CW20 transfer amount must be positive")
+	}
+
+	balBefore, err := c.BalanceOf(ctx, cw20, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	msgBz, err := json.Marshal(map[string]any{
+		"transfer": map[string]string{
+			"recipient": recipient.String(),
+			"amount":    amount.String(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err = wasmKeeper.Execute(ctx, cw20, sender, msgBz, sdk.Coins{}); err != nil {
+		return nil, fmt.Errorf("CW20 transfer failed: contract %s, sender %s, recipient %s: %w",
+			cw20, sender, recipient, err)
+	}
+
+	balAfter, err := c.BalanceOf(ctx, cw20, recipient)
+	if err != nil {
+		return nil, err
+	}
+	balanceIncrease = new(big.Int).Sub(balAfter, balBefore)
+	if balanceIncrease.Sign() <= 0 {
+		return nil, fmt.Errorf(
+			"CW20 transfer succeeded but recipient balance did not increase: contract %s, recipient %s",
+			cw20, recipient,
+		)
+	}
+	return balanceIncrease, nil
+}
+
+/*
+BalanceOf implements the "balance" query from the CW20 standard.
+
+	```rust
+	Cw20QueryMsg::Balance { address: String } -> BalanceResponse { balance: Uint128 }
+	```
+*/
+func (c cw20Calls) BalanceOf(
+	ctx sdk.Context, cw20, account sdk.AccAddress,
+) (*big.Int, error) {
+	wasmKeeper, err := c.wasm()
+	if err != nil {
+		return nil, err
+	}
+	reqBz, err := json.Marshal(map[string]any{
+		"balance": map[string]string{"address": account.String()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBz, err := wasmKeeper.QuerySmart(ctx, cw20, reqBz)
+	if err != nil {
+		return nil, fmt.Errorf("CW20 balance query failed: contract %s: %w", cw20, err)
+	}
+	var resp struct {
+		Balance sdkmath.Int `json:"balance"`
+	}
+	if err := json.Unmarshal(respBz, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse CW20 balance response: %w", err)
+	}
+	if resp.Balance.IsNil() {
+		return big.NewInt(0), nil
+	}
+	return resp.Balance.BigInt(), nil
+}
+
+/*
+TokenInfo implements the "token_info" query from the CW20 standard.
+
+	```rust
+	Cw20QueryMsg::TokenInfo {} -> TokenInfoResponse
+	```
+*/
+func (c cw20Calls) TokenInfo(
+	ctx sdk.Context, cw20 sdk.AccAddress,
+) (info CW20TokenInfo, err error) {
+	wasmKeeper, err := c.wasm()
+	if err != nil {
+		return info, err
+	}
+	respBz, err := wasmKeeper.QuerySmart(ctx, cw20, []byte(`{"token_info":{}}`))
+	if err != nil {
+		return info, fmt.Errorf("CW20 token_info query failed: contract %s: %w", cw20, err)
+	}
+	if err := json.Unmarshal(respBz, &info); err != nil {
+		return info, fmt.Errorf("failed to parse CW20 token_info response: %w", err)
+	}
+	return info, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// canDeploy returns true if the deploy permission in the [evm.Params] allows
+// the account to deploy contracts. The EVM module can always deploy
+// contracts, like the ERC20s of FunToken mappings.
+func canDeploy(params evm.Params, deployer gethcommon.Address) bool {
+	return deployer == evm.EVM_MODULE_ADDRESS || params.CanDeploy(deployer)
+}
+
+// deployGuard enforces the deploy permission of the EVM on contracts deployed
+// with CREATE or CREATE2 during the execution of an EVM message. The EVM has
+// no way to refuse a deployment, so the guard records the first forbidden
+// deployment with a tracing hook, and [Keeper.ApplyEvmMsg] reverts the
+// execution afterward.
+//
+// The permission is checked against the immediate caller of CREATE or
+// CREATE2, so an allowlisted tx origin doesn't let the contracts it calls
+// deploy. A contract deployed with permission earlier in the same message
+// can deploy contracts too, like a contract that deploys helper contracts
+// from its constructor.
+type deployGuard struct {
+	params evm.Params
+	origin gethcommon.Address
+	// deployed holds the contracts deployed with permission during the
+	// execution of the message.
+	deployed map[gethcommon.Address]struct{}
+	// violation is the error for the first forbidden deployment, if any.
+	violation error
+}
+
+// newDeployGuard returns an error if "origin" cannot deploy a contract with a
+// contract creation tx. Otherwise, it returns the [deployGuard] for the
+// execution of the message, or nil if every deployment is allowed. Messages
+// from the EVM module skip reading the params so that the gas cost of module
+// calls, like the ERC20 calls of the FunToken precompile, doesn't change.
+func (k Keeper) newDeployGuard(
+	ctx sdk.Context, origin gethcommon.Address, contractCreation bool,
+) (*deployGuard, error) {
+	if origin == evm.EVM_MODULE_ADDRESS {
+		return nil, nil
+	}
+	params := k.GetParams(ctx)
+	if params.DeployPermission == evm.DeployPermissionEverybody {
+		return nil, nil
+	}
+	if contractCreation && !canDeploy(params, origin) {
+		return nil, fmt.Errorf(
+			"%w: %s cannot deploy contracts", evm.ErrDeployNotPermitted, origin.Hex(),
+		)
+	}
+	return &deployGuard{
+		params:   params,
+		origin:   origin,
+		deployed: make(map[gethcommon.Address]struct{}),
+	}, nil
+}
+
+// wrapTracer returns a copy of the tracer with an "OnEnter" hook that checks
+// every contract deployment before calling the "OnEnter" hook of the tracer.
+func (g *deployGuard) wrapTracer(tracer *tracing.Hooks) *tracing.Hooks {
+	hooks := new(tracing.Hooks)
+	if tracer != nil {
+		*hooks = *tracer
+	}
+	onEnter := hooks.OnEnter
+	hooks.OnEnter = func(
+		depth int, typ byte, from, to gethcommon.Address, input []byte, gas uint64, value *big.Int,
+	) {
+		isCreate := vm.OpCode(typ) == vm.CREATE || vm.OpCode(typ) == vm.CREATE2
+		if isCreate && g.violation == nil {
+			if _, ok := g.deployed[from]; ok || canDeploy(g.params, from) {
+				g.deployed[to] = struct{}{}
+			} else {
+				g.violation = fmt.Errorf(
+					"%w: %s cannot deploy contracts (tx origin %s)", evm.ErrDeployNotPermitted, from.Hex(), g.origin.Hex(),
+				)
+			}
+		}
+		if onEnter != nil {
+			onEnter(depth, typ, from, to, input, gas, value)
+		}
+	}
+	return hooks
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+)
+
+func (s *SuiteFunToken) TestDeployPermission() {
+	deps := evmtest.NewTestDeps()
+	otherAcc := evmtest.NewEthPrivAcc()
+
+	s.T().Log("Deploy a factory contract while anyone can deploy contracts")
+	factory, err := evmtest.DeployContract(&deps, embeds.SmartContract_TestContractFactory)
+	s.Require().NoError(err)
+
+	setDeployPermission := func(msg *evm.MsgSetDeployPermission) error {
+		_, err := deps.EvmKeeper.SetDeployPermission(sdk.WrapSDKContext(deps.Ctx), msg)
+		return err
+	}
+	queryCanDeploy := func(addr string) bool {
+		resp, err := deps.EvmKeeper.DeployPermission(
+			sdk.WrapSDKContext(deps.Ctx), &evm.QueryDeployPermissionRequest{Address: addr},
+		)
+		s.Require().NoError(err)
+		return resp.CanDeploy
+	}
+	deployFromFactory := func(from gethcommon.Address) error {
+		contractInput, err := embeds.SmartContract_TestContractFactory.ABI.Pack("deploy")
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVM()
+		_, err = deps.EvmKeeper.CallContract(
+			deps.Ctx, evmObj, from, &factory.ContractAddr, contractInput,
+			evmtest.FunTokenGasLimitSendToEvm, evm.COMMIT_ETH_TX, nil,
+		)
+		return err
+	}
+	s.Require().True(queryCanDeploy(deps.Sender.EthAddr.Hex()))
+
+	s.T().Log("sad: only sudoers or governance can set the deploy permission")
+	err = setDeployPermission(&evm.MsgSetDeployPermission{
+		Sender:     deps.Sender.NibiruAddr.String(),
+		Permission: evm.DeployPermissionNobody,
+	})
+	s.Require().ErrorContains(err, "invalid signing authority")
+
+	s.T().Log("sad: invalid allowlist address")
+	err = setDeployPermission(&evm.MsgSetDeployPermission{
+		Sender:         testutil.ADDR_SUDO_ROOT,
+		Permission:     evm.DeployPermissionAllowlist,
+		AddToAllowlist: []string{"not-an-address"},
+	})
+	s.Require().Error(err)
+
+	s.T().Log("happy: sudo root stops all deployments")
+	s.Require().NoError(setDeployPermission(&evm.MsgSetDeployPermission{
+		Sender:     testutil.ADDR_SUDO_ROOT,
+		Permission: evm.DeployPermissionNobody,
+	}))
+	s.Require().False(queryCanDeploy(deps.Sender.NibiruAddr.String()))
+
+	_, err = evmtest.DeployContract(&deps, embeds.SmartContract_TestERC20)
+	s.Require().ErrorContains(err, evm.ErrDeployNotPermitted.Error())
+
+	s.T().Log("sad: contracts cannot deploy contracts either")
+	err = deployFromFactory(deps.Sender.EthAddr)
+	s.Require().ErrorContains(err, evm.ErrDeployNotPermitted.Error())
+	s.Require().Equal(
+		uint64(1), deps.EvmKeeper.GetAccNonce(deps.Ctx, factory.ContractAddr),
+		"the factory nonce must not change after a forbidden deployment",
+	)
+
+	s.T().Log("happy: the EVM module can still deploy FunToken ERC20s")
+	evmtest.CreateFunTokenForBankCoin(deps, "deploypermission", &s.Suite)
+
+	s.T().Log("happy: an allowlisted factory can deploy for any caller")
+	s.Require().NoError(setDeployPermission(&evm.MsgSetDeployPermission{
+		Sender:         testutil.ADDR_SUDO_ROOT,
+		Permission:     evm.DeployPermissionAllowlist,
+		AddToAllowlist: []string{factory.ContractAddr.Hex()},
+	}))
+	testutil.RequireContainsTypedEvent(s.T(), deps.Ctx, &evm.EventDeployPermissionUpdated{
+		Sender:     testutil.ADDR_SUDO_ROOT,
+		Permission: evm.DeployPermissionAllowlist,
+		Allowlist:  []string{factory.ContractAddr.Hex()},
+	})
+	s.Require().NoError(deployFromFactory(otherAcc.EthAddr))
+	s.Require().Equal(uint64(2), deps.EvmKeeper.GetAccNonce(deps.Ctx, factory.ContractAddr))
+	_, err = evmtest.DeployContract(&deps, embeds.SmartContract_TestERC20)
+	s.Require().ErrorContains(err, evm.ErrDeployNotPermitted.Error())
+
+	s.T().Log("happy: an allowlisted account can deploy contracts")
+	s.Require().NoError(setDeployPermission(&evm.MsgSetDeployPermission{
+		Sender:              testutil.ADDR_SUDO_ROOT,
+		Permission:          evm.DeployPermissionAllowlist,
+		AddToAllowlist:      []string{deps.Sender.NibiruAddr.String()},
+		RemoveFromAllowlist: []string{factory.ContractAddr.Hex()},
+	}))
+	resp, err := deps.EvmKeeper.DeployPermission(
+		sdk.WrapSDKContext(deps.Ctx), &evm.QueryDeployPermissionRequest{},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{deps.Sender.EthAddr.Hex()}, resp.Allowlist)
+	s.Require().True(queryCanDeploy(deps.Sender.EthAddr.Hex()))
+	s.Require().False(queryCanDeploy(otherAcc.EthAddr.Hex()))
+
+	_, err = evmtest.DeployContract(&deps, embeds.SmartContract_TestERC20)
+	s.Require().NoError(err)
+
+	s.T().Log("sad: an allowlisted tx origin doesn't let the contracts it calls deploy")
+	s.Require().ErrorContains(deployFromFactory(deps.Sender.EthAddr), evm.ErrDeployNotPermitted.Error())
+	s.Require().ErrorContains(deployFromFactory(otherAcc.EthAddr), evm.ErrDeployNotPermitted.Error())
+	contractInput, err := embeds.SmartContract_TestContractFactory.ABI.Pack("deploy2", [32]byte{1})
+	s.Require().NoError(err)
+	evmObj, _ := deps.NewEVM()
+	_, err = deps.EvmKeeper.CallContract(
+		deps.Ctx, evmObj, deps.Sender.EthAddr, &factory.ContractAddr, contractInput,
+		evmtest.FunTokenGasLimitSendToEvm, evm.COMMIT_ETH_TX, nil,
+	)
+	s.Require().ErrorContains(err, evm.ErrDeployNotPermitted.Error(), "CREATE2")
+	s.Require().Equal(uint64(2), deps.EvmKeeper.GetAccNonce(deps.Ctx, factory.ContractAddr))
+
+	s.T().Log("happy: a contract deployed by an allowlisted account can deploy in the same tx")
+	// Init code that deploys an empty contract with CREATE: PUSH0 PUSH0 PUSH0
+	// CREATE POP STOP
+	deployer, err := evmtest.DeployContract(&deps, embeds.CompiledEvmContract{
+		ABI:      new(gethabi.ABI),
+		Bytecode: []byte{0x5f, 0x5f, 0x5f, 0xf0, 0x50, 0x00},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), deps.EvmKeeper.GetAccNonce(deps.Ctx, deployer.ContractAddr))
+
+	s.T().Log("happy: anyone can deploy contracts again")
+	s.Require().NoError(setDeployPermission(&evm.MsgSetDeployPermission{
+		Sender:     testutil.ADDR_SUDO_ROOT,
+		Permission: evm.DeployPermissionEverybody,
+	}))
+	s.Require().NoError(deployFromFactory(otherAcc.EthAddr))
+}
@@ -44,6 +44,8 @@ type EvmState struct {
 	BlockTxIndex collections.ItemTransient[uint64]
 	// BlockBloom: Bloom filters.
 	BlockBloom collections.ItemTransient[[]byte]
+	// BlockGasUsed: Gas used by the EVM txs of the block (transient).
+	BlockGasUsed collections.ItemTransient[uint64]
 }
 
 func (k *Keeper) EVMState() EvmState { return k.EvmState }
@@ -83,6 +85,11 @@ func NewEvmState(
 			evm.NamespaceBlockTxIndex,
 			collections.Uint64ValueEncoder,
 		),
+		BlockGasUsed: collections.NewItemTransient(
+			storeKeyTransient,
+			evm.NamespaceBlockGasUsed,
+			collections.Uint64ValueEncoder,
+		),
 	}
 }
 
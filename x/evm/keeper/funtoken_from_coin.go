@@ -18,7 +18,7 @@ import (
 )
 
 func (k *Keeper) createFunTokenFromCoin(
-	ctx sdk.Context, bankDenom string, allowZeroDecimals bool,
+	ctx sdk.Context, bankDenom string, allowZeroDecimals bool, decimalsScale uint32,
 ) (funtoken *evm.FunToken, err error) {
 	// 1 | Coin already registered with FunToken?
 	if funtokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.BankDenom.ExactMatch(ctx, bankDenom)); len(funtokens) > 0 {
@@ -36,6 +36,7 @@ func (k *Keeper) createFunTokenFromCoin(
 		ctx,
 		bankMetadata,
 		allowZeroDecimals,
+		decimalsScale,
 	)
 	if err != nil {
 		return nil, sdkioerrors.Wrap(err, "failed to deploy ERC20 for bank coin")
@@ -53,17 +54,14 @@ func (k *Keeper) createFunTokenFromCoin(
 		},
 		BankDenom:      bankDenom,
 		IsMadeFromCoin: true,
+		DecimalsScale:  decimalsScale,
 	}
 
-	return funtoken, k.FunTokens.SafeInsert(
-		ctx, erc20Addr,
-		funtoken.BankDenom,
-		funtoken.IsMadeFromCoin,
-	)
+	return funtoken, k.FunTokens.SafeInsertFunToken(ctx, *funtoken)
 }
 
 func (k *Keeper) deployERC20ForBankCoin(
-	ctx sdk.Context, bankCoin bank.Metadata, allowZeroDecimals bool,
+	ctx sdk.Context, bankCoin bank.Metadata, allowZeroDecimals bool, decimalsScale uint32,
 ) (erc20Addr gethcommon.Address, err error) {
 	erc20Addr = crypto.CreateAddress(evm.EVM_MODULE_ADDRESS, k.GetAccNonce(ctx, evm.EVM_MODULE_ADDRESS))
 
@@ -72,15 +70,25 @@ func (k *Keeper) deployERC20ForBankCoin(
 		err = fmt.Errorf(`metadata unsuitable to create FunToken mapping for Bank Coin "%s": %w. Fix this with "MsgSudoSetDenomMetadata" or "MsgSetDenomMetadata"`, bankCoin.Base, err)
 		return
 	}
+	// The ERC20 has "decimalsScale" more decimals than the bank coin, so that
+	// amounts of the two represent the same value for clients.
+	if erc20Decimals := uint32(erc20Info.Decimals) + decimalsScale; erc20Decimals > evm.MaxErc20Decimals {
+		err = fmt.Errorf(
+			"decimals scale %d gives the ERC20 for bank coin \"%s\" %d decimals, which exceeds the maximum of %d",
+			decimalsScale, bankCoin.Base, erc20Decimals, evm.MaxErc20Decimals,
+		)
+		return
+	}
+	erc20Info.Decimals += uint8(decimalsScale)
 
 	// pass empty method name to deploy the contract
-	packedArgs, err := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI.Pack(
+	packedArgs, err := embeds.SmartContract_ERC20MinterWithPermit.ABI.Pack(
 		"", erc20Info.Name, erc20Info.Symbol, erc20Info.Decimals,
 	)
 	if err != nil {
 		return gethcommon.Address{}, sdkioerrors.Wrap(err, "failed to pack ABI args")
 	}
-	input := append(embeds.SmartContract_ERC20MinterWithMetadataUpdates.Bytecode, packedArgs...)
+	input := append(embeds.SmartContract_ERC20MinterWithPermit.Bytecode, packedArgs...)
 
 	unusedBigInt := big.NewInt(0)
 	evmMsg := core.Message{
@@ -2,6 +2,7 @@
 package keeper_test
 
 import (
+	"math"
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,8 @@ import (
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
 	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
 )
 
 func (s *SuiteFunToken) TestCreateFunTokenFromCoin() {
@@ -430,3 +433,277 @@ func (s *SuiteFunToken) fundAndCreateFunToken(deps evmtest.TestDeps, bankAmount
 
 	return createFunTokenResp.FuntokenMapping
 }
+
+// TestFunTokenFromCoin_Permit: The ERC20 deployed for a FunToken mapping from a
+// bank coin supports EIP-2612 "permit" with a domain separator that uses the
+// Ethereum chain ID of the network.
+func (s *SuiteFunToken) TestFunTokenFromCoin_Permit() {
+	deps := evmtest.NewTestDeps()
+	bankDenom := "permittoken"
+	funtoken := evmtest.CreateFunTokenForBankCoin(deps, bankDenom, &s.Suite)
+	erc20 := funtoken.Erc20Addr.Address
+	erc20Abi := embeds.SmartContract_ERC20MinterWithPermit.ABI
+	tokenName := "Name for " + bankDenom
+	evmObj, _ := deps.NewEVM()
+
+	s.T().Log("DOMAIN_SEPARATOR respects the EthChainID")
+	{
+		input, err := erc20Abi.Pack("DOMAIN_SEPARATOR")
+		s.Require().NoError(err)
+		evmResp, err := deps.EvmKeeper.CallContract(
+			deps.Ctx, evmObj, deps.Sender.EthAddr, &erc20, input,
+			keeper.Erc20GasLimitQuery, evm.COMMIT_READONLY, nil,
+		)
+		s.Require().NoError(err)
+		s.Require().Equal(
+			evmtest.PermitDomainSeparator(deps, erc20, tokenName).Hex(),
+			gethcommon.BytesToHash(evmResp.Ret).Hex(),
+		)
+	}
+
+	s.T().Log("eip712Domain reports the EIP-712 domain (EIP-5267)")
+	{
+		input, err := erc20Abi.Pack("eip712Domain")
+		s.Require().NoError(err)
+		evmResp, err := deps.EvmKeeper.CallContract(
+			deps.Ctx, evmObj, deps.Sender.EthAddr, &erc20, input,
+			keeper.Erc20GasLimitQuery, evm.COMMIT_READONLY, nil,
+		)
+		s.Require().NoError(err)
+		out, err := erc20Abi.Unpack("eip712Domain", evmResp.Ret)
+		s.Require().NoError(err)
+		s.Require().Equal([1]byte{0x0f}, out[0])
+		s.Require().Equal(tokenName, out[1])
+		s.Require().Equal("1", out[2])
+		s.Require().Equal(deps.EvmKeeper.EthChainID(deps.Ctx).String(), out[3].(*big.Int).String())
+		s.Require().Equal(erc20, out[4])
+	}
+
+	owner := evmtest.NewEthPrivAcc()
+	spender := evmtest.NewEthPrivAcc()
+	permit := evmtest.ERC20Permit{
+		Owner:    owner,
+		Spender:  spender.EthAddr,
+		Value:    big.NewInt(420),
+		Nonce:    big.NewInt(0),
+		Deadline: new(big.Int).SetUint64(math.MaxUint64),
+	}
+	callPermit := func(permit evmtest.ERC20Permit, v uint8, r, sig [32]byte) error {
+		input, err := erc20Abi.Pack(
+			"permit", permit.Owner.EthAddr, permit.Spender, permit.Value, permit.Deadline, v, r, sig,
+		)
+		s.Require().NoError(err)
+		_, err = deps.EvmKeeper.CallContract(
+			deps.Ctx, evmObj, spender.EthAddr, &erc20, input,
+			evm.Erc20GasLimitExecute, evm.COMMIT_ETH_TX, nil,
+		)
+		return err
+	}
+
+	s.T().Log("sad: permit signed by another account")
+	{
+		wrongSigner := permit
+		wrongSigner.Owner = spender
+		v, r, sig := wrongSigner.Sign(s.T(), deps, erc20, tokenName)
+		s.Require().ErrorContains(callPermit(permit, v, r, sig), "ERC20Permit: invalid signature")
+	}
+
+	s.T().Log("happy: spender submits the owner's permit")
+	v, r, sig := permit.Sign(s.T(), deps, erc20, tokenName)
+	s.Require().NoError(callPermit(permit, v, r, sig))
+
+	allowance, err := deps.EvmKeeper.ERC20().LoadERC20BigInt(
+		deps.Ctx, evmObj, erc20Abi, erc20, "allowance", owner.EthAddr, spender.EthAddr,
+	)
+	s.Require().NoError(err)
+	s.Require().Equal("420", allowance.String())
+	nonce, err := deps.EvmKeeper.ERC20().LoadERC20BigInt(
+		deps.Ctx, evmObj, erc20Abi, erc20, "nonces", owner.EthAddr,
+	)
+	s.Require().NoError(err)
+	s.Require().Equal("1", nonce.String())
+
+	s.T().Log("sad: permit cannot be replayed")
+	s.Require().ErrorContains(callPermit(permit, v, r, sig), "ERC20Permit: invalid signature")
+}
+
+// TestFunTokenFromCoin_DecimalsScale: A FunToken mapping created from a bank
+// coin with a decimals scale "k" gets an ERC20 with "k" more decimals than the
+// coin, and conversions scale amounts by 10^k. Conversions to the bank coin
+// only move whole bank base units, and the dust goes into the escrow of the EVM
+// module.
+func (s *SuiteFunToken) TestFunTokenFromCoin_DecimalsScale() {
+	deps := evmtest.NewTestDeps()
+	bankDenom := "scaledcoin"
+	deps.App.BankKeeper.SetDenomMetaData(deps.Ctx, bank.Metadata{
+		DenomUnits: []*bank.DenomUnit{
+			{Denom: bankDenom, Exponent: 0},
+			{Denom: "SCALED", Exponent: 6},
+		},
+		Base:    bankDenom,
+		Display: "SCALED",
+		Name:    "Scaled Coin",
+		Symbol:  "SCALED",
+	})
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx).Add(sdk.NewInt64Coin(bankDenom, 100)),
+	))
+	cacheCtx, _ := deps.Ctx.CacheContext()
+
+	s.T().Log("sad: the ERC20 cannot have more than 18 decimals")
+	_, err := deps.EvmKeeper.CreateFunToken(
+		sdk.WrapSDKContext(cacheCtx),
+		&evm.MsgCreateFunToken{
+			FromBankDenom: bankDenom,
+			Sender:        deps.Sender.NibiruAddr.String(),
+			DecimalsScale: 13,
+		},
+	)
+	s.Require().ErrorContains(err, "exceeds the maximum of 18")
+
+	s.T().Log("happy: create a FunToken with 18 decimals for a coin with 6 decimals")
+	createResp, err := deps.EvmKeeper.CreateFunToken(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgCreateFunToken{
+			FromBankDenom: bankDenom,
+			Sender:        deps.Sender.NibiruAddr.String(),
+			DecimalsScale: 12,
+		},
+	)
+	s.Require().NoError(err)
+	funtoken := createResp.FuntokenMapping
+	s.Require().EqualValues(12, funtoken.DecimalsScale)
+	erc20 := funtoken.Erc20Addr.Address
+
+	evmObj, _ := deps.NewEVM()
+	info, err := deps.EvmKeeper.FindERC20Metadata(deps.Ctx, evmObj, erc20, nil)
+	s.Require().NoError(err)
+	s.Require().EqualValues(18, info.Decimals)
+
+	s.T().Log("Convert 5 bank base units to 5 * 10^12 ERC20 base units")
+	_, err = deps.EvmKeeper.ConvertCoinToEvm(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertCoinToEvm{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			BankCoin:  sdk.NewInt64Coin(bankDenom, 5),
+			ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+		},
+	)
+	s.Require().NoError(err)
+	testutil.RequireContainsTypedEvent(s.T(), deps.Ctx, &evm.EventConvertCoinToEvm{
+		Sender:               deps.Sender.NibiruAddr.String(),
+		Erc20ContractAddress: erc20.String(),
+		ToEthAddr:            deps.Sender.EthAddr.String(),
+		BankCoin:             sdk.NewInt64Coin(bankDenom, 5),
+		Erc20Amount:          "5000000000000",
+	})
+	evmObj, _ = deps.NewEVM()
+	evmtest.FunTokenBalanceAssert{
+		FunToken:     funtoken,
+		Account:      deps.Sender.EthAddr,
+		BalanceBank:  big.NewInt(95),
+		BalanceERC20: big.NewInt(5e12),
+	}.Assert(s.T(), deps, evmObj)
+
+	s.T().Log("sad: convert less than one bank base unit back to the coin")
+	_, err = deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			Erc20Addr: funtoken.Erc20Addr,
+			Amount:    sdk.NewInt(1e12 - 1),
+			ToAddr:    deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().ErrorContains(err, "less than one base unit")
+
+	s.T().Log("Convert 2.5 bank base units worth of ERC20: the dust goes into escrow")
+	_, err = deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			Erc20Addr: funtoken.Erc20Addr,
+			Amount:    sdk.NewInt(2.5e12),
+			ToAddr:    deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().NoError(err)
+	testutil.RequireContainsTypedEvent(s.T(), deps.Ctx, &evm.EventConvertEvmToCoin{
+		Sender:               deps.Sender.NibiruAddr.String(),
+		Erc20ContractAddress: erc20.Hex(),
+		ToAddress:            deps.Sender.NibiruAddr.String(),
+		BankCoin:             sdk.NewInt64Coin(bankDenom, 2),
+		SenderEthAddr:        deps.Sender.EthAddr.Hex(),
+		Erc20Amount:          "2500000000000",
+	})
+	evmObj, _ = deps.NewEVM()
+	evmtest.FunTokenBalanceAssert{
+		FunToken:     funtoken,
+		Account:      deps.Sender.EthAddr,
+		BalanceBank:  big.NewInt(97),
+		BalanceERC20: big.NewInt(2.5e12),
+	}.Assert(s.T(), deps, evmObj)
+	evmtest.FunTokenBalanceAssert{
+		FunToken:     funtoken,
+		Account:      evm.EVM_MODULE_ADDRESS,
+		BalanceBank:  big.NewInt(3),
+		BalanceERC20: big.NewInt(0.5e12),
+		Description:  "escrow holds the dust",
+	}.Assert(s.T(), deps, evmObj)
+
+	s.T().Log("Precompile sendToBank: 1.5 bank base units worth of ERC20 sends 1 coin")
+	alice := evmtest.NewEthPrivAcc()
+	contractInput, err := embeds.SmartContract_FunToken.ABI.Pack(
+		string(precompile.FunTokenMethod_sendToBank), erc20, big.NewInt(1.5e12), alice.NibiruAddr.String(),
+	)
+	s.Require().NoError(err)
+	evmObj, _ = deps.NewEVM()
+	evmResp, err := deps.EvmKeeper.CallContract(
+		deps.Ctx, evmObj, deps.Sender.EthAddr, &precompile.PrecompileAddr_FunToken,
+		contractInput, evmtest.FunTokenGasLimitSendToEvm, evm.COMMIT_ETH_TX, nil,
+	)
+	s.Require().NoError(err)
+	sentAmount, err := embeds.SmartContract_FunToken.ABI.Unpack(
+		string(precompile.FunTokenMethod_sendToBank), evmResp.Ret,
+	)
+	s.Require().NoError(err)
+	s.Require().Equal("1", sentAmount[0].(*big.Int).String())
+
+	s.T().Log("Precompile sendToEvm: 1 coin mints 10^12 ERC20 base units")
+	contractInput, err = embeds.SmartContract_FunToken.ABI.Pack(
+		string(precompile.FunTokenMethod_sendToEvm), bankDenom, big.NewInt(1), alice.EthAddr.Hex(),
+	)
+	s.Require().NoError(err)
+	evmResp, err = deps.EvmKeeper.CallContract(
+		deps.Ctx, evmObj, deps.Sender.EthAddr, &precompile.PrecompileAddr_FunToken,
+		contractInput, evmtest.FunTokenGasLimitSendToEvm, evm.COMMIT_ETH_TX, nil,
+	)
+	s.Require().NoError(err)
+	sentAmount, err = embeds.SmartContract_FunToken.ABI.Unpack(
+		string(precompile.FunTokenMethod_sendToEvm), evmResp.Ret,
+	)
+	s.Require().NoError(err)
+	s.Require().Equal("1000000000000", sentAmount[0].(*big.Int).String())
+
+	evmtest.FunTokenBalanceAssert{
+		FunToken:     funtoken,
+		Account:      deps.Sender.EthAddr,
+		BalanceBank:  big.NewInt(96),
+		BalanceERC20: big.NewInt(1e12),
+		Description:  "sendToBank takes the dust from the sender",
+	}.Assert(s.T(), deps, evmObj)
+	evmtest.FunTokenBalanceAssert{
+		FunToken:     funtoken,
+		Account:      alice.EthAddr,
+		BalanceBank:  big.NewInt(1),
+		BalanceERC20: big.NewInt(1e12),
+	}.Assert(s.T(), deps, evmObj)
+	evmtest.FunTokenBalanceAssert{
+		FunToken:     funtoken,
+		Account:      evm.EVM_MODULE_ADDRESS,
+		BalanceBank:  big.NewInt(3),
+		BalanceERC20: big.NewInt(1e12),
+		Description:  "escrow holds the dust and backs the 3 * 10^12 ERC20 supply",
+	}.Assert(s.T(), deps, evmObj)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"fmt"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// createFunTokenFromCW20 creates a new FunToken mapping from an existing CW20
+// token.
+//
+// This function performs the following steps:
+//  1. Checks if the CW20 token is already registered as a FunToken.
+//  2. Retrieves the metadata of the CW20 token with the "token_info" query.
+//  3. Sets the bank coin denom metadata, "cw20/{cw20_addr}", in the state.
+//  4. Deploys an ERC20 owned by the EVM module with the same metadata.
+//  5. Creates and inserts the new FunToken mapping.
+//
+// The CW20 balance is the Cosmos-side representation of the token. Converting
+// to the ERC20 escrows CW20 tokens in the EVM module account, and converting
+// back releases them, so no bank coins are minted for the "cw20/" denom.
+func (k *Keeper) createFunTokenFromCW20(
+	ctx sdk.Context, cw20 sdk.AccAddress, allowZeroDecimals bool,
+) (funtoken *evm.FunToken, err error) {
+	bankDenom := evm.Cw20BankDenom(cw20.String())
+
+	// 1 | CW20 already registered with FunToken?
+	if funtokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.BankDenom.ExactMatch(ctx, bankDenom)); len(funtokens) > 0 {
+		return nil, fmt.Errorf("funtoken mapping already created for CW20 \"%s\"", cw20)
+	}
+	if _, isFound := k.Bank.GetDenomMetaData(ctx, bankDenom); isFound {
+		return nil, fmt.Errorf("bank coin denom already registered with denom \"%s\"", bankDenom)
+	}
+
+	// 2 | Get existing CW20 metadata
+	cw20Info, err := k.CW20().TokenInfo(ctx, cw20)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3 | Set bank coin denom metadata in state
+	var bankMetadata bank.Metadata
+	{
+		displayDenom := bankDenom
+		denomUnits := []*bank.DenomUnit{
+			{
+				Denom:    bankDenom,
+				Exponent: 0,
+			},
+		}
+		if cw20Info.Decimals > 0 {
+			displayDenom = fmt.Sprintf("decimals_denom_for-%s", bankDenom)
+			denomUnits = append(denomUnits, &bank.DenomUnit{
+				Denom:    displayDenom,
+				Exponent: uint32(cw20Info.Decimals),
+			})
+		}
+		bankMetadata = bank.Metadata{
+			Description: fmt.Sprintf(
+				`CW20 token "%s" with a corresponding FunToken mapping`, cw20,
+			),
+			DenomUnits: denomUnits,
+			Base:       bankDenom,
+			Display:    displayDenom,
+			Name:       cw20Info.Name,
+			Symbol:     cw20Info.Symbol,
+		}
+	}
+	if _, err = evm.ValidateFunTokenBankMetadata(
+		bankMetadata,
+		allowZeroDecimals,
+	); err != nil {
+		err = fmt.Errorf(`metadata unsuitable to create FunToken mapping for CW20 "%s": %w`, cw20, err)
+		return
+	}
+	k.Bank.SetDenomMetaData(ctx, bankMetadata)
+
+	// 4 | deploy ERC20 for metadata
+	erc20Addr, err := k.deployERC20ForBankCoin(ctx, bankMetadata, allowZeroDecimals, 0 /*decimalsScale*/)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to deploy ERC20 for CW20")
+	}
+	if funtokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20Addr)); len(funtokens) > 0 {
+		return nil, fmt.Errorf("funtoken mapping already created for ERC20 \"%s\"", erc20Addr.Hex())
+	}
+
+	// 5 | Officially create the funtoken mapping
+	funtoken = &evm.FunToken{
+		Erc20Addr: eth.EIP55Addr{
+			Address: erc20Addr,
+		},
+		BankDenom:      bankDenom,
+		IsMadeFromCoin: false,
+		Cw20Addr:       cw20.String(),
+	}
+
+	return funtoken, k.FunTokens.SafeInsertFunToken(ctx, *funtoken)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile/test"
+)
+
+func (s *SuiteFunToken) TestFunTokenFromCW20() {
+	deps := evmtest.NewTestDeps()
+	cw20 := test.DeployCW20(&deps, &s.Suite, 6, sdkmath.NewInt(1_000_000))
+	bankDenom := evm.Cw20BankDenom(cw20.String())
+
+	s.T().Log("sad: insufficient funds to create FunToken mapping")
+	_, err := deps.EvmKeeper.CreateFunToken(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgCreateFunToken{
+			FromCw20: cw20.String(),
+			Sender:   deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().ErrorContains(err, "insufficient funds")
+
+	s.T().Log("happy: CreateFunToken for the CW20")
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx),
+	))
+	createFunTokenResp, err := deps.EvmKeeper.CreateFunToken(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgCreateFunToken{
+			FromCw20: cw20.String(),
+			Sender:   deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().NoError(err)
+	funtoken := createFunTokenResp.FuntokenMapping
+	s.Require().True(funtoken.IsMadeFromCw20())
+	s.Require().False(funtoken.IsMadeFromCoin)
+	s.Require().Equal(bankDenom, funtoken.BankDenom)
+	s.Require().Equal(cw20.String(), funtoken.Cw20Addr)
+
+	testutil.RequireContainsTypedEvent(
+		s.T(),
+		deps.Ctx,
+		&evm.EventFunTokenCreated{
+			BankDenom:            bankDenom,
+			Erc20ContractAddress: funtoken.Erc20Addr.String(),
+			Creator:              deps.Sender.NibiruAddr.String(),
+			IsMadeFromCoin:       false,
+			Cw20Addr:             cw20.String(),
+		},
+	)
+
+	s.T().Log("Expect ERC20 metadata to match the CW20")
+	evmObj, _ := deps.NewEVM()
+	info, err := deps.EvmKeeper.FindERC20Metadata(deps.Ctx, evmObj, funtoken.Erc20Addr.Address, nil)
+	s.Require().NoError(err)
+	s.Equal(
+		evm.ERC20Metadata{
+			Name:     "Test CW20 Token",
+			Symbol:   "TESTCW",
+			Decimals: 6,
+		}, *info,
+	)
+
+	s.T().Log("Expect the FunTokenMapping query to resolve the CW20 address")
+	queryResp, err := deps.EvmKeeper.FunTokenMapping(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.QueryFunTokenMappingRequest{Token: cw20.String()},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(funtoken, *queryResp.FunToken)
+
+	s.T().Log("sad: CW20 already registered")
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx),
+	))
+	_, err = deps.EvmKeeper.CreateFunToken(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgCreateFunToken{
+			FromCw20: cw20.String(),
+			Sender:   deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().ErrorContains(err, "funtoken mapping already created")
+
+	s.T().Log("happy: convert CW20 to ERC20")
+	_, err = deps.EvmKeeper.ConvertCoinToEvm(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertCoinToEvm{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+			BankCoin:  sdk.NewInt64Coin(bankDenom, 400_000),
+		},
+	)
+	s.Require().NoError(err)
+	s.assertCW20Balance(deps, cw20, deps.Sender.NibiruAddr, big.NewInt(600_000))
+	s.assertCW20Balance(deps, cw20, deps.EvmKeeper.CW20().EscrowAddr(), big.NewInt(400_000))
+	evmtest.AssertERC20BalanceEqualWithDescription(
+		s.T(), deps, evmObj, funtoken.Erc20Addr.Address, deps.Sender.EthAddr, big.NewInt(400_000), "sender ERC20",
+	)
+
+	s.T().Log("sad: convert more CW20 than the sender has")
+	_, err = deps.EvmKeeper.ConvertCoinToEvm(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertCoinToEvm{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+			BankCoin:  sdk.NewInt64Coin(bankDenom, 1_000_000),
+		},
+	)
+	s.Require().ErrorContains(err, "failed to escrow CW20 tokens")
+
+	s.T().Log("happy: convert ERC20 back to CW20")
+	toAddr := evmtest.NewEthPrivAcc().NibiruAddr
+	_, err = deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			Erc20Addr: funtoken.Erc20Addr,
+			Amount:    sdkmath.NewInt(150_000),
+			ToAddr:    toAddr.String(),
+		},
+	)
+	s.Require().NoError(err)
+	s.assertCW20Balance(deps, cw20, toAddr, big.NewInt(150_000))
+	s.assertCW20Balance(deps, cw20, deps.EvmKeeper.CW20().EscrowAddr(), big.NewInt(250_000))
+	evmObj, _ = deps.NewEVM()
+	evmtest.AssertERC20BalanceEqualWithDescription(
+		s.T(), deps, evmObj, funtoken.Erc20Addr.Address, deps.Sender.EthAddr, big.NewInt(250_000), "sender ERC20",
+	)
+	s.Require().True(
+		deps.App.BankKeeper.GetSupply(deps.Ctx, bankDenom).IsZero(),
+		"no bank coins should exist for a CW20 FunToken",
+	)
+}
+
+func (s *SuiteFunToken) assertCW20Balance(
+	deps evmtest.TestDeps, cw20, account sdk.AccAddress, want *big.Int,
+) {
+	got, err := deps.EvmKeeper.CW20().BalanceOf(deps.Ctx, cw20, account)
+	s.Require().NoError(err)
+	s.Require().Equal(want.String(), got.String(), "CW20 balance of %s", account)
+}
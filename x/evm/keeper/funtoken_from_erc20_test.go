@@ -208,7 +208,7 @@ func (s *SuiteFunToken) TestCreateFunTokenFromERC20() {
 						Sender:        deps.Sender.NibiruAddr.String(),
 					},
 				)
-				s.Require().ErrorContains(err, `either the "from_erc20" or "from_bank_denom" must be set`)
+				s.Require().ErrorContains(err, `exactly one of "from_erc20", "from_bank_denom", or "from_cw20" must be set`)
 			},
 		},
 	})
@@ -347,6 +347,7 @@ func (s *SuiteFunToken) TestSendFromEvmToBank_MadeFromErc20() {
 					Denom:  bankDemon,
 					Amount: sdk.NewInt(1),
 				},
+				Erc20Amount: "1",
 			},
 		)
 
@@ -0,0 +1,64 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// RecordFunTokenFlow guards every conversion of a FunToken mapping between its
+// bank (or CW20) and ERC-20 representations. It returns an error if the
+// conversions of the mapping are paused or if the conversion would exceed the
+// rate limit of the mapping. Otherwise, it adds the conversion to the net flow
+// of the current rate limit window.
+//
+// "netToEvm" is the amount converted: positive when tokens move into the
+// ERC-20 representation and negative when they move out of it.
+//
+// Conversions that bring the net flow of the window closer to zero are always
+// allowed, even if the net flow was already beyond a limit that was lowered
+// during the window.
+func (k Keeper) RecordFunTokenFlow(
+	ctx sdk.Context, bankDenom string, netToEvm sdkmath.Int,
+) error {
+	if k.FunTokens.Paused.Has(ctx, bankDenom) {
+		return fmt.Errorf("%w: bank denom \"%s\"", evm.ErrFunTokenPaused, bankDenom)
+	}
+
+	rateLimit, err := k.FunTokens.RateLimits.Get(ctx, bankDenom)
+	if err != nil {
+		// No rate limit for this mapping
+		return nil
+	}
+
+	flow := k.CurrentFunTokenFlow(ctx, rateLimit)
+	flow.NetToEvm = flow.NetToEvm.Add(netToEvm)
+	if netToEvm.Sign() == flow.NetToEvm.Sign() {
+		if err := rateLimit.CheckNetFlow(flow.NetToEvm); err != nil {
+			return err
+		}
+	}
+	k.FunTokens.Flows.Insert(ctx, bankDenom, flow)
+	return nil
+}
+
+// CurrentFunTokenFlow returns the net flow of the current rate limit window for
+// the given rate limit. A window starts with the first conversion after the
+// previous window ended and lasts "rateLimit.WindowBlocks" blocks. If the last
+// window has ended, an empty flow starting at the current block is returned.
+func (k Keeper) CurrentFunTokenFlow(
+	ctx sdk.Context, rateLimit evm.FunTokenRateLimit,
+) evm.FunTokenFlow {
+	height := ctx.BlockHeight()
+	flow, err := k.FunTokens.Flows.Get(ctx, rateLimit.BankDenom)
+	if err != nil ||
+		height < flow.WindowStartHeight ||
+		uint64(height-flow.WindowStartHeight) >= rateLimit.WindowBlocks {
+		return evm.NewFunTokenFlow(rateLimit.BankDenom, height)
+	}
+	return flow
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+)
+
+func (s *SuiteFunToken) TestFunTokenRateLimit() {
+	deps := evmtest.NewTestDeps()
+	bankDenom := "ratelimited"
+	funtoken := evmtest.CreateFunTokenForBankCoin(deps, bankDenom, &s.Suite)
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(bankDenom, 1_000)),
+	))
+
+	convertToEvm := func(amount int64) error {
+		_, err := deps.EvmKeeper.ConvertCoinToEvm(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertCoinToEvm{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+				BankCoin:  sdk.NewInt64Coin(bankDenom, amount),
+			},
+		)
+		return err
+	}
+	convertToBank := func(amount int64) error {
+		_, err := deps.EvmKeeper.ConvertEvmToCoin(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertEvmToCoin{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				Erc20Addr: funtoken.Erc20Addr,
+				Amount:    sdkmath.NewInt(amount),
+				ToAddr:    deps.Sender.NibiruAddr.String(),
+			},
+		)
+		return err
+	}
+	queryRateLimit := func() *evm.QueryFunTokenRateLimitResponse {
+		resp, err := deps.EvmKeeper.FunTokenRateLimit(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.QueryFunTokenRateLimitRequest{Token: funtoken.Erc20Addr.Hex()},
+		)
+		s.Require().NoError(err)
+		return resp
+	}
+
+	rateLimit := evm.FunTokenRateLimit{
+		BankDenom:    bankDenom,
+		WindowBlocks: 10,
+		MaxNetToEvm:  sdkmath.NewInt(500),
+		MaxNetToBank: sdkmath.NewInt(200),
+	}
+
+	s.T().Log("sad: only sudoers or governance can set a rate limit")
+	_, err := deps.EvmKeeper.SetFunTokenRateLimit(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgSetFunTokenRateLimit{Sender: deps.Sender.NibiruAddr.String(), RateLimit: rateLimit},
+	)
+	s.Require().ErrorContains(err, "invalid signing authority")
+
+	s.T().Log("sad: rate limit for a bank denom without a FunToken mapping")
+	_, err = deps.EvmKeeper.SetFunTokenRateLimit(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgSetFunTokenRateLimit{
+			Sender:    testutil.ADDR_SUDO_ROOT,
+			RateLimit: evm.FunTokenRateLimit{BankDenom: "nomapping", MaxNetToEvm: sdkmath.ZeroInt(), MaxNetToBank: sdkmath.ZeroInt()},
+		},
+	)
+	s.Require().ErrorContains(err, "does not exist")
+
+	s.T().Log("happy: sudo root sets the rate limit")
+	_, err = deps.EvmKeeper.SetFunTokenRateLimit(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgSetFunTokenRateLimit{Sender: testutil.ADDR_SUDO_ROOT, RateLimit: rateLimit},
+	)
+	s.Require().NoError(err)
+	testutil.RequireContainsTypedEvent(s.T(), deps.Ctx, &evm.EventFunTokenRateLimitUpdated{
+		Sender:    testutil.ADDR_SUDO_ROOT,
+		RateLimit: rateLimit,
+	})
+	s.Require().Equal(rateLimit.String(), queryRateLimit().RateLimit.String())
+
+	s.T().Log("Conversions to the EVM are limited to a net 500 per window")
+	s.Require().NoError(convertToEvm(300))
+	s.Require().ErrorIs(convertToEvm(300), evm.ErrFunTokenRateLimit)
+	s.Require().NoError(convertToEvm(200))
+	s.Require().Equal("500", queryRateLimit().Flow.NetToEvm.String())
+
+	s.T().Log("The net flow resets once the window ends")
+	deps.Ctx = deps.Ctx.WithBlockHeight(deps.Ctx.BlockHeight() + int64(rateLimit.WindowBlocks))
+	s.Require().Equal("0", queryRateLimit().Flow.NetToEvm.String())
+
+	s.T().Log("Conversions back to bank coins are limited to a net 200 per window")
+	s.Require().NoError(convertToBank(150))
+	s.Require().ErrorIs(convertToBank(100), evm.ErrFunTokenRateLimit)
+	s.Require().NoError(convertToBank(50))
+	s.Require().Equal("-200", queryRateLimit().Flow.NetToEvm.String())
+
+	s.T().Log("Conversions that bring the net flow closer to zero are allowed")
+	s.Require().NoError(convertToEvm(10))
+	s.Require().Equal("-190", queryRateLimit().Flow.NetToEvm.String())
+
+	s.T().Log("happy: pause conversions of the mapping")
+	_, err = deps.EvmKeeper.SetFunTokenPaused(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgSetFunTokenPaused{Sender: testutil.ADDR_SUDO_ROOT, BankDenom: bankDenom, Paused: true},
+	)
+	s.Require().NoError(err)
+	testutil.RequireContainsTypedEvent(s.T(), deps.Ctx, &evm.EventFunTokenPauseUpdated{
+		Sender:    testutil.ADDR_SUDO_ROOT,
+		BankDenom: bankDenom,
+		Paused:    true,
+	})
+	s.Require().True(queryRateLimit().Paused)
+	s.Require().ErrorIs(convertToEvm(1), evm.ErrFunTokenPaused)
+	s.Require().ErrorIs(convertToBank(1), evm.ErrFunTokenPaused)
+
+	s.T().Log("sad: the FunToken precompile respects the pause")
+	{
+		contractInput, err := embeds.SmartContract_FunToken.ABI.Pack(
+			string(precompile.FunTokenMethod_sendToEvm), bankDenom, big.NewInt(1), deps.Sender.EthAddr.Hex(),
+		)
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVM()
+		_, err = deps.EvmKeeper.CallContract(
+			deps.Ctx, evmObj, deps.Sender.EthAddr, &precompile.PrecompileAddr_FunToken,
+			contractInput, evmtest.FunTokenGasLimitSendToEvm, evm.COMMIT_ETH_TX, nil,
+		)
+		s.Require().ErrorContains(err, evm.ErrFunTokenPaused.Error())
+	}
+
+	s.T().Log("happy: resume conversions and remove the rate limit")
+	_, err = deps.EvmKeeper.SetFunTokenPaused(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgSetFunTokenPaused{Sender: testutil.ADDR_SUDO_ROOT, BankDenom: bankDenom, Paused: false},
+	)
+	s.Require().NoError(err)
+	_, err = deps.EvmKeeper.SetFunTokenRateLimit(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgSetFunTokenRateLimit{
+			Sender: testutil.ADDR_SUDO_ROOT,
+			RateLimit: evm.FunTokenRateLimit{
+				BankDenom: bankDenom, MaxNetToEvm: sdkmath.ZeroInt(), MaxNetToBank: sdkmath.ZeroInt(),
+			},
+		},
+	)
+	s.Require().NoError(err)
+	resp := queryRateLimit()
+	s.Require().False(resp.Paused)
+	s.Require().Nil(resp.RateLimit)
+	s.Require().NoError(convertToBank(300))
+}
@@ -17,6 +17,18 @@ import (
 // add utility functions.
 type FunTokenState struct {
 	collections.IndexedMap[[]byte, evm.FunToken, IndexesFunToken]
+
+	// RateLimits: Map from bank coin denomination -> rate limit on conversions
+	// of the FunToken mapping.
+	RateLimits collections.Map[string, evm.FunTokenRateLimit]
+
+	// Flows: Map from bank coin denomination -> net amount converted within the
+	// current rate limit window. Only written for rate limited mappings.
+	Flows collections.Map[string, evm.FunTokenFlow]
+
+	// Paused: Set of bank coin denominations for FunToken mappings whose
+	// conversions are paused.
+	Paused collections.KeySet[string]
 }
 
 func NewFunTokenState(
@@ -44,7 +56,23 @@ func NewFunTokenState(
 			),
 		},
 	)
-	return FunTokenState{IndexedMap: idxMap}
+	return FunTokenState{
+		IndexedMap: idxMap,
+		RateLimits: collections.NewMap(
+			storeKey, evm.KeyPrefixFunTokenRateLimits,
+			collections.StringKeyEncoder,
+			collections.ProtoValueEncoder[evm.FunTokenRateLimit](cdc),
+		),
+		Flows: collections.NewMap(
+			storeKey, evm.KeyPrefixFunTokenFlows,
+			collections.StringKeyEncoder,
+			collections.ProtoValueEncoder[evm.FunTokenFlow](cdc),
+		),
+		Paused: collections.NewKeySet(
+			storeKey, evm.KeyPrefixFunTokenPaused,
+			collections.StringKeyEncoder,
+		),
+	}
 }
 
 func (idxs IndexesFunToken) IndexerList() []collections.Indexer[[]byte, evm.FunToken] {
@@ -74,7 +102,13 @@ type IndexesFunToken struct {
 func (fun FunTokenState) SafeInsert(
 	ctx sdk.Context, erc20 gethcommon.Address, bankDenom string, isMadeFromCoin bool,
 ) error {
-	funtoken := evm.NewFunToken(erc20, bankDenom, isMadeFromCoin)
+	return fun.SafeInsertFunToken(ctx, evm.NewFunToken(erc20, bankDenom, isMadeFromCoin))
+}
+
+// SafeInsertFunToken adds an [evm.FunToken] to state with defensive validation.
+// Unlike [FunTokenState.SafeInsert], it preserves every field of the given
+// mapping, like the address of the CW20 contract a mapping originates from.
+func (fun FunTokenState) SafeInsertFunToken(ctx sdk.Context, funtoken evm.FunToken) error {
 	if err := funtoken.Validate(); err != nil {
 		return err
 	}
@@ -42,13 +42,17 @@ func (k *Keeper) InitGenesis(
 
 	// Create fungible token mappings
 	for _, funToken := range genState.FuntokenMappings {
-		err := k.FunTokens.SafeInsert(
-			ctx, gethcommon.HexToAddress(funToken.Erc20Addr.String()), funToken.BankDenom, funToken.IsMadeFromCoin,
-		)
+		err := k.FunTokens.SafeInsertFunToken(ctx, funToken)
 		if err != nil {
 			panic(fmt.Errorf("failed creating funtoken: %w", err))
 		}
 	}
+	for _, rateLimit := range genState.FuntokenRateLimits {
+		k.FunTokens.RateLimits.Insert(ctx, rateLimit.BankDenom, rateLimit)
+	}
+	for _, bankDenom := range genState.PausedFuntokens {
+		k.FunTokens.Paused.Insert(ctx, bankDenom)
+	}
 
 	return []abci.ValidatorUpdate{}
 }
@@ -105,9 +109,15 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *evm.GenesisState {
 		funTokens = append(funTokens, iter.Value())
 	}
 
+	// 3. Export FunToken rate limits and paused FunToken mappings
+	funTokenRateLimits := k.FunTokens.RateLimits.Iterate(ctx, collections.Range[string]{}).Values()
+	pausedFunTokens := k.FunTokens.Paused.Iterate(ctx, collections.Range[string]{}).Keys()
+
 	return &evm.GenesisState{
-		Params:           k.GetParams(ctx),
-		Accounts:         genesisAccounts,
-		FuntokenMappings: funTokens,
+		Params:             k.GetParams(ctx),
+		Accounts:           genesisAccounts,
+		FuntokenMappings:   funTokens,
+		FuntokenRateLimits: funTokenRateLimits,
+		PausedFuntokens:    pausedFunTokens,
 	}
 }
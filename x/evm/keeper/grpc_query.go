@@ -15,10 +15,13 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/common"
 	"github.com/NibiruChain/nibiru/v2/x/common/set"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
@@ -878,5 +881,94 @@ func (k Keeper) FunTokenMapping(
 		}, nil
 	}
 
+	// finally, try lookup by CW20 contract address
+	cw20DenomIter := k.FunTokens.Indexes.BankDenom.ExactMatch(ctx, evm.Cw20BankDenom(req.Token))
+	funTokenMappings = k.FunTokens.Collect(ctx, cw20DenomIter)
+	if len(funTokenMappings) > 0 {
+		return &evm.QueryFunTokenMappingResponse{
+			FunToken: &funTokenMappings[0],
+		}, nil
+	}
+
 	return nil, grpcstatus.Errorf(grpccodes.NotFound, "token mapping not found for %s", req.Token)
 }
+
+// FunTokenMappings lists all FunToken mappings, ordered by their ID.
+func (k Keeper) FunTokenMappings(
+	goCtx context.Context, req *evm.QueryFunTokenMappingsRequest,
+) (*evm.QueryFunTokenMappingsResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "empty request")
+	}
+	pageReq, _, err := common.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), evm.KeyPrefixFunTokens.Prefix())
+
+	var funTokens []evm.FunToken
+	pageResp, err := sdkquery.Paginate(store, pageReq, func(_, value []byte) error {
+		var funToken evm.FunToken
+		if err := k.cdc.Unmarshal(value, &funToken); err != nil {
+			return err
+		}
+		funTokens = append(funTokens, funToken)
+		return nil
+	})
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+
+	return &evm.QueryFunTokenMappingsResponse{
+		FunTokens:  funTokens,
+		Pagination: pageResp,
+	}, nil
+}
+
+// FunTokenRateLimit queries the rate limit, the net flow in the current rate
+// limit window, and the pause status of a FunToken mapping. The token can be
+// given as a bank coin denomination, an ERC20 address, or a CW20 address.
+func (k Keeper) FunTokenRateLimit(
+	goCtx context.Context, req *evm.QueryFunTokenRateLimitRequest,
+) (*evm.QueryFunTokenRateLimitResponse, error) {
+	mappingResp, err := k.FunTokenMapping(goCtx, &evm.QueryFunTokenMappingRequest{Token: req.Token})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	funtoken := *mappingResp.FunToken
+	resp := &evm.QueryFunTokenRateLimitResponse{
+		FunToken: funtoken,
+		Flow:     evm.NewFunTokenFlow(funtoken.BankDenom, ctx.BlockHeight()),
+		Paused:   k.FunTokens.Paused.Has(ctx, funtoken.BankDenom),
+	}
+	if rateLimit, err := k.FunTokens.RateLimits.Get(ctx, funtoken.BankDenom); err == nil {
+		resp.RateLimit = &rateLimit
+		resp.Flow = k.CurrentFunTokenFlow(ctx, rateLimit)
+	}
+	return resp, nil
+}
+
+// DeployPermission returns which accounts can deploy contracts to the EVM and,
+// if an address is given, whether that account can.
+func (k Keeper) DeployPermission(
+	goCtx context.Context, req *evm.QueryDeployPermissionRequest,
+) (*evm.QueryDeployPermissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	resp := &evm.QueryDeployPermissionResponse{
+		Permission: params.DeployPermission,
+		Allowlist:  params.DeployAllowlistHex(),
+	}
+	if req.Address != "" {
+		addr, err := evm.ParseHexOrBech32Addr(req.Address)
+		if err != nil {
+			return nil, err
+		}
+		resp.CanDeploy = canDeploy(params, addr)
+	}
+	return resp, nil
+}
@@ -9,6 +9,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethparams "github.com/ethereum/go-ethereum/params"
@@ -1081,3 +1082,46 @@ func (s *Suite) TestQueryFunTokenMapping() {
 		})
 	}
 }
+
+func (s *Suite) TestQueryFunTokenMappings() {
+	deps := evmtest.NewTestDeps()
+	goCtx := sdk.WrapSDKContext(deps.Ctx)
+
+	resp, err := deps.EvmKeeper.FunTokenMappings(goCtx, &evm.QueryFunTokenMappingsRequest{})
+	s.Require().NoError(err)
+	s.Empty(resp.FunTokens)
+
+	var wantFunTokens []evm.FunToken
+	for _, denom := range []string{"unibi", "uusdc", "uatom"} {
+		funToken := evm.NewFunToken(evmtest.NewEthPrivAcc().EthAddr, denom, true)
+		s.Require().NoError(deps.EvmKeeper.FunTokens.SafeInsertFunToken(deps.Ctx, funToken))
+		wantFunTokens = append(wantFunTokens, funToken)
+	}
+
+	s.T().Log("all mappings fit in one page by default")
+	resp, err = deps.EvmKeeper.FunTokenMappings(goCtx, &evm.QueryFunTokenMappingsRequest{})
+	s.Require().NoError(err)
+	s.ElementsMatch(wantFunTokens, resp.FunTokens)
+
+	s.T().Log("pages continue from the next key")
+	resp, err = deps.EvmKeeper.FunTokenMappings(goCtx, &evm.QueryFunTokenMappingsRequest{
+		Pagination: &sdkquery.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Len(resp.FunTokens, 2)
+	s.EqualValues(3, resp.Pagination.Total)
+	gotFunTokens := resp.FunTokens
+
+	resp, err = deps.EvmKeeper.FunTokenMappings(goCtx, &evm.QueryFunTokenMappingsRequest{
+		Pagination: &sdkquery.PageRequest{Key: resp.Pagination.NextKey, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Len(resp.FunTokens, 1)
+	s.Nil(resp.Pagination.NextKey)
+	s.ElementsMatch(wantFunTokens, append(gotFunTokens, resp.FunTokens...))
+
+	_, err = deps.EvmKeeper.FunTokenMappings(goCtx, &evm.QueryFunTokenMappingsRequest{
+		Pagination: &sdkquery.PageRequest{Key: []byte{1}, Offset: 1},
+	})
+	s.ErrorContains(err, "either offset or key")
+}
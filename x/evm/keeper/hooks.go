@@ -8,6 +8,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethcoretypes "github.com/ethereum/go-ethereum/core/types"
 )
@@ -22,6 +23,7 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Valida
 	_ = ctx.EventManager().EmitTypedEvent(&evm.EventBlockBloom{
 		Bloom: eth.BloomToHex(bloom),
 	})
+	telemetry.SetGauge(float32(k.EvmState.BlockGasUsed.GetOr(ctx, 0)), evm.MetricKeyBlockGasUsed...)
 	// The bloom logic doesn't update the validator set.
 	return []abci.ValidatorUpdate{}
 }
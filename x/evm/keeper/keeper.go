@@ -46,6 +46,7 @@ type Keeper struct {
 	accountKeeper evm.AccountKeeper
 	stakingKeeper evm.StakingKeeper
 	sudoKeeper    evm.SudoKeeper
+	wasmKeeper    evm.WasmKeeper
 
 	// tracer: Configures the output type for a geth `vm.EVMLogger`. Tracer types
 	// include "access_list", "json", "struct", and "markdown". If any other
@@ -62,6 +63,7 @@ func NewKeeper(
 	accKeeper evm.AccountKeeper,
 	bankKeeper *NibiruBankKeeper,
 	stakingKeeper evm.StakingKeeper,
+	sudoKeeper evm.SudoKeeper,
 	tracer string,
 ) Keeper {
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -78,10 +80,18 @@ func NewKeeper(
 		accountKeeper: accKeeper,
 		Bank:          bankKeeper,
 		stakingKeeper: stakingKeeper,
+		sudoKeeper:    sudoKeeper,
 		tracer:        tracer,
 	}
 }
 
+// SetWasmKeeper sets the Wasm keeper used for FunToken mappings that originate
+// from CW20 contracts. The Wasm keeper is constructed after the EVM keeper
+// during app initialization, so it can't be passed to [NewKeeper].
+func (k *Keeper) SetWasmKeeper(wasmKeeper evm.WasmKeeper) {
+	k.wasmKeeper = wasmKeeper
+}
+
 // GetEvmGasBalance: Used in the EVM Ante Handler,
 // "github.com/NibiruChain/nibiru/v2/app/evmante": Load account's balance of gas
 // tokens for EVM execution in EVM denom units.
@@ -6,6 +6,7 @@ import (
 	"math/big"
 
 	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -165,6 +166,7 @@ func (k *Keeper) convertCoinToEvmForWNIBI(
 		Erc20ContractAddress: erc20.Hex(),
 		ToEthAddr:            msg.ToEthAddr.Hex(),
 		BankCoin:             msg.BankCoin,
+		Erc20Amount:          depositWei.String(),
 	})
 
 	return &evm.MsgConvertCoinToEvmResponse{}, nil
@@ -186,9 +188,11 @@ func (k Keeper) convertCoinToEvmBornCoin(
 		return nil, sdkioerrors.Wrap(err, "failed to send coins to module account")
 	}
 
-	// 2 | Mint ERC20 tokens to the recipient
+	// 2 | Mint ERC20 tokens to the recipient, scaled by the decimals scale of
+	// the mapping.
 	erc20Addr := funTokenMapping.Erc20Addr.Address
-	contractInput, err := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI.Pack("mint", recipient, coin.Amount.BigInt())
+	erc20Amount := funTokenMapping.ToErc20Amount(coin.Amount.BigInt())
+	contractInput, err := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI.Pack("mint", recipient, erc20Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -247,6 +251,7 @@ func (k Keeper) convertCoinToEvmBornCoin(
 		Erc20ContractAddress: erc20Addr.String(),
 		ToEthAddr:            recipient.String(),
 		BankCoin:             coin,
+		Erc20Amount:          erc20Amount.String(),
 	})
 
 	// Emit tx logs of Mint event
@@ -328,7 +333,7 @@ func (k Keeper) convertCoinToEvmBornERC20(
 		SkipFromEOACheck: true,
 	}
 	evmObj := k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil /*tracer*/, stateDB)
-	_, evmResp, err := k.ERC20().Transfer(
+	balIncrease, evmResp, err := k.ERC20().Transfer(
 		erc20Addr,
 		evm.EVM_MODULE_ADDRESS,
 		recipient,
@@ -352,6 +357,7 @@ func (k Keeper) convertCoinToEvmBornERC20(
 		Erc20ContractAddress: funTokenMapping.Erc20Addr.String(),
 		ToEthAddr:            recipient.String(),
 		BankCoin:             coin,
+		Erc20Amount:          balIncrease.String(),
 	})
 
 	// Emit tx logs of Transfer event
@@ -362,3 +368,95 @@ func (k Keeper) convertCoinToEvmBornERC20(
 
 	return &evm.MsgConvertCoinToEvmResponse{}, nil
 }
+
+// Converts CW20 tokens for a FunToken mapping that was born from a CW20
+// contract into ERC20 tokens. The sender's CW20 tokens are transferred into
+// escrow in the EVM module account, and the EVM module mints the same amount of
+// the ERC20, which it owns, to the recipient.
+func (k Keeper) convertCoinToEvmBornCW20(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	recipient gethcommon.Address,
+	coin sdk.Coin,
+	funTokenMapping evm.FunToken,
+) (*evm.MsgConvertCoinToEvmResponse, error) {
+	// 1 | Escrow the CW20 tokens in the EVM module account
+	cw20 := sdk.MustAccAddressFromBech32(funTokenMapping.Cw20Addr)
+	escrowedAmount, err := k.CW20().Transfer(
+		ctx, cw20, sender, k.CW20().EscrowAddr(), coin.Amount.BigInt(),
+	)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to escrow CW20 tokens")
+	}
+	coin = sdk.NewCoin(coin.Denom, sdkmath.NewIntFromBigInt(escrowedAmount))
+
+	// 2 | Mint ERC20 tokens to the recipient
+	erc20Addr := funTokenMapping.Erc20Addr.Address
+	contractInput, err := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI.Pack("mint", recipient, escrowedAmount)
+	if err != nil {
+		return nil, err
+	}
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               &erc20Addr,
+		From:             evm.EVM_MODULE_ADDRESS,
+		Nonce:            k.GetAccNonce(ctx, evm.EVM_MODULE_ADDRESS),
+		Value:            unusedBigInt, // amount
+		GasLimit:         evm.Erc20GasLimitExecute,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		Data:             contractInput,
+		AccessList:       gethcore.AccessList{},
+		BlobGasFeeCap:    &big.Int{},
+		BlobHashes:       []gethcommon.Hash{},
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+	stateDB := k.Bank.StateDB
+	if stateDB == nil {
+		stateDB = k.NewStateDB(ctx, k.TxConfig(ctx, gethcommon.Hash{}))
+	}
+	defer func() {
+		k.Bank.StateDB = nil
+	}()
+
+	evmObj := k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil /*tracer*/, stateDB)
+	evmResp, err := k.CallContract(
+		ctx,
+		evmObj,
+		evm.EVM_MODULE_ADDRESS,
+		&erc20Addr,
+		contractInput,
+		evm.Erc20GasLimitExecute,
+		evm.COMMIT_ETH_TX, /*commit*/
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if evmResp.Failed() {
+		return nil,
+			fmt.Errorf("failed to mint erc-20 tokens of contract %s", erc20Addr.String())
+	}
+
+	if err = stateDB.Commit(); err != nil {
+		return nil, sdkioerrors.Wrap(err, evm.ErrStateDBCommit)
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventConvertCoinToEvm{
+		Sender:               sender.String(),
+		Erc20ContractAddress: erc20Addr.String(),
+		ToEthAddr:            recipient.String(),
+		BankCoin:             coin,
+		Erc20Amount:          escrowedAmount.String(),
+	})
+
+	// Emit tx logs of Mint event
+	err = ctx.EventManager().EmitTypedEvent(&evm.EventTxLog{Logs: evmResp.Logs})
+	if err == nil {
+		k.updateBlockBloom(ctx, evmResp, uint64(k.EvmState.BlockTxIndex.GetOr(ctx, 0)))
+	}
+
+	return &evm.MsgConvertCoinToEvmResponse{}, nil
+}
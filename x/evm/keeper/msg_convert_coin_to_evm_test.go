@@ -53,6 +53,7 @@ func (s *SuiteFunToken) TestConvertCoinToEvmAndBack() {
 			Erc20ContractAddress: funToken.Erc20Addr.String(),
 			ToEthAddr:            alice.EthAddr.String(),
 			BankCoin:             sdk.NewCoin(funToken.BankDenom, sdk.NewInt(10)),
+			Erc20Amount:          "10",
 		},
 	)
 
@@ -755,6 +756,7 @@ func (s *SuiteFunToken) TestConvertCoinToEvmForWNIBI() {
 				Erc20ContractAddress: erc20Addr.Hex(),
 				ToEthAddr:            someoneElse.EthAddr.Hex(),
 				BankCoin:             unibi(big.NewInt(69)),
+				Erc20Amount:          evm.NativeToWei(big.NewInt(69)).String(),
 			},
 		)
 
@@ -25,18 +25,30 @@ import (
 // "eth.evm.v1.MsgConvertEvmToCoin" tx. This function handles conversion of ERC20
 // tokens that were originally bank coins back into coin form. The EVM module
 // owns the ERC20 contract and will burn the tokens
+//
+// If the mapping has a decimals scale, only the part of "amount" worth whole
+// bank base units is converted. The rest, the dust, moves from the sender into
+// the escrow of the EVM module, where the bank coins that back it stay.
 func (k Keeper) convertEvmToCoinForCoinOriginated(
 	ctx sdk.Context,
 	sender evm.Addrs,
 	toAddress sdk.AccAddress,
 	erc20Addr gethcommon.Address,
 	amount *big.Int,
-	bankDenom string,
+	funtoken evm.FunToken,
 	stateDB *statedb.StateDB,
 ) error {
-	bankCoins := sdk.NewCoins(sdk.NewCoin(bankDenom, sdkmath.NewIntFromBigInt(amount)))
+	bankAmount, dust := funtoken.ToBankAmount(amount)
+	if bankAmount.Sign() != 1 {
+		return fmt.Errorf(
+			"ERC20 amount %s is less than one base unit of bank coin \"%s\" (%s ERC20 tokens)",
+			amount, funtoken.BankDenom, funtoken.DecimalsScaleFactor(),
+		)
+	}
+	bankCoins := sdk.NewCoins(sdk.NewCoin(funtoken.BankDenom, sdkmath.NewIntFromBigInt(bankAmount)))
 
-	// 1 | Burn the ERC20 tokens from the sender's account
+	// 1 | Burn the ERC20 tokens from the sender's account. The dust is burned
+	// too and minted to the EVM module, which escrows it.
 	contractInput, err := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI.Pack(
 		"burnFromAuthority",
 		sender.Eth /*from: address where we burn the token balance from*/, amount,
@@ -64,6 +76,14 @@ func (k Keeper) convertEvmToCoinForCoinOriginated(
 	}
 
 	evmObj := k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil /*tracer*/, stateDB)
+	if dust.Sign() == 1 {
+		_, err := k.ERC20().Mint(
+			erc20Addr, evm.EVM_MODULE_ADDRESS, evm.EVM_MODULE_ADDRESS, dust, ctx, evmObj,
+		)
+		if err != nil {
+			return sdkioerrors.Wrap(err, "failed to escrow the ERC20 dust")
+		}
+	}
 	evmResp, err := k.CallContract(
 		ctx,
 		evmObj,
@@ -94,6 +114,92 @@ func (k Keeper) convertEvmToCoinForCoinOriginated(
 		ToAddress:            toAddress.String(),
 		BankCoin:             bankCoins[0],
 		SenderEthAddr:        sender.Eth.Hex(),
+		Erc20Amount:          amount.String(),
+	})
+
+	// Emit tx logs of the Burn event and the Mint event of the dust
+	err = ctx.EventManager().EmitTypedEvent(&evm.EventTxLog{Logs: evmResp.Logs})
+	if err == nil {
+		k.updateBlockBloom(ctx, evmResp, uint64(k.EvmState.BlockTxIndex.GetOr(ctx, 0)))
+	}
+
+	return nil
+}
+
+// convertEvmToCoinForCW20Originated is part of the
+// "eth.evm.v1.MsgConvertEvmToCoin" tx. This function handles conversion of
+// ERC20 tokens that were originally CW20 tokens back into CW20 form. The EVM
+// module owns the ERC20 contract and burns the tokens, then releases the same
+// amount of CW20 tokens from escrow to the recipient.
+func (k Keeper) convertEvmToCoinForCW20Originated(
+	ctx sdk.Context,
+	sender evm.Addrs,
+	toAddress sdk.AccAddress,
+	erc20Addr gethcommon.Address,
+	amount *big.Int,
+	funtoken evm.FunToken,
+	stateDB *statedb.StateDB,
+) error {
+	// 1 | Burn the ERC20 tokens from the sender's account
+	contractInput, err := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI.Pack(
+		"burnFromAuthority",
+		sender.Eth /*from: address where we burn the token balance from*/, amount,
+	)
+	if err != nil {
+		return err
+	}
+
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               &erc20Addr,
+		From:             evm.EVM_MODULE_ADDRESS,
+		Nonce:            k.GetAccNonce(ctx, evm.EVM_MODULE_ADDRESS),
+		Value:            unusedBigInt,
+		GasLimit:         evm.Erc20GasLimitExecute,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		Data:             contractInput,
+		AccessList:       gethcore.AccessList{},
+		BlobGasFeeCap:    &big.Int{},
+		BlobHashes:       []gethcommon.Hash{},
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+
+	evmObj := k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil /*tracer*/, stateDB)
+	evmResp, err := k.CallContract(
+		ctx,
+		evmObj,
+		evm.EVM_MODULE_ADDRESS,
+		&erc20Addr,
+		contractInput,
+		evm.Erc20GasLimitExecute,
+		evm.COMMIT_ETH_TX, /*commit*/
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	if evmResp.Failed() {
+		return fmt.Errorf("failed to burn ERC20 tokens: %s", evmResp.VmError)
+	}
+
+	// 2 | Release CW20 tokens from escrow to the recipient
+	cw20 := sdk.MustAccAddressFromBech32(funtoken.Cw20Addr)
+	released, err := k.CW20().Transfer(ctx, cw20, k.CW20().EscrowAddr(), toAddress, amount)
+	if err != nil {
+		return sdkioerrors.Wrap(err, "failed to release CW20 tokens from escrow")
+	}
+
+	// Emit event
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventConvertEvmToCoin{
+		Sender:               sender.Bech32.String(),
+		Erc20ContractAddress: erc20Addr.Hex(),
+		ToAddress:            toAddress.String(),
+		BankCoin:             sdk.NewCoin(funtoken.BankDenom, sdkmath.NewIntFromBigInt(released)),
+		SenderEthAddr:        sender.Eth.Hex(),
+		Erc20Amount:          amount.String(),
 	})
 
 	// Emit tx logs of Burn event
@@ -181,6 +287,7 @@ func (k Keeper) convertEvmToCoinForERC20Originated(
 		ToAddress:            toAddress.String(),
 		BankCoin:             bankCoin,
 		SenderEthAddr:        sender.Eth.Hex(),
+		Erc20Amount:          amount.String(),
 	})
 
 	// Emit tx logs of Transfer event
@@ -307,6 +414,7 @@ func (k Keeper) ConvertEvmToCoinForWNIBI(
 		ToAddress:            toAddrBech32.String(),
 		BankCoin:             withdrawnMicronibi,
 		SenderEthAddr:        sender.Eth.Hex(),
+		Erc20Amount:          withdrawWei.String(),
 	})
 
 	return withdrawWei, nil
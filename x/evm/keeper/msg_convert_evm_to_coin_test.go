@@ -376,6 +376,7 @@ func (s *SuiteFunToken) TestConvertEvmToCoin_Events() {
 		ToAddress:            toAddr.String(),
 		BankCoin:             sdk.NewCoin(bankDenom, convertAmount),
 		SenderEthAddr:        deps.Sender.EthAddr.Hex(),
+		Erc20Amount:          convertAmount.String(),
 	})
 
 	// Check EventTxLog was emitted
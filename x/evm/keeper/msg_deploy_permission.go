@@ -0,0 +1,41 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// SetDeployPermission sets which accounts can deploy contracts to the EVM and
+// edits the deploy allowlist. Only callable by governance or the sudoers of
+// the x/sudo module.
+func (k *Keeper) SetDeployPermission(
+	goCtx context.Context, msg *evm.MsgSetDeployPermission,
+) (resp *evm.MsgSetDeployPermissionResponse, err error) {
+	add, remove, err := msg.Validate()
+	if err != nil {
+		return resp, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkSudoOrGovPermissions(ctx, msg.Sender); err != nil {
+		return resp, err
+	}
+
+	params := k.GetParams(ctx)
+	params.DeployPermission = msg.Permission
+	params.EditDeployAllowlist(add, remove)
+	if err := k.SetParams(ctx, params); err != nil {
+		return resp, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventDeployPermissionUpdated{
+		Sender:     msg.Sender,
+		Permission: params.DeployPermission,
+		Allowlist:  params.DeployAllowlistHex(),
+	})
+	return &evm.MsgSetDeployPermissionResponse{}, nil
+}
@@ -225,6 +225,7 @@ func (s *Suite) TestMsgEthereumTx_SimpleTransfer() {
 		gasUsed := strconv.FormatUint(resp.GasUsed, 10)
 		wantGasUsed := strconv.FormatUint(gethparams.TxGas, 10)
 		s.Equal(gasUsed, wantGasUsed)
+		s.EqualValues(gethparams.TxGas, deps.App.EvmKeeper.EvmState.BlockGasUsed.GetOr(deps.Ctx, 0))
 
 		// Event "EventTransfer" must present
 		testutil.RequireContainsTypedEvent(
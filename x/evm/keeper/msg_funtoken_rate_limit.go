@@ -0,0 +1,87 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// SetFunTokenRateLimit sets or removes the rate limit on conversions of a
+// FunToken mapping. Only callable by governance or the sudoers of the x/sudo
+// module.
+func (k *Keeper) SetFunTokenRateLimit(
+	goCtx context.Context, msg *evm.MsgSetFunTokenRateLimit,
+) (resp *evm.MsgSetFunTokenRateLimitResponse, err error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return resp, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkSudoOrGovPermissions(ctx, msg.Sender); err != nil {
+		return resp, err
+	}
+
+	rateLimit := msg.RateLimit
+	if err := k.assertFunTokenForBankDenom(ctx, rateLimit.BankDenom); err != nil {
+		return resp, err
+	}
+
+	if rateLimit.IsRemoval() {
+		_ = k.FunTokens.RateLimits.Delete(ctx, rateLimit.BankDenom)
+		_ = k.FunTokens.Flows.Delete(ctx, rateLimit.BankDenom)
+	} else {
+		k.FunTokens.RateLimits.Insert(ctx, rateLimit.BankDenom, rateLimit)
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventFunTokenRateLimitUpdated{
+		Sender:    msg.Sender,
+		RateLimit: rateLimit,
+		Removed:   rateLimit.IsRemoval(),
+	})
+	return &evm.MsgSetFunTokenRateLimitResponse{}, nil
+}
+
+// SetFunTokenPaused pauses or resumes all conversions of a FunToken mapping.
+// Only callable by governance or the sudoers of the x/sudo module.
+func (k *Keeper) SetFunTokenPaused(
+	goCtx context.Context, msg *evm.MsgSetFunTokenPaused,
+) (resp *evm.MsgSetFunTokenPausedResponse, err error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return resp, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkSudoOrGovPermissions(ctx, msg.Sender); err != nil {
+		return resp, err
+	}
+	if err := k.assertFunTokenForBankDenom(ctx, msg.BankDenom); err != nil {
+		return resp, err
+	}
+
+	if msg.Paused {
+		k.FunTokens.Paused.Insert(ctx, msg.BankDenom)
+	} else {
+		k.FunTokens.Paused.Delete(ctx, msg.BankDenom)
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventFunTokenPauseUpdated{
+		Sender:    msg.Sender,
+		BankDenom: msg.BankDenom,
+		Paused:    msg.Paused,
+	})
+	return &evm.MsgSetFunTokenPausedResponse{}, nil
+}
+
+// assertFunTokenForBankDenom returns an error if there's no FunToken mapping
+// for the given bank coin denomination.
+func (k *Keeper) assertFunTokenForBankDenom(ctx sdk.Context, bankDenom string) error {
+	funTokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.BankDenom.ExactMatch(ctx, bankDenom))
+	if len(funTokens) == 0 {
+		return fmt.Errorf("funtoken for bank denom \"%s\" does not exist", bankDenom)
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 
 	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmttypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -94,6 +95,8 @@ func (k *Keeper) EthereumTx(
 		if gasErr != nil {
 			return nil, gasErr
 		}
+		k.EvmState.BlockGasUsed.Set(ctx, k.EvmState.BlockGasUsed.GetOr(ctx, 0)+evmResp.GasUsed)
+		evm.EmitTxGasUsedMetric(evmResp.GasUsed, evmResp.Failed())
 	}
 
 	k.updateBlockBloom(ctx, evmResp, uint64(txConfig.LogIndex))
@@ -305,6 +308,20 @@ func (k *Keeper) ApplyEvmMsg(
 		evmStateDB   = evmObj.StateDB.(*statedb.StateDB) // retains doc comments
 	)
 
+	// Enforce the deploy permission of the EVM. Contract creation txs are
+	// rejected up front, while deployments with CREATE or CREATE2 from a
+	// contract are recorded by a [deployGuard] and reverted after execution.
+	guard, err := k.newDeployGuard(ctx, msg.From, contractCreation)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(err, "ApplyEvmMsg")
+	}
+	if guard != nil {
+		evmObj.Config.Tracer = guard.wrapTracer(tracer)
+		defer func() {
+			evmObj.Config.Tracer = tracer
+		}()
+	}
+
 	// Required: Allow the tracer to capture tx level events pertaining to gas consumption.
 	if tracer != nil {
 		// Formerly: evmObj.Config.Tracer.CaptureTxStart in geth v1.10
@@ -396,7 +413,8 @@ func (k *Keeper) ApplyEvmMsg(
 	var (
 		returnBz []byte
 		// vmErr: VM errors do not affect consensus and therefore are not assigned to "err"
-		vmErr error
+		vmErr    error
+		snapshot = evmStateDB.Snapshot()
 	)
 	if contractCreation {
 		returnBz, _, gasRemaining, vmErr = evmObj.Create(
@@ -414,6 +432,12 @@ func (k *Keeper) ApplyEvmMsg(
 			msgWei,
 		)
 	}
+	if guard != nil && guard.violation != nil {
+		// A contract deployed another contract without permission, so the
+		// execution fails as if it had reverted.
+		evmStateDB.RevertToSnapshot(snapshot)
+		returnBz, vmErr = nil, guard.violation
+	}
 	// Increment nonce after processing the message
 	evmStateDB.SetNonce(msg.From, msg.Nonce+1)
 
@@ -524,22 +548,29 @@ func (k *Keeper) CreateFunToken(
 	var funtoken *evm.FunToken
 	emptyErc20 := msg.FromErc20 == nil || msg.FromErc20.Size() == 0
 	switch {
-	case !emptyErc20 && msg.FromBankDenom == "":
+	case !emptyErc20 && msg.FromBankDenom == "" && msg.FromCw20 == "":
 		funtoken, err = k.createFunTokenFromERC20(
 			ctx,
 			msg.FromErc20.Address,
 			msg.AllowZeroDecimals,
 		)
-	case emptyErc20 && msg.FromBankDenom != "":
+	case emptyErc20 && msg.FromBankDenom != "" && msg.FromCw20 == "":
 		funtoken, err = k.createFunTokenFromCoin(
 			ctx,
 			msg.FromBankDenom,
 			msg.AllowZeroDecimals,
+			msg.DecimalsScale,
+		)
+	case emptyErc20 && msg.FromBankDenom == "" && msg.FromCw20 != "":
+		funtoken, err = k.createFunTokenFromCW20(
+			ctx,
+			sdk.MustAccAddressFromBech32(msg.FromCw20), // validation in msg.ValidateBasic
+			msg.AllowZeroDecimals,
 		)
 	default:
 		// Impossible to reach this case due to ValidateBasic
 		err = fmt.Errorf(
-			"either the \"from_erc20\" or \"from_bank_denom\" must be set (but not both)")
+			"exactly one of \"from_erc20\", \"from_bank_denom\", or \"from_cw20\" must be set")
 	}
 	if err != nil {
 		return nil, err
@@ -549,7 +580,9 @@ func (k *Keeper) CreateFunToken(
 		Creator:              msg.Sender,
 		BankDenom:            funtoken.BankDenom,
 		Erc20ContractAddress: funtoken.Erc20Addr.String(),
-		IsMadeFromCoin:       emptyErc20,
+		IsMadeFromCoin:       funtoken.IsMadeFromCoin,
+		Cw20Addr:             funtoken.Cw20Addr,
+		DecimalsScale:        funtoken.DecimalsScale,
 	})
 
 	return &evm.MsgCreateFunTokenResponse{
@@ -616,16 +649,31 @@ func (k *Keeper) ConvertCoinToEvm(
 	}
 
 	fungibleTokenMapping := funTokens[0]
+	if err = k.RecordFunTokenFlow(ctx, fungibleTokenMapping.BankDenom, msg.BankCoin.Amount); err != nil {
+		return nil, err
+	}
 
-	if fungibleTokenMapping.IsMadeFromCoin {
-		return k.convertCoinToEvmBornCoin(
+	if fungibleTokenMapping.IsMadeFromCw20() {
+		resp, err = k.convertCoinToEvmBornCW20(
+			ctx, senderBech32, msg.ToEthAddr.Address, msg.BankCoin, fungibleTokenMapping,
+		)
+	} else if fungibleTokenMapping.IsMadeFromCoin {
+		resp, err = k.convertCoinToEvmBornCoin(
 			ctx, senderBech32, msg.ToEthAddr.Address, msg.BankCoin, fungibleTokenMapping,
 		)
 	} else {
-		return k.convertCoinToEvmBornERC20(
+		resp, err = k.convertCoinToEvmBornERC20(
 			ctx, senderBech32, msg.ToEthAddr.Address, msg.BankCoin, fungibleTokenMapping,
 		)
 	}
+	if err != nil {
+		return nil, err
+	}
+	// Simulations don't count toward the conversion metrics.
+	if !ctx.IsCheckTx() {
+		evm.EmitFunTokenConvertedMetric(fungibleTokenMapping.BankDenom, msg.BankCoin.Amount)
+	}
+	return resp, nil
 }
 
 // ConvertEvmToCoin Sends an ERC20 token with a valid "FunToken" mapping to the
@@ -664,10 +712,29 @@ func (k *Keeper) ConvertEvmToCoin(
 		}
 
 		funtokenMapping := funTokens[0]
+		// Rate limits are denominated in bank coin units.
+		bankAmount, _ := funtokenMapping.ToBankAmount(amount.BigInt())
+		if err = k.RecordFunTokenFlow(
+			ctx, funtokenMapping.BankDenom, sdkmath.NewIntFromBigInt(bankAmount).Neg(),
+		); err != nil {
+			return
+		}
+		// Simulations don't count toward the conversion metrics.
+		if !ctx.IsCheckTx() {
+			stateDB.OnCommit(func() {
+				evm.EmitFunTokenConvertedMetric(
+					funtokenMapping.BankDenom, sdkmath.NewIntFromBigInt(bankAmount).Neg(),
+				)
+			})
+		}
 		amountBig := amount.BigInt()
-		if funtokenMapping.IsMadeFromCoin {
+		if funtokenMapping.IsMadeFromCw20() {
+			err = k.convertEvmToCoinForCW20Originated(
+				ctx, senderAddrs, toAddrs.Bech32, erc20.Address, amountBig, funtokenMapping, stateDB,
+			)
+		} else if funtokenMapping.IsMadeFromCoin {
 			err = k.convertEvmToCoinForCoinOriginated(
-				ctx, senderAddrs, toAddrs.Bech32, erc20.Address, amountBig, funtokenMapping.BankDenom, stateDB,
+				ctx, senderAddrs, toAddrs.Bech32, erc20.Address, amountBig, funtokenMapping, stateDB,
 			)
 		} else {
 			err = k.convertEvmToCoinForERC20Originated(
@@ -18,16 +18,9 @@ func (k *Keeper) UpdateParams(
 		return resp, err
 	}
 
-	sender := sdk.MustAccAddressFromBech32(req.Authority)
 	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	sudoPermsErr := k.sudoKeeper.CheckPermissions(sender, ctx)
-	havePerms := (sudoPermsErr == nil) || (k.authority.String() == req.Authority)
-	if !havePerms {
-		return resp, fmt.Errorf(
-			"invalid signing authority, expected governance account %s or one of the sudoers defined by the x/sudo module. Sender was %s",
-			k.authority, req.Authority,
-		)
+	if err := k.checkSudoOrGovPermissions(ctx, req.Authority); err != nil {
+		return resp, err
 	}
 
 	err = k.SetParams(ctx, req.Params)
@@ -36,3 +29,19 @@ func (k *Keeper) UpdateParams(
 	}
 	return &evm.MsgUpdateParamsResponse{}, nil
 }
+
+// checkSudoOrGovPermissions returns an error unless the "sender" is the
+// governance account or one of the sudoers defined by the x/sudo module.
+func (k *Keeper) checkSudoOrGovPermissions(ctx sdk.Context, sender string) error {
+	if k.authority.String() == sender {
+		return nil
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err == nil && k.sudoKeeper != nil && k.sudoKeeper.CheckPermissions(senderAddr, ctx) == nil {
+		return nil
+	}
+	return fmt.Errorf(
+		"invalid signing authority, expected governance account %s or one of the sudoers defined by the x/sudo module. Sender was %s",
+		k.authority, sender,
+	)
+}
@@ -36,6 +36,8 @@ var (
 	_ sdk.Msg    = &MsgCreateFunToken{}
 	_ sdk.Msg    = &MsgConvertCoinToEvm{}
 	_ sdk.Msg    = &MsgConvertEvmToCoin{}
+	_ sdk.Msg    = &MsgSetFunTokenRateLimit{}
+	_ sdk.Msg    = &MsgSetFunTokenPaused{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -482,15 +484,29 @@ func (m *MsgCreateFunToken) ValidateBasic() error {
 		return fmt.Errorf("invalid sender addr")
 	}
 
-	emptyBankDenom := m.FromBankDenom == ""
-	emptyErc20 := m.FromErc20 == nil || m.FromErc20.Size() == 0
+	numSet := 0
+	if m.FromBankDenom != "" {
+		numSet++
+	}
+	if m.FromErc20 != nil && m.FromErc20.Size() != 0 {
+		numSet++
+	}
+	if m.FromCw20 != "" {
+		numSet++
+		if _, err := sdk.AccAddressFromBech32(m.FromCw20); err != nil {
+			return fmt.Errorf("invalid \"from_cw20\" addr: %w", err)
+		}
+	}
 
-	if emptyErc20 && emptyBankDenom {
-		return fmt.Errorf("either the \"from_erc20\" or \"from_bank_denom\" must be set")
+	if numSet != 1 {
+		return fmt.Errorf("exactly one of \"from_erc20\", \"from_bank_denom\", or \"from_cw20\" must be set")
 	}
 
-	if !emptyErc20 && !emptyBankDenom {
-		return fmt.Errorf("either the \"from_erc20\" or \"from_bank_denom\" must be set (but not both)")
+	if m.DecimalsScale > 0 && m.FromBankDenom == "" {
+		return fmt.Errorf("\"decimals_scale\" can only be set with \"from_bank_denom\"")
+	}
+	if m.DecimalsScale > MaxErc20Decimals {
+		return fmt.Errorf("\"decimals_scale\" must be at most %d, got %d", MaxErc20Decimals, m.DecimalsScale)
 	}
 
 	return nil
@@ -593,3 +609,90 @@ func (m *MsgConvertEvmToCoin) ValidateBasic() error {
 func (m MsgConvertEvmToCoin) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgSetFunTokenRateLimit message.
+func (m MsgSetFunTokenRateLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetFunTokenRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("error in MsgSetFunTokenRateLimit: invalid sender addr: %w", err)
+	}
+	if err := m.RateLimit.Validate(); err != nil {
+		return fmt.Errorf("error in MsgSetFunTokenRateLimit: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetFunTokenRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetFunTokenPaused message.
+func (m MsgSetFunTokenPaused) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetFunTokenPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("error in MsgSetFunTokenPaused: invalid sender addr: %w", err)
+	}
+	if err := sdk.ValidateDenom(m.BankDenom); err != nil {
+		return fmt.Errorf("error in MsgSetFunTokenPaused: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetFunTokenPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetDeployPermission message.
+func (m MsgSetDeployPermission) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetDeployPermission) ValidateBasic() error {
+	_, _, err := m.Validate()
+	return err
+}
+
+// Validate does a sanity check of the provided data and returns the parsed
+// addresses to add to and remove from the deploy allowlist.
+func (m *MsgSetDeployPermission) Validate() (add, remove []gethcommon.Address, err error) {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return nil, nil, fmt.Errorf("error in MsgSetDeployPermission: invalid sender addr: %w", err)
+	}
+	if _, ok := DeployPermission_name[int32(m.Permission)]; !ok {
+		return nil, nil, fmt.Errorf("error in MsgSetDeployPermission: invalid deploy permission %d", m.Permission)
+	}
+	for _, addrStr := range m.AddToAllowlist {
+		addr, err := ParseHexOrBech32Addr(addrStr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error in MsgSetDeployPermission: %w", err)
+		}
+		add = append(add, addr)
+	}
+	for _, addrStr := range m.RemoveFromAllowlist {
+		addr, err := ParseHexOrBech32Addr(addrStr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error in MsgSetDeployPermission: %w", err)
+		}
+		remove = append(remove, addr)
+	}
+	return add, remove, nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetDeployPermission) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
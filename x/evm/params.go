@@ -36,6 +36,8 @@ func DefaultParams() Params {
 		CanonicalWnibi: eth.EIP55Addr{
 			Address: gethcommon.HexToAddress("0x0CaCF669f8446BeCA826913a3c6B96aCD4b02a97"),
 		},
+		DeployPermission: DeployPermissionEverybody,
+		DeployAllowlist:  []eth.EIP55Addr{},
 	}
 }
 
@@ -74,6 +76,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateDeployPermission(p.DeployPermission, p.DeployAllowlist); err != nil {
+		return fmt.Errorf("ParamsError: %w", err)
+	}
+
 	return nil
 }
 
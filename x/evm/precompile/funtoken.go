@@ -65,6 +65,7 @@ func (p precompileFunToken) Run(
 ) (bz []byte, err error) {
 	defer func() {
 		err = ErrPrecompileRun(err, p)
+		emitPrecompileCallMetric(p, contract.Input, err)
 	}()
 	startResult, err := OnRunStart(evmObj, contract.Input, p.ABI(), contract.Gas)
 	if err != nil {
@@ -155,12 +156,13 @@ func (p precompileFunToken) sendToBank(
 		return nil, err
 	}
 
-	// gotAmount is the actual number of tokens credited to the recipient after
-	// the ERC20 transfer. This may differ from the requested `amount` if the
-	// ERC20 contract charges a fee or reduces the transfer value.
+	// gotAmount is the actual number of ERC20 tokens the EVM module received
+	// from the ERC20 transfer. This may differ from the requested `amount` if
+	// the ERC20 contract charges a fee or reduces the transfer value.
 	//
-	// The Solidity ABI for `sendToBank` expects this return value to be encoded
-	// as a single *big.Int packed into bytes.
+	// The Solidity ABI for `sendToBank` expects the amount of bank coins
+	// credited to the recipient to be returned as a single *big.Int packed into
+	// bytes. It's "gotAmount" unless the mapping has a decimals scale.
 	var gotAmount *big.Int
 
 	erc20, amount, to, err := p.parseArgsSendToBank(args)
@@ -188,6 +190,20 @@ func (p precompileFunToken) sendToBank(
 	if amount == nil || amount.Cmp(big.NewInt(0)) != 1 {
 		return nil, fmt.Errorf("transfer amount must be positive")
 	}
+	// If the mapping has a decimals scale, only the part of "amount" worth whole
+	// bank base units is sent. The dust stays in the escrow of the EVM module.
+	bankAmount, _ := funtoken.ToBankAmount(amount)
+	if bankAmount.Sign() != 1 {
+		return nil, fmt.Errorf(
+			"ERC20 amount %s is less than one base unit of bank coin \"%s\" (%s ERC20 tokens)",
+			amount, funtoken.BankDenom, funtoken.DecimalsScaleFactor(),
+		)
+	}
+	if err := p.evmKeeper.RecordFunTokenFlow(
+		ctx, funtoken.BankDenom, sdkmath.NewIntFromBigInt(bankAmount).Neg(),
+	); err != nil {
+		return nil, err
+	}
 
 	// Caller transfers ERC20 to the EVM module account
 	gotAmount, _, err = p.evmKeeper.ERC20().Transfer(
@@ -205,14 +221,40 @@ func (p precompileFunToken) sendToBank(
 		)
 	}
 
+	if funtoken.IsMadeFromCw20() {
+		// The EVM account owns the ERC20 contract of a CW20-originated FunToken
+		// mapping, so the tokens are burned and the CW20 tokens held in escrow
+		// are released to the recipient.
+		_, err := p.evmKeeper.ERC20().Burn(erc20, evm.EVM_MODULE_ADDRESS, gotAmount, ctx, evmObj)
+		if err != nil {
+			return nil, fmt.Errorf("ERC20.Burn: %w", err)
+		}
+		_, err = p.evmKeeper.CW20().Transfer(
+			ctx,
+			sdk.MustAccAddressFromBech32(funtoken.Cw20Addr),
+			p.evmKeeper.CW20().EscrowAddr(),
+			eth.EthAddrToNibiruAddr(toAddr),
+			gotAmount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to release CW20 tokens from escrow: contract caller %s: %w",
+				caller.Hex(), err,
+			)
+		}
+		emitFunTokenConvertedMetricOnCommit(startResult, funtoken.BankDenom, sdkmath.NewIntFromBigInt(bankAmount).Neg())
+		return method.Outputs.Pack(gotAmount)
+	}
+
 	// EVM account mints FunToken.BankDenom to module account
-	coinToSend := sdk.NewCoin(funtoken.BankDenom, sdkmath.NewIntFromBigInt(gotAmount))
+	gotBankAmount, gotDust := funtoken.ToBankAmount(gotAmount)
+	coinToSend := sdk.NewCoin(funtoken.BankDenom, sdkmath.NewIntFromBigInt(gotBankAmount))
 	if funtoken.IsMadeFromCoin {
 		// If the FunToken mapping was created from a bank coin, then the EVM account
 		// owns the ERC20 contract and was the original minter of the ERC20 tokens.
 		// Since we're sending them away and want accurate total supply tracking, the
-		// tokens need to be burned.
-		_, err := p.evmKeeper.ERC20().Burn(erc20, evm.EVM_MODULE_ADDRESS, gotAmount, ctx, evmObj)
+		// tokens need to be burned. The dust isn't sent, so it isn't burned.
+		burnAmount := new(big.Int).Sub(gotAmount, gotDust)
+		_, err := p.evmKeeper.ERC20().Burn(erc20, evm.EVM_MODULE_ADDRESS, burnAmount, ctx, evmObj)
 		if err != nil {
 			return nil, fmt.Errorf("ERC20.Burn: %w", err)
 		}
@@ -249,7 +291,23 @@ func (p precompileFunToken) sendToBank(
 		)
 	}
 
-	return method.Outputs.Pack(gotAmount)
+	emitFunTokenConvertedMetricOnCommit(startResult, funtoken.BankDenom, sdkmath.NewIntFromBigInt(bankAmount).Neg())
+	return method.Outputs.Pack(coinToSend.Amount.BigInt())
+}
+
+// emitFunTokenConvertedMetricOnCommit records a conversion of a FunToken
+// mapping for telemetry once the EVM tx that made it is committed. Reverted
+// conversions and simulations, like "eth_call", "eth_estimateGas" and Cosmos tx
+// simulations, aren't counted.
+func emitFunTokenConvertedMetricOnCommit(
+	startResult OnRunStartResult, bankDenom string, netToEvm sdkmath.Int,
+) {
+	if startResult.CacheCtx.IsCheckTx() {
+		return
+	}
+	startResult.StateDB.OnCommit(func() {
+		evm.EmitFunTokenConvertedMetric(bankDenom, netToEvm)
+	})
 }
 
 func (p precompileFunToken) parseArgsSendToBank(args []any) (
@@ -304,6 +362,9 @@ func (p precompileFunToken) parseArgsSendToBank(args []any) (
 //	        NibiruAccount memory whoAddrs
 //	    );
 //	```
+//
+// Both balances are in ERC20 base units: the bank balance is scaled by the
+// decimals scale of the mapping, like the amounts of the conversion methods.
 func (p precompileFunToken) balance(
 	start OnRunStartResult,
 	contract *vm.Contract,
@@ -329,7 +390,21 @@ func (p precompileFunToken) balance(
 	if err != nil {
 		return
 	}
-	bankBal := p.evmKeeper.Bank.GetBalance(ctx, addrBech32, funtoken.BankDenom).Amount.BigInt()
+	var bankBal *big.Int
+	if funtoken.IsMadeFromCw20() {
+		// For CW20-originated mappings, the CW20 balance takes the place of the
+		// bank balance.
+		bankBal, err = p.evmKeeper.CW20().BalanceOf(
+			ctx, sdk.MustAccAddressFromBech32(funtoken.Cw20Addr), addrBech32,
+		)
+		if err != nil {
+			return
+		}
+	} else {
+		bankBal = funtoken.ToErc20Amount(
+			p.evmKeeper.Bank.GetBalance(ctx, addrBech32, funtoken.BankDenom).Amount.BigInt(),
+		)
+	}
 
 	return method.Outputs.Pack([]any{
 		erc20Bal,
@@ -404,6 +479,9 @@ func (p precompileFunToken) parseArgsBalance(args []any, ctx sdk.Context) (
 //	    string calldata bankDenom
 //	) external returns (uint256 bankBalance, NibiruAccount memory whoAddrs);
 //	```
+//
+// If "bankDenom" has a FunToken mapping, the balance is scaled by its decimals
+// scale to ERC20 base units, like the "bankBalance" of "IFunToken.balance".
 func (p precompileFunToken) bankBalance(
 	start OnRunStartResult,
 	contract *vm.Contract,
@@ -424,6 +502,12 @@ func (p precompileFunToken) bankBalance(
 		return
 	}
 	bankBal := p.evmKeeper.Bank.GetBalance(ctx, addrBech32, bankDenom).Amount.BigInt()
+	funtokens := p.evmKeeper.FunTokens.Collect(
+		ctx, p.evmKeeper.FunTokens.Indexes.BankDenom.ExactMatch(ctx, bankDenom),
+	)
+	if len(funtokens) > 0 {
+		bankBal = funtokens[0].ToErc20Amount(bankBal)
+	}
 
 	return method.Outputs.Pack([]any{
 		bankBal,
@@ -580,6 +664,11 @@ func (p precompileFunToken) sendToEvm(
 	if amount == nil || amount.Sign() != 1 {
 		return nil, fmt.Errorf("transfer amount must be positive")
 	}
+	if err := p.evmKeeper.RecordFunTokenFlow(
+		ctx, funtoken.BankDenom, sdkmath.NewIntFromBigInt(amount),
+	); err != nil {
+		return nil, err
+	}
 
 	// parse the `to` argument as hex or bech32 address
 	toEthAddr, err := parseToAddr(toStr)
@@ -591,10 +680,23 @@ func (p precompileFunToken) sendToEvm(
 	coinToSend := sdk.NewCoin(funtoken.BankDenom, sdkmath.NewIntFromBigInt(amount))
 	callerBech32 := eth.EthAddrToNibiruAddr(caller)
 
-	// bank send from account => module
-	if err := p.evmKeeper.Bank.SendCoinsFromAccountToModule(
+	if funtoken.IsMadeFromCw20() {
+		// CW20 transfer from caller => EVM module escrow
+		escrowed, err := p.evmKeeper.CW20().Transfer(
+			ctx,
+			sdk.MustAccAddressFromBech32(funtoken.Cw20Addr),
+			callerBech32,
+			p.evmKeeper.CW20().EscrowAddr(),
+			amount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to escrow CW20 tokens: %w", err)
+		}
+		coinToSend = sdk.NewCoin(funtoken.BankDenom, sdkmath.NewIntFromBigInt(escrowed))
+	} else if err := p.evmKeeper.Bank.SendCoinsFromAccountToModule(
 		ctx, callerBech32, evm.ModuleName, sdk.NewCoins(coinToSend),
 	); err != nil {
+		// bank send from account => module
 		return nil, fmt.Errorf("failed to send coins to module: %w", err)
 	}
 
@@ -612,7 +714,7 @@ func (p precompileFunToken) sendToEvm(
 		return nil, err
 	}
 
-	if !funtoken.IsMadeFromCoin {
+	if !funtoken.IsMadeFromCoin && !funtoken.IsMadeFromCw20() {
 		// If the tokens is from an ERC20, we need to burn the cosmos coin
 		// and unescrow the ERC20 tokens to the recipient.
 		err := p.evmKeeper.Bank.BurnCoins(ctx, evm.ModuleName, sdk.NewCoins(coinToSend))
@@ -621,6 +723,8 @@ func (p precompileFunToken) sendToEvm(
 		}
 	}
 
+	emitFunTokenConvertedMetricOnCommit(startResult, funtoken.BankDenom, sdkmath.NewIntFromBigInt(amount))
+
 	// return the number of tokens minted
 	return method.Outputs.Pack(actualAmt)
 }
@@ -633,9 +737,13 @@ func (p precompileFunToken) mintOrUnescrowERC20(
 	funtoken evm.FunToken,
 	evmObj *vm.EVM,
 ) (*big.Int, error) {
-	// If funtoken is "IsMadeFromCoin", we own the ERC20 contract, so we can mint.
-	// If not, we do a transfer from EVM module to 'to' address using escrowed tokens.
-	if funtoken.IsMadeFromCoin {
+	// If funtoken is "IsMadeFromCoin" or made from a CW20, we own the ERC20
+	// contract, so we can mint. If not, we do a transfer from EVM module to 'to'
+	// address using escrowed tokens.
+	if funtoken.IsMadeFromCoin || funtoken.IsMadeFromCw20() {
+		// "amount" is in bank coin units, so it's scaled by the decimals scale
+		// of the mapping.
+		amount = funtoken.ToErc20Amount(amount)
 		_, err := p.evmKeeper.ERC20().Mint(
 			erc20Addr,              /*erc20Contract*/
 			evm.EVM_MODULE_ADDRESS, /*from*/
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/stretchr/testify/require"
@@ -832,6 +833,106 @@ func (out FunTokenBankBalanceReturn) ParseFromResp(
 	return out.BankBal, out.NibiruAcc.EthAddr, out.NibiruAcc.Bech32Addr, nil
 }
 
+// TestBalance_DecimalsScale: The "balance" and "bankBalance" methods return the
+// bank balance of a FunToken mapping with a decimals scale in ERC20 base
+// units, so it's comparable to the ERC20 balance.
+func (s *FuntokenSuite) TestBalance_DecimalsScale() {
+	deps := evmtest.NewTestDeps()
+	bankDenom := "scaledcoin"
+	deps.App.BankKeeper.SetDenomMetaData(deps.Ctx, bank.Metadata{
+		DenomUnits: []*bank.DenomUnit{
+			{Denom: bankDenom, Exponent: 0},
+			{Denom: "SCALED", Exponent: 6},
+		},
+		Base:    bankDenom,
+		Display: "SCALED",
+		Name:    "Scaled Coin",
+		Symbol:  "SCALED",
+	})
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx).Add(sdk.NewInt64Coin(bankDenom, 100)),
+	))
+	createResp, err := deps.EvmKeeper.CreateFunToken(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgCreateFunToken{
+			FromBankDenom: bankDenom,
+			Sender:        deps.Sender.NibiruAddr.String(),
+			DecimalsScale: 12,
+		},
+	)
+	s.Require().NoError(err)
+	funtoken := createResp.FuntokenMapping
+
+	s.T().Log("Convert 40 bank base units to 40 * 10^12 ERC20 base units")
+	_, err = deps.EvmKeeper.ConvertCoinToEvm(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertCoinToEvm{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			BankCoin:  sdk.NewInt64Coin(bankDenom, 40),
+			ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+		},
+	)
+	s.Require().NoError(err)
+
+	s.Run("IFunToken.balance()", func() {
+		contractInput, err := embeds.SmartContract_FunToken.ABI.Pack(
+			string(precompile.FunTokenMethod_balance), deps.Sender.EthAddr, funtoken.Erc20Addr.Address,
+		)
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVM()
+		evmResp, err := deps.EvmKeeper.CallContract(
+			deps.Ctx, evmObj, deps.Sender.EthAddr, &precompile.PrecompileAddr_FunToken,
+			contractInput, keeper.Erc20GasLimitQuery, evm.COMMIT_READONLY, nil,
+		)
+		s.Require().NoError(err, evmResp)
+
+		bals, err := new(FunTokenBalanceReturn).ParseFromResp(evmResp)
+		s.Require().NoError(err)
+		s.Require().Equal("40000000000000", bals.BalanceERC20.String(), "ERC20 balance")
+		s.Require().Equal("60000000000000", bals.BalanceBank.String(), "bank balance scaled by 10^12")
+	})
+
+	s.Run("IFunToken.bankBalance()", func() {
+		contractInput, err := embeds.SmartContract_FunToken.ABI.Pack(
+			string(precompile.FunTokenMethod_bankBalance), deps.Sender.EthAddr, bankDenom,
+		)
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVM()
+		evmResp, err := deps.EvmKeeper.CallContract(
+			deps.Ctx, evmObj, deps.Sender.EthAddr, &precompile.PrecompileAddr_FunToken,
+			contractInput, keeper.Erc20GasLimitQuery, evm.COMMIT_READONLY, nil,
+		)
+		s.Require().NoError(err, evmResp)
+
+		bal, _, _, err := new(FunTokenBankBalanceReturn).ParseFromResp(evmResp)
+		s.Require().NoError(err)
+		s.Require().Equal("60000000000000", bal.String(), "bank balance scaled by 10^12")
+	})
+
+	s.Run("IFunToken.bankBalance() of a coin without a FunToken mapping", func() {
+		unmappedDenom := "unmapped"
+		s.Require().NoError(testapp.FundAccount(
+			deps.App.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+			sdk.NewCoins(sdk.NewInt64Coin(unmappedDenom, 25)),
+		))
+		contractInput, err := embeds.SmartContract_FunToken.ABI.Pack(
+			string(precompile.FunTokenMethod_bankBalance), deps.Sender.EthAddr, unmappedDenom,
+		)
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVM()
+		evmResp, err := deps.EvmKeeper.CallContract(
+			deps.Ctx, evmObj, deps.Sender.EthAddr, &precompile.PrecompileAddr_FunToken,
+			contractInput, keeper.Erc20GasLimitQuery, evm.COMMIT_READONLY, nil,
+		)
+		s.Require().NoError(err, evmResp)
+
+		bal, _, _, err := new(FunTokenBankBalanceReturn).ParseFromResp(evmResp)
+		s.Require().NoError(err)
+		s.Require().Equal("25", bal.String())
+	})
+}
+
 func (s *FuntokenSuite) TestGetErc20Address() {
 	deps := evmtest.NewTestDeps()
 	bankDenom := "testdenom" // Example bank denom
@@ -52,6 +52,7 @@ func (p precompileOracle) Run(
 ) (bz []byte, err error) {
 	defer func() {
 		err = ErrPrecompileRun(err, p)
+		emitPrecompileCallMetric(p, contract.Input, err)
 	}()
 	startResult, err := OnRunStart(evm, contract.Input, p.ABI(), contract.Gas)
 	if err != nil {
@@ -16,6 +16,8 @@ package precompile
 import (
 	"bytes"
 	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/NibiruChain/collections"
 	store "github.com/cosmos/cosmos-sdk/store/types"
@@ -26,6 +28,7 @@ import (
 	gethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
 	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
 )
 
@@ -72,6 +75,21 @@ func InitPrecompiles(
 type NibiruCustomPrecompile interface {
 	vm.PrecompiledContract
 	Address() gethcommon.Address
+	ABI() *gethabi.ABI
+}
+
+// emitPrecompileCallMetric records a call to a custom precompile for
+// telemetry, labeled by the precompile and the called method. Calls whose
+// input doesn't select a method are labeled with the method "unknown".
+func emitPrecompileCallMetric(p NibiruCustomPrecompile, input []byte, err error) {
+	methodName := "unknown"
+	if len(input) >= 4 {
+		if method, methodErr := methodById(p.ABI(), input[:4]); methodErr == nil {
+			methodName = method.Name
+		}
+	}
+	precompileName := strings.TrimPrefix(reflect.TypeOf(p).Name(), "precompile")
+	evm.EmitPrecompileCallMetric(precompileName, methodName, err)
 }
 
 // methodById: Looks up an ABI method by the 4-byte id.
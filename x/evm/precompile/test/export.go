@@ -7,6 +7,7 @@ import (
 	"path"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasm "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -140,6 +141,53 @@ func deployWasmBytecode(
 	return codeIds
 }
 
+// DeployCW20 stores the cw20-base bytecode and has "deps.Sender" instantiate a
+// CW20 token with an initial balance of "initialBalance" for "deps.Sender".
+//
+// cw20_base.wasm is a compiled version of:
+// https://github.com/CosmWasm/cw-plus/tree/main/contracts/cw20-base
+func DeployCW20(
+	deps *evmtest.TestDeps, s *suite.Suite, decimals uint8, initialBalance sdkmath.Int,
+) (cw20 sdk.AccAddress) {
+	rootPathBz, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}").Output()
+	s.Require().NoError(err)
+	rootPath := strings.Trim(string(rootPathBz), "\n")
+	wasmBytecode, err := os.ReadFile(path.Join(rootPath, "x/evm/precompile/test/cw20_base.wasm"))
+	s.Require().NoError(err)
+
+	wasmPermissionedKeeper := wasmkeeper.NewDefaultPermissionKeeper(deps.App.WasmKeeper)
+	codeId, _, err := wasmPermissionedKeeper.Create(
+		deps.Ctx, deps.Sender.NibiruAddr, wasmBytecode, &wasm.AccessConfig{
+			Permission: wasm.AccessTypeEverybody,
+		},
+	)
+	s.Require().NoError(err)
+
+	instantiateMsg, err := json.Marshal(map[string]any{
+		"name":     "Test CW20 Token",
+		"symbol":   "TESTCW",
+		"decimals": decimals,
+		"initial_balances": []map[string]string{
+			{
+				"address": deps.Sender.NibiruAddr.String(),
+				"amount":  initialBalance.String(),
+			},
+		},
+	})
+	s.Require().NoError(err)
+	cw20, _, err = wasmPermissionedKeeper.Instantiate(
+		deps.Ctx,
+		codeId,
+		deps.Sender.NibiruAddr,
+		deps.Sender.NibiruAddr,
+		instantiateMsg,
+		"cw20-base",
+		sdk.Coins{},
+	)
+	s.Require().NoError(err)
+	return cw20
+}
+
 // From IWasm.query of Wasm.sol:
 //
 //	```solidity
@@ -46,6 +46,7 @@ func (p precompileWasm) Run(
 ) (bz []byte, err error) {
 	defer func() {
 		err = ErrPrecompileRun(err, p)
+		emitPrecompileCallMetric(p, contract.Input, err)
 	}()
 	startResult, err := OnRunStart(evmObj, contract.Input, p.ABI(), contract.Gas)
 	if err != nil {
@@ -1316,6 +1316,330 @@ func (m *QueryFunTokenMappingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryFunTokenMappingResponse proto.InternalMessageInfo
 
+type QueryFunTokenMappingsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFunTokenMappingsRequest) Reset()         { *m = QueryFunTokenMappingsRequest{} }
+func (m *QueryFunTokenMappingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenMappingsRequest) ProtoMessage()    {}
+func (*QueryFunTokenMappingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{24}
+}
+func (m *QueryFunTokenMappingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenMappingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenMappingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenMappingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenMappingsRequest.Merge(m, src)
+}
+func (m *QueryFunTokenMappingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenMappingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenMappingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenMappingsRequest proto.InternalMessageInfo
+
+func (m *QueryFunTokenMappingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFunTokenMappingsResponse struct {
+	FunTokens  []FunToken          `protobuf:"bytes,1,rep,name=fun_tokens,json=funTokens,proto3" json:"fun_tokens"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFunTokenMappingsResponse) Reset()         { *m = QueryFunTokenMappingsResponse{} }
+func (m *QueryFunTokenMappingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenMappingsResponse) ProtoMessage()    {}
+func (*QueryFunTokenMappingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{25}
+}
+func (m *QueryFunTokenMappingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenMappingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenMappingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenMappingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenMappingsResponse.Merge(m, src)
+}
+func (m *QueryFunTokenMappingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenMappingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenMappingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenMappingsResponse proto.InternalMessageInfo
+
+func (m *QueryFunTokenMappingsResponse) GetFunTokens() []FunToken {
+	if m != nil {
+		return m.FunTokens
+	}
+	return nil
+}
+
+func (m *QueryFunTokenMappingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFunTokenRateLimitRequest struct {
+	// Either the hexadecimal-encoded ERC20 contract address or denomination of the
+	// Bank Coin.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *QueryFunTokenRateLimitRequest) Reset()         { *m = QueryFunTokenRateLimitRequest{} }
+func (m *QueryFunTokenRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenRateLimitRequest) ProtoMessage()    {}
+func (*QueryFunTokenRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{26}
+}
+func (m *QueryFunTokenRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenRateLimitRequest.Merge(m, src)
+}
+func (m *QueryFunTokenRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryFunTokenRateLimitRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type QueryFunTokenRateLimitResponse struct {
+	// fun_token is the FunToken mapping for the queried token.
+	FunToken FunToken `protobuf:"bytes,1,opt,name=fun_token,json=funToken,proto3" json:"fun_token"`
+	// rate_limit is the rate limit of the FunToken mapping. Null if there's no
+	// rate limit.
+	RateLimit *FunTokenRateLimit `protobuf:"bytes,2,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// flow is the net flow within the current rate limit window.
+	Flow FunTokenFlow `protobuf:"bytes,3,opt,name=flow,proto3" json:"flow"`
+	// paused is true if conversions of the FunToken mapping are paused.
+	Paused bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *QueryFunTokenRateLimitResponse) Reset()         { *m = QueryFunTokenRateLimitResponse{} }
+func (m *QueryFunTokenRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenRateLimitResponse) ProtoMessage()    {}
+func (*QueryFunTokenRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{27}
+}
+func (m *QueryFunTokenRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenRateLimitResponse.Merge(m, src)
+}
+func (m *QueryFunTokenRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryFunTokenRateLimitResponse) GetFunToken() FunToken {
+	if m != nil {
+		return m.FunToken
+	}
+	return FunToken{}
+}
+
+func (m *QueryFunTokenRateLimitResponse) GetRateLimit() *FunTokenRateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return nil
+}
+
+func (m *QueryFunTokenRateLimitResponse) GetFlow() FunTokenFlow {
+	if m != nil {
+		return m.Flow
+	}
+	return FunTokenFlow{}
+}
+
+func (m *QueryFunTokenRateLimitResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+type QueryDeployPermissionRequest struct {
+	// Optional hexadecimal or Bech32 address of an account to check the deploy
+	// permission of.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryDeployPermissionRequest) Reset()         { *m = QueryDeployPermissionRequest{} }
+func (m *QueryDeployPermissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeployPermissionRequest) ProtoMessage()    {}
+func (*QueryDeployPermissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{28}
+}
+func (m *QueryDeployPermissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeployPermissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeployPermissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeployPermissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeployPermissionRequest.Merge(m, src)
+}
+func (m *QueryDeployPermissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeployPermissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeployPermissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeployPermissionRequest proto.InternalMessageInfo
+
+func (m *QueryDeployPermissionRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryDeployPermissionResponse struct {
+	Permission DeployPermission `protobuf:"varint,1,opt,name=permission,proto3,enum=eth.evm.v1.DeployPermission" json:"permission,omitempty"`
+	// Hexadecimal addresses of the deploy allowlist.
+	Allowlist []string `protobuf:"bytes,2,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// True if the account given by "address" can deploy contracts. False if no
+	// address is given.
+	CanDeploy bool `protobuf:"varint,3,opt,name=can_deploy,json=canDeploy,proto3" json:"can_deploy,omitempty"`
+}
+
+func (m *QueryDeployPermissionResponse) Reset()         { *m = QueryDeployPermissionResponse{} }
+func (m *QueryDeployPermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeployPermissionResponse) ProtoMessage()    {}
+func (*QueryDeployPermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{29}
+}
+func (m *QueryDeployPermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeployPermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeployPermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeployPermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeployPermissionResponse.Merge(m, src)
+}
+func (m *QueryDeployPermissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeployPermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeployPermissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeployPermissionResponse proto.InternalMessageInfo
+
+func (m *QueryDeployPermissionResponse) GetPermission() DeployPermission {
+	if m != nil {
+		return m.Permission
+	}
+	return DeployPermissionEverybody
+}
+
+func (m *QueryDeployPermissionResponse) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func (m *QueryDeployPermissionResponse) GetCanDeploy() bool {
+	if m != nil {
+		return m.CanDeploy
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryEthAccountRequest)(nil), "eth.evm.v1.QueryEthAccountRequest")
 	proto.RegisterType((*QueryEthAccountResponse)(nil), "eth.evm.v1.QueryEthAccountResponse")
@@ -1341,112 +1665,135 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "eth.evm.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryFunTokenMappingRequest)(nil), "eth.evm.v1.QueryFunTokenMappingRequest")
 	proto.RegisterType((*QueryFunTokenMappingResponse)(nil), "eth.evm.v1.QueryFunTokenMappingResponse")
+	proto.RegisterType((*QueryFunTokenMappingsRequest)(nil), "eth.evm.v1.QueryFunTokenMappingsRequest")
+	proto.RegisterType((*QueryFunTokenMappingsResponse)(nil), "eth.evm.v1.QueryFunTokenMappingsResponse")
+	proto.RegisterType((*QueryFunTokenRateLimitRequest)(nil), "eth.evm.v1.QueryFunTokenRateLimitRequest")
+	proto.RegisterType((*QueryFunTokenRateLimitResponse)(nil), "eth.evm.v1.QueryFunTokenRateLimitResponse")
+	proto.RegisterType((*QueryDeployPermissionRequest)(nil), "eth.evm.v1.QueryDeployPermissionRequest")
+	proto.RegisterType((*QueryDeployPermissionResponse)(nil), "eth.evm.v1.QueryDeployPermissionResponse")
 }
 
 func init() { proto.RegisterFile("eth/evm/v1/query.proto", fileDescriptor_ffa36cdc5add14ed) }
 
 var fileDescriptor_ffa36cdc5add14ed = []byte{
-	// 1592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0x5b, 0xc5,
-	0x16, 0x8f, 0x63, 0x27, 0x76, 0x8e, 0xd3, 0x24, 0x6f, 0x9a, 0x36, 0x89, 0x93, 0xd8, 0xc9, 0xcd,
-	0x7b, 0x49, 0xda, 0xd7, 0xde, 0xfb, 0xe2, 0x3e, 0xbd, 0xa7, 0x57, 0xbd, 0x0a, 0xea, 0x28, 0x0d,
-	0xa5, 0x7f, 0xd4, 0x9a, 0x00, 0x12, 0x08, 0x59, 0x63, 0x7b, 0x72, 0x7d, 0x15, 0xfb, 0x8e, 0xeb,
-	0x19, 0xbb, 0x0e, 0x25, 0x1b, 0xba, 0x41, 0x42, 0x95, 0x2a, 0xf1, 0x05, 0xba, 0xe2, 0x23, 0xc0,
-	0x57, 0xe8, 0x8e, 0x4a, 0x6c, 0x10, 0x8b, 0x82, 0x5a, 0x16, 0xac, 0x59, 0xb2, 0x42, 0xf3, 0xcf,
-	0xbe, 0xb6, 0xaf, 0x13, 0xaa, 0xc2, 0x8e, 0xd5, 0x9d, 0x39, 0x73, 0xfe, 0xfc, 0xe6, 0xcc, 0xb9,
-	0xe7, 0xfc, 0xe0, 0x2c, 0xe1, 0x15, 0x87, 0xb4, 0x6a, 0x4e, 0x6b, 0xcb, 0xb9, 0xd7, 0x24, 0x8d,
-	0x43, 0xbb, 0xde, 0xa0, 0x9c, 0x22, 0x20, 0xbc, 0x62, 0x93, 0x56, 0xcd, 0x6e, 0x6d, 0xa5, 0xce,
-	0x97, 0x28, 0xab, 0x51, 0xe6, 0x14, 0x31, 0x23, 0x4a, 0xc9, 0x69, 0x6d, 0x15, 0x09, 0xc7, 0x5b,
-	0x4e, 0x1d, 0xbb, 0x9e, 0x8f, 0xb9, 0x47, 0x7d, 0x65, 0x97, 0x9a, 0x0d, 0xf8, 0x13, 0xe6, 0x4a,
-	0x7a, 0x3a, 0x20, 0xe5, 0x6d, 0xa3, 0xea, 0x52, 0x97, 0xca, 0xa5, 0x23, 0x56, 0x5a, 0xba, 0xe4,
-	0x52, 0xea, 0x56, 0x89, 0x83, 0xeb, 0x9e, 0x83, 0x7d, 0x9f, 0x72, 0xe9, 0x9d, 0xe9, 0xd3, 0x8c,
-	0x3e, 0x95, 0xbb, 0x62, 0x73, 0xdf, 0xe1, 0x5e, 0x8d, 0x30, 0x8e, 0x6b, 0x75, 0xa5, 0x60, 0xfd,
-	0x1f, 0xce, 0xde, 0x15, 0x08, 0x77, 0x78, 0xe5, 0x6a, 0xa9, 0x44, 0x9b, 0x3e, 0xcf, 0x93, 0x7b,
-	0x4d, 0xc2, 0x38, 0x9a, 0x87, 0x38, 0x2e, 0x97, 0x1b, 0x84, 0xb1, 0xf9, 0xc8, 0x4a, 0x64, 0x73,
-	0x22, 0x6f, 0xb6, 0x97, 0x13, 0x9f, 0x3d, 0xc9, 0x8c, 0xfc, 0xfc, 0x24, 0x33, 0x62, 0x7d, 0x13,
-	0x81, 0xb9, 0x01, 0x73, 0x56, 0xa7, 0x3e, 0x23, 0xc2, 0xbe, 0x88, 0xab, 0xd8, 0x2f, 0x11, 0x63,
-	0xaf, 0xb7, 0x28, 0x03, 0x49, 0xbd, 0x2c, 0xdc, 0x27, 0xde, 0xfc, 0xa8, 0x3c, 0x05, 0x2d, 0x7a,
-	0x9f, 0x78, 0x68, 0x11, 0x26, 0x4a, 0xb4, 0x4c, 0x0a, 0x15, 0xcc, 0x2a, 0xf3, 0x51, 0x79, 0x9c,
-	0x10, 0x82, 0xb7, 0x30, 0xab, 0xa0, 0x59, 0x18, 0xf3, 0xa9, 0xf0, 0x1a, 0x5b, 0x89, 0x6c, 0xc6,
-	0xf2, 0x6a, 0x23, 0x7c, 0x12, 0x5e, 0x29, 0x18, 0xc4, 0x63, 0xca, 0x27, 0xe1, 0x95, 0xab, 0x4a,
-	0x82, 0xfe, 0x01, 0x53, 0x45, 0x52, 0xaa, 0x5c, 0xca, 0x76, 0x74, 0xc6, 0xa5, 0xce, 0x29, 0x25,
-	0xd5, 0x6a, 0xd6, 0x0d, 0x58, 0x92, 0x17, 0x7a, 0x0f, 0x57, 0xbd, 0x32, 0xe6, 0xb4, 0xd1, 0x97,
-	0x95, 0x55, 0x98, 0x2c, 0x51, 0x9f, 0x15, 0x7a, 0x53, 0x93, 0x14, 0xb2, 0xab, 0x03, 0xe9, 0xf9,
-	0x3c, 0x02, 0xcb, 0x43, 0xbc, 0xe9, 0x24, 0x6d, 0xc0, 0x34, 0x56, 0xa2, 0x3e, 0x8f, 0x53, 0x5a,
-	0x6c, 0xe0, 0xa7, 0x20, 0xc1, 0x04, 0x04, 0x71, 0xf1, 0x51, 0x79, 0xf1, 0xce, 0x5e, 0x5c, 0xcd,
-	0x38, 0xf1, 0x9b, 0xb5, 0x22, 0x69, 0xc8, 0x9c, 0xc5, 0xf2, 0xa7, 0xb4, 0xf4, 0xb6, 0x14, 0x5a,
-	0xff, 0x83, 0xd3, 0x12, 0x4c, 0x4e, 0x25, 0xfa, 0x55, 0xde, 0xf9, 0x2e, 0xcc, 0xf6, 0x9a, 0xbe,
-	0xf6, 0x1b, 0x5b, 0x37, 0x34, 0x9a, 0x77, 0x38, 0x6d, 0x60, 0xf7, 0x64, 0x34, 0x68, 0x06, 0xa2,
-	0x07, 0xe4, 0x50, 0x7b, 0x12, 0xcb, 0x00, 0xbe, 0x0b, 0x1a, 0x5f, 0xc7, 0x99, 0xc6, 0x37, 0x0b,
-	0x63, 0x2d, 0x5c, 0x6d, 0x1a, 0x74, 0x6a, 0x63, 0xfd, 0x07, 0x66, 0xa4, 0xf6, 0x36, 0x2d, 0xbf,
-	0x52, 0x16, 0x36, 0xe0, 0x6f, 0x01, 0x3b, 0x1d, 0x02, 0x41, 0x4c, 0x94, 0xa6, 0xb4, 0x9a, 0xcc,
-	0xcb, 0xb5, 0xf5, 0x31, 0x20, 0xa9, 0xb8, 0xd7, 0xbe, 0x49, 0x5d, 0x66, 0x42, 0x20, 0x88, 0xc9,
-	0x82, 0x56, 0xfe, 0xe5, 0x1a, 0x5d, 0x03, 0xe8, 0xb6, 0x04, 0x79, 0xb7, 0x64, 0x76, 0xdd, 0x56,
-	0xfd, 0xc3, 0x16, 0xfd, 0xc3, 0x56, 0x4d, 0x46, 0xf7, 0x0f, 0xfb, 0x4e, 0x37, 0x55, 0xf9, 0x80,
-	0x65, 0x00, 0xe4, 0xc3, 0x88, 0x4e, 0xac, 0x09, 0xae, 0x71, 0xae, 0x41, 0xac, 0x4a, 0x5d, 0x71,
-	0xbb, 0xe8, 0x66, 0x32, 0x3b, 0x6d, 0x77, 0xfb, 0x95, 0x7d, 0x93, 0xba, 0x79, 0x79, 0x88, 0x76,
-	0x43, 0xe0, 0x6c, 0x9c, 0x08, 0x47, 0x45, 0x08, 0xe2, 0xb1, 0x66, 0x75, 0x06, 0xee, 0xe0, 0x06,
-	0xae, 0x99, 0x0c, 0x58, 0xbb, 0x1a, 0x9a, 0x91, 0x6a, 0x68, 0xff, 0x82, 0xf1, 0xba, 0x94, 0xc8,
-	0xd4, 0x24, 0xb3, 0x28, 0x08, 0x4e, 0xe9, 0xe6, 0x62, 0x4f, 0x9f, 0x67, 0x46, 0xf2, 0x5a, 0xcf,
-	0xfa, 0x3a, 0x02, 0x53, 0x3b, 0xbc, 0xb2, 0x8d, 0xab, 0xd5, 0x40, 0x76, 0x71, 0xc3, 0x65, 0xe6,
-	0x1d, 0xc4, 0x1a, 0xcd, 0x41, 0xdc, 0xc5, 0xac, 0x50, 0xc2, 0x75, 0xfd, 0xcf, 0x8c, 0xbb, 0x98,
-	0x6d, 0xe3, 0x3a, 0xfa, 0x08, 0x66, 0xea, 0x0d, 0x5a, 0xa7, 0x8c, 0x34, 0x3a, 0xff, 0x9d, 0xf8,
-	0x67, 0x26, 0x73, 0xd9, 0x5f, 0x9f, 0x67, 0x6c, 0xd7, 0xe3, 0x95, 0x66, 0xd1, 0x2e, 0xd1, 0x9a,
-	0xa3, 0x5b, 0xb9, 0xfa, 0x5c, 0x64, 0xe5, 0x03, 0x87, 0x1f, 0xd6, 0x09, 0xb3, 0xb7, 0xbb, 0x3f,
-	0x7c, 0x7e, 0xda, 0xf8, 0x32, 0x3f, 0xeb, 0x02, 0x24, 0x4a, 0x15, 0xec, 0xf9, 0x05, 0xaf, 0x2c,
-	0xbb, 0x54, 0x34, 0x1f, 0x97, 0xfb, 0xeb, 0x65, 0x6b, 0x03, 0x4e, 0xef, 0x30, 0xee, 0xd5, 0x30,
-	0x27, 0xbb, 0xb8, 0x9b, 0x82, 0x19, 0x88, 0xba, 0x58, 0x81, 0x8f, 0xe5, 0xc5, 0xd2, 0xfa, 0x25,
-	0x6a, 0xde, 0xb1, 0x81, 0x4b, 0x64, 0xaf, 0x6d, 0xee, 0xf9, 0x4f, 0x88, 0xd6, 0x98, 0xab, 0x33,
-	0xb5, 0x10, 0xcc, 0xd4, 0x2d, 0xe6, 0xee, 0xf0, 0x0a, 0x69, 0x90, 0x66, 0x6d, 0xaf, 0x9d, 0x17,
-	0x5a, 0xe8, 0x32, 0x4c, 0x72, 0x61, 0x5e, 0x28, 0x51, 0x7f, 0xdf, 0x73, 0xe5, 0x1d, 0x93, 0xd9,
-	0xb9, 0xa0, 0x95, 0x74, 0xbf, 0x2d, 0x8f, 0xf3, 0x49, 0xde, 0xdd, 0xa0, 0x2b, 0x30, 0x59, 0x6f,
-	0x90, 0x32, 0x29, 0x11, 0xc6, 0x68, 0x83, 0xcd, 0xc7, 0x64, 0xe1, 0x1c, 0x13, 0xb1, 0x47, 0x5d,
-	0x34, 0xca, 0x62, 0x95, 0x96, 0x0e, 0x4c, 0x4b, 0x1a, 0x93, 0x79, 0x48, 0x4a, 0x99, 0x6a, 0x48,
-	0x68, 0x19, 0x40, 0xa9, 0xc8, 0xdf, 0x42, 0xb5, 0xe3, 0x09, 0x29, 0x91, 0x8d, 0x7e, 0xdb, 0x1c,
-	0x8b, 0x99, 0x35, 0x1f, 0x97, 0xd0, 0x53, 0xb6, 0x1a, 0x68, 0xb6, 0x19, 0x68, 0xf6, 0x9e, 0x19,
-	0x68, 0xb9, 0x84, 0x28, 0x91, 0xc7, 0x3f, 0x64, 0x22, 0xda, 0x89, 0x38, 0x09, 0x7d, 0xe9, 0xc4,
-	0x9f, 0xf3, 0xd2, 0x13, 0x3d, 0x2f, 0x8d, 0x2c, 0x38, 0xa5, 0xe0, 0xd7, 0x70, 0xbb, 0x20, 0x1e,
-	0x17, 0x02, 0x19, 0xb8, 0x85, 0xdb, 0xbb, 0x98, 0xbd, 0x1d, 0x4b, 0x8c, 0xce, 0x44, 0xf3, 0x09,
-	0xde, 0x2e, 0x78, 0x7e, 0x99, 0xb4, 0xad, 0xf3, 0xba, 0x8f, 0x75, 0xde, 0xbc, 0xdb, 0x64, 0xca,
-	0x98, 0x63, 0x53, 0xdc, 0x62, 0x6d, 0x7d, 0x19, 0xd5, 0xa3, 0x5b, 0x2a, 0xe7, 0x84, 0xd7, 0x40,
-	0x8d, 0xf0, 0xb6, 0xf9, 0xd5, 0x8f, 0xab, 0x11, 0xde, 0x66, 0xaf, 0x55, 0x23, 0x7f, 0x3d, 0xf2,
-	0xc9, 0x8f, 0x6c, 0x5d, 0xd4, 0x1c, 0x29, 0xf8, 0x4e, 0xc7, 0xbc, 0xeb, 0x99, 0xce, 0x98, 0x66,
-	0xe4, 0x1a, 0x31, 0xdd, 0xde, 0x7a, 0x14, 0xe9, 0xcc, 0x60, 0x2d, 0xd7, 0x3e, 0xfe, 0x0d, 0x09,
-	0xd1, 0x99, 0x0b, 0xfb, 0x44, 0x8f, 0xb9, 0xdc, 0xc2, 0xf7, 0xcf, 0x33, 0x67, 0xd4, 0x15, 0x59,
-	0xf9, 0xc0, 0xf6, 0xa8, 0x53, 0xc3, 0xbc, 0x62, 0x5f, 0xf7, 0xb9, 0x98, 0xcf, 0xd2, 0x1a, 0xbd,
-	0x01, 0x53, 0xc6, 0xaa, 0xd0, 0xf4, 0xbd, 0xa2, 0x1e, 0xd1, 0xc7, 0xd9, 0x4e, 0x6a, 0xdb, 0x77,
-	0x85, 0xba, 0x75, 0x05, 0x16, 0x25, 0x9c, 0x6b, 0x4d, 0x7f, 0x8f, 0x1e, 0x10, 0xff, 0x16, 0xae,
-	0xd7, 0x3d, 0xdf, 0x35, 0x25, 0x38, 0x0b, 0x63, 0x5c, 0x88, 0xcd, 0xe4, 0x95, 0x9b, 0xc0, 0x98,
-	0xfa, 0x50, 0xf3, 0xac, 0x01, 0x73, 0x7d, 0xab, 0x2d, 0x98, 0xd8, 0x6f, 0xfa, 0x85, 0xae, 0x8f,
-	0x64, 0x76, 0x36, 0x58, 0x92, 0xc6, 0x2e, 0x9f, 0xd8, 0xd7, 0xab, 0xae, 0xf3, 0xec, 0x57, 0x93,
-	0x30, 0x26, 0xbd, 0xa3, 0x87, 0x11, 0x80, 0x2e, 0x37, 0x45, 0x56, 0xd0, 0x45, 0x38, 0xef, 0x4d,
-	0xad, 0x1d, 0xab, 0xa3, 0xe0, 0x59, 0x17, 0x3e, 0xfd, 0xf6, 0xa7, 0x2f, 0x46, 0xd7, 0xd1, 0xdf,
-	0x1d, 0x91, 0x8c, 0x46, 0xb3, 0x43, 0xe1, 0x05, 0x07, 0x55, 0xba, 0xce, 0x03, 0x5d, 0x8a, 0x47,
-	0xe8, 0x49, 0x04, 0x66, 0xfa, 0x29, 0x20, 0xda, 0x1c, 0x88, 0x33, 0x84, 0x73, 0xa6, 0xce, 0xfd,
-	0x0e, 0x4d, 0x8d, 0xeb, 0xbf, 0x12, 0xd7, 0x16, 0x72, 0xfa, 0x70, 0xb5, 0x8c, 0x41, 0x17, 0x5d,
-	0x90, 0xc6, 0x1e, 0xa1, 0xfb, 0x10, 0xcf, 0x19, 0xea, 0x36, 0x10, 0xae, 0x97, 0x31, 0xa6, 0x56,
-	0x86, 0x2b, 0x68, 0x18, 0xe7, 0x24, 0x8c, 0x35, 0xb4, 0xda, 0x07, 0x43, 0xf3, 0x3f, 0x16, 0xc8,
-	0xcd, 0x27, 0x10, 0xd7, 0xac, 0x2d, 0x24, 0x70, 0x2f, 0x39, 0x0c, 0x09, 0xdc, 0x47, 0xf8, 0x2c,
-	0x5b, 0x06, 0xde, 0x44, 0xeb, 0x7d, 0x81, 0x99, 0xd2, 0xeb, 0xc6, 0x75, 0x1e, 0x1c, 0x90, 0xc3,
-	0x23, 0x74, 0x00, 0x31, 0xc1, 0xe6, 0xd0, 0xd2, 0x80, 0xe7, 0x00, 0x39, 0x4c, 0x2d, 0x0f, 0x39,
-	0xd5, 0x41, 0xd7, 0x65, 0xd0, 0x15, 0x94, 0xee, 0x0b, 0x2a, 0xb8, 0x60, 0xf0, 0xaa, 0x15, 0x18,
-	0x57, 0x6c, 0x06, 0xa5, 0x07, 0x1c, 0xf6, 0x10, 0xa5, 0x54, 0x66, 0xe8, 0xb9, 0x0e, 0xb9, 0x2c,
-	0x43, 0xce, 0xa1, 0x33, 0x7d, 0x21, 0x15, 0x3f, 0x42, 0x1e, 0xc4, 0x35, 0x3d, 0x42, 0xa9, 0xa0,
-	0xab, 0x5e, 0xce, 0x94, 0x5a, 0x1d, 0x3e, 0x1a, 0x4c, 0xa0, 0x8c, 0x0c, 0xb4, 0x80, 0xe6, 0x42,
-	0x0a, 0xbd, 0x24, 0xfc, 0x53, 0x48, 0x06, 0x08, 0xcd, 0xb1, 0xe1, 0x7a, 0x6e, 0x15, 0xc2, 0x82,
-	0xac, 0x35, 0x19, 0x6c, 0x19, 0x2d, 0xf6, 0x07, 0xd3, 0xba, 0xa2, 0xc3, 0xa2, 0x1a, 0xc4, 0xf5,
-	0x78, 0x0c, 0x29, 0x98, 0x5e, 0xb2, 0x14, 0x52, 0x30, 0x7d, 0x93, 0x75, 0xe8, 0xfd, 0xd4, 0x48,
-	0xe4, 0x6d, 0x74, 0x08, 0xd0, 0x6d, 0xdc, 0x21, 0x0d, 0x64, 0x60, 0xfa, 0x86, 0x34, 0x90, 0xc1,
-	0xce, 0x6f, 0x59, 0x32, 0xee, 0x12, 0x4a, 0x85, 0xc6, 0x95, 0xe3, 0x03, 0xdd, 0x83, 0x09, 0x35,
-	0x79, 0x45, 0x9e, 0xff, 0x80, 0xbb, 0xae, 0xca, 0x98, 0x8b, 0x68, 0x21, 0x34, 0xa6, 0x7c, 0xcd,
-	0x9a, 0x68, 0x03, 0x6a, 0x42, 0x84, 0xb5, 0x81, 0xe0, 0x44, 0x0a, 0x6d, 0x03, 0x3d, 0xa3, 0x69,
-	0x68, 0x72, 0xcd, 0xe4, 0x41, 0x8f, 0x22, 0x30, 0xdd, 0x37, 0x01, 0xd0, 0xc6, 0x80, 0xdb, 0xf0,
-	0x11, 0x93, 0xda, 0x3c, 0x59, 0x51, 0xe3, 0xd8, 0x90, 0x38, 0x56, 0x51, 0xa6, 0x0f, 0xc7, 0x7e,
-	0xd3, 0x97, 0x03, 0xc6, 0x79, 0x20, 0x3f, 0x47, 0xb9, 0x37, 0x9f, 0xbe, 0x48, 0x47, 0x9e, 0xbd,
-	0x48, 0x47, 0x7e, 0x7c, 0x91, 0x8e, 0x3c, 0x7e, 0x99, 0x1e, 0x79, 0xf6, 0x32, 0x3d, 0xf2, 0xdd,
-	0xcb, 0xf4, 0xc8, 0x07, 0xeb, 0x01, 0x12, 0x71, 0x5b, 0x3a, 0xd9, 0x16, 0x14, 0xc0, 0x38, 0x6c,
-	0x65, 0x9d, 0xb6, 0xf0, 0x5a, 0x1c, 0x97, 0x9c, 0xe5, 0xd2, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xe6, 0x31, 0x27, 0xb5, 0x28, 0x12, 0x00, 0x00,
+	// 1868 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x5f, 0x6f, 0x1b, 0x59,
+	0x15, 0xcf, 0xd4, 0x4e, 0x62, 0x1f, 0xa7, 0x49, 0xf6, 0xc6, 0x6d, 0x92, 0x69, 0x62, 0x3b, 0x13,
+	0x48, 0xd2, 0xd2, 0x9d, 0x21, 0x5e, 0x60, 0xd9, 0x55, 0x57, 0xd0, 0x84, 0xb6, 0x2c, 0xdb, 0xae,
+	0xba, 0x43, 0x00, 0x09, 0x84, 0xac, 0x6b, 0xfb, 0x66, 0x3c, 0x8a, 0x67, 0xc6, 0x9d, 0x7b, 0x9d,
+	0x38, 0x94, 0xbe, 0xb0, 0x2f, 0x48, 0x68, 0xa5, 0x45, 0xbc, 0xf1, 0x80, 0x2a, 0x21, 0xf1, 0x15,
+	0xf8, 0x0a, 0xfb, 0xc6, 0x4a, 0xbc, 0x20, 0x1e, 0x0a, 0x6a, 0x79, 0xe0, 0x99, 0x47, 0x5e, 0x40,
+	0xf7, 0x9f, 0x3d, 0xb6, 0xc7, 0x71, 0x97, 0x2d, 0x6f, 0xfb, 0x34, 0xf7, 0x9e, 0x7b, 0xfe, 0xfc,
+	0xce, 0x39, 0x77, 0xce, 0x3d, 0x07, 0xae, 0x12, 0xd6, 0x72, 0xc8, 0x69, 0xe0, 0x9c, 0xee, 0x3b,
+	0x8f, 0xba, 0x24, 0x3e, 0xb7, 0x3b, 0x71, 0xc4, 0x22, 0x04, 0x84, 0xb5, 0x6c, 0x72, 0x1a, 0xd8,
+	0xa7, 0xfb, 0xe6, 0x8d, 0x46, 0x44, 0x83, 0x88, 0x3a, 0x75, 0x4c, 0x89, 0x64, 0x72, 0x4e, 0xf7,
+	0xeb, 0x84, 0xe1, 0x7d, 0xa7, 0x83, 0x3d, 0x3f, 0xc4, 0xcc, 0x8f, 0x42, 0x29, 0x67, 0x16, 0x13,
+	0xfa, 0xb8, 0xb8, 0xa4, 0xae, 0x24, 0xa8, 0xac, 0xa7, 0x59, 0xbd, 0xc8, 0x8b, 0xc4, 0xd2, 0xe1,
+	0x2b, 0x45, 0xdd, 0xf0, 0xa2, 0xc8, 0x6b, 0x13, 0x07, 0x77, 0x7c, 0x07, 0x87, 0x61, 0xc4, 0x84,
+	0x76, 0xaa, 0x4e, 0xcb, 0xea, 0x54, 0xec, 0xea, 0xdd, 0x63, 0x87, 0xf9, 0x01, 0xa1, 0x0c, 0x07,
+	0x1d, 0xc9, 0x60, 0xdd, 0x82, 0xab, 0x1f, 0x70, 0x84, 0x77, 0x58, 0xeb, 0x76, 0xa3, 0x11, 0x75,
+	0x43, 0xe6, 0x92, 0x47, 0x5d, 0x42, 0x19, 0x5a, 0x83, 0x79, 0xdc, 0x6c, 0xc6, 0x84, 0xd2, 0x35,
+	0xa3, 0x62, 0xec, 0xe5, 0x5d, 0xbd, 0x7d, 0x3b, 0xf7, 0xcb, 0xa7, 0xe5, 0x99, 0x7f, 0x3e, 0x2d,
+	0xcf, 0x58, 0x7f, 0x32, 0x60, 0x75, 0x4c, 0x9c, 0x76, 0xa2, 0x90, 0x12, 0x2e, 0x5f, 0xc7, 0x6d,
+	0x1c, 0x36, 0x88, 0x96, 0x57, 0x5b, 0x54, 0x86, 0x82, 0x5a, 0xd6, 0xce, 0x88, 0xbf, 0x76, 0x49,
+	0x9c, 0x82, 0x22, 0xfd, 0x88, 0xf8, 0xe8, 0x1a, 0xe4, 0x1b, 0x51, 0x93, 0xd4, 0x5a, 0x98, 0xb6,
+	0xd6, 0x32, 0xe2, 0x38, 0xc7, 0x09, 0xdf, 0xc5, 0xb4, 0x85, 0x8a, 0x30, 0x1b, 0x46, 0x5c, 0x6b,
+	0xb6, 0x62, 0xec, 0x65, 0x5d, 0xb9, 0xe1, 0x3a, 0x09, 0x6b, 0xd5, 0x34, 0xe2, 0x59, 0xa9, 0x93,
+	0xb0, 0xd6, 0x6d, 0x49, 0x41, 0x5f, 0x86, 0xc5, 0x3a, 0x69, 0xb4, 0xde, 0xa8, 0xf6, 0x79, 0xe6,
+	0x04, 0xcf, 0x65, 0x49, 0x55, 0x6c, 0xd6, 0x7b, 0xb0, 0x21, 0x1c, 0xfa, 0x21, 0x6e, 0xfb, 0x4d,
+	0xcc, 0xa2, 0x78, 0x24, 0x2a, 0x5b, 0xb0, 0xd0, 0x88, 0x42, 0x5a, 0x1b, 0x0e, 0x4d, 0x81, 0xd3,
+	0x6e, 0x8f, 0x85, 0xe7, 0x57, 0x06, 0x6c, 0x4e, 0xd0, 0xa6, 0x82, 0xb4, 0x0b, 0x4b, 0x58, 0x92,
+	0x46, 0x34, 0x2e, 0x2a, 0xb2, 0x86, 0x6f, 0x42, 0x8e, 0x72, 0x08, 0xdc, 0xf1, 0x4b, 0xc2, 0xf1,
+	0xfe, 0x9e, 0xbb, 0xa6, 0x95, 0x84, 0xdd, 0xa0, 0x4e, 0x62, 0x11, 0xb3, 0xac, 0x7b, 0x59, 0x51,
+	0xdf, 0x17, 0x44, 0xeb, 0x2d, 0x58, 0x11, 0x60, 0x0e, 0x64, 0xa0, 0x3f, 0x4b, 0x9e, 0x3f, 0x80,
+	0xe2, 0xb0, 0xe8, 0xe7, 0xce, 0xb1, 0xf5, 0x9e, 0x42, 0xf3, 0x7d, 0x16, 0xc5, 0xd8, 0x9b, 0x8e,
+	0x06, 0x2d, 0x43, 0xe6, 0x84, 0x9c, 0x2b, 0x4d, 0x7c, 0x99, 0xc0, 0x77, 0x13, 0x8a, 0xc3, 0xca,
+	0x14, 0xbe, 0x22, 0xcc, 0x9e, 0xe2, 0x76, 0x57, 0xa3, 0x93, 0x1b, 0xeb, 0x1b, 0xb0, 0x2c, 0xb8,
+	0x0f, 0xa3, 0xe6, 0x67, 0x8a, 0xc2, 0x2e, 0xbc, 0x96, 0x90, 0x53, 0x26, 0x10, 0x64, 0xf9, 0xd5,
+	0x14, 0x52, 0x0b, 0xae, 0x58, 0x5b, 0x3f, 0x03, 0x24, 0x18, 0x8f, 0x7a, 0xf7, 0x23, 0x8f, 0x6a,
+	0x13, 0x08, 0xb2, 0xe2, 0x42, 0x4b, 0xfd, 0x62, 0x8d, 0xee, 0x02, 0x0c, 0x4a, 0x82, 0xf0, 0xad,
+	0x50, 0xdd, 0xb1, 0x65, 0xfd, 0xb0, 0x79, 0xfd, 0xb0, 0x65, 0x91, 0x51, 0xf5, 0xc3, 0x7e, 0x38,
+	0x08, 0x95, 0x9b, 0x90, 0x4c, 0x80, 0xfc, 0xd0, 0x80, 0x95, 0x21, 0xe3, 0x0a, 0xe7, 0x36, 0x64,
+	0xdb, 0x91, 0xc7, 0xbd, 0xcb, 0xec, 0x15, 0xaa, 0x4b, 0xf6, 0xa0, 0x5e, 0xd9, 0xf7, 0x23, 0xcf,
+	0x15, 0x87, 0xe8, 0x5e, 0x0a, 0x9c, 0xdd, 0xa9, 0x70, 0xa4, 0x85, 0x24, 0x1e, 0xab, 0xa8, 0x22,
+	0xf0, 0x10, 0xc7, 0x38, 0xd0, 0x11, 0xb0, 0xee, 0xc1, 0xca, 0x10, 0x55, 0x41, 0xfb, 0x2a, 0xcc,
+	0x75, 0x04, 0x45, 0x84, 0xa6, 0x50, 0x45, 0x49, 0x70, 0x92, 0xf7, 0x20, 0xfb, 0xc9, 0xb3, 0xf2,
+	0x8c, 0xab, 0xf8, 0xac, 0x3f, 0x1a, 0xb0, 0x78, 0x87, 0xb5, 0x0e, 0x71, 0xbb, 0x9d, 0x88, 0x2e,
+	0x8e, 0x3d, 0xaa, 0xf3, 0xc0, 0xd7, 0x68, 0x15, 0xe6, 0x3d, 0x4c, 0x6b, 0x0d, 0xdc, 0x51, 0xff,
+	0xcc, 0x9c, 0x87, 0xe9, 0x21, 0xee, 0xa0, 0x9f, 0xc2, 0x72, 0x27, 0x8e, 0x3a, 0x11, 0x25, 0x71,
+	0xff, 0xbf, 0xe3, 0xff, 0xcc, 0xc2, 0x41, 0xf5, 0xdf, 0xcf, 0xca, 0xb6, 0xe7, 0xb3, 0x56, 0xb7,
+	0x6e, 0x37, 0xa2, 0xc0, 0x51, 0xa5, 0x5c, 0x7e, 0x5e, 0xa7, 0xcd, 0x13, 0x87, 0x9d, 0x77, 0x08,
+	0xb5, 0x0f, 0x07, 0x3f, 0xbc, 0xbb, 0xa4, 0x75, 0xe9, 0x9f, 0x75, 0x1d, 0x72, 0x8d, 0x16, 0xf6,
+	0xc3, 0x9a, 0xdf, 0x14, 0x55, 0x2a, 0xe3, 0xce, 0x8b, 0xfd, 0xbb, 0x4d, 0x6b, 0x17, 0x56, 0xee,
+	0x50, 0xe6, 0x07, 0x98, 0x91, 0x7b, 0x78, 0x10, 0x82, 0x65, 0xc8, 0x78, 0x58, 0x82, 0xcf, 0xba,
+	0x7c, 0x69, 0xfd, 0x2b, 0xa3, 0xf3, 0x18, 0xe3, 0x06, 0x39, 0xea, 0x69, 0x3f, 0xbf, 0x02, 0x99,
+	0x80, 0x7a, 0x2a, 0x52, 0xeb, 0xc9, 0x48, 0x3d, 0xa0, 0xde, 0x1d, 0xd6, 0x22, 0x31, 0xe9, 0x06,
+	0x47, 0x3d, 0x97, 0x73, 0xa1, 0xb7, 0x61, 0x81, 0x71, 0xf1, 0x5a, 0x23, 0x0a, 0x8f, 0x7d, 0x4f,
+	0xf8, 0x58, 0xa8, 0xae, 0x26, 0xa5, 0x84, 0xfa, 0x43, 0x71, 0xec, 0x16, 0xd8, 0x60, 0x83, 0xde,
+	0x81, 0x85, 0x4e, 0x4c, 0x9a, 0xa4, 0x41, 0x28, 0x8d, 0x62, 0xba, 0x96, 0xad, 0x64, 0x2e, 0xb6,
+	0x38, 0xc4, 0xce, 0x0b, 0x65, 0xbd, 0x1d, 0x35, 0x4e, 0x74, 0x49, 0x9a, 0x15, 0x71, 0x28, 0x08,
+	0x9a, 0x2c, 0x48, 0x68, 0x13, 0x40, 0xb2, 0x88, 0xdf, 0x42, 0x96, 0xe3, 0xbc, 0xa0, 0x88, 0x42,
+	0x7f, 0xa8, 0x8f, 0xf9, 0x9b, 0xb5, 0x36, 0x2f, 0xa0, 0x9b, 0xb6, 0x7c, 0xd0, 0x6c, 0xfd, 0xa0,
+	0xd9, 0x47, 0xfa, 0x41, 0x3b, 0xc8, 0xf1, 0x2b, 0xf2, 0xf1, 0xdf, 0xca, 0x86, 0x52, 0xc2, 0x4f,
+	0x52, 0x33, 0x9d, 0xfb, 0xff, 0x64, 0x3a, 0x3f, 0x94, 0x69, 0x64, 0xc1, 0x65, 0x09, 0x3f, 0xc0,
+	0xbd, 0x1a, 0x4f, 0x2e, 0x24, 0x22, 0xf0, 0x00, 0xf7, 0xee, 0x61, 0xfa, 0xbd, 0x6c, 0xee, 0xd2,
+	0x72, 0xc6, 0xcd, 0xb1, 0x5e, 0xcd, 0x0f, 0x9b, 0xa4, 0x67, 0xdd, 0x50, 0x75, 0xac, 0x9f, 0xf3,
+	0x41, 0x91, 0x69, 0x62, 0x86, 0xf5, 0xe5, 0xe6, 0x6b, 0xeb, 0x0f, 0x19, 0xb8, 0x3a, 0x60, 0x3e,
+	0xe0, 0x5a, 0x13, 0x77, 0x84, 0xf5, 0xf4, 0xaf, 0x7e, 0xd1, 0x1d, 0x61, 0x3d, 0xfa, 0xb9, 0xee,
+	0xc8, 0x17, 0x49, 0x9e, 0x9e, 0x64, 0xeb, 0x75, 0xd5, 0x23, 0x25, 0xf3, 0x74, 0x41, 0x5e, 0xaf,
+	0xf4, 0x9f, 0x69, 0x4a, 0xee, 0x12, 0x5d, 0xed, 0xad, 0x8f, 0x0c, 0x28, 0x0e, 0xd3, 0x95, 0x8e,
+	0xaf, 0x41, 0x8e, 0x57, 0xe6, 0xda, 0x31, 0x51, 0xcf, 0xdc, 0xc1, 0xfa, 0x5f, 0x9f, 0x95, 0xaf,
+	0x48, 0x17, 0x69, 0xf3, 0xc4, 0xf6, 0x23, 0x27, 0xc0, 0xac, 0x65, 0xbf, 0x1b, 0x32, 0xfe, 0x3e,
+	0x0b, 0x69, 0xf4, 0x2d, 0x58, 0xd4, 0x52, 0xb5, 0x6e, 0xe8, 0xd7, 0xd5, 0x13, 0x7d, 0x91, 0xec,
+	0x82, 0x92, 0xfd, 0x01, 0x67, 0xb7, 0xde, 0x81, 0x6b, 0x02, 0xce, 0xdd, 0x6e, 0x78, 0x14, 0x9d,
+	0x90, 0xf0, 0x01, 0xee, 0x74, 0xfc, 0xd0, 0xd3, 0x57, 0xb0, 0x08, 0xb3, 0x8c, 0x93, 0xf5, 0xcb,
+	0x2b, 0x36, 0x89, 0x67, 0xea, 0x27, 0xb0, 0x91, 0x2e, 0xae, 0xbc, 0xda, 0x87, 0xfc, 0x71, 0x37,
+	0xac, 0x0d, 0x74, 0x14, 0xaa, 0xc5, 0xe4, 0x95, 0xd4, 0x72, 0x6e, 0xee, 0x58, 0xad, 0x12, 0xca,
+	0x8f, 0xd3, 0x95, 0xf7, 0x5f, 0xe2, 0xe1, 0x57, 0xd7, 0xf8, 0x5f, 0x5f, 0x5d, 0xeb, 0xf7, 0xba,
+	0xbf, 0x1b, 0x37, 0xa4, 0xdc, 0x78, 0x0b, 0xa0, 0xef, 0x86, 0xfe, 0x21, 0x53, 0xfd, 0x50, 0x0f,
+	0x5c, 0x5e, 0x7b, 0xf3, 0x0a, 0xdf, 0xe2, 0xaf, 0x8f, 0x80, 0x74, 0x31, 0x23, 0xf7, 0xfd, 0xc0,
+	0x67, 0x17, 0xe6, 0xca, 0x7a, 0x61, 0x40, 0x69, 0x92, 0x9c, 0xf2, 0xee, 0xcd, 0x97, 0x4c, 0x92,
+	0x72, 0xae, 0x9f, 0x2a, 0x74, 0x0b, 0x20, 0xc6, 0x8c, 0xd4, 0xda, 0x5c, 0x9d, 0xf2, 0x6d, 0x33,
+	0x35, 0xbd, 0x7d, 0x9b, 0xf9, 0x58, 0x2f, 0x51, 0x15, 0xb2, 0xc7, 0xed, 0xe8, 0x4c, 0x55, 0xaa,
+	0xb5, 0x34, 0xb9, 0xbb, 0xed, 0xe8, 0x4c, 0x59, 0x15, 0xbc, 0xe8, 0x2a, 0xef, 0x31, 0xba, 0x94,
+	0xc8, 0x07, 0x39, 0xe7, 0xaa, 0x9d, 0xf5, 0x4d, 0x75, 0x55, 0xbe, 0x43, 0x3a, 0xed, 0xe8, 0xfc,
+	0x21, 0x89, 0x03, 0x9f, 0x52, 0x3f, 0x0a, 0xa7, 0xf6, 0x85, 0xd6, 0x6f, 0x75, 0xf2, 0xc7, 0x45,
+	0x55, 0x78, 0x6e, 0x01, 0x74, 0xfa, 0x54, 0x21, 0xbe, 0x58, 0xdd, 0x48, 0xa2, 0x1d, 0x93, 0x4c,
+	0xf0, 0xa3, 0x0d, 0xc8, 0xe3, 0x76, 0x3b, 0x3a, 0x6b, 0xfb, 0x94, 0x87, 0x28, 0xc3, 0xeb, 0x66,
+	0x9f, 0xc0, 0xcb, 0x6a, 0x03, 0x87, 0xb5, 0xa6, 0xd0, 0x20, 0x22, 0x91, 0x73, 0xf3, 0x0d, 0x1c,
+	0x4a, 0x95, 0xd5, 0xff, 0x2c, 0xc1, 0xac, 0x00, 0x87, 0x3e, 0x34, 0x00, 0x06, 0xd3, 0x19, 0xb2,
+	0x92, 0xf6, 0xd3, 0x27, 0x3f, 0x73, 0xfb, 0x42, 0x1e, 0xe9, 0x9c, 0x75, 0xf3, 0x17, 0x7f, 0xfe,
+	0xc7, 0x6f, 0x2e, 0xed, 0xa0, 0x2f, 0x39, 0xbc, 0x1c, 0xc4, 0xdd, 0xfe, 0x10, 0xcb, 0xa7, 0x30,
+	0xc9, 0xeb, 0x3c, 0x56, 0xb1, 0x7a, 0x82, 0x9e, 0x1a, 0xb0, 0x3c, 0x3a, 0x04, 0xa1, 0xbd, 0x31,
+	0x3b, 0x13, 0xa6, 0x2e, 0xf3, 0xfa, 0x4b, 0x70, 0x2a, 0x5c, 0x6f, 0x0a, 0x5c, 0xfb, 0xc8, 0x19,
+	0xc1, 0x75, 0xaa, 0x05, 0x06, 0xe8, 0x92, 0x83, 0xdc, 0x13, 0x74, 0x06, 0xf3, 0x07, 0x7a, 0x78,
+	0x19, 0x33, 0x37, 0x3c, 0x33, 0x99, 0x95, 0xc9, 0x0c, 0x0a, 0xc6, 0x75, 0x01, 0x63, 0x1b, 0x6d,
+	0x8d, 0xc0, 0x50, 0x13, 0x10, 0x4d, 0xc4, 0xe6, 0xe7, 0x30, 0xaf, 0xe6, 0x96, 0x14, 0xc3, 0xc3,
+	0xe3, 0x91, 0x59, 0x99, 0xcc, 0xa0, 0x0c, 0xdb, 0xc2, 0xf0, 0x1e, 0xda, 0x19, 0x31, 0x4c, 0x25,
+	0xdf, 0xc0, 0xae, 0xf3, 0xf8, 0x84, 0x9c, 0x3f, 0x41, 0x27, 0x90, 0xe5, 0xf3, 0x0c, 0xda, 0x18,
+	0xd3, 0x9c, 0x18, 0x8f, 0xcc, 0xcd, 0x09, 0xa7, 0xca, 0xe8, 0x8e, 0x30, 0x5a, 0x41, 0xa5, 0x11,
+	0xa3, 0x7c, 0x1a, 0x4a, 0xba, 0xda, 0x82, 0x39, 0xd9, 0xcf, 0xa3, 0xd2, 0x98, 0xc2, 0xa1, 0x51,
+	0xc1, 0x2c, 0x4f, 0x3c, 0x57, 0x26, 0x37, 0x85, 0xc9, 0x55, 0x74, 0x65, 0xc4, 0xa4, 0x9c, 0x10,
+	0x90, 0x0f, 0xf3, 0x6a, 0x40, 0x40, 0x66, 0x52, 0xd5, 0xf0, 0xd4, 0x60, 0x6e, 0x4d, 0x6e, 0x8e,
+	0xb4, 0xa1, 0xb2, 0x30, 0xb4, 0x8e, 0x56, 0x53, 0x2e, 0x7a, 0x83, 0xeb, 0x8f, 0xa0, 0x90, 0x68,
+	0xe9, 0x2f, 0x34, 0x37, 0xe4, 0x55, 0xca, 0x1c, 0x60, 0x6d, 0x0b, 0x63, 0x9b, 0xe8, 0xda, 0xa8,
+	0x31, 0xc5, 0xcb, 0x7b, 0x0c, 0x14, 0xc0, 0xbc, 0x6a, 0x10, 0x53, 0x2e, 0xcc, 0xf0, 0xb8, 0x60,
+	0x56, 0x26, 0x33, 0x4c, 0xf1, 0x4f, 0x36, 0x85, 0xac, 0x87, 0xce, 0x01, 0x06, 0xad, 0x4b, 0x4a,
+	0x01, 0x19, 0xeb, 0x3f, 0xcd, 0xed, 0x0b, 0x79, 0x94, 0x5d, 0x4b, 0xd8, 0xdd, 0x40, 0x66, 0xaa,
+	0x5d, 0xd1, 0x40, 0xa1, 0x47, 0x90, 0x97, 0xbd, 0x27, 0x8f, 0xf3, 0x2b, 0xf0, 0x75, 0x4b, 0xd8,
+	0xbc, 0x86, 0xd6, 0x53, 0x6d, 0x8a, 0x6c, 0x06, 0xbc, 0x0c, 0xc8, 0x1e, 0x29, 0xad, 0x0c, 0x24,
+	0x7b, 0x32, 0xb3, 0x32, 0x99, 0x61, 0x4a, 0x70, 0x75, 0xef, 0x85, 0x3e, 0x32, 0x60, 0x69, 0xa4,
+	0x7b, 0x40, 0xbb, 0x63, 0x6a, 0xd3, 0x9b, 0x2c, 0x73, 0x6f, 0x3a, 0xa3, 0xc2, 0xb1, 0x2b, 0x70,
+	0x6c, 0xa1, 0xf2, 0x08, 0x8e, 0xe3, 0x6e, 0x28, 0x5e, 0x6f, 0xe7, 0xb1, 0xf8, 0x3c, 0x41, 0xbf,
+	0x36, 0x60, 0x79, 0x44, 0x09, 0x45, 0x53, 0xed, 0xd0, 0xc9, 0x85, 0x7a, 0x52, 0x6b, 0x64, 0xed,
+	0x09, 0x48, 0x16, 0xaa, 0x4c, 0x80, 0x54, 0x0b, 0xb4, 0xf9, 0xdf, 0x19, 0xf0, 0xda, 0x58, 0x43,
+	0x80, 0x26, 0x9b, 0x1a, 0x6d, 0x70, 0xcc, 0x1b, 0x2f, 0xc3, 0xaa, 0x60, 0x55, 0x05, 0xac, 0x9b,
+	0xe8, 0xc6, 0x24, 0x58, 0x83, 0xc6, 0x65, 0x28, 0x68, 0xa3, 0x6f, 0x79, 0x4a, 0xd0, 0x26, 0xf4,
+	0x18, 0xe6, 0xf5, 0x97, 0xe0, 0x9c, 0x12, 0x34, 0xd9, 0x07, 0xd4, 0x06, 0xed, 0xc3, 0xc1, 0xb7,
+	0x3f, 0x79, 0x5e, 0x32, 0x3e, 0x7d, 0x5e, 0x32, 0xfe, 0xfe, 0xbc, 0x64, 0x7c, 0xfc, 0xa2, 0x34,
+	0xf3, 0xe9, 0x8b, 0xd2, 0xcc, 0x5f, 0x5e, 0x94, 0x66, 0x7e, 0xbc, 0x93, 0x98, 0x87, 0xde, 0x17,
+	0x5a, 0x0e, 0xf9, 0x34, 0xa3, 0x35, 0x9e, 0x56, 0x9d, 0x1e, 0x57, 0x5b, 0x9f, 0x13, 0xe3, 0xd7,
+	0x1b, 0xff, 0x1d, 0x00, 0xc7, 0x41, 0xae, 0xeb, 0xf3, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1490,6 +1837,14 @@ type QueryClient interface {
 	// Similar to feemarket module's method
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(ctx context.Context, in *QueryFunTokenMappingRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingResponse, error)
+	// FunTokenMappings lists all FunToken mappings, ordered by their ID.
+	FunTokenMappings(ctx context.Context, in *QueryFunTokenMappingsRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingsResponse, error)
+	// FunTokenRateLimit queries the rate limit, the net flow in the current rate
+	// limit window, and the pause status of a FunToken mapping.
+	FunTokenRateLimit(ctx context.Context, in *QueryFunTokenRateLimitRequest, opts ...grpc.CallOption) (*QueryFunTokenRateLimitResponse, error)
+	// DeployPermission queries which accounts can deploy contracts to the EVM
+	// and, optionally, whether a given account can.
+	DeployPermission(ctx context.Context, in *QueryDeployPermissionRequest, opts ...grpc.CallOption) (*QueryDeployPermissionResponse, error)
 }
 
 type queryClient struct {
@@ -1617,6 +1972,33 @@ func (c *queryClient) FunTokenMapping(ctx context.Context, in *QueryFunTokenMapp
 	return out, nil
 }
 
+func (c *queryClient) FunTokenMappings(ctx context.Context, in *QueryFunTokenMappingsRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingsResponse, error) {
+	out := new(QueryFunTokenMappingsResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunTokenMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FunTokenRateLimit(ctx context.Context, in *QueryFunTokenRateLimitRequest, opts ...grpc.CallOption) (*QueryFunTokenRateLimitResponse, error) {
+	out := new(QueryFunTokenRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunTokenRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeployPermission(ctx context.Context, in *QueryDeployPermissionRequest, opts ...grpc.CallOption) (*QueryDeployPermissionResponse, error) {
+	out := new(QueryDeployPermissionResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/DeployPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EthAccount queries a Nibiru account using its EVM address or Bech32 Nibiru
@@ -1648,6 +2030,14 @@ type QueryServer interface {
 	// Similar to feemarket module's method
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(context.Context, *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error)
+	// FunTokenMappings lists all FunToken mappings, ordered by their ID.
+	FunTokenMappings(context.Context, *QueryFunTokenMappingsRequest) (*QueryFunTokenMappingsResponse, error)
+	// FunTokenRateLimit queries the rate limit, the net flow in the current rate
+	// limit window, and the pause status of a FunToken mapping.
+	FunTokenRateLimit(context.Context, *QueryFunTokenRateLimitRequest) (*QueryFunTokenRateLimitResponse, error)
+	// DeployPermission queries which accounts can deploy contracts to the EVM
+	// and, optionally, whether a given account can.
+	DeployPermission(context.Context, *QueryDeployPermissionRequest) (*QueryDeployPermissionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1693,6 +2083,15 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) FunTokenMapping(ctx context.Context, req *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FunTokenMapping not implemented")
 }
+func (*UnimplementedQueryServer) FunTokenMappings(ctx context.Context, req *QueryFunTokenMappingsRequest) (*QueryFunTokenMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunTokenMappings not implemented")
+}
+func (*UnimplementedQueryServer) FunTokenRateLimit(ctx context.Context, req *QueryFunTokenRateLimitRequest) (*QueryFunTokenRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunTokenRateLimit not implemented")
+}
+func (*UnimplementedQueryServer) DeployPermission(ctx context.Context, req *QueryDeployPermissionRequest) (*QueryDeployPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployPermission not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1932,6 +2331,60 @@ func _Query_FunTokenMapping_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FunTokenMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunTokenMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunTokenMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunTokenMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunTokenMappings(ctx, req.(*QueryFunTokenMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FunTokenRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunTokenRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunTokenRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunTokenRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunTokenRateLimit(ctx, req.(*QueryFunTokenRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeployPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeployPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeployPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/DeployPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeployPermission(ctx, req.(*QueryDeployPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "eth.evm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1988,6 +2441,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FunTokenMapping",
 			Handler:    _Query_FunTokenMapping_Handler,
 		},
+		{
+			MethodName: "FunTokenMappings",
+			Handler:    _Query_FunTokenMappings_Handler,
+		},
+		{
+			MethodName: "FunTokenRateLimit",
+			Handler:    _Query_FunTokenRateLimit_Handler,
+		},
+		{
+			MethodName: "DeployPermission",
+			Handler:    _Query_DeployPermission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/query.proto",
@@ -2951,8 +3416,264 @@ func (m *QueryFunTokenMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
+func (m *QueryFunTokenMappingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunTokenMappingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunTokenMappingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFunTokenMappingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunTokenMappingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunTokenMappingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunTokens) > 0 {
+		for iNdEx := len(m.FunTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FunTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFunTokenRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunTokenRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunTokenRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFunTokenRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunTokenRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunTokenRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Flow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.FunToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeployPermissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeployPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeployPermissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeployPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeployPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeployPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CanDeploy {
+		i--
+		if m.CanDeploy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Permission != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -3374,31 +4095,130 @@ func (m *QueryFunTokenMappingResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryFunTokenMappingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryEthAccountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *QueryFunTokenMappingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FunTokens) > 0 {
+		for _, e := range m.FunTokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunTokenRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunTokenRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FunToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Flow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDeployPermissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeployPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		n += 1 + sovQuery(uint64(m.Permission))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CanDeploy {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEthAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
@@ -6143,6 +6963,669 @@ func (m *QueryFunTokenMappingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFunTokenMappingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunTokenMappingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunTokenMappingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunTokenMappingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunTokenMappingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunTokenMappingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunTokens = append(m.FunTokens, FunToken{})
+			if err := m.FunTokens[len(m.FunTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunTokenRateLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunTokenRateLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunTokenRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunTokenRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunTokenRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunTokenRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FunToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &FunTokenRateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Flow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeployPermissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeployPermissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeployPermissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeployPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeployPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeployPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= DeployPermission(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanDeploy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanDeploy = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -281,6 +281,23 @@ func (ch addLogChange) Dirtied() *common.Address {
 	return nil
 }
 
+// ------------------------------------------------------
+// onCommitChange
+
+// onCommitChange represents [JournalChange] for a function registered with
+// [StateDB.OnCommit]. When reverted, it removes the last registered function.
+type onCommitChange struct{}
+
+var _ JournalChange = onCommitChange{}
+
+func (ch onCommitChange) Revert(s *StateDB) {
+	s.onCommit = s.onCommit[:len(s.onCommit)-1]
+}
+
+func (ch onCommitChange) Dirtied() *common.Address {
+	return nil
+}
+
 // ------------------------------------------------------
 // accessListAddAccountChange
 
@@ -82,6 +82,9 @@ type StateDB struct {
 
 	// Per-transaction access list
 	accessList *accessList
+	// onCommit holds the functions to run once the transaction is committed
+	// with [StateDB.Commit]. See [StateDB.OnCommit].
+	onCommit []func()
 }
 
 func FromVM(evmObj *vm.EVM) *StateDB {
@@ -139,6 +142,16 @@ func (s *StateDB) AddLog(log *gethcore.Log) {
 	s.logs = append(s.logs, log)
 }
 
+// OnCommit registers a function to run after the state changes of the
+// transaction are written by [StateDB.Commit]. The function is dropped if the
+// EVM reverts to a snapshot taken before [OnCommit] was called, or if the
+// StateDB is never committed, as with "eth_call" and "eth_estimateGas". It's
+// meant for side effects outside of state, like telemetry.
+func (s *StateDB) OnCommit(fn func()) {
+	s.Journal.append(onCommitChange{})
+	s.onCommit = append(s.onCommit, fn)
+}
+
 // Logs returns the event logs of current transaction.
 func (s *StateDB) Logs() []*gethcore.Log {
 	return s.logs
@@ -659,7 +672,14 @@ func (s *StateDB) Commit() error {
 	if s.writeToCommitCtxFromCacheCtx != nil {
 		s.writeToCommitCtxFromCacheCtx()
 	}
-	return s.commitCtx(s.GetEvmTxContext())
+	if err := s.commitCtx(s.GetEvmTxContext()); err != nil {
+		return err
+	}
+	for _, fn := range s.onCommit {
+		fn()
+	}
+	s.onCommit = nil
+	return nil
 }
 
 // CommitCacheCtx is identical to [StateDB.Commit], except it:
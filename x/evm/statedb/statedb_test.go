@@ -610,6 +610,21 @@ func (s *Suite) TestLog() {
 	s.Require().Equal(wantLog, gotLog)
 }
 
+func (s *Suite) TestOnCommit() {
+	deps := evmtest.NewTestDeps()
+	db := deps.NewStateDB()
+
+	var called []string
+	db.OnCommit(func() { called = append(called, "kept") })
+	rev := db.Snapshot()
+	db.OnCommit(func() { called = append(called, "reverted") })
+	db.RevertToSnapshot(rev)
+	s.Require().Empty(called, "functions only run on commit")
+
+	s.Require().NoError(db.Commit())
+	s.Require().Equal([]string{"kept"}, called)
+}
+
 func (s *Suite) TestRefund() {
 	testCases := []struct {
 		name      string
@@ -0,0 +1,86 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evm
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Telemetry metric keys of the EVM module. The metrics go to the sinks of the
+// node's [telemetry.Metrics], where Prometheus names them by joining the keys
+// with underscores, e.g. "evm_tx_gas_used".
+var (
+	// MetricKeyTxs: Counter of Ethereum txs by status.
+	MetricKeyTxs = []string{ModuleName, "tx", "count"}
+	// MetricKeyTxGasUsed: Counter of the gas used by Ethereum txs by status.
+	// Divided by [MetricKeyTxs], it gives the average gas used per tx.
+	MetricKeyTxGasUsed = []string{ModuleName, "tx", "gas_used"}
+	// MetricKeyBlockGasUsed: Gauge of the gas used by the Ethereum txs of the
+	// last block.
+	MetricKeyBlockGasUsed = []string{ModuleName, "block", "gas_used"}
+	// MetricKeyPrecompileCalls: Counter of precompile calls by precompile and
+	// method.
+	MetricKeyPrecompileCalls = []string{ModuleName, "precompile", "calls"}
+	// MetricKeyPrecompileFailures: Counter of failed precompile calls by
+	// precompile and method.
+	MetricKeyPrecompileFailures = []string{ModuleName, "precompile", "failures"}
+	// MetricKeyFunTokenConverted: Counter of the amount converted between the
+	// bank coin and ERC20 of FunToken mappings, by bank denom and direction.
+	// Only committed conversions count, not reverted or simulated ones.
+	MetricKeyFunTokenConverted = []string{ModuleName, "funtoken", "converted"}
+)
+
+// Telemetry metric label names of the EVM module.
+const (
+	MetricLabelStatus     = "status"
+	MetricLabelPrecompile = "precompile"
+	MetricLabelMethod     = "method"
+	MetricLabelDenom      = "denom"
+	MetricLabelDirection  = "direction"
+)
+
+// EmitTxGasUsedMetric records an Ethereum tx and the gas it used.
+func EmitTxGasUsedMetric(gasUsed uint64, failed bool) {
+	status := "success"
+	if failed {
+		status = "failed"
+	}
+	labels := []metrics.Label{telemetry.NewLabel(MetricLabelStatus, status)}
+	telemetry.IncrCounterWithLabels(MetricKeyTxs, 1, labels)
+	telemetry.IncrCounterWithLabels(MetricKeyTxGasUsed, float32(gasUsed), labels)
+}
+
+// EmitPrecompileCallMetric records a call to "method" of a precompile and
+// whether it failed.
+func EmitPrecompileCallMetric(precompile, method string, err error) {
+	labels := []metrics.Label{
+		telemetry.NewLabel(MetricLabelPrecompile, precompile),
+		telemetry.NewLabel(MetricLabelMethod, method),
+	}
+	telemetry.IncrCounterWithLabels(MetricKeyPrecompileCalls, 1, labels)
+	if err != nil {
+		telemetry.IncrCounterWithLabels(MetricKeyPrecompileFailures, 1, labels)
+	}
+}
+
+// EmitFunTokenConvertedMetric records a conversion of a FunToken mapping.
+// "netToEvm" is positive when tokens move into the ERC20 representation and
+// negative when they move out of it.
+func EmitFunTokenConvertedMetric(bankDenom string, netToEvm sdkmath.Int) {
+	direction := "to_evm"
+	if netToEvm.IsNegative() {
+		direction = "to_bank"
+	}
+	amount, _ := new(big.Float).SetInt(netToEvm.Abs().BigInt()).Float32()
+	telemetry.IncrCounterWithLabels(
+		MetricKeyFunTokenConverted,
+		amount,
+		[]metrics.Label{
+			telemetry.NewLabel(MetricLabelDenom, bankDenom),
+			telemetry.NewLabel(MetricLabelDirection, direction),
+		},
+	)
+}
@@ -475,8 +475,10 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
 // MsgCreateFunToken: Arguments to create a "FunToken" mapping. Either the ERC20
-// contract address can be given to create the mapping to a Bank Coin, or the
-// denomination for a Bank Coin can be given to create the mapping to an ERC20.
+// contract address can be given to create the mapping to a Bank Coin, the
+// denomination for a Bank Coin can be given to create the mapping to an ERC20,
+// or the address of a CW20 contract can be given to create the mapping to an
+// ERC20. Exactly one of these must be set.
 type MsgCreateFunToken struct {
 	// Hexadecimal address of the ERC20 token to which the `FunToken` maps
 	FromErc20 *github_com_NibiruChain_nibiru_v2_eth.EIP55Addr `protobuf:"bytes,1,opt,name=from_erc20,json=fromErc20,proto3,customtype=github.com/NibiruChain/nibiru/v2/eth.EIP55Addr" json:"from_erc20,omitempty"`
@@ -491,6 +493,15 @@ type MsgCreateFunToken struct {
 	// missing metadata.
 	// Set this to true if the token is truly intended to have 0 decimals.
 	AllowZeroDecimals bool `protobuf:"varint,4,opt,name=allow_zero_decimals,json=allowZeroDecimals,proto3" json:"allow_zero_decimals,omitempty"`
+	// Bech32 address of a CW20 contract to create the `FunToken` mapping from.
+	FromCw20 string `protobuf:"bytes,5,opt,name=from_cw20,json=fromCw20,proto3" json:"from_cw20,omitempty"`
+	// Optional exponent "k" for a mapping created from a bank coin
+	// ("from_bank_denom") such that the deployed ERC-20 has "k" more decimals
+	// than the bank coin and one bank base unit converts to 10^k ERC-20 base
+	// units. For example, a 6 decimal coin with "decimals_scale = 12" gets an 18
+	// decimal ERC-20, which is what most EVM tooling assumes. The decimals of
+	// the ERC-20 cannot exceed 18.
+	DecimalsScale uint32 `protobuf:"varint,6,opt,name=decimals_scale,json=decimalsScale,proto3" json:"decimals_scale,omitempty"`
 }
 
 func (m *MsgCreateFunToken) Reset()         { *m = MsgCreateFunToken{} }
@@ -547,6 +558,20 @@ func (m *MsgCreateFunToken) GetAllowZeroDecimals() bool {
 	return false
 }
 
+func (m *MsgCreateFunToken) GetFromCw20() string {
+	if m != nil {
+		return m.FromCw20
+	}
+	return ""
+}
+
+func (m *MsgCreateFunToken) GetDecimalsScale() uint32 {
+	if m != nil {
+		return m.DecimalsScale
+	}
+	return 0
+}
+
 type MsgCreateFunTokenResponse struct {
 	// Fungible token mapping corresponding to ERC20 tokens.
 	FuntokenMapping FunToken `protobuf:"bytes,1,opt,name=funtoken_mapping,json=funtokenMapping,proto3" json:"funtoken_mapping"`
@@ -789,6 +814,311 @@ func (m *MsgConvertEvmToCoinResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertEvmToCoinResponse proto.InternalMessageInfo
 
+// MsgSetFunTokenRateLimit: Arguments to set or remove the rate limit of a
+// `FunToken` mapping. Setting "rate_limit.window_blocks" to zero removes the
+// rate limit.
+type MsgSetFunTokenRateLimit struct {
+	// Sender: Address of the governance account or of a sudoer.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Rate limit to set. The `FunToken` mapping is identified by
+	// "rate_limit.bank_denom".
+	RateLimit FunTokenRateLimit `protobuf:"bytes,2,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+}
+
+func (m *MsgSetFunTokenRateLimit) Reset()         { *m = MsgSetFunTokenRateLimit{} }
+func (m *MsgSetFunTokenRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFunTokenRateLimit) ProtoMessage()    {}
+func (*MsgSetFunTokenRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{14}
+}
+func (m *MsgSetFunTokenRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFunTokenRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFunTokenRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFunTokenRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFunTokenRateLimit.Merge(m, src)
+}
+func (m *MsgSetFunTokenRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFunTokenRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFunTokenRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFunTokenRateLimit proto.InternalMessageInfo
+
+func (m *MsgSetFunTokenRateLimit) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetFunTokenRateLimit) GetRateLimit() FunTokenRateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return FunTokenRateLimit{}
+}
+
+type MsgSetFunTokenRateLimitResponse struct {
+}
+
+func (m *MsgSetFunTokenRateLimitResponse) Reset()         { *m = MsgSetFunTokenRateLimitResponse{} }
+func (m *MsgSetFunTokenRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFunTokenRateLimitResponse) ProtoMessage()    {}
+func (*MsgSetFunTokenRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{15}
+}
+func (m *MsgSetFunTokenRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFunTokenRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFunTokenRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFunTokenRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFunTokenRateLimitResponse.Merge(m, src)
+}
+func (m *MsgSetFunTokenRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFunTokenRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFunTokenRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFunTokenRateLimitResponse proto.InternalMessageInfo
+
+// MsgSetFunTokenPaused: Arguments to pause or unpause the conversions of a
+// `FunToken` mapping.
+type MsgSetFunTokenPaused struct {
+	// Sender: Address of the governance account or of a sudoer.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Coin denomination of the `FunToken` mapping in the Bank Module.
+	BankDenom string `protobuf:"bytes,2,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// True to pause conversions, false to resume them.
+	Paused bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *MsgSetFunTokenPaused) Reset()         { *m = MsgSetFunTokenPaused{} }
+func (m *MsgSetFunTokenPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFunTokenPaused) ProtoMessage()    {}
+func (*MsgSetFunTokenPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{16}
+}
+func (m *MsgSetFunTokenPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFunTokenPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFunTokenPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFunTokenPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFunTokenPaused.Merge(m, src)
+}
+func (m *MsgSetFunTokenPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFunTokenPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFunTokenPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFunTokenPaused proto.InternalMessageInfo
+
+func (m *MsgSetFunTokenPaused) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetFunTokenPaused) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *MsgSetFunTokenPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+type MsgSetFunTokenPausedResponse struct {
+}
+
+func (m *MsgSetFunTokenPausedResponse) Reset()         { *m = MsgSetFunTokenPausedResponse{} }
+func (m *MsgSetFunTokenPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFunTokenPausedResponse) ProtoMessage()    {}
+func (*MsgSetFunTokenPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{17}
+}
+func (m *MsgSetFunTokenPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFunTokenPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFunTokenPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFunTokenPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFunTokenPausedResponse.Merge(m, src)
+}
+func (m *MsgSetFunTokenPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFunTokenPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFunTokenPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFunTokenPausedResponse proto.InternalMessageInfo
+
+// MsgSetDeployPermission: Arguments to set which accounts can deploy contracts
+// to the EVM and to edit the deploy allowlist.
+type MsgSetDeployPermission struct {
+	// Sender: Address of the governance account or of a sudoer.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Deploy permission to set.
+	Permission DeployPermission `protobuf:"varint,2,opt,name=permission,proto3,enum=eth.evm.v1.DeployPermission" json:"permission,omitempty"`
+	// Hexadecimal or Bech32 addresses to add to the deploy allowlist.
+	AddToAllowlist []string `protobuf:"bytes,3,rep,name=add_to_allowlist,json=addToAllowlist,proto3" json:"add_to_allowlist,omitempty"`
+	// Hexadecimal or Bech32 addresses to remove from the deploy allowlist.
+	RemoveFromAllowlist []string `protobuf:"bytes,4,rep,name=remove_from_allowlist,json=removeFromAllowlist,proto3" json:"remove_from_allowlist,omitempty"`
+}
+
+func (m *MsgSetDeployPermission) Reset()         { *m = MsgSetDeployPermission{} }
+func (m *MsgSetDeployPermission) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDeployPermission) ProtoMessage()    {}
+func (*MsgSetDeployPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{18}
+}
+func (m *MsgSetDeployPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDeployPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDeployPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDeployPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDeployPermission.Merge(m, src)
+}
+func (m *MsgSetDeployPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDeployPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDeployPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDeployPermission proto.InternalMessageInfo
+
+func (m *MsgSetDeployPermission) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetDeployPermission) GetPermission() DeployPermission {
+	if m != nil {
+		return m.Permission
+	}
+	return DeployPermissionEverybody
+}
+
+func (m *MsgSetDeployPermission) GetAddToAllowlist() []string {
+	if m != nil {
+		return m.AddToAllowlist
+	}
+	return nil
+}
+
+func (m *MsgSetDeployPermission) GetRemoveFromAllowlist() []string {
+	if m != nil {
+		return m.RemoveFromAllowlist
+	}
+	return nil
+}
+
+type MsgSetDeployPermissionResponse struct {
+}
+
+func (m *MsgSetDeployPermissionResponse) Reset()         { *m = MsgSetDeployPermissionResponse{} }
+func (m *MsgSetDeployPermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDeployPermissionResponse) ProtoMessage()    {}
+func (*MsgSetDeployPermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{19}
+}
+func (m *MsgSetDeployPermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDeployPermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDeployPermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDeployPermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDeployPermissionResponse.Merge(m, src)
+}
+func (m *MsgSetDeployPermissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDeployPermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDeployPermissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDeployPermissionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "eth.evm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "eth.evm.v1.LegacyTx")
@@ -804,97 +1134,120 @@ func init() {
 	proto.RegisterType((*MsgConvertCoinToEvmResponse)(nil), "eth.evm.v1.MsgConvertCoinToEvmResponse")
 	proto.RegisterType((*MsgConvertEvmToCoin)(nil), "eth.evm.v1.MsgConvertEvmToCoin")
 	proto.RegisterType((*MsgConvertEvmToCoinResponse)(nil), "eth.evm.v1.MsgConvertEvmToCoinResponse")
+	proto.RegisterType((*MsgSetFunTokenRateLimit)(nil), "eth.evm.v1.MsgSetFunTokenRateLimit")
+	proto.RegisterType((*MsgSetFunTokenRateLimitResponse)(nil), "eth.evm.v1.MsgSetFunTokenRateLimitResponse")
+	proto.RegisterType((*MsgSetFunTokenPaused)(nil), "eth.evm.v1.MsgSetFunTokenPaused")
+	proto.RegisterType((*MsgSetFunTokenPausedResponse)(nil), "eth.evm.v1.MsgSetFunTokenPausedResponse")
+	proto.RegisterType((*MsgSetDeployPermission)(nil), "eth.evm.v1.MsgSetDeployPermission")
+	proto.RegisterType((*MsgSetDeployPermissionResponse)(nil), "eth.evm.v1.MsgSetDeployPermissionResponse")
 }
 
 func init() { proto.RegisterFile("eth/evm/v1/tx.proto", fileDescriptor_82a0bfe4f0bab953) }
 
 var fileDescriptor_82a0bfe4f0bab953 = []byte{
-	// 1350 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0x8e, 0x3f, 0xc6, 0x6e, 0x92, 0x6e, 0x53, 0x62, 0xbb, 0xad, 0x37, 0x6c, 0x45,
-	0x09, 0x48, 0xd9, 0x6d, 0x8c, 0x5a, 0xa9, 0x39, 0x11, 0x27, 0x2e, 0x2a, 0x4a, 0x20, 0x5a, 0x9c,
-	0x1e, 0x2a, 0x24, 0x6b, 0xbc, 0x3b, 0x59, 0xaf, 0xe2, 0x9d, 0x59, 0xed, 0x8c, 0x17, 0xa7, 0xc7,
-	0x9e, 0x90, 0x38, 0x00, 0xe2, 0x1f, 0xe0, 0xc0, 0x89, 0x13, 0x87, 0x1e, 0xf8, 0x13, 0x2a, 0x4e,
-	0x15, 0x20, 0x81, 0x8a, 0x64, 0x50, 0x8a, 0x84, 0xd4, 0x63, 0x0f, 0x9c, 0xd1, 0xcc, 0x8e, 0xbf,
-	0x92, 0x3a, 0x85, 0x80, 0xb8, 0xcd, 0x9b, 0xf7, 0x31, 0xef, 0xfd, 0x7e, 0x6f, 0xde, 0x0c, 0xb8,
-	0x80, 0x58, 0xdb, 0x44, 0x91, 0x6f, 0x46, 0x6b, 0x26, 0xeb, 0x19, 0x41, 0x48, 0x18, 0x51, 0x01,
-	0x62, 0x6d, 0x03, 0x45, 0xbe, 0x11, 0xad, 0x95, 0x2b, 0x36, 0xa1, 0x3e, 0xa1, 0x66, 0x0b, 0x52,
-	0x64, 0x46, 0x6b, 0x2d, 0xc4, 0xe0, 0x9a, 0x69, 0x13, 0x0f, 0xc7, 0xb6, 0xe5, 0x25, 0xa9, 0xf7,
-	0xa9, 0xcb, 0x63, 0xf8, 0xd4, 0x95, 0x8a, 0x52, 0xac, 0x68, 0x0a, 0xc9, 0x8c, 0x05, 0xa9, 0x5a,
-	0x1c, 0x3b, 0x94, 0x1f, 0x23, 0x77, 0x5d, 0xe2, 0x92, 0xd8, 0x9a, 0xaf, 0xe4, 0xee, 0x65, 0x97,
-	0x10, 0xb7, 0x83, 0x4c, 0x18, 0x78, 0x26, 0xc4, 0x98, 0x30, 0xc8, 0x3c, 0x82, 0x07, 0x91, 0x4a,
-	0x52, 0x2b, 0xa4, 0x56, 0x77, 0xdf, 0x84, 0xf8, 0x30, 0x56, 0xe9, 0x9f, 0x2a, 0xe0, 0xdc, 0x0e,
-	0x75, 0xeb, 0xac, 0x8d, 0x42, 0xd4, 0xf5, 0x1b, 0x3d, 0x75, 0x05, 0xa4, 0x1c, 0xc8, 0x60, 0x51,
-	0x59, 0x56, 0x56, 0xf2, 0xd5, 0x45, 0x23, 0xf6, 0x35, 0x06, 0xbe, 0xc6, 0x06, 0x3e, 0xb4, 0x84,
-	0x85, 0x5a, 0x02, 0x29, 0xea, 0xdd, 0x47, 0xc5, 0xc4, 0xb2, 0xb2, 0xa2, 0xd4, 0x66, 0x9f, 0xf5,
-	0x35, 0x65, 0xd5, 0x12, 0x5b, 0xaa, 0x06, 0x52, 0x6d, 0x48, 0xdb, 0xc5, 0xe4, 0xb2, 0xb2, 0x92,
-	0xab, 0xe5, 0x9f, 0xf7, 0xb5, 0x4c, 0xd8, 0x09, 0xd6, 0xf5, 0x55, 0xdd, 0x12, 0x0a, 0x55, 0x05,
-	0xa9, 0xfd, 0x90, 0xf8, 0xc5, 0x14, 0x37, 0xb0, 0xc4, 0x7a, 0x3d, 0xf5, 0xf1, 0x97, 0xda, 0x8c,
-	0xfe, 0x79, 0x02, 0x64, 0xb7, 0x91, 0x0b, 0xed, 0xc3, 0x46, 0x4f, 0x5d, 0x04, 0xb3, 0x98, 0x60,
-	0x1b, 0x89, 0x6c, 0x52, 0x56, 0x2c, 0xa8, 0x37, 0x41, 0xce, 0x85, 0x1c, 0x33, 0xcf, 0x8e, 0x4f,
-	0xcf, 0xd5, 0x4a, 0x4f, 0xfa, 0xda, 0xc5, 0x18, 0x3e, 0xea, 0x1c, 0x18, 0x1e, 0x31, 0x7d, 0xc8,
-	0xda, 0xc6, 0x1d, 0xcc, 0xac, 0xac, 0x0b, 0xe9, 0x2e, 0x37, 0x55, 0x2b, 0x20, 0xe9, 0x42, 0x2a,
-	0x92, 0x4a, 0xd5, 0x0a, 0x47, 0x7d, 0x2d, 0xfb, 0x0e, 0xa4, 0xdb, 0x9e, 0xef, 0x31, 0x8b, 0x2b,
-	0xd4, 0x39, 0x90, 0x60, 0x44, 0xa6, 0x94, 0x60, 0x44, 0xbd, 0x05, 0x66, 0x23, 0xd8, 0xe9, 0xa2,
-	0xe2, 0xac, 0x38, 0xe3, 0xea, 0xd4, 0x33, 0x8e, 0xfa, 0x5a, 0x7a, 0xc3, 0x27, 0x5d, 0xcc, 0xac,
-	0xd8, 0x83, 0xd7, 0x27, 0x50, 0x4c, 0x2f, 0x2b, 0x2b, 0x05, 0x89, 0x57, 0x01, 0x28, 0x51, 0x31,
-	0x23, 0x36, 0x94, 0x88, 0x4b, 0x61, 0x31, 0x1b, 0x4b, 0x21, 0x97, 0x68, 0x31, 0x17, 0x4b, 0x74,
-	0x7d, 0x8e, 0x23, 0xf1, 0xdd, 0xc3, 0xd5, 0x74, 0xa3, 0xb7, 0x05, 0x19, 0xd4, 0xbf, 0x4d, 0x82,
-	0xc2, 0x86, 0x6d, 0x23, 0x4a, 0xb7, 0x3d, 0xca, 0x1a, 0x3d, 0xf5, 0x5d, 0x90, 0xb5, 0xdb, 0xd0,
-	0xc3, 0x4d, 0xcf, 0x11, 0xd0, 0xe4, 0x6a, 0xe6, 0x69, 0xc9, 0x65, 0x36, 0xb9, 0xf1, 0x9d, 0xad,
-	0x67, 0x7d, 0x2d, 0x63, 0xc7, 0x4b, 0x4b, 0x2e, 0x9c, 0x11, 0xc6, 0x89, 0xa9, 0x18, 0x27, 0xff,
-	0x31, 0xc6, 0xa9, 0xd3, 0x31, 0x9e, 0x3d, 0x89, 0x71, 0xfa, 0xcc, 0x18, 0x67, 0xc6, 0x30, 0xde,
-	0x03, 0x59, 0x28, 0x80, 0x42, 0xb4, 0x98, 0x5d, 0x4e, 0xae, 0xe4, 0xab, 0x4b, 0xc6, 0xe8, 0x9e,
-	0x1a, 0x31, 0x88, 0x8d, 0x6e, 0xd0, 0x41, 0xb5, 0xe5, 0x47, 0x7d, 0x6d, 0xe6, 0x59, 0x5f, 0x03,
-	0x70, 0x88, 0xec, 0xd7, 0xbf, 0x6a, 0x60, 0x84, 0xb3, 0x35, 0x0c, 0x15, 0x53, 0x97, 0x9b, 0xa0,
-	0x0e, 0x4c, 0x50, 0x97, 0x9f, 0x46, 0xdd, 0x9f, 0x49, 0x50, 0xd8, 0x3a, 0xc4, 0xd0, 0xf7, 0xec,
-	0xdb, 0x08, 0xfd, 0x2f, 0xd4, 0xdd, 0x02, 0x79, 0x4e, 0x1d, 0xf3, 0x82, 0xa6, 0x0d, 0x83, 0x97,
-	0x93, 0xc7, 0x89, 0x6e, 0x78, 0xc1, 0x26, 0x0c, 0x06, 0xae, 0xfb, 0x08, 0x09, 0xd7, 0xd4, 0xdf,
-	0x71, 0xbd, 0x8d, 0x10, 0x77, 0x95, 0xc4, 0xcf, 0x9e, 0x4e, 0x7c, 0xfa, 0x24, 0xf1, 0x99, 0x33,
-	0x13, 0x9f, 0x9d, 0x42, 0x7c, 0xee, 0x3f, 0x26, 0x1e, 0x4c, 0x10, 0x9f, 0x9f, 0x20, 0xbe, 0x30,
-	0x8d, 0x78, 0x1d, 0x94, 0xeb, 0x3d, 0x86, 0x30, 0xf5, 0x08, 0x7e, 0x3f, 0x10, 0xe3, 0x78, 0x34,
-	0x65, 0xe5, 0xac, 0xfb, 0x4a, 0x01, 0x17, 0x27, 0xa6, 0xaf, 0x85, 0x68, 0x40, 0x30, 0x15, 0x25,
-	0x8a, 0x01, 0xaa, 0xc4, 0xf3, 0x51, 0xcc, 0xcc, 0x37, 0x40, 0xaa, 0x43, 0x5c, 0x5a, 0x4c, 0x88,
-	0xf2, 0xe6, 0xc7, 0xcb, 0xdb, 0x26, 0x6e, 0x2d, 0xc5, 0xcb, 0xb2, 0x84, 0x89, 0xba, 0x00, 0x92,
-	0x21, 0x62, 0x82, 0xfa, 0x82, 0xc5, 0x97, 0x6a, 0x09, 0x64, 0x23, 0xbf, 0x89, 0xc2, 0x90, 0x84,
-	0x72, 0xc2, 0x65, 0x22, 0xbf, 0xce, 0x45, 0xae, 0xe2, 0xa4, 0x77, 0x29, 0x72, 0x62, 0xfa, 0xac,
-	0x8c, 0x0b, 0xe9, 0x1e, 0x45, 0x8e, 0x4c, 0xf3, 0x13, 0x05, 0xcc, 0xef, 0x50, 0x77, 0x2f, 0x70,
-	0x20, 0x43, 0xbb, 0x30, 0x84, 0x3e, 0xe5, 0xf3, 0x01, 0x76, 0x59, 0x9b, 0x84, 0x1e, 0x3b, 0x94,
-	0x7d, 0x5c, 0xfc, 0xfe, 0xe1, 0xea, 0xa2, 0x7c, 0xc2, 0x36, 0x1c, 0x27, 0x44, 0x94, 0x7e, 0xc0,
-	0x42, 0x0f, 0xbb, 0xd6, 0xc8, 0x54, 0xbd, 0x0e, 0xd2, 0x81, 0x88, 0x20, 0x7a, 0x36, 0x5f, 0x55,
-	0xc7, 0xcb, 0x88, 0x63, 0xcb, 0x4a, 0xa4, 0xdd, 0xfa, 0xdc, 0x83, 0x3f, 0xbe, 0x79, 0x73, 0x14,
-	0x41, 0x2f, 0x81, 0xa5, 0x63, 0xc9, 0x0c, 0x50, 0xd3, 0x9f, 0x28, 0xe0, 0xfc, 0x0e, 0x75, 0x37,
-	0x43, 0x04, 0x19, 0xba, 0xdd, 0xc5, 0x0d, 0x72, 0x80, 0xb0, 0xba, 0x07, 0x00, 0x7f, 0x5f, 0x9a,
-	0x28, 0xb4, 0xab, 0xd7, 0x65, 0xae, 0x37, 0x1f, 0xf5, 0x35, 0xe5, 0x49, 0x5f, 0x33, 0x5c, 0x8f,
-	0xb5, 0xbb, 0x2d, 0xc3, 0x26, 0xbe, 0xf9, 0x9e, 0xd7, 0xf2, 0xc2, 0xae, 0xb8, 0x6f, 0x26, 0x16,
-	0x6b, 0x33, 0xaa, 0x9a, 0x3c, 0xbd, 0xfa, 0x9d, 0xdd, 0x1b, 0x37, 0x78, 0x49, 0x56, 0x8e, 0x47,
-	0xaa, 0xf3, 0x40, 0xea, 0x35, 0x30, 0x2f, 0xc2, 0xb6, 0x20, 0x3e, 0x68, 0x3a, 0x08, 0x13, 0x3f,
-	0x7e, 0x8b, 0xac, 0x73, 0x7c, 0xbb, 0x06, 0xf1, 0xc1, 0x16, 0xdf, 0x54, 0x5f, 0x01, 0x69, 0x8a,
-	0xb0, 0x83, 0xc2, 0xf8, 0x26, 0x5a, 0x52, 0x52, 0x0d, 0x70, 0x01, 0x76, 0x3a, 0xe4, 0xa3, 0xe6,
-	0x7d, 0x14, 0x92, 0xa6, 0x83, 0x6c, 0xcf, 0x87, 0x9d, 0x78, 0x72, 0x66, 0xad, 0xf3, 0x42, 0x75,
-	0x0f, 0x85, 0x64, 0x4b, 0x2a, 0xf4, 0x16, 0x28, 0x9d, 0xa8, 0x6d, 0xd8, 0x2f, 0x75, 0xb0, 0xb0,
-	0xdf, 0xc5, 0x8c, 0xef, 0x35, 0x7d, 0x18, 0x04, 0x1e, 0x76, 0x87, 0x2f, 0xf8, 0x18, 0xc0, 0x03,
-	0x3f, 0x09, 0xf1, 0xfc, 0xc0, 0x67, 0x27, 0x76, 0xd1, 0x7f, 0x52, 0xc0, 0x05, 0x7e, 0x08, 0xc1,
-	0x11, 0x0a, 0xd9, 0x26, 0xf1, 0x70, 0x83, 0xd4, 0x23, 0x5f, 0xbd, 0x0b, 0xf2, 0x8c, 0x34, 0x11,
-	0x6b, 0x37, 0xa1, 0xe3, 0x84, 0x63, 0x18, 0xce, 0x9c, 0x05, 0x43, 0x46, 0xea, 0xac, 0xcd, 0x97,
-	0x63, 0xd8, 0x24, 0x26, 0xb0, 0xd9, 0x05, 0x39, 0x01, 0x2b, 0xff, 0x42, 0x09, 0xd8, 0xf2, 0xd5,
-	0x92, 0x21, 0x5b, 0x8b, 0xff, 0xb1, 0x0c, 0xf9, 0xc7, 0x32, 0x78, 0x8a, 0xb5, 0x22, 0x4f, 0xe4,
-	0x79, 0x5f, 0x5b, 0x38, 0x84, 0x7e, 0x67, 0x5d, 0x1f, 0x7a, 0xea, 0x56, 0x96, 0xaf, 0xb9, 0x8d,
-	0x7e, 0x05, 0x5c, 0x7a, 0x41, 0x61, 0xc3, 0xce, 0xf9, 0x71, 0xa2, 0xf0, 0x7a, 0xe4, 0x37, 0x08,
-	0x37, 0x1a, 0x4b, 0x50, 0x99, 0x48, 0x70, 0x0f, 0x00, 0xd1, 0x4e, 0x31, 0x1e, 0x89, 0x7f, 0x87,
-	0x87, 0x88, 0x24, 0xf0, 0xb8, 0x01, 0xd2, 0x50, 0x8c, 0x3a, 0x39, 0xb5, 0xaf, 0xc8, 0x90, 0x53,
-	0xc6, 0xaf, 0x34, 0x56, 0x97, 0x40, 0x86, 0x91, 0x38, 0x95, 0xf8, 0x6e, 0xa7, 0x19, 0xe1, 0xf1,
-	0x26, 0xab, 0x1e, 0x56, 0x35, 0xa8, 0xba, 0xfa, 0x4b, 0x12, 0x24, 0x77, 0xa8, 0xab, 0x62, 0x00,
-	0xc6, 0x7e, 0x80, 0xa5, 0xf1, 0x8e, 0x99, 0x18, 0x4f, 0xe5, 0x57, 0xa7, 0xaa, 0x86, 0x48, 0xea,
-	0x0f, 0x7e, 0xf8, 0xfd, 0x8b, 0xc4, 0x65, 0xbd, 0x3c, 0xa8, 0x77, 0xf0, 0x85, 0x95, 0xa6, 0x4d,
-	0xd6, 0x53, 0x77, 0x41, 0x61, 0x62, 0x98, 0x5c, 0x3a, 0x16, 0x76, 0x5c, 0x59, 0xbe, 0x7a, 0x8a,
-	0x72, 0xd8, 0xff, 0x77, 0xc1, 0xdc, 0xb1, 0x5b, 0x7f, 0xe5, 0x98, 0xdb, 0xa4, 0xba, 0xfc, 0xda,
-	0xa9, 0xea, 0x61, 0xdc, 0x0f, 0xc1, 0xc2, 0x89, 0xcb, 0xa0, 0x1d, 0x77, 0x3d, 0x66, 0x50, 0x7e,
-	0xfd, 0x25, 0x06, 0x2f, 0x88, 0x3e, 0xea, 0xb8, 0x29, 0xd1, 0x87, 0x06, 0xd3, 0xa2, 0x9f, 0x60,
-	0xb7, 0xf6, 0xf6, 0xa3, 0xa3, 0x8a, 0xf2, 0xf8, 0xa8, 0xa2, 0xfc, 0x76, 0x54, 0x51, 0x3e, 0x7b,
-	0x5a, 0x99, 0x79, 0xfc, 0xb4, 0x32, 0xf3, 0xf3, 0xd3, 0xca, 0xcc, 0xbd, 0x6b, 0x2f, 0xed, 0xd0,
-	0x1e, 0xa7, 0xad, 0x95, 0x16, 0xbf, 0xfe, 0xb7, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x34,
-	0x1c, 0x9c, 0x00, 0x0d, 0x00, 0x00,
+	// 1627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0xdb, 0xc8,
+	0x15, 0x36, 0x25, 0x59, 0x3f, 0x9e, 0x1c, 0xc7, 0xa1, 0x9d, 0x8d, 0xa4, 0xc4, 0xa2, 0x97, 0x8b,
+	0xdd, 0x55, 0xb7, 0x08, 0x99, 0xa8, 0xc8, 0x02, 0x6b, 0xf4, 0x50, 0xcb, 0x56, 0x8a, 0x14, 0x71,
+	0x6b, 0x30, 0xf2, 0x1e, 0x16, 0x05, 0x88, 0x11, 0x39, 0xa1, 0x88, 0x88, 0x1c, 0x82, 0x33, 0x52,
+	0xe4, 0x3d, 0xe6, 0x54, 0xa0, 0x28, 0xda, 0xa2, 0xf7, 0xa2, 0x87, 0x9e, 0x7a, 0xea, 0x61, 0x0f,
+	0xfd, 0x13, 0x82, 0x9e, 0x16, 0x6d, 0x81, 0xb6, 0x7b, 0x50, 0x0b, 0xa7, 0x40, 0x81, 0x1c, 0xf7,
+	0xd0, 0x73, 0x31, 0xc3, 0x11, 0xf5, 0xc3, 0x92, 0x9d, 0xa6, 0x45, 0x6f, 0x33, 0xf3, 0xbe, 0xf7,
+	0xe6, 0xbd, 0xef, 0x9b, 0x79, 0x43, 0xc2, 0x36, 0x66, 0x3d, 0x13, 0x0f, 0x03, 0x73, 0x78, 0xdf,
+	0x64, 0x23, 0x23, 0x8a, 0x09, 0x23, 0x2a, 0x60, 0xd6, 0x33, 0xf0, 0x30, 0x30, 0x86, 0xf7, 0x6b,
+	0x75, 0x87, 0xd0, 0x80, 0x50, 0xb3, 0x8b, 0x28, 0x36, 0x87, 0xf7, 0xbb, 0x98, 0xa1, 0xfb, 0xa6,
+	0x43, 0xfc, 0x30, 0xc1, 0xd6, 0x6e, 0x49, 0x7b, 0x40, 0x3d, 0x1e, 0x23, 0xa0, 0x9e, 0x34, 0x54,
+	0x13, 0x83, 0x2d, 0x66, 0x66, 0x32, 0x91, 0xa6, 0x9d, 0x99, 0x4d, 0xf9, 0x36, 0x72, 0xd5, 0x23,
+	0x1e, 0x49, 0xd0, 0x7c, 0x24, 0x57, 0xef, 0x78, 0x84, 0x78, 0x7d, 0x6c, 0xa2, 0xc8, 0x37, 0x51,
+	0x18, 0x12, 0x86, 0x98, 0x4f, 0xc2, 0x49, 0xa4, 0xaa, 0xb4, 0x8a, 0x59, 0x77, 0xf0, 0xd4, 0x44,
+	0xe1, 0x59, 0x62, 0xd2, 0x7f, 0xaa, 0xc0, 0xb5, 0x63, 0xea, 0xb5, 0x59, 0x0f, 0xc7, 0x78, 0x10,
+	0x74, 0x46, 0x6a, 0x03, 0x72, 0x2e, 0x62, 0xa8, 0xa2, 0xec, 0x29, 0x8d, 0x72, 0x73, 0xc7, 0x48,
+	0x7c, 0x8d, 0x89, 0xaf, 0x71, 0x10, 0x9e, 0x59, 0x02, 0xa1, 0x56, 0x21, 0x47, 0xfd, 0xcf, 0x71,
+	0x25, 0xb3, 0xa7, 0x34, 0x94, 0xd6, 0xfa, 0xeb, 0xb1, 0xa6, 0xdc, 0xb5, 0xc4, 0x92, 0xaa, 0x41,
+	0xae, 0x87, 0x68, 0xaf, 0x92, 0xdd, 0x53, 0x1a, 0xa5, 0x56, 0xf9, 0xeb, 0xb1, 0x56, 0x88, 0xfb,
+	0xd1, 0xbe, 0x7e, 0x57, 0xb7, 0x84, 0x41, 0x55, 0x21, 0xf7, 0x34, 0x26, 0x41, 0x25, 0xc7, 0x01,
+	0x96, 0x18, 0xef, 0xe7, 0x7e, 0xf4, 0x2b, 0x6d, 0x4d, 0xff, 0x79, 0x06, 0x8a, 0x8f, 0xb1, 0x87,
+	0x9c, 0xb3, 0xce, 0x48, 0xdd, 0x81, 0xf5, 0x90, 0x84, 0x0e, 0x16, 0xd9, 0xe4, 0xac, 0x64, 0xa2,
+	0x7e, 0x0c, 0x25, 0x0f, 0x71, 0xce, 0x7c, 0x27, 0xd9, 0xbd, 0xd4, 0xaa, 0x7e, 0x35, 0xd6, 0x6e,
+	0x26, 0xf4, 0x51, 0xf7, 0x99, 0xe1, 0x13, 0x33, 0x40, 0xac, 0x67, 0x3c, 0x0a, 0x99, 0x55, 0xf4,
+	0x10, 0x3d, 0xe1, 0x50, 0xb5, 0x0e, 0x59, 0x0f, 0x51, 0x91, 0x54, 0xae, 0xb5, 0x71, 0x3e, 0xd6,
+	0x8a, 0xdf, 0x45, 0xf4, 0xb1, 0x1f, 0xf8, 0xcc, 0xe2, 0x06, 0x75, 0x13, 0x32, 0x8c, 0xc8, 0x94,
+	0x32, 0x8c, 0xa8, 0x9f, 0xc0, 0xfa, 0x10, 0xf5, 0x07, 0xb8, 0xb2, 0x2e, 0xf6, 0x78, 0x6f, 0xe5,
+	0x1e, 0xe7, 0x63, 0x2d, 0x7f, 0x10, 0x90, 0x41, 0xc8, 0xac, 0xc4, 0x83, 0xd7, 0x27, 0x58, 0xcc,
+	0xef, 0x29, 0x8d, 0x0d, 0xc9, 0xd7, 0x06, 0x28, 0xc3, 0x4a, 0x41, 0x2c, 0x28, 0x43, 0x3e, 0x8b,
+	0x2b, 0xc5, 0x64, 0x16, 0xf3, 0x19, 0xad, 0x94, 0x92, 0x19, 0xdd, 0xdf, 0xe4, 0x4c, 0xfc, 0xfe,
+	0x8b, 0xbb, 0xf9, 0xce, 0xe8, 0x08, 0x31, 0xa4, 0xff, 0x2e, 0x0b, 0x1b, 0x07, 0x8e, 0x83, 0x29,
+	0x7d, 0xec, 0x53, 0xd6, 0x19, 0xa9, 0xdf, 0x83, 0xa2, 0xd3, 0x43, 0x7e, 0x68, 0xfb, 0xae, 0xa0,
+	0xa6, 0xd4, 0x32, 0x2f, 0x4b, 0xae, 0x70, 0xc8, 0xc1, 0x8f, 0x8e, 0x5e, 0x8f, 0xb5, 0x82, 0x93,
+	0x0c, 0x2d, 0x39, 0x70, 0xa7, 0x1c, 0x67, 0x56, 0x72, 0x9c, 0xfd, 0x8f, 0x39, 0xce, 0x5d, 0xce,
+	0xf1, 0xfa, 0x45, 0x8e, 0xf3, 0x6f, 0xcd, 0x71, 0x61, 0x86, 0xe3, 0x53, 0x28, 0x22, 0x41, 0x14,
+	0xa6, 0x95, 0xe2, 0x5e, 0xb6, 0x51, 0x6e, 0xde, 0x32, 0xa6, 0xf7, 0xd4, 0x48, 0x48, 0xec, 0x0c,
+	0xa2, 0x3e, 0x6e, 0xed, 0xbd, 0x1c, 0x6b, 0x6b, 0xaf, 0xc7, 0x1a, 0xa0, 0x94, 0xd9, 0xdf, 0xfc,
+	0x4d, 0x83, 0x29, 0xcf, 0x56, 0x1a, 0x2a, 0x91, 0xae, 0x34, 0x27, 0x1d, 0xcc, 0x49, 0x57, 0x5e,
+	0x25, 0xdd, 0xbf, 0xb2, 0xb0, 0x71, 0x74, 0x16, 0xa2, 0xc0, 0x77, 0x1e, 0x62, 0xfc, 0x7f, 0x91,
+	0xee, 0x13, 0x28, 0x73, 0xe9, 0x98, 0x1f, 0xd9, 0x0e, 0x8a, 0xae, 0x16, 0x8f, 0x0b, 0xdd, 0xf1,
+	0xa3, 0x43, 0x14, 0x4d, 0x5c, 0x9f, 0x62, 0x2c, 0x5c, 0x73, 0x6f, 0xe2, 0xfa, 0x10, 0x63, 0xee,
+	0x2a, 0x85, 0x5f, 0xbf, 0x5c, 0xf8, 0xfc, 0x45, 0xe1, 0x0b, 0x6f, 0x2d, 0x7c, 0x71, 0x85, 0xf0,
+	0xa5, 0xff, 0xb1, 0xf0, 0x30, 0x27, 0x7c, 0x79, 0x4e, 0xf8, 0x8d, 0x55, 0xc2, 0xeb, 0x50, 0x6b,
+	0x8f, 0x18, 0x0e, 0xa9, 0x4f, 0xc2, 0x1f, 0x44, 0xa2, 0x1d, 0x4f, 0xbb, 0xac, 0xec, 0x75, 0xbf,
+	0x56, 0xe0, 0xe6, 0x5c, 0xf7, 0xb5, 0x30, 0x8d, 0x48, 0x48, 0x45, 0x89, 0xa2, 0x81, 0x2a, 0x49,
+	0x7f, 0xe4, 0x63, 0xf5, 0x1b, 0x90, 0xeb, 0x13, 0x8f, 0x56, 0x32, 0xa2, 0xbc, 0xeb, 0xb3, 0xe5,
+	0x3d, 0x26, 0x5e, 0x2b, 0xc7, 0xcb, 0xb2, 0x04, 0x44, 0xdd, 0x82, 0x6c, 0x8c, 0x99, 0x90, 0x7e,
+	0xc3, 0xe2, 0x43, 0xb5, 0x0a, 0xc5, 0x61, 0x60, 0xe3, 0x38, 0x26, 0xb1, 0xec, 0x70, 0x85, 0x61,
+	0xd0, 0xe6, 0x53, 0x6e, 0xe2, 0xa2, 0x0f, 0x28, 0x76, 0x13, 0xf9, 0xac, 0x82, 0x87, 0xe8, 0x29,
+	0xc5, 0xae, 0x4c, 0xf3, 0xc7, 0x0a, 0x5c, 0x3f, 0xa6, 0xde, 0x69, 0xe4, 0x22, 0x86, 0x4f, 0x50,
+	0x8c, 0x02, 0xca, 0xfb, 0x03, 0x1a, 0xb0, 0x1e, 0x89, 0x7d, 0x76, 0x26, 0xcf, 0x71, 0xe5, 0x0f,
+	0x5f, 0xdc, 0xdd, 0x91, 0x4f, 0xd8, 0x81, 0xeb, 0xc6, 0x98, 0xd2, 0x27, 0x2c, 0xf6, 0x43, 0xcf,
+	0x9a, 0x42, 0xd5, 0x7b, 0x90, 0x8f, 0x44, 0x04, 0x71, 0x66, 0xcb, 0x4d, 0x75, 0xb6, 0x8c, 0x24,
+	0xb6, 0xac, 0x44, 0xe2, 0xf6, 0x37, 0x5f, 0xfc, 0xf3, 0xb7, 0x1f, 0x4d, 0x23, 0xe8, 0x55, 0xb8,
+	0xb5, 0x90, 0xcc, 0x84, 0x35, 0xfd, 0x97, 0x19, 0xb8, 0x71, 0x4c, 0xbd, 0xc3, 0x18, 0x23, 0x86,
+	0x1f, 0x0e, 0xc2, 0x0e, 0x79, 0x86, 0x43, 0xf5, 0x14, 0x80, 0xbf, 0x2f, 0x36, 0x8e, 0x9d, 0xe6,
+	0x3d, 0x99, 0xeb, 0xc7, 0x2f, 0xc7, 0x9a, 0xf2, 0xd5, 0x58, 0x33, 0x3c, 0x9f, 0xf5, 0x06, 0x5d,
+	0xc3, 0x21, 0x81, 0xf9, 0x7d, 0xbf, 0xeb, 0xc7, 0x03, 0x71, 0xdf, 0xcc, 0x50, 0x8c, 0xcd, 0x61,
+	0xd3, 0xe4, 0xe9, 0xb5, 0x1f, 0x9d, 0x3c, 0x78, 0xc0, 0x4b, 0xb2, 0x4a, 0x3c, 0x52, 0x9b, 0x07,
+	0x52, 0x3f, 0x80, 0xeb, 0x22, 0x6c, 0x17, 0x85, 0xcf, 0x6c, 0x17, 0x87, 0x24, 0x48, 0xde, 0x22,
+	0xeb, 0x1a, 0x5f, 0x6e, 0xa1, 0xf0, 0xd9, 0x11, 0x5f, 0x54, 0xdf, 0x81, 0x3c, 0xc5, 0xa1, 0x8b,
+	0xe3, 0xe4, 0x26, 0x5a, 0x72, 0xa6, 0x1a, 0xb0, 0x8d, 0xfa, 0x7d, 0xf2, 0xdc, 0xfe, 0x1c, 0xc7,
+	0xc4, 0x76, 0xb1, 0xe3, 0x07, 0xa8, 0x9f, 0x74, 0xce, 0xa2, 0x75, 0x43, 0x98, 0x3e, 0xc3, 0x31,
+	0x39, 0x92, 0x06, 0xf5, 0x36, 0x88, 0xcd, 0x6d, 0xe7, 0x79, 0xf3, 0x9e, 0x6c, 0xa0, 0x45, 0xbe,
+	0x70, 0xf8, 0xbc, 0x79, 0x4f, 0x7d, 0x1f, 0x36, 0x27, 0x11, 0x6c, 0xea, 0xa0, 0x7e, 0xd2, 0x4f,
+	0xaf, 0x59, 0xd7, 0x26, 0xab, 0x4f, 0xf8, 0xa2, 0xde, 0x85, 0xea, 0x05, 0x7e, 0xd2, 0x33, 0xd7,
+	0x86, 0xad, 0xa7, 0x83, 0x90, 0xf1, 0x35, 0x3b, 0x40, 0x51, 0xe4, 0x87, 0x5e, 0xfa, 0x15, 0x30,
+	0x23, 0xd2, 0xc4, 0x4f, 0xca, 0x74, 0x7d, 0xe2, 0x73, 0x9c, 0xb8, 0xe8, 0x7f, 0x56, 0x60, 0x9b,
+	0x6f, 0x42, 0xc2, 0x21, 0x8e, 0xd9, 0x21, 0xf1, 0xc3, 0x0e, 0x69, 0x0f, 0x03, 0xf5, 0x53, 0x28,
+	0x33, 0x62, 0x63, 0xd6, 0xb3, 0x91, 0xeb, 0xc6, 0x33, 0x3a, 0xac, 0xbd, 0x8d, 0x0e, 0x8c, 0xb4,
+	0x59, 0x8f, 0x0f, 0x67, 0xf8, 0xcd, 0xcc, 0xf1, 0x7b, 0x02, 0x25, 0x21, 0x0d, 0xff, 0x0c, 0x13,
+	0xd4, 0x97, 0x9b, 0x55, 0x43, 0x1e, 0x4f, 0xfe, 0x9d, 0x66, 0xc8, 0xef, 0x34, 0x83, 0xa7, 0xd8,
+	0xaa, 0xf0, 0x44, 0xbe, 0x1e, 0x6b, 0x5b, 0x67, 0x28, 0xe8, 0xef, 0xeb, 0xa9, 0xa7, 0x6e, 0x15,
+	0xf9, 0x98, 0x63, 0xf4, 0x5d, 0xb8, 0xbd, 0xa4, 0xb0, 0xf4, 0xf4, 0xfd, 0x69, 0xae, 0xf0, 0xf6,
+	0x30, 0xe8, 0x10, 0x0e, 0x9a, 0x49, 0x50, 0x99, 0x4b, 0xf0, 0x14, 0x40, 0x1c, 0xc9, 0x84, 0x8f,
+	0xcc, 0x7f, 0xc7, 0x87, 0x88, 0x24, 0xf8, 0x78, 0x00, 0x79, 0x24, 0xda, 0xa5, 0xec, 0xfc, 0xbb,
+	0x32, 0xe4, 0x8a, 0x16, 0x2e, 0xc1, 0xea, 0x2d, 0x28, 0x30, 0x92, 0xa4, 0x92, 0xf4, 0x87, 0x3c,
+	0x23, 0x3c, 0xde, 0x7c, 0xd5, 0x69, 0x55, 0x69, 0xd5, 0x2f, 0x14, 0x71, 0x1f, 0x9f, 0x60, 0x96,
+	0x1e, 0x28, 0xc4, 0xb0, 0x68, 0xfc, 0x2b, 0x2b, 0x6f, 0x01, 0xc4, 0x88, 0x61, 0xbb, 0xcf, 0x51,
+	0xb2, 0x11, 0xec, 0x2e, 0x3b, 0x63, 0x69, 0x28, 0x79, 0xd8, 0x4a, 0xf1, 0x64, 0x61, 0xbf, 0xcc,
+	0xdb, 0x82, 0x0c, 0xa8, 0xbf, 0x0b, 0xda, 0x8a, 0x1c, 0xd2, 0x3c, 0x63, 0xd8, 0x99, 0x87, 0x9c,
+	0x20, 0xde, 0xf1, 0x56, 0xe6, 0xb8, 0x0b, 0x70, 0xe1, 0x66, 0x97, 0xba, 0xb3, 0xb7, 0x3a, 0x12,
+	0x01, 0x04, 0xcb, 0x45, 0x4b, 0xce, 0xe6, 0xd3, 0xaa, 0xc3, 0x9d, 0x65, 0x7b, 0xa6, 0x39, 0xfd,
+	0x55, 0x81, 0x77, 0x12, 0xc0, 0x11, 0x8e, 0xfa, 0xe4, 0xec, 0x04, 0xc7, 0x81, 0x4f, 0xf9, 0x8b,
+	0xb1, 0x32, 0xad, 0x6f, 0x03, 0x44, 0x29, 0x4a, 0xa4, 0xb5, 0xd9, 0xbc, 0x33, 0x4b, 0xdd, 0x62,
+	0x24, 0x6b, 0x06, 0xaf, 0x36, 0x60, 0x0b, 0xb9, 0xae, 0xcd, 0x85, 0xe6, 0xfd, 0xa5, 0xef, 0x53,
+	0x7e, 0x4a, 0xb2, 0x8d, 0x92, 0xb5, 0x89, 0x5c, 0xb7, 0x43, 0x0e, 0x26, 0xab, 0x6a, 0x13, 0x6e,
+	0xc6, 0x38, 0x20, 0x43, 0x6c, 0x8b, 0xa6, 0x33, 0x85, 0xe7, 0x04, 0x7c, 0x3b, 0x31, 0x3e, 0x8c,
+	0x49, 0x90, 0xfa, 0xcc, 0xd7, 0xbe, 0x07, 0xf5, 0xe5, 0xa5, 0x4d, 0xaa, 0x6f, 0xfe, 0x24, 0x0f,
+	0xd9, 0x63, 0xea, 0xa9, 0x21, 0xc0, 0xcc, 0xff, 0x47, 0x75, 0xb6, 0x98, 0xb9, 0xc7, 0xb1, 0xf6,
+	0xee, 0x4a, 0x53, 0xca, 0xa8, 0xfe, 0xe2, 0x8f, 0xff, 0xf8, 0x45, 0xe6, 0x8e, 0x5e, 0x9b, 0xdc,
+	0x94, 0xc9, 0x0f, 0x94, 0x84, 0xda, 0x6c, 0xa4, 0x9e, 0xc0, 0xc6, 0xdc, 0x53, 0x76, 0x7b, 0x21,
+	0xec, 0xac, 0xb1, 0xf6, 0xde, 0x25, 0xc6, 0xb4, 0x73, 0x7e, 0x0a, 0x9b, 0x0b, 0x6f, 0xce, 0xee,
+	0x82, 0xdb, 0xbc, 0xb9, 0xf6, 0xfe, 0xa5, 0xe6, 0x34, 0xee, 0x0f, 0x61, 0xeb, 0x42, 0x1b, 0xd5,
+	0x16, 0x5d, 0x17, 0x00, 0xb5, 0x0f, 0xaf, 0x00, 0x2c, 0x89, 0x3e, 0xed, 0x55, 0x2b, 0xa2, 0xa7,
+	0x80, 0xda, 0x87, 0x57, 0x00, 0xd2, 0xe8, 0x3d, 0xd8, 0x59, 0xda, 0x13, 0x16, 0x09, 0x5d, 0x06,
+	0xaa, 0x7d, 0xf3, 0x0d, 0x40, 0xe9, 0x4e, 0x36, 0xdc, 0xb8, 0x78, 0xad, 0xf7, 0x56, 0x47, 0x48,
+	0x10, 0xb5, 0xc6, 0x55, 0x88, 0x74, 0x03, 0x0c, 0xdb, 0xcb, 0xae, 0xa8, 0x7e, 0x31, 0xc0, 0x22,
+	0xa6, 0xf6, 0xd1, 0xd5, 0x98, 0xc9, 0x36, 0xad, 0xef, 0xbc, 0x3c, 0xaf, 0x2b, 0x5f, 0x9e, 0xd7,
+	0x95, 0xbf, 0x9f, 0xd7, 0x95, 0x9f, 0xbd, 0xaa, 0xaf, 0x7d, 0xf9, 0xaa, 0xbe, 0xf6, 0x97, 0x57,
+	0xf5, 0xb5, 0xcf, 0x3e, 0xb8, 0xf2, 0x35, 0x18, 0xf1, 0x83, 0xde, 0xcd, 0x8b, 0xbf, 0xf4, 0x6f,
+	0xfd, 0x7b, 0x00, 0x76, 0xe6, 0x0c, 0xaf, 0xb0, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -926,6 +1279,17 @@ type MsgClient interface {
 	// ConvertEvmToCoin: Sends an ERC20 token with a valid "FunToken" mapping to the
 	// given recipient address as a bank coin.
 	ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoin, opts ...grpc.CallOption) (*MsgConvertEvmToCoinResponse, error)
+	// SetFunTokenRateLimit: Sets or removes the rate limit on conversions of a
+	// "FunToken" mapping. Only callable by governance or the sudoers of the
+	// x/sudo module.
+	SetFunTokenRateLimit(ctx context.Context, in *MsgSetFunTokenRateLimit, opts ...grpc.CallOption) (*MsgSetFunTokenRateLimitResponse, error)
+	// SetFunTokenPaused: Pauses or unpauses all conversions of a "FunToken"
+	// mapping. Only callable by governance or the sudoers of the x/sudo module.
+	SetFunTokenPaused(ctx context.Context, in *MsgSetFunTokenPaused, opts ...grpc.CallOption) (*MsgSetFunTokenPausedResponse, error)
+	// SetDeployPermission: Sets which accounts can deploy contracts to the EVM
+	// and edits the deploy allowlist. Only callable by governance or the sudoers
+	// of the x/sudo module.
+	SetDeployPermission(ctx context.Context, in *MsgSetDeployPermission, opts ...grpc.CallOption) (*MsgSetDeployPermissionResponse, error)
 }
 
 type msgClient struct {
@@ -981,6 +1345,33 @@ func (c *msgClient) ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoi
 	return out, nil
 }
 
+func (c *msgClient) SetFunTokenRateLimit(ctx context.Context, in *MsgSetFunTokenRateLimit, opts ...grpc.CallOption) (*MsgSetFunTokenRateLimitResponse, error) {
+	out := new(MsgSetFunTokenRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/SetFunTokenRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFunTokenPaused(ctx context.Context, in *MsgSetFunTokenPaused, opts ...grpc.CallOption) (*MsgSetFunTokenPausedResponse, error) {
+	out := new(MsgSetFunTokenPausedResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/SetFunTokenPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDeployPermission(ctx context.Context, in *MsgSetDeployPermission, opts ...grpc.CallOption) (*MsgSetDeployPermissionResponse, error) {
+	out := new(MsgSetDeployPermissionResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/SetDeployPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -1000,6 +1391,17 @@ type MsgServer interface {
 	// ConvertEvmToCoin: Sends an ERC20 token with a valid "FunToken" mapping to the
 	// given recipient address as a bank coin.
 	ConvertEvmToCoin(context.Context, *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error)
+	// SetFunTokenRateLimit: Sets or removes the rate limit on conversions of a
+	// "FunToken" mapping. Only callable by governance or the sudoers of the
+	// x/sudo module.
+	SetFunTokenRateLimit(context.Context, *MsgSetFunTokenRateLimit) (*MsgSetFunTokenRateLimitResponse, error)
+	// SetFunTokenPaused: Pauses or unpauses all conversions of a "FunToken"
+	// mapping. Only callable by governance or the sudoers of the x/sudo module.
+	SetFunTokenPaused(context.Context, *MsgSetFunTokenPaused) (*MsgSetFunTokenPausedResponse, error)
+	// SetDeployPermission: Sets which accounts can deploy contracts to the EVM
+	// and edits the deploy allowlist. Only callable by governance or the sudoers
+	// of the x/sudo module.
+	SetDeployPermission(context.Context, *MsgSetDeployPermission) (*MsgSetDeployPermissionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1021,6 +1423,15 @@ func (*UnimplementedMsgServer) ConvertCoinToEvm(ctx context.Context, req *MsgCon
 func (*UnimplementedMsgServer) ConvertEvmToCoin(ctx context.Context, req *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertEvmToCoin not implemented")
 }
+func (*UnimplementedMsgServer) SetFunTokenRateLimit(ctx context.Context, req *MsgSetFunTokenRateLimit) (*MsgSetFunTokenRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFunTokenRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) SetFunTokenPaused(ctx context.Context, req *MsgSetFunTokenPaused) (*MsgSetFunTokenPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFunTokenPaused not implemented")
+}
+func (*UnimplementedMsgServer) SetDeployPermission(ctx context.Context, req *MsgSetDeployPermission) (*MsgSetDeployPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDeployPermission not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1116,6 +1527,60 @@ func _Msg_ConvertEvmToCoin_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetFunTokenRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFunTokenRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFunTokenRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/SetFunTokenRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFunTokenRateLimit(ctx, req.(*MsgSetFunTokenRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFunTokenPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFunTokenPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFunTokenPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/SetFunTokenPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFunTokenPaused(ctx, req.(*MsgSetFunTokenPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDeployPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDeployPermission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDeployPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/SetDeployPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDeployPermission(ctx, req.(*MsgSetDeployPermission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "eth.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1140,6 +1605,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertEvmToCoin",
 			Handler:    _Msg_ConvertEvmToCoin_Handler,
 		},
+		{
+			MethodName: "SetFunTokenRateLimit",
+			Handler:    _Msg_SetFunTokenRateLimit_Handler,
+		},
+		{
+			MethodName: "SetFunTokenPaused",
+			Handler:    _Msg_SetFunTokenPaused_Handler,
+		},
+		{
+			MethodName: "SetDeployPermission",
+			Handler:    _Msg_SetDeployPermission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/tx.proto",
@@ -1709,6 +2186,18 @@ func (m *MsgCreateFunToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DecimalsScale != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DecimalsScale))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.FromCw20) > 0 {
+		i -= len(m.FromCw20)
+		copy(dAtA[i:], m.FromCw20)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromCw20)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.AllowZeroDecimals {
 		i--
 		if m.AllowZeroDecimals {
@@ -1934,7 +2423,216 @@ func (m *MsgConvertEvmToCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+func (m *MsgSetFunTokenRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFunTokenRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFunTokenRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFunTokenRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFunTokenRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFunTokenRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFunTokenPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFunTokenPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFunTokenPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFunTokenPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFunTokenPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFunTokenPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDeployPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDeployPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDeployPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveFromAllowlist) > 0 {
+		for iNdEx := len(m.RemoveFromAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveFromAllowlist[iNdEx])
+			copy(dAtA[i:], m.RemoveFromAllowlist[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveFromAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddToAllowlist) > 0 {
+		for iNdEx := len(m.AddToAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddToAllowlist[iNdEx])
+			copy(dAtA[i:], m.AddToAllowlist[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddToAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Permission != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDeployPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDeployPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDeployPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
 	for v >= 1<<7 {
@@ -2206,6 +2904,13 @@ func (m *MsgCreateFunToken) Size() (n int) {
 	if m.AllowZeroDecimals {
 		n += 2
 	}
+	l = len(m.FromCw20)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DecimalsScale != 0 {
+		n += 1 + sovTx(uint64(m.DecimalsScale))
+	}
 	return n
 }
 
@@ -2276,6 +2981,96 @@ func (m *MsgConvertEvmToCoinResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetFunTokenRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RateLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetFunTokenRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFunTokenPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetFunTokenPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDeployPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovTx(uint64(m.Permission))
+	}
+	if len(m.AddToAllowlist) > 0 {
+		for _, s := range m.AddToAllowlist {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveFromAllowlist) > 0 {
+		for _, s := range m.RemoveFromAllowlist {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetDeployPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4168,13 +4963,64 @@ func (m *MsgCreateFunToken) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowZeroDecimals = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCw20", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromCw20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalsScale", wireType)
+			}
+			m.DecimalsScale = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecimalsScale |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
 			if (iNdEx + skippy) > l {
@@ -4703,6 +5549,570 @@ func (m *MsgConvertEvmToCoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetFunTokenRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFunTokenRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFunTokenRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFunTokenRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFunTokenRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFunTokenRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFunTokenPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFunTokenPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFunTokenPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFunTokenPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFunTokenPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFunTokenPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDeployPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDeployPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDeployPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= DeployPermission(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddToAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddToAllowlist = append(m.AddToAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveFromAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveFromAllowlist = append(m.RemoveFromAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDeployPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDeployPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDeployPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
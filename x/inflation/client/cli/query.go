@@ -2,7 +2,10 @@ package cli
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -27,7 +30,9 @@ func GetQueryCmd() *cobra.Command {
 		GetEpochMintProvision(),
 		GetSkippedEpochs(),
 		GetCirculatingSupply(),
+		GetCirculatingSupplyBreakdown(),
 		GetInflationRate(),
+		GetInflationSchedule(),
 		GetParams(),
 	)
 
@@ -153,6 +158,36 @@ func GetCirculatingSupply() *cobra.Command {
 	return cmd
 }
 
+// GetCirculatingSupplyBreakdown implements a command to return the total
+// supply together with the amounts excluded from the circulating supply
+func GetCirculatingSupplyBreakdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circulating-supply-breakdown",
+		Short: "Query the total supply and the amounts excluded from circulation",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCirculatingSupplyBreakdownRequest{}
+			res, err := queryClient.CirculatingSupplyBreakdown(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetInflationRate implements a command to return the inflation rate in %
 func GetInflationRate() *cobra.Command {
 	cmd := &cobra.Command{
@@ -211,3 +246,67 @@ func GetParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetInflationSchedule implements a command to return the projected inflation
+// schedule, optionally as CSV
+func GetInflationSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Query the projected inflation schedule of the next periods",
+		Long: strings.TrimSpace(`
+Query the projected inflation schedule of the next periods, starting with the
+current one. Each period reports the amount minted per epoch, the amount left
+to mint in the period, the projected total supply at its end, and the
+annualized inflation rate in percent.
+
+--periods: the number of periods to project. Zero projects every period until
+the max period.
+--csv: print the schedule as CSV
+
+$ nibid q inflation schedule --periods 24 --csv
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			numPeriods, _ := cmd.Flags().GetUint64("periods")
+			req := &types.QueryInflationScheduleRequest{NumPeriods: numPeriods}
+			res, err := queryClient.InflationSchedule(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			if asCSV, _ := cmd.Flags().GetBool("csv"); !asCSV {
+				return clientCtx.PrintProto(res)
+			}
+
+			w := csv.NewWriter(cmd.OutOrStdout())
+			records := [][]string{{
+				"period", "num_epochs", "epoch_mint_provision", "period_mint",
+				"cumulative_supply", "annualized_rate",
+			}}
+			for _, p := range res.Periods {
+				records = append(records, []string{
+					strconv.FormatUint(p.Period, 10),
+					strconv.FormatUint(p.NumEpochs, 10),
+					p.EpochMintProvision.String(),
+					p.PeriodMint.String(),
+					p.CumulativeSupply.String(),
+					p.AnnualizedRate.String(),
+				})
+			}
+			return w.WriteAll(records)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64("periods", 0, "number of periods to project; zero projects every period until the max period")
+	cmd.Flags().Bool("csv", false, "print the schedule as CSV")
+
+	return cmd
+}
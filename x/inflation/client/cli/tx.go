@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
@@ -69,7 +71,7 @@ $ nibid tx inflation toggle-inflation true
 
 func CmdEditInflationParams() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "edit-params --staking-proportion [staking-proportion] --community-pool-proportion [community-pool-proportion] --strategic-reserves-proportion [strategic-reserves-proportion] --polynomial-factors [polynomial-factors] --epochs-per-period [epochs-per-period] --periods-per-year [periods-per-year] --max-period [max-period]",
+		Use:   "edit-params --staking-proportion [staking-proportion] --community-pool-proportion [community-pool-proportion] --strategic-reserves-proportion [strategic-reserves-proportion] --polynomial-factors [polynomial-factors] --epochs-per-period [epochs-per-period] --periods-per-year [periods-per-year] --max-period [max-period] --recipient [name=type:weight[:target]] --excluded-module-accounts [names] --excluded-addresses [addresses] --exclude-strategic-reserve [true | false] --bonded-ratio-inflation [true | false] --target-bonded-ratio [ratio] --min-bonded-ratio-multiplier [multiplier] --max-bonded-ratio-multiplier [multiplier]",
 		Args:  cobra.ExactArgs(0),
 		Short: "Edit the inflation module parameters",
 		Long: strings.TrimSpace(`
@@ -86,7 +88,29 @@ Requires sudo permissions.
 --periods-per-year: the number of periods per year
 --max-period: the maximum number of periods
 
+--recipient: an inflation recipient as name=type:weight[:target]. Repeat the
+flag for each recipient; the recipients replace the current ones and their
+weights must sum to 1. The type is one of module_account, address,
+community_pool, oracle_rewards or strategic_reserve. The target is the module
+account name, the bech32 address, or the number of vote periods for
+oracle_rewards. The staking, community pool and strategic reserves proportions
+are a deprecated shorthand for three recipients.
+
+--excluded-module-accounts: comma-separated module accounts excluded from the circulating supply
+--excluded-addresses: comma-separated addresses excluded from the circulating supply
+--exclude-strategic-reserve: whether the strategic reserve is excluded from the circulating supply
+The three exclusion flags replace the current exclusions as a whole.
+
+--bonded-ratio-inflation: whether the epoch mint provision is scaled by the staking bonded ratio
+--target-bonded-ratio: the bonded ratio at which the epoch mint provision is not scaled
+--min-bonded-ratio-multiplier: the lower bound of the bonded ratio multiplier
+--max-bonded-ratio-multiplier: the upper bound of the bonded ratio multiplier
+The four bonded ratio flags replace the current bonded ratio inflation as a
+whole. Unset ones take their default value.
+
 $ nibid tx oracle edit-params --staking-proportion 0.6 --community-pool-proportion 0.2 --strategic-reserves-proportion 0.2 --polynomial-factors 0.1,0.2,0.3,0.4,0.5,0.6 --epochs-per-period 100 --periods-per-year 100 --max-period 100
+$ nibid tx inflation edit-params --recipient staking_rewards=module_account:0.5:fee_collector --recipient oracle=oracle_rewards:0.1:14400 --recipient strategic_reserve=strategic_reserve:0.4
+$ nibid tx inflation edit-params --bonded-ratio-inflation --target-bonded-ratio 0.6 --min-bonded-ratio-multiplier 0.8 --max-bonded-ratio-multiplier 1.2
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -149,6 +173,50 @@ $ nibid tx oracle edit-params --staking-proportion 0.6 --community-pool-proporti
 				msg.MaxPeriod = &maxPeriodInt
 			}
 
+			recipients, _ := cmd.Flags().GetStringArray("recipient")
+			for _, recipient := range recipients {
+				inflationRecipient, err := parseInflationRecipient(recipient)
+				if err != nil {
+					return err
+				}
+				msg.InflationRecipients = append(msg.InflationRecipients, inflationRecipient)
+			}
+
+			if cmd.Flags().Changed("excluded-module-accounts") ||
+				cmd.Flags().Changed("excluded-addresses") ||
+				cmd.Flags().Changed("exclude-strategic-reserve") {
+				moduleAccounts, _ := cmd.Flags().GetStringSlice("excluded-module-accounts")
+				addresses, _ := cmd.Flags().GetStringSlice("excluded-addresses")
+				strategicReserve, _ := cmd.Flags().GetBool("exclude-strategic-reserve")
+				msg.CirculatingSupplyExclusions = &types.CirculatingSupplyExclusions{
+					ModuleAccounts:   moduleAccounts,
+					Addresses:        addresses,
+					StrategicReserve: strategicReserve,
+				}
+			}
+
+			if cmd.Flags().Changed("bonded-ratio-inflation") ||
+				cmd.Flags().Changed("target-bonded-ratio") ||
+				cmd.Flags().Changed("min-bonded-ratio-multiplier") ||
+				cmd.Flags().Changed("max-bonded-ratio-multiplier") {
+				enabled, _ := cmd.Flags().GetBool("bonded-ratio-inflation")
+				bondedRatioInflation := types.BondedRatioInflation{Enabled: enabled}
+				for _, dec := range []struct {
+					flag string
+					dest *sdkmath.LegacyDec
+				}{
+					{"target-bonded-ratio", &bondedRatioInflation.TargetBondedRatio},
+					{"min-bonded-ratio-multiplier", &bondedRatioInflation.MinMultiplier},
+					{"max-bonded-ratio-multiplier", &bondedRatioInflation.MaxMultiplier},
+				} {
+					value, _ := cmd.Flags().GetString(dec.flag)
+					if *dec.dest, err = sdkmath.LegacyNewDecFromStr(value); err != nil {
+						return fmt.Errorf("invalid --%s: %w", dec.flag, err)
+					}
+				}
+				msg.BondedRatioInflation = &bondedRatioInflation
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -166,6 +234,56 @@ $ nibid tx oracle edit-params --staking-proportion 0.6 --community-pool-proporti
 	cmd.Flags().Uint64("epochs-per-period", 0, "the number of epochs per period")
 	cmd.Flags().Uint64("periods-per-year", 0, "the number of periods per year")
 	cmd.Flags().Uint64("max-period", 0, "the maximum number of periods")
+	cmd.Flags().StringArray("recipient", nil, "inflation recipient as name=type:weight[:target] (repeatable)")
+	cmd.Flags().StringSlice("excluded-module-accounts", nil, "module accounts excluded from the circulating supply")
+	cmd.Flags().StringSlice("excluded-addresses", nil, "addresses excluded from the circulating supply")
+	cmd.Flags().Bool("exclude-strategic-reserve", false, "whether the strategic reserve is excluded from the circulating supply")
+	cmd.Flags().Bool("bonded-ratio-inflation", false, "whether the epoch mint provision is scaled by the staking bonded ratio")
+	cmd.Flags().String("target-bonded-ratio", types.DefaultBondedRatioInflation.TargetBondedRatio.String(), "the bonded ratio at which the epoch mint provision is not scaled")
+	cmd.Flags().String("min-bonded-ratio-multiplier", types.DefaultBondedRatioInflation.MinMultiplier.String(), "the lower bound of the bonded ratio multiplier")
+	cmd.Flags().String("max-bonded-ratio-multiplier", types.DefaultBondedRatioInflation.MaxMultiplier.String(), "the upper bound of the bonded ratio multiplier")
 
 	return cmd
 }
+
+// parseInflationRecipient parses an inflation recipient given as
+// "name=type:weight[:target]". For oracle_rewards recipients the target is the
+// number of vote periods.
+func parseInflationRecipient(s string) (types.InflationRecipient, error) {
+	name, spec, found := strings.Cut(s, "=")
+	if !found || name == "" {
+		return types.InflationRecipient{}, fmt.Errorf(
+			"invalid recipient %q: expected name=type:weight[:target]", s)
+	}
+	parts := strings.Split(spec, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return types.InflationRecipient{}, fmt.Errorf(
+			"invalid recipient %q: expected name=type:weight[:target]", s)
+	}
+
+	recipientType, err := types.ParseInflationRecipientType(parts[0])
+	if err != nil {
+		return types.InflationRecipient{}, err
+	}
+	weight, err := sdkmath.LegacyNewDecFromStr(parts[1])
+	if err != nil {
+		return types.InflationRecipient{}, fmt.Errorf("invalid recipient %q weight: %w", s, err)
+	}
+
+	recipient := types.InflationRecipient{
+		Name:          name,
+		RecipientType: recipientType,
+		Weight:        weight,
+	}
+	if len(parts) == 3 {
+		if recipientType == types.INFLATION_RECIPIENT_TYPE_ORACLE_REWARDS {
+			recipient.VotePeriods, err = strconv.ParseUint(parts[2], 10, 64)
+			if err != nil {
+				return types.InflationRecipient{}, fmt.Errorf("invalid recipient %q vote periods: %w", s, err)
+			}
+		} else {
+			recipient.Target = parts[2]
+		}
+	}
+	return recipient, recipient.Validate()
+}
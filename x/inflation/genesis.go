@@ -21,13 +21,16 @@ func InitGenesis(
 	}
 
 	// Set genesis state
-	k.Params.Set(ctx, data.Params)
+	k.Params.Set(ctx, data.Params.WithoutLegacyInflationDistribution())
 
 	period := data.Period
 	k.CurrentPeriod.Set(ctx, period)
 
 	skippedEpochs := data.SkippedEpochs
 	k.NumSkippedEpochs.Set(ctx, skippedEpochs)
+
+	// The accounts are initialized by x/auth, which runs its genesis first.
+	k.IndexVestingAccounts(ctx)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+
+	"github.com/NibiruChain/nibiru/v2/x/inflation/types"
+)
+
+// GetCirculatingSupply returns the bank supply of the mintDenom minus the
+// balances of the accounts in [types.CirculatingSupplyExclusions] and minus
+// the unvested balances of vesting accounts.
+func (k Keeper) GetCirculatingSupply(ctx sdk.Context, mintDenom string) sdkmath.Int {
+	return k.GetCirculatingSupplyBreakdown(ctx, mintDenom).CirculatingSupply.Amount
+}
+
+// GetCirculatingSupplyBreakdown computes the circulating supply of the
+// mintDenom and reports each amount subtracted from the total supply. An
+// account listed more than once, for instance as a module account and as an
+// address, is only subtracted once. Vesting accounts that are excluded
+// explicitly do not count toward the unvested amount. Only the accounts in
+// [Keeper.VestingAccounts] are read, so the cost doesn't grow with the number
+// of accounts.
+func (k Keeper) GetCirculatingSupplyBreakdown(
+	ctx sdk.Context, mintDenom string,
+) types.QueryCirculatingSupplyBreakdownResponse {
+	exclusions := k.GetParams(ctx).CirculatingSupplyExclusions
+	totalSupply := k.bankKeeper.GetSupply(ctx, mintDenom)
+
+	excluded := []types.ExcludedBalance{}
+	excludedAddrs := make(map[string]struct{})
+	excludedSum := sdkmath.ZeroInt()
+	exclude := func(addr sdk.AccAddress, label string) {
+		if addr == nil {
+			return
+		}
+		if _, seen := excludedAddrs[addr.String()]; seen {
+			return
+		}
+		excludedAddrs[addr.String()] = struct{}{}
+		balance := k.bankKeeper.GetBalance(ctx, addr, mintDenom)
+		excludedSum = excludedSum.Add(balance.Amount)
+		excluded = append(excluded, types.ExcludedBalance{
+			Address: addr.String(),
+			Label:   label,
+			Balance: balance,
+		})
+	}
+
+	for _, name := range exclusions.ModuleAccounts {
+		exclude(authtypes.NewModuleAddress(name), name)
+	}
+	if exclusions.StrategicReserve {
+		if rootAddr, err := k.sudoKeeper.GetRootAddr(ctx); err == nil {
+			exclude(rootAddr, types.ExcludedLabelStrategicReserve)
+		}
+	}
+	for _, bech32Addr := range exclusions.Addresses {
+		// Addresses are validated with the params.
+		addr, _ := sdk.AccAddressFromBech32(bech32Addr)
+		exclude(addr, types.ExcludedLabelAddress)
+	}
+
+	unvested := sdkmath.ZeroInt()
+	blockTime := ctx.BlockTime()
+	for _, addr := range k.VestingAccounts.Iterate(ctx, collections.Range[sdk.AccAddress]{}).Keys() {
+		if _, isExcluded := excludedAddrs[addr.String()]; isExcluded {
+			continue
+		}
+		vestingAcc, ok := k.accountKeeper.GetAccount(ctx, addr).(vestexported.VestingAccount)
+		if !ok {
+			continue
+		}
+		unvested = unvested.Add(vestingAcc.GetVestingCoins(blockTime).AmountOf(mintDenom))
+	}
+
+	circulating := totalSupply.Amount.Sub(excludedSum).Sub(unvested)
+	if circulating.IsNegative() {
+		circulating = sdkmath.ZeroInt()
+	}
+
+	return types.QueryCirculatingSupplyBreakdownResponse{
+		TotalSupply:       totalSupply,
+		CirculatingSupply: sdk.NewCoin(mintDenom, circulating),
+		Unvested:          sdk.NewCoin(mintDenom, unvested),
+		Excluded:          excluded,
+	}
+}
+
+// IndexVestingAccounts rebuilds [Keeper.VestingAccounts] from every account
+// in the x/auth store. It iterates over all accounts, so it only runs in
+// "InitGenesis" and upgrades.
+func (k Keeper) IndexVestingAccounts(ctx sdk.Context) {
+	for _, addr := range k.VestingAccounts.Iterate(ctx, collections.Range[sdk.AccAddress]{}).Keys() {
+		k.VestingAccounts.Delete(ctx, addr)
+	}
+	blockTime := ctx.BlockTime()
+	k.accountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) (stop bool) {
+		vestingAcc, ok := account.(vestexported.VestingAccount)
+		if ok && !vestingAcc.GetVestingCoins(blockTime).IsZero() {
+			k.VestingAccounts.Insert(ctx, account.GetAddress())
+		}
+		return false
+	})
+}
+
+// PruneVestingAccounts removes the accounts that finished vesting from
+// [Keeper.VestingAccounts]. It runs at the end of every day epoch.
+func (k Keeper) PruneVestingAccounts(ctx sdk.Context) {
+	blockTime := ctx.BlockTime()
+	for _, addr := range k.VestingAccounts.Iterate(ctx, collections.Range[sdk.AccAddress]{}).Keys() {
+		vestingAcc, ok := k.accountKeeper.GetAccount(ctx, addr).(vestexported.VestingAccount)
+		if !ok || vestingAcc.GetVestingCoins(blockTime).IsZero() {
+			k.VestingAccounts.Delete(ctx, addr)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/inflation/types"
+)
+
+func TestGetCirculatingSupplyBreakdown(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	// Vesting accounts can be present from genesis even though the x/vesting
+	// Cosmos-SDK module is disabled on Nibiru.
+	vestingtypes.RegisterInterfaces(nibiruApp.InterfaceRegistry())
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	k := nibiruApp.InflationKeeper
+	nibi := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, amt)) }
+
+	before := k.GetCirculatingSupplyBreakdown(ctx, denoms.NIBI)
+	require.True(t, before.TotalSupply.Amount.Equal(
+		before.CirculatingSupply.Amount.
+			Add(before.Unvested.Amount).
+			Add(sumExcluded(before.Excluded)),
+	))
+
+	// An explicitly excluded address, a vesting account, a module escrow and
+	// a regular holder.
+	excludedAddr := testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(nibiruApp.BankKeeper, ctx, excludedAddr, nibi(300)))
+
+	vestingAddr := testutil.AccAddress()
+	vestingAcc := vestingtypes.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(vestingAddr),
+		nibi(1_000),
+		ctx.BlockTime().Unix(),
+		ctx.BlockTime().Add(100*time.Second).Unix(),
+	)
+	nibiruApp.AccountKeeper.SetAccount(ctx, nibiruApp.AccountKeeper.NewAccount(ctx, vestingAcc))
+	require.NoError(t, testapp.FundAccount(nibiruApp.BankKeeper, ctx, vestingAddr, nibi(1_000)))
+	k.IndexVestingAccounts(ctx)
+	require.True(t, k.VestingAccounts.Has(ctx, vestingAddr))
+
+	require.NoError(t, testapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.ModuleName, nibi(100)))
+	require.NoError(t, testapp.FundAccount(nibiruApp.BankKeeper, ctx, testutil.AccAddress(), nibi(50)))
+
+	params := k.GetParams(ctx)
+	params.CirculatingSupplyExclusions = types.CirculatingSupplyExclusions{
+		ModuleAccounts: []string{types.ModuleName},
+		Addresses:      []string{excludedAddr.String()},
+	}
+	k.Params.Set(ctx, params)
+
+	t.Log("half of the vesting schedule has elapsed")
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(50 * time.Second))
+	after := k.GetCirculatingSupplyBreakdown(ctx, denoms.NIBI)
+	require.Equal(t, before.TotalSupply.Amount.AddRaw(1_450), after.TotalSupply.Amount)
+	require.Equal(t, before.Unvested.Amount.AddRaw(500), after.Unvested.Amount)
+	require.Equal(t, before.CirculatingSupply.Amount.AddRaw(550), after.CirculatingSupply.Amount)
+	require.Equal(t, after.CirculatingSupply.Amount, k.GetCirculatingSupply(ctx, denoms.NIBI))
+	require.Len(t, after.Excluded, 2)
+	require.Equal(t, types.ModuleName, after.Excluded[0].Label)
+	require.Equal(t, types.ExcludedLabelAddress, after.Excluded[1].Label)
+	require.Equal(t, int64(300), after.Excluded[1].Balance.Amount.Int64())
+
+	t.Log("excluding the vesting account removes it from the unvested amount")
+	inflationAddr := authtypes.NewModuleAddress(types.ModuleName)
+	params.CirculatingSupplyExclusions.Addresses = []string{
+		excludedAddr.String(), vestingAddr.String(), inflationAddr.String(),
+	}
+	k.Params.Set(ctx, params)
+	excludedVesting := k.GetCirculatingSupplyBreakdown(ctx, denoms.NIBI)
+	require.Equal(t, before.Unvested.Amount, excludedVesting.Unvested.Amount)
+	require.Equal(t, after.CirculatingSupply.Amount.SubRaw(500), excludedVesting.CirculatingSupply.Amount)
+	// The inflation module address is only counted once.
+	require.Len(t, excludedVesting.Excluded, 3)
+
+	resp, err := k.CirculatingSupplyBreakdown(
+		sdk.WrapSDKContext(ctx), &types.QueryCirculatingSupplyBreakdownRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, excludedVesting, *resp)
+
+	t.Log("accounts that finished vesting are pruned from the index")
+	k.PruneVestingAccounts(ctx)
+	require.True(t, k.VestingAccounts.Has(ctx, vestingAddr))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(50 * time.Second))
+	k.PruneVestingAccounts(ctx)
+	require.False(t, k.VestingAccounts.Has(ctx, vestingAddr))
+	params.CirculatingSupplyExclusions.Addresses = []string{excludedAddr.String()}
+	k.Params.Set(ctx, params)
+	require.Equal(t, before.Unvested.Amount, k.GetCirculatingSupplyBreakdown(ctx, denoms.NIBI).Unvested.Amount)
+}
+
+func sumExcluded(excluded []types.ExcludedBalance) sdkmath.Int {
+	sum := sdkmath.ZeroInt()
+	for _, e := range excluded {
+		sum = sum.Add(e.Balance.Amount)
+	}
+	return sum
+}
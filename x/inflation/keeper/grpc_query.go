@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -73,8 +75,8 @@ func (k Keeper) InflationRate(
 	return &types.QueryInflationRateResponse{InflationRate: inflationRate}, nil
 }
 
-// CirculatingSupply returns the total supply in circulation excluding the team
-// allocation in the first year
+// CirculatingSupply returns the total supply in circulation. See
+// [Keeper.GetCirculatingSupply].
 func (k Keeper) CirculatingSupply(
 	c context.Context,
 	_ *types.QueryCirculatingSupplyRequest,
@@ -87,3 +89,46 @@ func (k Keeper) CirculatingSupply(
 
 	return &types.QueryCirculatingSupplyResponse{CirculatingSupply: coin}, nil
 }
+
+// CirculatingSupplyBreakdown returns the total supply, the circulating supply
+// and the amounts subtracted from the total supply to compute it.
+func (k Keeper) CirculatingSupplyBreakdown(
+	c context.Context,
+	_ *types.QueryCirculatingSupplyBreakdownRequest,
+) (*types.QueryCirculatingSupplyBreakdownResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	breakdown := k.GetCirculatingSupplyBreakdown(ctx, denoms.NIBI)
+	return &breakdown, nil
+}
+
+// InflationSchedule projects the inflation schedule for the next periods from
+// the current state.
+func (k Keeper) InflationSchedule(
+	c context.Context,
+	req *types.QueryInflationScheduleRequest,
+) (*types.QueryInflationScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.NumPeriods > types.MaxInflationSchedulePeriods {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"num periods %d exceeds the maximum of %d", req.NumPeriods, types.MaxInflationSchedulePeriods)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	numPeriods := req.NumPeriods
+	if numPeriods == 0 {
+		// Project every period that still mints.
+		params := k.GetParams(ctx)
+		if period := k.CurrentPeriod.Peek(ctx); period < params.MaxPeriod {
+			numPeriods = min(params.MaxPeriod-period, types.MaxInflationSchedulePeriods)
+		}
+	}
+
+	totalSupply, circulatingSupply, schedule := k.GetInflationSchedule(ctx, denoms.NIBI, numPeriods)
+	return &types.QueryInflationScheduleResponse{
+		TotalSupply:       totalSupply,
+		Periods:           schedule,
+		CirculatingSupply: circulatingSupply,
+	}, nil
+}
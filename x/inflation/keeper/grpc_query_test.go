@@ -2,14 +2,20 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/NibiruChain/nibiru/v2/x/inflation/keeper"
 
 	"github.com/NibiruChain/nibiru/v2/app"
+	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	epochstypes "github.com/NibiruChain/nibiru/v2/x/epochs/types"
 
 	inflationtypes "github.com/NibiruChain/nibiru/v2/x/inflation/types"
 )
@@ -107,3 +113,74 @@ func (s *QueryServerSuite) TestQueryParams() {
 	s.NoError(err)
 	s.NotNil(resp2)
 }
+
+func (s *QueryServerSuite) TestQueryInflationSchedule() {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	inflationKeeper := nibiruApp.InflationKeeper
+	params := inflationKeeper.GetParams(ctx)
+	params.InflationEnabled = true
+	inflationKeeper.Params.Set(ctx, params)
+
+	_, err := inflationKeeper.InflationSchedule(sdk.WrapSDKContext(ctx), nil)
+	s.Error(err)
+
+	_, err = inflationKeeper.InflationSchedule(
+		sdk.WrapSDKContext(ctx),
+		&inflationtypes.QueryInflationScheduleRequest{
+			NumPeriods: inflationtypes.MaxInflationSchedulePeriods + 1,
+		},
+	)
+	s.ErrorContains(err, "exceeds the maximum")
+
+	s.T().Log("period 2 with 10 skipped epochs and 5 epochs already minted")
+	inflationKeeper.CurrentPeriod.Set(ctx, 2)
+	inflationKeeper.NumSkippedEpochs.Set(ctx, 10)
+	nibiruApp.EpochsKeeper.Epochs.Insert(ctx, epochstypes.DayEpochID, epochstypes.EpochInfo{
+		Identifier:   epochstypes.DayEpochID,
+		StartTime:    ctx.BlockTime(),
+		CurrentEpoch: params.EpochsPerPeriod*2 + 10 + 5 + 1,
+	})
+
+	resp, err := inflationKeeper.InflationSchedule(
+		sdk.WrapSDKContext(ctx),
+		&inflationtypes.QueryInflationScheduleRequest{NumPeriods: 3},
+	)
+	s.Require().NoError(err)
+	s.Require().Len(resp.Periods, 3)
+	s.EqualValues(2, resp.Periods[0].Period)
+	s.Equal(params.EpochsPerPeriod-5, resp.Periods[0].NumEpochs)
+	s.Equal(params.EpochsPerPeriod, resp.Periods[1].NumEpochs)
+	s.Equal(resp.TotalSupply.Amount.Add(resp.Periods[0].PeriodMint), resp.Periods[0].CumulativeSupply)
+	s.Equal(inflationKeeper.GetCirculatingSupply(ctx, denoms.NIBI), resp.CirculatingSupply)
+	s.True(resp.Periods[0].AnnualizedRate.IsPositive())
+	s.Equal(inflationKeeper.GetInflationRate(ctx, denoms.NIBI), resp.Periods[0].AnnualizedRate)
+
+	s.T().Log("the projection starts from the circulating supply net of vesting")
+	vestingtypes.RegisterInterfaces(nibiruApp.InterfaceRegistry())
+	vestingAddr := testutil.AccAddress()
+	vestingCoins := sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_000))
+	nibiruApp.AccountKeeper.SetAccount(ctx, nibiruApp.AccountKeeper.NewAccount(ctx,
+		vestingtypes.NewDelayedVestingAccount(
+			authtypes.NewBaseAccountWithAddress(vestingAddr),
+			vestingCoins,
+			ctx.BlockTime().Add(time.Hour).Unix(),
+		),
+	))
+	s.Require().NoError(testapp.FundAccount(nibiruApp.BankKeeper, ctx, vestingAddr, vestingCoins))
+	inflationKeeper.IndexVestingAccounts(ctx)
+	vestingResp, err := inflationKeeper.InflationSchedule(
+		sdk.WrapSDKContext(ctx),
+		&inflationtypes.QueryInflationScheduleRequest{NumPeriods: 3},
+	)
+	s.Require().NoError(err)
+	s.Equal(resp.TotalSupply.Amount.AddRaw(1_000), vestingResp.TotalSupply.Amount)
+	s.Equal(resp.CirculatingSupply, vestingResp.CirculatingSupply)
+
+	s.T().Log("zero periods projects every remaining period")
+	resp, err = inflationKeeper.InflationSchedule(
+		sdk.WrapSDKContext(ctx),
+		&inflationtypes.QueryInflationScheduleRequest{},
+	)
+	s.Require().NoError(err)
+	s.Len(resp.Periods, int(params.MaxPeriod-2))
+}
@@ -34,6 +34,16 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 	_, _, _ = ctx, epochIdentifier, epochNumber
 }
 
+// legacyAllocateMetricNames maps the default inflation recipients to the names
+// of their "inflation.allocate.<name>.total" counters from before recipients
+// were configurable, so that existing dashboards keep working. Other
+// recipients use their own name.
+var legacyAllocateMetricNames = map[string]string{
+	types.RecipientNameStakingRewards:   "staking",
+	types.RecipientNameStrategicReserve: "strategic",
+	types.RecipientNameCommunityPool:    "community_pool",
+}
+
 // AfterEpochEnd is a hook that runs just prior to the first block whose
 // timestamp is after the end of an epoch duration.
 // AfterEpochEnd mints and allocates coins at the end of each epoch.
@@ -44,6 +54,8 @@ func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumbe
 		return
 	}
 
+	h.K.PruneVestingAccounts(ctx)
+
 	params := h.K.GetParams(ctx)
 
 	// Skip inflation if it is disabled and increment number of skipped epochs
@@ -75,11 +87,11 @@ func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumbe
 	epochMintProvision := types.CalculateEpochMintProvision(
 		params,
 		period,
-	)
+	).Mul(h.K.GetBondedRatioMultiplier(ctx, params))
 
 	if !epochMintProvision.IsPositive() {
 		h.K.Logger(ctx).Error(
-			"SKIPPING INFLATION: negative epoch mint provision",
+			"SKIPPING INFLATION: non-positive epoch mint provision",
 			"value", epochMintProvision.String(),
 		)
 		return
@@ -90,7 +102,7 @@ func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumbe
 		Amount: epochMintProvision.TruncateInt(),
 	}
 
-	staking, strategic, communityPool, err := h.K.MintAndAllocateInflation(ctx, mintedCoin, params)
+	allocations, err := h.K.MintAndAllocateInflation(ctx, mintedCoin, params)
 	if err != nil {
 		h.K.Logger(ctx).Error(
 			"SKIPPING INFLATION: failed to mint and allocate inflation",
@@ -124,10 +136,6 @@ func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumbe
 	}
 
 	defer func() {
-		stakingAmt := staking.Amount
-		strategicAmt := strategic.Amount
-		cpAmt := communityPool.Amount
-
 		if mintedCoin.Amount.IsInt64() {
 			telemetry.IncrCounterWithLabels(
 				[]string{types.ModuleName, "allocate", "total"},
@@ -135,26 +143,18 @@ func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumbe
 				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
 			)
 		}
-		if stakingAmt.IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{types.ModuleName, "allocate", "staking", "total"},
-				float32(stakingAmt.Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
-			)
-		}
-		if strategicAmt.IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{types.ModuleName, "allocate", "strategic", "total"},
-				float32(strategicAmt.Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
-			)
-		}
-		if cpAmt.IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{types.ModuleName, "allocate", "community_pool", "total"},
-				float32(cpAmt.Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
-			)
+		for _, allocation := range allocations {
+			if allocation.Amount.Amount.IsInt64() {
+				metricName := allocation.Name
+				if legacyName, ok := legacyAllocateMetricNames[allocation.Name]; ok {
+					metricName = legacyName
+				}
+				telemetry.IncrCounterWithLabels(
+					[]string{types.ModuleName, "allocate", metricName, "total"},
+					float32(allocation.Amount.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
+				)
+			}
 		}
 	}()
 
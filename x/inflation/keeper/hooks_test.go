@@ -6,6 +6,7 @@ import (
 	"time"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/armon/go-metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
@@ -15,6 +16,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
 	epochstypes "github.com/NibiruChain/nibiru/v2/x/epochs/types"
+	"github.com/NibiruChain/nibiru/v2/x/inflation/keeper"
 	"github.com/NibiruChain/nibiru/v2/x/inflation/types"
 )
 
@@ -36,6 +38,31 @@ func TestEpochIdentifierAfterEpochEnd(t *testing.T) {
 		feePoolOld.CommunityPool.AmountOf(denoms.NIBI).BigInt().Uint64())
 }
 
+// TestAllocateMetricNames: The default inflation recipients keep the names of
+// their "inflation.allocate.<name>.total" counters from before recipients were
+// configurable.
+func TestAllocateMetricNames(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	_, err := metrics.NewGlobal(&metrics.Config{FilterDefault: true}, sink)
+	require.NoError(t, err)
+
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	params := nibiruApp.InflationKeeper.GetParams(ctx)
+	params.InflationEnabled = true
+	nibiruApp.InflationKeeper.Params.Set(ctx, params)
+	nibiruApp.EpochsKeeper.AfterEpochEnd(ctx, epochstypes.DayEpochID, 1)
+
+	counters := make(map[string]bool)
+	for _, interval := range sink.Data() {
+		for name := range interval.Counters {
+			counters[name] = true
+		}
+	}
+	for _, name := range []string{"total", "staking.total", "strategic.total", "community_pool.total"} {
+		require.True(t, counters["inflation.allocate."+name+";denom="+denoms.NIBI], name)
+	}
+}
+
 // TestPeriodChangesSkippedEpochsAfterEpochEnd: Tests whether current period and
 // the number of skipped epochs are accurately updated and that skipped epochs
 // are handled correctly.
@@ -226,10 +253,8 @@ func TestManual(t *testing.T) {
 
 	// y = 3 * x + 3 -> 3 nibi per epoch for period 0, 6 nibi per epoch for period 1
 	params.PolynomialFactors = []sdkmath.LegacyDec{sdkmath.LegacyNewDec(3), sdkmath.LegacyNewDec(3)}
-	params.InflationDistribution = types.InflationDistribution{
-		CommunityPool:     sdkmath.LegacyZeroDec(),
-		StakingRewards:    sdkmath.LegacyOneDec(),
-		StrategicReserves: sdkmath.LegacyZeroDec(),
+	params.InflationRecipients = []types.InflationRecipient{
+		types.StakingRewardsRecipient(sdkmath.LegacyOneDec()),
 	}
 
 	inflationKeeper.Params.Set(ctx, params)
@@ -293,3 +318,90 @@ func TestManual(t *testing.T) {
 
 	require.EqualValues(t, uint64(1+2*42069+60), epochNumber)
 }
+
+// TestBondedRatioInflationToggle: Enables, edits and disables the bonded ratio
+// inflation mode through MsgEditInflationParams between epochs and checks that
+// each epoch mints the polynomial provision scaled by the multiplier in effect.
+func TestBondedRatioInflationToggle(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	inflationKeeper := nibiruApp.InflationKeeper
+	msgServer := keeper.NewMsgServerImpl(inflationKeeper)
+
+	params := inflationKeeper.GetParams(ctx)
+	params.InflationEnabled = true
+	params.HasInflationStarted = true
+	params.EpochsPerPeriod = 30
+	// y = 3 -> 3 nibi per period, or 100k unibi per epoch
+	params.PolynomialFactors = []sdkmath.LegacyDec{sdkmath.LegacyNewDec(3)}
+	params.InflationRecipients = []types.InflationRecipient{
+		types.StakingRewardsRecipient(sdkmath.LegacyOneDec()),
+	}
+	inflationKeeper.Params.Set(ctx, params)
+
+	// The test app bonds a negligible share of the staking supply, so the
+	// multiplier sits at its upper bound.
+	bondedRatio := nibiruApp.StakingKeeper.BondedRatio(ctx)
+	require.True(t, bondedRatio.LT(sdkmath.LegacyMustNewDecFromStr("0.01")), bondedRatio)
+
+	epochNumber := uint64(1)
+	balance := GetBalanceStaking(ctx, nibiruApp)
+	runEpoch := func(wantMinted int64) {
+		inflationKeeper.Hooks().AfterEpochEnd(ctx, epochstypes.DayEpochID, epochNumber)
+		epochNumber++
+		newBalance := GetBalanceStaking(ctx, nibiruApp)
+		require.Equal(t, sdkmath.NewInt(wantMinted), newBalance.Sub(balance))
+		balance = newBalance
+	}
+	editBondedRatioInflation := func(bondedRatioInflation types.BondedRatioInflation) {
+		_, err := msgServer.EditInflationParams(ctx, &types.MsgEditInflationParams{
+			Sender:               testutil.ADDR_SUDO_ROOT,
+			BondedRatioInflation: &bondedRatioInflation,
+		})
+		require.NoError(t, err)
+	}
+
+	t.Log("disabled by default: the polynomial provision is minted")
+	require.False(t, inflationKeeper.GetParams(ctx).BondedRatioInflation.Enabled)
+	runEpoch(100_000)
+
+	t.Log("enabled: the provision is scaled up to the max multiplier")
+	editBondedRatioInflation(types.BondedRatioInflation{
+		Enabled:           true,
+		TargetBondedRatio: sdkmath.LegacyMustNewDecFromStr("0.5"),
+		MinMultiplier:     sdkmath.LegacyMustNewDecFromStr("0.5"),
+		MaxMultiplier:     sdkmath.LegacyMustNewDecFromStr("1.5"),
+	})
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("150000"), inflationKeeper.GetEpochMintProvision(ctx))
+	runEpoch(150_000)
+	runEpoch(150_000)
+
+	t.Log("edited: a lower max multiplier takes effect on the next epoch")
+	editBondedRatioInflation(types.BondedRatioInflation{
+		Enabled:           true,
+		TargetBondedRatio: sdkmath.LegacyMustNewDecFromStr("0.5"),
+		MinMultiplier:     sdkmath.LegacyMustNewDecFromStr("0.5"),
+		MaxMultiplier:     sdkmath.LegacyMustNewDecFromStr("1.2"),
+	})
+	runEpoch(120_000)
+
+	t.Log("disabled again: the polynomial provision is minted")
+	bondedRatioInflation := inflationKeeper.GetParams(ctx).BondedRatioInflation
+	bondedRatioInflation.Enabled = false
+	editBondedRatioInflation(bondedRatioInflation)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("100000"), inflationKeeper.GetEpochMintProvision(ctx))
+	runEpoch(100_000)
+
+	t.Log("invalid bonded ratio inflation is rejected")
+	_, err := msgServer.EditInflationParams(ctx, &types.MsgEditInflationParams{
+		Sender: testutil.ADDR_SUDO_ROOT,
+		BondedRatioInflation: &types.BondedRatioInflation{
+			Enabled:           true,
+			TargetBondedRatio: sdkmath.LegacyZeroDec(),
+			MinMultiplier:     sdkmath.LegacyMustNewDecFromStr("0.5"),
+			MaxMultiplier:     sdkmath.LegacyMustNewDecFromStr("1.5"),
+		},
+	})
+	require.ErrorContains(t, err, "target bonded ratio")
+	require.False(t, inflationKeeper.GetParams(ctx).BondedRatioInflation.Enabled)
+	runEpoch(100_000)
+}
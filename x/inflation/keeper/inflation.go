@@ -5,9 +5,11 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
-	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
+	epochstypes "github.com/NibiruChain/nibiru/v2/x/epochs/types"
 	"github.com/NibiruChain/nibiru/v2/x/inflation/types"
+	oracletypes "github.com/NibiruChain/nibiru/v2/x/oracle/types"
 )
 
 // MintAndAllocateInflation mints and allocates tokens based on the polynomial
@@ -15,35 +17,31 @@ import (
 //
 // Args:
 //   - coins: Tokens to be minted.
-//   - params:
+//   - params: Module parameters. The minted tokens are split between
+//     [types.Params.InflationRecipients].
 //
 // Returns:
-//   - staking: Tokens minted for staking inflation that go to the decentralized
-//     validator set and delegators. This is handled by the `auth` module fee
-//     collector.
-//   - strategic: Tokens minted to the Strategic Reserve, the root account fo the
-//     x/sudo module.
-//   - community: Tokens minted to the Community Pool, which is managed strictly
-//     by on-chain governance.
+//   - allocations: The amount sent to each inflation recipient, in the order
+//     of the recipients.
 func (k Keeper) MintAndAllocateInflation(
 	ctx sdk.Context,
 	coins sdk.Coin,
 	params types.Params,
 ) (
-	staking, strategic, community sdk.Coin,
+	allocations []types.InflationAllocation,
 	err error,
 ) {
 	// skip as no coins need to be minted
 	if coins.Amount.IsNil() || !coins.Amount.IsPositive() {
-		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, nil
+		return nil, nil
 	}
 
 	// Mint coins for distribution
 	if err := k.MintCoins(ctx, coins); err != nil {
-		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+		return nil, err
 	}
 
-	// Allocate minted coins according to allocation proportions (staking, strategic, community pool)
+	// Allocate minted coins according to the recipient weights
 	return k.AllocatePolynomialInflation(ctx, coins, params)
 }
 
@@ -53,73 +51,134 @@ func (k Keeper) MintCoins(ctx sdk.Context, coin sdk.Coin) error {
 	return k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 }
 
-// AllocatePolynomialInflation allocates coins from the inflation to external
-// modules according to proportions:
+// AllocatePolynomialInflation allocates coins from the inflation module to the
+// [types.Params.InflationRecipients] in proportion to their weights. The last
+// recipient receives the rounding remainder so that the whole minted amount
+// is distributed.
 //
 // Returns:
-//   - staking: Tokens minted for staking inflation that go to the decentralized
-//     validator set and delegators. This is handled by the `auth` module fee
-//     collector.
-//   - strategic: Tokens minted to the Strategic Reserve, the root account fo the
-//     x/sudo module.
-//   - community: Tokens minted to the Community Pool, which is managed strictly
-//     by on-chain governance.
+//   - allocations: The amount sent to each inflation recipient, in the order
+//     of the recipients. If a transfer fails, the allocations made so far are
+//     returned with the error.
 func (k Keeper) AllocatePolynomialInflation(
 	ctx sdk.Context,
 	mintedCoin sdk.Coin,
 	params types.Params,
 ) (
-	staking, strategic, community sdk.Coin,
+	allocations []types.InflationAllocation,
 	err error,
 ) {
-	inflationDistribution := params.InflationDistribution
-	inflationModuleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
-
-	// Allocate staking rewards into fee collector account
-	staking = k.GetProportions(ctx, mintedCoin, inflationDistribution.StakingRewards)
-	if err := k.bankKeeper.SendCoinsFromModuleToModule(
-		ctx,
-		types.ModuleName,
-		k.feeCollectorName,
-		sdk.NewCoins(staking),
-	); err != nil {
-		return staking, strategic, community, err
-	}
+	recipients := params.InflationRecipients
+	remaining := mintedCoin
+	for i, recipient := range recipients {
+		amount := k.GetProportions(ctx, mintedCoin, recipient.Weight)
+		if i == len(recipients)-1 {
+			amount = remaining
+		}
+		remaining = remaining.Sub(amount)
 
-	// Allocate community pool rewards into community pool
-	community = k.GetProportions(ctx, mintedCoin, inflationDistribution.CommunityPool)
-	if err = k.distrKeeper.FundCommunityPool(
-		ctx,
-		sdk.NewCoins(community),
-		inflationModuleAddr,
-	); err != nil {
-		return staking, strategic, community, err
+		recipientAddr, err := k.sendToInflationRecipient(ctx, recipient, amount)
+		if err != nil {
+			err := fmt.Errorf("inflation error: failed to allocate to recipient %s: %w", recipient.Name, err)
+			k.Logger(ctx).Error(err.Error())
+			return allocations, err
+		}
+		allocations = append(allocations, types.InflationAllocation{
+			Name:      recipient.Name,
+			Recipient: recipientAddr.String(),
+			Amount:    amount,
+		})
 	}
 
-	// Remaining balance is strategic reserve allocation to the root account
-	// of the x/sudo module
-	strategic = k.bankKeeper.GetBalance(ctx, inflationModuleAddr, denoms.NIBI)
-	strategicAccountAddr, err := k.sudoKeeper.GetRootAddr(ctx)
-	if err != nil {
-		err := fmt.Errorf("inflation error: failed to get sudo root account: %w", err)
-		k.Logger(ctx).Error(err.Error())
-		return staking, strategic, community, err
-	}
+	return allocations, ctx.EventManager().EmitTypedEvent(
+		k.newEventInflationDistribution(mintedCoin.Denom, recipients, allocations),
+	)
+}
+
+// sendToInflationRecipient transfers "amount" from the inflation module to the
+// recipient and returns the address that received it.
+func (k Keeper) sendToInflationRecipient(
+	ctx sdk.Context, recipient types.InflationRecipient, amount sdk.Coin,
+) (recipientAddr sdk.AccAddress, err error) {
+	coins := sdk.NewCoins(amount)
+	switch recipient.RecipientType {
+	case types.INFLATION_RECIPIENT_TYPE_MODULE_ACCOUNT:
+		recipientAddr = k.accountKeeper.GetModuleAddress(recipient.Target)
+		if recipientAddr == nil {
+			return nil, fmt.Errorf("module account %s does not exist", recipient.Target)
+		}
+		return recipientAddr, k.bankKeeper.SendCoinsFromModuleToModule(
+			ctx, types.ModuleName, recipient.Target, coins,
+		)
 
-	if err = k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx, types.ModuleName, strategicAccountAddr, sdk.NewCoins(strategic),
-	); err != nil {
-		err := fmt.Errorf("inflation error: failed to send coins to sudo root account: %w", err)
-		k.Logger(ctx).Error(err.Error())
-		return staking, strategic, community, err
+	case types.INFLATION_RECIPIENT_TYPE_ADDRESS:
+		recipientAddr, err = sdk.AccAddressFromBech32(recipient.Target)
+		if err != nil {
+			return nil, err
+		}
+		return recipientAddr, k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.ModuleName, recipientAddr, coins,
+		)
+
+	case types.INFLATION_RECIPIENT_TYPE_COMMUNITY_POOL:
+		recipientAddr = k.accountKeeper.GetModuleAddress(distrtypes.ModuleName)
+		return recipientAddr, k.distrKeeper.FundCommunityPool(
+			ctx, coins, k.accountKeeper.GetModuleAddress(types.ModuleName),
+		)
+
+	case types.INFLATION_RECIPIENT_TYPE_ORACLE_REWARDS:
+		recipientAddr = k.accountKeeper.GetModuleAddress(oracletypes.ModuleName)
+		if coins.IsZero() {
+			return recipientAddr, nil
+		}
+		return recipientAddr, k.oracleKeeper.AllocateRewards(
+			ctx, types.ModuleName, coins, recipient.VotePeriods,
+		)
+
+	case types.INFLATION_RECIPIENT_TYPE_STRATEGIC_RESERVE:
+		// The strategic reserve is the root account of the x/sudo module.
+		recipientAddr, err = k.sudoKeeper.GetRootAddr(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sudo root account: %w", err)
+		}
+		return recipientAddr, k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.ModuleName, recipientAddr, coins,
+		)
+
+	default:
+		return nil, fmt.Errorf("invalid recipient type %s", recipient.RecipientType)
 	}
+}
 
-	return staking, strategic, community, ctx.EventManager().EmitTypedEvents(
-		&types.EventInflationDistribution{
-			StakingRewards:   staking,
-			StrategicReserve: strategic,
-			CommunityPool:    community,
-		})
+// newEventInflationDistribution builds the typed event for an inflation
+// allocation. The legacy staking, strategic reserve and community pool fields
+// sum the allocations to the fee collector, the strategic reserve and the
+// community pool.
+func (k Keeper) newEventInflationDistribution(
+	denom string,
+	recipients []types.InflationRecipient,
+	allocations []types.InflationAllocation,
+) *types.EventInflationDistribution {
+	event := &types.EventInflationDistribution{
+		StakingRewards:   sdk.NewCoin(denom, sdkmath.ZeroInt()),
+		StrategicReserve: sdk.NewCoin(denom, sdkmath.ZeroInt()),
+		CommunityPool:    sdk.NewCoin(denom, sdkmath.ZeroInt()),
+		Allocations:      allocations,
+	}
+	feeCollectorAddr := k.accountKeeper.GetModuleAddress(k.feeCollectorName)
+	for i, allocation := range allocations {
+		switch recipients[i].RecipientType {
+		case types.INFLATION_RECIPIENT_TYPE_COMMUNITY_POOL:
+			event.CommunityPool = event.CommunityPool.Add(allocation.Amount)
+		case types.INFLATION_RECIPIENT_TYPE_STRATEGIC_RESERVE:
+			event.StrategicReserve = event.StrategicReserve.Add(allocation.Amount)
+		case types.INFLATION_RECIPIENT_TYPE_MODULE_ACCOUNT:
+			if allocation.Recipient == feeCollectorAddr.String() {
+				event.StakingRewards = event.StakingRewards.Add(allocation.Amount)
+			}
+		}
+	}
+	return event
 }
 
 // GetProportions calculates the proportion of coins that is to be
@@ -135,12 +194,6 @@ func (k Keeper) GetProportions(
 	}
 }
 
-// GetCirculatingSupply returns the bank supply of the mintDenom excluding the
-// team allocation in the first year
-func (k Keeper) GetCirculatingSupply(ctx sdk.Context, mintDenom string) sdkmath.Int {
-	return k.bankKeeper.GetSupply(ctx, mintDenom).Amount
-}
-
 // GetInflationRate returns the inflation rate for the current period.
 func (k Keeper) GetInflationRate(ctx sdk.Context, mintDenom string) sdkmath.LegacyDec {
 	epochMintProvision := k.GetEpochMintProvision(ctx)
@@ -166,9 +219,53 @@ func (k Keeper) GetInflationRate(ctx sdk.Context, mintDenom string) sdkmath.Lega
 // and calculate EpochMintProvision
 func (k Keeper) GetEpochMintProvision(ctx sdk.Context) sdkmath.LegacyDec {
 	peek := k.CurrentPeriod.Peek(ctx)
+	params := k.GetParams(ctx)
 
 	return types.CalculateEpochMintProvision(
-		k.GetParams(ctx),
+		params,
 		peek,
+	).Mul(k.GetBondedRatioMultiplier(ctx, params))
+}
+
+// GetBondedRatioMultiplier returns the factor applied to the epoch mint
+// provision of the polynomial for the current staking bonded ratio. It is one
+// unless [types.Params.BondedRatioInflation] is enabled.
+func (k Keeper) GetBondedRatioMultiplier(ctx sdk.Context, params types.Params) sdkmath.LegacyDec {
+	if !params.BondedRatioInflation.Enabled {
+		return sdkmath.LegacyOneDec()
+	}
+	return params.BondedRatioInflation.Multiplier(k.stakingKeeper.BondedRatio(ctx))
+}
+
+// GetInflationSchedule projects "numPeriods" periods of inflation from the
+// current period, skipping the epochs of the current period that already
+// minted. The bonded ratio multiplier, if enabled, is assumed to keep its
+// current value. The annualized rates are relative to the circulating supply,
+// like [Keeper.GetInflationRate], which reads the unvested balances from the
+// [Keeper.VestingAccounts] index. See [types.ProjectInflationSchedule].
+func (k Keeper) GetInflationSchedule(
+	ctx sdk.Context, mintDenom string, numPeriods uint64,
+) (totalSupply sdk.Coin, circulatingSupply sdkmath.Int, schedule []types.InflationSchedulePeriod) {
+	params := k.GetParams(ctx)
+	period := k.CurrentPeriod.Peek(ctx)
+	totalSupply = k.bankKeeper.GetSupply(ctx, mintDenom)
+	circulatingSupply = k.GetCirculatingSupply(ctx, mintDenom)
+
+	// The day epoch that is running has not ended, so "CurrentEpoch - 1"
+	// epochs ended. Those that ended while inflation was disabled count as
+	// skipped epochs.
+	var elapsedEpochs uint64
+	if epochInfo, err := k.epochsKeeper.GetEpochInfo(ctx, epochstypes.DayEpochID); err == nil &&
+		epochInfo.CurrentEpoch > 0 {
+		endedEpochs := epochInfo.CurrentEpoch - 1
+		mintedEpochs := params.EpochsPerPeriod*period + k.NumSkippedEpochs.Peek(ctx)
+		if endedEpochs > mintedEpochs {
+			elapsedEpochs = endedEpochs - mintedEpochs
+		}
+	}
+
+	return totalSupply, circulatingSupply, types.ProjectInflationSchedule(
+		params, period, elapsedEpochs, totalSupply.Amount, circulatingSupply, numPeriods,
+		k.GetBondedRatioMultiplier(ctx, params),
 	)
 }
@@ -11,9 +11,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/v2/app"
+	"github.com/NibiruChain/nibiru/v2/x/common"
 	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
 	"github.com/NibiruChain/nibiru/v2/x/inflation/types"
+	oracletypes "github.com/NibiruChain/nibiru/v2/x/oracle/types"
 	sudotypes "github.com/NibiruChain/nibiru/v2/x/sudo/types"
 )
 
@@ -73,16 +76,20 @@ func TestMintAndAllocateInflation(t *testing.T) {
 				Contracts: []string{},
 			})
 
-			staking, strategic, community, err := nibiruApp.InflationKeeper.MintAndAllocateInflation(ctx, tc.coinsToMint, types.DefaultParams())
+			allocations, err := nibiruApp.InflationKeeper.MintAndAllocateInflation(ctx, tc.coinsToMint, types.DefaultParams())
 			if tc.rootAccount != "" {
 				require.NoError(t, err)
 			} else {
 				require.Error(t, err)
 				return
 			}
-			assert.Equal(t, tc.expectedStakingAmt, staking)
-			assert.Equal(t, tc.expectedStrategicAmt, strategic)
-			assert.Equal(t, tc.expectedCommunityAmt, community)
+			allocated := make(map[string]sdk.Coin)
+			for _, allocation := range allocations {
+				allocated[allocation.Name] = allocation.Amount
+			}
+			assert.Equal(t, tc.expectedStakingAmt, allocated[types.RecipientNameStakingRewards])
+			assert.Equal(t, tc.expectedStrategicAmt, allocated[types.RecipientNameStrategicReserve])
+			assert.Equal(t, tc.expectedCommunityAmt, allocated[types.RecipientNameCommunityPool])
 
 			// Get balances
 			var balanceStrategicReserve sdk.Coin
@@ -121,6 +128,79 @@ func TestMintAndAllocateInflation(t *testing.T) {
 	}
 }
 
+func TestAllocateToInflationRecipients(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	rootAddr := testutil.AccAddress()
+	nibiruApp.SudoKeeper.Sudoers.Set(ctx, sudotypes.Sudoers{
+		Root:      rootAddr.String(),
+		Contracts: []string{},
+	})
+
+	treasuryAddr := testutil.AccAddress()
+	quarter := sdkmath.LegacyNewDecWithPrec(25, 2)
+	params := types.DefaultParams()
+	params.InflationRecipients = []types.InflationRecipient{
+		{
+			Name:          "treasury_pool",
+			RecipientType: types.INFLATION_RECIPIENT_TYPE_MODULE_ACCOUNT,
+			Target:        common.TreasuryPoolModuleAccount,
+			Weight:        quarter,
+		},
+		{
+			Name:          "treasury",
+			RecipientType: types.INFLATION_RECIPIENT_TYPE_ADDRESS,
+			Target:        treasuryAddr.String(),
+			Weight:        quarter,
+		},
+		{
+			Name:          "oracle",
+			RecipientType: types.INFLATION_RECIPIENT_TYPE_ORACLE_REWARDS,
+			Weight:        quarter,
+			VotePeriods:   10,
+		},
+		types.StrategicReserveRecipient(quarter),
+	}
+	require.NoError(t, params.Validate())
+
+	oracleAddr := nibiruApp.AccountKeeper.GetModuleAddress(oracletypes.ModuleName)
+	oracleBalanceBefore := nibiruApp.BankKeeper.GetBalance(ctx, oracleAddr, denoms.NIBI)
+
+	allocations, err := nibiruApp.InflationKeeper.MintAndAllocateInflation(
+		ctx, sdk.NewInt64Coin(denoms.NIBI, 1_001), params,
+	)
+	require.NoError(t, err)
+
+	treasuryPoolAddr := nibiruApp.AccountKeeper.GetModuleAddress(common.TreasuryPoolModuleAccount)
+	wantAllocations := []types.InflationAllocation{
+		{Name: "treasury_pool", Recipient: treasuryPoolAddr.String(), Amount: sdk.NewInt64Coin(denoms.NIBI, 250)},
+		{Name: "treasury", Recipient: treasuryAddr.String(), Amount: sdk.NewInt64Coin(denoms.NIBI, 250)},
+		{Name: "oracle", Recipient: oracleAddr.String(), Amount: sdk.NewInt64Coin(denoms.NIBI, 250)},
+		// The last recipient receives the rounding remainder.
+		{Name: types.RecipientNameStrategicReserve, Recipient: rootAddr.String(), Amount: sdk.NewInt64Coin(denoms.NIBI, 251)},
+	}
+	require.Equal(t, wantAllocations, allocations)
+
+	bk := nibiruApp.BankKeeper
+	require.EqualValues(t, 250, bk.GetBalance(ctx, treasuryPoolAddr, denoms.NIBI).Amount.Int64())
+	require.EqualValues(t, 250, bk.GetBalance(ctx, treasuryAddr, denoms.NIBI).Amount.Int64())
+	require.EqualValues(t, 251, bk.GetBalance(ctx, rootAddr, denoms.NIBI).Amount.Int64())
+	require.Equal(t,
+		oracleBalanceBefore.AddAmount(sdkmath.NewInt(250)),
+		bk.GetBalance(ctx, oracleAddr, denoms.NIBI),
+	)
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 25)),
+		nibiruApp.OracleKeeper.GatherRewardsForVotePeriod(ctx),
+	)
+
+	testutil.RequireContainsTypedEvent(t, ctx, &types.EventInflationDistribution{
+		StakingRewards:   sdk.NewInt64Coin(denoms.NIBI, 0),
+		StrategicReserve: sdk.NewInt64Coin(denoms.NIBI, 251),
+		CommunityPool:    sdk.NewInt64Coin(denoms.NIBI, 0),
+		Allocations:      wantAllocations,
+	})
+}
+
 func TestGetCirculatingSupplyAndInflationRate(t *testing.T) {
 	testCases := []struct {
 		name             string
@@ -133,10 +213,10 @@ func TestGetCirculatingSupplyAndInflationRate(t *testing.T) {
 			sdk.TokensFromConsensusPower(400_000_000-100_000_001, sdk.DefaultPowerReduction),
 			func(nibiruApp *app.NibiruApp, ctx sdk.Context) {
 				nibiruApp.InflationKeeper.Params.Set(ctx, types.Params{
-					EpochsPerPeriod:       0,
-					InflationEnabled:      true,
-					PolynomialFactors:     types.DefaultPolynomialFactors,
-					InflationDistribution: types.DefaultInflationDistribution,
+					EpochsPerPeriod:     0,
+					InflationEnabled:    true,
+					PolynomialFactors:   types.DefaultPolynomialFactors,
+					InflationRecipients: types.DefaultInflationRecipients,
 				})
 			},
 			sdkmath.LegacyZeroDec(),
@@ -175,6 +255,12 @@ func TestGetCirculatingSupplyAndInflationRate(t *testing.T) {
 			)
 			err := nibiruApp.InflationKeeper.MintCoins(ctx, coin)
 			require.NoError(t, err)
+			// Balances held by the inflation module are excluded from the
+			// circulating supply, so the minted coins are moved to a user.
+			err = nibiruApp.BankKeeper.SendCoinsFromModuleToAccount(
+				ctx, types.ModuleName, testutil.AccAddress(), sdk.NewCoins(coin),
+			)
+			require.NoError(t, err)
 
 			circulatingSupply := nibiruApp.InflationKeeper.GetCirculatingSupply(ctx, denoms.NIBI)
 			require.EqualValues(t, tc.supply.Add(sdk.TokensFromConsensusPower(100_000_001, sdk.DefaultPowerReduction)), circulatingSupply)
@@ -191,7 +277,7 @@ func TestGetters(t *testing.T) {
 	require.NotPanics(t, func() {
 		_ = k.GetPolynomialFactors(ctx)
 		_ = k.GetPeriodsPerYear(ctx)
-		_ = k.GetInflationDistribution(ctx)
+		_ = k.GetInflationRecipients(ctx)
 		_ = k.GetInflationEnabled(ctx)
 		_ = k.GetEpochsPerPeriod(ctx)
 	})
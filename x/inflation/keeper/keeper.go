@@ -23,6 +23,8 @@ type Keeper struct {
 	distrKeeper   types.DistrKeeper
 	stakingKeeper types.StakingKeeper
 	sudoKeeper    types.SudoKeeper
+	oracleKeeper  types.OracleKeeper
+	epochsKeeper  types.EpochsKeeper
 	// feeCollectorName is the name of x/auth module's fee collector module
 	// account, "fee_collector", which collects transaction fees for distribution
 	// to all stakers.
@@ -46,6 +48,13 @@ type Keeper struct {
 	// economics, token release schedule, maximum supply, and whether or not
 	// inflation is enabled on the network.
 	Params collections.Item[types.Params]
+
+	// VestingAccounts indexes the addresses of the vesting accounts that
+	// haven't finished vesting, so that the unvested supply is computed without
+	// iterating over every account. The x/vesting module is disabled on
+	// Nibiru, so vesting accounts only come from genesis, and the index is
+	// built with [Keeper.IndexVestingAccounts] in "InitGenesis" and upgrades.
+	VestingAccounts collections.KeySet[sdk.AccAddress]
 }
 
 // NewKeeper creates a new mint Keeper instance
@@ -57,6 +66,8 @@ func NewKeeper(
 	distributionKeeper types.DistrKeeper,
 	stakingKeeper types.StakingKeeper,
 	sudoKeeper types.SudoKeeper,
+	oracleKeeper types.OracleKeeper,
+	epochsKeeper types.EpochsKeeper,
 	feeCollectorName string,
 ) Keeper {
 	// ensure mint module account is set
@@ -72,10 +83,13 @@ func NewKeeper(
 		distrKeeper:      distributionKeeper,
 		stakingKeeper:    stakingKeeper,
 		sudoKeeper:       sudoKeeper,
+		oracleKeeper:     oracleKeeper,
+		epochsKeeper:     epochsKeeper,
 		feeCollectorName: feeCollectorName,
 		CurrentPeriod:    collections.NewSequence(storeKey, 0),
 		NumSkippedEpochs: collections.NewSequence(storeKey, 1),
 		Params:           collections.NewItem(storeKey, 2, collections.ProtoValueEncoder[types.Params](cdc)),
+		VestingAccounts:  collections.NewKeySet(storeKey, 3, collections.AccAddressKeyEncoder),
 	}
 }
 
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateInflationDistribution converts the deprecated
+// [types.Params.InflationDistribution] of an existing network into the
+// equivalent [types.Params.InflationRecipients] and clears it. Params that
+// were already migrated are left unchanged.
+func (k Keeper) MigrateInflationDistribution(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read inflation params: %w", err)
+	}
+	if params.InflationDistribution == nil {
+		return nil
+	}
+
+	params = params.WithoutLegacyInflationDistribution()
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid inflation params after migration: %w", err)
+	}
+
+	k.Params.Set(ctx, params)
+	return nil
+}
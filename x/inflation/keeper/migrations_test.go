@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/inflation/types"
+)
+
+func TestMigrateInflationDistribution(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	k := nibiruApp.InflationKeeper
+
+	t.Log("params stored before the migration only have the distribution")
+	legacy := types.InflationDistribution{
+		StakingRewards:    sdkmath.LegacyMustNewDecFromStr("0.5"),
+		CommunityPool:     sdkmath.LegacyMustNewDecFromStr("0.3"),
+		StrategicReserves: sdkmath.LegacyMustNewDecFromStr("0.2"),
+	}
+	params := k.GetParams(ctx)
+	params.InflationDistribution = &legacy
+	params.InflationRecipients = nil
+	k.Params.Set(ctx, params)
+
+	require.NoError(t, k.MigrateInflationDistribution(ctx))
+	migrated := k.GetParams(ctx)
+	require.Nil(t, migrated.InflationDistribution)
+	require.Equal(t, []types.InflationRecipient{
+		types.StakingRewardsRecipient(legacy.StakingRewards),
+		types.CommunityPoolRecipient(legacy.CommunityPool),
+		types.StrategicReserveRecipient(legacy.StrategicReserves),
+	}, migrated.InflationRecipients)
+	require.NoError(t, migrated.Validate())
+
+	t.Log("the migration is a no-op for migrated params")
+	require.NoError(t, k.MigrateInflationDistribution(ctx))
+	require.Equal(t, migrated, k.GetParams(ctx))
+}
+
+func TestMigrateInflationDistributionZeroBucket(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	k := nibiruApp.InflationKeeper
+
+	t.Log("zero-weight buckets were valid in the distribution")
+	legacy := types.InflationDistribution{
+		StakingRewards:    sdkmath.LegacyOneDec(),
+		CommunityPool:     sdkmath.LegacyZeroDec(),
+		StrategicReserves: sdkmath.LegacyZeroDec(),
+	}
+	params := k.GetParams(ctx)
+	params.InflationDistribution = &legacy
+	params.InflationRecipients = nil
+	k.Params.Set(ctx, params)
+
+	require.NoError(t, k.MigrateInflationDistribution(ctx))
+	migrated := k.GetParams(ctx)
+	require.Nil(t, migrated.InflationDistribution)
+	require.Equal(t, []types.InflationRecipient{
+		types.StakingRewardsRecipient(sdkmath.LegacyOneDec()),
+	}, migrated.InflationRecipients)
+	require.NoError(t, migrated.Validate())
+}
@@ -17,9 +17,9 @@ func (k Keeper) GetPolynomialFactors(ctx sdk.Context) (res []sdkmath.LegacyDec)
 	return params.PolynomialFactors
 }
 
-func (k Keeper) GetInflationDistribution(ctx sdk.Context) (res types.InflationDistribution) {
+func (k Keeper) GetInflationRecipients(ctx sdk.Context) (res []types.InflationRecipient) {
 	params, _ := k.Params.Get(ctx)
-	return params.InflationDistribution
+	return params.InflationRecipients
 }
 
 func (k Keeper) GetInflationEnabled(ctx sdk.Context) (res bool) {
@@ -41,6 +41,15 @@ func (k sudoExtension) EditInflationParams(
 	if err != nil {
 		return
 	}
+	for _, recipient := range paramsAfter.InflationRecipients {
+		if recipient.RecipientType == inflationtypes.INFLATION_RECIPIENT_TYPE_MODULE_ACCOUNT &&
+			k.accountKeeper.GetModuleAddress(recipient.Target) == nil {
+			return fmt.Errorf(
+				"inflation recipient %s: module account %s does not exist",
+				recipient.Name, recipient.Target,
+			)
+		}
+	}
 	k.Params.Set(ctx, paramsAfter)
 	return paramsAfter.Validate()
 }
@@ -80,7 +89,12 @@ func MergeInflationParams(
 	}
 
 	if partial.InflationDistribution != nil {
-		inflationParams.InflationDistribution = *partial.InflationDistribution
+		inflationParams.InflationRecipients = inflationtypes.LegacyInflationRecipients(
+			*partial.InflationDistribution,
+		)
+	}
+	if len(partial.InflationRecipients) > 0 {
+		inflationParams.InflationRecipients = partial.InflationRecipients
 	}
 
 	if partial.EpochsPerPeriod != nil {
@@ -92,6 +106,12 @@ func MergeInflationParams(
 	if partial.MaxPeriod != nil {
 		inflationParams.MaxPeriod = partial.MaxPeriod.Uint64()
 	}
+	if partial.CirculatingSupplyExclusions != nil {
+		inflationParams.CirculatingSupplyExclusions = *partial.CirculatingSupplyExclusions
+	}
+	if partial.BondedRatioInflation != nil {
+		inflationParams.BondedRatioInflation = *partial.BondedRatioInflation
+	}
 
 	return inflationParams, inflationParams.Validate()
 }
@@ -38,7 +38,7 @@ func (s *SuiteInflationSudo) TestMergeInflationParams() {
 	s.Require().EqualValues(currentParams.PeriodsPerYear, paramsAfter.PeriodsPerYear)
 	s.Require().EqualValues(currentParams.MaxPeriod, paramsAfter.MaxPeriod)
 	s.Require().EqualValues(currentParams.PolynomialFactors, paramsAfter.PolynomialFactors)
-	s.Require().EqualValues(currentParams.InflationDistribution, paramsAfter.InflationDistribution)
+	s.Require().EqualValues(currentParams.InflationRecipients, paramsAfter.InflationRecipients)
 
 	// Test a change to all parameters
 	newInflationDistribution := types.InflationDistribution{
@@ -67,7 +67,27 @@ func (s *SuiteInflationSudo) TestMergeInflationParams() {
 		sdkmath.LegacyMustNewDecFromStr("0.1"),
 		sdkmath.LegacyMustNewDecFromStr("0.2"),
 	}, paramsAfter.PolynomialFactors)
-	s.Require().EqualValues(newInflationDistribution, paramsAfter.InflationDistribution)
+	s.Require().EqualValues(
+		types.LegacyInflationRecipients(newInflationDistribution),
+		paramsAfter.InflationRecipients,
+	)
+	s.Require().Nil(paramsAfter.InflationDistribution)
+
+	// Test a change to the inflation recipients
+	newRecipients := []types.InflationRecipient{
+		types.StakingRewardsRecipient(sdkmath.LegacyMustNewDecFromStr("0.5")),
+		{
+			Name:          "oracle",
+			RecipientType: types.INFLATION_RECIPIENT_TYPE_ORACLE_REWARDS,
+			Weight:        sdkmath.LegacyMustNewDecFromStr("0.5"),
+			VotePeriods:   100,
+		},
+	}
+	paramsAfter, err = inflationKeeper.MergeInflationParams(types.MsgEditInflationParams{
+		InflationRecipients: newRecipients,
+	}, currentParams)
+	s.Require().NoError(err)
+	s.Require().EqualValues(newRecipients, paramsAfter.InflationRecipients)
 }
 
 func (s *SuiteInflationSudo) TestEditInflationParams() {
@@ -81,17 +101,22 @@ func (s *SuiteInflationSudo) TestEditInflationParams() {
 		sdkmath.LegacyMustNewDecFromStr("0.1"),
 		sdkmath.LegacyMustNewDecFromStr("0.2"),
 	}
-	inflationDistribution := types.InflationDistribution{
-		CommunityPool:     sdkmath.LegacyMustNewDecFromStr("0.8"),
-		StakingRewards:    sdkmath.LegacyMustNewDecFromStr("0.1"),
-		StrategicReserves: sdkmath.LegacyMustNewDecFromStr("0.1"),
+	inflationRecipients := []types.InflationRecipient{
+		types.StakingRewardsRecipient(sdkmath.LegacyMustNewDecFromStr("0.1")),
+		types.CommunityPoolRecipient(sdkmath.LegacyMustNewDecFromStr("0.8")),
+		{
+			Name:          "treasury",
+			RecipientType: types.INFLATION_RECIPIENT_TYPE_ADDRESS,
+			Target:        testutil.AccAddress().String(),
+			Weight:        sdkmath.LegacyMustNewDecFromStr("0.1"),
+		},
 	}
 	msgEditParams := types.MsgEditInflationParams{
-		EpochsPerPeriod:       &epochsPerPeriod,
-		PeriodsPerYear:        &periodsPerYear,
-		MaxPeriod:             &maxPeriod,
-		PolynomialFactors:     polynomialFactors,
-		InflationDistribution: &inflationDistribution,
+		EpochsPerPeriod:     &epochsPerPeriod,
+		PeriodsPerYear:      &periodsPerYear,
+		MaxPeriod:           &maxPeriod,
+		PolynomialFactors:   polynomialFactors,
+		InflationRecipients: inflationRecipients,
 	}
 
 	s.T().Log("Params before MUST NOT be equal to default")
@@ -114,7 +139,18 @@ func (s *SuiteInflationSudo) TestEditInflationParams() {
 	s.Require().EqualValues(1234, paramsAfter.PeriodsPerYear)
 	s.Require().EqualValues(1234, paramsAfter.MaxPeriod)
 	s.Require().EqualValues(polynomialFactors, paramsAfter.PolynomialFactors)
-	s.Require().EqualValues(inflationDistribution, paramsAfter.InflationDistribution)
+	s.Require().EqualValues(inflationRecipients, paramsAfter.InflationRecipients)
+
+	s.T().Log("EditInflationParams should fail for an unknown module account")
+	err = nibiru.InflationKeeper.Sudo().EditInflationParams(ctx, types.MsgEditInflationParams{
+		InflationRecipients: []types.InflationRecipient{{
+			Name:          "unknown",
+			RecipientType: types.INFLATION_RECIPIENT_TYPE_MODULE_ACCOUNT,
+			Target:        "not_a_module",
+			Weight:        sdkmath.LegacyOneDec(),
+		}},
+	}, okSender)
+	s.Require().ErrorContains(err, "module account not_a_module does not exist")
 }
 
 func (s *SuiteInflationSudo) TestToggleInflation() {
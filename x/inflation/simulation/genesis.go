@@ -25,10 +25,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 				sdkmath.LegacyMustNewDecFromStr("-338072.17402939"),
 				sdkmath.LegacyMustNewDecFromStr("17999834.20786474"),
 			},
-			InflationDistribution: types.InflationDistribution{
-				CommunityPool:     sdkmath.LegacyNewDecWithPrec(35_142714, 8), // 35.142714%
-				StakingRewards:    sdkmath.LegacyNewDecWithPrec(27_855672, 8), // 27.855672%
-				StrategicReserves: sdkmath.LegacyNewDecWithPrec(37_001614, 8), // 37.001614%
+			InflationRecipients: []types.InflationRecipient{
+				types.StakingRewardsRecipient(sdkmath.LegacyNewDecWithPrec(27_855672, 8)),   // 27.855672%
+				types.CommunityPoolRecipient(sdkmath.LegacyNewDecWithPrec(35_142714, 8)),    // 35.142714%
+				types.StrategicReserveRecipient(sdkmath.LegacyNewDecWithPrec(37_001614, 8)), // 37.001614%
 			},
 			EpochsPerPeriod: 30,
 			PeriodsPerYear:  12,
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// DefaultBondedRatioInflation keeps the bonded ratio multiplier off. When
+// enabled, inflation targets two thirds of the staking supply being bonded
+// and moves within half and one and a half times the polynomial.
+var DefaultBondedRatioInflation = BondedRatioInflation{
+	Enabled:           false,
+	TargetBondedRatio: sdkmath.LegacyMustNewDecFromStr("0.67"),
+	MinMultiplier:     sdkmath.LegacyMustNewDecFromStr("0.5"),
+	MaxMultiplier:     sdkmath.LegacyMustNewDecFromStr("1.5"),
+}
+
+// Validate checks that the target bonded ratio is in (0, 1] and that the
+// multipliers are positive with the minimum not above the maximum. A zero
+// multiplier would mint nothing for the epoch, which the inflation hook treats
+// as a skipped mint that does not advance the period. A disabled mode with no
+// values set is valid, since that is how the params of networks that predate
+// the mode are stored.
+func (b BondedRatioInflation) Validate() error {
+	if !b.Enabled && b.TargetBondedRatio.IsNil() &&
+		b.MinMultiplier.IsNil() && b.MaxMultiplier.IsNil() {
+		return nil
+	}
+
+	switch {
+	case b.TargetBondedRatio.IsNil() || b.MinMultiplier.IsNil() || b.MaxMultiplier.IsNil():
+		return fmt.Errorf("bonded ratio inflation: target bonded ratio, min multiplier and max multiplier must be set")
+	case !b.TargetBondedRatio.IsPositive() || b.TargetBondedRatio.GT(sdkmath.LegacyOneDec()):
+		return fmt.Errorf("bonded ratio inflation: target bonded ratio must be in (0, 1], got %s", b.TargetBondedRatio)
+	case !b.MinMultiplier.IsPositive():
+		return fmt.Errorf("bonded ratio inflation: min multiplier must be positive, got %s", b.MinMultiplier)
+	case b.MinMultiplier.GT(b.MaxMultiplier):
+		return fmt.Errorf(
+			"bonded ratio inflation: min multiplier %s is greater than max multiplier %s",
+			b.MinMultiplier, b.MaxMultiplier,
+		)
+	}
+	return nil
+}
+
+// Multiplier returns the factor applied to the epoch mint provision for the
+// given staking bonded ratio:
+//
+//	1 + (target - bondedRatio) / target
+//
+// clamped to [MinMultiplier, MaxMultiplier]. It is one when the mode is
+// disabled.
+func (b BondedRatioInflation) Multiplier(bondedRatio sdkmath.LegacyDec) sdkmath.LegacyDec {
+	if !b.Enabled {
+		return sdkmath.LegacyOneDec()
+	}
+
+	multiplier := sdkmath.LegacyOneDec().Add(
+		b.TargetBondedRatio.Sub(bondedRatio).Quo(b.TargetBondedRatio),
+	)
+	if multiplier.LT(b.MinMultiplier) {
+		return b.MinMultiplier
+	}
+	if multiplier.GT(b.MaxMultiplier) {
+		return b.MaxMultiplier
+	}
+	return multiplier
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Labels of [ExcludedBalance] entries that are not module account names.
+const (
+	ExcludedLabelStrategicReserve = "strategic_reserve"
+	ExcludedLabelAddress          = "address"
+)
+
+// DefaultCirculatingSupplyExclusions excludes the inflation module escrow and
+// the strategic reserve from the circulating supply.
+var DefaultCirculatingSupplyExclusions = CirculatingSupplyExclusions{
+	ModuleAccounts:   []string{ModuleName},
+	StrategicReserve: true,
+}
+
+// Validate checks that the module account names are non-empty, the addresses
+// are valid bech32 account addresses, and that neither list has duplicates.
+func (e CirculatingSupplyExclusions) Validate() error {
+	seenModules := make(map[string]struct{}, len(e.ModuleAccounts))
+	for _, name := range e.ModuleAccounts {
+		if name == "" {
+			return fmt.Errorf("circulating supply exclusions: empty module account name")
+		}
+		if _, seen := seenModules[name]; seen {
+			return fmt.Errorf("circulating supply exclusions: duplicate module account %s", name)
+		}
+		seenModules[name] = struct{}{}
+	}
+
+	seenAddrs := make(map[string]struct{}, len(e.Addresses))
+	for _, addr := range e.Addresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("circulating supply exclusions: invalid address %s: %w", addr, err)
+		}
+		if _, seen := seenAddrs[addr]; seen {
+			return fmt.Errorf("circulating supply exclusions: duplicate address %s", addr)
+		}
+		seenAddrs[addr] = struct{}{}
+	}
+	return nil
+}
@@ -25,11 +25,15 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // EventInflationDistribution: Emitted when NIBI tokens are minted on the
-// network based on Nibiru's inflation schedule.
+// network based on Nibiru's inflation schedule. The staking_rewards,
+// strategic_reserve and community_pool fields sum the allocations to the fee
+// collector, the strategic reserve and the community pool respectively.
 type EventInflationDistribution struct {
 	StakingRewards   types.Coin `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3" json:"staking_rewards" yaml:"staking_rewards"`
 	StrategicReserve types.Coin `protobuf:"bytes,2,opt,name=strategic_reserve,json=strategicReserve,proto3" json:"strategic_reserve" yaml:"strategic_reserve"`
 	CommunityPool    types.Coin `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3" json:"community_pool" yaml:"community_pool"`
+	// allocations lists the amount sent to each inflation recipient.
+	Allocations []InflationAllocation `protobuf:"bytes,4,rep,name=allocations,proto3" json:"allocations"`
 }
 
 func (m *EventInflationDistribution) Reset()         { *m = EventInflationDistribution{} }
@@ -86,35 +90,113 @@ func (m *EventInflationDistribution) GetCommunityPool() types.Coin {
 	return types.Coin{}
 }
 
+func (m *EventInflationDistribution) GetAllocations() []InflationAllocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+// InflationAllocation is the amount of minted tokens sent to one inflation
+// recipient.
+type InflationAllocation struct {
+	// name is the name of the inflation recipient.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// recipient is the address that received the tokens.
+	Recipient string     `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *InflationAllocation) Reset()         { *m = InflationAllocation{} }
+func (m *InflationAllocation) String() string { return proto.CompactTextString(m) }
+func (*InflationAllocation) ProtoMessage()    {}
+func (*InflationAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18fa0385facaf5d9, []int{1}
+}
+func (m *InflationAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationAllocation.Merge(m, src)
+}
+func (m *InflationAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationAllocation proto.InternalMessageInfo
+
+func (m *InflationAllocation) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InflationAllocation) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *InflationAllocation) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*EventInflationDistribution)(nil), "nibiru.inflation.v1.EventInflationDistribution")
+	proto.RegisterType((*InflationAllocation)(nil), "nibiru.inflation.v1.InflationAllocation")
 }
 
 func init() { proto.RegisterFile("nibiru/inflation/v1/event.proto", fileDescriptor_18fa0385facaf5d9) }
 
 var fileDescriptor_18fa0385facaf5d9 = []byte{
-	// 336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xbf, 0x4e, 0xeb, 0x30,
-	0x14, 0xc6, 0x93, 0x5e, 0xe9, 0x0e, 0xb9, 0xba, 0x05, 0xc2, 0x1f, 0x85, 0x4a, 0xb8, 0x55, 0x26,
-	0x26, 0x5b, 0x29, 0x1b, 0x63, 0x0b, 0x03, 0x12, 0x42, 0x28, 0x23, 0x4b, 0x64, 0x07, 0x93, 0x5a,
-	0x24, 0x3e, 0x95, 0xed, 0x04, 0xfa, 0x16, 0x3c, 0x56, 0x25, 0x96, 0x8e, 0x4c, 0x15, 0x6a, 0xdf,
-	0x80, 0x27, 0x40, 0xa9, 0xd3, 0x0a, 0xba, 0x74, 0x3b, 0xfa, 0xce, 0x39, 0xbf, 0xdf, 0xf0, 0x79,
-	0x5d, 0x29, 0x98, 0x50, 0x25, 0x11, 0xf2, 0x29, 0xa7, 0x46, 0x80, 0x24, 0x55, 0x44, 0x78, 0xc5,
-	0xa5, 0xc1, 0x63, 0x05, 0x06, 0xfc, 0x43, 0x7b, 0x80, 0x37, 0x07, 0xb8, 0x8a, 0x3a, 0x47, 0x19,
-	0x64, 0xb0, 0xda, 0x93, 0x7a, 0xb2, 0xa7, 0x1d, 0x94, 0x82, 0x2e, 0x40, 0x13, 0x46, 0x35, 0x27,
-	0x55, 0xc4, 0xb8, 0xa1, 0x11, 0x49, 0x41, 0x48, 0xbb, 0x0f, 0xdf, 0x5b, 0x5e, 0xe7, 0xba, 0x46,
-	0xdf, 0xac, 0x59, 0x57, 0x42, 0x1b, 0x25, 0x58, 0x59, 0xcf, 0x3e, 0xf3, 0xf6, 0xb4, 0xa1, 0xcf,
-	0x42, 0x66, 0x89, 0xe2, 0x2f, 0x54, 0x3d, 0xea, 0xc0, 0xed, 0xb9, 0xe7, 0xff, 0xfa, 0xa7, 0xd8,
-	0x82, 0x71, 0x0d, 0xc6, 0x0d, 0x18, 0x0f, 0x41, 0xc8, 0x01, 0x9a, 0xce, 0xbb, 0xce, 0xd7, 0xbc,
-	0x7b, 0x32, 0xa1, 0x45, 0x7e, 0x19, 0x6e, 0xfd, 0x87, 0x71, 0xbb, 0x49, 0x62, 0x1b, 0xf8, 0x23,
-	0xef, 0x40, 0x1b, 0x45, 0x0d, 0xcf, 0x44, 0x9a, 0x28, 0xae, 0xb9, 0xaa, 0x78, 0xd0, 0xda, 0x65,
-	0xe9, 0x35, 0x96, 0x60, 0x6d, 0xd9, 0x22, 0x84, 0xf1, 0xfe, 0x26, 0x8b, 0x6d, 0xe4, 0x27, 0x5e,
-	0x3b, 0x85, 0xa2, 0x28, 0xa5, 0x30, 0x93, 0x64, 0x0c, 0x90, 0x07, 0x7f, 0x76, 0x69, 0xce, 0x1a,
-	0xcd, 0xb1, 0xd5, 0xfc, 0x7e, 0x0f, 0xe3, 0xff, 0x9b, 0xe0, 0x1e, 0x20, 0x1f, 0xdc, 0x4e, 0x17,
-	0xc8, 0x9d, 0x2d, 0x90, 0xfb, 0xb9, 0x40, 0xee, 0xdb, 0x12, 0x39, 0xb3, 0x25, 0x72, 0x3e, 0x96,
-	0xc8, 0x79, 0xe8, 0x67, 0xc2, 0x8c, 0x4a, 0x86, 0x53, 0x28, 0xc8, 0xdd, 0xaa, 0xbd, 0xe1, 0x88,
-	0x0a, 0x49, 0x9a, 0xaa, 0xab, 0x3e, 0x79, 0xfd, 0xd1, 0xb7, 0x99, 0x8c, 0xb9, 0x66, 0x7f, 0x57,
-	0x15, 0x5d, 0x7c, 0x07, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x1d, 0x75, 0xa0, 0x10, 0x02, 0x00, 0x00,
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x41, 0x8e, 0xd3, 0x30,
+	0x14, 0x86, 0x1b, 0x5a, 0x8d, 0x54, 0x57, 0x0c, 0xe0, 0x01, 0x14, 0x2a, 0x70, 0xab, 0xac, 0xba,
+	0xb2, 0xd5, 0xb2, 0x40, 0x62, 0x47, 0x07, 0x16, 0x48, 0x08, 0x8d, 0xbc, 0x64, 0x13, 0x39, 0xc1,
+	0xa4, 0x16, 0x89, 0x5f, 0x64, 0x3b, 0x81, 0xee, 0x38, 0x02, 0x17, 0xe0, 0x3e, 0xb3, 0x9c, 0x25,
+	0xab, 0x11, 0x6a, 0x6f, 0xc0, 0x09, 0x50, 0xe2, 0x4c, 0x98, 0x19, 0x8d, 0xd4, 0xdd, 0xd3, 0xef,
+	0xff, 0xfd, 0x9f, 0xfd, 0xfc, 0xd0, 0x4c, 0xab, 0x44, 0x99, 0x8a, 0x29, 0xfd, 0x25, 0x17, 0x4e,
+	0x81, 0x66, 0xf5, 0x92, 0xc9, 0x5a, 0x6a, 0x47, 0x4b, 0x03, 0x0e, 0xf0, 0x89, 0x37, 0xd0, 0xde,
+	0x40, 0xeb, 0xe5, 0xf4, 0x71, 0x06, 0x19, 0xb4, 0xe7, 0xac, 0xa9, 0xbc, 0x75, 0x4a, 0x52, 0xb0,
+	0x05, 0x58, 0x96, 0x08, 0x2b, 0x59, 0xbd, 0x4c, 0xa4, 0x13, 0x4b, 0x96, 0x82, 0xd2, 0xfe, 0x3c,
+	0xfa, 0x35, 0x44, 0xd3, 0x77, 0x4d, 0xf4, 0xfb, 0xab, 0xac, 0xb7, 0xca, 0x3a, 0xa3, 0x92, 0xaa,
+	0xa9, 0x71, 0x82, 0x1e, 0x58, 0x27, 0xbe, 0x2a, 0x9d, 0xc5, 0x46, 0x7e, 0x13, 0xe6, 0xb3, 0x0d,
+	0x83, 0x79, 0xb0, 0x98, 0xac, 0x9e, 0x51, 0x1f, 0x4c, 0x9b, 0x60, 0xda, 0x05, 0xd3, 0x53, 0x50,
+	0x7a, 0x4d, 0xce, 0x2f, 0x67, 0x83, 0xbf, 0x97, 0xb3, 0xa7, 0x5b, 0x51, 0xe4, 0xaf, 0xa3, 0x5b,
+	0xfd, 0x11, 0x3f, 0xee, 0x14, 0xee, 0x05, 0xbc, 0x41, 0x8f, 0xac, 0x33, 0xc2, 0xc9, 0x4c, 0xa5,
+	0xb1, 0x91, 0x56, 0x9a, 0x5a, 0x86, 0xf7, 0x0e, 0x51, 0xe6, 0x1d, 0x25, 0xbc, 0xa2, 0xdc, 0x4a,
+	0x88, 0xf8, 0xc3, 0x5e, 0xe3, 0x5e, 0xc2, 0x31, 0x3a, 0x4e, 0xa1, 0x28, 0x2a, 0xad, 0xdc, 0x36,
+	0x2e, 0x01, 0xf2, 0x70, 0x78, 0x08, 0xf3, 0xa2, 0xc3, 0x3c, 0xf1, 0x98, 0x9b, 0xed, 0x11, 0xbf,
+	0xdf, 0x0b, 0x67, 0x00, 0x39, 0x3e, 0x43, 0x13, 0x91, 0xe7, 0x90, 0xb6, 0x83, 0xb4, 0xe1, 0x68,
+	0x3e, 0x5c, 0x4c, 0x56, 0x0b, 0x7a, 0xc7, 0x77, 0xd1, 0x7e, 0xde, 0x6f, 0xfa, 0x86, 0xf5, 0xa8,
+	0x81, 0xf1, 0xeb, 0x11, 0xd1, 0x8f, 0x00, 0x9d, 0xdc, 0x61, 0xc5, 0x18, 0x8d, 0xb4, 0x28, 0x64,
+	0xfb, 0x1b, 0x63, 0xde, 0xd6, 0xf8, 0x39, 0x1a, 0x1b, 0x99, 0xaa, 0x52, 0x49, 0xed, 0xda, 0x01,
+	0x8e, 0xf9, 0x7f, 0x01, 0xbf, 0x42, 0x47, 0xa2, 0x80, 0x4a, 0xbb, 0xc3, 0x8f, 0xf6, 0xf7, 0xe8,
+	0xec, 0xeb, 0x0f, 0xe7, 0x3b, 0x12, 0x5c, 0xec, 0x48, 0xf0, 0x67, 0x47, 0x82, 0x9f, 0x7b, 0x32,
+	0xb8, 0xd8, 0x93, 0xc1, 0xef, 0x3d, 0x19, 0x7c, 0x5a, 0x65, 0xca, 0x6d, 0xaa, 0x84, 0xa6, 0x50,
+	0xb0, 0x8f, 0xed, 0x1b, 0x4f, 0x37, 0x42, 0x69, 0xd6, 0xed, 0x6f, 0xbd, 0x62, 0xdf, 0xaf, 0x2d,
+	0xb1, 0xdb, 0x96, 0xd2, 0x26, 0x47, 0xed, 0xde, 0xbd, 0xfc, 0x37, 0x00, 0xfb, 0xf4, 0xc8, 0x7a,
+	0xe5, 0x02, 0x00, 0x00,
 }
 
 func (m *EventInflationDistribution) Marshal() (dAtA []byte, err error) {
@@ -137,6 +219,20 @@ func (m *EventInflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.CommunityPool.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -170,6 +266,53 @@ func (m *EventInflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *InflationAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -193,6 +336,31 @@ func (m *EventInflationDistribution) Size() (n int) {
 	n += 1 + l + sovEvent(uint64(l))
 	l = m.CommunityPool.Size()
 	n += 1 + l + sovEvent(uint64(l))
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InflationAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
 	return n
 }
 
@@ -330,6 +498,187 @@ func (m *EventInflationDistribution) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, InflationAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -23,7 +23,8 @@ func DefaultGenesisState() *GenesisState {
 }
 
 // Validate performs basic genesis state validation returning an error upon any
-// failure.
+// failure. The deprecated inflation distribution of older exports is accepted
+// and validated as the recipients it converts to.
 func (gs GenesisState) Validate() error {
 	if err := validateUint64(gs.SkippedEpochs); err != nil {
 		return err
@@ -33,5 +34,5 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.Params.Validate()
+	return gs.Params.WithoutLegacyInflationDistribution().Validate()
 }
@@ -98,8 +98,9 @@ type Params struct {
 	// polynomial_factors takes in the variables to calculate polynomial
 	// inflation
 	PolynomialFactors []cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,rep,name=polynomial_factors,json=polynomialFactors,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"polynomial_factors"`
-	// inflation_distribution of the minted denom
-	InflationDistribution InflationDistribution `protobuf:"bytes,3,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution"`
+	// Deprecated: inflation_distribution is replaced by inflation_recipients.
+	// It is only read to migrate the params of existing networks.
+	InflationDistribution *InflationDistribution `protobuf:"bytes,3,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution,omitempty"` // Deprecated: Do not use.
 	// epochs_per_period is the number of epochs that must pass before a new
 	// period is created
 	EpochsPerPeriod uint64 `protobuf:"varint,4,opt,name=epochs_per_period,json=epochsPerPeriod,proto3" json:"epochs_per_period,omitempty"`
@@ -112,6 +113,15 @@ type Params struct {
 	// started. It's set to false at the starts, and stays at true when we toggle
 	// inflation on. It's used to track num skipped epochs
 	HasInflationStarted bool `protobuf:"varint,7,opt,name=has_inflation_started,json=hasInflationStarted,proto3" json:"has_inflation_started,omitempty"`
+	// circulating_supply_exclusions lists the accounts whose balances are not
+	// counted as circulating supply.
+	CirculatingSupplyExclusions CirculatingSupplyExclusions `protobuf:"bytes,8,opt,name=circulating_supply_exclusions,json=circulatingSupplyExclusions,proto3" json:"circulating_supply_exclusions"`
+	// inflation_recipients lists the accounts that receive the minted denom
+	// and the share of each. The weights sum to 1.
+	InflationRecipients []InflationRecipient `protobuf:"bytes,9,rep,name=inflation_recipients,json=inflationRecipients,proto3" json:"inflation_recipients"`
+	// bonded_ratio_inflation optionally scales the epoch mint provision of the
+	// polynomial by how far the staking bonded ratio is from a target.
+	BondedRatioInflation BondedRatioInflation `protobuf:"bytes,10,opt,name=bonded_ratio_inflation,json=bondedRatioInflation,proto3" json:"bonded_ratio_inflation"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -154,11 +164,12 @@ func (m *Params) GetInflationEnabled() bool {
 	return false
 }
 
-func (m *Params) GetInflationDistribution() InflationDistribution {
+// Deprecated: Do not use.
+func (m *Params) GetInflationDistribution() *InflationDistribution {
 	if m != nil {
 		return m.InflationDistribution
 	}
-	return InflationDistribution{}
+	return nil
 }
 
 func (m *Params) GetEpochsPerPeriod() uint64 {
@@ -189,6 +200,27 @@ func (m *Params) GetHasInflationStarted() bool {
 	return false
 }
 
+func (m *Params) GetCirculatingSupplyExclusions() CirculatingSupplyExclusions {
+	if m != nil {
+		return m.CirculatingSupplyExclusions
+	}
+	return CirculatingSupplyExclusions{}
+}
+
+func (m *Params) GetInflationRecipients() []InflationRecipient {
+	if m != nil {
+		return m.InflationRecipients
+	}
+	return nil
+}
+
+func (m *Params) GetBondedRatioInflation() BondedRatioInflation {
+	if m != nil {
+		return m.BondedRatioInflation
+	}
+	return BondedRatioInflation{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.inflation.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "nibiru.inflation.v1.Params")
@@ -197,38 +229,44 @@ func init() {
 func init() { proto.RegisterFile("nibiru/inflation/v1/genesis.proto", fileDescriptor_2d00e2bb98c08f74) }
 
 var fileDescriptor_2d00e2bb98c08f74 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x9b, 0x60, 0xe8, 0x16, 0x4a, 0xb3, 0xa5, 0x95, 0x69, 0x85, 0x1b, 0x8a, 0x90, 0xa2,
-	0x22, 0x6c, 0xc5, 0x9c, 0xb8, 0x86, 0x14, 0x84, 0x54, 0xa1, 0x28, 0x3d, 0xc1, 0xc5, 0xac, 0xed,
-	0xa9, 0xbd, 0x6a, 0xec, 0x5d, 0xed, 0x6e, 0xa2, 0xe4, 0x0f, 0x38, 0xf2, 0x1b, 0xdc, 0xf9, 0x88,
-	0x1e, 0x2b, 0x4e, 0x88, 0x43, 0x85, 0x92, 0x1f, 0xa9, 0xb2, 0xbb, 0x4d, 0x7a, 0xc8, 0x6d, 0x67,
-	0xde, 0x9b, 0xb7, 0xf3, 0x9e, 0x06, 0xbd, 0xac, 0x68, 0x42, 0xc5, 0x28, 0xa4, 0xd5, 0xc5, 0x90,
-	0x28, 0xca, 0xaa, 0x70, 0xdc, 0x09, 0x73, 0xa8, 0x40, 0x52, 0x19, 0x70, 0xc1, 0x14, 0xc3, 0xbb,
-	0x86, 0x12, 0x2c, 0x29, 0xc1, 0xb8, 0x73, 0xf0, 0x2c, 0x67, 0x39, 0xd3, 0x78, 0xb8, 0x78, 0x19,
-	0xea, 0xc1, 0xab, 0x75, 0x6a, 0xab, 0x39, 0x43, 0x7a, 0x9e, 0x32, 0x59, 0x32, 0x19, 0x9b, 0x69,
-	0x53, 0x18, 0xe8, 0xf8, 0x87, 0x83, 0x1e, 0x7f, 0x32, 0x9f, 0x9f, 0x2b, 0xa2, 0x00, 0xbf, 0x47,
-	0x2e, 0x27, 0x82, 0x94, 0xd2, 0x73, 0x5a, 0x4e, 0x7b, 0x2b, 0x3a, 0x0c, 0xd6, 0x2c, 0x13, 0xf4,
-	0x35, 0xa5, 0xdb, 0xb8, 0xba, 0x39, 0xaa, 0x0d, 0xec, 0x00, 0xde, 0x47, 0x2e, 0x07, 0x41, 0x59,
-	0xe6, 0x6d, 0xb4, 0x9c, 0x76, 0x63, 0x60, 0x2b, 0xfc, 0x1a, 0x6d, 0xcb, 0x4b, 0xca, 0x39, 0x64,
-	0x31, 0x70, 0x96, 0x16, 0xd2, 0xab, 0x6b, 0xfc, 0x89, 0xed, 0x9e, 0xea, 0xe6, 0xf1, 0xaf, 0x3a,
-	0x72, 0x8d, 0x2e, 0x7e, 0x83, 0x9a, 0xcb, 0xef, 0x62, 0xa8, 0x48, 0x32, 0x84, 0x4c, 0xef, 0xf3,
-	0x68, 0xb0, 0xb3, 0x04, 0x4e, 0x4d, 0x1f, 0x7f, 0x47, 0x98, 0xb3, 0xe1, 0xb4, 0x62, 0x25, 0x25,
-	0xc3, 0xf8, 0x82, 0xa4, 0x8a, 0x09, 0xe9, 0x6d, 0xb4, 0xea, 0xed, 0xcd, 0x6e, 0x67, 0xb1, 0xe0,
-	0xbf, 0x9b, 0xa3, 0x43, 0x63, 0x5a, 0x66, 0x97, 0x01, 0x65, 0x61, 0x49, 0x54, 0x11, 0x9c, 0x41,
-	0x4e, 0xd2, 0x69, 0x0f, 0xd2, 0x3f, 0xbf, 0xdf, 0x22, 0x9b, 0x49, 0x0f, 0xd2, 0x41, 0x73, 0x25,
-	0xf6, 0xd1, 0x68, 0xe1, 0x1c, 0xed, 0xaf, 0xd6, 0xc9, 0xa8, 0x54, 0x82, 0x26, 0xa3, 0x45, 0xa1,
-	0x8d, 0x6c, 0x45, 0x27, 0x6b, 0x33, 0xfa, 0x7c, 0x57, 0xf4, 0xee, 0x4d, 0xd8, 0xc8, 0xf6, 0xe8,
-	0x3a, 0x10, 0x9f, 0xa0, 0xa6, 0x49, 0x28, 0xe6, 0x20, 0x62, 0x1b, 0x66, 0x43, 0x87, 0xf5, 0xd4,
-	0x00, 0x7d, 0x10, 0x7d, 0x93, 0x6a, 0x1b, 0xed, 0x18, 0x82, 0x21, 0x4f, 0x81, 0x08, 0xef, 0x81,
-	0xa6, 0x6e, 0xdb, 0x7e, 0x1f, 0xc4, 0x57, 0x20, 0x02, 0xbf, 0x40, 0xa8, 0x24, 0x93, 0x3b, 0x39,
-	0x57, 0x73, 0x36, 0x4b, 0x32, 0xb1, 0x42, 0x11, 0xda, 0x2b, 0x88, 0x8c, 0x57, 0x0e, 0xa5, 0x22,
-	0x42, 0x41, 0xe6, 0x3d, 0xd4, 0x81, 0xef, 0x16, 0x44, 0x2e, 0xad, 0x9c, 0x1b, 0xa8, 0x7b, 0x76,
-	0x35, 0xf3, 0x9d, 0xeb, 0x99, 0xef, 0xfc, 0x9f, 0xf9, 0xce, 0xcf, 0xb9, 0x5f, 0xbb, 0x9e, 0xfb,
-	0xb5, 0xbf, 0x73, 0xbf, 0xf6, 0x2d, 0xca, 0xa9, 0x2a, 0x46, 0x49, 0x90, 0xb2, 0x32, 0xfc, 0xa2,
-	0x53, 0xf9, 0x50, 0x10, 0x5a, 0x85, 0xf6, 0x4e, 0xc7, 0x51, 0x38, 0xb9, 0x77, 0xac, 0x6a, 0xca,
-	0x41, 0x26, 0xae, 0xbe, 0xc5, 0x77, 0xb7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x85, 0x04, 0xb0, 0xba,
-	0x1b, 0x03, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x4f, 0xd4, 0x40,
+	0x18, 0xde, 0xf2, 0xb1, 0xc2, 0xa0, 0x08, 0xc3, 0x47, 0x2a, 0x84, 0x65, 0xc5, 0x18, 0x57, 0x8c,
+	0xad, 0xd4, 0x93, 0xd7, 0x05, 0x34, 0x26, 0xc4, 0x6c, 0xca, 0x49, 0x2f, 0x65, 0x3a, 0x1d, 0xda,
+	0x09, 0x6d, 0x67, 0x32, 0x33, 0x25, 0xbb, 0xfe, 0x02, 0x8f, 0xfe, 0x18, 0x7f, 0x04, 0x47, 0xe2,
+	0xc9, 0x78, 0x20, 0x06, 0xae, 0xfe, 0x08, 0xd3, 0x99, 0xd2, 0x92, 0x58, 0xbd, 0xed, 0xfb, 0x3e,
+	0xcf, 0xf3, 0x3e, 0xef, 0xfb, 0x74, 0x07, 0x3c, 0xce, 0x69, 0x48, 0x45, 0xe1, 0xd2, 0xfc, 0x34,
+	0x45, 0x8a, 0xb2, 0xdc, 0x3d, 0xdf, 0x73, 0x63, 0x92, 0x13, 0x49, 0xa5, 0xc3, 0x05, 0x53, 0x0c,
+	0xae, 0x18, 0x8a, 0x53, 0x53, 0x9c, 0xf3, 0xbd, 0x8d, 0xd5, 0x98, 0xc5, 0x4c, 0xe3, 0x6e, 0xf9,
+	0xcb, 0x50, 0x37, 0x9e, 0xb4, 0x4d, 0x6b, 0x74, 0x86, 0xf4, 0x08, 0x33, 0x99, 0x31, 0x19, 0x18,
+	0xb5, 0x29, 0x0c, 0xb4, 0xf3, 0xc5, 0x02, 0xf7, 0xdf, 0x19, 0xf3, 0x63, 0x85, 0x14, 0x81, 0x6f,
+	0x40, 0x97, 0x23, 0x81, 0x32, 0x69, 0x5b, 0x7d, 0x6b, 0xb0, 0xe0, 0x6d, 0x3a, 0x2d, 0xcb, 0x38,
+	0x23, 0x4d, 0x19, 0xce, 0x5c, 0x5c, 0x6d, 0x77, 0xfc, 0x4a, 0x00, 0xd7, 0x41, 0x97, 0x13, 0x41,
+	0x59, 0x64, 0x4f, 0xf5, 0xad, 0xc1, 0x8c, 0x5f, 0x55, 0xf0, 0x29, 0x58, 0x94, 0x67, 0x94, 0x73,
+	0x12, 0x05, 0x84, 0x33, 0x9c, 0x48, 0x7b, 0x5a, 0xe3, 0x0f, 0xaa, 0xee, 0xa1, 0x6e, 0xee, 0xfc,
+	0x9e, 0x05, 0x5d, 0x33, 0x17, 0xbe, 0x00, 0xcb, 0xb5, 0x5d, 0x40, 0x72, 0x14, 0xa6, 0x24, 0xd2,
+	0xfb, 0xcc, 0xf9, 0x4b, 0x35, 0x70, 0x68, 0xfa, 0xf0, 0x04, 0x40, 0xce, 0xd2, 0x49, 0xce, 0x32,
+	0x8a, 0xd2, 0xe0, 0x14, 0x61, 0xc5, 0x84, 0xb4, 0xa7, 0xfa, 0xd3, 0x83, 0xf9, 0xe1, 0x5e, 0xb9,
+	0xe0, 0xcf, 0xab, 0xed, 0x4d, 0x73, 0xb4, 0x8c, 0xce, 0x1c, 0xca, 0xdc, 0x0c, 0xa9, 0xc4, 0x39,
+	0x22, 0x31, 0xc2, 0x93, 0x03, 0x82, 0xbf, 0x7f, 0x7b, 0x09, 0xaa, 0x4c, 0x0e, 0x08, 0xf6, 0x97,
+	0x9b, 0x61, 0x6f, 0xcd, 0x2c, 0x48, 0xc0, 0x7a, 0xb3, 0x4e, 0x44, 0xa5, 0x12, 0x34, 0x2c, 0xca,
+	0x42, 0x1f, 0xb2, 0xe0, 0xed, 0xb6, 0x66, 0xf4, 0xfe, 0xb6, 0x38, 0xb8, 0xa3, 0x18, 0x4e, 0xd9,
+	0x96, 0xbf, 0x46, 0xdb, 0x20, 0xb8, 0x0b, 0x96, 0x4d, 0x3e, 0x01, 0x27, 0x22, 0xa8, 0xa2, 0x9c,
+	0xd1, 0x51, 0x3d, 0x34, 0xc0, 0x88, 0x88, 0x91, 0xc9, 0x74, 0x00, 0x96, 0x0c, 0xc1, 0x90, 0x27,
+	0x04, 0x09, 0x7b, 0x56, 0x53, 0x17, 0xab, 0xfe, 0x88, 0x88, 0x8f, 0x04, 0x09, 0xb8, 0x05, 0x40,
+	0x86, 0xc6, 0xb7, 0xe3, 0xba, 0x9a, 0x33, 0x9f, 0xa1, 0x71, 0x35, 0xc8, 0x03, 0x6b, 0x09, 0x92,
+	0x41, 0x73, 0x9f, 0x54, 0x48, 0x28, 0x12, 0xd9, 0xf7, 0x74, 0xdc, 0x2b, 0x09, 0x92, 0xf5, 0x21,
+	0xc7, 0x06, 0x82, 0x9f, 0xc1, 0x16, 0xa6, 0x02, 0x17, 0x65, 0x37, 0x8f, 0x03, 0x59, 0x70, 0x9e,
+	0x4e, 0x02, 0x32, 0xc6, 0x69, 0x21, 0x29, 0xcb, 0xa5, 0x3d, 0xa7, 0x63, 0x79, 0xd5, 0x1a, 0xcb,
+	0x7e, 0xa3, 0x3c, 0xd6, 0xc2, 0xc3, 0x5a, 0x57, 0xfd, 0x9f, 0x36, 0xf1, 0xbf, 0x29, 0xf0, 0x04,
+	0xac, 0x36, 0xbb, 0x0a, 0x82, 0x29, 0xa7, 0x24, 0x57, 0xd2, 0x9e, 0xef, 0x4f, 0x0f, 0x16, 0xbc,
+	0x67, 0xff, 0xff, 0x12, 0xfe, 0x2d, 0xbf, 0x72, 0x5a, 0xa1, 0x7f, 0x21, 0xfa, 0x6b, 0x87, 0x2c,
+	0x8f, 0x48, 0x14, 0x88, 0x12, 0x6a, 0xa2, 0xb1, 0x81, 0x3e, 0xeb, 0x79, 0xab, 0xc7, 0x50, 0x4b,
+	0xfc, 0xb2, 0xae, 0xed, 0x2a, 0x97, 0xd5, 0xb0, 0x0d, 0x3b, 0xba, 0xb8, 0xee, 0x59, 0x97, 0xd7,
+	0x3d, 0xeb, 0xd7, 0x75, 0xcf, 0xfa, 0x7a, 0xd3, 0xeb, 0x5c, 0xde, 0xf4, 0x3a, 0x3f, 0x6e, 0x7a,
+	0x9d, 0x4f, 0x5e, 0x4c, 0x55, 0x52, 0x84, 0x0e, 0x66, 0x99, 0xfb, 0x41, 0x5b, 0xed, 0x27, 0x88,
+	0xe6, 0x6e, 0xf5, 0xd4, 0xcf, 0x3d, 0x77, 0x7c, 0xe7, 0xbd, 0xab, 0x09, 0x27, 0x32, 0xec, 0xea,
+	0xe7, 0xfc, 0xfa, 0xcf, 0x00, 0xca, 0x38, 0x07, 0x2d, 0x5e, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -294,6 +332,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.BondedRatioInflation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.InflationRecipients) > 0 {
+		for iNdEx := len(m.InflationRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InflationRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.CirculatingSupplyExclusions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if m.HasInflationStarted {
 		i--
 		if m.HasInflationStarted {
@@ -319,16 +391,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
-	{
-		size, err := m.InflationDistribution.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.InflationDistribution != nil {
+		{
+			size, err := m.InflationDistribution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
 	if len(m.PolynomialFactors) > 0 {
 		for iNdEx := len(m.PolynomialFactors) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -399,8 +473,10 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	l = m.InflationDistribution.Size()
-	n += 1 + l + sovGenesis(uint64(l))
+	if m.InflationDistribution != nil {
+		l = m.InflationDistribution.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	if m.EpochsPerPeriod != 0 {
 		n += 1 + sovGenesis(uint64(m.EpochsPerPeriod))
 	}
@@ -413,6 +489,16 @@ func (m *Params) Size() (n int) {
 	if m.HasInflationStarted {
 		n += 2
 	}
+	l = m.CirculatingSupplyExclusions.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.InflationRecipients) > 0 {
+		for _, e := range m.InflationRecipients {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.BondedRatioInflation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -657,6 +743,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if m.InflationDistribution == nil {
+				m.InflationDistribution = &InflationDistribution{}
+			}
 			if err := m.InflationDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -738,6 +827,106 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.HasInflationStarted = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupplyExclusions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CirculatingSupplyExclusions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationRecipients = append(m.InflationRecipients, InflationRecipient{})
+			if err := m.InflationRecipients[len(m.InflationRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatioInflation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedRatioInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 )
 
@@ -12,6 +13,15 @@ func TestValidateGenesis(t *testing.T) {
 
 	newGen := NewGenesisState(validParams, 0, 0)
 
+	// Exports from before the inflation recipients only have the distribution
+	legacyParams := DefaultParams()
+	legacyParams.InflationRecipients = nil
+	legacyParams.InflationDistribution = &InflationDistribution{
+		StakingRewards:    sdkmath.LegacyMustNewDecFromStr("0.5"),
+		CommunityPool:     sdkmath.LegacyZeroDec(),
+		StrategicReserves: sdkmath.LegacyMustNewDecFromStr("0.5"),
+	}
+
 	testCases := []struct {
 		name     string
 		genState *GenesisState
@@ -41,6 +51,13 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid genesis with the deprecated inflation distribution",
+			&GenesisState{
+				Params: legacyParams,
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
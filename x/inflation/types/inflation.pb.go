@@ -25,9 +25,61 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationRecipientType defines how minted tokens reach an
+// InflationRecipient.
+type InflationRecipientType int32
+
+const (
+	INFLATION_RECIPIENT_TYPE_UNSPECIFIED InflationRecipientType = 0
+	// INFLATION_RECIPIENT_TYPE_MODULE_ACCOUNT sends to the module account named
+	// by the target, e.g. "fee_collector" for staking rewards.
+	INFLATION_RECIPIENT_TYPE_MODULE_ACCOUNT InflationRecipientType = 1
+	// INFLATION_RECIPIENT_TYPE_ADDRESS sends to the bech32 address given by the
+	// target.
+	INFLATION_RECIPIENT_TYPE_ADDRESS InflationRecipientType = 2
+	// INFLATION_RECIPIENT_TYPE_COMMUNITY_POOL funds the x/distribution
+	// community pool.
+	INFLATION_RECIPIENT_TYPE_COMMUNITY_POOL InflationRecipientType = 3
+	// INFLATION_RECIPIENT_TYPE_ORACLE_REWARDS funds the x/oracle reward pool,
+	// spread over vote_periods vote periods.
+	INFLATION_RECIPIENT_TYPE_ORACLE_REWARDS InflationRecipientType = 4
+	// INFLATION_RECIPIENT_TYPE_STRATEGIC_RESERVE sends to the strategic
+	// reserve, the root account of the x/sudo module.
+	INFLATION_RECIPIENT_TYPE_STRATEGIC_RESERVE InflationRecipientType = 5
+)
+
+var InflationRecipientType_name = map[int32]string{
+	0: "INFLATION_RECIPIENT_TYPE_UNSPECIFIED",
+	1: "INFLATION_RECIPIENT_TYPE_MODULE_ACCOUNT",
+	2: "INFLATION_RECIPIENT_TYPE_ADDRESS",
+	3: "INFLATION_RECIPIENT_TYPE_COMMUNITY_POOL",
+	4: "INFLATION_RECIPIENT_TYPE_ORACLE_REWARDS",
+	5: "INFLATION_RECIPIENT_TYPE_STRATEGIC_RESERVE",
+}
+
+var InflationRecipientType_value = map[string]int32{
+	"INFLATION_RECIPIENT_TYPE_UNSPECIFIED":       0,
+	"INFLATION_RECIPIENT_TYPE_MODULE_ACCOUNT":    1,
+	"INFLATION_RECIPIENT_TYPE_ADDRESS":           2,
+	"INFLATION_RECIPIENT_TYPE_COMMUNITY_POOL":    3,
+	"INFLATION_RECIPIENT_TYPE_ORACLE_REWARDS":    4,
+	"INFLATION_RECIPIENT_TYPE_STRATEGIC_RESERVE": 5,
+}
+
+func (x InflationRecipientType) String() string {
+	return proto.EnumName(InflationRecipientType_name, int32(x))
+}
+
+func (InflationRecipientType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_37da805e9a324a97, []int{0}
+}
+
 // InflationDistribution defines the distribution in which inflation is
 // allocated through minting on each epoch (staking, community, strategic). It
 // excludes the team vesting distribution.
+//
+// Deprecated: InflationDistribution is replaced by a list of
+// InflationRecipient entries.
 type InflationDistribution struct {
 	// staking_rewards defines the proportion of the minted_denom that is
 	// to be allocated as staking rewards
@@ -73,8 +125,218 @@ func (m *InflationDistribution) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InflationDistribution proto.InternalMessageInfo
 
+// CirculatingSupplyExclusions defines the accounts whose balances are
+// subtracted from the total supply when computing the circulating supply.
+// Unvested balances of vesting accounts are always excluded.
+type CirculatingSupplyExclusions struct {
+	// module_accounts is the list of module account names (e.g. "inflation")
+	// whose balances are not in circulation.
+	ModuleAccounts []string `protobuf:"bytes,1,rep,name=module_accounts,json=moduleAccounts,proto3" json:"module_accounts,omitempty"`
+	// addresses is the list of bech32 account addresses whose balances are not
+	// in circulation.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// strategic_reserve excludes the balance of the strategic reserve, the root
+	// account of the x/sudo module, when set to true.
+	StrategicReserve bool `protobuf:"varint,3,opt,name=strategic_reserve,json=strategicReserve,proto3" json:"strategic_reserve,omitempty"`
+}
+
+func (m *CirculatingSupplyExclusions) Reset()         { *m = CirculatingSupplyExclusions{} }
+func (m *CirculatingSupplyExclusions) String() string { return proto.CompactTextString(m) }
+func (*CirculatingSupplyExclusions) ProtoMessage()    {}
+func (*CirculatingSupplyExclusions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37da805e9a324a97, []int{1}
+}
+func (m *CirculatingSupplyExclusions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CirculatingSupplyExclusions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CirculatingSupplyExclusions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CirculatingSupplyExclusions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CirculatingSupplyExclusions.Merge(m, src)
+}
+func (m *CirculatingSupplyExclusions) XXX_Size() int {
+	return m.Size()
+}
+func (m *CirculatingSupplyExclusions) XXX_DiscardUnknown() {
+	xxx_messageInfo_CirculatingSupplyExclusions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CirculatingSupplyExclusions proto.InternalMessageInfo
+
+func (m *CirculatingSupplyExclusions) GetModuleAccounts() []string {
+	if m != nil {
+		return m.ModuleAccounts
+	}
+	return nil
+}
+
+func (m *CirculatingSupplyExclusions) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *CirculatingSupplyExclusions) GetStrategicReserve() bool {
+	if m != nil {
+		return m.StrategicReserve
+	}
+	return false
+}
+
+// InflationRecipient is a weighted destination for the tokens minted each
+// epoch.
+type InflationRecipient struct {
+	// name identifies the recipient in events and telemetry.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// recipient_type defines how the minted tokens are sent to the recipient.
+	RecipientType InflationRecipientType `protobuf:"varint,2,opt,name=recipient_type,json=recipientType,proto3,enum=nibiru.inflation.v1.InflationRecipientType" json:"recipient_type,omitempty"`
+	// target is the module account name for INFLATION_RECIPIENT_TYPE_MODULE_ACCOUNT
+	// and the bech32 address for INFLATION_RECIPIENT_TYPE_ADDRESS. It is empty
+	// for the other types.
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// weight is the proportion of the minted tokens sent to the recipient.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+	// vote_periods is the number of oracle vote periods over which the tokens
+	// are paid out. Only used by INFLATION_RECIPIENT_TYPE_ORACLE_REWARDS.
+	VotePeriods uint64 `protobuf:"varint,5,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty"`
+}
+
+func (m *InflationRecipient) Reset()         { *m = InflationRecipient{} }
+func (m *InflationRecipient) String() string { return proto.CompactTextString(m) }
+func (*InflationRecipient) ProtoMessage()    {}
+func (*InflationRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37da805e9a324a97, []int{2}
+}
+func (m *InflationRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationRecipient.Merge(m, src)
+}
+func (m *InflationRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationRecipient proto.InternalMessageInfo
+
+func (m *InflationRecipient) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InflationRecipient) GetRecipientType() InflationRecipientType {
+	if m != nil {
+		return m.RecipientType
+	}
+	return INFLATION_RECIPIENT_TYPE_UNSPECIFIED
+}
+
+func (m *InflationRecipient) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *InflationRecipient) GetVotePeriods() uint64 {
+	if m != nil {
+		return m.VotePeriods
+	}
+	return 0
+}
+
+// BondedRatioInflation defines an optional inflation mode in which the epoch
+// mint provision of the period polynomial is multiplied by a factor that
+// depends on the staking bonded ratio, similar to the Cosmos SDK mint module.
+// The multiplier is "1 + (target_bonded_ratio - bonded_ratio) /
+// target_bonded_ratio", clamped to [min_multiplier, max_multiplier], so that
+// inflation rises when less than the target is bonded and falls when more is.
+type BondedRatioInflation struct {
+	// enabled turns the bonded ratio multiplier on. When false, the epoch mint
+	// provision is the value of the polynomial.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// target_bonded_ratio is the bonded ratio at which the multiplier is 1.
+	TargetBondedRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=target_bonded_ratio,json=targetBondedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_bonded_ratio"`
+	// min_multiplier is the lower bound of the multiplier. It must be positive.
+	MinMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_multiplier,json=minMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_multiplier"`
+	// max_multiplier is the upper bound of the multiplier.
+	MaxMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_multiplier,json=maxMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_multiplier"`
+}
+
+func (m *BondedRatioInflation) Reset()         { *m = BondedRatioInflation{} }
+func (m *BondedRatioInflation) String() string { return proto.CompactTextString(m) }
+func (*BondedRatioInflation) ProtoMessage()    {}
+func (*BondedRatioInflation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37da805e9a324a97, []int{3}
+}
+func (m *BondedRatioInflation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BondedRatioInflation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BondedRatioInflation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BondedRatioInflation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondedRatioInflation.Merge(m, src)
+}
+func (m *BondedRatioInflation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BondedRatioInflation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondedRatioInflation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondedRatioInflation proto.InternalMessageInfo
+
+func (m *BondedRatioInflation) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("nibiru.inflation.v1.InflationRecipientType", InflationRecipientType_name, InflationRecipientType_value)
 	proto.RegisterType((*InflationDistribution)(nil), "nibiru.inflation.v1.InflationDistribution")
+	proto.RegisterType((*CirculatingSupplyExclusions)(nil), "nibiru.inflation.v1.CirculatingSupplyExclusions")
+	proto.RegisterType((*InflationRecipient)(nil), "nibiru.inflation.v1.InflationRecipient")
+	proto.RegisterType((*BondedRatioInflation)(nil), "nibiru.inflation.v1.BondedRatioInflation")
 }
 
 func init() {
@@ -82,27 +344,53 @@ func init() {
 }
 
 var fileDescriptor_37da805e9a324a97 = []byte{
-	// 310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd1, 0xc1, 0x4a, 0x02, 0x41,
-	0x18, 0x07, 0xf0, 0x5d, 0x83, 0xa0, 0x85, 0x8c, 0xb6, 0x02, 0x33, 0x18, 0xa3, 0x2e, 0x5d, 0xda,
-	0x41, 0x7b, 0x03, 0xf3, 0x12, 0x48, 0x84, 0xa7, 0xf0, 0xb2, 0xcd, 0x8e, 0xd3, 0xf8, 0xa1, 0x3b,
-	0x9f, 0xcc, 0xcc, 0x6e, 0xf9, 0x16, 0xbd, 0x49, 0x97, 0x1e, 0xc2, 0xa3, 0x74, 0x8a, 0x0e, 0x12,
-	0xfa, 0x22, 0xa1, 0xb3, 0x69, 0x67, 0x6f, 0xdf, 0x37, 0xff, 0x8f, 0xdf, 0x1c, 0xfe, 0xc1, 0xa5,
-	0x82, 0x04, 0x74, 0x46, 0x41, 0x3d, 0x0f, 0x99, 0x05, 0x54, 0x34, 0xaf, 0x6f, 0x96, 0x68, 0xa4,
-	0xd1, 0x62, 0x78, 0xe4, 0x8e, 0xa2, 0xcd, 0x7b, 0x5e, 0xaf, 0x1e, 0x4b, 0x94, 0xb8, 0xca, 0xe9,
-	0x72, 0x72, 0xa7, 0xd5, 0x53, 0x8e, 0x26, 0x45, 0x13, 0xbb, 0xc0, 0x2d, 0x2e, 0xba, 0x78, 0x2f,
-	0x05, 0x27, 0x77, 0x7f, 0x42, 0x0b, 0x8c, 0xd5, 0x90, 0x64, 0xcb, 0x39, 0xec, 0x06, 0x07, 0xc6,
-	0xb2, 0x01, 0x28, 0x19, 0x6b, 0xf1, 0xc2, 0x74, 0xcf, 0x54, 0xfc, 0x73, 0xff, 0x6a, 0xaf, 0x59,
-	0x9f, 0xcc, 0x6a, 0xde, 0xf7, 0xac, 0x76, 0xe6, 0x20, 0xd3, 0x1b, 0x44, 0x80, 0x34, 0x65, 0xb6,
-	0x1f, 0xb5, 0x85, 0x64, 0x7c, 0xdc, 0x12, 0xfc, 0xf3, 0xe3, 0x3a, 0x28, 0xfe, 0x69, 0x09, 0xde,
-	0x29, 0x17, 0x52, 0xc7, 0x41, 0xe1, 0x63, 0x50, 0xe6, 0x98, 0xa6, 0x99, 0x02, 0x3b, 0x8e, 0x47,
-	0x88, 0xc3, 0x4a, 0x69, 0x5b, 0x7a, 0x7f, 0x0d, 0x3d, 0x20, 0x0e, 0xc3, 0xa7, 0x20, 0x34, 0x56,
-	0x33, 0x2b, 0x24, 0xf0, 0x58, 0x0b, 0x23, 0x74, 0x2e, 0x4c, 0x65, 0x67, 0x5b, 0xfd, 0x70, 0x8d,
-	0x75, 0x0a, 0xab, 0xd9, 0x9e, 0xcc, 0x89, 0x3f, 0x9d, 0x13, 0xff, 0x67, 0x4e, 0xfc, 0xb7, 0x05,
-	0xf1, 0xa6, 0x0b, 0xe2, 0x7d, 0x2d, 0x88, 0xd7, 0x6d, 0x48, 0xb0, 0xfd, 0x2c, 0x89, 0x38, 0xa6,
-	0xf4, 0x7e, 0x55, 0xce, 0x6d, 0x9f, 0x81, 0xa2, 0x45, 0x9b, 0x79, 0x83, 0xbe, 0xfe, 0xab, 0xd4,
-	0x8e, 0x47, 0xc2, 0x24, 0xbb, 0xab, 0x1a, 0x6e, 0x7e, 0x03, 0x00, 0x00, 0xff, 0xff, 0x97, 0x7c,
-	0x3f, 0xa9, 0xf3, 0x01, 0x00, 0x00,
+	// 722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x4e, 0xeb, 0x46,
+	0x14, 0xc7, 0xe3, 0x90, 0x4b, 0x2f, 0xd3, 0x12, 0xc2, 0x40, 0x51, 0x0a, 0x55, 0xa0, 0x29, 0x12,
+	0x11, 0xa8, 0x8e, 0xa0, 0x4f, 0x10, 0x6c, 0x53, 0x59, 0xca, 0x97, 0x26, 0x4e, 0x5b, 0xd8, 0x4c,
+	0x1d, 0x7b, 0xea, 0x8c, 0xb0, 0x3d, 0xd6, 0xcc, 0x38, 0x24, 0xdb, 0xae, 0xba, 0x6c, 0x9f, 0xa1,
+	0xea, 0xba, 0x9b, 0xbe, 0x43, 0x59, 0xa2, 0xae, 0xaa, 0x2e, 0x50, 0x05, 0x2f, 0x52, 0xd9, 0x0e,
+	0x49, 0x74, 0xf9, 0x58, 0x64, 0x37, 0xe7, 0x3f, 0x7f, 0xff, 0x7c, 0xe6, 0x9c, 0x33, 0x03, 0xbe,
+	0x0c, 0xe9, 0x80, 0xf2, 0xb8, 0x4e, 0xc3, 0x1f, 0x7d, 0x5b, 0x52, 0x16, 0xd6, 0x47, 0xa7, 0xf3,
+	0x40, 0x8d, 0x38, 0x93, 0x0c, 0x6e, 0x65, 0x26, 0x75, 0xae, 0x8f, 0x4e, 0x77, 0xb7, 0x3d, 0xe6,
+	0xb1, 0x74, 0xbf, 0x9e, 0xac, 0x32, 0xeb, 0xee, 0x67, 0x0e, 0x13, 0x01, 0x13, 0x38, 0xdb, 0xc8,
+	0x82, 0x6c, 0xab, 0xfa, 0x47, 0x1e, 0x7c, 0x6a, 0x3e, 0x11, 0x74, 0x2a, 0x24, 0xa7, 0x83, 0x38,
+	0x59, 0xc3, 0x2b, 0xb0, 0x21, 0xa4, 0x7d, 0x4d, 0x43, 0x0f, 0x73, 0x72, 0x63, 0x73, 0x57, 0x94,
+	0x95, 0x03, 0xa5, 0xb6, 0x76, 0x7e, 0x7a, 0x7b, 0xbf, 0x9f, 0xfb, 0xf7, 0x7e, 0x7f, 0x2f, 0x03,
+	0x09, 0xf7, 0x5a, 0xa5, 0xac, 0x1e, 0xd8, 0x72, 0xa8, 0x36, 0x89, 0x67, 0x3b, 0x13, 0x9d, 0x38,
+	0x7f, 0xff, 0xf9, 0x15, 0x98, 0xfe, 0x47, 0x27, 0x0e, 0x2a, 0x4e, 0x49, 0x28, 0x03, 0xc1, 0xef,
+	0x41, 0xd1, 0x61, 0x41, 0x10, 0x87, 0x54, 0x4e, 0x70, 0xc4, 0x98, 0x5f, 0xce, 0x2f, 0x8b, 0x5e,
+	0x9f, 0x81, 0xba, 0x8c, 0xf9, 0xf0, 0x07, 0x00, 0x85, 0xe4, 0xb6, 0x24, 0x1e, 0x75, 0x30, 0x27,
+	0x82, 0xf0, 0x11, 0x11, 0xe5, 0x95, 0x65, 0xe9, 0x9b, 0x33, 0x18, 0x9a, 0xb2, 0xaa, 0xbf, 0x2a,
+	0x60, 0x4f, 0xa3, 0xdc, 0x89, 0x93, 0x9a, 0x85, 0x5e, 0x2f, 0x8e, 0x22, 0x7f, 0x62, 0x8c, 0x1d,
+	0x3f, 0x16, 0x94, 0x85, 0x02, 0x1e, 0x81, 0x8d, 0x80, 0xb9, 0xb1, 0x4f, 0xb0, 0xed, 0x38, 0x2c,
+	0x0e, 0x65, 0x52, 0xb7, 0x95, 0xda, 0x1a, 0x2a, 0x66, 0x72, 0x63, 0xaa, 0xc2, 0xcf, 0xc1, 0x9a,
+	0xed, 0xba, 0x9c, 0x08, 0x41, 0x44, 0x39, 0x9f, 0x5a, 0xe6, 0x02, 0x3c, 0x01, 0x9b, 0xcf, 0x0e,
+	0x92, 0x9e, 0xe3, 0x3d, 0x2a, 0x7d, 0x98, 0x54, 0xf5, 0xa7, 0x3c, 0x80, 0xb3, 0x2e, 0x22, 0xe2,
+	0xd0, 0x88, 0x92, 0x50, 0x42, 0x08, 0x0a, 0xa1, 0x1d, 0x90, 0xac, 0x6f, 0x28, 0x5d, 0x43, 0x04,
+	0x8a, 0xfc, 0xc9, 0x80, 0xe5, 0x24, 0x22, 0x69, 0xe9, 0x8b, 0x67, 0x27, 0xea, 0x0b, 0xf3, 0xa4,
+	0x3e, 0x87, 0x5a, 0x93, 0x88, 0xa0, 0x75, 0xbe, 0x18, 0xc2, 0x1d, 0xb0, 0x2a, 0x6d, 0xee, 0x11,
+	0x99, 0x15, 0x1a, 0x4d, 0x23, 0x68, 0x82, 0xd5, 0x1b, 0x42, 0xbd, 0xa1, 0x2c, 0x17, 0x96, 0x6d,
+	0xc0, 0x14, 0x00, 0xbf, 0x00, 0x9f, 0x8c, 0x98, 0x24, 0x38, 0x22, 0x9c, 0x32, 0x57, 0x94, 0xdf,
+	0x1d, 0x28, 0xb5, 0x02, 0xfa, 0x38, 0xd1, 0xba, 0x99, 0x54, 0xfd, 0x2b, 0x0f, 0xb6, 0xcf, 0x59,
+	0xe8, 0x12, 0x17, 0x25, 0x29, 0xcf, 0x52, 0x87, 0x65, 0xf0, 0x11, 0x09, 0xed, 0x81, 0x4f, 0xdc,
+	0xb4, 0x12, 0xef, 0xd1, 0x53, 0x08, 0x6d, 0xb0, 0x95, 0xa5, 0x8a, 0x07, 0xe9, 0x87, 0x98, 0x27,
+	0x5f, 0x2c, 0x3f, 0x8c, 0x9b, 0x19, 0x6d, 0x21, 0x8b, 0x64, 0xd4, 0x03, 0x1a, 0xe2, 0x20, 0xf6,
+	0x25, 0x8d, 0x7c, 0x4a, 0xf8, 0xf2, 0xc3, 0xb8, 0x1e, 0xd0, 0xb0, 0x35, 0xe3, 0xa4, 0x64, 0x7b,
+	0xbc, 0x48, 0x2e, 0x2c, 0x4f, 0xb6, 0xc7, 0x73, 0xf2, 0xf1, 0xef, 0x79, 0xb0, 0xf3, 0x72, 0xe7,
+	0x61, 0x0d, 0x1c, 0x9a, 0xed, 0x8b, 0x66, 0xc3, 0x32, 0x3b, 0x6d, 0x8c, 0x0c, 0xcd, 0xec, 0x9a,
+	0x46, 0xdb, 0xc2, 0xd6, 0x65, 0xd7, 0xc0, 0xfd, 0x76, 0xaf, 0x6b, 0x68, 0xe6, 0x85, 0x69, 0xe8,
+	0xa5, 0x1c, 0x3c, 0x01, 0x47, 0xaf, 0x3a, 0x5b, 0x1d, 0xbd, 0xdf, 0x34, 0x70, 0x43, 0xd3, 0x3a,
+	0xfd, 0xb6, 0x55, 0x52, 0xe0, 0x21, 0x38, 0x78, 0xd5, 0xdc, 0xd0, 0x75, 0x64, 0xf4, 0x7a, 0xa5,
+	0xfc, 0x9b, 0x48, 0xad, 0xd3, 0x6a, 0xf5, 0xdb, 0xa6, 0x75, 0x89, 0xbb, 0x9d, 0x4e, 0xb3, 0xb4,
+	0xf2, 0xa6, 0xb9, 0x83, 0x1a, 0x5a, 0xd3, 0xc0, 0xc8, 0xf8, 0xae, 0x81, 0xf4, 0x5e, 0xa9, 0x00,
+	0x55, 0x70, 0xfc, 0xaa, 0xb9, 0x67, 0xa1, 0x86, 0x65, 0x7c, 0x63, 0x6a, 0x18, 0x19, 0x3d, 0x03,
+	0x7d, 0x6b, 0x94, 0xde, 0xed, 0x16, 0x7e, 0xfe, 0xad, 0x92, 0x3b, 0x6f, 0xde, 0x3e, 0x54, 0x94,
+	0xbb, 0x87, 0x8a, 0xf2, 0xdf, 0x43, 0x45, 0xf9, 0xe5, 0xb1, 0x92, 0xbb, 0x7b, 0xac, 0xe4, 0xfe,
+	0x79, 0xac, 0xe4, 0xae, 0xce, 0x3c, 0x2a, 0x87, 0xf1, 0x40, 0x75, 0x58, 0x50, 0x6f, 0xa7, 0xf7,
+	0x4a, 0x1b, 0xda, 0x34, 0xac, 0x4f, 0x1f, 0xf6, 0xd1, 0x59, 0x7d, 0xbc, 0xf0, 0xba, 0x27, 0xd7,
+	0x50, 0x0c, 0x56, 0xd3, 0x17, 0xf9, 0xeb, 0xff, 0x07, 0x00, 0xbb, 0x02, 0x27, 0x3d, 0xfe, 0x05,
+	0x00, 0x00,
 }
 
 func (m *InflationDistribution) Marshal() (dAtA []byte, err error) {
@@ -158,6 +446,177 @@ func (m *InflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CirculatingSupplyExclusions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CirculatingSupplyExclusions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CirculatingSupplyExclusions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StrategicReserve {
+		i--
+		if m.StrategicReserve {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintInflation(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ModuleAccounts) > 0 {
+		for iNdEx := len(m.ModuleAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ModuleAccounts[iNdEx])
+			copy(dAtA[i:], m.ModuleAccounts[iNdEx])
+			i = encodeVarintInflation(dAtA, i, uint64(len(m.ModuleAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotePeriods != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RecipientType != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.RecipientType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BondedRatioInflation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BondedRatioInflation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondedRatioInflation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxMultiplier.Size()
+		i -= size
+		if _, err := m.MaxMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinMultiplier.Size()
+		i -= size
+		if _, err := m.MinMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TargetBondedRatio.Size()
+		i -= size
+		if _, err := m.TargetBondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovInflation(v)
 	base := offset
@@ -184,6 +643,73 @@ func (m *InflationDistribution) Size() (n int) {
 	return n
 }
 
+func (m *CirculatingSupplyExclusions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleAccounts) > 0 {
+		for _, s := range m.ModuleAccounts {
+			l = len(s)
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	if m.StrategicReserve {
+		n += 2
+	}
+	return n
+}
+
+func (m *InflationRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	if m.RecipientType != 0 {
+		n += 1 + sovInflation(uint64(m.RecipientType))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	if m.VotePeriods != 0 {
+		n += 1 + sovInflation(uint64(m.VotePeriods))
+	}
+	return n
+}
+
+func (m *BondedRatioInflation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.TargetBondedRatio.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.MinMultiplier.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.MaxMultiplier.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
 func sovInflation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -342,6 +868,498 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CirculatingSupplyExclusions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CirculatingSupplyExclusions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CirculatingSupplyExclusions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccounts = append(m.ModuleAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserve", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrategicReserve = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientType", wireType)
+			}
+			m.RecipientType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecipientType |= InflationRecipientType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
+			}
+			m.VotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BondedRatioInflation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondedRatioInflation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondedRatioInflation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipInflation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
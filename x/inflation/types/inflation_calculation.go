@@ -38,3 +38,57 @@ func polynomial(factors []sdkmath.LegacyDec, x sdkmath.LegacyDec) sdkmath.Legacy
 	// 1 unibi = 1e6 nibi and the polynomial was fit on nibi token curve.
 	return result.Mul(sdkmath.LegacyNewDec(1_000_000))
 }
+
+// MaxInflationSchedulePeriods bounds the number of periods projected by the
+// InflationSchedule query.
+const MaxInflationSchedulePeriods uint64 = 1_200
+
+// ProjectInflationSchedule projects "numPeriods" periods of inflation starting
+// at "startPeriod", of which "elapsedEpochs" epochs already minted, from the
+// total "supply". Each epoch mints the truncated [CalculateEpochMintProvision]
+// scaled by the bonded ratio "multiplier", as the epoch hook does. The
+// annualized rate uses the same formula as the InflationRate query, relative
+// to the circulating supply at the start of the period. The projection starts
+// from "circulatingSupply" and assumes that minted coins circulate.
+func ProjectInflationSchedule(
+	params Params,
+	startPeriod uint64,
+	elapsedEpochs uint64,
+	supply sdkmath.Int,
+	circulatingSupply sdkmath.Int,
+	numPeriods uint64,
+	multiplier sdkmath.LegacyDec,
+) []InflationSchedulePeriod {
+	schedule := make([]InflationSchedulePeriod, 0, numPeriods)
+	for i := uint64(0); i < numPeriods; i++ {
+		period := startPeriod + i
+		numEpochs := params.EpochsPerPeriod
+		if i == 0 {
+			numEpochs -= min(elapsedEpochs, numEpochs)
+		}
+
+		epochMintProvision := CalculateEpochMintProvision(params, period).Mul(multiplier)
+		periodMint := epochMintProvision.TruncateInt().MulRaw(int64(numEpochs))
+
+		annualizedRate := sdkmath.LegacyZeroDec()
+		if circulatingSupply.IsPositive() {
+			annualizedRate = epochMintProvision.
+				MulInt64(int64(params.EpochsPerPeriod)).
+				MulInt64(int64(params.PeriodsPerYear)).
+				Quo(sdkmath.LegacyNewDecFromInt(circulatingSupply)).
+				Mul(sdkmath.LegacyNewDec(100))
+		}
+
+		supply = supply.Add(periodMint)
+		circulatingSupply = circulatingSupply.Add(periodMint)
+		schedule = append(schedule, InflationSchedulePeriod{
+			Period:             period,
+			NumEpochs:          numEpochs,
+			EpochMintProvision: epochMintProvision,
+			PeriodMint:         periodMint,
+			CumulativeSupply:   supply,
+			AnnualizedRate:     annualizedRate,
+		})
+	}
+	return schedule
+}
@@ -92,6 +92,61 @@ func TestCalculateEpochMintProvision_ZeroEpochs(t *testing.T) {
 	require.Equal(t, epochMintProvisions, sdkmath.LegacyZeroDec())
 }
 
+func TestProjectInflationSchedule(t *testing.T) {
+	params := DefaultParams()
+	params.InflationEnabled = true
+	startSupply := sdkmath.NewInt(700_000_000e6)
+	startCirculatingSupply := sdkmath.NewInt(300_000_000e6)
+
+	t.Log("the full schedule mints the expected total inflation")
+	schedule := ProjectInflationSchedule(
+		params, 0, 0, startSupply, startCirculatingSupply, params.MaxPeriod+2, sdkmath.LegacyOneDec(),
+	)
+	require.Len(t, schedule, int(params.MaxPeriod+2))
+	supply, circulatingSupply := startSupply, startCirculatingSupply
+	for i, p := range schedule {
+		require.EqualValues(t, i, p.Period)
+		require.Equal(t, params.EpochsPerPeriod, p.NumEpochs)
+		require.Equal(t, CalculateEpochMintProvision(params, p.Period), p.EpochMintProvision)
+		require.Equal(t, p.EpochMintProvision.TruncateInt().MulRaw(int64(p.NumEpochs)), p.PeriodMint)
+		wantRate := p.EpochMintProvision.
+			MulInt64(int64(params.EpochsPerPeriod * params.PeriodsPerYear)).
+			Quo(sdkmath.LegacyNewDecFromInt(circulatingSupply)).
+			MulInt64(100)
+		require.Equal(t, wantRate, p.AnnualizedRate)
+		supply = supply.Add(p.PeriodMint)
+		circulatingSupply = circulatingSupply.Add(p.PeriodMint)
+		require.Equal(t, supply, p.CumulativeSupply)
+	}
+	totalMinted := sdkmath.LegacyNewDecFromInt(supply.Sub(startSupply))
+	require.NoError(t, withinRange(ExpectedTotalInflation, totalMinted))
+
+	t.Log("periods after the max period mint nothing")
+	for _, p := range schedule[params.MaxPeriod:] {
+		require.True(t, p.PeriodMint.IsZero())
+		require.True(t, p.AnnualizedRate.IsZero())
+	}
+
+	t.Log("elapsed epochs only shorten the first period")
+	partial := ProjectInflationSchedule(params, 5, 12, startSupply, startCirculatingSupply, 2, sdkmath.LegacyOneDec())
+	require.EqualValues(t, 5, partial[0].Period)
+	require.Equal(t, params.EpochsPerPeriod-12, partial[0].NumEpochs)
+	require.Equal(t, params.EpochsPerPeriod, partial[1].NumEpochs)
+
+	t.Log("the bonded ratio multiplier scales every epoch mint provision")
+	multiplier := sdkmath.LegacyMustNewDecFromStr("1.25")
+	for i, p := range ProjectInflationSchedule(params, 0, 0, startSupply, startCirculatingSupply, 3, multiplier) {
+		require.Equal(t, schedule[i].EpochMintProvision.Mul(multiplier), p.EpochMintProvision)
+	}
+
+	t.Log("disabled inflation projects a flat supply")
+	params.InflationEnabled = false
+	for _, p := range ProjectInflationSchedule(params, 0, 0, startSupply, startCirculatingSupply, 3, sdkmath.LegacyOneDec()) {
+		require.True(t, p.PeriodMint.IsZero())
+		require.Equal(t, startSupply, p.CumulativeSupply)
+	}
+}
+
 // withinRange returns an error if the actual value is not within the expected value +/- tolerance
 // tolerance is a percentage set to 0.01% by default
 func withinRange(expected, actual sdkmath.LegacyDec) error {
@@ -0,0 +1,155 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Names of the recipients that replace the fixed [InflationDistribution]
+// buckets.
+const (
+	RecipientNameStakingRewards   = "staking_rewards"
+	RecipientNameCommunityPool    = "community_pool"
+	RecipientNameStrategicReserve = "strategic_reserve"
+)
+
+// DefaultInflationRecipients sends 28.125% of the minted tokens to stakers,
+// 35.4825% to the community pool and 36.3925% to the strategic reserve.
+var DefaultInflationRecipients = []InflationRecipient{
+	StakingRewardsRecipient(sdkmath.LegacyNewDecWithPrec(28_1250, 6)),
+	CommunityPoolRecipient(sdkmath.LegacyNewDecWithPrec(35_4825, 6)),
+	StrategicReserveRecipient(sdkmath.LegacyNewDecWithPrec(36_3925, 6)),
+}
+
+// StakingRewardsRecipient returns a recipient that sends staking inflation to
+// the x/auth fee collector, which x/distribution pays out to the validators
+// and their delegators.
+func StakingRewardsRecipient(weight sdkmath.LegacyDec) InflationRecipient {
+	return InflationRecipient{
+		Name:          RecipientNameStakingRewards,
+		RecipientType: INFLATION_RECIPIENT_TYPE_MODULE_ACCOUNT,
+		Target:        authtypes.FeeCollectorName,
+		Weight:        weight,
+	}
+}
+
+// CommunityPoolRecipient returns a recipient that funds the community pool.
+func CommunityPoolRecipient(weight sdkmath.LegacyDec) InflationRecipient {
+	return InflationRecipient{
+		Name:          RecipientNameCommunityPool,
+		RecipientType: INFLATION_RECIPIENT_TYPE_COMMUNITY_POOL,
+		Weight:        weight,
+	}
+}
+
+// StrategicReserveRecipient returns a recipient that sends to the root account
+// of the x/sudo module.
+func StrategicReserveRecipient(weight sdkmath.LegacyDec) InflationRecipient {
+	return InflationRecipient{
+		Name:          RecipientNameStrategicReserve,
+		RecipientType: INFLATION_RECIPIENT_TYPE_STRATEGIC_RESERVE,
+		Weight:        weight,
+	}
+}
+
+// LegacyInflationRecipients converts a deprecated [InflationDistribution] to
+// the equivalent recipients. The strategic reserve comes last so that it
+// receives the rounding remainder, as it did before the conversion. Buckets
+// with a zero weight, which the distribution allowed, are left out since
+// recipients must have a positive weight.
+func LegacyInflationRecipients(dist InflationDistribution) []InflationRecipient {
+	var recipients []InflationRecipient
+	for _, recipient := range []InflationRecipient{
+		StakingRewardsRecipient(dist.StakingRewards),
+		CommunityPoolRecipient(dist.CommunityPool),
+		StrategicReserveRecipient(dist.StrategicReserves),
+	} {
+		if recipient.Weight.IsNil() || recipient.Weight.IsZero() {
+			continue
+		}
+		recipients = append(recipients, recipient)
+	}
+	return recipients
+}
+
+// Validate performs stateless validation of a single recipient.
+func (r InflationRecipient) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("inflation recipient name cannot be empty")
+	}
+	if r.Weight.IsNil() || !r.Weight.IsPositive() {
+		return fmt.Errorf("inflation recipient %s: weight must be positive, got %s", r.Name, r.Weight)
+	}
+
+	switch r.RecipientType {
+	case INFLATION_RECIPIENT_TYPE_MODULE_ACCOUNT:
+		if r.Target == "" {
+			return fmt.Errorf("inflation recipient %s: module account name cannot be empty", r.Name)
+		}
+		if r.Target == ModuleName {
+			return fmt.Errorf("inflation recipient %s: cannot send to the %s module itself", r.Name, ModuleName)
+		}
+	case INFLATION_RECIPIENT_TYPE_ADDRESS:
+		if _, err := sdk.AccAddressFromBech32(r.Target); err != nil {
+			return fmt.Errorf("inflation recipient %s: invalid address %s: %w", r.Name, r.Target, err)
+		}
+	case INFLATION_RECIPIENT_TYPE_ORACLE_REWARDS:
+		if r.VotePeriods == 0 {
+			return fmt.Errorf("inflation recipient %s: vote periods must be positive", r.Name)
+		}
+		if r.Target != "" {
+			return fmt.Errorf("inflation recipient %s: target must be empty for %s", r.Name, r.RecipientType)
+		}
+	case INFLATION_RECIPIENT_TYPE_COMMUNITY_POOL, INFLATION_RECIPIENT_TYPE_STRATEGIC_RESERVE:
+		if r.Target != "" {
+			return fmt.Errorf("inflation recipient %s: target must be empty for %s", r.Name, r.RecipientType)
+		}
+	default:
+		return fmt.Errorf("inflation recipient %s: invalid recipient type %s", r.Name, r.RecipientType)
+	}
+	return nil
+}
+
+// ValidateInflationRecipients checks each recipient, rejects duplicate names
+// and requires the weights to sum to 1.
+func ValidateInflationRecipients(recipients []InflationRecipient) error {
+	if len(recipients) == 0 {
+		return fmt.Errorf("inflation recipients cannot be empty")
+	}
+
+	totalWeight := sdkmath.LegacyZeroDec()
+	names := make(map[string]struct{}, len(recipients))
+	for _, r := range recipients {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if _, seen := names[r.Name]; seen {
+			return fmt.Errorf("duplicate inflation recipient %s", r.Name)
+		}
+		names[r.Name] = struct{}{}
+		totalWeight = totalWeight.Add(r.Weight)
+	}
+
+	if !totalWeight.Equal(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("inflation recipient weights should sum to 1, got %s", totalWeight)
+	}
+	return nil
+}
+
+// ParseInflationRecipientType parses a recipient type from its short name,
+// e.g. "module_account" or "oracle_rewards", or from its full enum name.
+func ParseInflationRecipientType(s string) (InflationRecipientType, error) {
+	name := strings.ToUpper(s)
+	if !strings.HasPrefix(name, "INFLATION_RECIPIENT_TYPE_") {
+		name = "INFLATION_RECIPIENT_TYPE_" + name
+	}
+	recipientType, ok := InflationRecipientType_value[name]
+	if !ok || recipientType == int32(INFLATION_RECIPIENT_TYPE_UNSPECIFIED) {
+		return INFLATION_RECIPIENT_TYPE_UNSPECIFIED, fmt.Errorf("unknown inflation recipient type %q", s)
+	}
+	return InflationRecipientType(recipientType), nil
+}
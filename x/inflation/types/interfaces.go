@@ -4,6 +4,8 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	epochstypes "github.com/NibiruChain/nibiru/v2/x/epochs/types"
 )
 
 // AccountKeeper defines the contract required for account APIs.
@@ -12,6 +14,7 @@ type AccountKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI
 	GetAccount(sdk.Context, sdk.AccAddress) types.AccountI
 	SetAccount(sdk.Context, types.AccountI)
+	IterateAccounts(ctx sdk.Context, cb func(account types.AccountI) (stop bool))
 }
 
 // BankKeeper defines the contract needed to be fulfilled for banking and supply
@@ -43,6 +46,17 @@ type StakingKeeper interface {
 	TotalBondedTokens(ctx sdk.Context) sdkmath.Int
 }
 
+// EpochsKeeper defines the contract needed to read the epoch that drives
+// inflation.
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, error)
+}
+
+// OracleKeeper defines the contract needed to fund the oracle reward pool.
+type OracleKeeper interface {
+	AllocateRewards(ctx sdk.Context, funderModule string, totalCoins sdk.Coins, votePeriods uint64) error
+}
+
 type SudoKeeper interface {
 	GetRootAddr(ctx sdk.Context) (sdk.AccAddress, error)
 	CheckPermissions(contract sdk.AccAddress, ctx sdk.Context) error
@@ -74,6 +74,27 @@ func (m MsgEditInflationParams) ValidateBasic() error {
 		}
 	}
 
+	if len(m.InflationRecipients) > 0 {
+		if m.InflationDistribution != nil {
+			return fmt.Errorf("inflation distribution and inflation recipients cannot both be set")
+		}
+		if err := ValidateInflationRecipients(m.InflationRecipients); err != nil {
+			return err
+		}
+	}
+
+	if m.CirculatingSupplyExclusions != nil {
+		if err := m.CirculatingSupplyExclusions.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if m.BondedRatioInflation != nil {
+		if err := m.BondedRatioInflation.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -8,13 +8,15 @@ import (
 )
 
 var (
-	KeyInflationEnabled      = []byte("InflationEnabled")
-	KeyHasInflationStarted   = []byte("HasInflationStarted")
-	KeyPolynomialFactors     = []byte("PolynomialFactors")
-	KeyInflationDistribution = []byte("InflationDistribution")
-	KeyEpochsPerPeriod       = []byte("EpochsPerPeriod")
-	KeyPeriodsPerYear        = []byte("PeriodsPerYear")
-	KeyMaxPeriod             = []byte("MaxPeriod")
+	KeyInflationEnabled            = []byte("InflationEnabled")
+	KeyHasInflationStarted         = []byte("HasInflationStarted")
+	KeyPolynomialFactors           = []byte("PolynomialFactors")
+	KeyInflationRecipients         = []byte("InflationRecipients")
+	KeyEpochsPerPeriod             = []byte("EpochsPerPeriod")
+	KeyPeriodsPerYear              = []byte("PeriodsPerYear")
+	KeyMaxPeriod                   = []byte("MaxPeriod")
+	KeyCirculatingSupplyExclusions = []byte("CirculatingSupplyExclusions")
+	KeyBondedRatioInflation        = []byte("BondedRatioInflation")
 )
 
 var (
@@ -27,11 +29,6 @@ var (
 		sdkmath.LegacyMustNewDecFromStr("-334834.740631598223"),
 		sdkmath.LegacyMustNewDecFromStr("17827464.906540066004"),
 	}
-	DefaultInflationDistribution = InflationDistribution{
-		CommunityPool:     sdkmath.LegacyNewDecWithPrec(35_4825, 6), // 35.4825%
-		StakingRewards:    sdkmath.LegacyNewDecWithPrec(28_1250, 6), // 28.1250%
-		StrategicReserves: sdkmath.LegacyNewDecWithPrec(36_3925, 6), // 36.3925%
-	}
 	DefaultEpochsPerPeriod = uint64(30)
 	DefaultPeriodsPerYear  = uint64(12)
 	DefaultMaxPeriod       = uint64(8 * 12) // 8 years with 360 days per year
@@ -39,7 +36,7 @@ var (
 
 func NewParams(
 	polynomialCalculation []sdkmath.LegacyDec,
-	inflationDistribution InflationDistribution,
+	inflationRecipients []InflationRecipient,
 	inflationEnabled bool,
 	hasInflationStarted bool,
 	epochsPerPeriod,
@@ -47,26 +44,29 @@ func NewParams(
 	maxPeriod uint64,
 ) Params {
 	return Params{
-		PolynomialFactors:     polynomialCalculation,
-		InflationDistribution: inflationDistribution,
-		InflationEnabled:      inflationEnabled,
-		HasInflationStarted:   hasInflationStarted,
-		EpochsPerPeriod:       epochsPerPeriod,
-		PeriodsPerYear:        periodsPerYear,
-		MaxPeriod:             maxPeriod,
+		PolynomialFactors:   polynomialCalculation,
+		InflationRecipients: inflationRecipients,
+		InflationEnabled:    inflationEnabled,
+		HasInflationStarted: hasInflationStarted,
+		EpochsPerPeriod:     epochsPerPeriod,
+		PeriodsPerYear:      periodsPerYear,
+		MaxPeriod:           maxPeriod,
 	}
 }
 
 // default inflation module parameters
 func DefaultParams() Params {
 	return Params{
-		PolynomialFactors:     DefaultPolynomialFactors,
-		InflationDistribution: DefaultInflationDistribution,
-		InflationEnabled:      DefaultInflation,
-		HasInflationStarted:   DefaultInflation,
-		EpochsPerPeriod:       DefaultEpochsPerPeriod,
-		PeriodsPerYear:        DefaultPeriodsPerYear,
-		MaxPeriod:             DefaultMaxPeriod,
+		PolynomialFactors:   DefaultPolynomialFactors,
+		InflationRecipients: DefaultInflationRecipients,
+		InflationEnabled:    DefaultInflation,
+		HasInflationStarted: DefaultInflation,
+		EpochsPerPeriod:     DefaultEpochsPerPeriod,
+		PeriodsPerYear:      DefaultPeriodsPerYear,
+		MaxPeriod:           DefaultMaxPeriod,
+
+		CirculatingSupplyExclusions: DefaultCirculatingSupplyExclusions,
+		BondedRatioInflation:        DefaultBondedRatioInflation,
 	}
 }
 
@@ -82,32 +82,6 @@ func validatePolynomialFactors(i any) error {
 	return nil
 }
 
-func validateInflationDistribution(i any) error {
-	v, ok := i.(InflationDistribution)
-	if !ok {
-		return fmt.Errorf("invalid parameter type: %T", i)
-	}
-
-	if v.StakingRewards.IsNegative() {
-		return errors.New("staking distribution ratio must not be negative")
-	}
-
-	if v.CommunityPool.IsNegative() {
-		return errors.New("community pool distribution ratio must not be negative")
-	}
-
-	if v.StrategicReserves.IsNegative() {
-		return errors.New("pool incentives distribution ratio must not be negative")
-	}
-
-	totalProportions := v.StakingRewards.Add(v.StrategicReserves).Add(v.CommunityPool)
-	if !totalProportions.Equal(sdkmath.LegacyOneDec()) {
-		return errors.New("total distributions ratio should be 1")
-	}
-
-	return nil
-}
-
 func validateBool(i any) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -151,6 +125,21 @@ func validatePeriodsPerYear(i any) error {
 	return nil
 }
 
+// WithoutLegacyInflationDistribution converts a deprecated
+// [Params.InflationDistribution] into the equivalent [Params.InflationRecipients]
+// and clears it. Recipients that are already set are kept. Params from older
+// genesis exports go through it before they are validated and stored.
+func (p Params) WithoutLegacyInflationDistribution() Params {
+	if p.InflationDistribution == nil {
+		return p
+	}
+	if len(p.InflationRecipients) == 0 {
+		p.InflationRecipients = LegacyInflationRecipients(*p.InflationDistribution)
+	}
+	p.InflationDistribution = nil
+	return p
+}
+
 func (p Params) Validate() error {
 	if err := validateEpochsPerPeriod(p.EpochsPerPeriod); err != nil {
 		return err
@@ -161,7 +150,10 @@ func (p Params) Validate() error {
 	if err := validatePolynomialFactors(p.PolynomialFactors); err != nil {
 		return err
 	}
-	if err := validateInflationDistribution(p.InflationDistribution); err != nil {
+	if p.InflationDistribution != nil {
+		return errors.New("inflation distribution is deprecated: use inflation recipients")
+	}
+	if err := ValidateInflationRecipients(p.InflationRecipients); err != nil {
 		return err
 	}
 	if err := validateUint64(p.MaxPeriod); err != nil {
@@ -170,6 +162,12 @@ func (p Params) Validate() error {
 	if err := validateBool(p.HasInflationStarted); err != nil {
 		return err
 	}
+	if err := p.CirculatingSupplyExclusions.Validate(); err != nil {
+		return err
+	}
+	if err := p.BondedRatioInflation.Validate(); err != nil {
+		return err
+	}
 
 	return validateBool(p.InflationEnabled)
 }
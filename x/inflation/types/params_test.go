@@ -5,12 +5,14 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
 	inflationtypes "github.com/NibiruChain/nibiru/v2/x/inflation/types"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestParamsValidate(t *testing.T) {
+	dupAddr := testutil.AccAddress().String()
 	testCases := []struct {
 		name     string
 		params   inflationtypes.Params
@@ -25,7 +27,7 @@ func TestParamsValidate(t *testing.T) {
 			"valid",
 			inflationtypes.NewParams(
 				inflationtypes.DefaultPolynomialFactors,
-				inflationtypes.DefaultInflationDistribution,
+				inflationtypes.DefaultInflationRecipients,
 				true,
 				true,
 				inflationtypes.DefaultEpochsPerPeriod,
@@ -37,24 +39,24 @@ func TestParamsValidate(t *testing.T) {
 		{
 			"valid param literal",
 			inflationtypes.Params{
-				PolynomialFactors:     inflationtypes.DefaultPolynomialFactors,
-				InflationDistribution: inflationtypes.DefaultInflationDistribution,
-				InflationEnabled:      true,
-				HasInflationStarted:   true,
-				EpochsPerPeriod:       inflationtypes.DefaultEpochsPerPeriod,
-				PeriodsPerYear:        inflationtypes.DefaultPeriodsPerYear,
+				PolynomialFactors:   inflationtypes.DefaultPolynomialFactors,
+				InflationRecipients: inflationtypes.DefaultInflationRecipients,
+				InflationEnabled:    true,
+				HasInflationStarted: true,
+				EpochsPerPeriod:     inflationtypes.DefaultEpochsPerPeriod,
+				PeriodsPerYear:      inflationtypes.DefaultPeriodsPerYear,
 			},
 			false,
 		},
 		{
 			"invalid - polynomial calculation - no coefficient",
 			inflationtypes.Params{
-				PolynomialFactors:     []sdkmath.LegacyDec{},
-				InflationDistribution: inflationtypes.DefaultInflationDistribution,
-				InflationEnabled:      true,
-				HasInflationStarted:   true,
-				EpochsPerPeriod:       inflationtypes.DefaultEpochsPerPeriod,
-				PeriodsPerYear:        inflationtypes.DefaultPeriodsPerYear,
+				PolynomialFactors:   []sdkmath.LegacyDec{},
+				InflationRecipients: inflationtypes.DefaultInflationRecipients,
+				InflationEnabled:    true,
+				HasInflationStarted: true,
+				EpochsPerPeriod:     inflationtypes.DefaultEpochsPerPeriod,
+				PeriodsPerYear:      inflationtypes.DefaultPeriodsPerYear,
 			},
 			true,
 		},
@@ -62,11 +64,11 @@ func TestParamsValidate(t *testing.T) {
 			"invalid - inflation distribution - negative staking rewards",
 			inflationtypes.Params{
 				PolynomialFactors: inflationtypes.DefaultPolynomialFactors,
-				InflationDistribution: inflationtypes.InflationDistribution{
+				InflationRecipients: inflationtypes.LegacyInflationRecipients(inflationtypes.InflationDistribution{
 					StakingRewards:    sdkmath.LegacyOneDec().Neg(),
 					CommunityPool:     sdkmath.LegacyNewDecWithPrec(133333, 6),
 					StrategicReserves: sdkmath.LegacyNewDecWithPrec(333333, 6),
-				},
+				}),
 				InflationEnabled:    true,
 				HasInflationStarted: true,
 				EpochsPerPeriod:     inflationtypes.DefaultEpochsPerPeriod,
@@ -78,11 +80,11 @@ func TestParamsValidate(t *testing.T) {
 			"invalid - inflation distribution - negative usage incentives",
 			inflationtypes.Params{
 				PolynomialFactors: inflationtypes.DefaultPolynomialFactors,
-				InflationDistribution: inflationtypes.InflationDistribution{
+				InflationRecipients: inflationtypes.LegacyInflationRecipients(inflationtypes.InflationDistribution{
 					StakingRewards:    sdkmath.LegacyNewDecWithPrec(533334, 6),
 					CommunityPool:     sdkmath.LegacyNewDecWithPrec(133333, 6),
 					StrategicReserves: sdkmath.LegacyOneDec().Neg(),
-				},
+				}),
 				InflationEnabled:    true,
 				HasInflationStarted: true,
 				EpochsPerPeriod:     inflationtypes.DefaultEpochsPerPeriod,
@@ -94,11 +96,11 @@ func TestParamsValidate(t *testing.T) {
 			"invalid - inflation distribution - negative community pool rewards",
 			inflationtypes.Params{
 				PolynomialFactors: inflationtypes.DefaultPolynomialFactors,
-				InflationDistribution: inflationtypes.InflationDistribution{
+				InflationRecipients: inflationtypes.LegacyInflationRecipients(inflationtypes.InflationDistribution{
 					StakingRewards:    sdkmath.LegacyNewDecWithPrec(533334, 6),
 					CommunityPool:     sdkmath.LegacyOneDec().Neg(),
 					StrategicReserves: sdkmath.LegacyNewDecWithPrec(333333, 6),
-				},
+				}),
 				InflationEnabled:    true,
 				HasInflationStarted: true,
 				EpochsPerPeriod:     inflationtypes.DefaultEpochsPerPeriod,
@@ -110,11 +112,11 @@ func TestParamsValidate(t *testing.T) {
 			"invalid - inflation distribution - total distribution ratio unequal 1",
 			inflationtypes.Params{
 				PolynomialFactors: inflationtypes.DefaultPolynomialFactors,
-				InflationDistribution: inflationtypes.InflationDistribution{
+				InflationRecipients: inflationtypes.LegacyInflationRecipients(inflationtypes.InflationDistribution{
 					StakingRewards:    sdkmath.LegacyNewDecWithPrec(533333, 6),
 					CommunityPool:     sdkmath.LegacyNewDecWithPrec(133333, 6),
 					StrategicReserves: sdkmath.LegacyNewDecWithPrec(333333, 6),
-				},
+				}),
 				InflationEnabled:    true,
 				HasInflationStarted: true,
 				EpochsPerPeriod:     inflationtypes.DefaultEpochsPerPeriod,
@@ -122,6 +124,161 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid - deprecated inflation distribution is set",
+			func() inflationtypes.Params {
+				params := inflationtypes.DefaultParams()
+				params.InflationDistribution = &inflationtypes.InflationDistribution{
+					StakingRewards:    sdkmath.LegacyOneDec(),
+					CommunityPool:     sdkmath.LegacyZeroDec(),
+					StrategicReserves: sdkmath.LegacyZeroDec(),
+				}
+				return params
+			}(),
+			true,
+		},
+		{
+			"valid - inflation recipients of every type",
+			withRecipients(
+				inflationtypes.StakingRewardsRecipient(sdkmath.LegacyNewDecWithPrec(2, 1)),
+				inflationtypes.CommunityPoolRecipient(sdkmath.LegacyNewDecWithPrec(2, 1)),
+				inflationtypes.StrategicReserveRecipient(sdkmath.LegacyNewDecWithPrec(2, 1)),
+				inflationtypes.InflationRecipient{
+					Name:          "oracle",
+					RecipientType: inflationtypes.INFLATION_RECIPIENT_TYPE_ORACLE_REWARDS,
+					Weight:        sdkmath.LegacyNewDecWithPrec(2, 1),
+					VotePeriods:   1_000,
+				},
+				inflationtypes.InflationRecipient{
+					Name:          "treasury",
+					RecipientType: inflationtypes.INFLATION_RECIPIENT_TYPE_ADDRESS,
+					Target:        dupAddr,
+					Weight:        sdkmath.LegacyNewDecWithPrec(2, 1),
+				},
+			),
+			false,
+		},
+		{
+			"invalid - inflation recipients - empty",
+			withRecipients(),
+			true,
+		},
+		{
+			"invalid - inflation recipients - duplicate name",
+			withRecipients(
+				inflationtypes.CommunityPoolRecipient(sdkmath.LegacyNewDecWithPrec(5, 1)),
+				inflationtypes.CommunityPoolRecipient(sdkmath.LegacyNewDecWithPrec(5, 1)),
+			),
+			true,
+		},
+		{
+			"invalid - inflation recipients - oracle rewards without vote periods",
+			withRecipients(inflationtypes.InflationRecipient{
+				Name:          "oracle",
+				RecipientType: inflationtypes.INFLATION_RECIPIENT_TYPE_ORACLE_REWARDS,
+				Weight:        sdkmath.LegacyOneDec(),
+			}),
+			true,
+		},
+		{
+			"invalid - inflation recipients - bad address",
+			withRecipients(inflationtypes.InflationRecipient{
+				Name:          "treasury",
+				RecipientType: inflationtypes.INFLATION_RECIPIENT_TYPE_ADDRESS,
+				Target:        "not-an-address",
+				Weight:        sdkmath.LegacyOneDec(),
+			}),
+			true,
+		},
+		{
+			"invalid - inflation recipients - unspecified type",
+			withRecipients(inflationtypes.InflationRecipient{
+				Name:   "unknown",
+				Weight: sdkmath.LegacyOneDec(),
+			}),
+			true,
+		},
+		{
+			"valid - circulating supply exclusions",
+			withExclusions(inflationtypes.CirculatingSupplyExclusions{
+				ModuleAccounts:   []string{inflationtypes.ModuleName, "distribution"},
+				Addresses:        []string{testutil.AccAddress().String()},
+				StrategicReserve: true,
+			}),
+			false,
+		},
+		{
+			"invalid - circulating supply exclusions - empty module account",
+			withExclusions(inflationtypes.CirculatingSupplyExclusions{
+				ModuleAccounts: []string{""},
+			}),
+			true,
+		},
+		{
+			"invalid - circulating supply exclusions - duplicate module account",
+			withExclusions(inflationtypes.CirculatingSupplyExclusions{
+				ModuleAccounts: []string{"distribution", "distribution"},
+			}),
+			true,
+		},
+		{
+			"invalid - circulating supply exclusions - bad address",
+			withExclusions(inflationtypes.CirculatingSupplyExclusions{
+				Addresses: []string{"not-an-address"},
+			}),
+			true,
+		},
+		{
+			"invalid - circulating supply exclusions - duplicate address",
+			withExclusions(inflationtypes.CirculatingSupplyExclusions{
+				Addresses: []string{dupAddr, dupAddr},
+			}),
+			true,
+		},
+		{
+			"valid - bonded ratio inflation enabled",
+			withBondedRatioInflation(true, "0.5", "0.8", "1.2"),
+			false,
+		},
+		{
+			"valid - bonded ratio inflation not set",
+			withBondedRatioInflationUnset(),
+			false,
+		},
+		{
+			"invalid - bonded ratio inflation - zero target",
+			withBondedRatioInflation(true, "0", "0.8", "1.2"),
+			true,
+		},
+		{
+			"invalid - bonded ratio inflation - target above one",
+			withBondedRatioInflation(false, "1.1", "0.8", "1.2"),
+			true,
+		},
+		{
+			"invalid - bonded ratio inflation - negative min multiplier",
+			withBondedRatioInflation(true, "0.5", "-0.1", "1.2"),
+			true,
+		},
+		{
+			"invalid - bonded ratio inflation - zero min multiplier",
+			withBondedRatioInflation(true, "0.5", "0", "1.2"),
+			true,
+		},
+		{
+			"invalid - bonded ratio inflation - min above max",
+			withBondedRatioInflation(true, "0.5", "1.3", "1.2"),
+			true,
+		},
+		{
+			"invalid - bonded ratio inflation - enabled without values",
+			func() inflationtypes.Params {
+				params := withBondedRatioInflationUnset()
+				params.BondedRatioInflation.Enabled = true
+				return params
+			}(),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -137,3 +294,60 @@ func TestParamsValidate(t *testing.T) {
 		})
 	}
 }
+
+func withBondedRatioInflation(enabled bool, target, minMultiplier, maxMultiplier string) inflationtypes.Params {
+	params := inflationtypes.DefaultParams()
+	params.BondedRatioInflation = inflationtypes.BondedRatioInflation{
+		Enabled:           enabled,
+		TargetBondedRatio: sdkmath.LegacyMustNewDecFromStr(target),
+		MinMultiplier:     sdkmath.LegacyMustNewDecFromStr(minMultiplier),
+		MaxMultiplier:     sdkmath.LegacyMustNewDecFromStr(maxMultiplier),
+	}
+	return params
+}
+
+func withBondedRatioInflationUnset() inflationtypes.Params {
+	params := inflationtypes.DefaultParams()
+	params.BondedRatioInflation = inflationtypes.BondedRatioInflation{}
+	return params
+}
+
+func TestBondedRatioInflationMultiplier(t *testing.T) {
+	bondedRatioInflation := inflationtypes.BondedRatioInflation{
+		Enabled:           true,
+		TargetBondedRatio: sdkmath.LegacyMustNewDecFromStr("0.5"),
+		MinMultiplier:     sdkmath.LegacyMustNewDecFromStr("0.8"),
+		MaxMultiplier:     sdkmath.LegacyMustNewDecFromStr("1.5"),
+	}
+
+	for _, tc := range []struct {
+		bondedRatio string
+		want        string
+	}{
+		{"0.5", "1"},
+		{"0.4", "1.2"},
+		{"0.6", "0.8"},
+		{"0.1", "1.5"}, // clamped to the max multiplier
+		{"0", "1.5"},
+		{"0.9", "0.8"}, // clamped to the min multiplier
+		{"1", "0.8"},
+	} {
+		got := bondedRatioInflation.Multiplier(sdkmath.LegacyMustNewDecFromStr(tc.bondedRatio))
+		require.Equal(t, sdkmath.LegacyMustNewDecFromStr(tc.want), got, "bonded ratio %s", tc.bondedRatio)
+	}
+
+	bondedRatioInflation.Enabled = false
+	require.Equal(t, sdkmath.LegacyOneDec(), bondedRatioInflation.Multiplier(sdkmath.LegacyZeroDec()))
+}
+
+func withExclusions(exclusions inflationtypes.CirculatingSupplyExclusions) inflationtypes.Params {
+	params := inflationtypes.DefaultParams()
+	params.CirculatingSupplyExclusions = exclusions
+	return params
+}
+
+func withRecipients(recipients ...inflationtypes.InflationRecipient) inflationtypes.Params {
+	params := inflationtypes.DefaultParams()
+	params.InflationRecipients = recipients
+	return params
+}
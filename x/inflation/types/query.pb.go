@@ -371,6 +371,190 @@ func (m *QueryCirculatingSupplyResponse) GetCirculatingSupply() types.DecCoin {
 	return types.DecCoin{}
 }
 
+// QueryCirculatingSupplyBreakdownRequest is the request type for the
+// Query/CirculatingSupplyBreakdown RPC method.
+type QueryCirculatingSupplyBreakdownRequest struct {
+}
+
+func (m *QueryCirculatingSupplyBreakdownRequest) Reset() {
+	*m = QueryCirculatingSupplyBreakdownRequest{}
+}
+func (m *QueryCirculatingSupplyBreakdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCirculatingSupplyBreakdownRequest) ProtoMessage()    {}
+func (*QueryCirculatingSupplyBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{8}
+}
+func (m *QueryCirculatingSupplyBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCirculatingSupplyBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCirculatingSupplyBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCirculatingSupplyBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCirculatingSupplyBreakdownRequest.Merge(m, src)
+}
+func (m *QueryCirculatingSupplyBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCirculatingSupplyBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCirculatingSupplyBreakdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCirculatingSupplyBreakdownRequest proto.InternalMessageInfo
+
+// QueryCirculatingSupplyBreakdownResponse is the response type for the
+// Query/CirculatingSupplyBreakdown RPC method. The circulating supply equals
+// the total supply minus the excluded balances and the unvested amount.
+type QueryCirculatingSupplyBreakdownResponse struct {
+	// total_supply is the bank supply of the mint denom.
+	TotalSupply types.Coin `protobuf:"bytes,1,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply"`
+	// circulating_supply is the amount of the mint denom in circulation.
+	CirculatingSupply types.Coin `protobuf:"bytes,2,opt,name=circulating_supply,json=circulatingSupply,proto3" json:"circulating_supply"`
+	// unvested is the amount still locked in vesting accounts that are not
+	// otherwise excluded.
+	Unvested types.Coin `protobuf:"bytes,3,opt,name=unvested,proto3" json:"unvested"`
+	// excluded lists the balances of the excluded accounts.
+	Excluded []ExcludedBalance `protobuf:"bytes,4,rep,name=excluded,proto3" json:"excluded"`
+}
+
+func (m *QueryCirculatingSupplyBreakdownResponse) Reset() {
+	*m = QueryCirculatingSupplyBreakdownResponse{}
+}
+func (m *QueryCirculatingSupplyBreakdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCirculatingSupplyBreakdownResponse) ProtoMessage()    {}
+func (*QueryCirculatingSupplyBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{9}
+}
+func (m *QueryCirculatingSupplyBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCirculatingSupplyBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCirculatingSupplyBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCirculatingSupplyBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCirculatingSupplyBreakdownResponse.Merge(m, src)
+}
+func (m *QueryCirculatingSupplyBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCirculatingSupplyBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCirculatingSupplyBreakdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCirculatingSupplyBreakdownResponse proto.InternalMessageInfo
+
+func (m *QueryCirculatingSupplyBreakdownResponse) GetTotalSupply() types.Coin {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return types.Coin{}
+}
+
+func (m *QueryCirculatingSupplyBreakdownResponse) GetCirculatingSupply() types.Coin {
+	if m != nil {
+		return m.CirculatingSupply
+	}
+	return types.Coin{}
+}
+
+func (m *QueryCirculatingSupplyBreakdownResponse) GetUnvested() types.Coin {
+	if m != nil {
+		return m.Unvested
+	}
+	return types.Coin{}
+}
+
+func (m *QueryCirculatingSupplyBreakdownResponse) GetExcluded() []ExcludedBalance {
+	if m != nil {
+		return m.Excluded
+	}
+	return nil
+}
+
+// ExcludedBalance is the balance of an account excluded from the circulating
+// supply.
+type ExcludedBalance struct {
+	// address is the bech32 address of the excluded account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// label names the reason for the exclusion: a module account name,
+	// "strategic_reserve", or "address" for an explicitly listed address.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// balance is the balance of the mint denom held by the account.
+	Balance types.Coin `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *ExcludedBalance) Reset()         { *m = ExcludedBalance{} }
+func (m *ExcludedBalance) String() string { return proto.CompactTextString(m) }
+func (*ExcludedBalance) ProtoMessage()    {}
+func (*ExcludedBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{10}
+}
+func (m *ExcludedBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExcludedBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExcludedBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExcludedBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExcludedBalance.Merge(m, src)
+}
+func (m *ExcludedBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExcludedBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExcludedBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExcludedBalance proto.InternalMessageInfo
+
+func (m *ExcludedBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExcludedBalance) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *ExcludedBalance) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
 // QueryInflationRateRequest is the request type for the Query/InflationRate RPC
 // method.
 type QueryInflationRateRequest struct {
@@ -380,7 +564,7 @@ func (m *QueryInflationRateRequest) Reset()         { *m = QueryInflationRateReq
 func (m *QueryInflationRateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryInflationRateRequest) ProtoMessage()    {}
 func (*QueryInflationRateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9cef9ea5e4d20e5e, []int{8}
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{11}
 }
 func (m *QueryInflationRateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,7 +604,7 @@ func (m *QueryInflationRateResponse) Reset()         { *m = QueryInflationRateRe
 func (m *QueryInflationRateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInflationRateResponse) ProtoMessage()    {}
 func (*QueryInflationRateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9cef9ea5e4d20e5e, []int{9}
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{12}
 }
 func (m *QueryInflationRateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,6 +633,182 @@ func (m *QueryInflationRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryInflationRateResponse proto.InternalMessageInfo
 
+// QueryInflationScheduleRequest is the request type for the
+// Query/InflationSchedule RPC method.
+type QueryInflationScheduleRequest struct {
+	// num_periods is the number of periods to project, starting with the
+	// current one. Zero projects every period until max_period.
+	NumPeriods uint64 `protobuf:"varint,1,opt,name=num_periods,json=numPeriods,proto3" json:"num_periods,omitempty"`
+}
+
+func (m *QueryInflationScheduleRequest) Reset()         { *m = QueryInflationScheduleRequest{} }
+func (m *QueryInflationScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationScheduleRequest) ProtoMessage()    {}
+func (*QueryInflationScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{13}
+}
+func (m *QueryInflationScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationScheduleRequest.Merge(m, src)
+}
+func (m *QueryInflationScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryInflationScheduleRequest) GetNumPeriods() uint64 {
+	if m != nil {
+		return m.NumPeriods
+	}
+	return 0
+}
+
+// QueryInflationScheduleResponse is the response type for the
+// Query/InflationSchedule RPC method.
+type QueryInflationScheduleResponse struct {
+	// total_supply is the current bank supply of the mint denom, which the
+	// projection starts from.
+	TotalSupply types.Coin `protobuf:"bytes,1,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply"`
+	// periods is the projected schedule, one entry per period.
+	Periods []InflationSchedulePeriod `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods"`
+	// circulating_supply is the current circulating supply of the mint denom,
+	// which the annualized rates of the projection start from.
+	CirculatingSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=circulating_supply,json=circulatingSupply,proto3,customtype=cosmossdk.io/math.Int" json:"circulating_supply"`
+}
+
+func (m *QueryInflationScheduleResponse) Reset()         { *m = QueryInflationScheduleResponse{} }
+func (m *QueryInflationScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationScheduleResponse) ProtoMessage()    {}
+func (*QueryInflationScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{14}
+}
+func (m *QueryInflationScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationScheduleResponse.Merge(m, src)
+}
+func (m *QueryInflationScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryInflationScheduleResponse) GetTotalSupply() types.Coin {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return types.Coin{}
+}
+
+func (m *QueryInflationScheduleResponse) GetPeriods() []InflationSchedulePeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+// InflationSchedulePeriod is the projected inflation of a single period.
+type InflationSchedulePeriod struct {
+	// period is the inflation period number.
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// num_epochs is the number of epochs of the period that have yet to mint.
+	// It is lower than epochs_per_period for the current period when some of
+	// its epochs already minted.
+	NumEpochs uint64 `protobuf:"varint,2,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
+	// epoch_mint_provision is the amount minted per epoch.
+	EpochMintProvision cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=epoch_mint_provision,json=epochMintProvision,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"epoch_mint_provision"`
+	// period_mint is the amount minted by the num_epochs epochs.
+	PeriodMint cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=period_mint,json=periodMint,proto3,customtype=cosmossdk.io/math.Int" json:"period_mint"`
+	// cumulative_supply is the projected total supply at the end of the period.
+	CumulativeSupply cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=cumulative_supply,json=cumulativeSupply,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative_supply"`
+	// annualized_rate is the inflation rate in percent implied by the epoch
+	// mint provision, relative to the circulating supply at the start of the
+	// period, like the InflationRate query. Minted coins are assumed to
+	// circulate.
+	AnnualizedRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=annualized_rate,json=annualizedRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annualized_rate"`
+}
+
+func (m *InflationSchedulePeriod) Reset()         { *m = InflationSchedulePeriod{} }
+func (m *InflationSchedulePeriod) String() string { return proto.CompactTextString(m) }
+func (*InflationSchedulePeriod) ProtoMessage()    {}
+func (*InflationSchedulePeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{15}
+}
+func (m *InflationSchedulePeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationSchedulePeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationSchedulePeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationSchedulePeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationSchedulePeriod.Merge(m, src)
+}
+func (m *InflationSchedulePeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationSchedulePeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationSchedulePeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationSchedulePeriod proto.InternalMessageInfo
+
+func (m *InflationSchedulePeriod) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *InflationSchedulePeriod) GetNumEpochs() uint64 {
+	if m != nil {
+		return m.NumEpochs
+	}
+	return 0
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -457,7 +817,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9cef9ea5e4d20e5e, []int{10}
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{16}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,7 +856,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9cef9ea5e4d20e5e, []int{11}
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{17}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -541,8 +901,14 @@ func init() {
 	proto.RegisterType((*QuerySkippedEpochsResponse)(nil), "nibiru.inflation.v1.QuerySkippedEpochsResponse")
 	proto.RegisterType((*QueryCirculatingSupplyRequest)(nil), "nibiru.inflation.v1.QueryCirculatingSupplyRequest")
 	proto.RegisterType((*QueryCirculatingSupplyResponse)(nil), "nibiru.inflation.v1.QueryCirculatingSupplyResponse")
+	proto.RegisterType((*QueryCirculatingSupplyBreakdownRequest)(nil), "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownRequest")
+	proto.RegisterType((*QueryCirculatingSupplyBreakdownResponse)(nil), "nibiru.inflation.v1.QueryCirculatingSupplyBreakdownResponse")
+	proto.RegisterType((*ExcludedBalance)(nil), "nibiru.inflation.v1.ExcludedBalance")
 	proto.RegisterType((*QueryInflationRateRequest)(nil), "nibiru.inflation.v1.QueryInflationRateRequest")
 	proto.RegisterType((*QueryInflationRateResponse)(nil), "nibiru.inflation.v1.QueryInflationRateResponse")
+	proto.RegisterType((*QueryInflationScheduleRequest)(nil), "nibiru.inflation.v1.QueryInflationScheduleRequest")
+	proto.RegisterType((*QueryInflationScheduleResponse)(nil), "nibiru.inflation.v1.QueryInflationScheduleResponse")
+	proto.RegisterType((*InflationSchedulePeriod)(nil), "nibiru.inflation.v1.InflationSchedulePeriod")
 	proto.RegisterType((*QueryParamsRequest)(nil), "nibiru.inflation.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.inflation.v1.QueryParamsResponse")
 }
@@ -550,53 +916,77 @@ func init() {
 func init() { proto.RegisterFile("nibiru/inflation/v1/query.proto", fileDescriptor_9cef9ea5e4d20e5e) }
 
 var fileDescriptor_9cef9ea5e4d20e5e = []byte{
-	// 721 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcf, 0x4e, 0x13, 0x41,
-	0x1c, 0xc7, 0xbb, 0x06, 0x6b, 0x1c, 0x03, 0x09, 0x03, 0x31, 0xb2, 0x85, 0x2d, 0x96, 0x10, 0x20,
-	0x84, 0x9d, 0xb4, 0xf5, 0xe2, 0x95, 0xe2, 0xc1, 0x04, 0x0d, 0x96, 0x8b, 0xf1, 0xd2, 0x4c, 0xb7,
-	0xe3, 0x76, 0x02, 0x9d, 0x59, 0x76, 0xa6, 0x8d, 0xbd, 0x19, 0x7d, 0x01, 0x13, 0xcf, 0x9e, 0x3c,
-	0x98, 0x98, 0x78, 0x21, 0x3e, 0x04, 0x47, 0xa2, 0x17, 0xe3, 0x01, 0x0d, 0xf8, 0x20, 0x66, 0x67,
-	0x66, 0x4b, 0x4b, 0xa7, 0xad, 0x1c, 0x3c, 0x75, 0x77, 0x7e, 0xff, 0xbe, 0xf3, 0xdd, 0xf9, 0x4c,
-	0x41, 0x9e, 0xd1, 0x3a, 0x8d, 0xdb, 0x88, 0xb2, 0x97, 0x87, 0x58, 0x52, 0xce, 0x50, 0xa7, 0x88,
-	0x8e, 0xda, 0x24, 0xee, 0xfa, 0x51, 0xcc, 0x25, 0x87, 0x73, 0x3a, 0xc1, 0xef, 0x25, 0xf8, 0x9d,
-	0xa2, 0xeb, 0x05, 0x5c, 0xb4, 0xb8, 0x40, 0x75, 0x2c, 0x08, 0xea, 0x14, 0xeb, 0x44, 0xe2, 0x22,
-	0x0a, 0x38, 0x65, 0xba, 0xc8, 0xbd, 0x6f, 0xeb, 0x1a, 0x12, 0x46, 0x04, 0x15, 0x26, 0x65, 0x3e,
-	0xe4, 0x21, 0x57, 0x8f, 0x28, 0x79, 0x32, 0xab, 0x8b, 0x21, 0xe7, 0xe1, 0x21, 0x41, 0x38, 0xa2,
-	0x08, 0x33, 0xc6, 0xa5, 0xaa, 0x4e, 0x6b, 0x16, 0xf4, 0xd8, 0x9a, 0x2e, 0xd3, 0x2f, 0x3a, 0x54,
-	0x98, 0x07, 0xf0, 0x59, 0xa2, 0x7a, 0x8f, 0xc4, 0x94, 0x37, 0xaa, 0xe4, 0xa8, 0x4d, 0x84, 0x2c,
-	0x6c, 0x81, 0xb9, 0x81, 0x55, 0x11, 0x71, 0x26, 0x08, 0xbc, 0x0b, 0xb2, 0x91, 0x5a, 0xb9, 0xe7,
-	0x2c, 0x3b, 0xeb, 0x53, 0x55, 0xf3, 0x56, 0x58, 0x06, 0x9e, 0x4a, 0x7f, 0x14, 0xf1, 0xa0, 0xf9,
-	0x84, 0x32, 0xb9, 0x17, 0xf3, 0x0e, 0x15, 0x94, 0xb3, 0xb4, 0xe1, 0x27, 0x07, 0xe4, 0x47, 0xa6,
-	0x98, 0xee, 0x6f, 0x1d, 0x30, 0x4f, 0x92, 0x70, 0xad, 0x45, 0x99, 0x4c, 0xc4, 0xea, 0x04, 0x35,
-	0xec, 0x4e, 0x69, 0xd1, 0x37, 0xc2, 0x13, 0xf3, 0x7c, 0x63, 0x9e, 0xbf, 0x43, 0x82, 0x0a, 0xa7,
-	0x6c, 0xbb, 0x7c, 0x72, 0x96, 0xcf, 0x7c, 0xfe, 0x95, 0xdf, 0x0c, 0xa9, 0x6c, 0xb6, 0xeb, 0x7e,
-	0xc0, 0x5b, 0x66, 0xa3, 0xe6, 0x67, 0x4b, 0x34, 0x0e, 0x90, 0xec, 0x46, 0x44, 0xa4, 0x35, 0xa2,
-	0x0a, 0xc9, 0x90, 0x9a, 0x42, 0x0e, 0x2c, 0x28, 0xa1, 0xfb, 0x07, 0x34, 0x8a, 0x48, 0x43, 0xe9,
-	0x15, 0xe9, 0x36, 0x2a, 0xc0, 0xb5, 0x05, 0xcd, 0x06, 0x56, 0xc1, 0x8c, 0xd0, 0x81, 0x9a, 0x6a,
-	0x2c, 0x8c, 0x4d, 0xd3, 0xa2, 0x3f, 0xbd, 0x90, 0x07, 0x4b, 0xaa, 0x49, 0x85, 0xc6, 0x41, 0x3b,
-	0xf9, 0xcc, 0x2c, 0xdc, 0x6f, 0x47, 0xd1, 0x61, 0x37, 0x9d, 0xf2, 0xd1, 0x31, 0x7e, 0x5a, 0x32,
-	0xcc, 0xa8, 0xd7, 0x0e, 0x80, 0xc1, 0x65, 0xb4, 0x26, 0x54, 0xf8, 0xff, 0x39, 0x35, 0x1b, 0x5c,
-	0x95, 0xd2, 0x33, 0xea, 0x71, 0x7a, 0x56, 0xab, 0x58, 0x92, 0x74, 0x0b, 0x1d, 0x63, 0xd4, 0x95,
-	0xa0, 0x51, 0xff, 0x1c, 0xcc, 0xf4, 0x4e, 0x78, 0x2d, 0xc6, 0x92, 0x28, 0xe1, 0xb7, 0xb7, 0x8b,
-	0x89, 0xb4, 0x9f, 0x67, 0xf9, 0x9c, 0x16, 0x22, 0x1a, 0x07, 0x3e, 0xe5, 0xa8, 0x85, 0x65, 0xd3,
-	0xdf, 0x25, 0x21, 0x0e, 0xba, 0x3b, 0x24, 0xf8, 0xf6, 0x75, 0x0b, 0x98, 0xed, 0xed, 0x90, 0xa0,
-	0x3a, 0x4d, 0xfb, 0x27, 0x5c, 0x1e, 0x67, 0x1c, 0xe3, 0x56, 0xef, 0xb3, 0xed, 0xa5, 0xc7, 0xd9,
-	0xac, 0x1a, 0x19, 0x0f, 0x41, 0x36, 0x52, 0x2b, 0xc6, 0xb7, 0x9c, 0x6f, 0x61, 0xd6, 0xd7, 0x45,
-	0xdb, 0x53, 0x89, 0xb6, 0xaa, 0x29, 0x28, 0x1d, 0xdf, 0x02, 0x37, 0x55, 0xcb, 0xe4, 0x4b, 0x64,
-	0x35, 0x26, 0x70, 0xcd, 0x5a, 0x3f, 0x8c, 0x97, 0xbb, 0x3e, 0x39, 0x51, 0x4b, 0x2c, 0xac, 0xbc,
-	0xf9, 0xfe, 0xe7, 0xfd, 0x8d, 0x25, 0x98, 0x43, 0xb6, 0x9b, 0x41, 0xe3, 0x07, 0x8f, 0x1d, 0x00,
-	0x87, 0xb9, 0x82, 0xe5, 0xd1, 0x53, 0x46, 0x82, 0xea, 0x3e, 0xb8, 0x5e, 0x91, 0x91, 0x59, 0x54,
-	0x32, 0x37, 0xe1, 0x86, 0x55, 0xa6, 0x0d, 0x6a, 0xf8, 0xc1, 0x01, 0xd3, 0x03, 0x18, 0x41, 0x7f,
-	0xf4, 0x68, 0x1b, 0x8c, 0x2e, 0xfa, 0xe7, 0x7c, 0xa3, 0x72, 0x53, 0xa9, 0x5c, 0x85, 0x2b, 0x56,
-	0x95, 0x83, 0xe8, 0xc2, 0x2f, 0x0e, 0x98, 0x1d, 0xe2, 0x0f, 0x96, 0x46, 0xcf, 0x1c, 0x85, 0xb3,
-	0x5b, 0xbe, 0x56, 0x8d, 0xd1, 0x8a, 0x94, 0xd6, 0x0d, 0xb8, 0x66, 0xd5, 0x3a, 0x8c, 0xbe, 0xf2,
-	0x73, 0x80, 0xb6, 0x71, 0x7e, 0xda, 0x98, 0x1d, 0xe7, 0xa7, 0x15, 0xe3, 0x09, 0x7e, 0x0e, 0x12,
-	0xae, 0x39, 0x51, 0xf0, 0x8c, 0xe5, 0xa4, 0x9f, 0xdb, 0xb1, 0x9c, 0x0c, 0xa0, 0x3c, 0x89, 0x13,
-	0x8d, 0xf0, 0xee, 0xc9, 0xb9, 0xe7, 0x9c, 0x9e, 0x7b, 0xce, 0xef, 0x73, 0xcf, 0x79, 0x77, 0xe1,
-	0x65, 0x4e, 0x2f, 0xbc, 0xcc, 0x8f, 0x0b, 0x2f, 0xf3, 0xa2, 0xd4, 0x77, 0x17, 0x3e, 0x55, 0x0d,
-	0x2a, 0x4d, 0x4c, 0x59, 0xda, 0xac, 0x53, 0x42, 0xaf, 0xfa, 0x3a, 0xaa, 0xbb, 0xb1, 0x9e, 0x55,
-	0x7f, 0xa0, 0xe5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x5d, 0x2c, 0xa8, 0x0a, 0x08, 0x00,
-	0x00,
+	// 1108 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x3a, 0xbf, 0x9a, 0x17, 0x92, 0x92, 0x49, 0x80, 0x64, 0xd3, 0xd8, 0xc1, 0x25, 0x24,
+	0x55, 0xc8, 0xae, 0x6c, 0x73, 0x89, 0xca, 0x01, 0x25, 0x29, 0x52, 0xa4, 0x50, 0x05, 0xe7, 0x52,
+	0xe5, 0x62, 0xad, 0x77, 0x07, 0x7b, 0x14, 0x7b, 0x66, 0xeb, 0xd9, 0x35, 0x0d, 0xe2, 0x80, 0xe0,
+	0x8e, 0x90, 0x38, 0x73, 0xea, 0x01, 0x09, 0xa9, 0x17, 0xc4, 0x85, 0x2b, 0xa7, 0x1e, 0xab, 0x72,
+	0x41, 0x1c, 0x0a, 0x4a, 0xf8, 0x43, 0xd0, 0xce, 0x0f, 0xc7, 0x8e, 0x67, 0x13, 0x3b, 0xa2, 0xa7,
+	0x78, 0x67, 0xde, 0xfb, 0xde, 0xf7, 0xde, 0x7c, 0xf3, 0xe6, 0x05, 0x72, 0x94, 0x54, 0x49, 0x2b,
+	0x76, 0x09, 0xfd, 0xbc, 0xe1, 0x45, 0x84, 0x51, 0xb7, 0x5d, 0x70, 0x1f, 0xc7, 0xb8, 0x75, 0xea,
+	0x84, 0x2d, 0x16, 0x31, 0x34, 0x2f, 0x0d, 0x9c, 0x8e, 0x81, 0xd3, 0x2e, 0xd8, 0x59, 0x9f, 0xf1,
+	0x26, 0xe3, 0x6e, 0xd5, 0xe3, 0xd8, 0x6d, 0x17, 0xaa, 0x38, 0xf2, 0x0a, 0xae, 0xcf, 0x08, 0x95,
+	0x4e, 0xf6, 0xbb, 0x26, 0xd4, 0x1a, 0xa6, 0x98, 0x13, 0xae, 0x4c, 0x16, 0x6a, 0xac, 0xc6, 0xc4,
+	0x4f, 0x37, 0xf9, 0xa5, 0x56, 0xef, 0xd4, 0x18, 0xab, 0x35, 0xb0, 0xeb, 0x85, 0xc4, 0xf5, 0x28,
+	0x65, 0x91, 0xf0, 0xd6, 0x3e, 0x4b, 0x32, 0x6c, 0x45, 0xba, 0xc9, 0x0f, 0xb9, 0x95, 0x5f, 0x00,
+	0xf4, 0x59, 0xc2, 0xfa, 0x10, 0xb7, 0x08, 0x0b, 0xca, 0xf8, 0x71, 0x8c, 0x79, 0x94, 0xdf, 0x82,
+	0xf9, 0x9e, 0x55, 0x1e, 0x32, 0xca, 0x31, 0x7a, 0x1b, 0x26, 0x42, 0xb1, 0xb2, 0x68, 0xad, 0x5a,
+	0x1b, 0x63, 0x65, 0xf5, 0x95, 0x5f, 0x85, 0xac, 0x30, 0x7f, 0x10, 0x32, 0xbf, 0xfe, 0x29, 0xa1,
+	0xd1, 0x61, 0x8b, 0xb5, 0x09, 0x27, 0x8c, 0x6a, 0xc0, 0x9f, 0x2c, 0xc8, 0xa5, 0x9a, 0x28, 0xf4,
+	0x6f, 0x2d, 0x58, 0xc0, 0xc9, 0x76, 0xa5, 0x49, 0x68, 0x54, 0x09, 0xb5, 0x81, 0x08, 0x36, 0x5d,
+	0xbc, 0xe3, 0x28, 0xe2, 0x49, 0xf1, 0x1c, 0x55, 0x3c, 0x67, 0x0f, 0xfb, 0xbb, 0x8c, 0xd0, 0x9d,
+	0xd2, 0xf3, 0x57, 0xb9, 0x91, 0x9f, 0xff, 0xce, 0x6d, 0xd6, 0x48, 0x54, 0x8f, 0xab, 0x8e, 0xcf,
+	0x9a, 0x2a, 0x51, 0xf5, 0x67, 0x8b, 0x07, 0x27, 0x6e, 0x74, 0x1a, 0x62, 0xae, 0x7d, 0x78, 0x19,
+	0xe1, 0x3e, 0x36, 0xf9, 0x65, 0x58, 0x12, 0x44, 0x8f, 0x4e, 0x48, 0x18, 0xe2, 0x40, 0xf0, 0xe5,
+	0x3a, 0x8d, 0x5d, 0xb0, 0x4d, 0x9b, 0x2a, 0x81, 0x35, 0x98, 0xe5, 0x72, 0xa3, 0x22, 0x80, 0xb9,
+	0x2a, 0xd3, 0x0c, 0xef, 0x36, 0xcf, 0xe7, 0x60, 0x45, 0x80, 0xec, 0x92, 0x96, 0x1f, 0x27, 0xc7,
+	0x4c, 0x6b, 0x47, 0x71, 0x18, 0x36, 0x4e, 0x75, 0x94, 0xa7, 0x16, 0x64, 0xd3, 0x2c, 0x54, 0xa8,
+	0xaf, 0x2d, 0x40, 0xfe, 0xc5, 0x6e, 0x85, 0x8b, 0xed, 0xd7, 0x57, 0xa9, 0x39, 0xff, 0x32, 0x95,
+	0xfc, 0x06, 0xbc, 0x6f, 0x26, 0xb9, 0xd3, 0xc2, 0xde, 0x49, 0xc0, 0xbe, 0xe8, 0x1c, 0xfe, 0xef,
+	0x19, 0x58, 0xbf, 0xd6, 0x54, 0x25, 0xb6, 0x03, 0x6f, 0x44, 0x2c, 0xf2, 0x1a, 0xbd, 0x19, 0x2d,
+	0x19, 0x33, 0x12, 0xe9, 0x8c, 0x25, 0xe9, 0x94, 0xa7, 0x85, 0x93, 0x04, 0x45, 0x0f, 0x8d, 0xb5,
+	0xc9, 0x0c, 0x86, 0xd4, 0x9f, 0x29, 0xba, 0x0f, 0xb7, 0x62, 0xda, 0xc6, 0x3c, 0xc2, 0xc1, 0xe2,
+	0xe8, 0x60, 0x28, 0x1d, 0x07, 0xf4, 0x09, 0xdc, 0xc2, 0x4f, 0xfc, 0x46, 0x1c, 0xe0, 0x60, 0x71,
+	0x6c, 0x75, 0x74, 0x63, 0xba, 0xf8, 0x9e, 0x63, 0x68, 0x0d, 0xce, 0x03, 0x65, 0xb4, 0xe3, 0x35,
+	0x3c, 0xea, 0x63, 0x8d, 0xa3, 0x7d, 0xf3, 0x5f, 0xc1, 0xed, 0x4b, 0x26, 0x68, 0x11, 0x26, 0xbd,
+	0x20, 0x68, 0x61, 0x2e, 0x85, 0x36, 0x55, 0xd6, 0x9f, 0x68, 0x01, 0xc6, 0x1b, 0x5e, 0x15, 0x37,
+	0x44, 0xd2, 0x53, 0x65, 0xf9, 0x81, 0xb6, 0x61, 0xb2, 0x2a, 0x5d, 0x07, 0x4d, 0x43, 0xdb, 0x77,
+	0x6e, 0xc5, 0xbe, 0xa6, 0x5c, 0xf6, 0x22, 0xac, 0xcf, 0xb7, 0x0d, 0xb6, 0x69, 0x53, 0x9d, 0xe8,
+	0x23, 0x98, 0xed, 0x24, 0x5a, 0x69, 0x79, 0x11, 0x96, 0x64, 0x77, 0x0a, 0x49, 0x84, 0xbf, 0x5e,
+	0xe5, 0x96, 0x25, 0x07, 0x1e, 0x9c, 0x38, 0x84, 0xb9, 0x4d, 0x2f, 0xaa, 0x3b, 0x07, 0xb8, 0xe6,
+	0xf9, 0xa7, 0x7b, 0xd8, 0x7f, 0xf9, 0xeb, 0x16, 0x28, 0x8a, 0x7b, 0xd8, 0x2f, 0xcf, 0x90, 0xee,
+	0x08, 0xf9, 0x8f, 0x61, 0xa5, 0x37, 0xee, 0x91, 0x5f, 0xc7, 0x41, 0xdc, 0xd0, 0xc4, 0x50, 0x0e,
+	0xa6, 0x69, 0xdc, 0xac, 0xc8, 0x2e, 0xa5, 0x6f, 0x23, 0xd0, 0xb8, 0x29, 0xfb, 0x1a, 0xcf, 0x7f,
+	0x97, 0x81, 0x6c, 0x1a, 0xc4, 0xff, 0x28, 0xc8, 0x03, 0x98, 0xd4, 0x1c, 0x32, 0x42, 0x02, 0x1f,
+	0x18, 0x25, 0xd0, 0x47, 0x42, 0xd2, 0xd4, 0x67, 0xa1, 0x20, 0xd0, 0xb1, 0x51, 0xde, 0xa3, 0xa2,
+	0xa8, 0x9b, 0xaa, 0xa8, 0x6f, 0xf5, 0x17, 0x75, 0x9f, 0x46, 0x5d, 0xe5, 0xdc, 0xa7, 0x91, 0xe9,
+	0x52, 0xff, 0x36, 0x0a, 0xef, 0xa4, 0xd0, 0x48, 0xeb, 0xfe, 0x68, 0x05, 0x92, 0x92, 0xea, 0x96,
+	0x97, 0x11, 0x7b, 0x53, 0x34, 0x6e, 0xca, 0x76, 0x87, 0xfc, 0x94, 0xae, 0x3e, 0x7a, 0x53, 0x15,
+	0x18, 0xba, 0x36, 0x3a, 0x80, 0x69, 0xc9, 0x46, 0x44, 0x59, 0x1c, 0x1b, 0xbe, 0x18, 0x20, 0xfd,
+	0x13, 0x58, 0xf4, 0x08, 0xe6, 0xfc, 0xb8, 0x29, 0x2a, 0xd3, 0xc6, 0xba, 0xc0, 0xe3, 0xc3, 0x63,
+	0xbe, 0x79, 0x81, 0xa2, 0x94, 0x70, 0x0c, 0xb7, 0x3d, 0x4a, 0x63, 0xaf, 0x41, 0xbe, 0xc4, 0x81,
+	0xbc, 0x0d, 0x13, 0x37, 0xad, 0xc3, 0xec, 0x05, 0x92, 0xb8, 0x0e, 0x9d, 0xa7, 0xdc, 0x6b, 0x79,
+	0xcd, 0xce, 0x93, 0x75, 0x08, 0xf3, 0x3d, 0xab, 0x4a, 0xd6, 0xdb, 0x30, 0x11, 0x8a, 0x15, 0x25,
+	0xe8, 0x65, 0xa3, 0x22, 0xa5, 0x93, 0x12, 0xa0, 0x72, 0x28, 0x3e, 0x03, 0x18, 0x17, 0x90, 0xc9,
+	0x2b, 0x34, 0xa1, 0xc4, 0xb1, 0x6e, 0xf4, 0xef, 0x1f, 0x2d, 0xec, 0x8d, 0xeb, 0x0d, 0x25, 0xc5,
+	0xfc, 0xdd, 0x6f, 0xfe, 0xf8, 0xf7, 0x87, 0xcc, 0x0a, 0x5a, 0x76, 0x4d, 0x53, 0x91, 0x12, 0xdf,
+	0x2f, 0x16, 0xa0, 0xfe, 0x99, 0x02, 0x95, 0xd2, 0xa3, 0xa4, 0x0e, 0x29, 0xf6, 0x87, 0xc3, 0x39,
+	0x29, 0x9a, 0x05, 0x41, 0x73, 0x13, 0xdd, 0x33, 0xd2, 0x34, 0x49, 0x1f, 0xfd, 0x68, 0xc1, 0x4c,
+	0xcf, 0x08, 0x81, 0x9c, 0xf4, 0xd0, 0xa6, 0x41, 0xc4, 0x76, 0x07, 0xb6, 0x57, 0x2c, 0x37, 0x05,
+	0xcb, 0x35, 0x74, 0xd7, 0xc8, 0xb2, 0x77, 0x6c, 0x41, 0xcf, 0x2c, 0x98, 0xeb, 0x7b, 0xab, 0x51,
+	0x31, 0x3d, 0x66, 0xda, 0x28, 0x63, 0x97, 0x86, 0xf2, 0x51, 0x5c, 0x5d, 0xc1, 0xf5, 0x1e, 0x5a,
+	0x37, 0x72, 0xed, 0xef, 0x7d, 0xe8, 0xa5, 0x05, 0x76, 0xfa, 0x6c, 0x81, 0xee, 0x0f, 0x41, 0xe2,
+	0xf2, 0xf0, 0x62, 0x7f, 0x74, 0x33, 0x67, 0x95, 0xca, 0xb6, 0x48, 0xa5, 0x84, 0x0a, 0x03, 0xa6,
+	0xe2, 0x56, 0x3b, 0xac, 0x13, 0x91, 0xf4, 0xbc, 0xa8, 0x57, 0x89, 0xc4, 0xf4, 0x2e, 0xdb, 0xee,
+	0xc0, 0xf6, 0x03, 0x89, 0xa4, 0xf7, 0x15, 0x47, 0x4f, 0x2d, 0x98, 0xeb, 0x7b, 0x2a, 0xae, 0x12,
+	0x49, 0xda, 0x33, 0x6d, 0x97, 0x86, 0xf2, 0x51, 0x5c, 0xd7, 0x04, 0xd7, 0x1c, 0x5a, 0x31, 0x0b,
+	0x5a, 0xf3, 0x11, 0x2d, 0x4a, 0xf4, 0xad, 0x2b, 0x5b, 0x54, 0x77, 0xcb, 0xb4, 0x37, 0xae, 0x37,
+	0x1c, 0xac, 0x45, 0xc9, 0xee, 0x79, 0xf0, 0xfc, 0x2c, 0x6b, 0xbd, 0x38, 0xcb, 0x5a, 0xff, 0x9c,
+	0x65, 0xad, 0xef, 0xcf, 0xb3, 0x23, 0x2f, 0xce, 0xb3, 0x23, 0x7f, 0x9e, 0x67, 0x47, 0x8e, 0x8b,
+	0x5d, 0x23, 0xf8, 0x43, 0x01, 0xb0, 0x5b, 0xf7, 0x08, 0xd5, 0x60, 0xed, 0xa2, 0xfb, 0xa4, 0x0b,
+	0x51, 0x8c, 0xe4, 0xd5, 0x09, 0xf1, 0x7f, 0x5b, 0xe9, 0xbf, 0x01, 0x00, 0x64, 0xa3, 0xdf, 0xfe,
+	0x81, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -620,8 +1010,14 @@ type QueryClient interface {
 	// CirculatingSupply retrieves the total number of tokens that are in
 	// circulation (i.e. excluding unvested tokens).
 	CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error)
+	// CirculatingSupplyBreakdown retrieves the total supply together with the
+	// amounts subtracted from it to compute the circulating supply.
+	CirculatingSupplyBreakdown(ctx context.Context, in *QueryCirculatingSupplyBreakdownRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyBreakdownResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
+	// InflationSchedule projects the inflation schedule for the next periods
+	// from the current state.
+	InflationSchedule(ctx context.Context, in *QueryInflationScheduleRequest, opts ...grpc.CallOption) (*QueryInflationScheduleResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -670,6 +1066,15 @@ func (c *queryClient) CirculatingSupply(ctx context.Context, in *QueryCirculatin
 	return out, nil
 }
 
+func (c *queryClient) CirculatingSupplyBreakdown(ctx context.Context, in *QueryCirculatingSupplyBreakdownRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyBreakdownResponse, error) {
+	out := new(QueryCirculatingSupplyBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.inflation.v1.Query/CirculatingSupplyBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error) {
 	out := new(QueryInflationRateResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.inflation.v1.Query/InflationRate", in, out, opts...)
@@ -679,6 +1084,15 @@ func (c *queryClient) InflationRate(ctx context.Context, in *QueryInflationRateR
 	return out, nil
 }
 
+func (c *queryClient) InflationSchedule(ctx context.Context, in *QueryInflationScheduleRequest, opts ...grpc.CallOption) (*QueryInflationScheduleResponse, error) {
+	out := new(QueryInflationScheduleResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.inflation.v1.Query/InflationSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.inflation.v1.Query/Params", in, out, opts...)
@@ -699,8 +1113,14 @@ type QueryServer interface {
 	// CirculatingSupply retrieves the total number of tokens that are in
 	// circulation (i.e. excluding unvested tokens).
 	CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error)
+	// CirculatingSupplyBreakdown retrieves the total supply together with the
+	// amounts subtracted from it to compute the circulating supply.
+	CirculatingSupplyBreakdown(context.Context, *QueryCirculatingSupplyBreakdownRequest) (*QueryCirculatingSupplyBreakdownResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
+	// InflationSchedule projects the inflation schedule for the next periods
+	// from the current state.
+	InflationSchedule(context.Context, *QueryInflationScheduleRequest) (*QueryInflationScheduleResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -721,9 +1141,15 @@ func (*UnimplementedQueryServer) SkippedEpochs(ctx context.Context, req *QuerySk
 func (*UnimplementedQueryServer) CirculatingSupply(ctx context.Context, req *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CirculatingSupply not implemented")
 }
+func (*UnimplementedQueryServer) CirculatingSupplyBreakdown(ctx context.Context, req *QueryCirculatingSupplyBreakdownRequest) (*QueryCirculatingSupplyBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CirculatingSupplyBreakdown not implemented")
+}
 func (*UnimplementedQueryServer) InflationRate(ctx context.Context, req *QueryInflationRateRequest) (*QueryInflationRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InflationRate not implemented")
 }
+func (*UnimplementedQueryServer) InflationSchedule(ctx context.Context, req *QueryInflationScheduleRequest) (*QueryInflationScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationSchedule not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -804,6 +1230,24 @@ func _Query_CirculatingSupply_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CirculatingSupplyBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCirculatingSupplyBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CirculatingSupplyBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.inflation.v1.Query/CirculatingSupplyBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CirculatingSupplyBreakdown(ctx, req.(*QueryCirculatingSupplyBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_InflationRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryInflationRateRequest)
 	if err := dec(in); err != nil {
@@ -822,6 +1266,24 @@ func _Query_InflationRate_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InflationSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.inflation.v1.Query/InflationSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationSchedule(ctx, req.(*QueryInflationScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -860,10 +1322,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CirculatingSupply",
 			Handler:    _Query_CirculatingSupply_Handler,
 		},
+		{
+			MethodName: "CirculatingSupplyBreakdown",
+			Handler:    _Query_CirculatingSupplyBreakdown_Handler,
+		},
 		{
 			MethodName: "InflationRate",
 			Handler:    _Query_InflationRate_Handler,
 		},
+		{
+			MethodName: "InflationSchedule",
+			Handler:    _Query_InflationSchedule_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1087,7 +1557,7 @@ func (m *QueryCirculatingSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryInflationRateRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCirculatingSupplyBreakdownRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1097,12 +1567,12 @@ func (m *QueryInflationRateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryInflationRateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCirculatingSupplyBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInflationRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCirculatingSupplyBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1110,7 +1580,7 @@ func (m *QueryInflationRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryInflationRateResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCirculatingSupplyBreakdownResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1120,26 +1590,321 @@ func (m *QueryInflationRateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryInflationRateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCirculatingSupplyBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInflationRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCirculatingSupplyBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Excluded) > 0 {
+		for iNdEx := len(m.Excluded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Excluded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
-		size := m.InflationRate.Size()
+		size, err := m.Unvested.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
-		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CirculatingSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	{
+		size, err := m.TotalSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExcludedBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExcludedBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExcludedBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumPeriods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPeriods))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CirculatingSupply.Size()
+		i -= size
+		if _, err := m.CirculatingSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.TotalSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationSchedulePeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationSchedulePeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationSchedulePeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualizedRate.Size()
+		i -= size
+		if _, err := m.AnnualizedRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CumulativeSupply.Size()
+		i -= size
+		if _, err := m.CumulativeSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PeriodMint.Size()
+		i -= size
+		if _, err := m.PeriodMint.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.EpochMintProvision.Size()
+		i -= size
+		if _, err := m.EpochMintProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.NumEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1292,6 +2057,55 @@ func (m *QueryCirculatingSupplyResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCirculatingSupplyBreakdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCirculatingSupplyBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CirculatingSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Unvested.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Excluded) > 0 {
+		for _, e := range m.Excluded {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExcludedBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryInflationRateRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1312,22 +2126,76 @@ func (m *QueryInflationRateResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryInflationScheduleRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.NumPeriods != 0 {
+		n += 1 + sovQuery(uint64(m.NumPeriods))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryInflationScheduleResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.CirculatingSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *InflationSchedulePeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	if m.NumEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.NumEpochs))
+	}
+	l = m.EpochMintProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PeriodMint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CumulativeSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualizedRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
@@ -1338,7 +2206,511 @@ func sovQuery(x uint64) (n int) {
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryPeriodRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPeriodRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPeriodResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochMintProvisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySkippedEpochsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySkippedEpochsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySkippedEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySkippedEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySkippedEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySkippedEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedEpochs", wireType)
+			}
+			m.SkippedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCirculatingSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCirculatingSupplyBreakdownRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1361,10 +2733,10 @@ func (m *QueryPeriodRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPeriodRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCirculatingSupplyBreakdownRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCirculatingSupplyBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1388,7 +2760,7 @@ func (m *QueryPeriodRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPeriodResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCirculatingSupplyBreakdownResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1411,17 +2783,17 @@ func (m *QueryPeriodResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPeriodResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCirculatingSupplyBreakdownResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCirculatingSupplyBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
 			}
-			m.Period = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1431,61 +2803,125 @@ func (m *QueryPeriodResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEpochMintProvisionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Unvested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochMintProvisionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochMintProvisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Excluded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Excluded = append(m.Excluded, ExcludedBalance{})
+			if err := m.Excluded[len(m.Excluded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1507,7 +2943,7 @@ func (m *QueryEpochMintProvisionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
+func (m *ExcludedBalance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1530,15 +2966,79 @@ func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochMintProvisionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExcludedBalance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochMintProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExcludedBalance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1565,7 +3065,7 @@ func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1590,7 +3090,7 @@ func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySkippedEpochsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1613,10 +3113,10 @@ func (m *QuerySkippedEpochsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySkippedEpochsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationRateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySkippedEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1640,7 +3140,7 @@ func (m *QuerySkippedEpochsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySkippedEpochsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1663,17 +3163,17 @@ func (m *QuerySkippedEpochsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySkippedEpochsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySkippedEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkippedEpochs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
 			}
-			m.SkippedEpochs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1683,11 +3183,26 @@ func (m *QuerySkippedEpochsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SkippedEpochs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1709,7 +3224,7 @@ func (m *QuerySkippedEpochsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCirculatingSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1732,12 +3247,31 @@ func (m *QueryCirculatingSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPeriods", wireType)
+			}
+			m.NumPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1759,7 +3293,7 @@ func (m *QueryCirculatingSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1782,15 +3316,48 @@ func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1817,6 +3384,41 @@ func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			m.Periods = append(m.Periods, InflationSchedulePeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
 			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1842,7 +3444,7 @@ func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
+func (m *InflationSchedulePeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1865,65 +3467,155 @@ func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InflationSchedulePeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InflationSchedulePeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+			}
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodMint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.PeriodMint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CumulativeSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualizedRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1951,7 +3643,7 @@ func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AnnualizedRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -72,13 +72,18 @@ func (m *MsgToggleInflation) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgToggleInflation proto.InternalMessageInfo
 
 type MsgEditInflationParams struct {
-	Sender                string                        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	InflationEnabled      bool                          `protobuf:"varint,2,opt,name=inflation_enabled,json=inflationEnabled,proto3" json:"inflation_enabled,omitempty"`
-	PolynomialFactors     []cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,rep,name=polynomial_factors,json=polynomialFactors,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"polynomial_factors,omitempty"`
-	InflationDistribution *InflationDistribution        `protobuf:"bytes,4,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution,omitempty"`
-	EpochsPerPeriod       *cosmossdk_io_math.Int        `protobuf:"bytes,5,opt,name=epochs_per_period,json=epochsPerPeriod,proto3,customtype=cosmossdk.io/math.Int" json:"epochs_per_period,omitempty"`
-	PeriodsPerYear        *cosmossdk_io_math.Int        `protobuf:"bytes,6,opt,name=periods_per_year,json=periodsPerYear,proto3,customtype=cosmossdk.io/math.Int" json:"periods_per_year,omitempty"`
-	MaxPeriod             *cosmossdk_io_math.Int        `protobuf:"bytes,7,opt,name=max_period,json=maxPeriod,proto3,customtype=cosmossdk.io/math.Int" json:"max_period,omitempty"`
+	Sender            string                        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	InflationEnabled  bool                          `protobuf:"varint,2,opt,name=inflation_enabled,json=inflationEnabled,proto3" json:"inflation_enabled,omitempty"`
+	PolynomialFactors []cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,rep,name=polynomial_factors,json=polynomialFactors,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"polynomial_factors,omitempty"`
+	// Deprecated: use inflation_recipients. When set, the distribution is
+	// converted to the equivalent recipients.
+	InflationDistribution       *InflationDistribution       `protobuf:"bytes,4,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution,omitempty"` // Deprecated: Do not use.
+	EpochsPerPeriod             *cosmossdk_io_math.Int       `protobuf:"bytes,5,opt,name=epochs_per_period,json=epochsPerPeriod,proto3,customtype=cosmossdk.io/math.Int" json:"epochs_per_period,omitempty"`
+	PeriodsPerYear              *cosmossdk_io_math.Int       `protobuf:"bytes,6,opt,name=periods_per_year,json=periodsPerYear,proto3,customtype=cosmossdk.io/math.Int" json:"periods_per_year,omitempty"`
+	MaxPeriod                   *cosmossdk_io_math.Int       `protobuf:"bytes,7,opt,name=max_period,json=maxPeriod,proto3,customtype=cosmossdk.io/math.Int" json:"max_period,omitempty"`
+	CirculatingSupplyExclusions *CirculatingSupplyExclusions `protobuf:"bytes,8,opt,name=circulating_supply_exclusions,json=circulatingSupplyExclusions,proto3" json:"circulating_supply_exclusions,omitempty"`
+	InflationRecipients         []InflationRecipient         `protobuf:"bytes,9,rep,name=inflation_recipients,json=inflationRecipients,proto3" json:"inflation_recipients"`
+	BondedRatioInflation        *BondedRatioInflation        `protobuf:"bytes,10,opt,name=bonded_ratio_inflation,json=bondedRatioInflation,proto3" json:"bonded_ratio_inflation,omitempty"`
 }
 
 func (m *MsgEditInflationParams) Reset()         { *m = MsgEditInflationParams{} }
@@ -287,51 +292,58 @@ func init() {
 func init() { proto.RegisterFile("nibiru/inflation/v1/tx.proto", fileDescriptor_9f6843f876608d76) }
 
 var fileDescriptor_9f6843f876608d76 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x8d, 0x21, 0x2f, 0xc0, 0xa0, 0xf7, 0x20, 0xe6, 0x81, 0x42, 0xe0, 0x39, 0x91, 0x59, 0x10,
-	0x1e, 0xc2, 0xa3, 0x84, 0x1d, 0x4b, 0x03, 0x95, 0xa8, 0x48, 0x15, 0x59, 0xad, 0xaa, 0x76, 0x93,
-	0x4e, 0xec, 0xc1, 0x19, 0xd5, 0x9e, 0xb1, 0x3c, 0x93, 0x28, 0xd9, 0x76, 0xd5, 0x25, 0x52, 0x7f,
-	0x80, 0x65, 0x57, 0x5d, 0xf5, 0x0f, 0xba, 0x61, 0x89, 0xda, 0x4d, 0xd5, 0x45, 0x54, 0x41, 0x17,
-	0x5d, 0xf3, 0x05, 0x95, 0x3d, 0x8e, 0x89, 0x1a, 0x23, 0x95, 0x45, 0xa4, 0xdc, 0x39, 0xf7, 0x9e,
-	0x7b, 0xee, 0xf8, 0xdc, 0x01, 0x9b, 0x94, 0x74, 0x48, 0xd8, 0x83, 0x84, 0x9e, 0x79, 0x48, 0x10,
-	0x46, 0x61, 0xbf, 0x0e, 0xc5, 0xc0, 0x08, 0x42, 0x26, 0x98, 0xba, 0x22, 0x51, 0x23, 0x45, 0x8d,
-	0x7e, 0xbd, 0xfc, 0xaf, 0xcb, 0x5c, 0x16, 0xe3, 0x30, 0xfa, 0x27, 0x53, 0xcb, 0x9b, 0x2e, 0x63,
-	0xae, 0x87, 0x21, 0x0a, 0x08, 0x44, 0x94, 0x32, 0x11, 0xe7, 0xf3, 0x04, 0xdd, 0xca, 0x6a, 0x73,
-	0xc7, 0x2a, 0x93, 0x34, 0x9b, 0x71, 0x9f, 0x71, 0xd8, 0x41, 0x1c, 0xc3, 0x7e, 0xbd, 0x83, 0x05,
-	0xaa, 0x43, 0x9b, 0x91, 0x31, 0xbe, 0x2e, 0xf1, 0xb6, 0xec, 0x2d, 0x03, 0x09, 0xe9, 0x08, 0xa8,
-	0x4d, 0xee, 0x3e, 0x65, 0xae, 0xeb, 0xe1, 0x93, 0x31, 0xad, 0xba, 0x06, 0x0a, 0x1c, 0x53, 0x07,
-	0x87, 0x25, 0xa5, 0xaa, 0xd4, 0x16, 0xac, 0x24, 0x52, 0x77, 0x40, 0x01, 0x53, 0xd4, 0xf1, 0x70,
-	0x69, 0xa6, 0xaa, 0xd4, 0xe6, 0xcd, 0xe2, 0xed, 0xa8, 0xf2, 0xf7, 0x10, 0xf9, 0xde, 0x81, 0x2e,
-	0xcf, 0x75, 0x2b, 0x49, 0x38, 0x98, 0x7f, 0x7b, 0x51, 0xc9, 0xfd, 0xbc, 0xa8, 0xe4, 0xf4, 0x0f,
-	0x79, 0xb0, 0xd6, 0xe4, 0xee, 0xb1, 0x43, 0x44, 0xda, 0xa1, 0x85, 0x42, 0xe4, 0xf3, 0x7b, 0xfb,
-	0xec, 0x82, 0x62, 0x3a, 0x63, 0x5b, 0x12, 0x3a, 0xb2, 0xa5, 0xb5, 0x9c, 0x02, 0xc7, 0xf2, 0x5c,
-	0x7d, 0x05, 0xd4, 0x80, 0x79, 0x43, 0xca, 0x7c, 0x82, 0xbc, 0xf6, 0x19, 0xb2, 0x05, 0x0b, 0x79,
-	0x69, 0xb6, 0x3a, 0x5b, 0x5b, 0x30, 0xeb, 0x97, 0xa3, 0x8a, 0xf2, 0x6d, 0x54, 0xd9, 0x90, 0x43,
-	0x73, 0xe7, 0xb5, 0x41, 0x18, 0xf4, 0x91, 0xe8, 0x1a, 0xa7, 0xd8, 0x45, 0xf6, 0xf0, 0x08, 0xdb,
-	0x9f, 0x3f, 0xee, 0x81, 0xe4, 0x4e, 0x8e, 0xb0, 0x6d, 0x15, 0xef, 0xc8, 0x1e, 0x49, 0x2e, 0xd5,
-	0x05, 0x6b, 0x77, 0x72, 0x1c, 0xc2, 0x45, 0x48, 0x3a, 0xbd, 0x28, 0x28, 0xe5, 0xab, 0x4a, 0x6d,
-	0xb1, 0xf1, 0xbf, 0x91, 0xf1, 0xb9, 0x8d, 0x74, 0xd8, 0xa3, 0x89, 0x0a, 0x33, 0x1f, 0x29, 0xb2,
-	0x56, 0x49, 0x16, 0xa8, 0x3e, 0x07, 0x45, 0x1c, 0x30, 0xbb, 0xcb, 0xdb, 0x01, 0x0e, 0xa3, 0x1f,
-	0x61, 0x4e, 0xe9, 0xaf, 0xe8, 0x6a, 0xcc, 0xdd, 0x64, 0x92, 0xd5, 0xe9, 0x49, 0x4e, 0xa8, 0x98,
-	0x98, 0xe1, 0x84, 0x0a, 0x6b, 0x49, 0xb2, 0xb4, 0x70, 0xd8, 0x8a, 0x39, 0xd4, 0x67, 0x60, 0x59,
-	0xb2, 0x49, 0xe6, 0x21, 0x46, 0x61, 0xa9, 0xf0, 0x70, 0xde, 0x7f, 0x12, 0x92, 0x16, 0x0e, 0x5f,
-	0x60, 0x14, 0xaa, 0x8f, 0x01, 0xf0, 0xd1, 0x60, 0x2c, 0x74, 0xee, 0xe1, 0x84, 0x0b, 0x3e, 0x1a,
-	0x48, 0x89, 0x13, 0x86, 0xd9, 0x04, 0xe5, 0x69, 0x4f, 0x5a, 0x98, 0x07, 0x8c, 0x72, 0xac, 0x57,
-	0x81, 0x96, 0xed, 0xa6, 0x34, 0x63, 0x00, 0xe6, 0x9a, 0xdc, 0x35, 0x7b, 0x21, 0x8d, 0x0c, 0x3b,
-	0x69, 0xb0, 0x49, 0xc3, 0xca, 0x73, 0x3d, 0xf5, 0x9c, 0x09, 0xf2, 0xd1, 0xca, 0xc4, 0x36, 0x5b,
-	0x6c, 0xac, 0x1b, 0x89, 0xca, 0x68, 0xa7, 0x8c, 0x64, 0xa7, 0x8c, 0x43, 0x46, 0xa8, 0xb9, 0x72,
-	0x39, 0xaa, 0xe4, 0x6e, 0x47, 0x95, 0x45, 0xc9, 0x13, 0x15, 0xe9, 0x56, 0x5c, 0xab, 0x17, 0xc1,
-	0x52, 0xd2, 0x79, 0x2c, 0xa6, 0xf1, 0x69, 0x06, 0xcc, 0x36, 0xb9, 0xab, 0x9e, 0x2b, 0x60, 0xe9,
-	0xf7, 0x35, 0xdb, 0xce, 0xf4, 0xcd, 0xf4, 0xec, 0x65, 0xf8, 0x87, 0x89, 0xe9, 0x15, 0x6c, 0xbd,
-	0xf9, 0xf2, 0xe3, 0xdd, 0xcc, 0x7f, 0xfa, 0x06, 0xcc, 0x7c, 0xa6, 0xe2, 0x2a, 0xf5, 0xbd, 0x02,
-	0x56, 0xb2, 0xb6, 0x72, 0xf7, 0xbe, 0x6e, 0x19, 0xc9, 0xe5, 0xfd, 0x07, 0x24, 0xa7, 0xf2, 0x60,
-	0x2c, 0x6f, 0x47, 0xdf, 0x9e, 0x96, 0x87, 0x1d, 0x22, 0xf6, 0xd2, 0x70, 0x2f, 0x88, 0x0b, 0xcd,
-	0xd3, 0xcb, 0x6b, 0x4d, 0xb9, 0xba, 0xd6, 0x94, 0xef, 0xd7, 0x9a, 0x72, 0x7e, 0xa3, 0xe5, 0xae,
-	0x6e, 0xb4, 0xdc, 0xd7, 0x1b, 0x2d, 0xf7, 0xb2, 0xe1, 0x12, 0xd1, 0xed, 0x75, 0x0c, 0x9b, 0xf9,
-	0xf0, 0x49, 0x4c, 0x76, 0xd8, 0x45, 0x84, 0x8e, 0x89, 0xfb, 0x0d, 0x38, 0x98, 0x60, 0x17, 0xc3,
-	0x00, 0xf3, 0x4e, 0x21, 0x7e, 0xfb, 0xf6, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x8c, 0xc0,
-	0x00, 0xc4, 0x05, 0x00, 0x00,
+	// 802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xb1, 0x4f, 0xfb, 0x46,
+	0x14, 0x8e, 0x09, 0x0d, 0xe4, 0xa2, 0x16, 0xe2, 0x40, 0x64, 0x02, 0x38, 0x91, 0x19, 0x08, 0x45,
+	0xd8, 0x4d, 0xd8, 0x18, 0x0d, 0x54, 0xa2, 0x22, 0x55, 0xe4, 0xb6, 0xaa, 0xda, 0xc5, 0x9c, 0xed,
+	0xc3, 0x39, 0xd5, 0xbe, 0xb3, 0x7c, 0x4e, 0x94, 0x74, 0xec, 0xd4, 0x11, 0xa9, 0xff, 0x00, 0x63,
+	0xff, 0x80, 0xfe, 0x07, 0x5d, 0x18, 0x51, 0xbb, 0x54, 0x1d, 0xa2, 0x0a, 0x3a, 0x74, 0x46, 0xea,
+	0x5e, 0xd9, 0xe7, 0x38, 0x51, 0x63, 0x7e, 0xfa, 0x31, 0x44, 0xca, 0xdd, 0xf7, 0xbd, 0xef, 0x7b,
+	0xef, 0x9d, 0xde, 0x33, 0xd8, 0x23, 0xd8, 0xc2, 0xe1, 0x50, 0xc3, 0xe4, 0xd6, 0x83, 0x11, 0xa6,
+	0x44, 0x1b, 0x75, 0xb4, 0x68, 0xac, 0x06, 0x21, 0x8d, 0xa8, 0x58, 0xe3, 0xa8, 0x9a, 0xa1, 0xea,
+	0xa8, 0xd3, 0xd8, 0x72, 0xa9, 0x4b, 0x13, 0x5c, 0x8b, 0xff, 0x71, 0x6a, 0x63, 0xcf, 0xa5, 0xd4,
+	0xf5, 0x90, 0x06, 0x03, 0xac, 0x41, 0x42, 0x68, 0x94, 0xf0, 0x59, 0x8a, 0x1e, 0xe4, 0xd9, 0xcc,
+	0x55, 0x39, 0x49, 0xb6, 0x29, 0xf3, 0x29, 0xd3, 0x2c, 0xc8, 0x90, 0x36, 0xea, 0x58, 0x28, 0x82,
+	0x1d, 0xcd, 0xa6, 0x78, 0x86, 0xef, 0x70, 0xdc, 0xe4, 0xde, 0xfc, 0xc0, 0x21, 0x05, 0x02, 0xb1,
+	0xc7, 0xdc, 0x2f, 0xa9, 0xeb, 0x7a, 0xe8, 0x6a, 0x26, 0x2b, 0xd6, 0x41, 0x89, 0x21, 0xe2, 0xa0,
+	0x50, 0x12, 0x5a, 0x42, 0xbb, 0x6c, 0xa4, 0x27, 0xf1, 0x08, 0x94, 0x10, 0x81, 0x96, 0x87, 0xa4,
+	0x95, 0x96, 0xd0, 0x5e, 0xd7, 0xab, 0x2f, 0xd3, 0xe6, 0x87, 0x13, 0xe8, 0x7b, 0x67, 0x0a, 0xbf,
+	0x57, 0x8c, 0x94, 0x70, 0xb6, 0xfe, 0xe3, 0x7d, 0xb3, 0xf0, 0xcf, 0x7d, 0xb3, 0xa0, 0xfc, 0x5b,
+	0x02, 0xf5, 0x1e, 0x73, 0x2f, 0x1d, 0x1c, 0x65, 0x0e, 0x7d, 0x18, 0x42, 0x9f, 0xbd, 0xea, 0x73,
+	0x0c, 0xaa, 0x59, 0x8d, 0x26, 0x17, 0x74, 0xb8, 0xa5, 0xb1, 0x99, 0x01, 0x97, 0xfc, 0x5e, 0xbc,
+	0x01, 0x62, 0x40, 0xbd, 0x09, 0xa1, 0x3e, 0x86, 0x9e, 0x79, 0x0b, 0xed, 0x88, 0x86, 0x4c, 0x2a,
+	0xb6, 0x8a, 0xed, 0xb2, 0xde, 0x79, 0x98, 0x36, 0x85, 0x3f, 0xa7, 0xcd, 0x5d, 0x5e, 0x34, 0x73,
+	0xbe, 0x53, 0x31, 0xd5, 0x7c, 0x18, 0x0d, 0xd4, 0x6b, 0xe4, 0x42, 0x7b, 0x72, 0x81, 0xec, 0xdf,
+	0x7e, 0x39, 0x01, 0x69, 0x4f, 0x2e, 0x90, 0x6d, 0x54, 0xe7, 0x62, 0x9f, 0x72, 0x2d, 0x11, 0x83,
+	0xfa, 0x3c, 0x1d, 0x07, 0xb3, 0x28, 0xc4, 0xd6, 0x30, 0x3e, 0x48, 0xab, 0x2d, 0xa1, 0x5d, 0xe9,
+	0x7e, 0xac, 0xe6, 0x3c, 0xb7, 0x9a, 0x15, 0x7b, 0xb1, 0x10, 0xa1, 0x97, 0xe2, 0x8c, 0x24, 0xc1,
+	0xd8, 0xc6, 0x79, 0xb0, 0xf8, 0x35, 0xa8, 0xa2, 0x80, 0xda, 0x03, 0x66, 0x06, 0x28, 0x8c, 0x7f,
+	0x98, 0x3a, 0xd2, 0x07, 0x71, 0x73, 0xf4, 0xe3, 0xb4, 0x96, 0xed, 0xe5, 0x5a, 0xae, 0x48, 0xb4,
+	0x50, 0xc5, 0x15, 0x89, 0x8c, 0x0d, 0xae, 0xd2, 0x47, 0x61, 0x3f, 0xd1, 0x10, 0xbf, 0x02, 0x9b,
+	0x5c, 0x8d, 0x2b, 0x4f, 0x10, 0x0c, 0xa5, 0xd2, 0xdb, 0x75, 0x3f, 0x4a, 0x45, 0xfa, 0x28, 0xfc,
+	0x06, 0xc1, 0x50, 0xfc, 0x0c, 0x00, 0x1f, 0x8e, 0x67, 0x89, 0xae, 0xbd, 0x5d, 0xb0, 0xec, 0xc3,
+	0x71, 0x9a, 0xe2, 0xf7, 0x60, 0xdf, 0xc6, 0xa1, 0x3d, 0x8c, 0xdb, 0x42, 0x5c, 0x93, 0x0d, 0x83,
+	0xc0, 0x9b, 0x98, 0x68, 0x6c, 0x7b, 0x43, 0x16, 0x8f, 0x84, 0xb4, 0x9e, 0x74, 0xfb, 0x93, 0xdc,
+	0x6e, 0x9f, 0xcf, 0x23, 0xbf, 0x48, 0x02, 0x2f, 0xb3, 0x38, 0x7d, 0x35, 0x4e, 0xc8, 0xd8, 0xb5,
+	0x5f, 0xa7, 0x88, 0x37, 0x60, 0x6b, 0xfe, 0xc4, 0x21, 0xb2, 0x71, 0x80, 0x11, 0x89, 0x98, 0x54,
+	0x6e, 0x15, 0xdb, 0x95, 0xee, 0xe1, 0xbb, 0x1f, 0xd8, 0x98, 0xf1, 0x13, 0xa7, 0x82, 0x51, 0xc3,
+	0x4b, 0x08, 0x13, 0x11, 0xa8, 0x5b, 0x94, 0x38, 0xc8, 0x31, 0xc3, 0x18, 0x32, 0x33, 0x8e, 0x04,
+	0x92, 0xb2, 0x8e, 0x72, 0x3d, 0xf4, 0x24, 0xc4, 0x88, 0xcf, 0x99, 0x5d, 0x5a, 0xcf, 0x96, 0x95,
+	0x83, 0x2d, 0xcc, 0xdd, 0x1e, 0x68, 0x2c, 0x8f, 0xb6, 0x81, 0x58, 0x40, 0x09, 0x43, 0x4a, 0x0b,
+	0xc8, 0xf9, 0x43, 0x99, 0x31, 0xc6, 0x60, 0xad, 0xc7, 0x5c, 0x7d, 0x18, 0x92, 0x78, 0xee, 0x17,
+	0xe7, 0x74, 0x71, 0xee, 0xf9, 0xbd, 0x92, 0x8d, 0xae, 0x0e, 0x56, 0xe3, 0xcd, 0x93, 0x4c, 0x6b,
+	0xa5, 0xbb, 0xa3, 0xa6, 0x4f, 0x1d, 0xaf, 0x26, 0x35, 0x5d, 0x4d, 0xea, 0x39, 0xc5, 0x44, 0xaf,
+	0xc5, 0xad, 0x7a, 0x99, 0x36, 0x2b, 0x5c, 0x27, 0x0e, 0x52, 0x8c, 0x24, 0x56, 0xa9, 0x82, 0x8d,
+	0xd4, 0x79, 0x96, 0x4c, 0xf7, 0xd7, 0x15, 0x50, 0xec, 0x31, 0x57, 0xbc, 0x13, 0xc0, 0xc6, 0xff,
+	0xb7, 0x55, 0xfe, 0xeb, 0x2c, 0xd7, 0xde, 0xd0, 0xde, 0x93, 0x98, 0xb5, 0xe0, 0xe0, 0x87, 0xdf,
+	0xff, 0xfe, 0x69, 0x65, 0x5f, 0xd9, 0xd5, 0x72, 0xb7, 0x7d, 0x12, 0x25, 0xfe, 0x2c, 0x80, 0x5a,
+	0xde, 0x72, 0x3b, 0x7e, 0xcd, 0x2d, 0x87, 0xdc, 0x38, 0x7d, 0x03, 0x39, 0x4b, 0x4f, 0x4b, 0xd2,
+	0x3b, 0x52, 0x0e, 0x97, 0xd3, 0x43, 0x0e, 0x8e, 0x4e, 0xb2, 0xe3, 0x49, 0x90, 0x04, 0xea, 0xd7,
+	0x0f, 0x4f, 0xb2, 0xf0, 0xf8, 0x24, 0x0b, 0x7f, 0x3d, 0xc9, 0xc2, 0xdd, 0xb3, 0x5c, 0x78, 0x7c,
+	0x96, 0x0b, 0x7f, 0x3c, 0xcb, 0x85, 0x6f, 0xbb, 0x2e, 0x8e, 0x06, 0x43, 0x4b, 0xb5, 0xa9, 0xaf,
+	0x7d, 0x9e, 0x88, 0x9d, 0x0f, 0x20, 0x26, 0x33, 0xe1, 0x51, 0x57, 0x1b, 0x2f, 0xa8, 0x47, 0x93,
+	0x00, 0x31, 0xab, 0x94, 0x7c, 0x42, 0x4e, 0xff, 0x1b, 0x00, 0xd7, 0x54, 0x00, 0xbb, 0x0b, 0x07,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -514,6 +526,44 @@ func (m *MsgEditInflationParams) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.BondedRatioInflation != nil {
+		{
+			size, err := m.BondedRatioInflation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.InflationRecipients) > 0 {
+		for iNdEx := len(m.InflationRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InflationRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.CirculatingSupplyExclusions != nil {
+		{
+			size, err := m.CirculatingSupplyExclusions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.MaxPeriod != nil {
 		{
 			size := m.MaxPeriod.Size()
@@ -767,6 +817,20 @@ func (m *MsgEditInflationParams) Size() (n int) {
 		l = m.MaxPeriod.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.CirculatingSupplyExclusions != nil {
+		l = m.CirculatingSupplyExclusions.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.InflationRecipients) > 0 {
+		for _, e := range m.InflationRecipients {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.BondedRatioInflation != nil {
+		l = m.BondedRatioInflation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1181,6 +1245,112 @@ func (m *MsgEditInflationParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupplyExclusions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CirculatingSupplyExclusions == nil {
+				m.CirculatingSupplyExclusions = &CirculatingSupplyExclusions{}
+			}
+			if err := m.CirculatingSupplyExclusions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationRecipients = append(m.InflationRecipients, InflationRecipient{})
+			if err := m.InflationRecipients[len(m.InflationRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatioInflation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BondedRatioInflation == nil {
+				m.BondedRatioInflation = &BondedRatioInflation{}
+			}
+			if err := m.BondedRatioInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -26,5 +26,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// reset miss counters of all validators at the last block of slash window
 	if types.IsPeriodLastBlock(ctx, params.SlashWindow) {
 		k.SlashAndResetMissCounters(ctx)
+		k.PruneVoteAccuracies(ctx)
 	}
+
+	// Delete price snapshots that are older than the snapshot retention
+	k.PrunePriceSnapshots(ctx, params, keeper.MaxPriceSnapshotsPrunedPerBlock)
 }
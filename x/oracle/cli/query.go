@@ -33,6 +33,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryAggregatePrevote(),
 		GetCmdQueryAggregateVote(),
 		GetCmdQueryVoteTargets(),
+		GetCmdQueryPriceHistory(),
+		GetCmdQueryDerivedPairs(),
+		GetCmdQueryPerformance(),
+		GetCmdQueryVoteAccuracies(),
 	)
 
 	return oracleQueryCmd
@@ -91,6 +95,52 @@ $ nibid query oracle exchange-rates nibi:usd
 	return cmd
 }
 
+// GetCmdQueryPriceHistory implements the query price history command.
+func GetCmdQueryPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [pair]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the retained price snapshots of a pair",
+		Long: strings.TrimSpace(`
+Query the price snapshots of a pair that are retained in the chain state, from
+the oldest to the most recent. Use --reverse to get the most recent first.
+
+$ nibid query oracle price-history ubtc:uusd --limit 10 --reverse
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			assetPair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PriceHistory(
+				context.Background(),
+				&types.QueryPriceHistoryRequest{Pair: assetPair, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "price-history")
+	return cmd
+}
+
 // GetCmdQueryActives implements the query actives command.
 func GetCmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -122,6 +172,38 @@ $ nibid query oracle actives
 	return cmd
 }
 
+// GetCmdQueryDerivedPairs implements the query derived pairs command.
+func GetCmdQueryDerivedPairs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "derived-pairs",
+		Args:  cobra.NoArgs,
+		Short: "Query the pairs whose exchange rates are derived from voted pairs",
+		Long: strings.TrimSpace(`
+Query the pairs whose exchange rates are computed from the exchange rates of
+their base and quote pairs instead of being voted on by validators.
+
+$ nibid query oracle derived-pairs
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DerivedPairs(context.Background(), &types.QueryDerivedPairsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -228,6 +310,92 @@ $ nibid query oracle miss nibivaloper...
 	return cmd
 }
 
+// GetCmdQueryPerformance implements the query validator vote accuracy command.
+func GetCmdQueryPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "performance [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the vote accuracy of a validator",
+		Long: strings.TrimSpace(`
+Query how far the exchange rate votes of a validator deviated from the weighted
+median, per pair and per slash window, along with its miss count in the
+current slash window.
+
+$ nibid query oracle performance nibivaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorAccuracy(
+				context.Background(),
+				&types.QueryValidatorAccuracyRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryVoteAccuracies implements the query vote accuracies command.
+func GetCmdQueryVoteAccuracies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-accuracies",
+		Args:  cobra.NoArgs,
+		Short: "Query the vote accuracy of all validators for a slash window",
+		Long: strings.TrimSpace(`
+Query the vote accuracy of all validators for a slash window. Defaults to the
+current slash window.
+
+$ nibid query oracle vote-accuracies --slash-window 12 --pair ubtc:uusd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			slashWindow, _ := cmd.Flags().GetUint64("slash-window")
+			pair, _ := cmd.Flags().GetString("pair")
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoteAccuracies(
+				context.Background(),
+				&types.QueryVoteAccuraciesRequest{SlashWindow: slashWindow, Pair: pair, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64("slash-window", 0, "the index of the slash window; defaults to the current slash window")
+	cmd.Flags().String("pair", "", "only query the records of a pair")
+	flags.AddPaginationFlagsToCmd(cmd, "vote-accuracies")
+	return cmd
+}
+
 // GetCmdQueryAggregatePrevote implements the query aggregate prevote of the validator command
 func GetCmdQueryAggregatePrevote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -5,6 +5,8 @@ import (
 	"strings"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/NibiruChain/nibiru/v2/x/common/asset"
 	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
 
@@ -31,6 +33,7 @@ func GetTxCmd() *cobra.Command {
 		GetCmdAggregateExchangeRatePrevote(),
 		GetCmdAggregateExchangeRateVote(),
 		GetCmdEditOracleParams(),
+		GetCmdEditDerivedPairs(),
 	)
 
 	return oracleTxCmd
@@ -227,6 +230,14 @@ Requires sudo permissions.
 --min-voters: the min voters of oracle vote
 --validator-fee-ratio: the validator fee ratio of oracle vote
 --expiration-blocks: the expiration blocks of oracle vote
+--snapshot-retention-windows: the number of twap lookback windows of price snapshots to retain;
+  0 disables pruning and keeps every snapshot
+--vote-accuracy-retention-windows: the number of completed slash windows of vote accuracy records to
+  retain besides the current one
+--pair-aggregation: the aggregation strategy of a pair formatted as
+  "pair=method[:trim-fraction[:max-std-dev-ratio]]", where method is one of weighted_median,
+  trimmed_mean, or weighted_mean. Repeatable. Only the given pairs change, and
+  "pair=weighted_median" resets a pair to the default strategy.
 --whitelist: the whitelist of oracle vote
 
 $ nibid tx oracle edit-params --vote-period 10 --vote-threshold 0.5 --reward-band 0.1 --slash-fraction 0.01 --slash-window 100 --min-valid-per-window 0.6 --whitelist BTC:USD,NIBI:USD
@@ -308,6 +319,28 @@ $ nibid tx oracle edit-params --vote-period 10 --vote-threshold 0.5 --reward-ban
 				msg.Params.ExpirationBlocks = expirationBlocks
 			}
 
+			// Zero keeps every snapshot, so the flag is applied whenever it is set.
+			if cmd.Flags().Changed("snapshot-retention-windows") {
+				retentionWindows, _ := cmd.Flags().GetUint64("snapshot-retention-windows")
+				msg.Params.SnapshotRetentionWindows = &retentionWindows
+			}
+
+			// Zero keeps only the current slash window, so the flag is applied
+			// whenever it is set.
+			if cmd.Flags().Changed("vote-accuracy-retention-windows") {
+				retentionWindows, _ := cmd.Flags().GetUint64("vote-accuracy-retention-windows")
+				msg.Params.VoteAccuracyRetentionWindows = &retentionWindows
+			}
+
+			pairAggregations, _ := cmd.Flags().GetStringArray("pair-aggregation")
+			for _, pairAggregationStr := range pairAggregations {
+				pairAggregation, err := parsePairAggregation(pairAggregationStr)
+				if err != nil {
+					return err
+				}
+				msg.Params.PairAggregations = append(msg.Params.PairAggregations, pairAggregation)
+			}
+
 			if whitelist, _ := cmd.Flags().GetString("whitelist"); whitelist != "" {
 				whitelistArr := strings.Split(whitelist, ",")
 				realWhitelist := make([]asset.Pair, len(whitelistArr))
@@ -343,7 +376,134 @@ $ nibid tx oracle edit-params --vote-period 10 --vote-threshold 0.5 --reward-ban
 	cmd.Flags().Uint64("min-voters", 0, "the min voters of oracle vote")
 	cmd.Flags().String("validator-fee-ratio", "", "the validator fee ratio of oracle vote")
 	cmd.Flags().Uint64("expiration-blocks", 0, "the expiration blocks of oracle vote")
+	cmd.Flags().Uint64("snapshot-retention-windows", 0, "the number of twap lookback windows of price snapshots to retain, 0 to keep every snapshot")
+	cmd.Flags().Uint64("vote-accuracy-retention-windows", 0, "the number of completed slash windows of vote accuracy records to retain besides the current one")
+	cmd.Flags().StringArray("pair-aggregation", nil, "the aggregation strategy of a pair formatted as pair=method[:trim-fraction[:max-std-dev-ratio]]")
 	cmd.Flags().String("whitelist", "", "the whitelist of oracle vote")
 
 	return cmd
 }
+
+// parsePairAggregation parses a pair aggregation formatted as
+// "pair=method[:trim-fraction[:max-std-dev-ratio]]", e.g.
+// "ubtc:uusd=trimmed_mean:0.1:0.05".
+func parsePairAggregation(s string) (types.PairAggregation, error) {
+	pairStr, strategyStr, found := strings.Cut(s, "=")
+	if !found {
+		return types.PairAggregation{}, fmt.Errorf(
+			"invalid pair aggregation %q: expected pair=method[:trim-fraction[:max-std-dev-ratio]]", s)
+	}
+	pair, err := asset.TryNewPair(strings.TrimSpace(pairStr))
+	if err != nil {
+		return types.PairAggregation{}, fmt.Errorf("invalid pair aggregation %q: %w", s, err)
+	}
+
+	parts := strings.Split(strategyStr, ":")
+	if len(parts) > 3 {
+		return types.PairAggregation{}, fmt.Errorf(
+			"invalid pair aggregation %q: expected pair=method[:trim-fraction[:max-std-dev-ratio]]", s)
+	}
+	method, err := types.ParseAggregationMethod(parts[0])
+	if err != nil {
+		return types.PairAggregation{}, err
+	}
+
+	pairAggregation := types.NewPairAggregation(pair, method, sdkmath.LegacyZeroDec())
+	if len(parts) > 1 {
+		if pairAggregation.TrimFraction, err = sdkmath.LegacyNewDecFromStr(parts[1]); err != nil {
+			return types.PairAggregation{}, fmt.Errorf("invalid trim fraction in %q: %w", s, err)
+		}
+	}
+	if len(parts) > 2 {
+		if pairAggregation.MaxStdDevRatio, err = sdkmath.LegacyNewDecFromStr(parts[2]); err != nil {
+			return types.PairAggregation{}, fmt.Errorf("invalid max std dev ratio in %q: %w", s, err)
+		}
+	}
+	return pairAggregation, pairAggregation.Validate()
+}
+
+// GetCmdEditDerivedPairs will create a tx that adds, updates, or removes the
+// derived pairs of the oracle.
+func GetCmdEditDerivedPairs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-derived-pairs --upsert [pair=base-pair/quote-pair] --remove [pair]",
+		Args:  cobra.NoArgs,
+		Short: "Add, update, or remove the derived pairs of the oracle",
+		Long: strings.TrimSpace(`
+Add, update, or remove the derived pairs of the oracle. The exchange rate of a
+derived pair is computed from the exchange rates of its base and quote pairs
+each vote period instead of being voted on by validators.
+
+Requires sudo permissions.
+
+--upsert: a derived pair formatted as "pair=base-pair/quote-pair". Repeatable.
+--remove: a derived pair to remove. Repeatable.
+
+$ nibid tx oracle edit-derived-pairs --upsert ueth:unibi=ueth:uusd/unibi:uusd --remove ubtc:unibi
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgEditDerivedPairs{
+				Sender: clientCtx.GetFromAddress().String(),
+			}
+
+			upserts, _ := cmd.Flags().GetStringArray("upsert")
+			for _, upsert := range upserts {
+				derivedPair, err := parseDerivedPair(upsert)
+				if err != nil {
+					return err
+				}
+				msg.Upsert = append(msg.Upsert, derivedPair)
+			}
+
+			removals, _ := cmd.Flags().GetStringArray("remove")
+			for _, removal := range removals {
+				pair, err := asset.TryNewPair(removal)
+				if err != nil {
+					return err
+				}
+				msg.Remove = append(msg.Remove, pair)
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	cmd.Flags().StringArray("upsert", nil, "a derived pair formatted as pair=base-pair/quote-pair")
+	cmd.Flags().StringArray("remove", nil, "a derived pair to remove")
+
+	return cmd
+}
+
+// parseDerivedPair parses a derived pair formatted as
+// "pair=base-pair/quote-pair", e.g. "ueth:unibi=ueth:uusd/unibi:uusd".
+func parseDerivedPair(s string) (types.DerivedPair, error) {
+	pairStr, sourcesStr, found := strings.Cut(s, "=")
+	if !found {
+		return types.DerivedPair{}, fmt.Errorf("invalid derived pair %q: expected pair=base-pair/quote-pair", s)
+	}
+	basePairStr, quotePairStr, found := strings.Cut(sourcesStr, "/")
+	if !found {
+		return types.DerivedPair{}, fmt.Errorf("invalid derived pair %q: expected pair=base-pair/quote-pair", s)
+	}
+
+	pairs := make([]asset.Pair, 3)
+	for i, str := range []string{pairStr, basePairStr, quotePairStr} {
+		pair, err := asset.TryNewPair(strings.TrimSpace(str))
+		if err != nil {
+			return types.DerivedPair{}, fmt.Errorf("invalid derived pair %q: %w", s, err)
+		}
+		pairs[i] = pair
+	}
+	return types.NewDerivedPair(pairs[0], pairs[1], pairs[2]), nil
+}
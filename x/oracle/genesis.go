@@ -80,6 +80,22 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 	}
 	keeper.Params.Set(ctx, data.Params)
 
+	for _, derivedPair := range data.DerivedPairs {
+		if err := keeper.SetDerivedPair(ctx, derivedPair); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, accuracy := range data.VoteAccuracies {
+		valAddr, err := sdk.ValAddressFromBech32(accuracy.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.VoteAccuracies.Insert(ctx,
+			collections.Join(valAddr, collections.Join(accuracy.SlashWindow, accuracy.Pair)), accuracy)
+	}
+
 	// check if the module account exists
 	moduleAcc := keeper.AccountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	if moduleAcc == nil {
@@ -120,7 +136,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	var pairs []asset.Pair
 	pairs = append(pairs, keeper.WhitelistedPairs.Iterate(ctx, collections.Range[asset.Pair]{}).Keys()...)
 
-	return types.NewGenesisState(
+	genesis := types.NewGenesisState(
 		params,
 		exchangeRates,
 		feederDelegations,
@@ -130,4 +146,8 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		pairs,
 		keeper.Rewards.Iterate(ctx, collections.Range[uint64]{}).Values(),
 	)
+	genesis.DerivedPairs = keeper.DerivedPairSources.Iterate(ctx, collections.Range[asset.Pair]{}).Values()
+	genesis.VoteAccuracies = keeper.VoteAccuracies.Iterate(ctx,
+		collections.PairRange[sdk.ValAddress, collections.Pair[uint64, asset.Pair]]{}).Values()
+	return genesis
 }
@@ -7,6 +7,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
 	"github.com/NibiruChain/nibiru/v2/x/oracle"
 	"github.com/NibiruChain/nibiru/v2/x/oracle/keeper"
 	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
@@ -28,6 +31,11 @@ func TestExportInitGenesis(t *testing.T) {
 	input.OracleKeeper.WhitelistedPairs.Insert(input.Ctx, "pair1:pair1")
 	input.OracleKeeper.WhitelistedPairs.Insert(input.Ctx, "pair2:pair2")
 	input.OracleKeeper.MissCounters.Insert(input.Ctx, keeper.ValAddrs[0], 10)
+	input.OracleKeeper.DerivedPairSources.Insert(input.Ctx, "ueth:uatom",
+		types.NewDerivedPair("ueth:uatom", asset.PAIR_ETH, asset.PAIR_ATOM))
+	input.OracleKeeper.VoteAccuracies.Insert(input.Ctx,
+		collections.Join(keeper.ValAddrs[0], collections.Join(uint64(2), asset.PAIR_ETH)),
+		types.NewVoteAccuracy(keeper.ValAddrs[0], asset.PAIR_ETH, 2))
 	input.OracleKeeper.Rewards.Insert(input.Ctx, 0, types.Rewards{
 		Id:          0,
 		VotePeriods: 100,
@@ -148,19 +148,34 @@ func Tally(
 	rewardBand sdkmath.LegacyDec,
 	validatorPerformances types.ValidatorPerformances,
 ) sdkmath.LegacyDec {
-	weightedMedian := votes.WeightedMedianWithAssertion()
-	standardDeviation := votes.StandardDeviation(weightedMedian)
-	rewardSpread := weightedMedian.Mul(rewardBand.QuoInt64(2))
+	weightedMedian, _ := TallyWithStrategy(
+		votes, types.WeightedMedianStrategy{}, rewardBand, validatorPerformances)
+	return weightedMedian
+}
 
-	if standardDeviation.GT(rewardSpread) {
-		rewardSpread = standardDeviation
+// TallyWithStrategy aggregates the votes into an exchange rate with the given
+// strategy and returns it. Voters within a reasonable spread from the exchange
+// rate are rewarded. If the strategy rejects the ballot, it returns the error
+// and leaves validatorPerformances untouched.
+//
+// ALERT: This function mutates validatorPerformances slice based on the votes
+// made by the validators.
+func TallyWithStrategy(
+	votes types.ExchangeRateVotes,
+	strategy types.AggregationStrategy,
+	rewardBand sdkmath.LegacyDec,
+	validatorPerformances types.ValidatorPerformances,
+) (sdkmath.LegacyDec, error) {
+	exchangeRate, err := strategy.Aggregate(votes)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
 	}
+	rewardSpread := tallyRewardSpread(votes, exchangeRate, rewardBand)
 
 	missedValidators := make(map[string]bool)
 	for _, v := range votes {
 		// Filter votes winners & abstain voters
-		isInsideSpread := v.ExchangeRate.GTE(weightedMedian.Sub(rewardSpread)) &&
-			v.ExchangeRate.LTE(weightedMedian.Add(rewardSpread))
+		isInsideSpread := isInsideRewardSpread(v.ExchangeRate, exchangeRate, rewardSpread)
 		isAbstainVote := !v.ExchangeRate.IsPositive() // strictly less than zero, don't want to include zero
 		isMiss := !isInsideSpread && !isAbstainVote
 
@@ -183,5 +198,25 @@ func Tally(
 		validatorPerformances[v.Voter.String()] = validatorPerformance
 	}
 
-	return weightedMedian
+	return exchangeRate, nil
+}
+
+// tallyRewardSpread returns the maximum distance a vote can have from the
+// tallied exchange rate to be rewarded: the larger of half the reward band and one
+// standard deviation of the votes.
+func tallyRewardSpread(
+	votes types.ExchangeRateVotes, exchangeRate, rewardBand sdkmath.LegacyDec,
+) sdkmath.LegacyDec {
+	standardDeviation := votes.StandardDeviation(exchangeRate)
+	rewardSpread := exchangeRate.Mul(rewardBand.QuoInt64(2))
+
+	if standardDeviation.GT(rewardSpread) {
+		rewardSpread = standardDeviation
+	}
+	return rewardSpread
+}
+
+func isInsideRewardSpread(vote, exchangeRate, rewardSpread sdkmath.LegacyDec) bool {
+	return vote.GTE(exchangeRate.Sub(rewardSpread)) &&
+		vote.LTE(exchangeRate.Add(rewardSpread))
 }
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+// SetDerivedPair adds or updates a derived pair. A derived pair can't be voted
+// on by validators, and it can't be computed from other derived pairs.
+func (k Keeper) SetDerivedPair(ctx sdk.Context, derivedPair types.DerivedPair) error {
+	if err := derivedPair.Validate(); err != nil {
+		return err
+	}
+	if k.isVotedPair(ctx, derivedPair.Pair) {
+		return types.ErrInvalidDerivedPair.Wrapf(
+			"pair %s is whitelisted for votes and can't be derived", derivedPair.Pair)
+	}
+	for _, sourcePair := range []asset.Pair{derivedPair.BasePair, derivedPair.QuotePair} {
+		if _, err := k.DerivedPairSources.Get(ctx, sourcePair); err == nil {
+			return types.ErrInvalidDerivedPair.Wrapf(
+				"pair %s can't be derived from another derived pair %s", derivedPair.Pair, sourcePair)
+		}
+	}
+	for _, other := range k.DerivedPairSources.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		if other.BasePair == derivedPair.Pair || other.QuotePair == derivedPair.Pair {
+			return types.ErrInvalidDerivedPair.Wrapf(
+				"pair %s is used to derive %s", derivedPair.Pair, other.Pair)
+		}
+	}
+
+	k.DerivedPairSources.Insert(ctx, derivedPair.Pair, derivedPair)
+	return nil
+}
+
+// RemoveDerivedPair removes a derived pair along with its exchange rate, so
+// that stale cross rates aren't served after the removal. The price snapshots
+// of the pair are removed too, since [Keeper.PrunePriceSnapshots] only prunes
+// the snapshots of pairs with an exchange rate.
+func (k Keeper) RemoveDerivedPair(ctx sdk.Context, pair asset.Pair) error {
+	if err := k.DerivedPairSources.Delete(ctx, pair); err != nil {
+		return types.ErrInvalidDerivedPair.Wrapf("pair %s is not a derived pair", pair)
+	}
+	_ = k.ExchangeRates.Delete(ctx, pair)
+	for _, key := range k.PriceSnapshots.Iterate(
+		ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair),
+	).Keys() {
+		_ = k.PriceSnapshots.Delete(ctx, key)
+	}
+	return nil
+}
+
+// isVotedPair returns true if validators can vote on the exchange rate of the
+// pair.
+func (k Keeper) isVotedPair(ctx sdk.Context, pair asset.Pair) bool {
+	if k.WhitelistedPairs.Has(ctx, pair) {
+		return true
+	}
+	for _, whitelisted := range k.Whitelist(ctx) {
+		if whitelisted == pair {
+			return true
+		}
+	}
+	return false
+}
+
+// updateDerivedPrices sets the exchange rates of the derived pairs whose base
+// and quote pairs were both tallied in the current vote period. Derived pairs
+// with a stale base or quote pair keep their previous exchange rate, which
+// eventually expires like the exchange rate of a voted pair.
+func (k Keeper) updateDerivedPrices(
+	ctx sdk.Context, talliedPrices map[asset.Pair]sdkmath.LegacyDec,
+) {
+	for _, derivedPair := range k.DerivedPairSources.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		if _, isTallied := talliedPrices[derivedPair.Pair]; isTallied {
+			// Votes take precedence over derived exchange rates.
+			continue
+		}
+		baseRate, isBaseFresh := talliedPrices[derivedPair.BasePair]
+		quoteRate, isQuoteFresh := talliedPrices[derivedPair.QuotePair]
+		if !isBaseFresh || !isQuoteFresh {
+			continue
+		}
+		crossRate, ok := derivedPair.CrossRate(baseRate, quoteRate)
+		if !ok {
+			continue
+		}
+		k.setPrice(ctx, derivedPair.Pair, crossRate, []asset.Pair{
+			derivedPair.BasePair, derivedPair.QuotePair,
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+const pairEthAtom asset.Pair = "ueth:uatom"
+
+func TestSetDerivedPair(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		derivedPair types.DerivedPair
+		wantErr     string
+	}{
+		{
+			name:        "happy path",
+			derivedPair: types.NewDerivedPair(pairEthAtom, asset.PAIR_ETH, asset.PAIR_ATOM),
+		},
+		{
+			name:        "invalid derived pair",
+			derivedPair: types.NewDerivedPair(pairEthAtom, asset.PAIR_BTC, asset.PAIR_ATOM),
+			wantErr:     "must price the base asset",
+		},
+		{
+			name:        "whitelisted pair can't be derived",
+			derivedPair: types.NewDerivedPair(asset.PAIR_ETH, "ueth:uatom", "uusd:uatom"),
+			wantErr:     "whitelisted for votes",
+		},
+		{
+			name:        "can't derive from a derived pair",
+			derivedPair: types.NewDerivedPair("ueth:ubtc", pairEthAtom, "ubtc:uatom"),
+			wantErr:     "derived from another derived pair",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			input := CreateTestFixture(t)
+			if tc.derivedPair.Pair != pairEthAtom {
+				require.NoError(t, input.OracleKeeper.SetDerivedPair(input.Ctx,
+					types.NewDerivedPair(pairEthAtom, asset.PAIR_ETH, asset.PAIR_ATOM)))
+			}
+
+			err := input.OracleKeeper.SetDerivedPair(input.Ctx, tc.derivedPair)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			got, err := input.OracleKeeper.DerivedPairSources.Get(input.Ctx, tc.derivedPair.Pair)
+			require.NoError(t, err)
+			require.Equal(t, tc.derivedPair, got)
+		})
+	}
+
+	t.Run("source pair of a derived pair can't be derived", func(t *testing.T) {
+		input := CreateTestFixture(t)
+		require.NoError(t, input.OracleKeeper.SetDerivedPair(input.Ctx,
+			types.NewDerivedPair("ubtc:uatom", "ubtc:unibi", "uatom:unibi")))
+		err := input.OracleKeeper.SetDerivedPair(input.Ctx,
+			types.NewDerivedPair("ubtc:unibi", asset.PAIR_BTC, "unibi:uusd"))
+		require.ErrorContains(t, err, "is used to derive ubtc:uatom")
+	})
+}
+
+func TestRemoveDerivedPair(t *testing.T) {
+	input := CreateTestFixture(t)
+
+	require.ErrorContains(t,
+		input.OracleKeeper.RemoveDerivedPair(input.Ctx, pairEthAtom), "not a derived pair")
+
+	require.NoError(t, input.OracleKeeper.SetDerivedPair(input.Ctx,
+		types.NewDerivedPair(pairEthAtom, asset.PAIR_ETH, asset.PAIR_ATOM)))
+	input.OracleKeeper.SetPrice(input.Ctx, pairEthAtom, sdkmath.LegacyNewDec(200))
+	input.Ctx = input.Ctx.WithBlockTime(input.Ctx.BlockTime().Add(time.Minute))
+	input.OracleKeeper.SetPrice(input.Ctx, pairEthAtom, sdkmath.LegacyNewDec(201))
+	input.OracleKeeper.SetPrice(input.Ctx, asset.PAIR_ETH, sdkmath.LegacyNewDec(2_000))
+
+	require.NoError(t, input.OracleKeeper.RemoveDerivedPair(input.Ctx, pairEthAtom))
+	_, err := input.OracleKeeper.DerivedPairSources.Get(input.Ctx, pairEthAtom)
+	require.Error(t, err)
+	_, err = input.OracleKeeper.ExchangeRates.Get(input.Ctx, pairEthAtom)
+	require.Error(t, err)
+
+	snapshotPairs := input.OracleKeeper.PriceSnapshots.Iterate(
+		input.Ctx, collections.PairRange[asset.Pair, time.Time]{},
+	).Values()
+	require.Len(t, snapshotPairs, 1, "only the snapshot of the other pair is left")
+	require.Equal(t, asset.PAIR_ETH, snapshotPairs[0].Pair)
+}
+
+func TestDerivedPairExchangeRate(t *testing.T) {
+	fixture, msgServer := Setup(t)
+	require.NoError(t, fixture.OracleKeeper.SetDerivedPair(fixture.Ctx,
+		types.NewDerivedPair(pairEthAtom, asset.PAIR_ETH, asset.PAIR_ATOM)))
+
+	for val := range 4 {
+		MakeAggregatePrevoteAndVote(t, fixture, msgServer, 0, types.ExchangeRateTuples{
+			{Pair: asset.PAIR_ETH, ExchangeRate: sdkmath.LegacyNewDec(2_000)},
+			{Pair: asset.PAIR_ATOM, ExchangeRate: sdkmath.LegacyNewDec(10)},
+		}, val)
+	}
+	fixture.OracleKeeper.UpdateExchangeRates(fixture.Ctx)
+
+	querier := NewQuerier(fixture.OracleKeeper)
+	resp, err := querier.ExchangeRate(sdk.WrapSDKContext(fixture.Ctx), &types.QueryExchangeRateRequest{Pair: pairEthAtom})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(200), resp.ExchangeRate)
+	require.Equal(t, []asset.Pair{asset.PAIR_ETH, asset.PAIR_ATOM}, resp.DerivedFrom)
+
+	resp, err = querier.ExchangeRate(sdk.WrapSDKContext(fixture.Ctx), &types.QueryExchangeRateRequest{Pair: asset.PAIR_ETH})
+	require.NoError(t, err)
+	require.Empty(t, resp.DerivedFrom)
+
+	// The derived pair isn't updated when one of its source pairs isn't tallied.
+	for val := range 4 {
+		MakeAggregatePrevoteAndVote(t, fixture, msgServer, 0, types.ExchangeRateTuples{
+			{Pair: asset.PAIR_ETH, ExchangeRate: sdkmath.LegacyNewDec(3_000)},
+		}, val)
+	}
+	fixture.OracleKeeper.UpdateExchangeRates(fixture.Ctx)
+
+	out, err := fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pairEthAtom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(200), out.ExchangeRate)
+}
@@ -4,8 +4,11 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
 	"github.com/NibiruChain/nibiru/v2/x/oracle/keeper"
@@ -52,3 +55,58 @@ func TestMsgServer_EditOracleParams(t *testing.T) {
 	_, err = msgServer.EditOracleParams(goCtx, &msg)
 	require.NoError(t, err)
 }
+
+func TestMsgServer_EditDerivedPairs(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(app.OracleKeeper, app.SudoKeeper)
+	pairEthAtom := asset.Pair("ueth:uatom")
+
+	alice := testutil.AccAddress()
+	upsert := types.NewDerivedPair(pairEthAtom, asset.PAIR_ETH, asset.PAIR_ATOM)
+
+	// Case 1: user is not authorized to edit derived pairs
+	_, err := msgServer.EditDerivedPairs(goCtx, &types.MsgEditDerivedPairs{
+		Sender: alice.String(),
+		Upsert: []types.DerivedPair{upsert},
+	})
+	require.EqualError(t, err, sudotypes.ErrUnauthorized.Error())
+
+	// Case 2: the governance module is authorized to edit derived pairs
+	_, err = msgServer.EditDerivedPairs(goCtx, &types.MsgEditDerivedPairs{
+		Sender: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Upsert: []types.DerivedPair{upsert},
+	})
+	require.NoError(t, err)
+	testutil.RequireContainsTypedEvent(t, ctx, &types.EventEditDerivedPairs{
+		Sender:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Upserted: []types.DerivedPair{upsert},
+		Removed:  []string{},
+	})
+
+	resp, err := keeper.NewQuerier(app.OracleKeeper).DerivedPairs(goCtx, &types.QueryDerivedPairsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.DerivedPair{upsert}, resp.DerivedPairs)
+
+	// Case 3: sudoers are authorized to edit derived pairs
+	app.SudoKeeper.Sudoers.Set(ctx, sudotypes.Sudoers{
+		Root:      testutil.AccAddress().String(),
+		Contracts: []string{alice.String()},
+	})
+	_, err = msgServer.EditDerivedPairs(goCtx, &types.MsgEditDerivedPairs{
+		Sender: alice.String(),
+		Remove: []asset.Pair{pairEthAtom},
+	})
+	require.NoError(t, err)
+
+	resp, err = keeper.NewQuerier(app.OracleKeeper).DerivedPairs(goCtx, &types.QueryDerivedPairsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.DerivedPairs)
+
+	// Case 4: removing a pair that isn't derived fails
+	_, err = msgServer.EditDerivedPairs(goCtx, &types.MsgEditDerivedPairs{
+		Sender: alice.String(),
+		Remove: []asset.Pair{pairEthAtom},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidDerivedPair)
+}
@@ -3,12 +3,15 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/NibiruChain/collections"
 
+	"github.com/NibiruChain/nibiru/v2/x/common"
 	"github.com/NibiruChain/nibiru/v2/x/common/asset"
 	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
 )
@@ -69,6 +72,7 @@ func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 		BlockTimestampMs: out.BlockTimestampMs,
 		BlockHeight:      out.CreatedBlock,
 		IsVintage:        isVintage,
+		DerivedFrom:      out.DerivedFrom,
 	}, nil
 }
 
@@ -119,6 +123,14 @@ func (q querier) VoteTargets(c context.Context, _ *types.QueryVoteTargetsRequest
 	return &types.QueryVoteTargetsResponse{VoteTargets: q.GetWhitelistedPairs(ctx)}, nil
 }
 
+// DerivedPairs queries the pairs whose exchange rates are derived from the
+// exchange rates of voted pairs
+func (q querier) DerivedPairs(c context.Context, _ *types.QueryDerivedPairsRequest) (*types.QueryDerivedPairsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	derivedPairs := q.Keeper.DerivedPairSources.Iterate(ctx, collections.Range[asset.Pair]{}).Values()
+	return &types.QueryDerivedPairsResponse{DerivedPairs: derivedPairs}, nil
+}
+
 // FeederDelegation queries the account address that the validator operator delegated oracle vote rights to
 func (q querier) FeederDelegation(c context.Context, req *types.QueryFeederDelegationRequest) (*types.QueryFeederDelegationResponse, error) {
 	if req == nil {
@@ -206,3 +218,124 @@ func (q querier) AggregateVote(c context.Context, req *types.QueryAggregateVoteR
 func (q querier) AggregateVotes(c context.Context, _ *types.QueryAggregateVotesRequest) (*types.QueryAggregateVotesResponse, error) {
 	return &types.QueryAggregateVotesResponse{AggregateVotes: q.Keeper.Votes.Iterate(sdk.UnwrapSDKContext(c), collections.Range[sdk.ValAddress]{}).Values()}, nil
 }
+
+// PriceHistory queries the retained price snapshots of a pair in chronological
+// order
+func (q querier) PriceHistory(
+	c context.Context, req *types.QueryPriceHistoryRequest,
+) (*types.QueryPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := req.Pair.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pageReq, _, err := common.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pairPrefix := append(priceSnapshotsNamespace.Prefix(), asset.PairKeyEncoder.Encode(req.Pair)...)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), pairPrefix)
+
+	var snapshots []types.PriceSnapshot
+	pageResp, err := sdkquery.Paginate(store, pageReq, func(_, value []byte) error {
+		var snapshot types.PriceSnapshot
+		if err := q.cdc.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPriceHistoryResponse{
+		Snapshots:  snapshots,
+		Pagination: pageResp,
+	}, nil
+}
+
+// ValidatorAccuracy queries the vote accuracy records of a validator
+func (q querier) ValidatorAccuracy(
+	c context.Context, req *types.QueryValidatorAccuracyRequest,
+) (*types.QueryValidatorAccuracyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	accuracies := q.Keeper.VoteAccuracies.Iterate(ctx,
+		collections.PairRange[sdk.ValAddress, collections.Pair[uint64, asset.Pair]]{}.Prefix(valAddr),
+	).Values()
+
+	return &types.QueryValidatorAccuracyResponse{
+		Accuracies:         accuracies,
+		MissCounter:        q.Keeper.MissCounters.GetOr(ctx, valAddr, 0),
+		CurrentSlashWindow: q.Keeper.CurrentSlashWindow(ctx),
+	}, nil
+}
+
+// VoteAccuracies queries the vote accuracy records of all validators for a
+// slash window
+func (q querier) VoteAccuracies(
+	c context.Context, req *types.QueryVoteAccuraciesRequest,
+) (*types.QueryVoteAccuraciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var pairFilter asset.Pair
+	if req.Pair != "" {
+		pair, err := asset.TryNewPair(req.Pair)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		pairFilter = pair
+	}
+
+	pageReq, _, err := common.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	slashWindow := req.SlashWindow
+	if slashWindow == 0 {
+		slashWindow = q.Keeper.CurrentSlashWindow(ctx)
+	}
+
+	// The records are keyed by validator first, so the records of other slash
+	// windows and pairs are filtered out page by page.
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), voteAccuraciesNamespace.Prefix())
+	accuracies := []types.VoteAccuracy{}
+	pageResp, err := sdkquery.FilteredPaginate(store, pageReq, func(_, value []byte, accumulate bool) (bool, error) {
+		var accuracy types.VoteAccuracy
+		if err := q.cdc.Unmarshal(value, &accuracy); err != nil {
+			return false, err
+		}
+		if accuracy.SlashWindow != slashWindow || (pairFilter != "" && accuracy.Pair != pairFilter) {
+			return false, nil
+		}
+		if accumulate {
+			accuracies = append(accuracies, accuracy)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVoteAccuraciesResponse{
+		Accuracies:  accuracies,
+		SlashWindow: slashWindow,
+		Pagination:  pageResp,
+	}, nil
+}
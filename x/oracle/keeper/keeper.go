@@ -48,6 +48,16 @@ type Keeper struct {
 	WhitelistedPairs collections.KeySet[asset.Pair]
 	Rewards          collections.Map[uint64, types.Rewards]
 	RewardsID        collections.Sequence
+
+	// DerivedPairSources maps each derived pair to the voted pairs its exchange rate
+	// is computed from.
+	DerivedPairSources collections.Map[asset.Pair, types.DerivedPair]
+
+	// VoteAccuracies maps (validator, (slash window, pair)) to the accuracy of
+	// the validator's votes for the pair during the slash window.
+	VoteAccuracies collections.Map[
+		collections.Pair[sdk.ValAddress, collections.Pair[uint64, asset.Pair]],
+		types.VoteAccuracy]
 }
 
 // NewKeeper constructs a new keeper for oracle
@@ -81,7 +91,7 @@ func NewKeeper(
 		distrModuleName:   distrName,
 		Params:            collections.NewItem(storeKey, 11, collections.ProtoValueEncoder[types.Params](cdc)),
 		ExchangeRates:     collections.NewMap(storeKey, 1, asset.PairKeyEncoder, collections.ProtoValueEncoder[types.ExchangeRateAtBlock](cdc)),
-		PriceSnapshots:    collections.NewMap(storeKey, 10, collections.PairKeyEncoder(asset.PairKeyEncoder, collections.TimeKeyEncoder), collections.ProtoValueEncoder[types.PriceSnapshot](cdc)),
+		PriceSnapshots:    collections.NewMap(storeKey, priceSnapshotsNamespace, collections.PairKeyEncoder(asset.PairKeyEncoder, collections.TimeKeyEncoder), collections.ProtoValueEncoder[types.PriceSnapshot](cdc)),
 		FeederDelegations: collections.NewMap(storeKey, 2, collections.ValAddressKeyEncoder, collections.AccAddressValueEncoder),
 		MissCounters:      collections.NewMap(storeKey, 3, collections.ValAddressKeyEncoder, collections.Uint64ValueEncoder),
 		Prevotes:          collections.NewMap(storeKey, 4, collections.ValAddressKeyEncoder, collections.ProtoValueEncoder[types.AggregateExchangeRatePrevote](cdc)),
@@ -91,6 +101,16 @@ func NewKeeper(
 			storeKey, 7,
 			collections.Uint64KeyEncoder, collections.ProtoValueEncoder[types.Rewards](cdc)),
 		RewardsID: collections.NewSequence(storeKey, 9),
+		DerivedPairSources: collections.NewMap(
+			storeKey, 12,
+			asset.PairKeyEncoder, collections.ProtoValueEncoder[types.DerivedPair](cdc)),
+		VoteAccuracies: collections.NewMap(
+			storeKey, voteAccuraciesNamespace,
+			collections.PairKeyEncoder(
+				collections.ValAddressKeyEncoder,
+				collections.PairKeyEncoder(collections.Uint64KeyEncoder, asset.PairKeyEncoder),
+			),
+			collections.ProtoValueEncoder[types.VoteAccuracy](cdc)),
 	}
 	return k
 }
@@ -184,12 +204,22 @@ func (k Keeper) GetExchangeRateTwap(ctx sdk.Context, pair asset.Pair) (price sdk
 
 // SetPrice sets the price for a pair as well as the price snapshot.
 func (k Keeper) SetPrice(ctx sdk.Context, pair asset.Pair, price sdkmath.LegacyDec) {
+	k.setPrice(ctx, pair, price, nil)
+}
+
+// setPrice sets the price for a pair as well as the price snapshot.
+// "derivedFrom" holds the pairs the price was derived from if the pair is a
+// derived pair.
+func (k Keeper) setPrice(
+	ctx sdk.Context, pair asset.Pair, price sdkmath.LegacyDec, derivedFrom []asset.Pair,
+) {
 	blockTimestampMs := ctx.BlockTime().UnixMilli()
 	k.ExchangeRates.Insert(ctx, pair,
 		types.ExchangeRateAtBlock{
 			ExchangeRate:     price,
 			CreatedBlock:     uint64(ctx.BlockHeight()),
 			BlockTimestampMs: blockTimestampMs,
+			DerivedFrom:      derivedFrom,
 		})
 
 	key := collections.Join(pair, ctx.BlockTime())
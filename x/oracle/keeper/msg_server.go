@@ -7,6 +7,8 @@ import (
 	sdkioerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
@@ -197,3 +199,46 @@ func (ms msgServer) EditOracleParams(goCtx context.Context, msg *types.MsgEditOr
 
 	return &types.MsgEditOracleParamsResponse{}, nil
 }
+
+// EditDerivedPairs: gRPC tx msg for adding, updating, and removing the derived
+// pairs of the oracle.
+// [SUDO] Only callable by sudoers or the governance module.
+func (ms msgServer) EditDerivedPairs(
+	goCtx context.Context, msg *types.MsgEditDerivedPairs,
+) (*types.MsgEditDerivedPairsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address")
+	}
+	isGov := sender.Equals(authtypes.NewModuleAddress(govtypes.ModuleName))
+	if !isGov && ms.SudoKeeper.CheckPermissions(sender, ctx) != nil {
+		return nil, sudotypes.ErrUnauthorized
+	}
+
+	for _, pair := range msg.Remove {
+		if err := ms.RemoveDerivedPair(ctx, pair); err != nil {
+			return nil, err
+		}
+	}
+	for _, derivedPair := range msg.Upsert {
+		if err := ms.SetDerivedPair(ctx, derivedPair); err != nil {
+			return nil, err
+		}
+	}
+
+	removed := make([]string, len(msg.Remove))
+	for i, pair := range msg.Remove {
+		removed[i] = pair.String()
+	}
+	err = ctx.EventManager().EmitTypedEvent(&types.EventEditDerivedPairs{
+		Sender:   msg.Sender,
+		Upserted: msg.Upsert,
+		Removed:  removed,
+	})
+	return &types.MsgEditDerivedPairsResponse{}, err
+}
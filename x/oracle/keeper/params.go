@@ -120,5 +120,43 @@ func mergeOracleParams(msg *types.MsgEditOracleParams, oracleParams types.Params
 		oracleParams.ExpirationBlocks = msg.Params.ExpirationBlocks
 	}
 
+	// Zero is a valid retention that keeps every snapshot, so an unset wrapper
+	// rather than zero leaves the retention unchanged.
+	if msg.Params.SnapshotRetentionWindows != nil {
+		oracleParams.SnapshotRetentionWindows = *msg.Params.SnapshotRetentionWindows
+	}
+
+	if msg.Params.VoteAccuracyRetentionWindows != nil {
+		oracleParams.VoteAccuracyRetentionWindows = *msg.Params.VoteAccuracyRetentionWindows
+	}
+
+	for _, pairAggregation := range msg.Params.PairAggregations {
+		oracleParams.PairAggregations = upsertPairAggregation(oracleParams.PairAggregations, pairAggregation)
+	}
+
 	return oracleParams
 }
+
+// upsertPairAggregation sets the aggregation of a pair, keeping the order of
+// the other pairs. A default aggregation removes the pair, which resets it to
+// the default strategy.
+func upsertPairAggregation(
+	pairAggregations []types.PairAggregation, pairAggregation types.PairAggregation,
+) []types.PairAggregation {
+	updated := make([]types.PairAggregation, 0, len(pairAggregations)+1)
+	found := false
+	for _, existing := range pairAggregations {
+		if existing.Pair != pairAggregation.Pair {
+			updated = append(updated, existing)
+			continue
+		}
+		found = true
+		if !pairAggregation.IsDefault() {
+			updated = append(updated, pairAggregation)
+		}
+	}
+	if !found && !pairAggregation.IsDefault() {
+		updated = append(updated, pairAggregation)
+	}
+	return updated
+}
@@ -96,6 +96,19 @@ func TestMergeOracleParams(t *testing.T) {
 	expirationBlocks := uint64(100)
 	changedExpirationBlocks := uint64(200)
 
+	snapshotRetentionWindows := uint64(4)
+	changedSnapshotRetentionWindows := uint64(8)
+
+	voteAccuracyRetentionWindows := uint64(4)
+	changedVoteAccuracyRetentionWindows := uint64(2)
+
+	pairAggregations := []types.PairAggregation{
+		types.NewPairAggregation(whitelist[0], types.AGGREGATION_METHOD_WEIGHTED_MEAN, sdk.ZeroDec()),
+	}
+	changedPairAggregations := []types.PairAggregation{
+		types.NewPairAggregation(whitelist[1], types.AGGREGATION_METHOD_TRIMMED_MEAN, sdk.NewDecWithPrec(1, 1)),
+	}
+
 	initialParams := types.Params{
 		VotePeriod:         votePeriod,
 		VoteThreshold:      voteThreshold,
@@ -108,6 +121,10 @@ func TestMergeOracleParams(t *testing.T) {
 		ValidatorFeeRatio:  minFeeRatio,
 		TwapLookbackWindow: twapLoopbackWindow,
 		ExpirationBlocks:   expirationBlocks,
+
+		SnapshotRetentionWindows:     snapshotRetentionWindows,
+		PairAggregations:             pairAggregations,
+		VoteAccuracyRetentionWindows: voteAccuracyRetentionWindows,
 	}
 
 	tests := []struct {
@@ -423,6 +440,117 @@ func TestMergeOracleParams(t *testing.T) {
 				require.Equal(t, expirationBlocks, params.ExpirationBlocks)
 			},
 		},
+		{
+			name: "snapshotRetentionWindows",
+			msg: &types.MsgEditOracleParams{
+				Params: &types.OracleParamsMsg{
+					SnapshotRetentionWindows: &changedSnapshotRetentionWindows,
+				},
+			},
+			require: func(params types.Params) {
+				require.Equal(t, changedSnapshotRetentionWindows, params.SnapshotRetentionWindows)
+			},
+		},
+		{
+			name: "snapshotRetentionWindows zero keeps every snapshot",
+			msg: &types.MsgEditOracleParams{
+				Params: &types.OracleParamsMsg{
+					SnapshotRetentionWindows: new(uint64),
+				},
+			},
+			require: func(params types.Params) {
+				require.Zero(t, params.SnapshotRetentionWindows)
+			},
+		},
+		{
+			name: "snapshotRetentionWindows unset not updated",
+			msg: &types.MsgEditOracleParams{
+				Params: &types.OracleParamsMsg{},
+			},
+			require: func(params types.Params) {
+				require.Equal(t, snapshotRetentionWindows, params.SnapshotRetentionWindows)
+			},
+		},
+		{
+			name: "voteAccuracyRetentionWindows",
+			msg: &types.MsgEditOracleParams{
+				Params: &types.OracleParamsMsg{
+					VoteAccuracyRetentionWindows: &changedVoteAccuracyRetentionWindows,
+				},
+			},
+			require: func(params types.Params) {
+				require.Equal(t, changedVoteAccuracyRetentionWindows, params.VoteAccuracyRetentionWindows)
+			},
+		},
+		{
+			name: "voteAccuracyRetentionWindows zero keeps only the current slash window",
+			msg: &types.MsgEditOracleParams{
+				Params: &types.OracleParamsMsg{
+					VoteAccuracyRetentionWindows: new(uint64),
+				},
+			},
+			require: func(params types.Params) {
+				require.Zero(t, params.VoteAccuracyRetentionWindows)
+			},
+		},
+		{
+			name: "voteAccuracyRetentionWindows unset not updated",
+			msg: &types.MsgEditOracleParams{
+				Params: &types.OracleParamsMsg{},
+			},
+			require: func(params types.Params) {
+				require.Equal(t, voteAccuracyRetentionWindows, params.VoteAccuracyRetentionWindows)
+			},
+		},
+		{
+			name: "pairAggregations",
+			msg: &types.MsgEditOracleParams{
+				Params: &types.OracleParamsMsg{
+					PairAggregations: changedPairAggregations,
+				},
+			},
+			require: func(params types.Params) {
+				require.Equal(t, append(pairAggregations, changedPairAggregations...), params.PairAggregations)
+			},
+		},
+		{
+			name: "pairAggregations of an existing pair replaced",
+			msg: &types.MsgEditOracleParams{
+				Params: &types.OracleParamsMsg{
+					PairAggregations: []types.PairAggregation{
+						types.NewPairAggregation(whitelist[0], types.AGGREGATION_METHOD_TRIMMED_MEAN, sdk.NewDecWithPrec(2, 1)),
+					},
+				},
+			},
+			require: func(params types.Params) {
+				require.Equal(t, []types.PairAggregation{
+					types.NewPairAggregation(whitelist[0], types.AGGREGATION_METHOD_TRIMMED_MEAN, sdk.NewDecWithPrec(2, 1)),
+				}, params.PairAggregations)
+			},
+		},
+		{
+			name: "default pairAggregation resets the pair",
+			msg: &types.MsgEditOracleParams{
+				Params: &types.OracleParamsMsg{
+					PairAggregations: []types.PairAggregation{
+						types.NewPairAggregation(whitelist[0], types.AGGREGATION_METHOD_WEIGHTED_MEDIAN, sdk.ZeroDec()),
+					},
+				},
+			},
+			require: func(params types.Params) {
+				require.Empty(t, params.PairAggregations)
+				require.Equal(t, types.WeightedMedianStrategy{}, params.AggregationStrategy(whitelist[0]))
+			},
+		},
+		{
+			name: "empty pairAggregations not updated",
+			msg: &types.MsgEditOracleParams{
+				Params: &types.OracleParamsMsg{},
+			},
+			require: func(params types.Params) {
+				require.Equal(t, pairAggregations, params.PairAggregations)
+			},
+		},
 	}
 
 	for _, tt := range tests {
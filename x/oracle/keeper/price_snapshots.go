@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+// priceSnapshotsNamespace is the store namespace of [Keeper.PriceSnapshots].
+const priceSnapshotsNamespace collections.Namespace = 10
+
+// MaxPriceSnapshotsPrunedPerBlock bounds the number of price snapshots
+// deleted by [Keeper.PrunePriceSnapshots] in a single block so that the
+// EndBlocker does a bounded amount of work, even on the first block after
+// enabling pruning on a chain with a long snapshot history. Snapshots left
+// over are pruned in the following blocks.
+const MaxPriceSnapshotsPrunedPerBlock = 1_000
+
+// PrunePriceSnapshots deletes the price snapshots that are older than the
+// snapshot retention of the params, up to "limit" snapshots, and returns the
+// number of snapshots deleted. Nothing is pruned if the retention is zero.
+func (k Keeper) PrunePriceSnapshots(
+	ctx sdk.Context, params types.Params, limit int,
+) (pruned int) {
+	retention := params.SnapshotRetention()
+	if retention <= 0 {
+		return 0
+	}
+	cutoff := ctx.BlockTime().Add(-retention)
+
+	// Every pair with price snapshots has an exchange rate, since both are
+	// written by [Keeper.SetPrice].
+	for _, pair := range k.ExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
+		if pruned >= limit {
+			break
+		}
+		for _, key := range k.expiredPriceSnapshots(ctx, pair, cutoff, limit-pruned) {
+			_ = k.PriceSnapshots.Delete(ctx, key)
+			pruned++
+		}
+	}
+	return pruned
+}
+
+// expiredPriceSnapshots returns the keys of up to "limit" price snapshots of
+// the pair that were created before the cutoff time.
+func (k Keeper) expiredPriceSnapshots(
+	ctx sdk.Context, pair asset.Pair, cutoff time.Time, limit int,
+) (keys []collections.Pair[asset.Pair, time.Time]) {
+	iter := k.PriceSnapshots.Iterate(
+		ctx,
+		collections.PairRange[asset.Pair, time.Time]{}.
+			Prefix(pair).
+			EndExclusive(cutoff),
+	)
+	defer iter.Close()
+	for ; iter.Valid() && len(keys) < limit; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	return keys
+}
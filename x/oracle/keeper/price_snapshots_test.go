@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+// setPrices sets one price per minute for the pair, starting at the block time
+// of the context, and returns the context at the time of the last price.
+func setPrices(input TestFixture, ctx sdk.Context, pair asset.Pair, count int) sdk.Context {
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+		}
+		input.OracleKeeper.SetPrice(ctx, pair, sdkmath.LegacyNewDec(int64(i+1)))
+	}
+	return ctx
+}
+
+func countPriceSnapshots(input TestFixture, ctx sdk.Context, pair asset.Pair) int {
+	return len(input.OracleKeeper.PriceSnapshots.Iterate(
+		ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair),
+	).Keys())
+}
+
+func TestPrunePriceSnapshots(t *testing.T) {
+	input := CreateTestFixture(t)
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	eth := asset.NewPair(denoms.ETH, denoms.NUSD)
+
+	params := types.DefaultParams()
+	params.TwapLookbackWindow = 10 * time.Minute
+	params.SnapshotRetentionWindows = 2
+	require.NoError(t, params.Validate())
+	require.Equal(t, 20*time.Minute, params.SnapshotRetention())
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	// 30 snapshots per pair, one per minute
+	ctx := setPrices(input, input.Ctx, btc, 30)
+	setPrices(input, input.Ctx, eth, 30)
+
+	// Snapshots from the first 9 minutes are older than 20 minutes.
+	pruned := input.OracleKeeper.PrunePriceSnapshots(ctx, params, 5)
+	require.Equal(t, 5, pruned, "pruning is bounded by the limit")
+	require.Equal(t, 25, countPriceSnapshots(input, ctx, btc))
+	require.Equal(t, 30, countPriceSnapshots(input, ctx, eth))
+
+	pruned = input.OracleKeeper.PrunePriceSnapshots(ctx, params, MaxPriceSnapshotsPrunedPerBlock)
+	require.Equal(t, 13, pruned)
+	require.Equal(t, 21, countPriceSnapshots(input, ctx, btc))
+	require.Equal(t, 21, countPriceSnapshots(input, ctx, eth))
+
+	require.Zero(t, input.OracleKeeper.PrunePriceSnapshots(ctx, params, MaxPriceSnapshotsPrunedPerBlock))
+
+	// The TWAP only depends on retained snapshots
+	twap, err := input.OracleKeeper.GetExchangeRateTwap(ctx, btc)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("24.5"), twap)
+
+	// A zero retention disables pruning
+	params.SnapshotRetentionWindows = 0
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	require.Zero(t, input.OracleKeeper.PrunePriceSnapshots(ctx, params, MaxPriceSnapshotsPrunedPerBlock))
+	require.Equal(t, 21, countPriceSnapshots(input, ctx, btc))
+}
+
+func TestQueryPriceHistory(t *testing.T) {
+	input := CreateTestFixture(t)
+	querier := NewQuerier(input.OracleKeeper)
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+
+	ctx := setPrices(input, input.Ctx, btc, 5)
+	setPrices(input, input.Ctx, asset.NewPair(denoms.ETH, denoms.NUSD), 3)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := querier.PriceHistory(goCtx, &types.QueryPriceHistoryRequest{})
+	require.Error(t, err)
+
+	res, err := querier.PriceHistory(goCtx, &types.QueryPriceHistoryRequest{
+		Pair:       btc,
+		Pagination: &sdkquery.PageRequest{Limit: 3, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 3)
+	require.EqualValues(t, 5, res.Pagination.Total)
+	for i, snapshot := range res.Snapshots {
+		require.Equal(t, btc, snapshot.Pair)
+		require.Equal(t, sdkmath.LegacyNewDec(int64(i+1)), snapshot.Price)
+		require.Equal(t, input.Ctx.BlockTime().Add(time.Duration(i)*time.Minute).UnixMilli(), snapshot.TimestampMs)
+	}
+
+	res, err = querier.PriceHistory(goCtx, &types.QueryPriceHistoryRequest{
+		Pair:       btc,
+		Pagination: &sdkquery.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 2)
+	require.Equal(t, sdkmath.LegacyNewDec(4), res.Snapshots[0].Price)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// Most recent first
+	res, err = querier.PriceHistory(goCtx, &types.QueryPriceHistoryRequest{
+		Pair:       btc,
+		Pagination: &sdkquery.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 1)
+	require.Equal(t, sdkmath.LegacyNewDec(5), res.Snapshots[0].Price)
+}
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/v2/x/common/asset"
@@ -17,7 +20,8 @@ func (k Keeper) UpdateExchangeRates(ctx sdk.Context) types.ValidatorPerformances
 
 	pairVotes := k.getPairVotes(ctx, validatorPerformances, whitelistedPairs)
 
-	k.tallyVotesAndUpdatePrices(ctx, pairVotes, validatorPerformances)
+	talliedPrices := k.tallyVotesAndUpdatePrices(ctx, pairVotes, whitelistedPairs, validatorPerformances)
+	k.updateDerivedPrices(ctx, talliedPrices)
 
 	k.incrementMissCounters(ctx, whitelistedPairs, validatorPerformances)
 	k.incrementAbstainsByOmission(ctx, len(whitelistedPairs), validatorPerformances)
@@ -75,19 +79,74 @@ func (k Keeper) incrementAbstainsByOmission(
 	}
 }
 
-// tallyVotesAndUpdatePrices processes the votes and updates the ExchangeRates based on the results.
+// tallyVotesAndUpdatePrices processes the votes and updates the ExchangeRates
+// based on the results. It returns the tallied exchange rate of each pair.
+//
+// ALERT: Pairs whose ballot is rejected by their aggregation strategy are
+// removed from whitelistedPairs, so that their voters aren't penalized.
 func (k Keeper) tallyVotesAndUpdatePrices(
 	ctx sdk.Context,
 	pairVotes map[asset.Pair]types.ExchangeRateVotes,
+	whitelistedPairs set.Set[asset.Pair],
 	validatorPerformances types.ValidatorPerformances,
-) {
-	rewardBand := k.RewardBand(ctx)
+) (talliedPrices map[asset.Pair]sdkmath.LegacyDec) {
+	params, _ := k.Params.Get(ctx)
+	rewardBand := params.RewardBand
+	talliedPrices = make(map[asset.Pair]sdkmath.LegacyDec, len(pairVotes))
 	// Iterate through sorted keys for deterministic ordering.
 	orderedPairVotes := omap.SortedMap_Pair[types.ExchangeRateVotes](pairVotes)
 	for pair := range orderedPairVotes.Range() {
-		exchangeRate := Tally(pairVotes[pair], rewardBand, validatorPerformances)
+		missesBefore := totalMissCount(validatorPerformances)
+		exchangeRate, err := TallyWithStrategy(
+			pairVotes[pair], params.AggregationStrategy(pair), rewardBand, validatorPerformances)
+		pairLabels := []metrics.Label{telemetry.NewLabel("pair", pair.String())}
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, "votes"}, float32(len(pairVotes[pair])), pairLabels)
+		if err != nil {
+			k.Logger(ctx).Info("ballot rejected", "pair", pair, "error", err)
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, "ballot", "rejected"}, 1, pairLabels)
+			delete(whitelistedPairs, pair)
+			continue
+		}
 		k.SetPrice(ctx, pair, exchangeRate)
+		k.recordVoteAccuracies(ctx, pairVotes[pair], exchangeRate, rewardBand)
+		talliedPrices[pair] = exchangeRate
+		// Misses are labeled by pair only. The misses of each validator are in
+		// the "MissCounters" state and the vote accuracy queries.
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "miss"},
+			float32(totalMissCount(validatorPerformances)-missesBefore),
+			pairLabels,
+		)
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, "price", "deviation"},
+			priceDeviation(pairVotes[pair], exchangeRate),
+			pairLabels,
+		)
+	}
+	return talliedPrices
+}
+
+// totalMissCount returns the sum of the miss counts of the validators.
+func totalMissCount(validatorPerformances types.ValidatorPerformances) (total int64) {
+	for _, validatorPerformance := range validatorPerformances {
+		total += validatorPerformance.MissCount
+	}
+	return total
+}
+
+// priceDeviation returns the standard deviation of the votes around the
+// tallied exchange rate, relative to the exchange rate.
+func priceDeviation(votes types.ExchangeRateVotes, exchangeRate sdkmath.LegacyDec) float32 {
+	if !exchangeRate.IsPositive() {
+		return 0
+	}
+	deviation, err := votes.StandardDeviation(exchangeRate).Quo(exchangeRate).Float64()
+	if err != nil {
+		return 0
 	}
+	return float32(deviation)
 }
 
 // getPairVotes returns a map of pairs and votes excluding abstained votes and
@@ -488,3 +488,40 @@ func TestWhitelistedPairs(t *testing.T) {
 	assert.EqualValues(t, 1, perf.AbstainCount)
 	assert.EqualValues(t, 0, perf.MissCount)
 }
+
+func TestPairAggregations(t *testing.T) {
+	fixture, msgServer := Setup(t)
+	params, err := fixture.OracleKeeper.Params.Get(fixture.Ctx)
+	require.NoError(t, err)
+
+	boundedMedian := types.NewPairAggregation(
+		asset.PAIR_ATOM, types.AGGREGATION_METHOD_WEIGHTED_MEDIAN, sdkmath.LegacyZeroDec())
+	boundedMedian.MaxStdDevRatio = sdkmath.LegacyNewDecWithPrec(1, 1)
+	params.PairAggregations = []types.PairAggregation{
+		types.NewPairAggregation(
+			asset.PAIR_ETH, types.AGGREGATION_METHOD_TRIMMED_MEAN, sdkmath.LegacyNewDecWithPrec(25, 2)),
+		boundedMedian,
+	}
+	fixture.OracleKeeper.Params.Set(fixture.Ctx, params)
+
+	for val, rates := range [][2]int64{{100, 10}, {100, 10}, {104, 10}, {200, 20}} {
+		MakeAggregatePrevoteAndVote(t, fixture, msgServer, 0, types.ExchangeRateTuples{
+			{Pair: asset.PAIR_ETH, ExchangeRate: sdkmath.LegacyNewDec(rates[0])},
+			{Pair: asset.PAIR_ATOM, ExchangeRate: sdkmath.LegacyNewDec(rates[1])},
+		}, val)
+	}
+	performances := fixture.OracleKeeper.UpdateExchangeRates(fixture.Ctx)
+
+	// The trimmed mean drops the lowest and highest of the four equal votes.
+	ethRate, err := fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, asset.PAIR_ETH)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(102), ethRate.ExchangeRate)
+
+	// The standard deviation of the atom votes is 5, above 10% of the median,
+	// so the ballot is rejected without penalizing its voters.
+	_, err = fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, asset.PAIR_ATOM)
+	require.Error(t, err)
+	for val := range 3 {
+		require.Zero(t, performances[ValAddrs[val].String()].MissCount)
+	}
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+// voteAccuraciesNamespace is the store namespace of [Keeper.VoteAccuracies].
+const voteAccuraciesNamespace collections.Namespace = 13
+
+// CurrentSlashWindow returns the index of the slash window of the current
+// block.
+func (k Keeper) CurrentSlashWindow(ctx sdk.Context) uint64 {
+	return types.SlashWindowIndex(ctx.BlockHeight(), k.SlashWindow(ctx))
+}
+
+// recordVoteAccuracies updates the vote accuracy records of the current slash
+// window with the tallied votes of a pair.
+func (k Keeper) recordVoteAccuracies(
+	ctx sdk.Context,
+	votes types.ExchangeRateVotes,
+	exchangeRate sdkmath.LegacyDec,
+	rewardBand sdkmath.LegacyDec,
+) {
+	slashWindow := k.CurrentSlashWindow(ctx)
+	rewardSpread := tallyRewardSpread(votes, exchangeRate, rewardBand)
+	for _, vote := range votes {
+		key := collections.Join(vote.Voter, collections.Join(slashWindow, vote.Pair))
+		accuracy := k.VoteAccuracies.GetOr(ctx, key,
+			types.NewVoteAccuracy(vote.Voter, vote.Pair, slashWindow))
+		accuracy.AddVote(
+			vote.ExchangeRate, exchangeRate,
+			isInsideRewardSpread(vote.ExchangeRate, exchangeRate, rewardSpread),
+		)
+		k.VoteAccuracies.Insert(ctx, key, accuracy)
+	}
+}
+
+// PruneVoteAccuracies deletes the vote accuracy records of the slash windows
+// that are older than the current one and the
+// [types.Params.VoteAccuracyRetentionWindows] completed ones before it. It's
+// supposed to be executed at the last block of a slash window.
+func (k Keeper) PruneVoteAccuracies(ctx sdk.Context) (pruned int) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0
+	}
+	currentSlashWindow := k.CurrentSlashWindow(ctx)
+	if currentSlashWindow <= params.VoteAccuracyRetentionWindows {
+		return 0
+	}
+	oldestRetained := currentSlashWindow - params.VoteAccuracyRetentionWindows
+
+	iter := k.VoteAccuracies.Iterate(ctx,
+		collections.PairRange[sdk.ValAddress, collections.Pair[uint64, asset.Pair]]{})
+	var expired []collections.Pair[sdk.ValAddress, collections.Pair[uint64, asset.Pair]]
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if key.K2().K1() < oldestRetained {
+			expired = append(expired, key)
+		}
+	}
+	iter.Close()
+
+	for _, key := range expired {
+		if err := k.VoteAccuracies.Delete(ctx, key); err != nil {
+			k.Logger(ctx).Error("failed to delete vote accuracy", "error", err)
+			continue
+		}
+		pruned++
+	}
+	return pruned
+}
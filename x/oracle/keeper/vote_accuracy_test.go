@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+func TestRecordVoteAccuracies(t *testing.T) {
+	fixture, msgServer := Setup(t)
+	fixture.Ctx = fixture.Ctx.WithBlockHeight(250)
+
+	for val, rate := range []int64{100, 100, 100, 110} {
+		MakeAggregatePrevoteAndVote(t, fixture, msgServer, 250, types.ExchangeRateTuples{
+			{Pair: asset.PAIR_ETH, ExchangeRate: sdkmath.LegacyNewDec(rate)},
+			{Pair: asset.PAIR_ATOM, ExchangeRate: sdkmath.LegacyNewDec(10)},
+		}, val)
+	}
+	fixture.OracleKeeper.UpdateExchangeRates(fixture.Ctx)
+
+	// The slash window of the test fixture is 100 blocks.
+	slashWindow := fixture.OracleKeeper.CurrentSlashWindow(fixture.Ctx)
+	require.EqualValues(t, 2, slashWindow)
+
+	querier := NewQuerier(fixture.OracleKeeper)
+	resp, err := querier.ValidatorAccuracy(sdk.WrapSDKContext(fixture.Ctx),
+		&types.QueryValidatorAccuracyRequest{ValidatorAddr: ValAddrs[3].String()})
+	require.NoError(t, err)
+	require.EqualValues(t, slashWindow, resp.CurrentSlashWindow)
+	require.Len(t, resp.Accuracies, 2)
+
+	atomAccuracy, ethAccuracy := resp.Accuracies[0], resp.Accuracies[1]
+	require.Equal(t, asset.PAIR_ATOM, atomAccuracy.Pair)
+	require.EqualValues(t, 1, atomAccuracy.WinCount)
+	require.Equal(t, sdkmath.LegacyZeroDec(), atomAccuracy.SumDeviation)
+
+	require.Equal(t, asset.PAIR_ETH, ethAccuracy.Pair)
+	require.EqualValues(t, slashWindow, ethAccuracy.SlashWindow)
+	require.EqualValues(t, 1, ethAccuracy.VoteCount)
+	require.EqualValues(t, 0, ethAccuracy.WinCount)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.1"), ethAccuracy.SumDeviation)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.1"), ethAccuracy.MaxDeviation)
+
+	accuraciesResp, err := querier.VoteAccuracies(sdk.WrapSDKContext(fixture.Ctx),
+		&types.QueryVoteAccuraciesRequest{Pair: asset.PAIR_ETH.String()})
+	require.NoError(t, err)
+	require.EqualValues(t, slashWindow, accuraciesResp.SlashWindow)
+	require.Len(t, accuraciesResp.Accuracies, 4)
+	for _, accuracy := range accuraciesResp.Accuracies {
+		require.Equal(t, asset.PAIR_ETH, accuracy.Pair)
+	}
+
+	accuraciesResp, err = querier.VoteAccuracies(sdk.WrapSDKContext(fixture.Ctx),
+		&types.QueryVoteAccuraciesRequest{SlashWindow: slashWindow + 1})
+	require.NoError(t, err)
+	require.Empty(t, accuraciesResp.Accuracies)
+
+	// The records of the slash window are paginated.
+	var paginated []types.VoteAccuracy
+	pageReq := &query.PageRequest{Limit: 3}
+	for {
+		accuraciesResp, err = querier.VoteAccuracies(sdk.WrapSDKContext(fixture.Ctx),
+			&types.QueryVoteAccuraciesRequest{Pagination: pageReq})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(accuraciesResp.Accuracies), 3)
+		paginated = append(paginated, accuraciesResp.Accuracies...)
+		if len(accuraciesResp.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: accuraciesResp.Pagination.NextKey, Limit: 3}
+	}
+	require.Len(t, paginated, 8)
+}
+
+func TestPruneVoteAccuracies(t *testing.T) {
+	input := CreateTestFixture(t)
+	slashWindowBlocks := input.OracleKeeper.SlashWindow(input.Ctx)
+
+	for slashWindow := uint64(0); slashWindow < 6; slashWindow++ {
+		input.OracleKeeper.VoteAccuracies.Insert(input.Ctx,
+			collections.Join(ValAddrs[0], collections.Join(slashWindow, asset.PAIR_BTC)),
+			types.NewVoteAccuracy(ValAddrs[0], asset.PAIR_BTC, slashWindow))
+	}
+
+	retainedSlashWindows := func(ctx sdk.Context) (retained []uint64) {
+		for _, accuracy := range input.OracleKeeper.VoteAccuracies.Iterate(ctx,
+			collections.PairRange[sdk.ValAddress, collections.Pair[uint64, asset.Pair]]{}).Values() {
+			retained = append(retained, accuracy.SlashWindow)
+		}
+		return retained
+	}
+
+	// last block of the slash window 5, keeping 4 completed slash windows
+	ctx := input.Ctx.WithBlockHeight(int64(6*slashWindowBlocks) - 1)
+	params, err := input.OracleKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, params.VoteAccuracyRetentionWindows)
+	require.Equal(t, 1, input.OracleKeeper.PruneVoteAccuracies(ctx))
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, retainedSlashWindows(ctx))
+
+	// keeping only the current slash window
+	params.VoteAccuracyRetentionWindows = 0
+	input.OracleKeeper.Params.Set(ctx, params)
+	require.Equal(t, 4, input.OracleKeeper.PruneVoteAccuracies(ctx))
+	require.Equal(t, []uint64{5}, retainedSlashWindows(ctx))
+}
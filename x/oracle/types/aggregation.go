@@ -0,0 +1,214 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+)
+
+// AggregationStrategy computes the exchange rate of a pair from the votes of
+// a ballot that passed the vote threshold. Implementations must be
+// deterministic and must not depend on the order of the votes.
+//
+// A volume-weighted average price (VWAP) is out of scope: votes only carry an
+// exchange rate, so there is no volume to weight them by.
+type AggregationStrategy interface {
+	// Aggregate returns the exchange rate of the ballot, or an error wrapping
+	// ErrBallotRejected if no exchange rate should be set for the vote period.
+	// Abstain votes have zero power and don't contribute to the exchange rate.
+	Aggregate(votes ExchangeRateVotes) (sdkmath.LegacyDec, error)
+}
+
+var (
+	_ AggregationStrategy = WeightedMedianStrategy{}
+	_ AggregationStrategy = TrimmedMeanStrategy{}
+	_ AggregationStrategy = WeightedMeanStrategy{}
+	_ AggregationStrategy = MaxStdDevStrategy{}
+)
+
+// WeightedMedianStrategy aggregates the votes into their median weighted by
+// voting power. It's the default strategy of every pair.
+type WeightedMedianStrategy struct{}
+
+func (WeightedMedianStrategy) Aggregate(votes ExchangeRateVotes) (sdkmath.LegacyDec, error) {
+	return votes.WeightedMedianWithAssertion(), nil
+}
+
+// TrimmedMeanStrategy aggregates the votes into their mean weighted by voting
+// power, after discarding TrimFraction of the total voting power at each end
+// of the sorted votes. A vote that straddles a cut-off contributes only its
+// power inside the kept range.
+type TrimmedMeanStrategy struct {
+	TrimFraction sdkmath.LegacyDec
+}
+
+func (s TrimmedMeanStrategy) Aggregate(votes ExchangeRateVotes) (sdkmath.LegacyDec, error) {
+	votes = sortedPositiveVotes(votes)
+	totalPower := sdkmath.LegacyNewDec(votes.Power())
+	if !totalPower.IsPositive() {
+		return sdkmath.LegacyZeroDec(), nil
+	}
+
+	trimmedPower := totalPower.Mul(s.TrimFraction)
+	lower, upper := trimmedPower, totalPower.Sub(trimmedPower)
+	keptPower := upper.Sub(lower)
+	if !keptPower.IsPositive() {
+		return votes.WeightedMedian(), nil
+	}
+
+	sum := sdkmath.LegacyZeroDec()
+	cumulative := sdkmath.LegacyZeroDec()
+	for _, vote := range votes {
+		start := cumulative
+		cumulative = cumulative.Add(sdkmath.LegacyNewDec(vote.Power))
+		overlap := sdkmath.LegacyMinDec(cumulative, upper).Sub(sdkmath.LegacyMaxDec(start, lower))
+		if overlap.IsPositive() {
+			sum = sum.Add(vote.ExchangeRate.Mul(overlap))
+		}
+	}
+	return sum.Quo(keptPower), nil
+}
+
+// WeightedMeanStrategy aggregates the votes into their mean weighted by voting
+// power. Unlike the median, a single voter can move the mean by an amount
+// proportional to its share of the voting power.
+type WeightedMeanStrategy struct{}
+
+func (WeightedMeanStrategy) Aggregate(votes ExchangeRateVotes) (sdkmath.LegacyDec, error) {
+	votes = sortedPositiveVotes(votes)
+	totalPower := votes.Power()
+	if totalPower <= 0 {
+		return sdkmath.LegacyZeroDec(), nil
+	}
+
+	sum := sdkmath.LegacyZeroDec()
+	for _, vote := range votes {
+		sum = sum.Add(vote.ExchangeRate.MulInt64(vote.Power))
+	}
+	return sum.QuoInt64(totalPower), nil
+}
+
+// MaxStdDevStrategy wraps a strategy and rejects the ballot when the standard
+// deviation of the votes around the aggregated exchange rate is larger than
+// MaxStdDevRatio times the exchange rate.
+type MaxStdDevStrategy struct {
+	Strategy       AggregationStrategy
+	MaxStdDevRatio sdkmath.LegacyDec
+}
+
+func (s MaxStdDevStrategy) Aggregate(votes ExchangeRateVotes) (sdkmath.LegacyDec, error) {
+	exchangeRate, err := s.Strategy.Aggregate(votes)
+	if err != nil {
+		return exchangeRate, err
+	}
+
+	maxStdDev := exchangeRate.Mul(s.MaxStdDevRatio)
+	if stdDev := votes.StandardDeviation(exchangeRate); stdDev.GT(maxStdDev) {
+		return sdkmath.LegacyDec{}, ErrBallotRejected.Wrapf(
+			"standard deviation %s exceeds %s", stdDev, maxStdDev)
+	}
+	return exchangeRate, nil
+}
+
+// sortedPositiveVotes returns a sorted copy of the votes that have a positive
+// exchange rate and voting power.
+func sortedPositiveVotes(votes ExchangeRateVotes) ExchangeRateVotes {
+	positiveVotes := make(ExchangeRateVotes, 0, len(votes))
+	for _, vote := range votes {
+		if vote.ExchangeRate.IsPositive() && vote.Power > 0 {
+			positiveVotes = append(positiveVotes, vote)
+		}
+	}
+	sort.SliceStable(positiveVotes, func(i, j int) bool {
+		if !positiveVotes[i].ExchangeRate.Equal(positiveVotes[j].ExchangeRate) {
+			return positiveVotes[i].ExchangeRate.LT(positiveVotes[j].ExchangeRate)
+		}
+		return positiveVotes[i].Voter.String() < positiveVotes[j].Voter.String()
+	})
+	return positiveVotes
+}
+
+// NewPairAggregation returns a [PairAggregation] without a standard deviation
+// bound.
+func NewPairAggregation(
+	pair asset.Pair, method AggregationMethod, trimFraction sdkmath.LegacyDec,
+) PairAggregation {
+	return PairAggregation{
+		Pair:           pair,
+		Method:         method,
+		TrimFraction:   trimFraction,
+		MaxStdDevRatio: sdkmath.LegacyZeroDec(),
+	}
+}
+
+// IsDefault returns true if the pair aggregation is the default strategy of
+// every pair, a weighted median without a standard deviation bound.
+func (pa PairAggregation) IsDefault() bool {
+	return pa.Method == AGGREGATION_METHOD_WEIGHTED_MEDIAN &&
+		(pa.TrimFraction.IsNil() || pa.TrimFraction.IsZero()) &&
+		(pa.MaxStdDevRatio.IsNil() || pa.MaxStdDevRatio.IsZero())
+}
+
+// Strategy returns the [AggregationStrategy] of the pair aggregation.
+func (pa PairAggregation) Strategy() AggregationStrategy {
+	var strategy AggregationStrategy
+	switch pa.Method {
+	case AGGREGATION_METHOD_TRIMMED_MEAN:
+		strategy = TrimmedMeanStrategy{TrimFraction: pa.TrimFraction}
+	case AGGREGATION_METHOD_WEIGHTED_MEAN:
+		strategy = WeightedMeanStrategy{}
+	default:
+		strategy = WeightedMedianStrategy{}
+	}
+
+	if !pa.MaxStdDevRatio.IsNil() && pa.MaxStdDevRatio.IsPositive() {
+		strategy = MaxStdDevStrategy{Strategy: strategy, MaxStdDevRatio: pa.MaxStdDevRatio}
+	}
+	return strategy
+}
+
+// Validate returns an error if the pair aggregation is invalid.
+func (pa PairAggregation) Validate() error {
+	if err := pa.Pair.Validate(); err != nil {
+		return err
+	}
+	if _, known := AggregationMethod_name[int32(pa.Method)]; !known {
+		return fmt.Errorf("unknown aggregation method %d for pair %s", pa.Method, pa.Pair)
+	}
+	if pa.TrimFraction.IsNil() || pa.TrimFraction.IsNegative() ||
+		pa.TrimFraction.GTE(sdkmath.LegacyNewDecWithPrec(5, 1)) {
+		return fmt.Errorf("trim fraction of pair %s must be in [0, 0.5)", pa.Pair)
+	}
+	if pa.MaxStdDevRatio.IsNil() || pa.MaxStdDevRatio.IsNegative() {
+		return fmt.Errorf("max std dev ratio of pair %s must not be negative", pa.Pair)
+	}
+	return nil
+}
+
+// ParseAggregationMethod parses an aggregation method from its name, with or
+// without the "AGGREGATION_METHOD_" prefix, e.g. "trimmed_mean".
+func ParseAggregationMethod(s string) (AggregationMethod, error) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	if !strings.HasPrefix(name, "AGGREGATION_METHOD_") {
+		name = "AGGREGATION_METHOD_" + name
+	}
+	method, ok := AggregationMethod_value[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown aggregation method %q", s)
+	}
+	return AggregationMethod(method), nil
+}
+
+// AggregationStrategy returns the aggregation strategy of the votes of a pair.
+func (p Params) AggregationStrategy(pair asset.Pair) AggregationStrategy {
+	for _, pairAggregation := range p.PairAggregations {
+		if pairAggregation.Pair == pair {
+			return pairAggregation.Strategy()
+		}
+	}
+	return WeightedMedianStrategy{}
+}
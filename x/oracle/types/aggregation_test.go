@@ -0,0 +1,266 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+// tolerance absorbs the rounding of the 18 decimal places of sdkmath.LegacyDec.
+var tolerance = sdkmath.LegacyNewDecWithPrec(1, 12)
+
+// drawVotes draws n votes with exchange rates in [minRate, maxRate] milli
+// units and powers in [1, 100].
+func drawVotes(rt *rapid.T, label string, n int, minRate, maxRate int64) types.ExchangeRateVotes {
+	votes := make(types.ExchangeRateVotes, n)
+	for i := range votes {
+		rate := rapid.Int64Range(minRate, maxRate).Draw(rt, fmt.Sprintf("%s_rate_%d", label, i))
+		power := rapid.Int64Range(1, 100).Draw(rt, fmt.Sprintf("%s_power_%d", label, i))
+		votes[i] = types.NewExchangeRateVote(
+			sdkmath.LegacyNewDecWithPrec(rate, 3),
+			asset.PAIR_BTC,
+			sdk.ValAddress(fmt.Sprintf("%s-%d", label, i)),
+			power,
+		)
+	}
+	return votes
+}
+
+func drawStrategy(rt *rapid.T) types.AggregationStrategy {
+	return rapid.SampledFrom([]types.AggregationStrategy{
+		types.WeightedMedianStrategy{},
+		types.WeightedMeanStrategy{},
+		types.TrimmedMeanStrategy{TrimFraction: sdkmath.LegacyNewDecWithPrec(1, 1)},
+		types.TrimmedMeanStrategy{TrimFraction: sdkmath.LegacyNewDecWithPrec(25, 2)},
+	}).Draw(rt, "strategy")
+}
+
+// honestRange returns the lowest and highest exchange rates of the votes.
+func honestRange(votes types.ExchangeRateVotes) (low, high sdkmath.LegacyDec) {
+	low, high = votes[0].ExchangeRate, votes[0].ExchangeRate
+	for _, vote := range votes {
+		low = sdkmath.LegacyMinDec(low, vote.ExchangeRate)
+		high = sdkmath.LegacyMaxDec(high, vote.ExchangeRate)
+	}
+	return low, high
+}
+
+func requireInRange(rt *rapid.T, rate, low, high sdkmath.LegacyDec) {
+	if rate.LT(low.Sub(tolerance)) || rate.GT(high.Add(tolerance)) {
+		rt.Fatalf("exchange rate %s outside of the honest range [%s, %s]", rate, low, high)
+	}
+}
+
+func TestAggregationStrategyDeterminism(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		strategy := drawStrategy(rt)
+		votes := drawVotes(rt, "voter", rapid.IntRange(1, 20).Draw(rt, "n"), 1, 1_000_000)
+		permutation := rapid.Permutation(votes).Draw(rt, "permutation")
+
+		original := make(types.ExchangeRateVotes, len(votes))
+		copy(original, votes)
+		want, err := strategy.Aggregate(original)
+		require.NoError(rt, err)
+
+		again := make(types.ExchangeRateVotes, len(votes))
+		copy(again, votes)
+		got, err := strategy.Aggregate(again)
+		require.NoError(rt, err)
+		require.Equal(rt, want, got, "same votes")
+
+		got, err = strategy.Aggregate(permutation)
+		require.NoError(rt, err)
+		require.Equal(rt, want, got, "permuted votes")
+
+		low, high := honestRange(votes)
+		requireInRange(rt, want, low, high)
+	})
+}
+
+// The mean-based strategies ignore abstain votes, which have zero power.
+func TestMeanStrategiesIgnoreAbstainVotes(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		strategy := rapid.SampledFrom([]types.AggregationStrategy{
+			types.WeightedMeanStrategy{},
+			types.TrimmedMeanStrategy{TrimFraction: sdkmath.LegacyNewDecWithPrec(1, 1)},
+		}).Draw(rt, "strategy")
+		votes := drawVotes(rt, "voter", rapid.IntRange(1, 20).Draw(rt, "n"), 1, 1_000_000)
+
+		want, err := strategy.Aggregate(append(types.ExchangeRateVotes{}, votes...))
+		require.NoError(rt, err)
+
+		withAbstains := append(types.ExchangeRateVotes{}, votes...)
+		for i := range rapid.IntRange(1, 5).Draw(rt, "abstains") {
+			withAbstains = append(withAbstains, types.NewExchangeRateVote(
+				sdkmath.LegacyZeroDec(), asset.PAIR_BTC, sdk.ValAddress(fmt.Sprintf("abstain-%d", i)), 0))
+		}
+		got, err := strategy.Aggregate(withAbstains)
+		require.NoError(rt, err)
+		require.Equal(rt, want, got)
+	})
+}
+
+// Voters with less than half of the voting power can't move the weighted
+// median outside the range of the other votes.
+func TestWeightedMedianManipulationResistance(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		honest := drawVotes(rt, "honest", rapid.IntRange(1, 15).Draw(rt, "honest_n"), 1_000, 2_000)
+		honestPower := honest.Power()
+		if honestPower < 3 {
+			rt.Skip("not enough honest voting power")
+		}
+
+		// The weighted median picks the first vote past half of the total
+		// power, so the attackers need strictly less than that.
+		maxAttackerPower := honestPower - 2
+		attackerPower := rapid.Int64Range(1, maxAttackerPower).Draw(rt, "attacker_power")
+		attackRate := rapid.SampledFrom([]int64{1, 1_000_000_000}).Draw(rt, "attack_rate")
+		attacker := types.NewExchangeRateVote(
+			sdkmath.LegacyNewDecWithPrec(attackRate, 3), asset.PAIR_BTC, sdk.ValAddress("attacker"), attackerPower)
+
+		rate, err := types.WeightedMedianStrategy{}.Aggregate(append(honest, attacker))
+		require.NoError(rt, err)
+
+		low, high := honestRange(honest)
+		requireInRange(rt, rate, low, high)
+	})
+}
+
+// Voters with at most the trim fraction of the voting power can't move the
+// trimmed mean outside the range of the other votes, even when they split their
+// votes between both extremes.
+func TestTrimmedMeanManipulationResistance(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		trimPercent := rapid.Int64Range(1, 49).Draw(rt, "trim_percent")
+		strategy := types.TrimmedMeanStrategy{TrimFraction: sdkmath.LegacyNewDecWithPrec(trimPercent, 2)}
+
+		honest := drawVotes(rt, "honest", rapid.IntRange(1, 15).Draw(rt, "honest_n"), 1_000, 2_000)
+		// attackerPower <= trim * (attackerPower + honestPower)
+		maxAttackerPower := trimPercent * honest.Power() / (100 - trimPercent)
+		if maxAttackerPower < 1 {
+			rt.Skip("trim fraction too small for a single unit of voting power")
+		}
+		attackerPower := rapid.Int64Range(1, maxAttackerPower).Draw(rt, "attacker_power")
+		lowPower := rapid.Int64Range(0, attackerPower).Draw(rt, "attacker_low_power")
+
+		votes := append(types.ExchangeRateVotes{}, honest...)
+		if lowPower > 0 {
+			votes = append(votes, types.NewExchangeRateVote(
+				sdkmath.LegacyNewDecWithPrec(1, 3), asset.PAIR_BTC, sdk.ValAddress("attacker-low"), lowPower))
+		}
+		if highPower := attackerPower - lowPower; highPower > 0 {
+			votes = append(votes, types.NewExchangeRateVote(
+				sdkmath.LegacyNewDec(1_000_000), asset.PAIR_BTC, sdk.ValAddress("attacker-high"), highPower))
+		}
+
+		rate, err := strategy.Aggregate(votes)
+		require.NoError(rt, err)
+
+		low, high := honestRange(honest)
+		requireInRange(rt, rate, low, high)
+	})
+}
+
+// A voter with a fraction f of the voting power moves the weighted mean by
+// exactly f times its distance to the mean of the other votes.
+func TestWeightedMeanManipulationBound(t *testing.T) {
+	rapid.Check(t, func(rt *rapid.T) {
+		honest := drawVotes(rt, "honest", rapid.IntRange(1, 15).Draw(rt, "honest_n"), 1_000, 2_000)
+		honestMean, err := types.WeightedMeanStrategy{}.Aggregate(honest)
+		require.NoError(rt, err)
+
+		attackerPower := rapid.Int64Range(1, 1_000).Draw(rt, "attacker_power")
+		attackRate := sdkmath.LegacyNewDec(rapid.Int64Range(1, 1_000_000).Draw(rt, "attack_rate"))
+		attacker := types.NewExchangeRateVote(attackRate, asset.PAIR_BTC, sdk.ValAddress("attacker"), attackerPower)
+
+		rate, err := types.WeightedMeanStrategy{}.Aggregate(append(honest, attacker))
+		require.NoError(rt, err)
+
+		fraction := sdkmath.LegacyNewDec(attackerPower).QuoInt64(attackerPower + honest.Power())
+		wantShift := attackRate.Sub(honestMean).Mul(fraction)
+		if diff := rate.Sub(honestMean).Sub(wantShift).Abs(); diff.GT(tolerance) {
+			rt.Fatalf("weighted mean moved by %s, want %s", rate.Sub(honestMean), wantShift)
+		}
+	})
+}
+
+func TestTrimmedMeanStrategy(t *testing.T) {
+	votes := types.ExchangeRateVotes{
+		types.NewExchangeRateVote(sdkmath.LegacyNewDec(1), asset.PAIR_BTC, sdk.ValAddress("a"), 1),
+		types.NewExchangeRateVote(sdkmath.LegacyNewDec(10), asset.PAIR_BTC, sdk.ValAddress("b"), 1),
+		types.NewExchangeRateVote(sdkmath.LegacyNewDec(20), asset.PAIR_BTC, sdk.ValAddress("c"), 1),
+		types.NewExchangeRateVote(sdkmath.LegacyNewDec(1_000), asset.PAIR_BTC, sdk.ValAddress("d"), 1),
+	}
+
+	rate, err := types.TrimmedMeanStrategy{TrimFraction: sdkmath.LegacyNewDecWithPrec(25, 2)}.Aggregate(votes)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(15), rate)
+
+	// Half of the power of "a" and "d" is kept: (0.5*1 + 10 + 20 + 0.5*1000) / 3
+	rate, err = types.TrimmedMeanStrategy{TrimFraction: sdkmath.LegacyNewDecWithPrec(125, 3)}.Aggregate(votes)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("176.833333333333333333"), rate)
+
+	rate, err = types.TrimmedMeanStrategy{TrimFraction: sdkmath.LegacyZeroDec()}.Aggregate(votes)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("257.75"), rate)
+}
+
+func TestMaxStdDevStrategy(t *testing.T) {
+	votes := types.ExchangeRateVotes{
+		types.NewExchangeRateVote(sdkmath.LegacyNewDec(10), asset.PAIR_BTC, sdk.ValAddress("a"), 1),
+		types.NewExchangeRateVote(sdkmath.LegacyNewDec(10), asset.PAIR_BTC, sdk.ValAddress("b"), 1),
+		types.NewExchangeRateVote(sdkmath.LegacyNewDec(10), asset.PAIR_BTC, sdk.ValAddress("c"), 1),
+		types.NewExchangeRateVote(sdkmath.LegacyNewDec(20), asset.PAIR_BTC, sdk.ValAddress("d"), 1),
+	}
+
+	// The standard deviation around the median is 5.
+	strategy := types.MaxStdDevStrategy{
+		Strategy:       types.WeightedMedianStrategy{},
+		MaxStdDevRatio: sdkmath.LegacyNewDecWithPrec(5, 1),
+	}
+	rate, err := strategy.Aggregate(votes)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(10), rate)
+
+	strategy.MaxStdDevRatio = sdkmath.LegacyNewDecWithPrec(49, 2)
+	_, err = strategy.Aggregate(votes)
+	require.ErrorIs(t, err, types.ErrBallotRejected)
+}
+
+func TestPairAggregationStrategy(t *testing.T) {
+	params := types.DefaultParams()
+	require.Equal(t, types.WeightedMedianStrategy{}, params.AggregationStrategy(asset.PAIR_BTC))
+
+	trimmedMean := types.NewPairAggregation(
+		asset.PAIR_BTC, types.AGGREGATION_METHOD_TRIMMED_MEAN, sdkmath.LegacyNewDecWithPrec(1, 1))
+	params.PairAggregations = []types.PairAggregation{trimmedMean}
+	require.Equal(t,
+		types.TrimmedMeanStrategy{TrimFraction: sdkmath.LegacyNewDecWithPrec(1, 1)},
+		params.AggregationStrategy(asset.PAIR_BTC))
+	require.Equal(t, types.WeightedMedianStrategy{}, params.AggregationStrategy(asset.PAIR_ETH))
+
+	trimmedMean.MaxStdDevRatio = sdkmath.LegacyNewDecWithPrec(5, 2)
+	require.Equal(t,
+		types.MaxStdDevStrategy{
+			Strategy:       types.TrimmedMeanStrategy{TrimFraction: sdkmath.LegacyNewDecWithPrec(1, 1)},
+			MaxStdDevRatio: sdkmath.LegacyNewDecWithPrec(5, 2),
+		},
+		trimmedMean.Strategy())
+
+	method, err := types.ParseAggregationMethod("trimmed_mean")
+	require.NoError(t, err)
+	require.Equal(t, types.AGGREGATION_METHOD_TRIMMED_MEAN, method)
+	method, err = types.ParseAggregationMethod("AGGREGATION_METHOD_WEIGHTED_MEAN")
+	require.NoError(t, err)
+	require.Equal(t, types.AGGREGATION_METHOD_WEIGHTED_MEAN, method)
+	_, err = types.ParseAggregationMethod("vwap")
+	require.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+)
+
+// NewDerivedPair returns a [DerivedPair] computed from the given voted pairs.
+func NewDerivedPair(pair, basePair, quotePair asset.Pair) DerivedPair {
+	return DerivedPair{Pair: pair, BasePair: basePair, QuotePair: quotePair}
+}
+
+// Validate returns an error if the exchange rate of the derived pair can't be
+// computed from its base and quote pairs. The base pair must price the base
+// asset of the derived pair, the quote pair must price the quote asset of the
+// derived pair, and both must be priced in the same asset.
+func (dp DerivedPair) Validate() error {
+	for _, pair := range []asset.Pair{dp.Pair, dp.BasePair, dp.QuotePair} {
+		if err := pair.Validate(); err != nil {
+			return ErrInvalidDerivedPair.Wrap(err.Error())
+		}
+	}
+
+	switch {
+	case dp.Pair.BaseDenom() == dp.Pair.QuoteDenom():
+		return ErrInvalidDerivedPair.Wrapf("pair %s has the same base and quote asset", dp.Pair)
+	case dp.BasePair.BaseDenom() != dp.Pair.BaseDenom():
+		return ErrInvalidDerivedPair.Wrapf(
+			"base pair %s must price the base asset of %s", dp.BasePair, dp.Pair)
+	case dp.QuotePair.BaseDenom() != dp.Pair.QuoteDenom():
+		return ErrInvalidDerivedPair.Wrapf(
+			"quote pair %s must price the quote asset of %s", dp.QuotePair, dp.Pair)
+	case dp.BasePair.QuoteDenom() != dp.QuotePair.QuoteDenom():
+		return ErrInvalidDerivedPair.Wrapf(
+			"base pair %s and quote pair %s must have the same quote asset", dp.BasePair, dp.QuotePair)
+	case dp.BasePair.QuoteDenom() == dp.Pair.QuoteDenom():
+		return ErrInvalidDerivedPair.Wrapf(
+			"pair %s is the same as its base pair %s", dp.Pair, dp.BasePair)
+	}
+	return nil
+}
+
+// CrossRate returns the exchange rate of the derived pair given the exchange
+// rates of its base and quote pairs. The boolean is false if the cross rate
+// can't be computed because one of the exchange rates isn't positive.
+func (dp DerivedPair) CrossRate(baseRate, quoteRate sdkmath.LegacyDec) (sdkmath.LegacyDec, bool) {
+	if !baseRate.IsPositive() || !quoteRate.IsPositive() {
+		return sdkmath.LegacyDec{}, false
+	}
+	return baseRate.Quo(quoteRate), true
+}
@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+func TestDerivedPairValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		derivedPair types.DerivedPair
+		wantErr     string
+	}{
+		{
+			name:        "happy path",
+			derivedPair: types.NewDerivedPair("ueth:unibi", "ueth:uusd", "unibi:uusd"),
+		},
+		{
+			name:        "invalid pair",
+			derivedPair: types.NewDerivedPair("ueth", "ueth:uusd", "unibi:uusd"),
+			wantErr:     "invalid derived pair",
+		},
+		{
+			name:        "same base and quote asset",
+			derivedPair: types.NewDerivedPair("ueth:ueth", "ueth:uusd", "ueth:uusd"),
+			wantErr:     "same base and quote asset",
+		},
+		{
+			name:        "base pair prices another asset",
+			derivedPair: types.NewDerivedPair("ueth:unibi", "ubtc:uusd", "unibi:uusd"),
+			wantErr:     "must price the base asset",
+		},
+		{
+			name:        "quote pair prices another asset",
+			derivedPair: types.NewDerivedPair("ueth:unibi", "ueth:uusd", "ubtc:uusd"),
+			wantErr:     "must price the quote asset",
+		},
+		{
+			name:        "source pairs with different quote assets",
+			derivedPair: types.NewDerivedPair("ueth:unibi", "ueth:uusd", "unibi:uusdc"),
+			wantErr:     "must have the same quote asset",
+		},
+		{
+			name:        "derived from itself",
+			derivedPair: types.NewDerivedPair("ueth:unibi", "ueth:unibi", "unibi:unibi"),
+			wantErr:     "invalid derived pair",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.derivedPair.Validate()
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDerivedPairCrossRate(t *testing.T) {
+	derivedPair := types.NewDerivedPair("ueth:unibi", asset.Pair("ueth:uusd"), asset.Pair("unibi:uusd"))
+
+	crossRate, ok := derivedPair.CrossRate(sdkmath.LegacyNewDec(2_000), sdkmath.LegacyMustNewDecFromStr("0.5"))
+	require.True(t, ok)
+	require.Equal(t, sdkmath.LegacyNewDec(4_000), crossRate)
+
+	_, ok = derivedPair.CrossRate(sdkmath.LegacyNewDec(2_000), sdkmath.LegacyZeroDec())
+	require.False(t, ok)
+}
@@ -35,4 +35,6 @@ var (
 	ErrNoAggregateVote        = registerError("no aggregate vote")
 	ErrUnknownPair            = registerError("unknown pair")
 	ErrNoValidTWAP            = registerError("TWA price not found")
+	ErrInvalidDerivedPair     = registerError("invalid derived pair")
+	ErrBallotRejected         = registerError("ballot rejected by the aggregation strategy")
 )
@@ -348,52 +348,120 @@ func (m *EventValidatorPerformance) GetMissCount() int64 {
 	return 0
 }
 
+// Emitted when derived pairs are added, updated, or removed
+type EventEditDerivedPairs struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Derived pairs that were added or updated
+	Upserted []DerivedPair `protobuf:"bytes,2,rep,name=upserted,proto3" json:"upserted"`
+	// Derived pairs that were removed
+	Removed []string `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (m *EventEditDerivedPairs) Reset()         { *m = EventEditDerivedPairs{} }
+func (m *EventEditDerivedPairs) String() string { return proto.CompactTextString(m) }
+func (*EventEditDerivedPairs) ProtoMessage()    {}
+func (*EventEditDerivedPairs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94ec441b793fc0ea, []int{5}
+}
+func (m *EventEditDerivedPairs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEditDerivedPairs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEditDerivedPairs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEditDerivedPairs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEditDerivedPairs.Merge(m, src)
+}
+func (m *EventEditDerivedPairs) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEditDerivedPairs) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEditDerivedPairs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEditDerivedPairs proto.InternalMessageInfo
+
+func (m *EventEditDerivedPairs) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventEditDerivedPairs) GetUpserted() []DerivedPair {
+	if m != nil {
+		return m.Upserted
+	}
+	return nil
+}
+
+func (m *EventEditDerivedPairs) GetRemoved() []string {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventPriceUpdate)(nil), "nibiru.oracle.v1.EventPriceUpdate")
 	proto.RegisterType((*EventDelegateFeederConsent)(nil), "nibiru.oracle.v1.EventDelegateFeederConsent")
 	proto.RegisterType((*EventAggregateVote)(nil), "nibiru.oracle.v1.EventAggregateVote")
 	proto.RegisterType((*EventAggregatePrevote)(nil), "nibiru.oracle.v1.EventAggregatePrevote")
 	proto.RegisterType((*EventValidatorPerformance)(nil), "nibiru.oracle.v1.EventValidatorPerformance")
+	proto.RegisterType((*EventEditDerivedPairs)(nil), "nibiru.oracle.v1.EventEditDerivedPairs")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/event.proto", fileDescriptor_94ec441b793fc0ea) }
 
 var fileDescriptor_94ec441b793fc0ea = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6e, 0x13, 0x3b,
-	0x14, 0xcd, 0xbc, 0xb4, 0xd1, 0x8b, 0x1b, 0xa4, 0xca, 0x02, 0x94, 0xa6, 0xe9, 0xa4, 0x4d, 0x37,
-	0xd9, 0x30, 0x56, 0xca, 0x17, 0x90, 0xa4, 0x20, 0x24, 0x8a, 0xa2, 0x11, 0xb4, 0x12, 0x9b, 0xc8,
-	0x99, 0xdc, 0x3a, 0x16, 0x19, 0x7b, 0x64, 0x3b, 0x93, 0xf6, 0x2f, 0x10, 0xbf, 0xc0, 0x8e, 0x35,
-	0x1f, 0xd1, 0x65, 0xc5, 0x0a, 0xb1, 0x28, 0x28, 0xf9, 0x11, 0x34, 0xb6, 0x53, 0x04, 0x5d, 0x20,
-	0x75, 0x77, 0xef, 0x39, 0xe7, 0x9e, 0x39, 0xe3, 0xab, 0x8b, 0x9a, 0x82, 0x8f, 0xb9, 0x9a, 0x13,
-	0xa9, 0x68, 0x32, 0x03, 0x92, 0x77, 0x09, 0xe4, 0x20, 0x4c, 0x94, 0x29, 0x69, 0x24, 0xde, 0x76,
-	0x6c, 0xe4, 0xd8, 0x28, 0xef, 0x36, 0xf6, 0xee, 0xe8, 0x3d, 0x67, 0x07, 0x1a, 0x0f, 0x99, 0x64,
-	0xd2, 0x96, 0xa4, 0xa8, 0x3c, 0xda, 0x64, 0x52, 0xb2, 0x19, 0x10, 0x9a, 0x71, 0x42, 0x85, 0x90,
-	0x86, 0x1a, 0x2e, 0x85, 0xf6, 0xec, 0x4e, 0x22, 0x75, 0x2a, 0xf5, 0xc8, 0x8d, 0xb9, 0xc6, 0x51,
-	0xed, 0x8f, 0x01, 0xda, 0x3e, 0x2e, 0xf2, 0x0c, 0x15, 0x4f, 0xe0, 0x6d, 0x36, 0xa1, 0x06, 0x30,
-	0x46, 0x1b, 0x19, 0xe5, 0xaa, 0x1e, 0xec, 0x07, 0x9d, 0x6a, 0x6c, 0x6b, 0xfc, 0x02, 0x6d, 0x66,
-	0x85, 0xa4, 0xfe, 0x5f, 0x01, 0xf6, 0xba, 0x57, 0x37, 0xad, 0xd2, 0xf7, 0x9b, 0xd6, 0xae, 0x73,
-	0xd3, 0x93, 0xf7, 0x11, 0x97, 0x24, 0xa5, 0x66, 0x1a, 0xbd, 0x02, 0x46, 0x93, 0xcb, 0x01, 0x24,
-	0x5f, 0xbf, 0x3c, 0x41, 0xfe, 0x63, 0x03, 0x48, 0x62, 0x37, 0x8f, 0x0f, 0x50, 0xcd, 0xf0, 0x14,
-	0xb4, 0xa1, 0x69, 0x36, 0x4a, 0x75, 0xbd, 0xbc, 0x1f, 0x74, 0xca, 0xf1, 0xd6, 0x2d, 0x76, 0xa2,
-	0xdb, 0x31, 0x6a, 0xd8, 0x4c, 0x03, 0x98, 0x01, 0xa3, 0x06, 0x9e, 0x03, 0x4c, 0x40, 0xf5, 0xa5,
-	0xd0, 0x20, 0x0c, 0x6e, 0xa2, 0x6a, 0x4e, 0x67, 0x7c, 0x42, 0x8d, 0x5c, 0x47, 0xfc, 0x0d, 0xe0,
-	0xc7, 0xa8, 0x72, 0x6e, 0xe5, 0x2e, 0x68, 0xec, 0xbb, 0xf6, 0xa7, 0x00, 0x61, 0x6b, 0xfa, 0x8c,
-	0x31, 0x65, 0x5d, 0x4f, 0xa5, 0x81, 0xfb, 0x99, 0xe1, 0x33, 0x54, 0xb1, 0x3f, 0x53, 0xa4, 0x2f,
-	0x77, 0xb6, 0x8e, 0x0e, 0xa3, 0xbf, 0xd7, 0x18, 0x1d, 0x5f, 0x24, 0x53, 0x2a, 0x18, 0xc4, 0xd4,
-	0xc0, 0x9b, 0x79, 0x36, 0x83, 0x5e, 0xa3, 0x78, 0xb2, 0xcf, 0x3f, 0x5a, 0xf8, 0x0e, 0xa5, 0x63,
-	0x6f, 0xd7, 0x3e, 0x41, 0x8f, 0xfe, 0x0c, 0x39, 0x54, 0x90, 0xdf, 0x3b, 0x67, 0x7b, 0x19, 0xa0,
-	0x1d, 0xeb, 0x77, 0xba, 0x96, 0x0e, 0x41, 0x9d, 0x4b, 0x95, 0x52, 0x91, 0xfc, 0xcb, 0xf3, 0x00,
-	0xd5, 0x72, 0x69, 0xb8, 0x60, 0xa3, 0x4c, 0x2e, 0xbc, 0x73, 0x39, 0xde, 0x72, 0xd8, 0xb0, 0x80,
-	0xf0, 0x21, 0x7a, 0xa0, 0x60, 0x41, 0xd5, 0x64, 0xb4, 0x00, 0xce, 0xa6, 0xc6, 0xef, 0xb2, 0xe6,
-	0xc0, 0x33, 0x8b, 0xe1, 0x5d, 0x54, 0x5d, 0x70, 0x31, 0x4a, 0xe4, 0x5c, 0x98, 0xfa, 0x86, 0x15,
-	0xfc, 0xbf, 0xe0, 0xa2, 0x5f, 0xf4, 0x85, 0x03, 0x1d, 0x6b, 0x43, 0x6f, 0x05, 0x9b, 0xce, 0xc1,
-	0x83, 0x4e, 0xb4, 0x87, 0x50, 0xca, 0xb5, 0xf6, 0x8a, 0x8a, 0x55, 0x54, 0x0b, 0xc4, 0xd2, 0xbd,
-	0x97, 0x57, 0xcb, 0x30, 0xb8, 0x5e, 0x86, 0xc1, 0xcf, 0x65, 0x18, 0x7c, 0x58, 0x85, 0xa5, 0xeb,
-	0x55, 0x58, 0xfa, 0xb6, 0x0a, 0x4b, 0xef, 0x08, 0xe3, 0x66, 0x3a, 0x1f, 0x47, 0x89, 0x4c, 0xc9,
-	0x6b, 0xbb, 0xa0, 0xfe, 0x94, 0x72, 0x41, 0xfc, 0x85, 0xe5, 0x47, 0xe4, 0x62, 0x7d, 0x66, 0xe6,
-	0x32, 0x03, 0x3d, 0xae, 0xd8, 0xa3, 0x78, 0xfa, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x06, 0x7f, 0xd3,
-	0x5e, 0xb4, 0x03, 0x00, 0x00,
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x4e, 0x1b, 0x3d,
+	0x14, 0xcd, 0x10, 0xc8, 0x47, 0x0c, 0x9f, 0x84, 0xac, 0xb6, 0x0a, 0x01, 0x06, 0x18, 0x36, 0x6c,
+	0x3a, 0x23, 0xe8, 0x03, 0x54, 0x0d, 0xa1, 0x55, 0xa5, 0x52, 0x45, 0xa3, 0x16, 0xa4, 0x6e, 0x22,
+	0x67, 0xe6, 0xe2, 0x58, 0xcd, 0xd8, 0x23, 0xdb, 0x99, 0xc0, 0x2b, 0x74, 0x55, 0xf5, 0x15, 0xba,
+	0xeb, 0xba, 0x0f, 0xc1, 0x12, 0x75, 0x55, 0x75, 0x41, 0xab, 0xf0, 0x22, 0x95, 0x7f, 0x42, 0x7f,
+	0x58, 0x54, 0x62, 0xe7, 0x7b, 0xce, 0xb9, 0x67, 0x8e, 0x7d, 0xe7, 0xa2, 0x75, 0xce, 0x06, 0x4c,
+	0x8e, 0x13, 0x21, 0x49, 0x36, 0x82, 0xa4, 0xda, 0x4b, 0xa0, 0x02, 0xae, 0xe3, 0x52, 0x0a, 0x2d,
+	0xf0, 0x8a, 0x63, 0x63, 0xc7, 0xc6, 0xd5, 0x5e, 0x7b, 0xe3, 0x96, 0xde, 0x73, 0xb6, 0xa1, 0x7d,
+	0x8f, 0x0a, 0x2a, 0xec, 0x31, 0x31, 0x27, 0x8f, 0xae, 0x53, 0x21, 0xe8, 0x08, 0x12, 0x52, 0xb2,
+	0x84, 0x70, 0x2e, 0x34, 0xd1, 0x4c, 0x70, 0xe5, 0xd9, 0xd5, 0x4c, 0xa8, 0x42, 0xa8, 0xbe, 0x6b,
+	0x73, 0x85, 0xa3, 0xa2, 0x0f, 0x01, 0x5a, 0x39, 0x34, 0x79, 0x7a, 0x92, 0x65, 0xf0, 0xba, 0xcc,
+	0x89, 0x06, 0x8c, 0xd1, 0x7c, 0x49, 0x98, 0x6c, 0x05, 0x5b, 0xc1, 0x6e, 0x33, 0xb5, 0x67, 0xfc,
+	0x0c, 0x2d, 0x94, 0x46, 0xd2, 0x9a, 0x33, 0x60, 0x67, 0xef, 0xe2, 0x6a, 0xb3, 0xf6, 0xed, 0x6a,
+	0x73, 0xcd, 0xb9, 0xa9, 0xfc, 0x6d, 0xcc, 0x44, 0x52, 0x10, 0x3d, 0x8c, 0x5f, 0x00, 0x25, 0xd9,
+	0x79, 0x17, 0xb2, 0x2f, 0x9f, 0x1f, 0x22, 0xff, 0xb1, 0x2e, 0x64, 0xa9, 0xeb, 0xc7, 0xdb, 0x68,
+	0x59, 0xb3, 0x02, 0x94, 0x26, 0x45, 0xd9, 0x2f, 0x54, 0xab, 0xbe, 0x15, 0xec, 0xd6, 0xd3, 0xa5,
+	0x1b, 0xec, 0x48, 0x45, 0x29, 0x6a, 0xdb, 0x4c, 0x5d, 0x18, 0x01, 0x25, 0x1a, 0x9e, 0x02, 0xe4,
+	0x20, 0x0f, 0x04, 0x57, 0xc0, 0x35, 0x5e, 0x47, 0xcd, 0x8a, 0x8c, 0x58, 0x4e, 0xb4, 0x98, 0x45,
+	0xfc, 0x05, 0xe0, 0x07, 0xa8, 0x71, 0x6a, 0xe5, 0x2e, 0x68, 0xea, 0xab, 0xe8, 0x63, 0x80, 0xb0,
+	0x35, 0x7d, 0x42, 0xa9, 0xb4, 0xae, 0xc7, 0x42, 0xc3, 0xdd, 0xcc, 0xf0, 0x09, 0x6a, 0xd8, 0xcb,
+	0x98, 0xf4, 0xf5, 0xdd, 0xa5, 0xfd, 0x9d, 0xf8, 0xef, 0x31, 0xc6, 0x87, 0x67, 0xd9, 0x90, 0x70,
+	0x0a, 0x29, 0xd1, 0xf0, 0x6a, 0x5c, 0x8e, 0xa0, 0xd3, 0x36, 0x4f, 0xf6, 0xe9, 0xfb, 0x26, 0xbe,
+	0x45, 0xa9, 0xd4, 0xdb, 0x45, 0x47, 0xe8, 0xfe, 0x9f, 0x21, 0x7b, 0x12, 0xaa, 0x3b, 0xe7, 0x8c,
+	0xa6, 0x01, 0x5a, 0xb5, 0x7e, 0xc7, 0x33, 0x69, 0x0f, 0xe4, 0xa9, 0x90, 0x05, 0xe1, 0xd9, 0xbf,
+	0x3c, 0xb7, 0xd1, 0x72, 0x25, 0x34, 0xe3, 0xb4, 0x5f, 0x8a, 0x89, 0x77, 0xae, 0xa7, 0x4b, 0x0e,
+	0xeb, 0x19, 0x08, 0xef, 0xa0, 0xff, 0x25, 0x4c, 0x88, 0xcc, 0xfb, 0x13, 0x60, 0x74, 0xa8, 0xfd,
+	0x2c, 0x97, 0x1d, 0x78, 0x62, 0x31, 0xbc, 0x86, 0x9a, 0x13, 0xc6, 0xfb, 0x99, 0x18, 0x73, 0xdd,
+	0x9a, 0xb7, 0x82, 0xc5, 0x09, 0xe3, 0x07, 0xa6, 0x36, 0x0e, 0x64, 0xa0, 0x34, 0xb9, 0x11, 0x2c,
+	0x38, 0x07, 0x0f, 0x3a, 0xd1, 0x06, 0x42, 0x05, 0x53, 0xca, 0x2b, 0x1a, 0x56, 0xd1, 0x34, 0x88,
+	0xa5, 0xa3, 0x77, 0x81, 0x7f, 0xb4, 0xc3, 0x9c, 0xe9, 0x2e, 0x48, 0x56, 0x41, 0xde, 0x23, 0x4c,
+	0x2a, 0xf3, 0x2c, 0x0a, 0x78, 0x0e, 0xb3, 0xdb, 0xf9, 0x0a, 0x3f, 0x46, 0x8b, 0xe3, 0x52, 0x81,
+	0xd4, 0x90, 0xb7, 0xe6, 0xec, 0x00, 0x37, 0x6e, 0x0f, 0xf0, 0x37, 0xa7, 0xce, 0xbc, 0x19, 0x5d,
+	0x7a, 0xd3, 0x84, 0x5b, 0xe8, 0x3f, 0x09, 0x85, 0xa8, 0x20, 0xb7, 0x3f, 0x40, 0x33, 0x9d, 0x95,
+	0x9d, 0xe7, 0x17, 0xd3, 0x30, 0xb8, 0x9c, 0x86, 0xc1, 0x8f, 0x69, 0x18, 0xbc, 0xbf, 0x0e, 0x6b,
+	0x97, 0xd7, 0x61, 0xed, 0xeb, 0x75, 0x58, 0x7b, 0x93, 0x50, 0xa6, 0x87, 0xe3, 0x41, 0x9c, 0x89,
+	0x22, 0x79, 0x69, 0x3f, 0x76, 0x30, 0x24, 0x8c, 0x27, 0x7e, 0xdd, 0xab, 0xfd, 0xe4, 0x6c, 0xb6,
+	0xf3, 0xfa, 0xbc, 0x04, 0x35, 0x68, 0xd8, 0x0d, 0x7d, 0xf4, 0x73, 0x00, 0xa3, 0x37, 0x40, 0x53,
+	0x41, 0x04, 0x00, 0x00,
 }
 
 func (m *EventPriceUpdate) Marshal() (dAtA []byte, err error) {
@@ -621,6 +689,59 @@ func (m *EventValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventEditDerivedPairs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEditDerivedPairs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEditDerivedPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Removed[iNdEx])
+			copy(dAtA[i:], m.Removed[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.Removed[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Upserted) > 0 {
+		for iNdEx := len(m.Upserted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upserted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -735,6 +856,31 @@ func (m *EventValidatorPerformance) Size() (n int) {
 	return n
 }
 
+func (m *EventEditDerivedPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Upserted) > 0 {
+		for _, e := range m.Upserted {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1429,6 +1575,154 @@ func (m *EventValidatorPerformance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventEditDerivedPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEditDerivedPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEditDerivedPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upserted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Upserted = append(m.Upserted, DerivedPair{})
+			if err := m.Upserted[len(m.Upserted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
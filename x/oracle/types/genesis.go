@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/v2/x/common/asset"
 )
@@ -31,7 +32,7 @@ func NewGenesisState(
 
 // DefaultGenesisState - default GenesisState
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(
+	genesis := NewGenesisState(
 		DefaultParams(),
 		[]ExchangeRateTuple{},
 		[]FeederDelegation{},
@@ -40,10 +41,26 @@ func DefaultGenesisState() *GenesisState {
 		[]AggregateExchangeRateVote{},
 		[]asset.Pair{},
 		[]Rewards{})
+	genesis.DerivedPairs = []DerivedPair{}
+	genesis.VoteAccuracies = []VoteAccuracy{}
+	return genesis
 }
 
 // ValidateGenesis validates the oracle genesis state
 func ValidateGenesis(data *GenesisState) error {
+	for _, derivedPair := range data.DerivedPairs {
+		if err := derivedPair.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, accuracy := range data.VoteAccuracies {
+		if _, err := sdk.ValAddressFromBech32(accuracy.Validator); err != nil {
+			return err
+		}
+		if err := accuracy.Pair.Validate(); err != nil {
+			return err
+		}
+	}
 	return data.Params.Validate()
 }
 
@@ -35,6 +35,8 @@ type GenesisState struct {
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote                            `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
 	Pairs                         []github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,7,rep,name=pairs,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"pairs"`
 	Rewards                       []Rewards                                              `protobuf:"bytes,8,rep,name=rewards,proto3" json:"rewards"`
+	DerivedPairs                  []DerivedPair                                          `protobuf:"bytes,9,rep,name=derived_pairs,json=derivedPairs,proto3" json:"derived_pairs"`
+	VoteAccuracies                []VoteAccuracy                                         `protobuf:"bytes,10,rep,name=vote_accuracies,json=voteAccuracies,proto3" json:"vote_accuracies"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -119,6 +121,20 @@ func (m *GenesisState) GetRewards() []Rewards {
 	return nil
 }
 
+func (m *GenesisState) GetDerivedPairs() []DerivedPair {
+	if m != nil {
+		return m.DerivedPairs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteAccuracies() []VoteAccuracy {
+	if m != nil {
+		return m.VoteAccuracies
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -237,42 +253,45 @@ func init() {
 func init() { proto.RegisterFile("nibiru/oracle/v1/genesis.proto", fileDescriptor_d88ebb2fa2659942) }
 
 var fileDescriptor_d88ebb2fa2659942 = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6b, 0x13, 0x41,
-	0x18, 0xc6, 0xb3, 0x6d, 0x93, 0xda, 0x49, 0x52, 0xd2, 0xc1, 0xc3, 0x1a, 0xc8, 0x26, 0x46, 0x84,
-	0x40, 0x61, 0x87, 0x44, 0x11, 0x04, 0x2f, 0x4d, 0xfd, 0x7b, 0x50, 0xc2, 0x2a, 0x0a, 0x82, 0x84,
-	0xc9, 0xee, 0x64, 0x33, 0x90, 0xdd, 0x59, 0xe6, 0x9d, 0xc4, 0x7a, 0xf0, 0x3b, 0xf8, 0x39, 0xfc,
-	0x24, 0x3d, 0xf6, 0x22, 0x88, 0x87, 0x2a, 0xc9, 0x17, 0x91, 0x9d, 0xd9, 0x36, 0x31, 0xdb, 0x4a,
-	0x6f, 0xe1, 0x7d, 0x7e, 0xef, 0xf3, 0xbc, 0x13, 0x9e, 0x04, 0x39, 0x31, 0x1f, 0x71, 0x39, 0x23,
-	0x42, 0x52, 0x7f, 0xca, 0xc8, 0xbc, 0x4b, 0x42, 0x16, 0x33, 0xe0, 0xe0, 0x26, 0x52, 0x28, 0x81,
-	0x6b, 0x46, 0x77, 0x8d, 0xee, 0xce, 0xbb, 0xf5, 0xdb, 0xa1, 0x08, 0x85, 0x16, 0x49, 0xfa, 0xc9,
-	0x70, 0xf5, 0x46, 0xce, 0x27, 0xdb, 0x30, 0xb2, 0xe3, 0x0b, 0x88, 0x04, 0x90, 0x11, 0x85, 0x54,
-	0x1c, 0x31, 0x45, 0xbb, 0xc4, 0x17, 0x3c, 0x36, 0x7a, 0xfb, 0x47, 0x11, 0x55, 0x5e, 0x98, 0xe0,
-	0xb7, 0x8a, 0x2a, 0x86, 0x1f, 0xa1, 0x52, 0x42, 0x25, 0x8d, 0xc0, 0xb6, 0x5a, 0x56, 0xa7, 0xdc,
-	0xb3, 0xdd, 0xcd, 0x43, 0xdc, 0x81, 0xd6, 0xfb, 0x3b, 0xa7, 0xe7, 0xcd, 0x82, 0x97, 0xd1, 0xf8,
-	0x03, 0xc2, 0x63, 0xc6, 0x02, 0x26, 0x87, 0x01, 0x9b, 0xb2, 0x90, 0x2a, 0x2e, 0x62, 0xb0, 0xb7,
-	0x5a, 0xdb, 0x9d, 0x72, 0xaf, 0x9d, 0xf7, 0x78, 0xae, 0xd9, 0xa7, 0x97, 0x68, 0xe6, 0x76, 0x30,
-	0xde, 0x98, 0x03, 0x1e, 0xa3, 0x7d, 0x76, 0xe2, 0x4f, 0x68, 0x1c, 0xb2, 0xa1, 0xa4, 0x8a, 0x81,
-	0xbd, 0xad, 0x4d, 0xef, 0xe5, 0x4d, 0x9f, 0x65, 0x9c, 0x47, 0x15, 0x7b, 0x37, 0x4b, 0xa6, 0xac,
-	0x5f, 0x4f, 0x5d, 0xbf, 0xff, 0x6e, 0xe2, 0x9c, 0x04, 0x5e, 0x95, 0xad, 0xcd, 0x00, 0xbf, 0x44,
-	0xd5, 0x88, 0x03, 0x0c, 0x7d, 0x31, 0x8b, 0x15, 0x93, 0x60, 0xef, 0xe8, 0x98, 0x46, 0x3e, 0xe6,
-	0x35, 0x07, 0x38, 0x36, 0x54, 0x76, 0x76, 0x25, 0x5a, 0x8d, 0x00, 0x7f, 0x45, 0x2d, 0x1a, 0x86,
-	0x32, 0x7d, 0x01, 0x1b, 0xfe, 0x73, 0xfb, 0x30, 0x91, 0x6c, 0x2e, 0xd2, 0x37, 0x14, 0xb5, 0xb9,
-	0x9b, 0x37, 0x3f, 0xba, 0xd8, 0x5c, 0xbf, 0x78, 0x60, 0xd6, 0xb2, 0xb4, 0x06, 0xfd, 0x0f, 0x03,
-	0x58, 0xa1, 0xc6, 0x75, 0xf1, 0x26, 0xbb, 0xa4, 0xb3, 0x0f, 0x6f, 0x98, 0xfd, 0x7e, 0x15, 0x5c,
-	0xa7, 0xd7, 0x01, 0x80, 0x3d, 0x54, 0x4c, 0x28, 0x97, 0x60, 0xef, 0xb6, 0xb6, 0x3b, 0x7b, 0xfd,
-	0x27, 0xe9, 0xc2, 0xaf, 0xf3, 0xe6, 0xc3, 0x90, 0xab, 0xc9, 0x6c, 0xe4, 0xfa, 0x22, 0x22, 0x6f,
-	0x74, 0xde, 0xf1, 0x84, 0xf2, 0x98, 0x64, 0xad, 0x9d, 0xf7, 0xc8, 0x09, 0xf1, 0x45, 0x14, 0x89,
-	0x98, 0x50, 0x00, 0xa6, 0xdc, 0x01, 0xe5, 0xd2, 0x33, 0x56, 0xf8, 0x31, 0xda, 0x95, 0xec, 0x33,
-	0x95, 0x01, 0xd8, 0xb7, 0xf4, 0xcd, 0x77, 0xf2, 0x37, 0x7b, 0x06, 0xc8, 0x2e, 0xbc, 0xe0, 0xdb,
-	0x63, 0x54, 0xdb, 0xac, 0x18, 0xbe, 0x8f, 0xf6, 0xb3, 0x8a, 0xd2, 0x20, 0x90, 0x0c, 0x4c, 0xc5,
-	0xf7, 0xbc, 0xaa, 0x99, 0x1e, 0x99, 0x21, 0x3e, 0x44, 0x07, 0x73, 0x3a, 0xe5, 0x01, 0x55, 0x62,
-	0x45, 0x6e, 0x69, 0xb2, 0x76, 0x29, 0x64, 0x70, 0xfb, 0x13, 0x2a, 0xaf, 0xd5, 0xe1, 0xea, 0x5d,
-	0xeb, 0xea, 0x5d, 0x7c, 0x17, 0x55, 0xd6, 0x1b, 0xa7, 0x33, 0x76, 0xbc, 0xf2, 0x5a, 0x97, 0xfa,
-	0xaf, 0x4e, 0x17, 0x8e, 0x75, 0xb6, 0x70, 0xac, 0x3f, 0x0b, 0xc7, 0xfa, 0xb6, 0x74, 0x0a, 0x67,
-	0x4b, 0xa7, 0xf0, 0x73, 0xe9, 0x14, 0x3e, 0x92, 0x1b, 0x7c, 0xb1, 0xd9, 0x7f, 0x82, 0xfa, 0x92,
-	0x30, 0x18, 0x95, 0xf4, 0x0f, 0xfe, 0xc1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x90, 0xe5, 0x08,
-	0xe6, 0x79, 0x04, 0x00, 0x00,
+	// 602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x6b, 0xd4, 0x40,
+	0x14, 0xdd, 0xed, 0xc7, 0xd6, 0xce, 0xb6, 0xb5, 0x1d, 0x7c, 0x88, 0x0b, 0x9b, 0xae, 0x2b, 0x42,
+	0xa1, 0x90, 0xd0, 0x2a, 0x82, 0xe0, 0x4b, 0xb7, 0xf5, 0xeb, 0xa1, 0x52, 0xa2, 0x28, 0x08, 0x12,
+	0x66, 0x93, 0xbb, 0xe9, 0xc0, 0x26, 0x13, 0xe6, 0xce, 0xc6, 0xf6, 0xc1, 0xff, 0xe0, 0xef, 0xf0,
+	0x97, 0xf4, 0xb1, 0x8f, 0xe2, 0x43, 0x95, 0xf6, 0xdd, 0xdf, 0x20, 0x99, 0x99, 0x76, 0xd7, 0xa6,
+	0x2b, 0x7d, 0x0b, 0xf7, 0x9c, 0x7b, 0xce, 0xc9, 0xdc, 0x3b, 0x43, 0xdc, 0x8c, 0xf7, 0xb9, 0x1c,
+	0xf9, 0x42, 0xb2, 0x68, 0x08, 0x7e, 0xb1, 0xe5, 0x27, 0x90, 0x01, 0x72, 0xf4, 0x72, 0x29, 0x94,
+	0xa0, 0xab, 0x06, 0xf7, 0x0c, 0xee, 0x15, 0x5b, 0xad, 0x7b, 0x89, 0x48, 0x84, 0x06, 0xfd, 0xf2,
+	0xcb, 0xf0, 0x5a, 0xed, 0x8a, 0x8e, 0xed, 0x30, 0xb0, 0x1b, 0x09, 0x4c, 0x05, 0xfa, 0x7d, 0x86,
+	0x25, 0xd8, 0x07, 0xc5, 0xb6, 0xfc, 0x48, 0xf0, 0xcc, 0xe0, 0xdd, 0x3f, 0x0d, 0xb2, 0xf4, 0xca,
+	0x18, 0xbf, 0x53, 0x4c, 0x01, 0x7d, 0x4a, 0x1a, 0x39, 0x93, 0x2c, 0x45, 0xa7, 0xde, 0xa9, 0x6f,
+	0x34, 0xb7, 0x1d, 0xef, 0x7a, 0x10, 0xef, 0x40, 0xe3, 0xbd, 0xb9, 0x93, 0xb3, 0xf5, 0x5a, 0x60,
+	0xd9, 0xf4, 0x23, 0xa1, 0x03, 0x80, 0x18, 0x64, 0x18, 0xc3, 0x10, 0x12, 0xa6, 0xb8, 0xc8, 0xd0,
+	0x99, 0xe9, 0xcc, 0x6e, 0x34, 0xb7, 0xbb, 0x55, 0x8d, 0x97, 0x9a, 0xbb, 0x77, 0x45, 0xb5, 0x6a,
+	0x6b, 0x83, 0x6b, 0x75, 0xa4, 0x03, 0xb2, 0x02, 0x47, 0xd1, 0x21, 0xcb, 0x12, 0x08, 0x25, 0x53,
+	0x80, 0xce, 0xac, 0x16, 0x7d, 0x58, 0x15, 0x7d, 0x61, 0x79, 0x01, 0x53, 0xf0, 0x7e, 0x94, 0x0f,
+	0xa1, 0xd7, 0x2a, 0x55, 0xbf, 0xff, 0x5a, 0xa7, 0x15, 0x08, 0x83, 0x65, 0x98, 0xa8, 0x21, 0x7d,
+	0x4d, 0x96, 0x53, 0x8e, 0x18, 0x46, 0x62, 0x94, 0x29, 0x90, 0xe8, 0xcc, 0x69, 0x9b, 0x76, 0xd5,
+	0x66, 0x9f, 0x23, 0xee, 0x1a, 0x96, 0x8d, 0xbd, 0x94, 0x8e, 0x4b, 0x48, 0xbf, 0x92, 0x0e, 0x4b,
+	0x12, 0x59, 0xfe, 0x01, 0x84, 0xff, 0x64, 0x0f, 0x73, 0x09, 0x85, 0x28, 0xff, 0x61, 0x5e, 0x8b,
+	0x7b, 0x55, 0xf1, 0x9d, 0xcb, 0xce, 0xc9, 0xc4, 0x07, 0xa6, 0xcd, 0xba, 0xb5, 0xd9, 0x7f, 0x38,
+	0x48, 0x15, 0x69, 0x4f, 0xb3, 0x37, 0xde, 0x0d, 0xed, 0xbd, 0x79, 0x4b, 0xef, 0x0f, 0x63, 0xe3,
+	0x16, 0x9b, 0x46, 0x40, 0x1a, 0x90, 0xf9, 0x9c, 0x71, 0x89, 0xce, 0x42, 0x67, 0x76, 0x63, 0xb1,
+	0xf7, 0xbc, 0x6c, 0xf8, 0x79, 0xb6, 0xfe, 0x24, 0xe1, 0xea, 0x70, 0xd4, 0xf7, 0x22, 0x91, 0xfa,
+	0x6f, 0xb5, 0xdf, 0xee, 0x21, 0xe3, 0x99, 0x6f, 0xb7, 0xb6, 0xd8, 0xf6, 0x8f, 0xfc, 0x48, 0xa4,
+	0xa9, 0xc8, 0x7c, 0x86, 0x08, 0xca, 0x3b, 0x60, 0x5c, 0x06, 0x46, 0x8a, 0x3e, 0x23, 0x0b, 0x12,
+	0xbe, 0x30, 0x19, 0xa3, 0x73, 0x47, 0x67, 0xbe, 0x5f, 0xcd, 0x1c, 0x18, 0x82, 0x4d, 0x78, 0xc9,
+	0x2f, 0xa7, 0x19, 0x83, 0xe4, 0x05, 0xc4, 0xa1, 0x89, 0xb5, 0x38, 0x6d, 0x9a, 0x7b, 0x86, 0x56,
+	0xfa, 0x5e, 0x4e, 0x33, 0x1e, 0x97, 0x90, 0xee, 0x93, 0xbb, 0xe5, 0xb1, 0x85, 0x2c, 0x8a, 0x46,
+	0x92, 0x45, 0x1c, 0xd0, 0x21, 0x5a, 0xcb, 0xad, 0x6a, 0x95, 0x47, 0xb1, 0x63, 0x78, 0xc7, 0x56,
+	0x6c, 0xa5, 0x18, 0xd7, 0x38, 0x60, 0x77, 0x40, 0x56, 0xaf, 0xef, 0x3e, 0x7d, 0x44, 0x56, 0xec,
+	0xdd, 0x61, 0x71, 0x2c, 0x01, 0xcd, 0xdd, 0x5b, 0x0c, 0x96, 0x4d, 0x75, 0xc7, 0x14, 0xe9, 0x26,
+	0x59, 0x2b, 0xd8, 0x90, 0xc7, 0x4c, 0x89, 0x31, 0x73, 0x46, 0x33, 0x57, 0xaf, 0x00, 0x4b, 0xee,
+	0x7e, 0x26, 0xcd, 0x89, 0x3d, 0xbd, 0xb9, 0xb7, 0x7e, 0x73, 0x2f, 0x7d, 0x40, 0x96, 0x26, 0xaf,
+	0x82, 0xf6, 0x98, 0x0b, 0x9a, 0x13, 0x4b, 0xde, 0x7b, 0x73, 0x72, 0xee, 0xd6, 0x4f, 0xcf, 0xdd,
+	0xfa, 0xef, 0x73, 0xb7, 0xfe, 0xed, 0xc2, 0xad, 0x9d, 0x5e, 0xb8, 0xb5, 0x1f, 0x17, 0x6e, 0xed,
+	0x93, 0x7f, 0x8b, 0x89, 0xdb, 0xc7, 0x4a, 0x1d, 0xe7, 0x80, 0xfd, 0x86, 0x7e, 0x89, 0x1e, 0xff,
+	0x1d, 0x00, 0x2f, 0x45, 0x6c, 0x4a, 0x12, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -295,6 +314,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoteAccuracies) > 0 {
+		for iNdEx := len(m.VoteAccuracies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteAccuracies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DerivedPairs) > 0 {
+		for iNdEx := len(m.DerivedPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivedPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Rewards) > 0 {
 		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -539,6 +586,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DerivedPairs) > 0 {
+		for _, e := range m.DerivedPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteAccuracies) > 0 {
+		for _, e := range m.VoteAccuracies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -883,6 +942,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivedPairs = append(m.DerivedPairs, DerivedPair{})
+			if err := m.DerivedPairs[len(m.DerivedPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteAccuracies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteAccuracies = append(m.VoteAccuracies, VoteAccuracy{})
+			if err := m.VoteAccuracies[len(m.VoteAccuracies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
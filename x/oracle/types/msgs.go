@@ -6,6 +6,8 @@ import (
 
 	sdkioerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
 )
 
 // ensure Msg interface compliance at compile time
@@ -14,6 +16,7 @@ var (
 	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
 	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
 	_ sdk.Msg = &MsgEditOracleParams{}
+	_ sdk.Msg = &MsgEditDerivedPairs{}
 )
 
 // oracle message types
@@ -22,6 +25,7 @@ const (
 	TypeMsgAggregateExchangeRatePrevote = "aggregate_exchange_rate_prevote"
 	TypeMsgAggregateExchangeRateVote    = "aggregate_exchange_rate_vote"
 	TypeMsgEditOracleParams             = "edit_oracle_params"
+	TypeMsgEditDerivedPairs             = "edit_derived_pairs"
 )
 
 //-------------------------------------------------
@@ -206,6 +210,20 @@ func (m MsgEditOracleParams) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
 		return err
 	}
+	if m.Params == nil {
+		return nil
+	}
+
+	seenPairs := make(map[asset.Pair]bool, len(m.Params.PairAggregations))
+	for _, pairAggregation := range m.Params.PairAggregations {
+		if err := pairAggregation.Validate(); err != nil {
+			return err
+		}
+		if seenPairs[pairAggregation.Pair] {
+			return sdkioerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate pair aggregation for %s", pairAggregation.Pair)
+		}
+		seenPairs[pairAggregation.Pair] = true
+	}
 	return nil
 }
 
@@ -220,3 +238,50 @@ func (m MsgEditOracleParams) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// ------------------------ MsgEditDerivedPairs ------------------------
+
+func (m MsgEditDerivedPairs) Route() string { return RouterKey }
+func (m MsgEditDerivedPairs) Type() string  { return TypeMsgEditDerivedPairs }
+
+func (m MsgEditDerivedPairs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	if len(m.Upsert) == 0 && len(m.Remove) == 0 {
+		return ErrInvalidDerivedPair.Wrap("no derived pairs to add, update, or remove")
+	}
+
+	seen := make(map[asset.Pair]bool)
+	for _, derivedPair := range m.Upsert {
+		if err := derivedPair.Validate(); err != nil {
+			return err
+		}
+		if seen[derivedPair.Pair] {
+			return ErrInvalidDerivedPair.Wrapf("duplicate pair %s", derivedPair.Pair)
+		}
+		seen[derivedPair.Pair] = true
+	}
+	for _, pair := range m.Remove {
+		if err := pair.Validate(); err != nil {
+			return ErrInvalidDerivedPair.Wrap(err.Error())
+		}
+		if seen[pair] {
+			return ErrInvalidDerivedPair.Wrapf("duplicate pair %s", pair)
+		}
+		seen[pair] = true
+	}
+	return nil
+}
+
+func (m MsgEditDerivedPairs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgEditDerivedPairs) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
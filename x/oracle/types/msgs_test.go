@@ -123,3 +123,30 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgEditOracleParams(t *testing.T) {
+	sender := sdk.AccAddress([]byte("addr1_______________")).String()
+	trimmedMean := types.NewPairAggregation(
+		"ubtc:uusd", types.AGGREGATION_METHOD_TRIMMED_MEAN, sdkmath.LegacyNewDecWithPrec(1, 1))
+	invalidTrimmedMean := types.NewPairAggregation(
+		"ueth:uusd", types.AGGREGATION_METHOD_TRIMMED_MEAN, sdkmath.LegacyOneDec())
+
+	tests := []struct {
+		params     *types.OracleParamsMsg
+		expectPass bool
+	}{
+		{nil, true},
+		{&types.OracleParamsMsg{PairAggregations: []types.PairAggregation{trimmedMean}}, true},
+		{&types.OracleParamsMsg{PairAggregations: []types.PairAggregation{invalidTrimmedMean}}, false},
+		{&types.OracleParamsMsg{PairAggregations: []types.PairAggregation{trimmedMean, trimmedMean}}, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.MsgEditOracleParams{Sender: sender, Params: tc.params}
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
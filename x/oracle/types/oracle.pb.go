@@ -31,6 +31,41 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AggregationMethod defines how the votes of a ballot are aggregated into an
+// exchange rate. There is no volume-weighted average price (VWAP) method:
+// votes carry an exchange rate but no traded volume to weight it by.
+type AggregationMethod int32
+
+const (
+	// Median of the votes weighted by voting power.
+	AGGREGATION_METHOD_WEIGHTED_MEDIAN AggregationMethod = 0
+	// Mean of the votes weighted by voting power, after discarding
+	// "trim_fraction" of the voting power at each end of the sorted votes.
+	AGGREGATION_METHOD_TRIMMED_MEAN AggregationMethod = 1
+	// Mean of the votes weighted by voting power.
+	AGGREGATION_METHOD_WEIGHTED_MEAN AggregationMethod = 2
+)
+
+var AggregationMethod_name = map[int32]string{
+	0: "AGGREGATION_METHOD_WEIGHTED_MEDIAN",
+	1: "AGGREGATION_METHOD_TRIMMED_MEAN",
+	2: "AGGREGATION_METHOD_WEIGHTED_MEAN",
+}
+
+var AggregationMethod_value = map[string]int32{
+	"AGGREGATION_METHOD_WEIGHTED_MEDIAN": 0,
+	"AGGREGATION_METHOD_TRIMMED_MEAN":    1,
+	"AGGREGATION_METHOD_WEIGHTED_MEAN":   2,
+}
+
+func (x AggregationMethod) String() string {
+	return proto.EnumName(AggregationMethod_name, int32(x))
+}
+
+func (AggregationMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{0}
+}
+
 // Params defines the module parameters for the x/oracle module.
 type Params struct {
 	// VotePeriod defines the number of blocks during which voting takes place.
@@ -69,6 +104,17 @@ type Params struct {
 	// The validator fee ratio that is given to validators every epoch.
 	ValidatorFeeRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=validator_fee_ratio,json=validatorFeeRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_fee_ratio" yaml:"validator_fee_ratio"`
 	ExpirationBlocks  uint64                      `protobuf:"varint,11,opt,name=expiration_blocks,json=expirationBlocks,proto3" json:"expiration_blocks,omitempty" yaml:"expiration_blocks"`
+	// Number of TWAP lookback windows of price snapshots to retain. Snapshots
+	// older than "snapshot_retention_windows * twap_lookback_window" are pruned
+	// in the EndBlocker. Zero disables pruning and keeps every snapshot.
+	SnapshotRetentionWindows uint64 `protobuf:"varint,12,opt,name=snapshot_retention_windows,json=snapshotRetentionWindows,proto3" json:"snapshot_retention_windows,omitempty" yaml:"snapshot_retention_windows"`
+	// Aggregation strategies of the pairs that don't use the default weighted
+	// median of the votes.
+	PairAggregations []PairAggregation `protobuf:"bytes,13,rep,name=pair_aggregations,json=pairAggregations,proto3" json:"pair_aggregations" yaml:"pair_aggregations"`
+	// Number of completed slash windows whose vote accuracy records are kept
+	// in addition to the current slash window. Older records are pruned at the
+	// end of each slash window. Zero keeps only the current slash window.
+	VoteAccuracyRetentionWindows uint64 `protobuf:"varint,14,opt,name=vote_accuracy_retention_windows,json=voteAccuracyRetentionWindows,proto3" json:"vote_accuracy_retention_windows,omitempty" yaml:"vote_accuracy_retention_windows"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -139,6 +185,79 @@ func (m *Params) GetExpirationBlocks() uint64 {
 	return 0
 }
 
+func (m *Params) GetSnapshotRetentionWindows() uint64 {
+	if m != nil {
+		return m.SnapshotRetentionWindows
+	}
+	return 0
+}
+
+func (m *Params) GetPairAggregations() []PairAggregation {
+	if m != nil {
+		return m.PairAggregations
+	}
+	return nil
+}
+
+func (m *Params) GetVoteAccuracyRetentionWindows() uint64 {
+	if m != nil {
+		return m.VoteAccuracyRetentionWindows
+	}
+	return 0
+}
+
+// PairAggregation defines the aggregation strategy of the votes of a pair.
+type PairAggregation struct {
+	Pair   github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	Method AggregationMethod                                    `protobuf:"varint,2,opt,name=method,proto3,enum=nibiru.oracle.v1.AggregationMethod" json:"method,omitempty" yaml:"method"`
+	// Fraction of the voting power discarded at each end of the sorted votes
+	// by AGGREGATION_METHOD_TRIMMED_MEAN. Must be in [0, 0.5).
+	TrimFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=trim_fraction,json=trimFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trim_fraction" yaml:"trim_fraction"`
+	// If positive, the ballot is rejected when the standard deviation of the
+	// votes is larger than "max_std_dev_ratio * exchange_rate".
+	MaxStdDevRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_std_dev_ratio,json=maxStdDevRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_std_dev_ratio" yaml:"max_std_dev_ratio"`
+}
+
+func (m *PairAggregation) Reset()         { *m = PairAggregation{} }
+func (m *PairAggregation) String() string { return proto.CompactTextString(m) }
+func (*PairAggregation) ProtoMessage()    {}
+func (*PairAggregation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{1}
+}
+func (m *PairAggregation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairAggregation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairAggregation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairAggregation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairAggregation.Merge(m, src)
+}
+func (m *PairAggregation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairAggregation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairAggregation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairAggregation proto.InternalMessageInfo
+
+func (m *PairAggregation) GetMethod() AggregationMethod {
+	if m != nil {
+		return m.Method
+	}
+	return AGGREGATION_METHOD_WEIGHTED_MEDIAN
+}
+
 // Struct for aggregate prevoting on the ExchangeRateVote.
 // The purpose of aggregate prevote is to hide vote exchange rates with hash
 // which is formatted as hex string in
@@ -153,7 +272,7 @@ func (m *AggregateExchangeRatePrevote) Reset()         { *m = AggregateExchangeR
 func (m *AggregateExchangeRatePrevote) String() string { return proto.CompactTextString(m) }
 func (*AggregateExchangeRatePrevote) ProtoMessage()    {}
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{1}
+	return fileDescriptor_43d45df86ea09ed4, []int{2}
 }
 func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,7 +312,7 @@ func (m *AggregateExchangeRateVote) Reset()         { *m = AggregateExchangeRate
 func (m *AggregateExchangeRateVote) String() string { return proto.CompactTextString(m) }
 func (*AggregateExchangeRateVote) ProtoMessage()    {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{2}
+	return fileDescriptor_43d45df86ea09ed4, []int{3}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,7 +351,7 @@ func (m *ExchangeRateTuple) Reset()         { *m = ExchangeRateTuple{} }
 func (m *ExchangeRateTuple) String() string { return proto.CompactTextString(m) }
 func (*ExchangeRateTuple) ProtoMessage()    {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{3}
+	return fileDescriptor_43d45df86ea09ed4, []int{4}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,13 +387,16 @@ type ExchangeRateAtBlock struct {
 	// price. This timestamp is a conventional Unix millisecond time, i.e. the
 	// number of milliseconds elapsed since January 1, 1970 UTC.
 	BlockTimestampMs int64 `protobuf:"varint,3,opt,name=block_timestamp_ms,json=blockTimestampMs,proto3" json:"block_timestamp_ms,omitempty" yaml:"block_timestamp_ms"`
+	// Pairs the exchange rate was derived from if the pair is a DerivedPair.
+	// Empty if validators voted on the pair directly.
+	DerivedFrom []github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,4,rep,name=derived_from,json=derivedFrom,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"derived_from" yaml:"derived_from"`
 }
 
 func (m *ExchangeRateAtBlock) Reset()         { *m = ExchangeRateAtBlock{} }
 func (m *ExchangeRateAtBlock) String() string { return proto.CompactTextString(m) }
 func (*ExchangeRateAtBlock) ProtoMessage()    {}
 func (*ExchangeRateAtBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{4}
+	return fileDescriptor_43d45df86ea09ed4, []int{5}
 }
 func (m *ExchangeRateAtBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,6 +439,146 @@ func (m *ExchangeRateAtBlock) GetBlockTimestampMs() int64 {
 	return 0
 }
 
+// DerivedPair defines a pair that validators don't vote on. Its exchange rate
+// is computed from the exchange rates of two voted pairs that share the same
+// quote asset, each time both of them are tallied:
+//
+//	rate(pair) = rate(base_pair) / rate(quote_pair)
+//
+// Ex. "ueth:unibi" is derived from "ueth:uusd" and "unibi:uusd".
+type DerivedPair struct {
+	// The derived pair. Ex. "ueth:unibi"
+	Pair github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	// The voted pair of the base asset of the derived pair. Ex. "ueth:uusd"
+	BasePair github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,2,opt,name=base_pair,json=basePair,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"base_pair" yaml:"base_pair"`
+	// The voted pair of the quote asset of the derived pair. Ex. "unibi:uusd"
+	QuotePair github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,3,opt,name=quote_pair,json=quotePair,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"quote_pair" yaml:"quote_pair"`
+}
+
+func (m *DerivedPair) Reset()         { *m = DerivedPair{} }
+func (m *DerivedPair) String() string { return proto.CompactTextString(m) }
+func (*DerivedPair) ProtoMessage()    {}
+func (*DerivedPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{6}
+}
+func (m *DerivedPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DerivedPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DerivedPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DerivedPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedPair.Merge(m, src)
+}
+func (m *DerivedPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *DerivedPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedPair proto.InternalMessageInfo
+
+// VoteAccuracy records how far the exchange rate votes of a validator for a
+// pair deviated from the tallied exchange rate, i.e. the weighted median by
+// default, during a slash window. Deviations are relative to the tallied
+// exchange rate: |vote - rate| / rate.
+type VoteAccuracy struct {
+	// Operator address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+	// The voted pair.
+	Pair github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	// Index of the slash window, i.e. block height / slash_window.
+	SlashWindow uint64 `protobuf:"varint,3,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
+	// Number of tallied votes with a positive exchange rate.
+	VoteCount uint64 `protobuf:"varint,4,opt,name=vote_count,json=voteCount,proto3" json:"vote_count,omitempty" yaml:"vote_count"`
+	// Number of tallied votes inside the reward spread of the exchange rate.
+	WinCount uint64 `protobuf:"varint,5,opt,name=win_count,json=winCount,proto3" json:"win_count,omitempty" yaml:"win_count"`
+	// Number of tallied abstain votes.
+	AbstainCount uint64 `protobuf:"varint,6,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty" yaml:"abstain_count"`
+	// Sum of the relative deviations of the votes from the exchange rate.
+	SumDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=sum_deviation,json=sumDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"sum_deviation" yaml:"sum_deviation"`
+	// Largest relative deviation of a vote from the exchange rate.
+	MaxDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=max_deviation,json=maxDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_deviation" yaml:"max_deviation"`
+}
+
+func (m *VoteAccuracy) Reset()         { *m = VoteAccuracy{} }
+func (m *VoteAccuracy) String() string { return proto.CompactTextString(m) }
+func (*VoteAccuracy) ProtoMessage()    {}
+func (*VoteAccuracy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{7}
+}
+func (m *VoteAccuracy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteAccuracy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteAccuracy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteAccuracy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteAccuracy.Merge(m, src)
+}
+func (m *VoteAccuracy) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteAccuracy) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteAccuracy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteAccuracy proto.InternalMessageInfo
+
+func (m *VoteAccuracy) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *VoteAccuracy) GetSlashWindow() uint64 {
+	if m != nil {
+		return m.SlashWindow
+	}
+	return 0
+}
+
+func (m *VoteAccuracy) GetVoteCount() uint64 {
+	if m != nil {
+		return m.VoteCount
+	}
+	return 0
+}
+
+func (m *VoteAccuracy) GetWinCount() uint64 {
+	if m != nil {
+		return m.WinCount
+	}
+	return 0
+}
+
+func (m *VoteAccuracy) GetAbstainCount() uint64 {
+	if m != nil {
+		return m.AbstainCount
+	}
+	return 0
+}
+
 // Rewards defines a credit object towards validators
 // which provide prices faithfully for different pairs.
 type Rewards struct {
@@ -333,7 +595,7 @@ func (m *Rewards) Reset()         { *m = Rewards{} }
 func (m *Rewards) String() string { return proto.CompactTextString(m) }
 func (*Rewards) ProtoMessage()    {}
 func (*Rewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{5}
+	return fileDescriptor_43d45df86ea09ed4, []int{8}
 }
 func (m *Rewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,83 +646,120 @@ func (m *Rewards) GetCoins() []types.Coin {
 }
 
 func init() {
+	proto.RegisterEnum("nibiru.oracle.v1.AggregationMethod", AggregationMethod_name, AggregationMethod_value)
 	proto.RegisterType((*Params)(nil), "nibiru.oracle.v1.Params")
+	proto.RegisterType((*PairAggregation)(nil), "nibiru.oracle.v1.PairAggregation")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "nibiru.oracle.v1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "nibiru.oracle.v1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "nibiru.oracle.v1.ExchangeRateTuple")
 	proto.RegisterType((*ExchangeRateAtBlock)(nil), "nibiru.oracle.v1.ExchangeRateAtBlock")
+	proto.RegisterType((*DerivedPair)(nil), "nibiru.oracle.v1.DerivedPair")
+	proto.RegisterType((*VoteAccuracy)(nil), "nibiru.oracle.v1.VoteAccuracy")
 	proto.RegisterType((*Rewards)(nil), "nibiru.oracle.v1.Rewards")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/oracle.proto", fileDescriptor_43d45df86ea09ed4) }
 
 var fileDescriptor_43d45df86ea09ed4 = []byte{
-	// 1032 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x5e, 0x27, 0xdb, 0x34, 0x3b, 0x9b, 0x94, 0x64, 0x92, 0x82, 0x93, 0xb6, 0xeb, 0xe0, 0x4a,
-	0x28, 0x07, 0xb0, 0x95, 0x50, 0x84, 0x88, 0xc4, 0xa1, 0x6e, 0x28, 0x0a, 0x0d, 0xb0, 0xb2, 0x22,
-	0x90, 0xb8, 0x58, 0xb3, 0xf6, 0xc4, 0x1e, 0x62, 0x7b, 0x16, 0xcf, 0x6c, 0x36, 0x39, 0x21, 0x6e,
-	0x1c, 0xcb, 0x05, 0x71, 0xcc, 0x99, 0x1b, 0x12, 0x7f, 0x44, 0x8f, 0x15, 0x27, 0xd4, 0x83, 0x5b,
-	0x25, 0x97, 0x8a, 0x03, 0x87, 0xfd, 0x0b, 0xd0, 0xfc, 0xd8, 0xac, 0x93, 0xdd, 0xc3, 0xaa, 0x12,
-	0x37, 0xcf, 0xfb, 0x9e, 0xbf, 0xf7, 0xbd, 0x1f, 0x7e, 0x1e, 0x70, 0x2f, 0x27, 0x1d, 0x52, 0xf4,
-	0x5c, 0x5a, 0xa0, 0x30, 0xc5, 0xee, 0xf1, 0x96, 0x7e, 0x72, 0xba, 0x05, 0xe5, 0x14, 0x2e, 0x29,
-	0xd8, 0xd1, 0xc6, 0xe3, 0xad, 0xf5, 0xd5, 0x98, 0xc6, 0x54, 0x82, 0xae, 0x78, 0x52, 0x7e, 0xeb,
-	0xad, 0x98, 0xd2, 0x38, 0xc5, 0xae, 0x3c, 0x75, 0x7a, 0x87, 0x6e, 0xd4, 0x2b, 0x10, 0x27, 0x34,
-	0x1f, 0xe2, 0x21, 0x65, 0x19, 0x65, 0x6e, 0x07, 0x31, 0x11, 0xa4, 0x83, 0x39, 0xda, 0x72, 0x43,
-	0x4a, 0x86, 0xf8, 0x9a, 0xc2, 0x03, 0x45, 0xac, 0x0e, 0x0a, 0xb2, 0xcb, 0x79, 0x30, 0xd7, 0x46,
-	0x05, 0xca, 0x18, 0xfc, 0x18, 0x34, 0x8f, 0x29, 0xc7, 0x41, 0x17, 0x17, 0x84, 0x46, 0xa6, 0xb1,
-	0x61, 0x6c, 0xd6, 0xbd, 0xb7, 0x07, 0xa5, 0x05, 0x4f, 0x51, 0x96, 0xee, 0xd8, 0x15, 0xd0, 0xf6,
-	0x81, 0x38, 0xb5, 0xe5, 0x01, 0xfe, 0x00, 0x6e, 0x49, 0x8c, 0x27, 0x05, 0x66, 0x09, 0x4d, 0x23,
-	0x73, 0x66, 0xc3, 0xd8, 0x6c, 0x78, 0x5f, 0x3c, 0x2b, 0xad, 0xda, 0x8b, 0xd2, 0xba, 0xa3, 0x22,
-	0xb2, 0xe8, 0xc8, 0x21, 0xd4, 0xcd, 0x10, 0x4f, 0x9c, 0x7d, 0x1c, 0xa3, 0xf0, 0x74, 0x17, 0x87,
-	0x83, 0xd2, 0xba, 0x5d, 0xa1, 0xbf, 0xa4, 0xb0, 0xff, 0xfa, 0xf3, 0x03, 0xa0, 0x95, 0xee, 0xe2,
-	0xd0, 0x5f, 0x14, 0xf0, 0xc1, 0x10, 0x85, 0x09, 0x68, 0x16, 0xb8, 0x8f, 0x8a, 0x28, 0xe8, 0xa0,
-	0x3c, 0x32, 0x67, 0x65, 0xbc, 0xcf, 0xa7, 0x8b, 0xa7, 0xd3, 0xa9, 0xbc, 0x7f, 0x3d, 0x18, 0x50,
-	0x98, 0x87, 0xf2, 0x08, 0x7e, 0x0f, 0x1a, 0xfd, 0x84, 0x70, 0x9c, 0x12, 0xc6, 0xcd, 0xfa, 0xc6,
-	0xec, 0x66, 0xc3, 0xdb, 0x7f, 0x51, 0x5a, 0x0f, 0x62, 0xc2, 0x93, 0x5e, 0xc7, 0x09, 0x69, 0xe6,
-	0x7e, 0x25, 0xbb, 0xf8, 0x28, 0x41, 0x24, 0x77, 0x75, 0xc3, 0x8f, 0xb7, 0xdd, 0x13, 0x37, 0xa4,
-	0x59, 0x46, 0x73, 0x17, 0x31, 0x86, 0xb9, 0xd3, 0x46, 0xa4, 0x18, 0x94, 0xd6, 0x92, 0x0a, 0x7e,
-	0x49, 0x69, 0xfb, 0x23, 0x7a, 0x51, 0x48, 0x96, 0x22, 0x96, 0x04, 0x87, 0x05, 0x0a, 0x45, 0x7f,
-	0xcd, 0x1b, 0x6f, 0x50, 0xc8, 0xab, 0x14, 0x63, 0x85, 0x94, 0xf0, 0x63, 0x8d, 0xc2, 0x1d, 0xb0,
-	0xa0, 0xfc, 0xfb, 0x24, 0x8f, 0x68, 0xdf, 0x9c, 0x93, 0x5d, 0x7f, 0x67, 0x50, 0x5a, 0x2b, 0x55,
-	0x36, 0x85, 0xda, 0x7e, 0x53, 0x1e, 0xbf, 0x95, 0x27, 0xf8, 0x93, 0x01, 0x56, 0x33, 0x92, 0x07,
-	0xc7, 0x28, 0x25, 0x91, 0x98, 0x8c, 0x21, 0xc9, 0x4d, 0xa9, 0xba, 0x3d, 0x9d, 0xea, 0x3b, 0x2a,
-	0xce, 0x24, 0xa2, 0xeb, 0xda, 0x97, 0x33, 0x92, 0x7f, 0x23, 0x7c, 0xda, 0xb8, 0xd0, 0x1a, 0x7e,
-	0x35, 0xc0, 0x2a, 0xef, 0xa3, 0x6e, 0x90, 0x52, 0x7a, 0xd4, 0x41, 0xe1, 0xd1, 0x50, 0xc3, 0xfc,
-	0x86, 0xb1, 0xd9, 0xdc, 0x5e, 0x73, 0xd4, 0xa7, 0xe3, 0x0c, 0x3f, 0x1d, 0x67, 0x57, 0x7f, 0x3a,
-	0xde, 0x9e, 0x90, 0xf7, 0x4f, 0x69, 0xb5, 0x26, 0xbd, 0xfe, 0x3e, 0xcd, 0x08, 0xc7, 0x59, 0x97,
-	0x9f, 0x8e, 0x14, 0x4e, 0xf2, 0xb3, 0x7f, 0x7b, 0x69, 0x19, 0x3e, 0x14, 0xd0, 0xbe, 0x46, 0xb4,
-	0xb0, 0x07, 0x00, 0xc8, 0x94, 0x28, 0xc7, 0x05, 0x33, 0x1b, 0xb2, 0xac, 0xb7, 0x07, 0xa5, 0xb5,
-	0x5c, 0x49, 0x57, 0x62, 0xb6, 0xdf, 0x10, 0x69, 0xc9, 0x67, 0xf8, 0x23, 0x58, 0x91, 0x45, 0x40,
-	0x9c, 0x16, 0xc1, 0x21, 0xc6, 0x81, 0x14, 0x6b, 0x02, 0x59, 0xd0, 0xaf, 0xa7, 0x2b, 0xe8, 0xba,
-	0xfe, 0x9e, 0xc6, 0x79, 0xc6, 0xea, 0x79, 0xe9, 0xf3, 0x18, 0x63, 0x5f, 0x78, 0xc0, 0x3d, 0xb0,
-	0x8c, 0x4f, 0xba, 0x44, 0xd5, 0x28, 0xe8, 0xa4, 0x34, 0x3c, 0x62, 0x66, 0x53, 0xaa, 0xbf, 0x3b,
-	0x28, 0x2d, 0x53, 0x71, 0x8f, 0xb9, 0xd8, 0xfe, 0xd2, 0xc8, 0xe6, 0x49, 0xd3, 0x4e, 0xfd, 0xf5,
-	0x99, 0x65, 0xd8, 0x7f, 0x18, 0xe0, 0xee, 0xc3, 0x38, 0x2e, 0x70, 0x8c, 0x38, 0xfe, 0xec, 0x24,
-	0x4c, 0x50, 0x1e, 0x8b, 0x58, 0xb8, 0x5d, 0x60, 0x51, 0x00, 0x78, 0x1f, 0xd4, 0x13, 0xc4, 0x12,
-	0xb9, 0x6f, 0x1a, 0xde, 0x5b, 0x83, 0xd2, 0x6a, 0xaa, 0x20, 0xc2, 0x6a, 0xfb, 0x12, 0x84, 0xef,
-	0x81, 0x1b, 0xb2, 0x5a, 0x7a, 0xb3, 0x2c, 0x0d, 0x4a, 0x6b, 0x61, 0xb4, 0x36, 0x0a, 0xdb, 0x57,
-	0xb0, 0x1c, 0xe7, 0x5e, 0x27, 0x23, 0x5c, 0xe9, 0x92, 0x8b, 0xe1, 0xea, 0x38, 0x57, 0x50, 0x31,
-	0xce, 0xf2, 0x28, 0x05, 0xef, 0xcc, 0xff, 0x7c, 0x66, 0xd5, 0x5e, 0x9f, 0x59, 0x35, 0xfb, 0x95,
-	0x01, 0xd6, 0x26, 0x6a, 0x16, 0x5d, 0x82, 0x4f, 0x0d, 0xb0, 0x8a, 0xb5, 0x51, 0xd4, 0x15, 0x07,
-	0xbc, 0xd7, 0x4d, 0x31, 0x33, 0x8d, 0x8d, 0xd9, 0xcd, 0xe6, 0xf6, 0x7d, 0xe7, 0xfa, 0x56, 0x77,
-	0xaa, 0x14, 0x07, 0xc2, 0xd7, 0xfb, 0x44, 0xb4, 0x72, 0x34, 0x5a, 0x93, 0xe8, 0xec, 0xdf, 0x5f,
-	0x5a, 0x70, 0xec, 0x4d, 0xe6, 0x43, 0x3c, 0x66, 0x9b, 0xb6, 0x3c, 0x95, 0x14, 0xff, 0x35, 0xc0,
-	0xf2, 0x18, 0x39, 0x0c, 0x40, 0xbd, 0x8b, 0x48, 0xa1, 0x7b, 0xf1, 0x44, 0xcf, 0xdb, 0x9b, 0xee,
-	0x3a, 0xdd, 0x47, 0xc1, 0x68, 0xfb, 0x92, 0x18, 0xe6, 0x60, 0xf1, 0x4a, 0xae, 0x5a, 0xf0, 0xde,
-	0x74, 0x93, 0xbd, 0x3a, 0xa1, 0x5a, 0xd7, 0x67, 0x7a, 0xa1, 0x5a, 0x9e, 0x4a, 0xc2, 0xbf, 0xcc,
-	0x80, 0x95, 0x6a, 0xc2, 0x0f, 0x55, 0xd7, 0xc7, 0x15, 0x19, 0xff, 0xab, 0x22, 0xf8, 0x29, 0x58,
-	0x0c, 0x0b, 0x8c, 0x38, 0x8e, 0xf4, 0x88, 0xce, 0xc8, 0x11, 0x35, 0x47, 0x64, 0x57, 0x60, 0xdb,
-	0x5f, 0xd0, 0x67, 0x25, 0xf7, 0x09, 0x80, 0xd2, 0x1e, 0x70, 0x92, 0x61, 0xc6, 0x51, 0xd6, 0x0d,
-	0x32, 0x26, 0xc7, 0x7c, 0xd6, 0xbb, 0x37, 0x28, 0xad, 0x35, 0xc5, 0x31, 0xee, 0x63, 0xfb, 0x4b,
-	0xd2, 0x78, 0x30, 0xb4, 0x7d, 0xc9, 0x6c, 0x06, 0x6e, 0xfa, 0xf2, 0x4f, 0xc7, 0xe0, 0x2d, 0x30,
-	0x43, 0xf4, 0x3f, 0xdf, 0x9f, 0x21, 0x11, 0x7c, 0x17, 0x2c, 0x54, 0xfe, 0xf7, 0x4c, 0xa9, 0xf4,
-	0x9b, 0xa3, 0xbf, 0x3e, 0x83, 0x1f, 0x81, 0x1b, 0xe2, 0x8e, 0x21, 0xa2, 0xcf, 0xca, 0x55, 0xab,
-	0x73, 0x16, 0xb7, 0x10, 0x47, 0xdf, 0x42, 0x9c, 0x47, 0x94, 0xe4, 0x5e, 0x5d, 0x14, 0xd3, 0x57,
-	0xde, 0xde, 0xde, 0xb3, 0xf3, 0x96, 0xf1, 0xfc, 0xbc, 0x65, 0xbc, 0x3a, 0x6f, 0x19, 0x4f, 0x2f,
-	0x5a, 0xb5, 0xe7, 0x17, 0xad, 0xda, 0xdf, 0x17, 0xad, 0xda, 0x77, 0xee, 0x14, 0x73, 0xa6, 0x6f,
-	0x52, 0xfc, 0xb4, 0x8b, 0x59, 0x67, 0x4e, 0x6e, 0xf5, 0x0f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0x82, 0x82, 0xec, 0xf5, 0x67, 0x09, 0x00, 0x00,
+	// 1560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0xdb, 0x46,
+	0x16, 0x17, 0x25, 0xd9, 0xb1, 0x46, 0x92, 0x23, 0x4d, 0x9c, 0x5d, 0xda, 0x49, 0x44, 0x87, 0xd9,
+	0x0d, 0x8c, 0x60, 0x57, 0x82, 0xbd, 0x59, 0x2c, 0xd6, 0x40, 0x0e, 0x52, 0xe4, 0x38, 0xda, 0xc4,
+	0x8e, 0x97, 0x31, 0x12, 0x60, 0x2f, 0xc4, 0x88, 0x1c, 0x4b, 0x5c, 0x8b, 0x1c, 0x85, 0x33, 0x92,
+	0xed, 0x53, 0xd1, 0x5b, 0x0a, 0xf4, 0x90, 0x53, 0xd1, 0x63, 0x80, 0x5e, 0x8a, 0xde, 0x5a, 0xf4,
+	0x43, 0xe4, 0xd6, 0xa0, 0xa7, 0x22, 0x07, 0x25, 0x48, 0x0e, 0x0d, 0x7a, 0xe8, 0x41, 0x9f, 0xa0,
+	0x98, 0xe1, 0x48, 0xa4, 0x44, 0xa7, 0x70, 0x1d, 0xf8, 0xc6, 0x37, 0xbf, 0x37, 0xbf, 0xf7, 0x67,
+	0xde, 0x7b, 0x9a, 0x11, 0xb8, 0xe2, 0x39, 0x4d, 0xc7, 0xef, 0x55, 0x88, 0x8f, 0xac, 0x0e, 0xae,
+	0xf4, 0x57, 0xe5, 0x57, 0xb9, 0xeb, 0x13, 0x46, 0x60, 0x21, 0x80, 0xcb, 0x72, 0xb1, 0xbf, 0xba,
+	0xb4, 0xd0, 0x22, 0x2d, 0x22, 0xc0, 0x0a, 0xff, 0x0a, 0xf4, 0x96, 0x4a, 0x2d, 0x42, 0x5a, 0x1d,
+	0x5c, 0x11, 0x52, 0xb3, 0xb7, 0x57, 0xb1, 0x7b, 0x3e, 0x62, 0x0e, 0xf1, 0x46, 0xb8, 0x45, 0xa8,
+	0x4b, 0x68, 0xa5, 0x89, 0x28, 0x37, 0xd2, 0xc4, 0x0c, 0xad, 0x56, 0x2c, 0xe2, 0x8c, 0xf0, 0xc5,
+	0x00, 0x37, 0x03, 0xe2, 0x40, 0x08, 0x20, 0xfd, 0xeb, 0x2c, 0x98, 0xdd, 0x41, 0x3e, 0x72, 0x29,
+	0xfc, 0x17, 0xc8, 0xf6, 0x09, 0xc3, 0x66, 0x17, 0xfb, 0x0e, 0xb1, 0x55, 0x65, 0x59, 0x59, 0x49,
+	0xd7, 0xfe, 0x34, 0x1c, 0x68, 0xf0, 0x08, 0xb9, 0x9d, 0x75, 0x3d, 0x02, 0xea, 0x06, 0xe0, 0xd2,
+	0x8e, 0x10, 0xe0, 0x13, 0x30, 0x2f, 0x30, 0xd6, 0xf6, 0x31, 0x6d, 0x93, 0x8e, 0xad, 0x26, 0x97,
+	0x95, 0x95, 0x4c, 0xed, 0x3f, 0x2f, 0x06, 0x5a, 0xe2, 0xd5, 0x40, 0xbb, 0x14, 0x58, 0xa4, 0xf6,
+	0x7e, 0xd9, 0x21, 0x15, 0x17, 0xb1, 0x76, 0xf9, 0x3e, 0x6e, 0x21, 0xeb, 0xa8, 0x8e, 0xad, 0xe1,
+	0x40, 0xbb, 0x18, 0xa1, 0x1f, 0x53, 0xe8, 0x3f, 0x7e, 0xff, 0x77, 0x20, 0x3d, 0xad, 0x63, 0xcb,
+	0xc8, 0x73, 0x78, 0x77, 0x84, 0xc2, 0x36, 0xc8, 0xfa, 0xf8, 0x00, 0xf9, 0xb6, 0xd9, 0x44, 0x9e,
+	0xad, 0xa6, 0x84, 0xbd, 0xcd, 0x93, 0xd9, 0x93, 0xe1, 0x44, 0xf6, 0x4f, 0x1b, 0x03, 0x01, 0x56,
+	0x43, 0x9e, 0x0d, 0xff, 0x0f, 0x32, 0x07, 0x6d, 0x87, 0xe1, 0x8e, 0x43, 0x99, 0x9a, 0x5e, 0x4e,
+	0xad, 0x64, 0x6a, 0xf7, 0x5f, 0x0d, 0xb4, 0x9b, 0x2d, 0x87, 0xb5, 0x7b, 0xcd, 0xb2, 0x45, 0xdc,
+	0xca, 0xb6, 0x38, 0xc5, 0xdb, 0x6d, 0xe4, 0x78, 0x15, 0x79, 0xe0, 0xfd, 0xb5, 0xca, 0x61, 0xc5,
+	0x22, 0xae, 0x4b, 0xbc, 0x0a, 0xa2, 0x14, 0xb3, 0xf2, 0x0e, 0x72, 0xfc, 0xe1, 0x40, 0x2b, 0x04,
+	0xc6, 0xc7, 0x94, 0xba, 0x11, 0xd2, 0xf3, 0x44, 0xd2, 0x0e, 0xa2, 0x6d, 0x73, 0xcf, 0x47, 0x16,
+	0x3f, 0x5f, 0x75, 0xe6, 0x14, 0x89, 0x9c, 0xa4, 0x88, 0x25, 0x52, 0xc0, 0x77, 0x24, 0x0a, 0xd7,
+	0x41, 0x2e, 0xd0, 0x3f, 0x70, 0x3c, 0x9b, 0x1c, 0xa8, 0xb3, 0xe2, 0xd4, 0xff, 0x3c, 0x1c, 0x68,
+	0x17, 0xa2, 0x6c, 0x01, 0xaa, 0x1b, 0x59, 0x21, 0x3e, 0x16, 0x12, 0xfc, 0x54, 0x01, 0x0b, 0xae,
+	0xe3, 0x99, 0x7d, 0xd4, 0x71, 0x6c, 0x5e, 0x19, 0x23, 0x92, 0x73, 0xc2, 0xeb, 0x9d, 0x93, 0x79,
+	0x7d, 0x29, 0xb0, 0x73, 0x1c, 0xd1, 0xb4, 0xef, 0x45, 0xd7, 0xf1, 0x1e, 0x71, 0x9d, 0x1d, 0xec,
+	0x4b, 0x1f, 0xbe, 0x50, 0xc0, 0x02, 0x3b, 0x40, 0x5d, 0xb3, 0x43, 0xc8, 0x7e, 0x13, 0x59, 0xfb,
+	0x23, 0x1f, 0xe6, 0x96, 0x95, 0x95, 0xec, 0xda, 0x62, 0x39, 0x68, 0x9d, 0xf2, 0xa8, 0x75, 0xca,
+	0x75, 0xd9, 0x3a, 0xb5, 0x06, 0x77, 0xef, 0x97, 0x81, 0x56, 0x3a, 0x6e, 0xfb, 0xdf, 0x88, 0xeb,
+	0x30, 0xec, 0x76, 0xd9, 0x51, 0xe8, 0xe1, 0x71, 0x7a, 0xfa, 0x97, 0xaf, 0x35, 0xc5, 0x80, 0x1c,
+	0xba, 0x2f, 0x11, 0xe9, 0xd8, 0x4d, 0x00, 0x44, 0x48, 0x84, 0x61, 0x9f, 0xaa, 0x19, 0x91, 0xd6,
+	0x8b, 0xc3, 0x81, 0x56, 0x8c, 0x84, 0x2b, 0x30, 0xdd, 0xc8, 0xf0, 0xb0, 0xc4, 0x37, 0xfc, 0x04,
+	0x5c, 0x10, 0x49, 0x40, 0x8c, 0xf8, 0xe6, 0x1e, 0xc6, 0xa6, 0x70, 0x56, 0x05, 0x22, 0xa1, 0x0f,
+	0x4e, 0x96, 0xd0, 0x25, 0xd9, 0x4f, 0x71, 0x9e, 0x58, 0x3e, 0xc7, 0x3a, 0x77, 0x30, 0x36, 0xb8,
+	0x06, 0x6c, 0x80, 0x22, 0x3e, 0xec, 0x3a, 0x41, 0x8e, 0xcc, 0x66, 0x87, 0x58, 0xfb, 0x54, 0xcd,
+	0x0a, 0xef, 0x2f, 0x0f, 0x07, 0x9a, 0x1a, 0x70, 0xc7, 0x54, 0x74, 0xa3, 0x10, 0xae, 0xd5, 0xc4,
+	0x12, 0xb4, 0xc0, 0x12, 0xf5, 0x50, 0x97, 0xb6, 0x09, 0x33, 0x7d, 0xcc, 0xb0, 0x27, 0xf4, 0x83,
+	0xbc, 0x51, 0x35, 0x27, 0x38, 0xff, 0x3a, 0x1c, 0x68, 0x57, 0x65, 0xa1, 0x7d, 0x50, 0x57, 0x37,
+	0xd4, 0x11, 0x68, 0x8c, 0xb0, 0x20, 0xcb, 0x14, 0x76, 0x41, 0xb1, 0x8b, 0x1c, 0xdf, 0x44, 0xad,
+	0x96, 0x8f, 0x5b, 0xc2, 0x3c, 0x55, 0xf3, 0xcb, 0xa9, 0x95, 0xec, 0xda, 0xd5, 0xf2, 0xf4, 0x78,
+	0x15, 0x4d, 0x58, 0x0d, 0x35, 0x6b, 0xcb, 0x3c, 0xa3, 0x61, 0x58, 0x31, 0x26, 0xdd, 0x28, 0x74,
+	0x27, 0xb7, 0x50, 0xf8, 0x04, 0x68, 0x62, 0x54, 0x21, 0xcb, 0xea, 0xf9, 0xc8, 0x3a, 0x3a, 0x26,
+	0xb6, 0x79, 0x11, 0xdb, 0x8d, 0xe1, 0x40, 0xbb, 0x1e, 0x99, 0x6d, 0x1f, 0xde, 0xa0, 0x1b, 0x97,
+	0xb9, 0x46, 0x55, 0x2a, 0x4c, 0x07, 0xb9, 0x9e, 0x7e, 0xff, 0x5c, 0x53, 0xf4, 0xef, 0x52, 0xe0,
+	0xfc, 0x54, 0x00, 0xd0, 0x04, 0x69, 0xee, 0xa0, 0x18, 0xd6, 0x99, 0xda, 0x3d, 0x59, 0x20, 0xa7,
+	0x1d, 0x4e, 0xd9, 0x30, 0x0d, 0xba, 0x21, 0x88, 0xe1, 0x36, 0x98, 0x75, 0x31, 0x6b, 0x93, 0x60,
+	0xa6, 0xcf, 0xaf, 0x5d, 0x8b, 0x27, 0x35, 0xe2, 0xcf, 0x96, 0x50, 0xad, 0x15, 0x87, 0x03, 0x2d,
+	0x2f, 0xeb, 0x5c, 0xac, 0xe8, 0x86, 0x64, 0x81, 0x1e, 0xc8, 0x33, 0xdf, 0x71, 0xc3, 0x09, 0x17,
+	0x8c, 0xee, 0xc6, 0xc9, 0x4a, 0x7b, 0x41, 0x76, 0x62, 0x94, 0x61, 0xba, 0xa8, 0x73, 0x1c, 0x1d,
+	0xcf, 0xb7, 0x43, 0x50, 0x74, 0xd1, 0xa1, 0x49, 0x99, 0x6d, 0xda, 0xb8, 0x2f, 0xdb, 0x29, 0x2d,
+	0x6c, 0x6e, 0x9d, 0xcc, 0xa6, 0xac, 0x8d, 0x18, 0xcb, 0xb4, 0xdd, 0x79, 0x17, 0x1d, 0x3e, 0x64,
+	0x76, 0x1d, 0xf7, 0x45, 0x27, 0xc9, 0x43, 0xfb, 0x56, 0x01, 0x97, 0x47, 0x09, 0xc2, 0x1b, 0x87,
+	0x56, 0x1b, 0x79, 0x2d, 0xde, 0x6a, 0x78, 0xc7, 0xc7, 0xfc, 0xcc, 0xe1, 0x35, 0x90, 0x6e, 0x23,
+	0xda, 0x96, 0x27, 0x78, 0x3e, 0x3c, 0x05, 0xbe, 0xaa, 0x1b, 0x02, 0x84, 0xd7, 0xc1, 0x0c, 0x57,
+	0xf6, 0xe5, 0x0f, 0x6b, 0x61, 0x38, 0xd0, 0x72, 0x61, 0x65, 0xf9, 0xba, 0x11, 0xc0, 0x62, 0x9a,
+	0xf7, 0x9a, 0xae, 0xc3, 0x82, 0xb6, 0x54, 0x53, 0xb1, 0x69, 0x1e, 0x41, 0xf9, 0x34, 0x17, 0xa2,
+	0xe8, 0xd7, 0xf5, 0xb9, 0xa7, 0xcf, 0xb5, 0xc4, 0xfb, 0xe7, 0x5a, 0x42, 0x7f, 0xa3, 0x80, 0xc5,
+	0x63, 0x7d, 0xe6, 0x43, 0x0a, 0x3e, 0x53, 0xc0, 0x02, 0x96, 0x8b, 0x3c, 0x13, 0xd8, 0x64, 0xbd,
+	0x6e, 0x07, 0x53, 0x55, 0x11, 0x5d, 0x77, 0x4c, 0x81, 0x44, 0x29, 0x76, 0xb9, 0x6e, 0xed, 0xdf,
+	0xb2, 0xef, 0x2e, 0x8d, 0xc6, 0x49, 0x9c, 0x4e, 0xff, 0xe6, 0xb5, 0x06, 0x63, 0x3b, 0xa9, 0x01,
+	0x71, 0x6c, 0xed, 0xa4, 0xe9, 0x89, 0x84, 0xf8, 0xab, 0x02, 0x8a, 0x31, 0xf2, 0xb3, 0xef, 0x26,
+	0x0f, 0xe4, 0x27, 0x62, 0x55, 0x93, 0xa7, 0xa8, 0xfe, 0x09, 0x86, 0x58, 0xf5, 0x47, 0xd3, 0x13,
+	0x09, 0xf8, 0xb3, 0x14, 0xb8, 0x10, 0x0d, 0xb8, 0x1a, 0x9c, 0x7a, 0xdc, 0x23, 0xe5, 0x4c, 0x3d,
+	0x82, 0xb7, 0x40, 0xde, 0xf2, 0x31, 0x62, 0xd8, 0x96, 0x25, 0x9a, 0x14, 0x25, 0xaa, 0x86, 0x64,
+	0x13, 0xb0, 0x6e, 0xe4, 0xa4, 0x1c, 0xb8, 0x7b, 0x0f, 0x40, 0xb1, 0x6e, 0x32, 0xc7, 0xc5, 0x94,
+	0x21, 0xb7, 0x6b, 0xba, 0x54, 0x94, 0x79, 0xaa, 0x76, 0x65, 0x38, 0xd0, 0x16, 0x03, 0x8e, 0xb8,
+	0x8e, 0x6e, 0x14, 0xc4, 0xe2, 0xee, 0x68, 0x6d, 0x8b, 0xc2, 0x3e, 0xc8, 0xd9, 0xd8, 0x77, 0xfa,
+	0xd8, 0x36, 0xf7, 0x7c, 0xe2, 0xca, 0xdb, 0xdd, 0xc3, 0x8f, 0x3c, 0x76, 0xd9, 0x69, 0x51, 0x66,
+	0xdd, 0xc8, 0x4a, 0xf1, 0x0e, 0x97, 0x7e, 0x48, 0x82, 0x6c, 0x3d, 0x90, 0xf9, 0x9e, 0xb3, 0x2f,
+	0x3b, 0x17, 0x64, 0xf8, 0xd3, 0xc0, 0x14, 0x56, 0x92, 0x13, 0x97, 0xb3, 0x8f, 0xbc, 0xc7, 0x8e,
+	0x69, 0x75, 0x63, 0x8e, 0x7f, 0x8b, 0x78, 0xba, 0x00, 0x3c, 0xe9, 0x11, 0x26, 0xed, 0x05, 0x03,
+	0xfe, 0xbf, 0x1f, 0x69, 0x4f, 0x5e, 0x9b, 0x42, 0x5e, 0xdd, 0xc8, 0x08, 0x81, 0xe3, 0xfa, 0xcf,
+	0x69, 0x90, 0x7b, 0x14, 0xf9, 0x05, 0x85, 0x6b, 0x20, 0x33, 0xbe, 0xdb, 0xc8, 0xbc, 0x2e, 0x84,
+	0x5e, 0x8f, 0x21, 0xdd, 0x08, 0xd5, 0xc6, 0xc7, 0x90, 0x3c, 0xab, 0x63, 0x98, 0xbe, 0x6b, 0xa7,
+	0xfe, 0xc0, 0x5d, 0xfb, 0x26, 0x10, 0x2f, 0x2e, 0xd3, 0x22, 0x3d, 0x8f, 0xa9, 0xe9, 0xe9, 0xeb,
+	0x64, 0x88, 0xf1, 0x90, 0x08, 0xc3, 0xb7, 0xf9, 0x37, 0x5c, 0x05, 0x99, 0x03, 0xc7, 0x93, 0x9b,
+	0x66, 0xc4, 0xa6, 0x48, 0x1a, 0xc6, 0x90, 0x6e, 0xcc, 0x1d, 0x38, 0x5e, 0xb0, 0xe5, 0x16, 0xc8,
+	0xa3, 0x26, 0x65, 0x68, 0xbc, 0x6d, 0x76, 0xba, 0x41, 0x27, 0x60, 0xdd, 0xc8, 0x49, 0x39, 0xd8,
+	0xee, 0x81, 0x3c, 0xed, 0xb9, 0xfc, 0x57, 0xd2, 0x11, 0x37, 0x02, 0xf5, 0xdc, 0x29, 0xe6, 0xc9,
+	0x04, 0x43, 0x6c, 0x9e, 0xd0, 0x9e, 0x5b, 0x1f, 0x81, 0xdc, 0x1e, 0xff, 0x65, 0x0e, 0xed, 0xcd,
+	0x9d, 0xc2, 0xde, 0x04, 0x43, 0xcc, 0x9e, 0x8b, 0x0e, 0xc7, 0xf6, 0x74, 0x0a, 0xce, 0x19, 0xe2,
+	0x71, 0x48, 0xe1, 0x3c, 0x48, 0x3a, 0xf2, 0x99, 0x6c, 0x24, 0x1d, 0x1b, 0x5e, 0x05, 0xb9, 0xc8,
+	0x13, 0x99, 0x06, 0x93, 0xcd, 0xc8, 0x86, 0x0f, 0x65, 0x0a, 0xff, 0x09, 0x66, 0xf8, 0xb3, 0x9c,
+	0x4f, 0xac, 0x94, 0x78, 0x9d, 0x48, 0x3b, 0xbc, 0x75, 0xca, 0xf2, 0xe1, 0x5e, 0xbe, 0x4d, 0x1c,
+	0xaf, 0x96, 0xe6, 0x01, 0x18, 0x81, 0xf6, 0x8d, 0xcf, 0x15, 0x50, 0x8c, 0xdd, 0xb2, 0xe0, 0x75,
+	0xa0, 0x57, 0x37, 0x37, 0x8d, 0x8d, 0xcd, 0xea, 0x6e, 0xe3, 0xc1, 0xb6, 0xb9, 0xb5, 0xb1, 0x7b,
+	0xf7, 0x41, 0xdd, 0x7c, 0xbc, 0xd1, 0xd8, 0xbc, 0xbb, 0xbb, 0x51, 0x37, 0xb7, 0x36, 0xea, 0x8d,
+	0xea, 0x76, 0x21, 0x01, 0xaf, 0x01, 0xed, 0x18, 0xbd, 0x5d, 0xa3, 0xb1, 0xb5, 0x25, 0xd4, 0xaa,
+	0xdb, 0x05, 0x05, 0xfe, 0x05, 0x2c, 0xff, 0x3e, 0x59, 0x75, 0xbb, 0x90, 0x5c, 0x4a, 0x3f, 0xfd,
+	0xaa, 0x94, 0xa8, 0x35, 0x5e, 0xbc, 0x2d, 0x29, 0x2f, 0xdf, 0x96, 0x94, 0x37, 0x6f, 0x4b, 0xca,
+	0xb3, 0x77, 0xa5, 0xc4, 0xcb, 0x77, 0xa5, 0xc4, 0x4f, 0xef, 0x4a, 0x89, 0xff, 0x55, 0x4e, 0xd0,
+	0x2c, 0xf2, 0xbf, 0x10, 0x76, 0xd4, 0xc5, 0xb4, 0x39, 0x2b, 0xde, 0x65, 0xff, 0xf8, 0x6d, 0x00,
+	0x36, 0x79, 0xda, 0x77, 0x29, 0x11, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -520,6 +819,53 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ExpirationBlocks != that1.ExpirationBlocks {
 		return false
 	}
+	if this.SnapshotRetentionWindows != that1.SnapshotRetentionWindows {
+		return false
+	}
+	if len(this.PairAggregations) != len(that1.PairAggregations) {
+		return false
+	}
+	for i := range this.PairAggregations {
+		if !this.PairAggregations[i].Equal(&that1.PairAggregations[i]) {
+			return false
+		}
+	}
+	if this.VoteAccuracyRetentionWindows != that1.VoteAccuracyRetentionWindows {
+		return false
+	}
+	return true
+}
+func (this *PairAggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PairAggregation)
+	if !ok {
+		that2, ok := that.(PairAggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pair.Equal(that1.Pair) {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if !this.TrimFraction.Equal(that1.TrimFraction) {
+		return false
+	}
+	if !this.MaxStdDevRatio.Equal(that1.MaxStdDevRatio) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -542,6 +888,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VoteAccuracyRetentionWindows != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VoteAccuracyRetentionWindows))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.PairAggregations) > 0 {
+		for iNdEx := len(m.PairAggregations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairAggregations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.SnapshotRetentionWindows != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SnapshotRetentionWindows))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.ExpirationBlocks != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.ExpirationBlocks))
 		i--
@@ -637,6 +1007,64 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PairAggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairAggregation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairAggregation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxStdDevRatio.Size()
+		i -= size
+		if _, err := m.MaxStdDevRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TrimFraction.Size()
+		i -= size
+		if _, err := m.TrimFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Method != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Method))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -786,6 +1214,20 @@ func (m *ExchangeRateAtBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DerivedFrom) > 0 {
+		for iNdEx := len(m.DerivedFrom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.DerivedFrom[iNdEx].Size()
+				i -= size
+				if _, err := m.DerivedFrom[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.BlockTimestampMs != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.BlockTimestampMs))
 		i--
@@ -809,7 +1251,7 @@ func (m *ExchangeRateAtBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Rewards) Marshal() (dAtA []byte, err error) {
+func (m *DerivedPair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -819,34 +1261,167 @@ func (m *Rewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Rewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *DerivedPair) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Rewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DerivedPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	{
+		size := m.QuotePair.Size()
+		i -= size
+		if _, err := m.QuotePair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if m.VotePeriods != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriods))
-		i--
-		dAtA[i] = 0x10
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BasePair.Size()
+		i -= size
+		if _, err := m.BasePair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteAccuracy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteAccuracy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteAccuracy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxDeviation.Size()
+		i -= size
+		if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SumDeviation.Size()
+		i -= size
+		if _, err := m.SumDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.AbstainCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AbstainCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WinCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VoteCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VoteCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SlashWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SlashWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Rewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Rewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Rewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.VotePeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.Id != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.Id))
@@ -903,6 +1478,36 @@ func (m *Params) Size() (n int) {
 	if m.ExpirationBlocks != 0 {
 		n += 1 + sovOracle(uint64(m.ExpirationBlocks))
 	}
+	if m.SnapshotRetentionWindows != 0 {
+		n += 1 + sovOracle(uint64(m.SnapshotRetentionWindows))
+	}
+	if len(m.PairAggregations) > 0 {
+		for _, e := range m.PairAggregations {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.VoteAccuracyRetentionWindows != 0 {
+		n += 1 + sovOracle(uint64(m.VoteAccuracyRetentionWindows))
+	}
+	return n
+}
+
+func (m *PairAggregation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Method != 0 {
+		n += 1 + sovOracle(uint64(m.Method))
+	}
+	l = m.TrimFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MaxStdDevRatio.Size()
+	n += 1 + l + sovOracle(uint64(l))
 	return n
 }
 
@@ -972,6 +1577,58 @@ func (m *ExchangeRateAtBlock) Size() (n int) {
 	if m.BlockTimestampMs != 0 {
 		n += 1 + sovOracle(uint64(m.BlockTimestampMs))
 	}
+	if len(m.DerivedFrom) > 0 {
+		for _, e := range m.DerivedFrom {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DerivedPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.BasePair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.QuotePair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *VoteAccuracy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SlashWindow != 0 {
+		n += 1 + sovOracle(uint64(m.SlashWindow))
+	}
+	if m.VoteCount != 0 {
+		n += 1 + sovOracle(uint64(m.VoteCount))
+	}
+	if m.WinCount != 0 {
+		n += 1 + sovOracle(uint64(m.WinCount))
+	}
+	if m.AbstainCount != 0 {
+		n += 1 + sovOracle(uint64(m.AbstainCount))
+	}
+	l = m.SumDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MaxDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
 	return n
 }
 
@@ -1346,6 +2003,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetentionWindows", wireType)
+			}
+			m.SnapshotRetentionWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotRetentionWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairAggregations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairAggregations = append(m.PairAggregations, PairAggregation{})
+			if err := m.PairAggregations[len(m.PairAggregations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteAccuracyRetentionWindows", wireType)
+			}
+			m.VoteAccuracyRetentionWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteAccuracyRetentionWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1367,7 +2096,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+func (m *PairAggregation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1390,15 +2119,15 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: PairAggregation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PairAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1426,13 +2155,15 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var stringLen uint64
+			m.Method = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1442,21 +2173,190 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Method |= AggregationMethod(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimFraction", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStdDevRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStdDevRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
 			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
@@ -1835,6 +2735,454 @@ func (m *ExchangeRateAtBlock) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_NibiruChain_nibiru_v2_x_common_asset.Pair
+			m.DerivedFrom = append(m.DerivedFrom, v)
+			if err := m.DerivedFrom[len(m.DerivedFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DerivedPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivedPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivedPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BasePair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotePair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuotePair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteAccuracy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteAccuracy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteAccuracy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCount", wireType)
+			}
+			m.VoteCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinCount", wireType)
+			}
+			m.WinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			m.AbstainCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbstainCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SumDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SumDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math"
 	time "time"
 
 	sdkmath "cosmossdk.io/math"
@@ -21,6 +22,9 @@ var (
 	KeyMinValidPerWindow  = []byte("MinValidPerWindow")
 	KeyTwapLookbackWindow = []byte("TwapLookbackWindow")
 	KeyValidatorFeeRatio  = []byte("ValidatorFeeRatio")
+
+	KeySnapshotRetentionWindows     = []byte("SnapshotRetentionWindows")
+	KeyVoteAccuracyRetentionWindows = []byte("VoteAccuracyRetentionWindows")
 )
 
 // Default parameter values
@@ -30,6 +34,14 @@ const (
 	DefaultSlashWindow      = 3600 // 2 hours
 	DefaultMinVoters        = 4    // minimum of 4 voters for a pair to become valid
 	DefaultExpirationBlocks = 900  // 30 minutes
+
+	// DefaultSnapshotRetentionWindows keeps price snapshots for 4 TWAP
+	// lookback windows, or 1 hour with the default lookback window.
+	DefaultSnapshotRetentionWindows = 4
+
+	// DefaultVoteAccuracyRetentionWindows keeps the vote accuracy records of
+	// 4 completed slash windows, or 8 hours with the default slash window.
+	DefaultVoteAccuracyRetentionWindows = 4
 )
 
 // Default parameter values
@@ -64,9 +76,19 @@ func DefaultParams() Params {
 		MinValidPerWindow:  DefaultMinValidPerWindow,
 		TwapLookbackWindow: DefaultTwapLookbackWindow,
 		ValidatorFeeRatio:  DefaultValidatorFeeRatio,
+
+		SnapshotRetentionWindows:     DefaultSnapshotRetentionWindows,
+		PairAggregations:             []PairAggregation{},
+		VoteAccuracyRetentionWindows: DefaultVoteAccuracyRetentionWindows,
 	}
 }
 
+// SnapshotRetention returns how long price snapshots are retained before they
+// get pruned. A zero duration means that snapshots are never pruned.
+func (p Params) SnapshotRetention() time.Duration {
+	return time.Duration(p.SnapshotRetentionWindows) * p.TwapLookbackWindow
+}
+
 // Validate performs basic validation on oracle parameters.
 func (p Params) Validate() error {
 	if p.VotePeriod == 0 {
@@ -101,10 +123,26 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter ValidatorFeeRatio must be between [0, 1]")
 	}
 
+	if p.TwapLookbackWindow > 0 &&
+		p.SnapshotRetentionWindows > uint64(math.MaxInt64/p.TwapLookbackWindow) {
+		return fmt.Errorf("oracle parameter SnapshotRetentionWindows is too large: %d", p.SnapshotRetentionWindows)
+	}
+
 	for _, pair := range p.Whitelist {
 		if err := pair.Validate(); err != nil {
 			return fmt.Errorf("oracle parameter Whitelist Pair invalid format: %w", err)
 		}
 	}
+
+	seenPairs := make(map[asset.Pair]bool, len(p.PairAggregations))
+	for _, pairAggregation := range p.PairAggregations {
+		if err := pairAggregation.Validate(); err != nil {
+			return fmt.Errorf("oracle parameter PairAggregations invalid: %w", err)
+		}
+		if seenPairs[pairAggregation.Pair] {
+			return fmt.Errorf("oracle parameter PairAggregations has duplicate pair %s", pairAggregation.Pair)
+		}
+		seenPairs[pairAggregation.Pair] = true
+	}
 	return nil
 }
@@ -71,6 +71,25 @@ func TestParamsEqual(t *testing.T) {
 	err = p13.Validate()
 	require.Error(t, err)
 
+	// invalid trim fraction
+	p14 := types.DefaultParams()
+	p14.PairAggregations = []types.PairAggregation{
+		types.NewPairAggregation("ubtc:uusd", types.AGGREGATION_METHOD_TRIMMED_MEAN, sdkmath.LegacyNewDecWithPrec(5, 1)),
+	}
+	err = p14.Validate()
+	require.Error(t, err)
+
+	// duplicate pair aggregations
+	p15 := types.DefaultParams()
+	p15.PairAggregations = []types.PairAggregation{
+		types.NewPairAggregation("ubtc:uusd", types.AGGREGATION_METHOD_WEIGHTED_MEAN, sdkmath.LegacyZeroDec()),
+		types.NewPairAggregation("ubtc:uusd", types.AGGREGATION_METHOD_TRIMMED_MEAN, sdkmath.LegacyZeroDec()),
+	}
+	err = p15.Validate()
+	require.Error(t, err)
+
+	require.NoError(t, p15.PairAggregations[0].Validate())
+
 	p11 := types.DefaultParams()
 	require.NotNil(t, p11.String())
 }
@@ -10,6 +10,7 @@ import (
 	github_com_NibiruChain_nibiru_v2_x_common_asset "github.com/NibiruChain/nibiru/v2/x/common/asset"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -86,6 +87,9 @@ type QueryExchangeRateResponse struct {
 	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	// True if this exchange rate has passed its expiration window.
 	IsVintage bool `protobuf:"varint,4,opt,name=is_vintage,json=isVintage,proto3" json:"is_vintage,omitempty"`
+	// Pairs the exchange rate was derived from if the pair is a derived pair.
+	// Empty if validators voted on the pair directly.
+	DerivedFrom []github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,5,rep,name=derived_from,json=derivedFrom,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"derived_from"`
 }
 
 func (m *QueryExchangeRateResponse) Reset()         { *m = QueryExchangeRateResponse{} }
@@ -989,6 +993,409 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryPriceHistoryRequest is the request type for the Query/PriceHistory RPC
+// method.
+type QueryPriceHistoryRequest struct {
+	// pair defines the pair to query for.
+	Pair github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"pair"`
+	// pagination defines an optional pagination for the request. Set
+	// "pagination.reverse" to get the most recent snapshots first.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPriceHistoryRequest) Reset()         { *m = QueryPriceHistoryRequest{} }
+func (m *QueryPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHistoryRequest) ProtoMessage()    {}
+func (*QueryPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16aef2382d1249a8, []int{22}
+}
+func (m *QueryPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHistoryRequest.Merge(m, src)
+}
+func (m *QueryPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceHistoryRequest proto.InternalMessageInfo
+
+// QueryPriceHistoryResponse is the response type for the Query/PriceHistory
+// RPC method.
+type QueryPriceHistoryResponse struct {
+	// snapshots defines the price snapshots of the pair
+	Snapshots []PriceSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPriceHistoryResponse) Reset()         { *m = QueryPriceHistoryResponse{} }
+func (m *QueryPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHistoryResponse) ProtoMessage()    {}
+func (*QueryPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16aef2382d1249a8, []int{23}
+}
+func (m *QueryPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHistoryResponse.Merge(m, src)
+}
+func (m *QueryPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPriceHistoryResponse) GetSnapshots() []PriceSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *QueryPriceHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDerivedPairsRequest is the request type for the Query/DerivedPairs RPC
+// method.
+type QueryDerivedPairsRequest struct {
+}
+
+func (m *QueryDerivedPairsRequest) Reset()         { *m = QueryDerivedPairsRequest{} }
+func (m *QueryDerivedPairsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedPairsRequest) ProtoMessage()    {}
+func (*QueryDerivedPairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16aef2382d1249a8, []int{24}
+}
+func (m *QueryDerivedPairsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedPairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedPairsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivedPairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedPairsRequest.Merge(m, src)
+}
+func (m *QueryDerivedPairsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedPairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedPairsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivedPairsRequest proto.InternalMessageInfo
+
+// QueryDerivedPairsResponse is the response type for the Query/DerivedPairs
+// RPC method.
+type QueryDerivedPairsResponse struct {
+	// derived_pairs defines all derived pairs and the voted pairs their
+	// exchange rates are computed from.
+	DerivedPairs []DerivedPair `protobuf:"bytes,1,rep,name=derived_pairs,json=derivedPairs,proto3" json:"derived_pairs"`
+}
+
+func (m *QueryDerivedPairsResponse) Reset()         { *m = QueryDerivedPairsResponse{} }
+func (m *QueryDerivedPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedPairsResponse) ProtoMessage()    {}
+func (*QueryDerivedPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16aef2382d1249a8, []int{25}
+}
+func (m *QueryDerivedPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivedPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedPairsResponse.Merge(m, src)
+}
+func (m *QueryDerivedPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedPairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivedPairsResponse proto.InternalMessageInfo
+
+func (m *QueryDerivedPairsResponse) GetDerivedPairs() []DerivedPair {
+	if m != nil {
+		return m.DerivedPairs
+	}
+	return nil
+}
+
+// QueryValidatorAccuracyRequest is the request type for the
+// Query/ValidatorAccuracy RPC method.
+type QueryValidatorAccuracyRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryValidatorAccuracyRequest) Reset()         { *m = QueryValidatorAccuracyRequest{} }
+func (m *QueryValidatorAccuracyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAccuracyRequest) ProtoMessage()    {}
+func (*QueryValidatorAccuracyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16aef2382d1249a8, []int{26}
+}
+func (m *QueryValidatorAccuracyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAccuracyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAccuracyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorAccuracyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAccuracyRequest.Merge(m, src)
+}
+func (m *QueryValidatorAccuracyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAccuracyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAccuracyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorAccuracyRequest proto.InternalMessageInfo
+
+// QueryValidatorAccuracyResponse is the response type for the
+// Query/ValidatorAccuracy RPC method.
+type QueryValidatorAccuracyResponse struct {
+	// accuracies defines the vote accuracy records of the validator, ordered by
+	// slash window and pair.
+	Accuracies []VoteAccuracy `protobuf:"bytes,1,rep,name=accuracies,proto3" json:"accuracies"`
+	// miss_counter defines the oracle miss counter of the validator in the
+	// current slash window
+	MissCounter uint64 `protobuf:"varint,2,opt,name=miss_counter,json=missCounter,proto3" json:"miss_counter,omitempty"`
+	// current_slash_window defines the index of the current slash window
+	CurrentSlashWindow uint64 `protobuf:"varint,3,opt,name=current_slash_window,json=currentSlashWindow,proto3" json:"current_slash_window,omitempty"`
+}
+
+func (m *QueryValidatorAccuracyResponse) Reset()         { *m = QueryValidatorAccuracyResponse{} }
+func (m *QueryValidatorAccuracyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAccuracyResponse) ProtoMessage()    {}
+func (*QueryValidatorAccuracyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16aef2382d1249a8, []int{27}
+}
+func (m *QueryValidatorAccuracyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAccuracyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAccuracyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorAccuracyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAccuracyResponse.Merge(m, src)
+}
+func (m *QueryValidatorAccuracyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAccuracyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAccuracyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorAccuracyResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorAccuracyResponse) GetAccuracies() []VoteAccuracy {
+	if m != nil {
+		return m.Accuracies
+	}
+	return nil
+}
+
+func (m *QueryValidatorAccuracyResponse) GetMissCounter() uint64 {
+	if m != nil {
+		return m.MissCounter
+	}
+	return 0
+}
+
+func (m *QueryValidatorAccuracyResponse) GetCurrentSlashWindow() uint64 {
+	if m != nil {
+		return m.CurrentSlashWindow
+	}
+	return 0
+}
+
+// QueryVoteAccuraciesRequest is the request type for the Query/VoteAccuracies
+// RPC method.
+type QueryVoteAccuraciesRequest struct {
+	// slash_window defines the index of the slash window to query for. Defaults
+	// to the current slash window if zero.
+	SlashWindow uint64 `protobuf:"varint,1,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty"`
+	// pair optionally filters the records by pair.
+	Pair string `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteAccuraciesRequest) Reset()         { *m = QueryVoteAccuraciesRequest{} }
+func (m *QueryVoteAccuraciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteAccuraciesRequest) ProtoMessage()    {}
+func (*QueryVoteAccuraciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16aef2382d1249a8, []int{28}
+}
+func (m *QueryVoteAccuraciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteAccuraciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteAccuraciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteAccuraciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteAccuraciesRequest.Merge(m, src)
+}
+func (m *QueryVoteAccuraciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteAccuraciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteAccuraciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteAccuraciesRequest proto.InternalMessageInfo
+
+// QueryVoteAccuraciesResponse is the response type for the
+// Query/VoteAccuracies RPC method.
+type QueryVoteAccuraciesResponse struct {
+	// accuracies defines the vote accuracy records of all validators for the
+	// slash window.
+	Accuracies []VoteAccuracy `protobuf:"bytes,1,rep,name=accuracies,proto3" json:"accuracies"`
+	// slash_window defines the index of the queried slash window
+	SlashWindow uint64 `protobuf:"varint,2,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteAccuraciesResponse) Reset()         { *m = QueryVoteAccuraciesResponse{} }
+func (m *QueryVoteAccuraciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteAccuraciesResponse) ProtoMessage()    {}
+func (*QueryVoteAccuraciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16aef2382d1249a8, []int{29}
+}
+func (m *QueryVoteAccuraciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteAccuraciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteAccuraciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteAccuraciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteAccuraciesResponse.Merge(m, src)
+}
+func (m *QueryVoteAccuraciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteAccuraciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteAccuraciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteAccuraciesResponse proto.InternalMessageInfo
+
+func (m *QueryVoteAccuraciesResponse) GetAccuracies() []VoteAccuracy {
+	if m != nil {
+		return m.Accuracies
+	}
+	return nil
+}
+
+func (m *QueryVoteAccuraciesResponse) GetSlashWindow() uint64 {
+	if m != nil {
+		return m.SlashWindow
+	}
+	return 0
+}
+
+func (m *QueryVoteAccuraciesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryExchangeRateRequest)(nil), "nibiru.oracle.v1.QueryExchangeRateRequest")
 	proto.RegisterType((*QueryExchangeRateResponse)(nil), "nibiru.oracle.v1.QueryExchangeRateResponse")
@@ -1012,88 +1419,122 @@ func init() {
 	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "nibiru.oracle.v1.QueryAggregateVotesResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "nibiru.oracle.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.oracle.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryPriceHistoryRequest)(nil), "nibiru.oracle.v1.QueryPriceHistoryRequest")
+	proto.RegisterType((*QueryPriceHistoryResponse)(nil), "nibiru.oracle.v1.QueryPriceHistoryResponse")
+	proto.RegisterType((*QueryDerivedPairsRequest)(nil), "nibiru.oracle.v1.QueryDerivedPairsRequest")
+	proto.RegisterType((*QueryDerivedPairsResponse)(nil), "nibiru.oracle.v1.QueryDerivedPairsResponse")
+	proto.RegisterType((*QueryValidatorAccuracyRequest)(nil), "nibiru.oracle.v1.QueryValidatorAccuracyRequest")
+	proto.RegisterType((*QueryValidatorAccuracyResponse)(nil), "nibiru.oracle.v1.QueryValidatorAccuracyResponse")
+	proto.RegisterType((*QueryVoteAccuraciesRequest)(nil), "nibiru.oracle.v1.QueryVoteAccuraciesRequest")
+	proto.RegisterType((*QueryVoteAccuraciesResponse)(nil), "nibiru.oracle.v1.QueryVoteAccuraciesResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/query.proto", fileDescriptor_16aef2382d1249a8) }
 
 var fileDescriptor_16aef2382d1249a8 = []byte{
-	// 1207 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x1b, 0xc7, 0x3d, 0x6d, 0xde, 0xb4, 0x1d, 0xc7, 0xa9, 0x33, 0x6f, 0x10, 0x8e, 0x93, 0xd8, 0xe9,
-	0xd2, 0x54, 0x69, 0x93, 0x78, 0x71, 0x52, 0x15, 0x05, 0x8a, 0x20, 0x3f, 0xa8, 0x28, 0x4a, 0x20,
-	0x58, 0x51, 0x84, 0x7a, 0x59, 0x8d, 0xd7, 0xd3, 0xf5, 0xaa, 0xde, 0x1d, 0x77, 0x67, 0x6c, 0x1a,
-	0x15, 0x2e, 0x15, 0x20, 0x8e, 0x48, 0x08, 0x71, 0x83, 0x5e, 0x90, 0x10, 0xe7, 0xc2, 0x1d, 0x4e,
-	0x3d, 0x56, 0x70, 0x41, 0x1c, 0x0a, 0x4a, 0x38, 0xf0, 0x67, 0xa0, 0x9d, 0x19, 0x6f, 0x76, 0xbd,
-	0x5e, 0x65, 0x71, 0xe1, 0x96, 0x3c, 0xcf, 0xb3, 0xcf, 0xf3, 0x99, 0xef, 0xce, 0xcc, 0x77, 0x0d,
-	0x67, 0x5c, 0xbb, 0x6e, 0x7b, 0x1d, 0x9d, 0x7a, 0xd8, 0x6c, 0x11, 0xbd, 0x5b, 0xd5, 0xef, 0x76,
-	0x88, 0x77, 0x50, 0x69, 0x7b, 0x94, 0x53, 0x94, 0x97, 0xd9, 0x8a, 0xcc, 0x56, 0xba, 0xd5, 0x62,
-	0xc9, 0xa4, 0xcc, 0xa1, 0x4c, 0xaf, 0x63, 0xe6, 0x57, 0xd7, 0x09, 0xc7, 0x55, 0xdd, 0xa4, 0xb6,
-	0x2b, 0x9f, 0x28, 0x4e, 0xc9, 0xbc, 0x21, 0xfe, 0xd3, 0xe5, 0x3f, 0x2a, 0x35, 0x69, 0x51, 0x8b,
-	0xca, 0xb8, 0xff, 0x97, 0x8a, 0xce, 0x58, 0x94, 0x5a, 0x2d, 0xa2, 0xe3, 0xb6, 0xad, 0x63, 0xd7,
-	0xa5, 0x1c, 0x73, 0x9b, 0xba, 0xbd, 0x67, 0x66, 0x63, 0x78, 0x0a, 0x45, 0xa4, 0xb5, 0x2e, 0x2c,
-	0xbc, 0xeb, 0xe3, 0xbe, 0x71, 0xcf, 0x6c, 0x62, 0xd7, 0x22, 0x35, 0xcc, 0x49, 0x8d, 0xdc, 0xed,
-	0x10, 0xc6, 0xd1, 0x2e, 0x1c, 0x69, 0x63, 0xdb, 0x2b, 0x80, 0x39, 0xb0, 0x70, 0x6e, 0xe3, 0xfa,
-	0xe3, 0xa7, 0xe5, 0xcc, 0x6f, 0x4f, 0xcb, 0x57, 0x2d, 0x9b, 0x37, 0x3b, 0xf5, 0x8a, 0x49, 0x1d,
-	0xfd, 0x6d, 0xd1, 0x7b, 0xb3, 0x89, 0x6d, 0x57, 0x57, 0x73, 0xba, 0x2b, 0xfa, 0x3d, 0xdd, 0xa4,
-	0x8e, 0x43, 0x5d, 0x1d, 0x33, 0x46, 0x78, 0x65, 0x17, 0xdb, 0x5e, 0x4d, 0x74, 0x7a, 0xf9, 0xec,
-	0xa7, 0x0f, 0xcb, 0x99, 0xbf, 0x1e, 0x96, 0x33, 0xda, 0x11, 0x80, 0x53, 0x03, 0x06, 0xb3, 0x36,
-	0x75, 0x19, 0x41, 0xfb, 0x30, 0x47, 0x54, 0xdc, 0xf0, 0x30, 0x27, 0x0a, 0xa1, 0xaa, 0x10, 0xa6,
-	0xa5, 0x2a, 0xac, 0x71, 0xa7, 0x62, 0x53, 0xdd, 0xc1, 0xbc, 0x59, 0xd9, 0x26, 0x16, 0x36, 0x0f,
-	0xb6, 0x88, 0xf9, 0xf3, 0xa3, 0x65, 0xa8, 0x44, 0xdb, 0x22, 0x66, 0x6d, 0x8c, 0x84, 0xfa, 0xa3,
-	0x25, 0x88, 0xea, 0x2d, 0x6a, 0xde, 0x31, 0xb8, 0xed, 0x10, 0xc6, 0xb1, 0xd3, 0x36, 0x1c, 0x56,
-	0x38, 0x35, 0x07, 0x16, 0x4e, 0xd7, 0xf2, 0x22, 0xb3, 0xd7, 0x4b, 0xec, 0x30, 0x74, 0x01, 0x8e,
-	0xc9, 0xea, 0x26, 0xb1, 0xad, 0x26, 0x2f, 0x9c, 0x9e, 0x03, 0x0b, 0x23, 0xb5, 0xac, 0x88, 0xbd,
-	0x29, 0x42, 0x68, 0x16, 0x42, 0x9b, 0x19, 0x5d, 0xdb, 0xe5, 0xd8, 0x22, 0x85, 0x91, 0x39, 0xb0,
-	0x70, 0xb6, 0x76, 0xce, 0x66, 0xfb, 0x32, 0xa0, 0x4d, 0x0f, 0x58, 0x24, 0x53, 0xf2, 0x6a, 0x1f,
-	0x01, 0x58, 0x1c, 0x94, 0x55, 0x1a, 0xdc, 0x86, 0xe3, 0x11, 0x0d, 0x58, 0x01, 0xcc, 0x9d, 0x5e,
-	0xc8, 0xae, 0xbc, 0x50, 0xe9, 0xdf, 0x52, 0x95, 0x70, 0x83, 0xbd, 0x4e, 0xbb, 0x45, 0x36, 0x8a,
-	0xbe, 0x52, 0xdf, 0xfd, 0x5e, 0x46, 0xb1, 0x14, 0xab, 0xe5, 0xc2, 0x92, 0x30, 0xed, 0x39, 0xf8,
-	0x7f, 0x41, 0xb1, 0x6e, 0x72, 0xbb, 0x7b, 0x4c, 0xe7, 0xc2, 0xc9, 0x68, 0x38, 0x78, 0x35, 0x67,
-	0xb0, 0x0c, 0x09, 0x9e, 0x67, 0xdd, 0x17, 0xbd, 0x66, 0xda, 0x14, 0x7c, 0x5e, 0xcc, 0xdb, 0xa7,
-	0x9c, 0xec, 0x61, 0xcf, 0x22, 0x3c, 0x40, 0xb9, 0xaf, 0xf6, 0x68, 0x24, 0xa5, 0x70, 0x0c, 0x38,
-	0xd6, 0xa5, 0x9c, 0x18, 0x5c, 0xc6, 0xff, 0x15, 0xa6, 0x6c, 0xf7, 0x78, 0x90, 0xf6, 0x0e, 0x9c,
-	0x11, 0xc3, 0x6f, 0x10, 0xd2, 0x20, 0xde, 0x16, 0x69, 0x11, 0x4b, 0x9c, 0xaf, 0xde, 0x21, 0x99,
-	0x87, 0xe3, 0x5d, 0xdc, 0xb2, 0x1b, 0x98, 0x53, 0xcf, 0xc0, 0x8d, 0x86, 0x3a, 0x2e, 0xb5, 0x5c,
-	0x10, 0x5d, 0x6f, 0x34, 0xc2, 0x3b, 0xff, 0x75, 0x38, 0x9b, 0xd0, 0x50, 0x2d, 0xa9, 0x0c, 0xb3,
-	0xb7, 0x45, 0x2e, 0xdc, 0x0e, 0xca, 0x90, 0xdf, 0x4b, 0x7b, 0x4b, 0x49, 0xb5, 0x63, 0x33, 0xb6,
-	0x49, 0x3b, 0x2e, 0x27, 0xde, 0xd0, 0x34, 0xaf, 0x2a, 0x6d, 0x23, 0xbd, 0x14, 0xc8, 0x05, 0x38,
-	0xe6, 0xd8, 0x8c, 0x19, 0xa6, 0x8c, 0x8b, 0x56, 0x23, 0xb5, 0xac, 0x73, 0x5c, 0x1a, 0xa8, 0xb3,
-	0x6e, 0x59, 0x9e, 0xbf, 0x0e, 0xb2, 0xeb, 0x11, 0x5f, 0xbd, 0xa1, 0x79, 0x1e, 0x00, 0x25, 0x4f,
-	0xbc, 0xa3, 0xa2, 0xc2, 0x70, 0x02, 0xf7, 0x72, 0x46, 0x5b, 0x26, 0x45, 0xd7, 0xec, 0x4a, 0x25,
-	0x7e, 0x34, 0x82, 0x36, 0xe1, 0x83, 0xa0, 0x5a, 0x6e, 0x8c, 0xf8, 0xdb, 0xa4, 0x96, 0xc7, 0x7d,
-	0xa3, 0xb4, 0x72, 0x02, 0x43, 0xb0, 0x23, 0x3f, 0x06, 0xb0, 0x94, 0x54, 0xa1, 0x30, 0x4d, 0x88,
-	0x62, 0x98, 0xbd, 0x23, 0x3c, 0x1c, 0xe7, 0x44, 0x3f, 0x27, 0xd3, 0xb6, 0xd5, 0xfd, 0x12, 0x3c,
-	0xbd, 0xff, 0x2c, 0xda, 0x77, 0xd5, 0x7d, 0xd4, 0xd7, 0x4d, 0x2d, 0xe8, 0x3d, 0x38, 0x7e, 0xbc,
-	0xa0, 0x90, 0xe8, 0x8b, 0x29, 0x17, 0xb3, 0x7f, 0xbc, 0x92, 0x1c, 0x0e, 0x4f, 0xd0, 0x66, 0x06,
-	0xcd, 0x0d, 0xb4, 0x3e, 0x80, 0xd3, 0x03, 0xb3, 0x0a, 0xeb, 0x16, 0x3c, 0x1f, 0xc5, 0xea, 0x89,
-	0x3c, 0x04, 0xd7, 0x78, 0x84, 0x8b, 0x69, 0x93, 0x10, 0x89, 0xd1, 0xbb, 0xd8, 0xc3, 0x4e, 0x00,
-	0xb4, 0xa3, 0x2e, 0xcc, 0x5e, 0x54, 0x81, 0x5c, 0x83, 0xa3, 0x6d, 0x11, 0x51, 0xba, 0x14, 0xe2,
-	0xf3, 0xe5, 0x13, 0x6a, 0x98, 0xaa, 0x5e, 0xf9, 0xe9, 0x3c, 0xfc, 0x9f, 0xe8, 0x87, 0xbe, 0x00,
-	0x70, 0x2c, 0x4c, 0x86, 0xae, 0xc4, 0x5b, 0x24, 0x99, 0x75, 0x71, 0x31, 0x55, 0xad, 0x64, 0xd5,
-	0x96, 0x1e, 0xfc, 0xf2, 0xe7, 0xe7, 0xa7, 0x2e, 0xa1, 0x8b, 0x7a, 0xff, 0xd7, 0x81, 0xfc, 0x1c,
-	0x89, 0x18, 0x0f, 0xfa, 0x0a, 0xc0, 0x7c, 0xc4, 0x47, 0xde, 0xc7, 0xed, 0xff, 0x8e, 0xad, 0x2a,
-	0xd8, 0x16, 0xd1, 0xe5, 0x34, 0x6c, 0x06, 0xf7, 0x59, 0xbe, 0x06, 0x30, 0x17, 0x31, 0x51, 0x94,
-	0x66, 0x62, 0xef, 0x85, 0x16, 0x97, 0xd2, 0x15, 0x2b, 0xbe, 0x55, 0xc1, 0xb7, 0x8c, 0x16, 0x13,
-	0xf8, 0xfc, 0x0f, 0x1d, 0x16, 0xa5, 0x64, 0xe8, 0x13, 0x00, 0xcf, 0x28, 0x27, 0x45, 0xf3, 0x09,
-	0xe3, 0xa2, 0x06, 0x5c, 0xbc, 0x74, 0x52, 0x59, 0xca, 0x77, 0x29, 0x79, 0x94, 0xcd, 0xa2, 0x2f,
-	0x01, 0xcc, 0x86, 0x7c, 0x14, 0x5d, 0x4e, 0x98, 0x12, 0xb7, 0xe1, 0xe2, 0x95, 0x34, 0xa5, 0x29,
-	0x5f, 0xa2, 0x84, 0x0a, 0x3b, 0x37, 0xfa, 0x01, 0xc0, 0x7c, 0xbf, 0x27, 0xa2, 0x4a, 0xc2, 0xcc,
-	0x04, 0x37, 0x2e, 0xea, 0xa9, 0xeb, 0x15, 0xe8, 0xba, 0x00, 0x7d, 0x05, 0xad, 0x25, 0x80, 0x06,
-	0x77, 0x25, 0xd3, 0xef, 0x47, 0x6f, 0xd3, 0x0f, 0x75, 0x69, 0xc9, 0xe8, 0x1b, 0x00, 0xb3, 0x21,
-	0xfb, 0x4c, 0x94, 0x34, 0x6e, 0xd7, 0x89, 0x92, 0x0e, 0x70, 0x63, 0xed, 0x35, 0x41, 0xba, 0x86,
-	0x5e, 0x1a, 0x82, 0xd4, 0xb7, 0x6c, 0xf4, 0x23, 0x80, 0xf9, 0x7e, 0xbf, 0x4a, 0x14, 0x38, 0xc1,
-	0xd0, 0x13, 0x05, 0x4e, 0xb2, 0x6b, 0x6d, 0x5b, 0x60, 0xdf, 0x40, 0x5b, 0x43, 0x60, 0xc7, 0x0c,
-	0x14, 0x3d, 0x02, 0x70, 0x22, 0xe6, 0xb9, 0x28, 0x2d, 0x54, 0xb0, 0x95, 0x5f, 0x4c, 0xff, 0x80,
-	0x5a, 0xc6, 0x75, 0xb1, 0x8c, 0x6b, 0xe8, 0xea, 0xc9, 0xcb, 0x88, 0xdb, 0x3e, 0xfa, 0x1e, 0xc0,
-	0x5c, 0xc4, 0xbf, 0x12, 0x2f, 0xa8, 0x41, 0x4e, 0x9e, 0x78, 0x41, 0x0d, 0x34, 0x6a, 0xed, 0xa6,
-	0x40, 0xdd, 0x44, 0xeb, 0xc9, 0xa8, 0x0d, 0xfb, 0x44, 0xc5, 0x85, 0xdc, 0xdf, 0x02, 0x38, 0x1e,
-	0xf5, 0x5d, 0x94, 0x8a, 0x25, 0x10, 0x7a, 0x39, 0x65, 0xb5, 0x42, 0x5f, 0x13, 0xe8, 0xab, 0xa8,
-	0xfa, 0x4f, 0x54, 0x96, 0x12, 0x7f, 0x00, 0x47, 0xa5, 0xbd, 0xa2, 0x8b, 0x09, 0x33, 0x23, 0x2e,
-	0x5e, 0x9c, 0x3f, 0xa1, 0x4a, 0x11, 0xcd, 0x0b, 0xa2, 0x32, 0x9a, 0x4d, 0xbc, 0xc8, 0x84, 0xa5,
-	0xdf, 0x7c, 0x7c, 0x58, 0x02, 0x4f, 0x0e, 0x4b, 0xe0, 0x8f, 0xc3, 0x12, 0xf8, 0xec, 0xa8, 0x94,
-	0x79, 0x72, 0x54, 0xca, 0xfc, 0x7a, 0x54, 0xca, 0xdc, 0xd2, 0x53, 0xfc, 0x04, 0x51, 0x3d, 0xf9,
-	0x41, 0x9b, 0xb0, 0xfa, 0xa8, 0xf8, 0x61, 0xbe, 0xfa, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x89,
-	0x56, 0xe4, 0x8b, 0x58, 0x10, 0x00, 0x00,
+	// 1617 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcf, 0x6f, 0xdc, 0xd4,
+	0x13, 0xc0, 0xe3, 0x24, 0xfd, 0x35, 0x9b, 0xe4, 0x9b, 0xbc, 0xe6, 0xab, 0xef, 0xc6, 0x49, 0x76,
+	0x53, 0x7f, 0x9b, 0x92, 0xe6, 0xc7, 0xba, 0x49, 0xab, 0xa2, 0xd2, 0x56, 0x90, 0x1f, 0xa4, 0x2d,
+	0x6a, 0x21, 0x6c, 0xab, 0x80, 0x7a, 0xb1, 0x5e, 0xbc, 0xaf, 0xbb, 0x56, 0xb3, 0xf6, 0xd6, 0xcf,
+	0xbb, 0x6d, 0x54, 0xb8, 0x54, 0x80, 0x38, 0x22, 0x21, 0xc4, 0x0d, 0x2a, 0xa1, 0x4a, 0xc0, 0xb9,
+	0x70, 0xa9, 0x84, 0x84, 0xc4, 0xa1, 0x37, 0x2a, 0x90, 0x10, 0xe2, 0x50, 0x50, 0xcb, 0x81, 0x3f,
+	0x03, 0xf9, 0x79, 0xec, 0xb5, 0x63, 0x5b, 0x71, 0xb7, 0xe9, 0x2d, 0x3b, 0x33, 0x6f, 0xe6, 0x33,
+	0xe3, 0x37, 0xef, 0xcd, 0x0b, 0x8c, 0x99, 0xc6, 0x86, 0x61, 0x37, 0x55, 0xcb, 0xa6, 0xfa, 0x26,
+	0x53, 0x5b, 0xf3, 0xea, 0x8d, 0x26, 0xb3, 0xb7, 0x4a, 0x0d, 0xdb, 0x72, 0x2c, 0x32, 0xe8, 0x69,
+	0x4b, 0x9e, 0xb6, 0xd4, 0x9a, 0x97, 0xa7, 0x75, 0x8b, 0xd7, 0x2d, 0xae, 0x6e, 0x50, 0xce, 0x3c,
+	0x53, 0xb5, 0x35, 0xbf, 0xc1, 0x1c, 0x3a, 0xaf, 0x36, 0x68, 0xd5, 0x30, 0xa9, 0x63, 0x58, 0xa6,
+	0xb7, 0x5a, 0x2e, 0x84, 0x6d, 0x7d, 0x2b, 0xdd, 0x32, 0x7c, 0xfd, 0x88, 0xa7, 0xd7, 0xc4, 0x2f,
+	0xd5, 0xfb, 0x81, 0xaa, 0xe1, 0xaa, 0x55, 0xb5, 0x3c, 0xb9, 0xfb, 0x17, 0x4a, 0xc7, 0xaa, 0x96,
+	0x55, 0xdd, 0x64, 0x2a, 0x6d, 0x18, 0x2a, 0x35, 0x4d, 0xcb, 0x11, 0xd1, 0xfc, 0x35, 0xe3, 0xb1,
+	0x54, 0x10, 0x1b, 0x17, 0xc7, 0xd4, 0xdc, 0xa1, 0x0e, 0x6a, 0x95, 0x16, 0xe4, 0xdf, 0x76, 0xb3,
+	0x79, 0xfd, 0x96, 0x5e, 0xa3, 0x66, 0x95, 0x95, 0xa9, 0xc3, 0xca, 0xec, 0x46, 0x93, 0x71, 0x87,
+	0xac, 0x41, 0x6f, 0x83, 0x1a, 0x76, 0x5e, 0x9a, 0x90, 0xa6, 0x0e, 0x2c, 0x9d, 0x79, 0xf8, 0xb8,
+	0xd8, 0xf5, 0xc7, 0xe3, 0xe2, 0x89, 0xaa, 0xe1, 0xd4, 0x9a, 0x1b, 0x25, 0xdd, 0xaa, 0xab, 0x6f,
+	0x0a, 0xd7, 0xcb, 0x35, 0x6a, 0x98, 0x2a, 0x86, 0x69, 0x2d, 0xa8, 0xb7, 0x54, 0xdd, 0xaa, 0xd7,
+	0x2d, 0x53, 0xa5, 0x9c, 0x33, 0xa7, 0xb4, 0x46, 0x0d, 0xbb, 0x2c, 0x3c, 0xbd, 0xb2, 0xff, 0xe3,
+	0xbb, 0xc5, 0xae, 0x7f, 0xee, 0x16, 0xbb, 0x94, 0x9f, 0xba, 0x61, 0x24, 0x21, 0x30, 0x6f, 0x58,
+	0x26, 0x67, 0x64, 0x1d, 0xfa, 0x19, 0xca, 0x35, 0x9b, 0x3a, 0x0c, 0x11, 0xe6, 0x11, 0x61, 0xd4,
+	0xab, 0x19, 0xaf, 0x5c, 0x2f, 0x19, 0x96, 0x5a, 0xa7, 0x4e, 0xad, 0x74, 0x91, 0x55, 0xa9, 0xbe,
+	0xb5, 0xc2, 0xf4, 0x5f, 0xee, 0xcf, 0x01, 0x96, 0x74, 0x85, 0xe9, 0xe5, 0x3e, 0x16, 0xf2, 0x4f,
+	0x66, 0x81, 0x6c, 0x6c, 0x5a, 0xfa, 0x75, 0xcd, 0x31, 0xea, 0x8c, 0x3b, 0xb4, 0xde, 0xd0, 0xea,
+	0x3c, 0xdf, 0x3d, 0x21, 0x4d, 0xf5, 0x94, 0x07, 0x85, 0xe6, 0x8a, 0xaf, 0xb8, 0xc4, 0xc9, 0x21,
+	0xe8, 0xf3, 0xac, 0x6b, 0xcc, 0xa8, 0xd6, 0x9c, 0x7c, 0xcf, 0x84, 0x34, 0xd5, 0x5b, 0xce, 0x09,
+	0xd9, 0x79, 0x21, 0x22, 0xe3, 0x00, 0x06, 0xd7, 0x5a, 0x86, 0xe9, 0xd0, 0x2a, 0xcb, 0xf7, 0x4e,
+	0x48, 0x53, 0xfb, 0xcb, 0x07, 0x0c, 0xbe, 0xee, 0x09, 0x88, 0x06, 0x7d, 0x15, 0x66, 0x1b, 0x2d,
+	0x56, 0xd1, 0xae, 0xd9, 0x56, 0x3d, 0xbf, 0x67, 0xa2, 0xe7, 0xb9, 0x2b, 0x99, 0x43, 0x8f, 0xab,
+	0xb6, 0x55, 0x57, 0x46, 0x13, 0xaa, 0xc8, 0xf1, 0xfb, 0x29, 0x1f, 0x48, 0x20, 0x27, 0x69, 0xb1,
+	0xc8, 0xd7, 0x60, 0x20, 0x52, 0x64, 0x9e, 0x97, 0x26, 0x7a, 0xa6, 0x72, 0x0b, 0xff, 0x2f, 0x6d,
+	0xdf, 0xfd, 0xa5, 0xb0, 0x83, 0x2b, 0xcd, 0xc6, 0x26, 0x5b, 0x92, 0xdd, 0x1c, 0xbe, 0xfd, 0xb3,
+	0x48, 0x62, 0x2a, 0x5e, 0xee, 0x0f, 0xd7, 0x9c, 0x2b, 0xff, 0x85, 0x83, 0x82, 0x62, 0x51, 0x77,
+	0x8c, 0x56, 0x9b, 0xce, 0x84, 0xe1, 0xa8, 0x38, 0xf8, 0xf6, 0xfb, 0xa8, 0x27, 0xca, 0x4b, 0xbb,
+	0x50, 0x2e, 0xdf, 0x99, 0x32, 0x02, 0xff, 0x13, 0xf1, 0xd6, 0x2d, 0x87, 0x5d, 0xa1, 0x76, 0x95,
+	0x39, 0x01, 0xca, 0x6d, 0xc8, 0xc7, 0x55, 0x88, 0xa3, 0x41, 0x5f, 0xcb, 0x72, 0x98, 0xe6, 0x78,
+	0xf2, 0x5d, 0x61, 0xca, 0xb5, 0xda, 0x81, 0x94, 0xb7, 0x60, 0x4c, 0x04, 0x5f, 0x65, 0xac, 0xc2,
+	0xec, 0x15, 0xb6, 0xc9, 0xaa, 0xa2, 0xbd, 0xfd, 0x2e, 0x9c, 0x84, 0x81, 0x16, 0xdd, 0x34, 0x2a,
+	0xd4, 0xb1, 0x6c, 0x8d, 0x56, 0x2a, 0xd8, 0x8f, 0xe5, 0xfe, 0x40, 0xba, 0x58, 0xa9, 0x84, 0x5b,
+	0xeb, 0x35, 0x18, 0x4f, 0x71, 0x88, 0x29, 0x15, 0x21, 0x77, 0x4d, 0xe8, 0xc2, 0xee, 0xc0, 0x13,
+	0xb9, 0xbe, 0x94, 0x37, 0xb0, 0x54, 0x97, 0x0c, 0xce, 0x97, 0xad, 0xa6, 0xe9, 0x30, 0xbb, 0x63,
+	0x9a, 0xb3, 0x90, 0x8f, 0xfb, 0x42, 0x90, 0x43, 0xd0, 0x57, 0x37, 0x38, 0xd7, 0x74, 0x4f, 0x2e,
+	0x5c, 0xf5, 0x96, 0x73, 0xf5, 0xb6, 0x69, 0x50, 0x9d, 0xc5, 0x6a, 0xd5, 0x76, 0xf3, 0x60, 0x6b,
+	0x36, 0x73, 0xab, 0xd7, 0x31, 0xcf, 0x1d, 0x09, 0xc6, 0x53, 0x3c, 0x22, 0x15, 0x85, 0x21, 0xea,
+	0xeb, 0xb4, 0x86, 0xa7, 0x14, 0x5e, 0x73, 0x0b, 0xa5, 0x78, 0x6b, 0x04, 0x6e, 0xc2, 0x8d, 0x80,
+	0x2e, 0x97, 0x7a, 0xdd, 0x6d, 0x52, 0x1e, 0xa4, 0xdb, 0x42, 0x29, 0xc5, 0x14, 0x86, 0x60, 0x47,
+	0x7e, 0x28, 0x41, 0x21, 0xcd, 0x02, 0x31, 0x75, 0x20, 0x31, 0x4c, 0xbf, 0x85, 0x3b, 0xe3, 0x1c,
+	0xda, 0xce, 0xc9, 0x95, 0x8b, 0x78, 0xbe, 0x04, 0xab, 0xd7, 0x9f, 0xa7, 0xf6, 0x2d, 0x90, 0x93,
+	0xbc, 0x61, 0x42, 0xef, 0xc2, 0x40, 0x3b, 0xa1, 0x50, 0xd1, 0x67, 0x32, 0x26, 0xb3, 0xde, 0xce,
+	0xa4, 0x9f, 0x86, 0x23, 0x28, 0x63, 0x49, 0x71, 0x83, 0x5a, 0x6f, 0xc1, 0x68, 0xa2, 0x16, 0xb1,
+	0xae, 0xc2, 0x7f, 0xa2, 0x58, 0x7e, 0x91, 0x3b, 0xe0, 0x1a, 0x88, 0x70, 0x71, 0x65, 0x18, 0x88,
+	0x08, 0xbd, 0x46, 0x6d, 0x5a, 0x0f, 0x80, 0x2e, 0xc1, 0xc1, 0x88, 0x14, 0x41, 0x4e, 0xc2, 0xde,
+	0x86, 0x90, 0x60, 0x5d, 0xf2, 0xf1, 0xf8, 0xde, 0x0a, 0x0c, 0x86, 0xd6, 0xca, 0x0f, 0x12, 0xb6,
+	0xe0, 0x9a, 0x6d, 0xe8, 0xec, 0xbc, 0xc1, 0x1d, 0xcb, 0xde, 0x7a, 0x61, 0x77, 0x3c, 0x59, 0x05,
+	0x68, 0x4f, 0x44, 0xe2, 0x6e, 0xcd, 0x2d, 0x1c, 0x29, 0xe1, 0x95, 0xec, 0x8e, 0x44, 0x25, 0x6f,
+	0xd2, 0xc2, 0xc1, 0xa8, 0xb4, 0x46, 0xab, 0xfe, 0x8e, 0x2a, 0x87, 0x56, 0x86, 0xb6, 0xcd, 0x37,
+	0x12, 0x8c, 0x24, 0x24, 0x80, 0x65, 0x59, 0x86, 0x03, 0xdc, 0xa4, 0x0d, 0x5e, 0xb3, 0x1c, 0xff,
+	0xcb, 0x14, 0x13, 0x2a, 0xe3, 0x2e, 0xbd, 0x8c, 0x76, 0x58, 0xa0, 0xf6, 0x3a, 0x72, 0x2e, 0x01,
+	0xfa, 0xa5, 0x1d, 0xa1, 0x3d, 0x82, 0x30, 0xb5, 0x22, 0x63, 0xad, 0x57, 0xbc, 0x4b, 0xda, 0xad,
+	0x4b, 0xf0, 0x5d, 0x19, 0x8c, 0x24, 0xe8, 0x30, 0x8d, 0xf3, 0xd0, 0xef, 0x8f, 0x0a, 0x6e, 0x19,
+	0xfd, 0x54, 0xc6, 0xe3, 0xa9, 0x84, 0x96, 0x63, 0x22, 0x7d, 0x95, 0xb6, 0x88, 0x2b, 0x6b, 0x78,
+	0xb8, 0xac, 0x07, 0x5d, 0xa8, 0xeb, 0x4d, 0x9b, 0xea, 0x5b, 0x1d, 0xf7, 0xed, 0x03, 0xff, 0x34,
+	0x4a, 0x70, 0x89, 0xf8, 0x2b, 0x00, 0xd4, 0x93, 0x19, 0x41, 0x83, 0x14, 0xe2, 0xec, 0xee, 0xb6,
+	0xf7, 0xd7, 0x22, 0x7c, 0x68, 0x5d, 0xec, 0x42, 0xe8, 0x8e, 0x5d, 0x08, 0xe4, 0x18, 0x0c, 0xeb,
+	0x4d, 0xdb, 0x66, 0xa6, 0xa3, 0xf1, 0x4d, 0xca, 0x6b, 0xda, 0x4d, 0xc3, 0xac, 0x58, 0x37, 0x71,
+	0x38, 0x23, 0xa8, 0xbb, 0xec, 0xaa, 0xde, 0x11, 0x1a, 0xe5, 0x9e, 0x3f, 0x06, 0x85, 0x82, 0x1b,
+	0x41, 0xfb, 0xbb, 0x31, 0x23, 0x8e, 0xf0, 0x12, 0xe2, 0x6d, 0x0f, 0x84, 0x60, 0x93, 0x74, 0x8b,
+	0x32, 0x25, 0x6d, 0xf3, 0x9e, 0x5d, 0xd8, 0xe6, 0x3f, 0x4b, 0x30, 0x9a, 0xc8, 0xb9, 0xdb, 0x25,
+	0x8e, 0xa4, 0xdb, 0x1d, 0x4f, 0xf7, 0x5c, 0x42, 0x6a, 0x9d, 0x34, 0xc3, 0xc2, 0x6f, 0xc3, 0xb0,
+	0x47, 0x64, 0x44, 0x3e, 0x93, 0xa0, 0x2f, 0x7c, 0x26, 0x92, 0xe9, 0x38, 0x78, 0xda, 0x3b, 0x44,
+	0x9e, 0xc9, 0x64, 0xeb, 0xc5, 0x57, 0x66, 0xef, 0xfc, 0xfa, 0xf7, 0xa7, 0xdd, 0x47, 0xc8, 0x61,
+	0x75, 0xfb, 0xbb, 0xc7, 0x7b, 0x87, 0x45, 0x46, 0x5e, 0xf2, 0x85, 0x04, 0x83, 0x91, 0x09, 0xf6,
+	0x26, 0x6d, 0xbc, 0x38, 0xb6, 0x79, 0xc1, 0x36, 0x43, 0x8e, 0x66, 0x61, 0xd3, 0x1c, 0x97, 0xe5,
+	0x4b, 0x09, 0xfa, 0xc3, 0xbe, 0x38, 0xc9, 0x12, 0xd1, 0xdf, 0xdc, 0xf2, 0x6c, 0x36, 0x63, 0xe4,
+	0x3b, 0x2e, 0xf8, 0xe6, 0xc8, 0x4c, 0x0a, 0x9f, 0x38, 0x98, 0xa2, 0x94, 0x9c, 0x7c, 0x24, 0xc1,
+	0x3e, 0x9c, 0xe1, 0xc9, 0x64, 0x4a, 0xb8, 0xe8, 0xe8, 0x2f, 0x1f, 0xd9, 0xc9, 0x2c, 0xe3, 0xb7,
+	0xf4, 0x78, 0x70, 0xc0, 0x27, 0x9f, 0x4b, 0x90, 0x0b, 0x4d, 0xf0, 0xe4, 0x68, 0x4a, 0x94, 0xf8,
+	0x03, 0x40, 0x9e, 0xce, 0x62, 0x9a, 0xf1, 0x23, 0x7a, 0x50, 0xe1, 0x37, 0x03, 0xf9, 0x5e, 0x82,
+	0xc1, 0xed, 0xd3, 0x38, 0x29, 0xa5, 0xc4, 0x4c, 0x79, 0x07, 0xc8, 0x6a, 0x66, 0x7b, 0x04, 0x5d,
+	0x14, 0xa0, 0xa7, 0xc9, 0xa9, 0x14, 0xd0, 0xe0, 0xb4, 0xe7, 0xea, 0xed, 0xe8, 0x7d, 0xf0, 0xbe,
+	0xea, 0x3d, 0x06, 0xc8, 0x3d, 0x09, 0x72, 0xa1, 0xc1, 0x3d, 0xb5, 0xa4, 0xf1, 0x87, 0x82, 0x3c,
+	0x9d, 0xc5, 0x14, 0x49, 0x5f, 0x15, 0xa4, 0xa7, 0xc8, 0xcb, 0x1d, 0x90, 0xba, 0x77, 0x03, 0xf9,
+	0x51, 0x82, 0xc1, 0xed, 0x93, 0x72, 0x6a, 0x81, 0x53, 0x9e, 0x12, 0xb2, 0x9a, 0xd9, 0x1e, 0xb1,
+	0x2f, 0x0a, 0xec, 0x55, 0xb2, 0xd2, 0x01, 0x76, 0x6c, 0x74, 0x27, 0xf7, 0x25, 0x18, 0xda, 0x1e,
+	0x8a, 0x93, 0xac, 0x50, 0xc1, 0x56, 0x3e, 0x96, 0x7d, 0x01, 0xa6, 0x71, 0x46, 0xa4, 0x71, 0x92,
+	0x9c, 0xd8, 0x39, 0x8d, 0xf8, 0x83, 0x83, 0x7c, 0x27, 0x41, 0x7f, 0x64, 0x72, 0x4e, 0x3d, 0xa0,
+	0x92, 0xde, 0x10, 0xf2, 0x6c, 0x36, 0x63, 0x44, 0xbd, 0x20, 0x50, 0x97, 0xc9, 0x62, 0x3a, 0x6a,
+	0xc5, 0xd8, 0xb1, 0xe2, 0xa2, 0xdc, 0x5f, 0x4b, 0x30, 0x10, 0x09, 0xc2, 0x49, 0x26, 0x96, 0xa0,
+	0xd0, 0x73, 0x19, 0xad, 0x11, 0xfd, 0x94, 0x40, 0x3f, 0x4e, 0xe6, 0x9f, 0xa5, 0xca, 0x5e, 0x89,
+	0xdf, 0x83, 0xbd, 0xde, 0x60, 0x4f, 0x0e, 0xa7, 0xc4, 0x8c, 0xbc, 0x1f, 0xe4, 0xc9, 0x1d, 0xac,
+	0x90, 0x68, 0x52, 0x10, 0x15, 0xc9, 0x78, 0xea, 0x41, 0x26, 0x62, 0xba, 0x57, 0x77, 0x78, 0xf0,
+	0x4e, 0xbd, 0x1e, 0x13, 0x9e, 0x17, 0xf2, 0x4c, 0x26, 0xdb, 0xac, 0xc7, 0xbd, 0xbb, 0x48, 0xab,
+	0x21, 0x86, 0xcb, 0x15, 0x9e, 0xa4, 0x53, 0xb9, 0x12, 0x46, 0x71, 0x79, 0x26, 0x93, 0xed, 0x33,
+	0x5d, 0x43, 0x38, 0x83, 0x93, 0x07, 0x12, 0x0c, 0xc5, 0xe6, 0xe4, 0xd4, 0x3e, 0x4e, 0x1b, 0xd2,
+	0xe5, 0x63, 0xd9, 0x17, 0x20, 0xe6, 0xb2, 0xc0, 0x3c, 0x4b, 0x4e, 0x77, 0x72, 0x1c, 0xf9, 0x9c,
+	0x5f, 0x49, 0x30, 0x10, 0x9d, 0x3f, 0x53, 0xdb, 0x22, 0x71, 0x9c, 0x96, 0xe7, 0x32, 0x5a, 0x23,
+	0xf4, 0x09, 0x01, 0x5d, 0x22, 0xb3, 0x19, 0xda, 0x22, 0x58, 0xbd, 0x74, 0xe1, 0xe1, 0x93, 0x82,
+	0xf4, 0xe8, 0x49, 0x41, 0xfa, 0xeb, 0x49, 0x41, 0xfa, 0xe4, 0x69, 0xa1, 0xeb, 0xd1, 0xd3, 0x42,
+	0xd7, 0xef, 0x4f, 0x0b, 0x5d, 0x57, 0xd5, 0x0c, 0x2f, 0x57, 0x0c, 0xe1, 0x6c, 0x35, 0x18, 0xdf,
+	0xd8, 0x2b, 0xfe, 0x0f, 0x7e, 0xfc, 0xdf, 0x01, 0x00, 0x7a, 0x6f, 0x62, 0x0f, 0x11, 0x18, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1133,6 +1574,17 @@ type QueryClient interface {
 	AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PriceHistory returns the retained price snapshots of a pair in
+	// chronological order
+	PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error)
+	// DerivedPairs returns all derived pairs
+	DerivedPairs(ctx context.Context, in *QueryDerivedPairsRequest, opts ...grpc.CallOption) (*QueryDerivedPairsResponse, error)
+	// ValidatorAccuracy returns the vote accuracy records of a validator for
+	// the retained slash windows
+	ValidatorAccuracy(ctx context.Context, in *QueryValidatorAccuracyRequest, opts ...grpc.CallOption) (*QueryValidatorAccuracyResponse, error)
+	// VoteAccuracies returns the vote accuracy records of all validators for a
+	// slash window
+	VoteAccuracies(ctx context.Context, in *QueryVoteAccuraciesRequest, opts ...grpc.CallOption) (*QueryVoteAccuraciesResponse, error)
 }
 
 type queryClient struct {
@@ -1251,6 +1703,42 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PriceHistory(ctx context.Context, in *QueryPriceHistoryRequest, opts ...grpc.CallOption) (*QueryPriceHistoryResponse, error) {
+	out := new(QueryPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/PriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DerivedPairs(ctx context.Context, in *QueryDerivedPairsRequest, opts ...grpc.CallOption) (*QueryDerivedPairsResponse, error) {
+	out := new(QueryDerivedPairsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/DerivedPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorAccuracy(ctx context.Context, in *QueryValidatorAccuracyRequest, opts ...grpc.CallOption) (*QueryValidatorAccuracyResponse, error) {
+	out := new(QueryValidatorAccuracyResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/ValidatorAccuracy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteAccuracies(ctx context.Context, in *QueryVoteAccuraciesRequest, opts ...grpc.CallOption) (*QueryVoteAccuraciesResponse, error) {
+	out := new(QueryVoteAccuraciesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/VoteAccuracies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// ExchangeRate returns exchange rate of a pair along with the block height and
@@ -1278,6 +1766,17 @@ type QueryServer interface {
 	AggregateVotes(context.Context, *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error)
 	// Params queries all parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PriceHistory returns the retained price snapshots of a pair in
+	// chronological order
+	PriceHistory(context.Context, *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error)
+	// DerivedPairs returns all derived pairs
+	DerivedPairs(context.Context, *QueryDerivedPairsRequest) (*QueryDerivedPairsResponse, error)
+	// ValidatorAccuracy returns the vote accuracy records of a validator for
+	// the retained slash windows
+	ValidatorAccuracy(context.Context, *QueryValidatorAccuracyRequest) (*QueryValidatorAccuracyResponse, error)
+	// VoteAccuracies returns the vote accuracy records of all validators for a
+	// slash window
+	VoteAccuracies(context.Context, *QueryVoteAccuraciesRequest) (*QueryVoteAccuraciesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1320,6 +1819,18 @@ func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryA
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) PriceHistory(ctx context.Context, req *QueryPriceHistoryRequest) (*QueryPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) DerivedPairs(ctx context.Context, req *QueryDerivedPairsRequest) (*QueryDerivedPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DerivedPairs not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorAccuracy(ctx context.Context, req *QueryValidatorAccuracyRequest) (*QueryValidatorAccuracyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAccuracy not implemented")
+}
+func (*UnimplementedQueryServer) VoteAccuracies(ctx context.Context, req *QueryVoteAccuraciesRequest) (*QueryVoteAccuraciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteAccuracies not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1541,14 +2052,86 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "nibiru.oracle.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ExchangeRate",
-			Handler:    _Query_ExchangeRate_Handler,
-		},
+func _Query_PriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/PriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHistory(ctx, req.(*QueryPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DerivedPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDerivedPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DerivedPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/DerivedPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DerivedPairs(ctx, req.(*QueryDerivedPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorAccuracy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAccuracyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAccuracy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/ValidatorAccuracy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAccuracy(ctx, req.(*QueryValidatorAccuracyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteAccuracies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteAccuraciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteAccuracies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/VoteAccuracies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteAccuracies(ctx, req.(*QueryVoteAccuraciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nibiru.oracle.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExchangeRate",
+			Handler:    _Query_ExchangeRate_Handler,
+		},
 		{
 			MethodName: "ExchangeRateTwap",
 			Handler:    _Query_ExchangeRateTwap_Handler,
@@ -1593,6 +2176,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PriceHistory",
+			Handler:    _Query_PriceHistory_Handler,
+		},
+		{
+			MethodName: "DerivedPairs",
+			Handler:    _Query_DerivedPairs_Handler,
+		},
+		{
+			MethodName: "ValidatorAccuracy",
+			Handler:    _Query_ValidatorAccuracy_Handler,
+		},
+		{
+			MethodName: "VoteAccuracies",
+			Handler:    _Query_VoteAccuracies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/oracle/v1/query.proto",
@@ -1651,6 +2250,20 @@ func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.DerivedFrom) > 0 {
+		for iNdEx := len(m.DerivedFrom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.DerivedFrom[iNdEx].Size()
+				i -= size
+				if _, err := m.DerivedFrom[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.IsVintage {
 		i--
 		if m.IsVintage {
@@ -2284,212 +2897,451 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryExchangeRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+
+func (m *QueryPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExchangeRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.BlockTimestampMs != 0 {
-		n += 1 + sovQuery(uint64(m.BlockTimestampMs))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.IsVintage {
-		n += 2
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryExchangeRatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for _, e := range m.ExchangeRates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActivesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDerivedPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryActivesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Actives) > 0 {
-		for _, e := range m.Actives {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryDerivedPairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVoteTargetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDerivedPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVoteTargetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.VoteTargets) > 0 {
-		for _, e := range m.VoteTargets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryDerivedPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFeederDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDerivedPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDerivedPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DerivedPairs) > 0 {
+		for iNdEx := len(m.DerivedPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivedPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeederDelegationResponse) Size() (n int) {
+func (m *QueryValidatorAccuracyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAccuracyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAccuracyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAccuracyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAccuracyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAccuracyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentSlashWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentSlashWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MissCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Accuracies) > 0 {
+		for iNdEx := len(m.Accuracies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accuracies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteAccuraciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteAccuraciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteAccuraciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SlashWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteAccuraciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteAccuraciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteAccuraciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SlashWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Accuracies) > 0 {
+		for iNdEx := len(m.Accuracies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accuracies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryExchangeRateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.FeederAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryMissCounterRequest) Size() (n int) {
+func (m *QueryExchangeRateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BlockTimestampMs != 0 {
+		n += 1 + sovQuery(uint64(m.BlockTimestampMs))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.IsVintage {
+		n += 2
+	}
+	if len(m.DerivedFrom) > 0 {
+		for _, e := range m.DerivedFrom {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *QueryMissCounterResponse) Size() (n int) {
+func (m *QueryExchangeRatesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MissCounter != 0 {
-		n += 1 + sovQuery(uint64(m.MissCounter))
-	}
 	return n
 }
 
-func (m *QueryAggregatePrevoteRequest) Size() (n int) {
+func (m *QueryExchangeRatesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *QueryAggregatePrevoteResponse) Size() (n int) {
+func (m *QueryActivesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.AggregatePrevote.Size()
-	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryAggregatePrevotesRequest) Size() (n int) {
+func (m *QueryActivesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Actives) > 0 {
+		for _, e := range m.Actives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *QueryAggregatePrevotesResponse) Size() (n int) {
+func (m *QueryVoteTargetsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for _, e := range m.AggregatePrevotes {
+	return n
+}
+
+func (m *QueryVoteTargetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoteTargets) > 0 {
+		for _, e := range m.VoteTargets {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -2497,7 +3349,7 @@ func (m *QueryAggregatePrevotesResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryAggregateVoteRequest) Size() (n int) {
+func (m *QueryFeederDelegationRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2510,68 +3362,1133 @@ func (m *QueryAggregateVoteRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryAggregateVoteResponse) Size() (n int) {
+func (m *QueryFeederDelegationResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.AggregateVote.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.FeederAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryAggregateVotesRequest) Size() (n int) {
+func (m *QueryMissCounterRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryAggregateVotesResponse) Size() (n int) {
+func (m *QueryMissCounterResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for _, e := range m.AggregateVotes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.MissCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissCounter))
 	}
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryAggregatePrevoteRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryAggregatePrevoteResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	var l int
+	_ = l
+	l = m.AggregatePrevote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregatePrevotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregatePrevotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregatePrevotes) > 0 {
+		for _, e := range m.AggregatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAggregateVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregateVote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregateVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregateVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregateVotes) > 0 {
+		for _, e := range m.AggregateVotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDerivedPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDerivedPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DerivedPairs) > 0 {
+		for _, e := range m.DerivedPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorAccuracyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccuracyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accuracies) > 0 {
+		for _, e := range m.Accuracies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MissCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissCounter))
+	}
+	if m.CurrentSlashWindow != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentSlashWindow))
+	}
+	return n
+}
+
+func (m *QueryVoteAccuraciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashWindow != 0 {
+		n += 1 + sovQuery(uint64(m.SlashWindow))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteAccuraciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accuracies) > 0 {
+		for _, e := range m.Accuracies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SlashWindow != 0 {
+		n += 1 + sovQuery(uint64(m.SlashWindow))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestampMs", wireType)
+			}
+			m.BlockTimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsVintage", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsVintage = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_NibiruChain_nibiru_v2_x_common_asset.Pair
+			m.DerivedFrom = append(m.DerivedFrom, v)
+			if err := m.DerivedFrom[len(m.DerivedFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, ExchangeRateTuple{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_NibiruChain_nibiru_v2_x_common_asset.Pair
+			m.Actives = append(m.Actives, v)
+			if err := m.Actives[len(m.Actives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_NibiruChain_nibiru_v2_x_common_asset.Pair
+			m.VoteTargets = append(m.VoteTargets, v)
+			if err := m.VoteTargets[len(m.VoteTargets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2594,15 +4511,15 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2630,9 +4547,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2655,7 +4570,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2678,17 +4593,17 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
 			}
-			var stringLen uint64
+			m.MissCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2698,50 +4613,66 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MissCounter |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestampMs", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.BlockTimestampMs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockTimestampMs |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2751,16 +4682,79 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsVintage", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2770,12 +4764,25 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsVintage = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2797,7 +4804,7 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2820,10 +4827,10 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2847,7 +4854,7 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2870,15 +4877,15 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2905,8 +4912,8 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = append(m.ExchangeRates, ExchangeRateTuple{})
-			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2931,7 +4938,7 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2954,12 +4961,44 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2981,7 +5020,7 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3004,17 +5043,17 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3024,25 +5063,22 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_NibiruChain_nibiru_v2_x_common_asset.Pair
-			m.Actives = append(m.Actives, v)
-			if err := m.Actives[len(m.Actives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3067,7 +5103,7 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3090,10 +5126,10 @@ func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVotesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3117,7 +5153,7 @@ func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3140,17 +5176,17 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3160,25 +5196,23 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_NibiruChain_nibiru_v2_x_common_asset.Pair
-			m.VoteTargets = append(m.VoteTargets, v)
-			if err := m.VoteTargets[len(m.VoteTargets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
+			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3203,7 +5237,7 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3226,44 +5260,12 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3285,7 +5287,7 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3308,17 +5310,17 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3328,23 +5330,24 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3367,7 +5370,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3390,15 +5393,15 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3426,63 +5429,15 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.MissCounter = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3492,11 +5447,28 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCounter |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3518,7 +5490,7 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3541,17 +5513,17 @@ func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3561,77 +5533,29 @@ func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, PriceSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3658,7 +5582,10 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3683,7 +5610,7 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDerivedPairsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3706,10 +5633,10 @@ func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDerivedPairsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDerivedPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3733,7 +5660,7 @@ func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDerivedPairsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3756,15 +5683,15 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDerivedPairsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDerivedPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedPairs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3791,8 +5718,8 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
-			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DerivedPairs = append(m.DerivedPairs, DerivedPair{})
+			if err := m.DerivedPairs[len(m.DerivedPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3817,7 +5744,7 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorAccuracyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3840,10 +5767,10 @@ func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorAccuracyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorAccuracyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3899,7 +5826,7 @@ func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorAccuracyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3922,15 +5849,15 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorAccuracyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorAccuracyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accuracies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3957,10 +5884,49 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accuracies = append(m.Accuracies, VoteAccuracy{})
+			if err := m.Accuracies[len(m.Accuracies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			}
+			m.MissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSlashWindow", wireType)
+			}
+			m.CurrentSlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentSlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3982,7 +5948,7 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteAccuraciesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4005,65 +5971,66 @@ func (m *QueryAggregateVotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteAccuraciesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteAccuraciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4090,8 +6057,10 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
-			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4116,7 +6085,7 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteAccuraciesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4139,65 +6108,68 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteAccuraciesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteAccuraciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accuracies", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Accuracies = append(m.Accuracies, VoteAccuracy{})
+			if err := m.Accuracies[len(m.Accuracies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4224,7 +6196,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
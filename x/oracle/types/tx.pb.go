@@ -18,6 +18,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -357,6 +358,84 @@ func (m *MsgEditOracleParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEditOracleParamsResponse proto.InternalMessageInfo
 
+// MsgEditDerivedPairs adds, updates, and removes derived pairs.
+type MsgEditDerivedPairs struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// Derived pairs to add or update
+	Upsert []DerivedPair `protobuf:"bytes,2,rep,name=upsert,proto3" json:"upsert" yaml:"upsert"`
+	// Derived pairs to remove. Their exchange rates are deleted too.
+	Remove []github_com_NibiruChain_nibiru_v2_x_common_asset.Pair `protobuf:"bytes,3,rep,name=remove,proto3,customtype=github.com/NibiruChain/nibiru/v2/x/common/asset.Pair" json:"remove" yaml:"remove"`
+}
+
+func (m *MsgEditDerivedPairs) Reset()         { *m = MsgEditDerivedPairs{} }
+func (m *MsgEditDerivedPairs) String() string { return proto.CompactTextString(m) }
+func (*MsgEditDerivedPairs) ProtoMessage()    {}
+func (*MsgEditDerivedPairs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{8}
+}
+func (m *MsgEditDerivedPairs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEditDerivedPairs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEditDerivedPairs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEditDerivedPairs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditDerivedPairs.Merge(m, src)
+}
+func (m *MsgEditDerivedPairs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEditDerivedPairs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditDerivedPairs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEditDerivedPairs proto.InternalMessageInfo
+
+type MsgEditDerivedPairsResponse struct {
+}
+
+func (m *MsgEditDerivedPairsResponse) Reset()         { *m = MsgEditDerivedPairsResponse{} }
+func (m *MsgEditDerivedPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEditDerivedPairsResponse) ProtoMessage()    {}
+func (*MsgEditDerivedPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{9}
+}
+func (m *MsgEditDerivedPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEditDerivedPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEditDerivedPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEditDerivedPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditDerivedPairsResponse.Merge(m, src)
+}
+func (m *MsgEditDerivedPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEditDerivedPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditDerivedPairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEditDerivedPairsResponse proto.InternalMessageInfo
+
 type OracleParamsMsg struct {
 	// VotePeriod defines the number of blocks during which voting takes place.
 	VotePeriod uint64 `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
@@ -393,13 +472,26 @@ type OracleParamsMsg struct {
 	// The validator fee ratio that is given to validators every epoch.
 	ValidatorFeeRatio *cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=validator_fee_ratio,json=validatorFeeRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_fee_ratio,omitempty" yaml:"validator_fee_ratio"`
 	ExpirationBlocks  uint64                       `protobuf:"varint,11,opt,name=expiration_blocks,json=expirationBlocks,proto3" json:"expiration_blocks,omitempty" yaml:"expiration_blocks"`
+	// Number of TWAP lookback windows of price snapshots to retain. Zero keeps
+	// every snapshot, so the field is a wrapper and only an unset field leaves
+	// the current value unchanged.
+	SnapshotRetentionWindows *uint64 `protobuf:"bytes,12,opt,name=snapshot_retention_windows,json=snapshotRetentionWindows,proto3,wktptr" json:"snapshot_retention_windows,omitempty" yaml:"snapshot_retention_windows"`
+	// Aggregation strategies to set for the given pairs. The other pairs keep
+	// their strategy. A pair given the default strategy, a weighted median
+	// without a standard deviation bound, is reset to the default.
+	PairAggregations []PairAggregation `protobuf:"bytes,13,rep,name=pair_aggregations,json=pairAggregations,proto3" json:"pair_aggregations" yaml:"pair_aggregations"`
+	// Number of completed slash windows whose vote accuracy records are kept
+	// in addition to the current one. Zero keeps only the current slash window,
+	// so the field is a wrapper and only an unset field leaves the current value
+	// unchanged.
+	VoteAccuracyRetentionWindows *uint64 `protobuf:"bytes,14,opt,name=vote_accuracy_retention_windows,json=voteAccuracyRetentionWindows,proto3,wktptr" json:"vote_accuracy_retention_windows,omitempty" yaml:"vote_accuracy_retention_windows"`
 }
 
 func (m *OracleParamsMsg) Reset()         { *m = OracleParamsMsg{} }
 func (m *OracleParamsMsg) String() string { return proto.CompactTextString(m) }
 func (*OracleParamsMsg) ProtoMessage()    {}
 func (*OracleParamsMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11e362c65eb610f4, []int{8}
+	return fileDescriptor_11e362c65eb610f4, []int{10}
 }
 func (m *OracleParamsMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -463,6 +555,27 @@ func (m *OracleParamsMsg) GetExpirationBlocks() uint64 {
 	return 0
 }
 
+func (m *OracleParamsMsg) GetSnapshotRetentionWindows() *uint64 {
+	if m != nil {
+		return m.SnapshotRetentionWindows
+	}
+	return nil
+}
+
+func (m *OracleParamsMsg) GetPairAggregations() []PairAggregation {
+	if m != nil {
+		return m.PairAggregations
+	}
+	return nil
+}
+
+func (m *OracleParamsMsg) GetVoteAccuracyRetentionWindows() *uint64 {
+	if m != nil {
+		return m.VoteAccuracyRetentionWindows
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgAggregateExchangeRatePrevote)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRatePrevote")
 	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRatePrevoteResponse")
@@ -472,84 +585,101 @@ func init() {
 	proto.RegisterType((*MsgDelegateFeedConsentResponse)(nil), "nibiru.oracle.v1.MsgDelegateFeedConsentResponse")
 	proto.RegisterType((*MsgEditOracleParams)(nil), "nibiru.oracle.v1.MsgEditOracleParams")
 	proto.RegisterType((*MsgEditOracleParamsResponse)(nil), "nibiru.oracle.v1.MsgEditOracleParamsResponse")
+	proto.RegisterType((*MsgEditDerivedPairs)(nil), "nibiru.oracle.v1.MsgEditDerivedPairs")
+	proto.RegisterType((*MsgEditDerivedPairsResponse)(nil), "nibiru.oracle.v1.MsgEditDerivedPairsResponse")
 	proto.RegisterType((*OracleParamsMsg)(nil), "nibiru.oracle.v1.OracleParamsMsg")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/tx.proto", fileDescriptor_11e362c65eb610f4) }
 
 var fileDescriptor_11e362c65eb610f4 = []byte{
-	// 1125 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0xe3, 0xc4,
-	0x1b, 0xae, 0xb7, 0xfd, 0xf5, 0xd7, 0x4c, 0xe8, 0xb6, 0x75, 0xda, 0xc5, 0x49, 0x5b, 0xbb, 0x0c,
-	0xb0, 0x74, 0x25, 0x6a, 0xd3, 0x82, 0x10, 0x14, 0x09, 0x41, 0xb6, 0x5b, 0xb4, 0xa8, 0x65, 0x23,
-	0x0b, 0x2d, 0x12, 0x97, 0x68, 0x62, 0x4f, 0x6d, 0xab, 0xb6, 0xc7, 0x78, 0xa6, 0xff, 0x4e, 0x08,
-	0x84, 0x04, 0x47, 0x24, 0x24, 0x54, 0x6e, 0xfd, 0x00, 0x48, 0x5c, 0xf8, 0x10, 0x7b, 0x5c, 0xc1,
-	0x05, 0x71, 0x08, 0xa8, 0xe5, 0xb0, 0xe2, 0xc0, 0x21, 0x77, 0x04, 0xf2, 0xcc, 0xc4, 0x49, 0x9d,
-	0xec, 0x6e, 0xca, 0x2d, 0x33, 0xcf, 0x33, 0xef, 0xfb, 0xbc, 0x8f, 0x67, 0xde, 0x37, 0xa0, 0x1a,
-	0x07, 0xad, 0x20, 0x3d, 0xb0, 0x48, 0x8a, 0x9c, 0x10, 0x5b, 0x87, 0xeb, 0x16, 0x3b, 0x36, 0x93,
-	0x94, 0x30, 0xa2, 0xce, 0x0a, 0xc8, 0x14, 0x90, 0x79, 0xb8, 0x5e, 0x9b, 0xf7, 0x88, 0x47, 0x38,
-	0x68, 0x65, 0xbf, 0x04, 0xaf, 0xb6, 0xe4, 0x11, 0xe2, 0x85, 0xd8, 0x42, 0x49, 0x60, 0xa1, 0x38,
-	0x26, 0x0c, 0xb1, 0x80, 0xc4, 0x54, 0xa2, 0xba, 0x44, 0xf9, 0xaa, 0x75, 0xb0, 0x67, 0xb9, 0x07,
-	0x29, 0x27, 0x48, 0x7c, 0x79, 0x40, 0x80, 0xcc, 0x27, 0xe0, 0xaa, 0x43, 0x68, 0x44, 0x68, 0x53,
-	0x64, 0x15, 0x0b, 0x01, 0xc1, 0x1f, 0x14, 0x60, 0xec, 0x52, 0xef, 0x5d, 0xcf, 0x4b, 0xb1, 0x87,
-	0x18, 0xbe, 0x73, 0xec, 0xf8, 0x28, 0xf6, 0xb0, 0x8d, 0x18, 0x6e, 0xa4, 0xf8, 0x90, 0x30, 0xac,
-	0x3e, 0x0f, 0x26, 0x7c, 0x44, 0x7d, 0x4d, 0x59, 0x51, 0x56, 0x4b, 0xf5, 0x99, 0x4e, 0xdb, 0x28,
-	0x9f, 0xa0, 0x28, 0xdc, 0x84, 0xd9, 0x2e, 0xb4, 0x39, 0xa8, 0xde, 0x02, 0x93, 0x7b, 0x18, 0xbb,
-	0x38, 0xd5, 0xae, 0x71, 0xda, 0x5c, 0xa7, 0x6d, 0x4c, 0x0b, 0x9a, 0xd8, 0x87, 0xb6, 0x24, 0xa8,
-	0x1b, 0xa0, 0x74, 0x88, 0xc2, 0xc0, 0x45, 0x8c, 0xa4, 0xda, 0x38, 0x67, 0xcf, 0x77, 0xda, 0xc6,
-	0xac, 0x60, 0xe7, 0x10, 0xb4, 0x7b, 0xb4, 0xcd, 0xa9, 0xaf, 0xce, 0x8c, 0xb1, 0x47, 0x67, 0xc6,
-	0x18, 0xbc, 0x05, 0x5e, 0x7a, 0x8a, 0x60, 0x1b, 0xd3, 0x84, 0xc4, 0x14, 0xc3, 0xbf, 0x14, 0xb0,
-	0xf4, 0x38, 0xee, 0x7d, 0x59, 0x19, 0x45, 0x21, 0x1b, 0xac, 0x2c, 0xdb, 0x85, 0x36, 0x07, 0xd5,
-	0x77, 0xc0, 0x75, 0x2c, 0x0f, 0x36, 0x53, 0xc4, 0x30, 0x95, 0x15, 0x56, 0x3b, 0x6d, 0x63, 0x41,
-	0xd0, 0x2f, 0xe3, 0xd0, 0x9e, 0xc6, 0x7d, 0x99, 0x68, 0x9f, 0x37, 0xe3, 0x57, 0xf2, 0x66, 0xe2,
-	0xaa, 0xde, 0xdc, 0x04, 0x2f, 0x3c, 0xa9, 0xde, 0xdc, 0x98, 0x2f, 0x14, 0x70, 0x63, 0x97, 0x7a,
-	0x5b, 0x38, 0xe4, 0xbc, 0x6d, 0x8c, 0xdd, 0xdb, 0x19, 0x10, 0x33, 0xd5, 0x02, 0x53, 0x24, 0xc1,
-	0x29, 0xcf, 0x2f, 0x6c, 0xa9, 0x74, 0xda, 0xc6, 0x8c, 0xc8, 0xdf, 0x45, 0xa0, 0x9d, 0x93, 0xb2,
-	0x03, 0xae, 0x8c, 0x23, 0x8d, 0xe9, 0x3b, 0xd0, 0x45, 0xa0, 0x9d, 0x93, 0xfa, 0xe4, 0xae, 0x00,
-	0x7d, 0xb8, 0x8a, 0x5c, 0xe8, 0xa9, 0x02, 0x2a, 0xbb, 0xd4, 0xbb, 0xe3, 0x06, 0xec, 0x1e, 0xbf,
-	0xd1, 0x0d, 0x94, 0xa2, 0x88, 0x3b, 0x4a, 0x71, 0x9c, 0x39, 0xaa, 0x14, 0x1d, 0x15, 0xfb, 0xd0,
-	0x96, 0x04, 0x75, 0x07, 0x4c, 0x26, 0xfc, 0x10, 0x57, 0x57, 0xde, 0x78, 0xce, 0x2c, 0x3e, 0x49,
-	0xb3, 0x3f, 0xf4, 0x2e, 0xf5, 0xfa, 0xa3, 0x89, 0xa3, 0xd0, 0x96, 0x31, 0xfa, 0xc4, 0x2f, 0x83,
-	0xc5, 0x21, 0xca, 0x72, 0xe5, 0xff, 0x4c, 0x81, 0x99, 0x42, 0x5c, 0xf5, 0x2d, 0x50, 0xce, 0xee,
-	0x67, 0x33, 0xc1, 0x69, 0x40, 0x5c, 0x2e, 0x7d, 0xa2, 0x5e, 0x7b, 0xd0, 0x36, 0x94, 0x4e, 0xdb,
-	0x50, 0xe5, 0x27, 0xee, 0x11, 0xa0, 0x0d, 0xb2, 0x55, 0x83, 0x2f, 0xd4, 0x4f, 0xc0, 0x75, 0x8e,
-	0x31, 0x3f, 0xc5, 0xd4, 0x27, 0xa1, 0x2b, 0xdd, 0x7e, 0x3f, 0x3b, 0xff, 0x6b, 0xdb, 0x58, 0x14,
-	0xef, 0x9a, 0xba, 0xfb, 0x66, 0x40, 0xac, 0x08, 0x31, 0xdf, 0xdc, 0xc1, 0x1e, 0x72, 0x4e, 0xb6,
-	0xb0, 0xd3, 0xbb, 0xa9, 0x97, 0x43, 0xc0, 0x9f, 0x7e, 0x5c, 0x03, 0xb2, 0x1f, 0x6c, 0x61, 0xc7,
-	0x9e, 0xce, 0xe0, 0x0f, 0xbb, 0xa8, 0xea, 0x83, 0x72, 0x8a, 0x8f, 0x50, 0xea, 0x36, 0x5b, 0x28,
-	0x76, 0xe5, 0xe5, 0x7d, 0x6f, 0xb4, 0x7c, 0xb2, 0x9c, 0xbe, 0xf3, 0xc5, 0x64, 0x40, 0x60, 0x75,
-	0x14, 0xbb, 0x6a, 0x0c, 0x4a, 0x47, 0x7e, 0xc0, 0x70, 0x18, 0x50, 0xa6, 0x4d, 0xac, 0x8c, 0xaf,
-	0x96, 0xea, 0x0d, 0x99, 0xe7, 0x35, 0x2f, 0x60, 0xfe, 0x41, 0xcb, 0x74, 0x48, 0x64, 0x7d, 0xc0,
-	0xbf, 0xdc, 0x6d, 0x1f, 0x05, 0xb1, 0x25, 0x5b, 0xde, 0xe1, 0x86, 0x75, 0x6c, 0x39, 0x24, 0x8a,
-	0x48, 0x6c, 0x21, 0x4a, 0x31, 0x33, 0x1b, 0x28, 0x48, 0x7b, 0x4f, 0x26, 0x0f, 0x0b, 0xed, 0x5e,
-	0x8a, 0xcc, 0x4c, 0x1a, 0x22, 0xea, 0x37, 0xf7, 0x52, 0xe4, 0x64, 0x8d, 0x54, 0xfb, 0xdf, 0x7f,
-	0x30, 0xf3, 0x72, 0x88, 0x01, 0x33, 0x39, 0xbc, 0x2d, 0x51, 0xf5, 0x6d, 0xf0, 0x8c, 0xe0, 0x1f,
-	0x05, 0xb1, 0x4b, 0x8e, 0xb4, 0x49, 0xfe, 0xf5, 0x17, 0xe5, 0xd7, 0xaf, 0xf4, 0x47, 0x14, 0x0c,
-	0x68, 0x97, 0xf9, 0xf2, 0x23, 0xbe, 0x52, 0x3f, 0x53, 0xc0, 0x7c, 0x14, 0xc4, 0x4d, 0xfe, 0xee,
-	0xb3, 0x1b, 0xd2, 0x0d, 0xf4, 0x7f, 0xae, 0xbc, 0x31, 0x9a, 0xf2, 0x45, 0x91, 0x67, 0x58, 0xa0,
-	0xa2, 0xfe, 0xb9, 0x28, 0x88, 0xef, 0x67, 0x9c, 0x06, 0x4e, 0xa5, 0x86, 0x6f, 0x15, 0x30, 0xcf,
-	0x8e, 0x50, 0xd2, 0x0c, 0x09, 0xd9, 0x6f, 0x21, 0x67, 0xbf, 0xab, 0x61, 0x8a, 0x3f, 0xad, 0xaa,
-	0x29, 0xe6, 0x94, 0xd9, 0x9d, 0x53, 0xe6, 0x96, 0x9c, 0x53, 0xf5, 0xbb, 0x99, 0xbc, 0x3f, 0xdb,
-	0x86, 0x3e, 0xec, 0xf8, 0xcb, 0x24, 0x0a, 0x18, 0x8e, 0x12, 0x76, 0xd2, 0x53, 0x38, 0x8c, 0x07,
-	0x4f, 0x7f, 0x33, 0x14, 0x5b, 0xcd, 0xa0, 0x1d, 0x89, 0x48, 0x61, 0x6f, 0x00, 0xc0, 0x4b, 0x22,
-	0x0c, 0xa7, 0x54, 0x2b, 0x71, 0x6b, 0xab, 0xd2, 0xda, 0xb9, 0xbe, 0x92, 0x39, 0x0e, 0xed, 0x52,
-	0x56, 0x1a, 0xff, 0xad, 0x7e, 0x0a, 0x2a, 0x79, 0x27, 0x6d, 0xee, 0x61, 0xde, 0xc2, 0x03, 0xa2,
-	0x01, 0x6e, 0xea, 0xbd, 0xd1, 0x4c, 0xad, 0x15, 0xba, 0x73, 0x2f, 0xce, 0x80, 0xa7, 0x39, 0x67,
-	0x1b, 0x67, 0x6d, 0x39, 0x20, 0xea, 0x2e, 0x98, 0xc3, 0xc7, 0x49, 0x20, 0x7c, 0x6a, 0xb6, 0x42,
-	0xe2, 0xec, 0x53, 0xad, 0xcc, 0x2b, 0x58, 0x91, 0x15, 0x68, 0xdd, 0x29, 0x53, 0xa0, 0x41, 0x7b,
-	0xb6, 0xb7, 0x57, 0xe7, 0x5b, 0x9b, 0x53, 0xa7, 0x67, 0x86, 0xf2, 0xe8, 0xcc, 0x50, 0x36, 0xfe,
-	0x9e, 0x00, 0xe3, 0x59, 0xd7, 0xf9, 0x5e, 0x01, 0x4b, 0x4f, 0x9c, 0xef, 0xeb, 0x83, 0x1d, 0xf1,
-	0x29, 0x13, 0xb6, 0xf6, 0xe6, 0x95, 0x8f, 0xe4, 0x8d, 0x51, 0xff, 0xfc, 0xe7, 0x3f, 0xbe, 0xb9,
-	0xa6, 0xc1, 0x1b, 0xd6, 0xe5, 0x3f, 0x2d, 0x89, 0x54, 0x73, 0xa6, 0x80, 0xea, 0xe3, 0x27, 0xb6,
-	0x39, 0x7a, 0xe2, 0x8c, 0x5f, 0x7b, 0xfd, 0x6a, 0xfc, 0x5c, 0xe5, 0x22, 0x57, 0xb9, 0x00, 0x2b,
-	0x05, 0x95, 0x5c, 0xe2, 0x77, 0x0a, 0xa8, 0x0c, 0x9b, 0x9d, 0xab, 0x43, 0x93, 0x0d, 0x61, 0xd6,
-	0x5e, 0x19, 0x95, 0x99, 0x0b, 0xba, 0xc9, 0x05, 0xad, 0x40, 0xbd, 0x20, 0x48, 0xfc, 0x6f, 0x58,
-	0xeb, 0x4e, 0x57, 0xf5, 0x4b, 0x05, 0xcc, 0x0e, 0x8c, 0xcb, 0x17, 0x87, 0xa6, 0x2b, 0xd2, 0x6a,
-	0x6b, 0x23, 0xd1, 0x72, 0x49, 0xcb, 0x5c, 0xd2, 0xb3, 0x70, 0xa1, 0xf8, 0x25, 0x39, 0xad, 0x7e,
-	0xf7, 0xc1, 0xb9, 0xae, 0x3c, 0x3c, 0xd7, 0x95, 0xdf, 0xcf, 0x75, 0xe5, 0xeb, 0x0b, 0x7d, 0xec,
-	0xe1, 0x85, 0x3e, 0xf6, 0xcb, 0x85, 0x3e, 0xf6, 0xb1, 0x35, 0x42, 0x4b, 0x97, 0xb1, 0xd8, 0x49,
-	0x82, 0x69, 0x6b, 0x92, 0x37, 0x94, 0x57, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x15, 0x0e, 0x3a,
-	0xaa, 0x69, 0x0b, 0x00, 0x00,
+	// 1376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x1b, 0x8e, 0xb3, 0xf9, 0xf2, 0x25, 0xb3, 0x4d, 0x9b, 0x38, 0x49, 0x3f, 0x67, 0x93, 0xac, 0xb7,
+	0xf3, 0x95, 0x92, 0x4a, 0xc4, 0xa6, 0xa1, 0xaa, 0xa0, 0x48, 0x88, 0x6e, 0xd3, 0xa2, 0xa2, 0x86,
+	0x46, 0x16, 0x14, 0x89, 0xcb, 0x6a, 0xd6, 0x9e, 0x78, 0xad, 0xec, 0x7a, 0xcc, 0xcc, 0xec, 0x26,
+	0x39, 0x21, 0x10, 0x08, 0x4e, 0x05, 0xa9, 0x12, 0x2a, 0xb7, 0xfc, 0x00, 0x24, 0x2e, 0xfc, 0x88,
+	0x1e, 0x2b, 0xb8, 0xa0, 0x1e, 0x16, 0xd4, 0x72, 0xa8, 0x38, 0x70, 0xd8, 0x3f, 0x00, 0xf2, 0xcc,
+	0xd8, 0xeb, 0x78, 0x37, 0xed, 0xa6, 0x37, 0xcf, 0x3c, 0xcf, 0xfb, 0xbe, 0xcf, 0x3c, 0xe3, 0x99,
+	0x77, 0xc0, 0x52, 0x18, 0xd4, 0x03, 0xda, 0xb6, 0x09, 0x45, 0x6e, 0x13, 0xdb, 0x9d, 0x4b, 0x36,
+	0xdf, 0xb7, 0x22, 0x4a, 0x38, 0xd1, 0x67, 0x25, 0x64, 0x49, 0xc8, 0xea, 0x5c, 0x2a, 0x2d, 0xf8,
+	0xc4, 0x27, 0x02, 0xb4, 0xe3, 0x2f, 0xc9, 0x2b, 0xad, 0xf8, 0x84, 0xf8, 0x4d, 0x6c, 0xa3, 0x28,
+	0xb0, 0x51, 0x18, 0x12, 0x8e, 0x78, 0x40, 0x42, 0xa6, 0xd0, 0xb2, 0x42, 0xc5, 0xa8, 0xde, 0xde,
+	0xb1, 0xbd, 0x36, 0x15, 0x84, 0xe3, 0xf0, 0x3d, 0x8a, 0xa2, 0x08, 0xd3, 0x24, 0x7e, 0x75, 0x40,
+	0xa0, 0xd2, 0x23, 0xe1, 0x25, 0x97, 0xb0, 0x16, 0x61, 0x35, 0xa9, 0x4a, 0x0e, 0x24, 0x04, 0x7f,
+	0xd2, 0x80, 0xb9, 0xc5, 0xfc, 0x6b, 0xbe, 0x4f, 0xb1, 0x8f, 0x38, 0xbe, 0xb1, 0xef, 0x36, 0x50,
+	0xe8, 0x63, 0x07, 0x71, 0xbc, 0x4d, 0x71, 0x87, 0x70, 0xac, 0xff, 0x1f, 0x4c, 0x34, 0x10, 0x6b,
+	0x18, 0x5a, 0x45, 0x5b, 0x9b, 0xae, 0x9e, 0xe9, 0x75, 0xcd, 0xe2, 0x01, 0x6a, 0x35, 0xaf, 0xc2,
+	0x78, 0x16, 0x3a, 0x02, 0xd4, 0x2f, 0x82, 0xc9, 0x1d, 0x8c, 0x3d, 0x4c, 0x8d, 0x71, 0x41, 0x9b,
+	0xeb, 0x75, 0xcd, 0x19, 0x49, 0x93, 0xf3, 0xd0, 0x51, 0x04, 0x7d, 0x03, 0x4c, 0x77, 0x50, 0x33,
+	0xf0, 0x10, 0x27, 0xd4, 0x28, 0x08, 0xf6, 0x42, 0xaf, 0x6b, 0xce, 0x4a, 0x76, 0x0a, 0x41, 0xa7,
+	0x4f, 0xbb, 0x3a, 0xf5, 0xcd, 0xa1, 0x39, 0xf6, 0xec, 0xd0, 0x1c, 0x83, 0x17, 0xc1, 0xab, 0x2f,
+	0x10, 0xec, 0x60, 0x16, 0x91, 0x90, 0x61, 0xf8, 0xb7, 0x06, 0x56, 0x8e, 0xe3, 0xde, 0x55, 0x2b,
+	0x63, 0xa8, 0xc9, 0x07, 0x57, 0x16, 0xcf, 0x42, 0x47, 0x80, 0xfa, 0xbb, 0xe0, 0x34, 0x56, 0x81,
+	0x35, 0x8a, 0x38, 0x66, 0x6a, 0x85, 0x4b, 0xbd, 0xae, 0xb9, 0x28, 0xe9, 0x47, 0x71, 0xe8, 0xcc,
+	0xe0, 0x4c, 0x25, 0x96, 0xf1, 0xa6, 0x70, 0x22, 0x6f, 0x26, 0x4e, 0xea, 0xcd, 0x05, 0x70, 0xfe,
+	0x79, 0xeb, 0x4d, 0x8d, 0xf9, 0x52, 0x03, 0x67, 0xb7, 0x98, 0xbf, 0x89, 0x9b, 0x82, 0x77, 0x13,
+	0x63, 0xef, 0x7a, 0x0c, 0x84, 0x5c, 0xb7, 0xc1, 0x14, 0x89, 0x30, 0x15, 0xf5, 0xa5, 0x2d, 0xf3,
+	0xbd, 0xae, 0x79, 0x46, 0xd6, 0x4f, 0x10, 0xe8, 0xa4, 0xa4, 0x38, 0xc0, 0x53, 0x79, 0x8c, 0xf1,
+	0x7c, 0x40, 0x82, 0x40, 0x27, 0x25, 0x65, 0xe4, 0x56, 0x40, 0x79, 0xb8, 0x8a, 0x54, 0xe8, 0x03,
+	0x0d, 0xcc, 0x6f, 0x31, 0xff, 0x86, 0x17, 0xf0, 0x3b, 0xe2, 0x8f, 0xde, 0x46, 0x14, 0xb5, 0x84,
+	0xa3, 0x0c, 0x87, 0x1e, 0x4e, 0x34, 0x66, 0x1c, 0x95, 0xf3, 0xd0, 0x51, 0x04, 0xfd, 0x36, 0x98,
+	0x8c, 0x44, 0x90, 0x50, 0x57, 0xdc, 0x38, 0x67, 0xe5, 0x8f, 0xac, 0x95, 0x4d, 0xbd, 0xc5, 0xfc,
+	0x6c, 0x36, 0x19, 0x0a, 0x1d, 0x95, 0x23, 0x23, 0x7e, 0x15, 0x2c, 0x0f, 0x51, 0x96, 0x2a, 0xff,
+	0xa7, 0xaf, 0x7c, 0x13, 0xd3, 0xa0, 0x83, 0xbd, 0x6d, 0x14, 0xd0, 0x93, 0x2a, 0x6f, 0x47, 0x0c,
+	0x53, 0x6e, 0x8c, 0x57, 0x0a, 0x6b, 0xc5, 0x8d, 0xd5, 0x41, 0xe5, 0x99, 0xd4, 0xd5, 0xc5, 0x87,
+	0x5d, 0x73, 0xac, 0x9f, 0x4d, 0x86, 0x42, 0x47, 0xe5, 0xd0, 0x31, 0x98, 0xa4, 0xb8, 0x45, 0x3a,
+	0xd8, 0x28, 0x54, 0x0a, 0x6b, 0xd3, 0xd5, 0xad, 0x98, 0xfe, 0xb8, 0x6b, 0x5e, 0xf6, 0x03, 0xde,
+	0x68, 0xd7, 0x2d, 0x97, 0xb4, 0xec, 0x0f, 0x44, 0xfe, 0xeb, 0x0d, 0x14, 0x84, 0xb6, 0xba, 0x52,
+	0x3a, 0x1b, 0xf6, 0xbe, 0xed, 0x92, 0x56, 0x8b, 0x84, 0x36, 0x62, 0x0c, 0x73, 0x2b, 0x2e, 0xd6,
+	0x2f, 0x23, 0x73, 0x42, 0x47, 0x25, 0x1f, 0x6a, 0x50, 0xd6, 0x80, 0xd4, 0xa0, 0x6f, 0x4f, 0x81,
+	0x33, 0x39, 0xe3, 0xf5, 0xb7, 0x41, 0x31, 0x3e, 0xc0, 0xb5, 0x08, 0xd3, 0x80, 0x78, 0xc2, 0xa1,
+	0x89, 0x6a, 0xe9, 0x61, 0xd7, 0xd4, 0x7a, 0x5d, 0x53, 0x57, 0x67, 0xa0, 0x4f, 0x80, 0x0e, 0x88,
+	0x47, 0xdb, 0x62, 0xa0, 0x7f, 0x0a, 0x4e, 0x0b, 0x8c, 0x37, 0x28, 0x66, 0x0d, 0xd2, 0xf4, 0xd4,
+	0xef, 0xf8, 0x7e, 0x1c, 0xff, 0xb8, 0x6b, 0x2e, 0xcb, 0x8b, 0x8f, 0x79, 0xbb, 0x56, 0x40, 0xec,
+	0x16, 0xe2, 0x0d, 0xeb, 0x36, 0xf6, 0x91, 0x7b, 0xb0, 0x89, 0xdd, 0xfe, 0x51, 0x3e, 0x9a, 0x02,
+	0xfe, 0xf2, 0xf3, 0x3a, 0x90, 0x71, 0xd6, 0x26, 0x76, 0x9d, 0x99, 0x18, 0xfe, 0x30, 0x41, 0xf5,
+	0x06, 0x28, 0x52, 0xbc, 0x87, 0xa8, 0x57, 0xab, 0xa3, 0xd0, 0x53, 0xa7, 0xfb, 0xbd, 0xd1, 0xea,
+	0xe9, 0x89, 0x7f, 0x69, 0x7c, 0xbe, 0x18, 0x90, 0x58, 0x15, 0x85, 0x9e, 0x1e, 0x82, 0xe9, 0xbd,
+	0x46, 0xc0, 0x71, 0x33, 0x60, 0xdc, 0x98, 0x10, 0x1b, 0xb8, 0xad, 0xea, 0xbc, 0xec, 0x06, 0xaa,
+	0x3b, 0x25, 0x4d, 0x0b, 0x9d, 0x7e, 0x89, 0xd8, 0x4c, 0xd6, 0x44, 0xac, 0x51, 0xdb, 0xa1, 0xc8,
+	0x8d, 0x3b, 0x91, 0xf1, 0x9f, 0x97, 0x30, 0xf3, 0x68, 0x8a, 0x01, 0x33, 0x05, 0x7c, 0x53, 0xa1,
+	0xfa, 0x3b, 0xe0, 0x94, 0xe4, 0xef, 0x05, 0xa1, 0x47, 0xf6, 0x8c, 0x49, 0xb1, 0xfb, 0xcb, 0x6a,
+	0xf7, 0xe7, 0xb3, 0x19, 0x25, 0x03, 0x3a, 0x45, 0x31, 0xfc, 0x58, 0x8c, 0xf4, 0xcf, 0x35, 0xb0,
+	0xd0, 0x0a, 0xc2, 0x9a, 0xb8, 0x18, 0xe3, 0x3f, 0x24, 0x49, 0xf4, 0xdf, 0x8a, 0x96, 0xb1, 0xeb,
+	0x05, 0xca, 0x97, 0x65, 0x9d, 0x61, 0x89, 0xf2, 0xfa, 0xe7, 0x5a, 0x41, 0x78, 0x37, 0xe6, 0x6c,
+	0x63, 0xaa, 0x34, 0x7c, 0xaf, 0x81, 0x05, 0xbe, 0x87, 0xa2, 0x5a, 0x93, 0x90, 0xdd, 0x3a, 0x72,
+	0x77, 0x13, 0x0d, 0x53, 0xe2, 0xee, 0x59, 0xb2, 0x64, 0x23, 0xb7, 0x92, 0x46, 0x6e, 0x6d, 0xaa,
+	0x46, 0x5f, 0xbd, 0x15, 0xcb, 0xfb, 0xab, 0x6b, 0x96, 0x87, 0x85, 0xbf, 0x46, 0x5a, 0x01, 0xc7,
+	0xad, 0x88, 0x1f, 0xf4, 0x15, 0x0e, 0xe3, 0xc1, 0x07, 0xbf, 0x9b, 0x9a, 0xa3, 0xc7, 0xd0, 0x6d,
+	0x85, 0x28, 0x61, 0x6f, 0x02, 0x20, 0x96, 0x44, 0x38, 0xa6, 0xcc, 0x98, 0x16, 0xd6, 0x2e, 0x29,
+	0x6b, 0xe7, 0x32, 0x4b, 0x16, 0x38, 0x74, 0xa6, 0xe3, 0xa5, 0x89, 0x6f, 0xfd, 0x33, 0x30, 0x9f,
+	0xb6, 0x9a, 0xda, 0x0e, 0x16, 0x3d, 0x2e, 0x20, 0x06, 0x10, 0xa6, 0xde, 0x19, 0xcd, 0xd4, 0x52,
+	0xae, 0x7d, 0xf5, 0xf3, 0x0c, 0x78, 0x9a, 0x72, 0x6e, 0xe2, 0xb8, 0x6f, 0x05, 0x44, 0xdf, 0x02,
+	0x73, 0x78, 0x3f, 0x0a, 0xa4, 0x4f, 0xb5, 0x7a, 0x93, 0xb8, 0xbb, 0xcc, 0x28, 0x8a, 0x15, 0x54,
+	0xd4, 0x0a, 0x8c, 0xa4, 0x0d, 0xe7, 0x68, 0xd0, 0x99, 0xed, 0xcf, 0x55, 0xc5, 0x94, 0xfe, 0x95,
+	0x06, 0x4a, 0x2c, 0x44, 0x11, 0x6b, 0x10, 0x5e, 0xa3, 0x98, 0xe3, 0x50, 0x04, 0x48, 0x03, 0x99,
+	0x71, 0x4a, 0x6c, 0xd4, 0xca, 0xc0, 0x46, 0x7d, 0x74, 0x2b, 0xe4, 0x57, 0x2e, 0xdf, 0x45, 0xcd,
+	0x36, 0xae, 0x5e, 0xec, 0x75, 0xcd, 0x73, 0xea, 0x7f, 0x3c, 0x36, 0x13, 0x3c, 0x8c, 0xf7, 0xc2,
+	0x48, 0x08, 0x4e, 0x82, 0xcb, 0x0d, 0x61, 0x7a, 0x04, 0xe6, 0x22, 0x14, 0xd0, 0x1a, 0x52, 0xdd,
+	0x3a, 0x7e, 0x0e, 0x1a, 0x33, 0x95, 0xc2, 0xf0, 0x16, 0x15, 0x9f, 0xd9, 0x6b, 0x7d, 0x66, 0xb5,
+	0xa2, 0x2e, 0x7b, 0x23, 0x69, 0x53, 0xb9, 0x4c, 0xd0, 0x99, 0x8d, 0x8e, 0x86, 0x30, 0xfd, 0xbe,
+	0x06, 0x4c, 0x71, 0xbd, 0x21, 0xd7, 0x6d, 0x53, 0xe4, 0x1e, 0x0c, 0x59, 0xfe, 0xe9, 0x11, 0x96,
+	0x6f, 0xf5, 0xba, 0xe6, 0x85, 0xcc, 0x6d, 0x79, 0x7c, 0x3a, 0xe9, 0xc1, 0x4a, 0xcc, 0xba, 0xa6,
+	0x48, 0x79, 0x1f, 0xae, 0x4e, 0x3d, 0x38, 0x34, 0xb5, 0x67, 0x87, 0xa6, 0xb6, 0x71, 0x6f, 0x12,
+	0x14, 0xe2, 0x2e, 0xf0, 0xa3, 0x06, 0x56, 0x9e, 0xfb, 0x20, 0xbd, 0x34, 0xe8, 0xcf, 0x0b, 0x9e,
+	0x84, 0xa5, 0xb7, 0x4e, 0x1c, 0x92, 0x36, 0xaa, 0xf2, 0x17, 0xbf, 0xfe, 0x79, 0x7f, 0xdc, 0x80,
+	0x67, 0xed, 0xa3, 0xaf, 0xec, 0x48, 0xa9, 0x39, 0xd4, 0xc0, 0xd2, 0xf1, 0x4f, 0x4c, 0x6b, 0xf4,
+	0xc2, 0x31, 0xbf, 0x74, 0xe5, 0x64, 0xfc, 0x54, 0xe5, 0xb2, 0x50, 0xb9, 0x08, 0xe7, 0x73, 0x2a,
+	0x85, 0xc4, 0x1f, 0x34, 0x30, 0x3f, 0xec, 0xb1, 0xb7, 0x36, 0xb4, 0xd8, 0x10, 0x66, 0xe9, 0xf5,
+	0x51, 0x99, 0xa9, 0xa0, 0x0b, 0x42, 0x50, 0x05, 0x96, 0x73, 0x82, 0xe4, 0x43, 0x77, 0x3d, 0x79,
+	0x0e, 0xea, 0x5f, 0x6b, 0x60, 0x76, 0xe0, 0x7d, 0xf7, 0xca, 0xd0, 0x72, 0x79, 0x5a, 0x69, 0x7d,
+	0x24, 0x5a, 0x2a, 0x69, 0x55, 0x48, 0xfa, 0x1f, 0x5c, 0xcc, 0xef, 0xa4, 0x2c, 0x7a, 0x4f, 0x29,
+	0x39, 0xf2, 0x5e, 0x3b, 0x5e, 0x49, 0x96, 0x56, 0x5a, 0x1f, 0x89, 0x96, 0x2a, 0x39, 0x2f, 0x94,
+	0x94, 0xe1, 0x4a, 0x4e, 0x89, 0x27, 0xc9, 0xeb, 0xf1, 0xd9, 0x65, 0xd5, 0x5b, 0x0f, 0x9f, 0x94,
+	0xb5, 0x47, 0x4f, 0xca, 0xda, 0x1f, 0x4f, 0xca, 0xda, 0x77, 0x4f, 0xcb, 0x63, 0x8f, 0x9e, 0x96,
+	0xc7, 0x7e, 0x7b, 0x5a, 0x1e, 0xfb, 0xc4, 0x1e, 0xa1, 0xe7, 0xab, 0x94, 0xfc, 0x20, 0xc2, 0xac,
+	0x3e, 0x29, 0x4e, 0xf2, 0x1b, 0xff, 0x0e, 0x00, 0x0b, 0xfc, 0xb5, 0x9c, 0xcb, 0x0e, 0x00, 0x00,
 }
 
 func (this *OracleParamsMsg) Equal(that interface{}) bool {
@@ -635,6 +765,32 @@ func (this *OracleParamsMsg) Equal(that interface{}) bool {
 	if this.ExpirationBlocks != that1.ExpirationBlocks {
 		return false
 	}
+	if this.SnapshotRetentionWindows != nil && that1.SnapshotRetentionWindows != nil {
+		if *this.SnapshotRetentionWindows != *that1.SnapshotRetentionWindows {
+			return false
+		}
+	} else if this.SnapshotRetentionWindows != nil {
+		return false
+	} else if that1.SnapshotRetentionWindows != nil {
+		return false
+	}
+	if len(this.PairAggregations) != len(that1.PairAggregations) {
+		return false
+	}
+	for i := range this.PairAggregations {
+		if !this.PairAggregations[i].Equal(&that1.PairAggregations[i]) {
+			return false
+		}
+	}
+	if this.VoteAccuracyRetentionWindows != nil && that1.VoteAccuracyRetentionWindows != nil {
+		if *this.VoteAccuracyRetentionWindows != *that1.VoteAccuracyRetentionWindows {
+			return false
+		}
+	} else if this.VoteAccuracyRetentionWindows != nil {
+		return false
+	} else if that1.VoteAccuracyRetentionWindows != nil {
+		return false
+	}
 	return true
 }
 
@@ -661,6 +817,10 @@ type MsgClient interface {
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
 	EditOracleParams(ctx context.Context, in *MsgEditOracleParams, opts ...grpc.CallOption) (*MsgEditOracleParamsResponse, error)
+	// EditDerivedPairs adds, updates, and removes derived pairs, whose exchange
+	// rates are computed from the exchange rates of voted pairs.
+	// [SUDO] Only callable by sudoers or governance.
+	EditDerivedPairs(ctx context.Context, in *MsgEditDerivedPairs, opts ...grpc.CallOption) (*MsgEditDerivedPairsResponse, error)
 }
 
 type msgClient struct {
@@ -707,6 +867,15 @@ func (c *msgClient) EditOracleParams(ctx context.Context, in *MsgEditOracleParam
 	return out, nil
 }
 
+func (c *msgClient) EditDerivedPairs(ctx context.Context, in *MsgEditDerivedPairs, opts ...grpc.CallOption) (*MsgEditDerivedPairsResponse, error) {
+	out := new(MsgEditDerivedPairsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Msg/EditDerivedPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AggregateExchangeRatePrevote defines a method for submitting
@@ -720,6 +889,10 @@ type MsgServer interface {
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
 	EditOracleParams(context.Context, *MsgEditOracleParams) (*MsgEditOracleParamsResponse, error)
+	// EditDerivedPairs adds, updates, and removes derived pairs, whose exchange
+	// rates are computed from the exchange rates of voted pairs.
+	// [SUDO] Only callable by sudoers or governance.
+	EditDerivedPairs(context.Context, *MsgEditDerivedPairs) (*MsgEditDerivedPairsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -738,6 +911,9 @@ func (*UnimplementedMsgServer) DelegateFeedConsent(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) EditOracleParams(ctx context.Context, req *MsgEditOracleParams) (*MsgEditOracleParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditOracleParams not implemented")
 }
+func (*UnimplementedMsgServer) EditDerivedPairs(ctx context.Context, req *MsgEditDerivedPairs) (*MsgEditDerivedPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditDerivedPairs not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -815,6 +991,24 @@ func _Msg_EditOracleParams_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_EditDerivedPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditDerivedPairs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditDerivedPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Msg/EditDerivedPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditDerivedPairs(ctx, req.(*MsgEditDerivedPairs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.oracle.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -835,6 +1029,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EditOracleParams",
 			Handler:    _Msg_EditOracleParams_Handler,
 		},
+		{
+			MethodName: "EditDerivedPairs",
+			Handler:    _Msg_EditDerivedPairs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/oracle/v1/tx.proto",
@@ -1106,6 +1304,87 @@ func (m *MsgEditOracleParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgEditDerivedPairs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditDerivedPairs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditDerivedPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Remove[iNdEx].Size()
+				i -= size
+				if _, err := m.Remove[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Upsert) > 0 {
+		for iNdEx := len(m.Upsert) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upsert[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditDerivedPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditDerivedPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditDerivedPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *OracleParamsMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1126,6 +1405,40 @@ func (m *OracleParamsMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VoteAccuracyRetentionWindows != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdUInt64MarshalTo(*m.VoteAccuracyRetentionWindows, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdUInt64(*m.VoteAccuracyRetentionWindows):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.PairAggregations) > 0 {
+		for iNdEx := len(m.PairAggregations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairAggregations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.SnapshotRetentionWindows != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdUInt64MarshalTo(*m.SnapshotRetentionWindows, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdUInt64(*m.SnapshotRetentionWindows):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.ExpirationBlocks != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationBlocks))
 		i--
@@ -1149,12 +1462,12 @@ func (m *OracleParamsMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x48
 	}
 	if m.TwapLookbackWindow != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.TwapLookbackWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.TwapLookbackWindow):])
-		if err2 != nil {
-			return 0, err2
+		n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.TwapLookbackWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.TwapLookbackWindow):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n2
-		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1360,6 +1673,40 @@ func (m *MsgEditOracleParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgEditDerivedPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Upsert) > 0 {
+		for _, e := range m.Upsert {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, e := range m.Remove {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgEditDerivedPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *OracleParamsMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1408,6 +1755,20 @@ func (m *OracleParamsMsg) Size() (n int) {
 	if m.ExpirationBlocks != 0 {
 		n += 1 + sovTx(uint64(m.ExpirationBlocks))
 	}
+	if m.SnapshotRetentionWindows != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdUInt64(*m.SnapshotRetentionWindows)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PairAggregations) > 0 {
+		for _, e := range m.PairAggregations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.VoteAccuracyRetentionWindows != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdUInt64(*m.VoteAccuracyRetentionWindows)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2173,6 +2534,208 @@ func (m *MsgEditOracleParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgEditDerivedPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditDerivedPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditDerivedPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Upsert = append(m.Upsert, DerivedPair{})
+			if err := m.Upsert[len(m.Upsert)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_NibiruChain_nibiru_v2_x_common_asset.Pair
+			m.Remove = append(m.Remove, v)
+			if err := m.Remove[len(m.Remove)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditDerivedPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditDerivedPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditDerivedPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OracleParamsMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2530,6 +3093,112 @@ func (m *OracleParamsMsg) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetentionWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SnapshotRetentionWindows == nil {
+				m.SnapshotRetentionWindows = new(uint64)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdUInt64Unmarshal(m.SnapshotRetentionWindows, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairAggregations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairAggregations = append(m.PairAggregations, PairAggregation{})
+			if err := m.PairAggregations[len(m.PairAggregations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteAccuracyRetentionWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteAccuracyRetentionWindows == nil {
+				m.VoteAccuracyRetentionWindows = new(uint64)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdUInt64Unmarshal(m.VoteAccuracyRetentionWindows, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+)
+
+// SlashWindowIndex returns the index of the slash window that contains the
+// given block height.
+func SlashWindowIndex(blockHeight int64, slashWindow uint64) uint64 {
+	if blockHeight < 0 || slashWindow == 0 {
+		return 0
+	}
+	return uint64(blockHeight) / slashWindow
+}
+
+// NewVoteAccuracy returns an empty [VoteAccuracy] record of a validator for a
+// pair during a slash window.
+func NewVoteAccuracy(valAddr sdk.ValAddress, pair asset.Pair, slashWindow uint64) VoteAccuracy {
+	return VoteAccuracy{
+		Validator:    valAddr.String(),
+		Pair:         pair,
+		SlashWindow:  slashWindow,
+		SumDeviation: sdkmath.LegacyZeroDec(),
+		MaxDeviation: sdkmath.LegacyZeroDec(),
+	}
+}
+
+// AddVote records a tallied vote against the exchange rate of its ballot.
+// Abstain votes only increment the abstain count.
+func (a *VoteAccuracy) AddVote(
+	vote, exchangeRate sdkmath.LegacyDec, isInsideSpread bool,
+) {
+	if !vote.IsPositive() {
+		a.AbstainCount++
+		return
+	}
+
+	a.VoteCount++
+	if isInsideSpread {
+		a.WinCount++
+	}
+	if !exchangeRate.IsPositive() {
+		return
+	}
+	deviation := vote.Sub(exchangeRate).Abs().Quo(exchangeRate)
+	a.SumDeviation = a.SumDeviation.Add(deviation)
+	if deviation.GT(a.MaxDeviation) {
+		a.MaxDeviation = deviation
+	}
+}
+
+// MeanDeviation returns the mean relative deviation of the votes from the
+// tallied exchange rates, or zero if there are no votes.
+func (a VoteAccuracy) MeanDeviation() sdkmath.LegacyDec {
+	if a.VoteCount == 0 {
+		return sdkmath.LegacyZeroDec()
+	}
+	return a.SumDeviation.QuoInt64(int64(a.VoteCount))
+}
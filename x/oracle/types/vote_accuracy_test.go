@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/asset"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+func TestSlashWindowIndex(t *testing.T) {
+	require.EqualValues(t, 0, types.SlashWindowIndex(99, 100))
+	require.EqualValues(t, 1, types.SlashWindowIndex(100, 100))
+	require.EqualValues(t, 0, types.SlashWindowIndex(100, 0))
+	require.EqualValues(t, 0, types.SlashWindowIndex(-1, 100))
+}
+
+func TestVoteAccuracyAddVote(t *testing.T) {
+	accuracy := types.NewVoteAccuracy(sdk.ValAddress("val"), asset.PAIR_BTC, 3)
+	median := sdkmath.LegacyNewDec(100)
+
+	accuracy.AddVote(sdkmath.LegacyNewDec(101), median, true)
+	accuracy.AddVote(sdkmath.LegacyNewDec(95), median, false)
+	accuracy.AddVote(sdkmath.LegacyZeroDec(), median, false)
+
+	require.EqualValues(t, 2, accuracy.VoteCount)
+	require.EqualValues(t, 1, accuracy.WinCount)
+	require.EqualValues(t, 1, accuracy.AbstainCount)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.06"), accuracy.SumDeviation)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.05"), accuracy.MaxDeviation)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.03"), accuracy.MeanDeviation())
+
+	require.Equal(t, sdkmath.LegacyZeroDec(),
+		types.NewVoteAccuracy(sdk.ValAddress("val"), asset.PAIR_BTC, 3).MeanDeviation())
+}